@@ -684,6 +684,11 @@ func generateAndValidateNewTargets(
 		if err != nil {
 			return nil, nil, hlc.Timestamp{}, nil, err
 		}
+		if table, ok := desc.(catalog.TableDescriptor); ok {
+			if err := checkRowLevelSecurityForTable(ctx, p, table); err != nil {
+				return nil, nil, hlc.Timestamp{}, nil, err
+			}
+		}
 		hasSelectPrivOnAllTables = hasSelectPrivOnAllTables && hasSelect
 		hasChangefeedPrivOnAllTables = hasChangefeedPrivOnAllTables && hasChangefeed
 	}
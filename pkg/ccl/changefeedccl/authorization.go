@@ -11,8 +11,12 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/changefeedbase"
 	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/sql"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
@@ -52,6 +56,135 @@ func checkPrivilegesForDescriptor(
 	return hasSelect, hasChangefeed, nil
 }
 
+// checkRowLevelSecurityForTable returns a pgcode.InsufficientPrivilege error if
+// the current user is subject to the row-level security policies of the given
+// table. Changefeeds read rows directly from KV and do not apply the policies,
+// so only users that are exempt from them can create changefeeds on the table.
+func checkRowLevelSecurityForTable(
+	ctx context.Context, p sql.PlanHookState, table catalog.TableDescriptor,
+) error {
+	exempt, err := p.IsExemptFromRowLevelSecurity(ctx, table)
+	if err != nil {
+		return err
+	}
+	if !exempt {
+		return errors.WithHint(
+			pgerror.Newf(pgcode.InsufficientPrivilege,
+				"user %s cannot create a changefeed on table %s because the user is subject to its row-level security policies",
+				p.User(), table.GetName()),
+			rowLevelSecurityHint)
+	}
+	return nil
+}
+
+const rowLevelSecurityHint = "Changefeeds do not apply row-level security policies. They can only target tables with " +
+	"row-level security enabled if the user is an admin, has the BYPASSRLS privilege, or owns " +
+	"the table and row-level security is not forced."
+
+// checkRowLevelSecurityForRunningChangefeed returns a terminal
+// pgcode.InsufficientPrivilege error if the given user of a running changefeed
+// is subject to the row-level security policies of the given table. Row-level
+// security may have been enabled on the table, or the user may have lost their
+// exemption, since the changefeed was created.
+func checkRowLevelSecurityForRunningChangefeed(
+	ctx context.Context,
+	execCfg *sql.ExecutorConfig,
+	user username.SQLUsername,
+	table catalog.TableDescriptor,
+) error {
+	if !table.IsRowLevelSecurityEnabled() {
+		return nil
+	}
+	var exempt bool
+	if err := sql.DescsTxn(ctx, execCfg, func(ctx context.Context, txn isql.Txn, col *descs.Collection) error {
+		sd := sql.NewInternalSessionData(ctx, execCfg.Settings, "changefeed-rls-check")
+		p, cleanup := sql.NewInternalPlanner(
+			"changefeed-rls-check", txn.KV(), user, &sql.MemoryMetrics{}, execCfg, sd,
+			sql.WithDescCollection(col),
+		)
+		defer cleanup()
+		var err error
+		exempt, err = p.(sql.PlanHookState).IsExemptFromRowLevelSecurity(ctx, table)
+		return err
+	}); err != nil {
+		return err
+	}
+	if !exempt {
+		return changefeedbase.WithTerminalError(errors.WithHint(
+			pgerror.Newf(pgcode.InsufficientPrivilege,
+				"changefeed cannot emit rows of table %s because its user %s is subject to the row-level security policies of the table",
+				table.GetName(), user),
+			rowLevelSecurityHint))
+	}
+	return nil
+}
+
+// checkRowLevelSecurityForTargets checks each table targeted by a changefeed
+// being resumed with checkRowLevelSecurityForRunningChangefeed. Tables that
+// were dropped are skipped; the changefeed handles them when it runs.
+func checkRowLevelSecurityForTargets(
+	ctx context.Context,
+	execCfg *sql.ExecutorConfig,
+	user username.SQLUsername,
+	targets changefeedbase.Targets,
+) error {
+	var tables []catalog.TableDescriptor
+	if err := sql.DescsTxn(ctx, execCfg, func(ctx context.Context, txn isql.Txn, col *descs.Collection) error {
+		tables = tables[:0]
+		return targets.EachTableID(func(id descpb.ID) error {
+			table, err := col.ByIDWithoutLeased(txn.KV()).Get().Table(ctx, id)
+			if err != nil {
+				if catalog.HasInactiveDescriptorError(err) || errors.Is(err, catalog.ErrDescriptorNotFound) {
+					return nil
+				}
+				return err
+			}
+			tables = append(tables, table)
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := checkRowLevelSecurityForRunningChangefeed(ctx, execCfg, user, table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowLevelSecurityChecker checks the tables of the rows emitted by a running
+// changefeed with checkRowLevelSecurityForRunningChangefeed. Each version of a
+// table descriptor is checked once, so that enabling row-level security on a
+// table, or changing its owner, fails the changefeed before it emits rows of
+// the new version.
+type rowLevelSecurityChecker struct {
+	execCfg *sql.ExecutorConfig
+	user    username.SQLUsername
+	checked map[descpb.ID]descpb.DescriptorVersion
+}
+
+func makeRowLevelSecurityChecker(
+	execCfg *sql.ExecutorConfig, user username.SQLUsername,
+) rowLevelSecurityChecker {
+	return rowLevelSecurityChecker{
+		execCfg: execCfg,
+		user:    user,
+		checked: make(map[descpb.ID]descpb.DescriptorVersion),
+	}
+}
+
+func (c *rowLevelSecurityChecker) check(ctx context.Context, table catalog.TableDescriptor) error {
+	if v, ok := c.checked[table.GetID()]; ok && v == table.GetVersion() {
+		return nil
+	}
+	if err := checkRowLevelSecurityForRunningChangefeed(ctx, c.execCfg, c.user, table); err != nil {
+		return err
+	}
+	c.checked[table.GetID()] = table.GetVersion()
+	return nil
+}
+
 // authorizeUserToCreateChangefeed performs changefeed creation authorization checks, returning a
 // pgcode.InsufficientPrivilege error if the check fails.
 //
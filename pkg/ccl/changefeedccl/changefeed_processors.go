@@ -603,6 +603,7 @@ func (ca *changeAggregator) makeKVFeedCfg(
 		WithFiltering:        filters.WithFiltering,
 		WithFrontierQuantize: changefeedbase.Quantize.Get(&cfg.Settings.SV),
 		WithBulkDelivery:     changefeedbase.BulkDelivery.Get(&cfg.Settings.SV),
+		EventFilter:          ca.rangefeedEventFilter(ctx),
 		NeedsInitialScan:     needsInitialScan,
 		SchemaChangeEvents:   schemaChange.EventClass,
 		SchemaChangePolicy:   schemaChange.Policy,
@@ -821,6 +821,15 @@ func createChangefeedJobRecord(
 		); err != nil {
 			return nil, changefeedbase.Targets{}, err
 		}
+		if err := targets.EachTableID(func(id descpb.ID) error {
+			table, err := p.Descriptors().ByIDWithoutLeased(p.Txn()).WithoutNonPublic().Get().Table(ctx, id)
+			if err != nil {
+				return err
+			}
+			return checkRowLevelSecurityForTable(ctx, p, table)
+		}); err != nil {
+			return nil, changefeedbase.Targets{}, err
+		}
 	}
 
 	if changefeedStmt.Select != nil {
@@ -1573,6 +1582,16 @@ func (b *changefeedResumer) Resume(ctx context.Context, execCtx interface{}) err
 		return err
 	}
 
+	// Row-level security may have been enabled on a target table, or the user
+	// may have lost their exemption from its policies, while the changefeed was
+	// paused.
+	if err := b.checkRowLevelSecurity(ctx, execCfg, details); err != nil {
+		if termErr := changefeedbase.AsTerminalError(ctx, execCfg.LeaseManager, err); termErr != nil {
+			return b.handleChangefeedError(ctx, termErr, details, jobExec)
+		}
+		return jobs.MarkAsRetryJobError(err)
+	}
+
 	err := b.resumeWithRetries(ctx, jobExec, jobID, details, description, progress, execCfg)
 	if err != nil {
 		return b.handleChangefeedError(ctx, err, details, jobExec)
@@ -1580,6 +1599,18 @@ func (b *changefeedResumer) Resume(ctx context.Context, execCtx interface{}) err
 	return nil
 }
 
+// checkRowLevelSecurity checks that the user of the changefeed is exempt from
+// the row-level security policies of the tables it targets.
+func (b *changefeedResumer) checkRowLevelSecurity(
+	ctx context.Context, execCfg *sql.ExecutorConfig, details jobspb.ChangefeedDetails,
+) error {
+	targets, err := AllTargets(ctx, details, execCfg, execCfg.Clock.Now())
+	if err != nil {
+		return err
+	}
+	return checkRowLevelSecurityForTargets(ctx, execCfg, b.job.Payload().UsernameProto.Decode(), targets)
+}
+
 // ensureClusterIDMatches verifies that this job record matches
 // the cluster ID of this cluster.
 // This check ensures that if the job has been restored from the
@@ -657,6 +657,155 @@ func TestRLSBlocking(t *testing.T) {
 	cdcTest(t, testFn, withAllowChangefeedErr("expects terminal error"))
 }
 
+// TestRLSChangefeedRequiresExemption verifies that changefeeds, which read rows
+// without applying row-level security policies, can only target tables with
+// row-level security enabled if the user is exempt from the policies.
+func TestRLSChangefeedRequiresExemption(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	testFn := func(t *testing.T, s TestServer, f cdctest.TestFeedFactory) {
+		rootDB := sqlutils.MakeSQLRunner(s.DB)
+		rootDB.Exec(t, `CREATE USER user1`)
+		rootDB.Exec(t, `CREATE TABLE rls (a INT PRIMARY KEY, b STRING)`)
+		rootDB.Exec(t, `INSERT INTO rls VALUES (0, 'hidden'), (1, 'visible')`)
+		rootDB.Exec(t, `GRANT SELECT ON rls TO user1`)
+		rootDB.Exec(t, `ALTER TABLE rls ENABLE ROW LEVEL SECURITY`)
+		rootDB.Exec(t, `CREATE POLICY p ON rls FOR SELECT TO user1 USING (a != 0)`)
+
+		expectSuccess := func(stmt string) {
+			successfulFeed := feed(t, f, stmt)
+			defer closeFeed(t, successfulFeed)
+			_, err := successfulFeed.Next()
+			require.NoError(t, err)
+		}
+		const expErrSubstr = `user user1 cannot create a changefeed on table rls because the user is subject to its row-level security policies`
+
+		// Admins are exempt from the policies.
+		expectSuccess(`CREATE CHANGEFEED FOR rls`)
+
+		asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+			expectErrCreatingFeed(t, f, `CREATE CHANGEFEED FOR rls`, expErrSubstr)
+		})
+
+		// The table owner is exempt, unless row-level security is forced.
+		rootDB.Exec(t, `ALTER TABLE rls OWNER TO user1`)
+		asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+			expectSuccess(`CREATE CHANGEFEED FOR rls`)
+		})
+		rootDB.Exec(t, `ALTER TABLE rls FORCE ROW LEVEL SECURITY`)
+		asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+			expectErrCreatingFeed(t, f, `CREATE CHANGEFEED FOR rls`, expErrSubstr)
+		})
+
+		// Users with BYPASSRLS are exempt.
+		rootDB.Exec(t, `GRANT SYSTEM BYPASSRLS TO user1`)
+		asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+			expectSuccess(`CREATE CHANGEFEED FOR rls`)
+		})
+		rootDB.Exec(t, `REVOKE SYSTEM BYPASSRLS FROM user1`)
+
+		// Disabling row-level security removes the restriction.
+		rootDB.Exec(t, `ALTER TABLE rls DISABLE ROW LEVEL SECURITY`)
+		asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+			expectSuccess(`CREATE CHANGEFEED FOR rls`)
+		})
+	}
+
+	cdcTest(t, testFn, feedTestForceSink("sinkless"))
+}
+
+// TestRLSChangefeedEnabledAfterCreation verifies that database-level
+// changefeeds check the row-level security policies of the tables of the
+// database, and that running changefeeds fail if row-level security is enabled
+// on a table after they were created.
+func TestRLSChangefeedEnabledAfterCreation(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	testFn := func(t *testing.T, s TestServer, f cdctest.TestFeedFactory) {
+		rootDB := sqlutils.MakeSQLRunner(s.DB)
+		rootDB.Exec(t, `CREATE USER user1`)
+		rootDB.Exec(t, `CREATE TABLE rls (a INT PRIMARY KEY, b STRING)`)
+		rootDB.Exec(t, `INSERT INTO rls VALUES (0, 'hidden'), (1, 'visible')`)
+		rootDB.Exec(t, `GRANT SELECT ON rls TO user1`)
+		rootDB.Exec(t, `GRANT CHANGEFEED ON DATABASE d TO user1`)
+		rootDB.Exec(t, `CREATE POLICY p ON rls FOR SELECT TO user1 USING (a != 0)`)
+
+		// A database-level changefeed targets every table of the database.
+		rootDB.Exec(t, `ALTER TABLE rls ENABLE ROW LEVEL SECURITY`)
+		asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+			expectErrCreatingFeed(t, f, `CREATE CHANGEFEED FOR DATABASE d`,
+				`user user1 cannot create a changefeed on table rls because the user is subject to its row-level security policies`)
+		})
+		rootDB.Exec(t, `ALTER TABLE rls DISABLE ROW LEVEL SECURITY`)
+
+		const expErrSubstr = `changefeed cannot emit rows of table rls because its user user1 is subject to the row-level security policies of the table`
+		for _, stmt := range []string{
+			`CREATE CHANGEFEED FOR rls`,
+			`CREATE CHANGEFEED FOR DATABASE d`,
+		} {
+			asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+				tf := feed(t, f, stmt)
+				defer closeFeed(t, tf)
+				assertPayloads(t, tf, []string{
+					`rls: [0]->{"after": {"a": 0, "b": "hidden"}}`,
+					`rls: [1]->{"after": {"a": 1, "b": "visible"}}`,
+				})
+
+				// Enabling row-level security fails the changefeed before it
+				// emits the rows written afterwards.
+				rootDB.Exec(t, `ALTER TABLE rls ENABLE ROW LEVEL SECURITY`)
+				rootDB.Exec(t, `INSERT INTO rls VALUES (2, 'hidden')`)
+				_, err := readNextMessages(context.Background(), tf, 1)
+				require.Error(t, err)
+				require.Contains(t, err.Error(), expErrSubstr)
+			})
+			rootDB.Exec(t, `ALTER TABLE rls DISABLE ROW LEVEL SECURITY`)
+			rootDB.Exec(t, `DELETE FROM rls WHERE a = 2`)
+		}
+	}
+
+	cdcTest(t, testFn, feedTestForceSink("sinkless"), withAllowChangefeedErr("expects terminal error"))
+}
+
+// TestRLSChangefeedCheckedOnResume verifies that a paused changefeed fails
+// when it is resumed if row-level security was enabled on one of its tables
+// while it was paused.
+func TestRLSChangefeedCheckedOnResume(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	testFn := func(t *testing.T, s TestServer, f cdctest.TestFeedFactory) {
+		rootDB := sqlutils.MakeSQLRunner(s.DB)
+		rootDB.Exec(t, `CREATE USER user1`)
+		rootDB.Exec(t, `CREATE TABLE rls (a INT PRIMARY KEY, b STRING)`)
+		rootDB.Exec(t, `INSERT INTO rls VALUES (0, 'hidden'), (1, 'visible')`)
+		rootDB.Exec(t, `GRANT SELECT, CHANGEFEED ON rls TO user1`)
+		rootDB.Exec(t, `CREATE POLICY p ON rls FOR SELECT TO user1 USING (a != 0)`)
+
+		var tf cdctest.TestFeed
+		asUser(t, f, `user1`, func(_ *sqlutils.SQLRunner) {
+			tf = feed(t, f, `CREATE CHANGEFEED FOR rls`)
+		})
+		defer closeFeed(t, tf)
+		assertPayloads(t, tf, []string{
+			`rls: [0]->{"after": {"a": 0, "b": "hidden"}}`,
+			`rls: [1]->{"after": {"a": 1, "b": "visible"}}`,
+		})
+
+		jobFeed := tf.(cdctest.EnterpriseTestFeed)
+		require.NoError(t, jobFeed.Pause())
+		rootDB.Exec(t, `ALTER TABLE rls ENABLE ROW LEVEL SECURITY`)
+		require.NoError(t, jobFeed.Resume())
+		require.NoError(t, jobFeed.WaitForState(func(s jobs.State) bool { return s == jobs.StateFailed }))
+		require.Contains(t, jobFeed.FetchTerminalJobErr().Error(),
+			`changefeed cannot emit rows of table rls because its user user1 is subject to the row-level security policies of the table`)
+	}
+
+	cdcTest(t, testFn, feedTestEnterpriseSinks, withAllowChangefeedErr("expects terminal error"))
+}
+
 func TestToJSONAsChangefeed(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 	defer log.Scope(t).Close(t)
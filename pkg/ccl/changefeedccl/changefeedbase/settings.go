@@ -232,6 +232,15 @@ var BulkDelivery = settings.RegisterBoolSetting(
 		"if false, rangefeed events are delivered individually",
 	metamorphic.ConstantWithTestBool("changefeed.bulk_delivery.enabled", true))
 
+// RangefeedEventFilter enables pushing targeted column families and simple
+// changefeed expression predicates down to the rangefeed servers.
+var RangefeedEventFilter = settings.RegisterBoolSetting(
+	settings.ApplicationLevel,
+	"changefeed.rangefeed_event_filter.enabled",
+	"if true, rangefeed servers drop events on untargeted column families and "+
+		"events that cannot satisfy the changefeed expression's WHERE clause",
+	metamorphic.ConstantWithTestBool("changefeed.rangefeed_event_filter.enabled", true))
+
 // MaxProtectedTimestampAge controls the frequency of protected timestamp record updates
 var MaxProtectedTimestampAge = settings.RegisterDurationSetting(
 	settings.ApplicationLevel,
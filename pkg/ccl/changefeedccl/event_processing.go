@@ -72,6 +72,10 @@ type kvEventToRowConsumer struct {
 	metrics *sliMetrics
 	sv      *settings.Values
 
+	// rlsChecker fails the changefeed if its user is subject to the row-level
+	// security policies of a table it emits rows of.
+	rlsChecker rowLevelSecurityChecker
+
 	// This pacer is used to incorporate event consumption to elastic CPU
 	// control. This helps ensure that event encoding/decoding does not throttle
 	// foreground SQL traffic.
@@ -278,6 +282,7 @@ func newKVEventToRowConsumer(
 		metrics:              metrics,
 		pacer:                pacer,
 		sv:                   cfg.SV(),
+		rlsChecker:           makeRowLevelSecurityChecker(cfg, spec.User()),
 	}, nil
 }
 
@@ -369,6 +374,9 @@ func (c *kvEventToRowConsumer) ConsumeEvent(ctx context.Context, ev kvevent.Even
 		}
 		return err
 	}
+	if err := c.rlsChecker.check(ctx, updatedRow.TableDescriptor()); err != nil {
+		return err
+	}
 
 	// Get prev value, if necessary.
 	prevRow, err := func() (cdcevent.Row, error) {
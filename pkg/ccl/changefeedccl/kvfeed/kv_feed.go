@@ -22,6 +22,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/keys"
 	"github.com/cockroachdb/cockroach/pkg/kv"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/kvcoord"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/util/ctxgroup"
@@ -105,6 +106,10 @@ type Config struct {
 	// granularity.
 	WithFrontierQuantize time.Duration
 
+	// EventFilter, if set, is propagated via the RangefeedRequest to the
+	// rangefeed server, which drops value events that provably don't match it.
+	EventFilter *kvpb.RangeFeedEventFilter
+
 	// Knobs are kvfeed testing knobs.
 	Knobs TestingKnobs
 
@@ -147,6 +152,7 @@ func Run(ctx context.Context, cfg Config) error {
 		cfg.Codec,
 		cfg.SchemaFeed,
 		sc, pff, bf, cfg.Targets, cfg.ScopedTimers, cfg.Knobs)
+	f.eventFilter = cfg.EventFilter
 	f.onBackfillCallback = cfg.MonitoringCfg.OnBackfillCallback
 	f.rangeObserver = startLaggingRangesObserver(g, cfg.MonitoringCfg.LaggingRangesCallback,
 		cfg.MonitoringCfg.LaggingRangesPollingInterval, cfg.MonitoringCfg.LaggingRangesThreshold)
@@ -267,6 +273,7 @@ type kvFeed struct {
 	withInitialBackfill  bool
 	withBulkDelivery     bool
 	consumerID           int64
+	eventFilter          *kvpb.RangeFeedEventFilter
 	initialHighWater     hlc.Timestamp
 	initialSpanTimePairs []kvcoord.SpanTimePair
 	endTime              hlc.Timestamp
@@ -624,6 +631,7 @@ func (f *kvFeed) runUntilTableEvent(ctx context.Context, resumeFrontier span.Fro
 		WithFrontierQuantize: f.withFrontierQuantize,
 		WithBulkDelivery:     f.withBulkDelivery,
 		ConsumerID:           f.consumerID,
+		EventFilter:          f.eventFilter,
 		Knobs:                f.knobs,
 		Timers:               f.timers,
 		RangeObserver:        f.rangeObserver,
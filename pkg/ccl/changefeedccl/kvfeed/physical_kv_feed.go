@@ -36,6 +36,7 @@ type rangeFeedConfig struct {
 	WithFrontierQuantize time.Duration
 	WithBulkDelivery     bool
 	ConsumerID           int64
+	EventFilter          *kvpb.RangeFeedEventFilter
 	RangeObserver        kvcoord.RangeObserver
 	Knobs                TestingKnobs
 	Timers               *timers.ScopedTimers
@@ -114,6 +115,9 @@ func (p rangefeedFactory) Run(ctx context.Context, sink kvevent.Writer, cfg rang
 	if cfg.ConsumerID != 0 {
 		rfOpts = append(rfOpts, kvcoord.WithConsumerID(cfg.ConsumerID))
 	}
+	if cfg.EventFilter != nil {
+		rfOpts = append(rfOpts, kvcoord.WithEventFilter(cfg.EventFilter))
+	}
 	if len(cfg.Knobs.RangefeedOptions) != 0 {
 		rfOpts = append(rfOpts, cfg.Knobs.RangefeedOptions...)
 	}
@@ -0,0 +1,255 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package changefeedccl
+
+import (
+	"context"
+	"go/constant"
+	"math"
+
+	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/cdceval"
+	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/changefeedbase"
+	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/sql"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq/oid"
+)
+
+// rangefeedEventFilter returns the filter the aggregator's kvfeed pushes down
+// to the rangefeed servers, or nil if there is nothing to push down. The filter
+// is only an optimization, so failing to build it is logged and ignored.
+func (ca *changeAggregator) rangefeedEventFilter(ctx context.Context) *kvpb.RangeFeedEventFilter {
+	if !changefeedbase.RangefeedEventFilter.Get(&ca.FlowCtx.Cfg.Settings.SV) {
+		return nil
+	}
+	var sc *tree.SelectClause
+	if ca.spec.Select.Expr != "" {
+		var err error
+		if sc, err = cdceval.ParseChangefeedExpression(ca.spec.Select.Expr); err != nil {
+			log.Changefeed.Warningf(ctx, "not filtering rangefeed events: %v", err)
+			return nil
+		}
+	}
+	// Avoid fetching descriptors when neither the targets nor the expression
+	// can narrow down the events.
+	var hasColumnFamilyTarget bool
+	_ = ca.targets.EachTarget(func(t changefeedbase.Target) error {
+		if t.Type == jobspb.ChangefeedTargetSpecification_COLUMN_FAMILY {
+			hasColumnFamilyTarget = true
+		}
+		return nil
+	})
+	if !hasColumnFamilyTarget && (sc == nil || sc.Where == nil) {
+		return nil
+	}
+
+	execCfg := ca.FlowCtx.Cfg.ExecutorConfig.(*sql.ExecutorConfig)
+	if ca.knobs.OverrideExecCfg != nil {
+		execCfg = ca.knobs.OverrideExecCfg(execCfg)
+	}
+	tableDescs, err := fetchTableDescriptors(ctx, execCfg, ca.targets, ca.spec.GetSchemaTS())
+	if err != nil {
+		log.Changefeed.Warningf(ctx, "not filtering rangefeed events: %v", err)
+		return nil
+	}
+	return makeRangefeedEventFilter(tableDescs, ca.targets, sc)
+}
+
+// makeRangefeedEventFilter returns a filter that lets the rangefeed servers
+// drop value events that the changefeed would discard anyway: events on
+// column families that are not targeted, and events whose row does not
+// satisfy the simple conjuncts of the changefeed expression's WHERE clause.
+// The rangefeed filter is conservative, so the changefeed still evaluates
+// its targets and expression on every event it receives. Returns nil if
+// nothing can be pushed down.
+func makeRangefeedEventFilter(
+	tableDescs []catalog.TableDescriptor, targets changefeedbase.Targets, sc *tree.SelectClause,
+) *kvpb.RangeFeedEventFilter {
+	var filter kvpb.RangeFeedEventFilter
+	filter.FamilyIDs = targetedFamilyIDs(tableDescs, targets)
+	// Changefeed expressions are restricted to a single target table.
+	if sc != nil && sc.Where != nil && len(tableDescs) == 1 {
+		filter.Predicates = wherePredicates(tableDescs[0], sc.Where.Expr, nil /* preds */)
+	}
+	if len(filter.FamilyIDs) == 0 && len(filter.Predicates) == 0 {
+		return nil
+	}
+	return &filter
+}
+
+// targetedFamilyIDs returns the IDs of the column families watched by the
+// targets, or nil if every family of some table is watched or no target names
+// a column family. The family filter applies to all tables in the feed, so the
+// returned IDs are the union over all targets.
+func targetedFamilyIDs(
+	tableDescs []catalog.TableDescriptor, targets changefeedbase.Targets,
+) []uint32 {
+	byID := make(map[catid.DescID]catalog.TableDescriptor, len(tableDescs))
+	for _, desc := range tableDescs {
+		byID[desc.GetID()] = desc
+	}
+	var familyIDs []uint32
+	var hasColumnFamilyTarget bool
+	seen := make(map[uint32]struct{})
+	if err := targets.EachTarget(func(t changefeedbase.Target) error {
+		desc, ok := byID[t.DescID]
+		if !ok {
+			return errNoFamilyFilter
+		}
+		var id uint32
+		switch t.Type {
+		case jobspb.ChangefeedTargetSpecification_PRIMARY_FAMILY_ONLY:
+			families := desc.GetFamilies()
+			if len(families) == 0 {
+				return errNoFamilyFilter
+			}
+			id = uint32(families[0].ID)
+		case jobspb.ChangefeedTargetSpecification_COLUMN_FAMILY:
+			hasColumnFamilyTarget = true
+			found := false
+			for _, family := range desc.GetFamilies() {
+				if family.Name == t.FamilyName {
+					id, found = uint32(family.ID), true
+					break
+				}
+			}
+			if !found {
+				return errNoFamilyFilter
+			}
+		default:
+			return errNoFamilyFilter
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			familyIDs = append(familyIDs, id)
+		}
+		return nil
+	}); err != nil || !hasColumnFamilyTarget {
+		return nil
+	}
+	return familyIDs
+}
+
+// errNoFamilyFilter stops the iteration in targetedFamilyIDs when the targets
+// can't be expressed as a set of family IDs.
+var errNoFamilyFilter = errors.New("no family filter")
+
+// wherePredicates appends to preds a rangefeed predicate for every top-level
+// conjunct of expr that compares a stored column of desc with a constant.
+// Other conjuncts are skipped, which only makes the filter less selective.
+func wherePredicates(
+	desc catalog.TableDescriptor, expr tree.Expr, preds []kvpb.RangeFeedValuePredicate,
+) []kvpb.RangeFeedValuePredicate {
+	switch t := expr.(type) {
+	case *tree.ParenExpr:
+		return wherePredicates(desc, t.Expr, preds)
+	case *tree.AndExpr:
+		preds = wherePredicates(desc, t.Left, preds)
+		return wherePredicates(desc, t.Right, preds)
+	case *tree.ComparisonExpr:
+		if pred, ok := comparisonPredicate(desc, t); ok {
+			preds = append(preds, pred)
+		}
+	}
+	return preds
+}
+
+// comparisonPredicate converts a comparison between an unqualified column name
+// and a constant into a rangefeed predicate.
+func comparisonPredicate(
+	desc catalog.TableDescriptor, cmp *tree.ComparisonExpr,
+) (kvpb.RangeFeedValuePredicate, bool) {
+	op, ok := predicateOps[cmp.Operator.Symbol]
+	if !ok {
+		return kvpb.RangeFeedValuePredicate{}, false
+	}
+	left, right := tree.StripParens(cmp.Left), tree.StripParens(cmp.Right)
+	if _, ok := left.(*tree.UnresolvedName); !ok {
+		// Put the column on the left, e.g. 5 < a becomes a > 5.
+		left, right = right, left
+		op = commutedPredicateOps[op]
+	}
+	name, ok := left.(*tree.UnresolvedName)
+	// Qualified names may refer to cdc_prev rather than the current row.
+	if !ok || name.Star || name.NumParts != 1 {
+		return kvpb.RangeFeedValuePredicate{}, false
+	}
+	col := catalog.FindColumnByTreeName(desc, tree.Name(name.Parts[0]))
+	if col == nil || !col.Public() || col.IsSystemColumn() || col.IsVirtual() {
+		return kvpb.RangeFeedValuePredicate{}, false
+	}
+	operand, ok := encodePredicateOperand(col.GetType(), right)
+	if !ok {
+		return kvpb.RangeFeedValuePredicate{}, false
+	}
+	return kvpb.RangeFeedValuePredicate{
+		ColumnID: uint32(col.GetID()),
+		Op:       op,
+		Operand:  operand,
+	}, true
+}
+
+var predicateOps = map[treecmp.ComparisonOperatorSymbol]kvpb.RangeFeedValuePredicate_Op{
+	treecmp.EQ: kvpb.RangeFeedValuePredicate_EQ,
+	treecmp.NE: kvpb.RangeFeedValuePredicate_NE,
+	treecmp.LT: kvpb.RangeFeedValuePredicate_LT,
+	treecmp.LE: kvpb.RangeFeedValuePredicate_LE,
+	treecmp.GT: kvpb.RangeFeedValuePredicate_GT,
+	treecmp.GE: kvpb.RangeFeedValuePredicate_GE,
+}
+
+var commutedPredicateOps = map[kvpb.RangeFeedValuePredicate_Op]kvpb.RangeFeedValuePredicate_Op{
+	kvpb.RangeFeedValuePredicate_EQ: kvpb.RangeFeedValuePredicate_EQ,
+	kvpb.RangeFeedValuePredicate_NE: kvpb.RangeFeedValuePredicate_NE,
+	kvpb.RangeFeedValuePredicate_LT: kvpb.RangeFeedValuePredicate_GT,
+	kvpb.RangeFeedValuePredicate_LE: kvpb.RangeFeedValuePredicate_GE,
+	kvpb.RangeFeedValuePredicate_GT: kvpb.RangeFeedValuePredicate_LT,
+	kvpb.RangeFeedValuePredicate_GE: kvpb.RangeFeedValuePredicate_LE,
+}
+
+// encodePredicateOperand returns the value encoding of the constant expr as a
+// datum of type typ, if the rangefeed server compares such datums the same
+// way SQL does.
+func encodePredicateOperand(typ *types.T, expr tree.Expr) ([]byte, bool) {
+	switch c := expr.(type) {
+	case *tree.NumVal:
+		switch typ.Family() {
+		case types.IntFamily:
+			i, err := c.AsInt64()
+			if err != nil {
+				return nil, false
+			}
+			return encoding.EncodeIntValue(nil, encoding.NoColumnID, i), true
+		case types.FloatFamily:
+			// Like SQL, compare against the nearest float64 to the constant.
+			f, _ := constant.Float64Val(constant.ToFloat(c.AsConstantValue()))
+			if math.IsInf(f, 0) {
+				return nil, false
+			}
+			return encoding.EncodeFloatValue(nil, encoding.NoColumnID, f), true
+		}
+	case *tree.StrVal:
+		// Exclude bytes literals, as well as CHAR, whose comparisons ignore
+		// trailing spaces, and collated strings, which aren't compared bytewise.
+		scannedAsBytes := c.AvailableTypes()[0].Family() == types.BytesFamily
+		if !scannedAsBytes && (typ.Oid() == oid.T_text || typ.Oid() == oid.T_varchar) {
+			return encoding.EncodeBytesValue(nil, encoding.NoColumnID, []byte(c.RawString())), true
+		}
+	case *tree.DBool:
+		if typ.Family() == types.BoolFamily {
+			return encoding.EncodeBoolValue(nil, encoding.NoColumnID, bool(*c)), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package changefeedccl
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/cdceval"
+	"github.com/cockroachdb/cockroach/pkg/ccl/changefeedccl/changefeedbase"
+	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeRangefeedEventFilter(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	desc := tabledesc.NewBuilder(&descpb.TableDescriptor{
+		ID:   104,
+		Name: "t",
+		Columns: []descpb.ColumnDescriptor{
+			{ID: 1, Name: "k", Type: types.Int},
+			{ID: 2, Name: "a", Type: types.Int, Nullable: true},
+			{ID: 3, Name: "s", Type: types.String, Nullable: true},
+			{ID: 4, Name: "f", Type: types.Float, Nullable: true},
+			{ID: 5, Name: "b", Type: types.Bool, Nullable: true},
+			{ID: 6, Name: "c", Type: types.BPChar, Nullable: true},
+		},
+		Families: []descpb.ColumnFamilyDescriptor{
+			{ID: 0, Name: "primary", ColumnNames: []string{"k", "a", "s"}, ColumnIDs: []descpb.ColumnID{1, 2, 3}},
+			{ID: 1, Name: "extra", ColumnNames: []string{"f", "b", "c"}, ColumnIDs: []descpb.ColumnID{4, 5, 6}},
+		},
+	}).BuildImmutableTable()
+	tableDescs := []catalog.TableDescriptor{desc}
+
+	makeTargets := func(typ jobspb.ChangefeedTargetSpecification_TargetType, family string) changefeedbase.Targets {
+		var targets changefeedbase.Targets
+		targets.Add(changefeedbase.Target{
+			Type:              typ,
+			DescID:            desc.GetID(),
+			FamilyName:        family,
+			StatementTimeName: "t",
+		})
+		return targets
+	}
+	primaryOnly := makeTargets(jobspb.ChangefeedTargetSpecification_PRIMARY_FAMILY_ONLY, "")
+	pred := func(colID uint32, op kvpb.RangeFeedValuePredicate_Op, operand []byte) kvpb.RangeFeedValuePredicate {
+		return kvpb.RangeFeedValuePredicate{ColumnID: colID, Op: op, Operand: operand}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		targets  changefeedbase.Targets
+		query    string
+		expected *kvpb.RangeFeedEventFilter
+	}{
+		{
+			name:    "primary family",
+			targets: primaryOnly,
+		},
+		{
+			name:    "each family",
+			targets: makeTargets(jobspb.ChangefeedTargetSpecification_EACH_FAMILY, ""),
+		},
+		{
+			name:     "column family",
+			targets:  makeTargets(jobspb.ChangefeedTargetSpecification_COLUMN_FAMILY, "extra"),
+			expected: &kvpb.RangeFeedEventFilter{FamilyIDs: []uint32{1}},
+		},
+		{
+			name:    "unknown column family",
+			targets: makeTargets(jobspb.ChangefeedTargetSpecification_COLUMN_FAMILY, "missing"),
+		},
+		{
+			name:    "no where clause",
+			targets: primaryOnly,
+			query:   "SELECT a FROM t",
+		},
+		{
+			name:    "predicates",
+			targets: primaryOnly,
+			query: `SELECT * FROM t WHERE a > 5 AND 10 >= a AND (s = 'x') AND f < 1.5 AND b = true ` +
+				`AND a < 2.5 AND c = 'y' AND cdc_prev.a = 1 AND (a = 1 OR a = 2) AND a + 1 = 3`,
+			expected: &kvpb.RangeFeedEventFilter{Predicates: []kvpb.RangeFeedValuePredicate{
+				pred(2, kvpb.RangeFeedValuePredicate_GT, encoding.EncodeIntValue(nil, encoding.NoColumnID, 5)),
+				pred(2, kvpb.RangeFeedValuePredicate_LE, encoding.EncodeIntValue(nil, encoding.NoColumnID, 10)),
+				pred(3, kvpb.RangeFeedValuePredicate_EQ, encoding.EncodeBytesValue(nil, encoding.NoColumnID, []byte("x"))),
+				pred(4, kvpb.RangeFeedValuePredicate_LT, encoding.EncodeFloatValue(nil, encoding.NoColumnID, 1.5)),
+				pred(5, kvpb.RangeFeedValuePredicate_EQ, encoding.EncodeBoolValue(nil, encoding.NoColumnID, true)),
+			}},
+		},
+		{
+			name:    "unsupported predicates only",
+			targets: primaryOnly,
+			query:   "SELECT * FROM t WHERE a = 1 OR a = 2",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var sc *tree.SelectClause
+			if tc.query != "" {
+				var err error
+				sc, err = cdceval.ParseChangefeedExpression(tc.query)
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, makeRangefeedEventFilter(tableDescs, tc.targets, sc))
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
 	"github.com/cockroachdb/cockroach/pkg/storage/fs"
 	"github.com/cockroachdb/cockroach/pkg/storage/storageconfig"
@@ -37,7 +38,8 @@ import (
 //   about encryption settings used for the file, including the key id.
 // - The StoreKeyManager uses the base-FS to read the user-specified store keys at startup.
 //   These are in two key files: the active key file and the old key file, which contain the
-//   key id and the key.
+//   key id and the key. Alternatively, the KMSStoreKeyManager generates the store keys and
+//   uses the base-FS to store them, encrypted by a KMS master key.
 // - The store-FS is used only for storing the key file for the generated keys. It is used by
 //   the DataKeyManager. These keys are rotated periodically in a simple manner -- a new
 //   active key is generated for future file writes. Existing files are not affected.
@@ -239,15 +241,13 @@ func (fs *encryptedFS) ReuseForWrite(
 }
 
 type encryptionStatsHandler struct {
-	storeKM *StoreKeyManager
+	storeKM PebbleKeyManager
 	dataKM  *DataKeyManager
 }
 
 func (e *encryptionStatsHandler) GetEncryptionStatus() ([]byte, error) {
 	var s enginepb.EncryptionStatus
-	if e.storeKM.activeKey != nil {
-		s.ActiveStoreKey = e.storeKM.activeKey.Info
-	}
+	s.ActiveStoreKey = e.storeKM.ActiveKeyInfoForStats()
 	ki := e.dataKM.ActiveKeyInfoForStats()
 	s.ActiveDataKey = ki
 	return protoutil.Marshal(&s)
@@ -267,8 +267,8 @@ func (e *encryptionStatsHandler) GetActiveDataKeyID() (string, error) {
 }
 
 func (e *encryptionStatsHandler) GetActiveStoreKeyType() int32 {
-	if e.storeKM.activeKey != nil {
-		return int32(e.storeKM.activeKey.Info.EncryptionType)
+	if ki := e.storeKM.ActiveKeyInfoForStats(); ki != nil {
+		return int32(ki.EncryptionType)
 	}
 	return int32(enginepb.EncryptionType_Plaintext)
 }
@@ -298,17 +298,34 @@ func newEncryptedEnv(
 	readOnly bool,
 	options *storageconfig.EncryptionOptions,
 ) (*fs.EncryptionEnv, error) {
-	if options.KeySource != storageconfig.EncryptionKeyFromFiles {
+	var storeKeyManager PebbleKeyManager
+	switch options.KeySource {
+	case storageconfig.EncryptionKeyFromFiles:
+		skm := &StoreKeyManager{
+			fs:                unencryptedFS,
+			activeKeyFilename: options.KeyFiles.CurrentKey,
+			oldKeyFilename:    options.KeyFiles.OldKey,
+		}
+		if err := skm.Load(context.TODO()); err != nil {
+			return nil, err
+		}
+		storeKeyManager = skm
+	case storageconfig.EncryptionKeyFromKMS:
+		skm := &KMSStoreKeyManager{
+			fs:        unencryptedFS,
+			dbDir:     dbDir,
+			readOnly:  readOnly,
+			kmsURI:    options.KMS.URI,
+			oldKMSURI: options.KMS.OldURI,
+			kmsEnv:    &storeKMSEnv{settings: cluster.MakeClusterSettings()},
+		}
+		if err := skm.Load(context.TODO()); err != nil {
+			return nil, err
+		}
+		storeKeyManager = skm
+	default:
 		return nil, fmt.Errorf("unknown encryption key source: %d", options.KeySource)
 	}
-	storeKeyManager := &StoreKeyManager{
-		fs:                unencryptedFS,
-		activeKeyFilename: options.KeyFiles.CurrentKey,
-		oldKeyFilename:    options.KeyFiles.OldKey,
-	}
-	if err := storeKeyManager.Load(context.TODO()); err != nil {
-		return nil, err
-	}
 	storeFS := &encryptedFS{
 		FS:           unencryptedFS,
 		fileRegistry: fr,
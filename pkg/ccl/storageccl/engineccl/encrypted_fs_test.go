@@ -437,6 +437,74 @@ func TestPebbleEncryption2(t *testing.T) {
 	addKeyAndValidate("d", "d", "plain", "16v2.key")
 }
 
+// TestPebbleEncryptionKMS tests a store whose store key is encrypted by a
+// KMS, across a rotation of the KMS master key.
+func TestPebbleEncryptionKMS(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	const stickyVFSID = `foo`
+	ctx := context.Background()
+	stickyRegistry := fs.NewStickyRegistry()
+
+	// openAndPut opens the store using the given KMS master keys, checks that
+	// all previously written keys are readable, writes the given key, and
+	// returns the active store key info.
+	var written []string
+	openAndPut := func(kmsURI, oldKMSURI string, key string) *enginepb.KeyInfo {
+		settings := cluster.MakeTestingClusterSettings()
+		env, err := fs.InitEnvFromStoreSpec(
+			ctx,
+			base.StoreSpec{
+				InMemory: true,
+				Size:     storageconfig.BytesSize(512 << 20),
+				EncryptionOptions: &storageconfig.EncryptionOptions{
+					KeySource:      storageconfig.EncryptionKeyFromKMS,
+					KMS:            &storageconfig.EncryptionKMS{URI: kmsURI, OldURI: oldKMSURI},
+					RotationPeriod: time.Hour,
+				},
+				StickyVFSID: stickyVFSID,
+			},
+			fs.EnvConfig{
+				RW:      fs.ReadWrite,
+				Version: settings.Version,
+			},
+			stickyRegistry, /* sticky registry */
+			nil,            /* statsCollector */
+		)
+		require.NoError(t, err)
+		db, err := storage.Open(ctx, env, settings)
+		require.NoError(t, err)
+		defer db.Close()
+
+		for _, k := range written {
+			require.Equal(t, []byte(k), storageutils.MVCCGetRaw(t, db, storageutils.PointKey(keys.SystemSQLCodec, k, 0)))
+		}
+		batch := db.NewWriteBatch()
+		defer batch.Close()
+		require.NoError(t, batch.PutUnversioned(roachpb.Key(key), []byte(key)))
+		require.NoError(t, batch.Commit(true))
+		require.NoError(t, db.Flush())
+		written = append(written, key)
+
+		stats, err := db.GetEnvStats()
+		require.NoError(t, err)
+		require.Equal(t, int32(enginepb.EncryptionType_AES_256_CTR_V2), stats.EncryptionType)
+		var s enginepb.EncryptionStatus
+		require.NoError(t, protoutil.Unmarshal(stats.EncryptionStatus, &s))
+		require.Equal(t, kmsStoreKeySource, s.ActiveStoreKey.Source)
+		require.Equal(t, s.ActiveStoreKey.KeyId, s.ActiveDataKey.ParentKeyId)
+		return s.ActiveStoreKey
+	}
+
+	first := openAndPut("fakekms:///a", "", "a")
+	require.Equal(t, first.KeyId, openAndPut("fakekms:///a", "", "b").KeyId)
+	// Rotating the KMS master key rotates the store key, and the data keys
+	// registry is rewritten using the new store key.
+	second := openAndPut("fakekms:///b", "fakekms:///a", "c")
+	require.NotEqual(t, first.KeyId, second.KeyId)
+	require.Equal(t, second.KeyId, openAndPut("fakekms:///b", "", "d").KeyId)
+}
+
 func TestCanRegistryElide(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 
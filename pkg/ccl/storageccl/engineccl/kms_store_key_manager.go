@@ -0,0 +1,296 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package engineccl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/cloud"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
+	"github.com/cockroachdb/cockroach/pkg/storage/fs"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/errors/oserror"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// The filename used for writing the KMS-encrypted store keys by the
+	// KMSStoreKeyManager.
+	kmsStoreKeysFilename = "COCKROACHDB_KMS_STORE_KEYS"
+	// The source recorded in the KeyInfo of the store keys generated by the
+	// KMSStoreKeyManager.
+	kmsStoreKeySource = "kms store key manager"
+	// The length of the store keys generated by the KMSStoreKeyManager.
+	kmsStoreKeyLength = 32
+	// The scheme of KMS URIs referencing an external connection. These are
+	// resolved using SQL, which is not available when opening a store.
+	externalConnectionScheme = "external"
+)
+
+// KMSStoreKeyManager manages store keys that are generated by the store and
+// encrypted by a KMS. Implements PebbleKeyManager.
+//
+// The store keys are only ever written to disk encrypted by the KMS master
+// key, in a file in the store directory. The KMS is only used by Load(), which
+// decrypts the store keys and holds them in memory.
+//
+// Store key rotation is driven through the KMS: when the store is opened with
+// a new KMS master key, the current store key is decrypted using the old KMS
+// master key, a new store key is generated, and both are written encrypted by
+// the new KMS master key. The current store key is retained as the old key so
+// that the data keys registry, which is encrypted using the store key, remains
+// readable until it has been rewritten using the new store key.
+type KMSStoreKeyManager struct {
+	// Initialize the following before calling Load().
+	fs        vfs.FS
+	dbDir     string
+	readOnly  bool
+	kmsURI    string
+	oldKMSURI string
+	kmsEnv    cloud.KMSEnv
+
+	// Implementation. activeKey is not nil after a successful call to Load();
+	// oldKey is nil if the store key has never been rotated.
+	activeKey *enginepb.SecretKey
+	oldKey    *enginepb.SecretKey
+}
+
+var _ PebbleKeyManager = &KMSStoreKeyManager{}
+
+// Load must be called before calling other functions.
+func (m *KMSStoreKeyManager) Load(ctx context.Context) (retErr error) {
+	kms, err := m.openKMS(ctx, m.kmsURI)
+	if err != nil {
+		return err
+	}
+	defer func() { retErr = errors.CombineErrors(retErr, kms.Close()) }()
+	var oldKMS cloud.KMS
+	if m.oldKMSURI != "" {
+		if oldKMS, err = m.openKMS(ctx, m.oldKMSURI); err != nil {
+			return err
+		}
+		defer func() { retErr = errors.CombineErrors(retErr, oldKMS.Close()) }()
+	}
+
+	storeKeys, err := m.readStoreKeys()
+	if err != nil {
+		return err
+	}
+	if storeKeys.ActiveKey != nil {
+		if m.activeKey, err = unwrapStoreKey(ctx, storeKeys.ActiveKey, kms, oldKMS); err != nil {
+			return err
+		}
+	}
+	if storeKeys.OldKey != nil {
+		if m.oldKey, err = unwrapStoreKey(ctx, storeKeys.OldKey, kms, oldKMS); err != nil {
+			return err
+		}
+	}
+
+	if m.activeKey == nil || storeKeys.ActiveKey.MasterKeyId != kms.MasterKeyID() {
+		if m.readOnly {
+			if m.activeKey == nil {
+				return errors.Newf("no KMS-encrypted store keys found in %q", m.dbDir)
+			}
+		} else if err := m.rotateStoreKey(ctx, kms); err != nil {
+			return err
+		}
+	}
+
+	oldKeyInfo := "none"
+	if m.oldKey != nil {
+		oldKeyInfo = proto.CompactTextString(m.oldKey.Info)
+	}
+	log.Dev.Infof(ctx, "loaded active store key: %s, old store key: %s, KMS master key: %s",
+		proto.CompactTextString(m.activeKey.Info), oldKeyInfo, kms.MasterKeyID())
+	return nil
+}
+
+// ActiveKeyForWriter implements PebbleKeyManager.
+func (m *KMSStoreKeyManager) ActiveKeyForWriter(ctx context.Context) (*enginepb.SecretKey, error) {
+	return m.activeKey, nil
+}
+
+// ActiveKeyInfoForStats implements PebbleKeyManager.
+func (m *KMSStoreKeyManager) ActiveKeyInfoForStats() *enginepb.KeyInfo {
+	if m.activeKey != nil {
+		return m.activeKey.Info
+	}
+	return nil
+}
+
+// GetKey implements PebbleKeyManager.GetKey.
+func (m *KMSStoreKeyManager) GetKey(id string) (*enginepb.SecretKey, error) {
+	if m.activeKey.Info.KeyId == id {
+		return m.activeKey, nil
+	}
+	if m.oldKey != nil && m.oldKey.Info.KeyId == id {
+		return m.oldKey, nil
+	}
+	return nil, fmt.Errorf("store key ID %s was not found", id)
+}
+
+// openKMS returns the KMS referenced by the given URI.
+func (m *KMSStoreKeyManager) openKMS(ctx context.Context, uri string) (cloud.KMS, error) {
+	kmsURL, err := url.ParseRequestURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	if kmsURL.Scheme == externalConnectionScheme {
+		return nil, errors.Newf("external connections cannot be used to encrypt store keys")
+	}
+	return cloud.KMSFromURI(ctx, uri, m.kmsEnv)
+}
+
+// readStoreKeys reads the KMS-encrypted store keys. It returns an empty
+// KMSStoreKeys if the store has never had a store key.
+func (m *KMSStoreKeyManager) readStoreKeys() (*enginepb.KMSStoreKeys, error) {
+	storeKeys := &enginepb.KMSStoreKeys{}
+	f, err := m.fs.Open(m.fs.PathJoin(m.dbDir, kmsStoreKeysFilename))
+	if err != nil {
+		if oserror.IsNotExist(err) {
+			return storeKeys, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	if err := protoutil.Unmarshal(b, storeKeys); err != nil {
+		return nil, err
+	}
+	return storeKeys, nil
+}
+
+// rotateStoreKey generates a new active store key, retaining the current active
+// store key as the old key, and writes both encrypted by the given KMS.
+func (m *KMSStoreKeyManager) rotateStoreKey(ctx context.Context, kms cloud.KMS) error {
+	newKey, err := generateStoreKey()
+	if err != nil {
+		return err
+	}
+	storeKeys := &enginepb.KMSStoreKeys{}
+	if storeKeys.ActiveKey, err = wrapStoreKey(ctx, newKey, kms); err != nil {
+		return err
+	}
+	if m.activeKey != nil {
+		if storeKeys.OldKey, err = wrapStoreKey(ctx, m.activeKey, kms); err != nil {
+			return err
+		}
+	}
+	b, err := protoutil.Marshal(storeKeys)
+	if err != nil {
+		return err
+	}
+	filename := m.fs.PathJoin(m.dbDir, kmsStoreKeysFilename)
+	if err := fs.SafeWriteToUnencryptedFile(
+		m.fs, m.dbDir, filename, b, fs.EncryptionRegistryWriteCategory); err != nil {
+		return err
+	}
+	log.Dev.Infof(ctx, "rotated to new active store key: %s",
+		proto.CompactTextString(newKey.Info))
+	m.activeKey, m.oldKey = newKey, m.activeKey
+	return nil
+}
+
+// generateStoreKey generates a new random store key.
+func generateStoreKey() (*enginepb.SecretKey, error) {
+	key := &enginepb.SecretKey{}
+	key.Info = &enginepb.KeyInfo{}
+	key.Info.EncryptionType = enginepb.EncryptionType_AES_256_CTR_V2
+	key.Info.CreationTime = kmTimeNow().Unix()
+	key.Info.Source = kmsStoreKeySource
+	key.Key = make([]byte, kmsStoreKeyLength)
+	if _, err := rand.Read(key.Key); err != nil {
+		return nil, err
+	}
+	keyID := make([]byte, keyIDLength)
+	if _, err := rand.Read(keyID); err != nil {
+		return nil, err
+	}
+	// Hex encoding to make it human readable.
+	key.Info.KeyId = hex.EncodeToString(keyID)
+	return key, nil
+}
+
+// wrapStoreKey encrypts the given store key using the KMS.
+func wrapStoreKey(
+	ctx context.Context, key *enginepb.SecretKey, kms cloud.KMS,
+) (*enginepb.WrappedSecretKey, error) {
+	encryptedKey, err := kms.Encrypt(ctx, key.Key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "encrypting store key %s", key.Info.KeyId)
+	}
+	return &enginepb.WrappedSecretKey{
+		Info:         key.Info,
+		MasterKeyId:  kms.MasterKeyID(),
+		EncryptedKey: encryptedKey,
+	}, nil
+}
+
+// unwrapStoreKey decrypts the given store key using whichever of kms and oldKMS
+// holds the master key it was encrypted by. oldKMS may be nil.
+func unwrapStoreKey(
+	ctx context.Context, wrapped *enginepb.WrappedSecretKey, kms, oldKMS cloud.KMS,
+) (*enginepb.SecretKey, error) {
+	var decrypter cloud.KMS
+	if wrapped.MasterKeyId == kms.MasterKeyID() {
+		decrypter = kms
+	} else if oldKMS != nil && wrapped.MasterKeyId == oldKMS.MasterKeyID() {
+		decrypter = oldKMS
+	} else {
+		return nil, errors.Newf("store key %s is encrypted by KMS master key %s, which matches "+
+			"neither the kms nor the old-kms master key", wrapped.Info.KeyId, wrapped.MasterKeyId)
+	}
+	key, err := decrypter.Decrypt(ctx, wrapped.EncryptedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypting store key %s", wrapped.Info.KeyId)
+	}
+	return &enginepb.SecretKey{Info: wrapped.Info, Key: key}, nil
+}
+
+// storeKMSEnv is the cloud.KMSEnv used for store keys. Stores are opened before
+// the node has joined the cluster, so it uses the default cluster settings and
+// does not provide a SQL connection.
+type storeKMSEnv struct {
+	settings *cluster.Settings
+}
+
+var _ cloud.KMSEnv = &storeKMSEnv{}
+
+// ClusterSettings implements cloud.KMSEnv.
+func (e *storeKMSEnv) ClusterSettings() *cluster.Settings {
+	return e.settings
+}
+
+// KMSConfig implements cloud.KMSEnv.
+func (e *storeKMSEnv) KMSConfig() *base.ExternalIODirConfig {
+	return &base.ExternalIODirConfig{}
+}
+
+// DBHandle implements cloud.KMSEnv.
+func (e *storeKMSEnv) DBHandle() isql.DB {
+	return nil
+}
+
+// User implements cloud.KMSEnv.
+func (e *storeKMSEnv) User() username.SQLUsername {
+	return username.NodeUserName()
+}
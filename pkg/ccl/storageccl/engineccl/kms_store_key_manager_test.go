@@ -0,0 +1,197 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package engineccl
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/cloud"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
+	"github.com/cockroachdb/cockroach/pkg/storage/fs"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble/vfs"
+	"github.com/stretchr/testify/require"
+)
+
+const fakeKMSScheme = "fakekms"
+
+func init() {
+	cloud.RegisterKMSFromURIFactory(makeFakeKMS, fakeKMSScheme)
+}
+
+// fakeKMS is a cloud.KMS used in tests. Its master key ID is the path of its
+// URI, and it encrypts data using AES-GCM with a key derived from the master
+// key ID.
+type fakeKMS struct {
+	masterKeyID string
+}
+
+var _ cloud.KMS = &fakeKMS{}
+
+func makeFakeKMS(_ context.Context, uri string, _ cloud.KMSEnv) (cloud.KMS, error) {
+	kmsURL, err := url.ParseRequestURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeKMS{masterKeyID: strings.TrimPrefix(kmsURL.Path, "/")}, nil
+}
+
+// MasterKeyID implements cloud.KMS.
+func (k *fakeKMS) MasterKeyID() string {
+	return k.masterKeyID
+}
+
+func (k *fakeKMS) aead() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(k.masterKeyID))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt implements cloud.KMS.
+func (k *fakeKMS) Encrypt(_ context.Context, data []byte) ([]byte, error) {
+	aead, err := k.aead()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// Decrypt implements cloud.KMS.
+func (k *fakeKMS) Decrypt(_ context.Context, data []byte) ([]byte, error) {
+	aead, err := k.aead()
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	return aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+}
+
+// Close implements cloud.KMS.
+func (k *fakeKMS) Close() error {
+	return nil
+}
+
+func TestKMSStoreKeyManager(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	ctx := context.Background()
+	memFS := vfs.NewMem()
+	kmTimeNow = func() time.Time { return timeutil.Unix(5, 0) }
+	env := &storeKMSEnv{settings: cluster.MakeTestingClusterSettings()}
+
+	makeKM := func(kmsURI, oldKMSURI string, readOnly bool) *KMSStoreKeyManager {
+		return &KMSStoreKeyManager{
+			fs:        memFS,
+			readOnly:  readOnly,
+			kmsURI:    kmsURI,
+			oldKMSURI: oldKMSURI,
+			kmsEnv:    env,
+		}
+	}
+	readStoreKeys := func() *enginepb.KMSStoreKeys {
+		storeKeys, err := makeKM("", "", true /* readOnly */).readStoreKeys()
+		require.NoError(t, err)
+		return storeKeys
+	}
+	// requireNotInFile checks that the raw keys are never written to disk.
+	requireNotInFile := func(keys ...*enginepb.SecretKey) {
+		b, err := fs.ReadFile(memFS, kmsStoreKeysFilename)
+		require.NoError(t, err)
+		for _, key := range keys {
+			require.False(t, bytes.Contains(b, key.Key))
+		}
+	}
+
+	// A read-only store cannot generate its store key.
+	km := makeKM("fakekms:///a", "", true /* readOnly */)
+	require.Regexp(t, "no KMS-encrypted store keys found", km.Load(ctx))
+
+	// The first load generates the store key.
+	km = makeKM("fakekms:///a", "", false /* readOnly */)
+	require.NoError(t, km.Load(ctx))
+	key1, err := km.ActiveKeyForWriter(ctx)
+	require.NoError(t, err)
+	require.Equal(t, enginepb.EncryptionType_AES_256_CTR_V2, key1.Info.EncryptionType)
+	require.Equal(t, kmsStoreKeySource, key1.Info.Source)
+	require.Equal(t, int64(5), key1.Info.CreationTime)
+	require.Len(t, key1.Key, kmsStoreKeyLength)
+	require.Len(t, key1.Info.KeyId, 2*keyIDLength)
+	require.Nil(t, km.oldKey)
+	key, err := km.GetKey(key1.Info.KeyId)
+	require.NoError(t, err)
+	require.Equal(t, key1.String(), key.String())
+	_, err = km.GetKey("x")
+	require.Error(t, err)
+	storeKeys := readStoreKeys()
+	require.Equal(t, "a", storeKeys.ActiveKey.MasterKeyId)
+	require.Nil(t, storeKeys.OldKey)
+	requireNotInFile(key1)
+
+	// Loading again with the same master key decrypts the same store key.
+	km = makeKM("fakekms:///a", "", false /* readOnly */)
+	require.NoError(t, km.Load(ctx))
+	require.Equal(t, key1.String(), km.activeKey.String())
+	require.Nil(t, km.oldKey)
+
+	// Rotating to a new master key requires the old one.
+	km = makeKM("fakekms:///b", "", false /* readOnly */)
+	require.Regexp(t, "matches neither the kms nor the old-kms master key", km.Load(ctx))
+
+	// A read-only store can be opened during a rotation, but does not rotate.
+	km = makeKM("fakekms:///b", "fakekms:///a", true /* readOnly */)
+	require.NoError(t, km.Load(ctx))
+	require.Equal(t, key1.String(), km.activeKey.String())
+	require.Equal(t, "a", readStoreKeys().ActiveKey.MasterKeyId)
+
+	// Rotating to a new master key generates a new store key and retains the
+	// previous one, both encrypted by the new master key.
+	km = makeKM("fakekms:///b", "fakekms:///a", false /* readOnly */)
+	require.NoError(t, km.Load(ctx))
+	key2 := km.activeKey
+	require.NotEqual(t, key1.Info.KeyId, key2.Info.KeyId)
+	require.Equal(t, key1.String(), km.oldKey.String())
+	key, err = km.GetKey(key1.Info.KeyId)
+	require.NoError(t, err)
+	require.Equal(t, key1.String(), key.String())
+	key, err = km.GetKey(key2.Info.KeyId)
+	require.NoError(t, err)
+	require.Equal(t, key2.String(), key.String())
+	storeKeys = readStoreKeys()
+	require.Equal(t, "b", storeKeys.ActiveKey.MasterKeyId)
+	require.Equal(t, "b", storeKeys.OldKey.MasterKeyId)
+	requireNotInFile(key1, key2)
+
+	// Once rotated, the old master key is no longer needed.
+	km = makeKM("fakekms:///b", "", false /* readOnly */)
+	require.NoError(t, km.Load(ctx))
+	require.Equal(t, key2.String(), km.activeKey.String())
+	require.Equal(t, key1.String(), km.oldKey.String())
+
+	// External connections cannot be resolved when opening a store.
+	km = makeKM("external://conn", "", false /* readOnly */)
+	require.Regexp(t, "external connections cannot be used", km.Load(ctx))
+}
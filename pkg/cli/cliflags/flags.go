@@ -1126,7 +1126,8 @@ See the storage.wal_failover.unhealthy_op_threshold cluster setting.
 		Name: "experimental-secondary-cache",
 		Description: `
 Enables the use of a secondary cache to store objects from shared storage (see
---experimental-shared-storage) inside local paths for each store. A size must
+--experimental-shared-storage) and from cold storage (see the cold_storage_after
+zone configuration) inside local paths for each store. A size must
 be specified with this flag, which will be the maximum size for the secondary
 cache on each store on this node:
 <PRE>
@@ -2064,18 +2065,29 @@ A valid enterprise license is required to use this functionality.
 
 Key files should be generated by "cockroach gen encryption-key".
 
+Alternatively, the store key can be generated by the store and encrypted by a
+KMS (aws-kms, gcp-kms or azure-kms URIs), in which case it is only ever written
+to disk encrypted by the KMS master key. Changing the KMS master key rotates
+the store key.
+
 Valid fields:
 
 * path    (required): must match the path of one of the stores, or the special
                       value "*" to match all stores
-* key     (required): path to the current key file, or "plain"
-* old-key (required): path to the previous key file, or "plain"
+* key               : path to the current key file, or "plain"
+* old-key           : path to the previous key file, or "plain"
+* kms               : URI of the KMS master key encrypting the store key
+* old-kms           : URI of the previous KMS master key, when rotating to a new
+                      master key
 * rotation-period   : amount of time after which data keys should be rotated
 
+Either key and old-key, or kms must be specified.
+
 </PRE>
 example:
 <PRE>
-  --enterprise-encryption=path=cockroach-data,key=/keys/aes-128.key,old-key=plain</PRE>
+  --enterprise-encryption=path=cockroach-data,key=/keys/aes-128.key,old-key=plain
+  --enterprise-encryption=path=cockroach-data,kms=aws-kms:///alias/store-key?AUTH=implicit&REGION=us-east-1</PRE>
 `,
 	}
 )
@@ -1835,6 +1835,35 @@ func TestParseEncryptionSpec(t *testing.T) {
 				},
 			},
 		},
+
+		// KMS-encrypted store keys.
+		{value: "path=/data,kms=", expectedErr: "no value specified for kms"},
+		{value: "path=/data,old-kms=testkms:///old", expectedErr: "no kms specified"},
+		{value: "path=/data,key=/new.key,old-key=/old.key,kms=testkms:///new", expectedErr: "key files and kms cannot both be specified"},
+		{
+			value: "path=/data,kms=testkms:///new",
+			expected: storeEncryptionSpec{
+				Path: "/data",
+				Options: storageconfig.EncryptionOptions{
+					KeySource:      storageconfig.EncryptionKeyFromKMS,
+					KMS:            &storageconfig.EncryptionKMS{URI: "testkms:///new"},
+					RotationPeriod: storageconfig.DefaultRotationPeriod,
+				},
+			},
+		},
+		{
+			value: "path=/data,kms=testkms:///new?AUTH=implicit&REGION=us-east-1,old-kms=testkms:///old,rotation-period=1h",
+			expected: storeEncryptionSpec{
+				Path: "/data",
+				Options: storageconfig.EncryptionOptions{
+					KeySource: storageconfig.EncryptionKeyFromKMS,
+					KMS: &storageconfig.EncryptionKMS{
+						URI: "testkms:///new?AUTH=implicit&REGION=us-east-1", OldURI: "testkms:///old",
+					},
+					RotationPeriod: time.Hour,
+				},
+			},
+		},
 	}
 
 	for i, testCase := range testCases {
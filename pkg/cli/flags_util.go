@@ -519,6 +519,15 @@ type storeEncryptionSpec struct {
 
 // String returns a fully parsable version of the encryption spec.
 func (es storeEncryptionSpec) String() string {
+	if es.Options.KMS != nil {
+		var oldKMS string
+		if es.Options.KMS.OldURI != "" {
+			oldKMS = ",old-kms=" + es.Options.KMS.OldURI
+		}
+		return fmt.Sprintf("path=%s,kms=%s%s,rotation-period=%s",
+			es.Path, es.Options.KMS.URI, oldKMS, es.Options.RotationPeriod,
+		)
+	}
 	// All fields are set.
 	return fmt.Sprintf("path=%s,key=%s,old-key=%s,rotation-period=%s",
 		es.Path, es.Options.KeyFiles.CurrentKey, es.Options.KeyFiles.OldKey, es.Options.RotationPeriod,
@@ -576,6 +585,16 @@ func parseStoreEncryptionSpec(value string) (storeEncryptionSpec, error) {
 			es.Options.KeyFiles.CurrentKey = value
 		case "old-key":
 			es.Options.KeyFiles.OldKey = value
+		case "kms":
+			if es.Options.KMS == nil {
+				es.Options.KMS = &storageconfig.EncryptionKMS{}
+			}
+			es.Options.KMS.URI = value
+		case "old-kms":
+			if es.Options.KMS == nil {
+				es.Options.KMS = &storageconfig.EncryptionKMS{}
+			}
+			es.Options.KMS.OldURI = value
 		case "rotation-period":
 			dur, err := time.ParseDuration(value)
 			if err != nil {
@@ -591,6 +610,10 @@ func parseStoreEncryptionSpec(value string) (storeEncryptionSpec, error) {
 	if es.Path == "" {
 		return storeEncryptionSpec{}, fmt.Errorf("no path specified")
 	}
+	if es.Options.KMS != nil && *es.Options.KeyFiles == (storageconfig.EncryptionKeyFiles{}) {
+		// The store key is encrypted by a KMS rather than read from key files.
+		es.Options.KeyFiles = nil
+	}
 	if err := es.Options.Validate(); err != nil {
 		return storeEncryptionSpec{}, err
 	}
@@ -241,6 +241,24 @@ const (
 	// meta1 and meta2.
 	V26_1_InstallMeta2StaticSplitPoint
 
+	// V26_1_AddSystemNotificationsTable adds the system.notifications table,
+	// which is used to deliver LISTEN/NOTIFY notifications across the cluster.
+	V26_1_AddSystemNotificationsTable
+
+	// V26_1_ForeignTables enables the creation of foreign tables, which read
+	// their rows from files in external storage.
+	V26_1_ForeignTables
+
+	// V26_1_AddSystemPublicationsTables adds the system.publications and
+	// system.replication_slots tables, which store the state of logical
+	// replication over pgwire.
+	V26_1_AddSystemPublicationsTables
+
+	// V26_1_TableStatisticsDependencyDegrees adds the dependencyDegrees column
+	// to system.table_statistics, which stores the degrees of functional
+	// dependency between the columns of multi-column statistics.
+	V26_1_TableStatisticsDependencyDegrees
+
 	// *************************************************
 	// Step (1) Add new versions above this comment.
 	// Do not add new versions to a patch release.
@@ -311,6 +329,14 @@ var versionTable = [numKeys]roachpb.Version{
 
 	V26_1_InstallMeta2StaticSplitPoint: {Major: 25, Minor: 4, Internal: 4},
 
+	V26_1_AddSystemNotificationsTable: {Major: 25, Minor: 4, Internal: 6},
+
+	V26_1_ForeignTables: {Major: 25, Minor: 4, Internal: 8},
+
+	V26_1_AddSystemPublicationsTables: {Major: 25, Minor: 4, Internal: 10},
+
+	V26_1_TableStatisticsDependencyDegrees: {Major: 25, Minor: 4, Internal: 12},
+
 	// *************************************************
 	// Step (2): Add new versions above this comment.
 	// Do not add new versions to a patch release.
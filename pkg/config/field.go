@@ -30,6 +30,8 @@ const (
 	Constraints            // constraints
 	VoterConstraints       // voter_constraints
 	LeasePreferences       // lease_preferences
+	NumWitnesses           // num_witnesses
+	ColdStorageAfter       // cold_storage_after
 
 	// NumFields is the number of fields in the config.
 	NumFields int = iota - 1
@@ -10,6 +10,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"slices"
 	"strconv"
 	"strings"
@@ -420,6 +421,15 @@ func (z *ZoneConfig) Validate() error {
 		}
 	}
 
+	if z.NumWitnesses != nil && *z.NumWitnesses < 0 {
+		return fmt.Errorf("num_witnesses cannot be negative")
+	}
+
+	if z.ColdStorageAfterSeconds != nil && *z.ColdStorageAfterSeconds < 0 {
+		return fmt.Errorf("cold_storage_after must be a non-negative interval of at most %d seconds",
+			int32(math.MaxInt32))
+	}
+
 	if z.RangeMaxBytes != nil && *z.RangeMaxBytes < minRangeMaxBytes {
 		return fmt.Errorf("RangeMaxBytes %d less than minimum allowed %d",
 			*z.RangeMaxBytes, minRangeMaxBytes)
@@ -592,6 +602,11 @@ func (z *ZoneConfig) InheritFromParent(parent *ZoneConfig) {
 			z.NumVoters = proto.Int32(*parent.NumVoters)
 		}
 	}
+	if z.NumWitnesses == nil {
+		if parent.NumWitnesses != nil {
+			z.NumWitnesses = proto.Int32(*parent.NumWitnesses)
+		}
+	}
 	if z.GlobalReads == nil {
 		if parent.GlobalReads != nil {
 			z.GlobalReads = proto.Bool(*parent.GlobalReads)
@@ -612,6 +627,11 @@ func (z *ZoneConfig) InheritFromParent(parent *ZoneConfig) {
 		tempGC := *parent.GC
 		z.GC = &tempGC
 	}
+	if z.ColdStorageAfterSeconds == nil {
+		if parent.ColdStorageAfterSeconds != nil {
+			z.ColdStorageAfterSeconds = proto.Int32(*parent.ColdStorageAfterSeconds)
+		}
+	}
 	if z.ShouldInheritConstraints(parent) {
 		z.Constraints = parent.Constraints
 		z.InheritedConstraints = false
@@ -641,6 +661,11 @@ func (z *ZoneConfig) CopyFromZone(other ZoneConfig, fieldList []tree.Name) {
 			if other.NumVoters != nil {
 				z.NumVoters = proto.Int32(*other.NumVoters)
 			}
+		case "num_witnesses":
+			z.NumWitnesses = nil
+			if other.NumWitnesses != nil {
+				z.NumWitnesses = proto.Int32(*other.NumWitnesses)
+			}
 		case "range_min_bytes":
 			z.RangeMinBytes = nil
 			if other.RangeMinBytes != nil {
@@ -662,6 +687,11 @@ func (z *ZoneConfig) CopyFromZone(other ZoneConfig, fieldList []tree.Name) {
 				tempGC := *other.GC
 				z.GC = &tempGC
 			}
+		case "cold_storage_after":
+			z.ColdStorageAfterSeconds = nil
+			if other.ColdStorageAfterSeconds != nil {
+				z.ColdStorageAfterSeconds = proto.Int32(*other.ColdStorageAfterSeconds)
+			}
 		case "constraints":
 			z.Constraints = other.Constraints
 			z.InheritedConstraints = other.InheritedConstraints
@@ -756,6 +786,18 @@ func (z *ZoneConfig) DiffWithZone(
 					Actual:   int32ToString(z.NumVoters),
 				}, nil
 			}
+		case "num_witnesses":
+			if other.NumWitnesses == nil && z.NumWitnesses == nil {
+				continue
+			}
+			if z.NumWitnesses == nil || other.NumWitnesses == nil ||
+				*z.NumWitnesses != *other.NumWitnesses {
+				return false, DiffWithZoneMismatch{
+					Field:    "num_witnesses",
+					Expected: int32ToString(other.NumWitnesses),
+					Actual:   int32ToString(z.NumWitnesses),
+				}, nil
+			}
 		case "range_min_bytes":
 			if other.RangeMinBytes == nil && z.RangeMinBytes == nil {
 				continue
@@ -803,6 +845,18 @@ func (z *ZoneConfig) DiffWithZone(
 					Actual:   int32ToString(&z.GC.TTLSeconds),
 				}, nil
 			}
+		case "cold_storage_after":
+			if other.ColdStorageAfterSeconds == nil && z.ColdStorageAfterSeconds == nil {
+				continue
+			}
+			if z.ColdStorageAfterSeconds == nil || other.ColdStorageAfterSeconds == nil ||
+				*z.ColdStorageAfterSeconds != *other.ColdStorageAfterSeconds {
+				return false, DiffWithZoneMismatch{
+					Field:    "cold_storage_after",
+					Expected: int32ToString(other.ColdStorageAfterSeconds),
+					Actual:   int32ToString(z.ColdStorageAfterSeconds),
+				}, nil
+			}
 		case "constraints":
 			if other.Constraints == nil && z.Constraints == nil {
 				continue
@@ -1337,6 +1391,9 @@ func (z *ZoneConfig) toSpanConfig() (roachpb.SpanConfig, error) {
 	sc.RangeMinBytes = *z.RangeMinBytes
 	sc.RangeMaxBytes = *z.RangeMaxBytes
 	sc.GCPolicy.TTLSeconds = z.GC.TTLSeconds
+	if z.ColdStorageAfterSeconds != nil {
+		sc.ColdStorageAfterSeconds = *z.ColdStorageAfterSeconds
+	}
 
 	// GlobalReads is false by default.
 	if z.GlobalReads != nil {
@@ -1346,6 +1403,9 @@ func (z *ZoneConfig) toSpanConfig() (roachpb.SpanConfig, error) {
 	if z.NumVoters != nil {
 		sc.NumVoters = *z.NumVoters
 	}
+	if z.NumWitnesses != nil {
+		sc.NumWitnesses = *z.NumWitnesses
+	}
 
 	toSpanConfigConstraints := func(src []Constraint) ([]roachpb.Constraint, error) {
 		spanConfigConstraints := make([]roachpb.Constraint, len(src))
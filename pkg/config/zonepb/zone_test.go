@@ -87,6 +87,22 @@ func TestZoneConfigValidate(t *testing.T) {
 			},
 			"",
 		},
+		{
+			ZoneConfig{
+				NumReplicas:             proto.Int32(1),
+				RangeMaxBytes:           DefaultZoneConfig().RangeMaxBytes,
+				ColdStorageAfterSeconds: proto.Int32(-1),
+			},
+			"cold_storage_after must be a non-negative interval",
+		},
+		{
+			ZoneConfig{
+				NumReplicas:             proto.Int32(1),
+				RangeMaxBytes:           DefaultZoneConfig().RangeMaxBytes,
+				ColdStorageAfterSeconds: proto.Int32(30 * 24 * 60 * 60),
+			},
+			"",
+		},
 		{
 			ZoneConfig{
 				NumReplicas:   proto.Int32(1),
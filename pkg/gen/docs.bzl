@@ -35,6 +35,7 @@ DOCS_SRCS = [
     "//docs/generated/sql/bnf:alter_database_to_schema_stmt.bnf",
     "//docs/generated/sql/bnf:alter_ddl_stmt.bnf",
     "//docs/generated/sql/bnf:alter_default_privileges_stmt.bnf",
+    "//docs/generated/sql/bnf:alter_domain_stmt.bnf",
     "//docs/generated/sql/bnf:alter_external_connection.bnf",
     "//docs/generated/sql/bnf:alter_func_dep_extension_stmt.bnf",
     "//docs/generated/sql/bnf:alter_func_options_stmt.bnf",
@@ -115,6 +116,7 @@ DOCS_SRCS = [
     "//docs/generated/sql/bnf:create_changefeed_stmt.bnf",
     "//docs/generated/sql/bnf:create_database_stmt.bnf",
     "//docs/generated/sql/bnf:create_ddl_stmt.bnf",
+    "//docs/generated/sql/bnf:create_domain_stmt.bnf",
     "//docs/generated/sql/bnf:create_extension_stmt.bnf",
     "//docs/generated/sql/bnf:create_external_connection.bnf",
     "//docs/generated/sql/bnf:create_func.bnf",
@@ -148,6 +150,7 @@ DOCS_SRCS = [
     "//docs/generated/sql/bnf:drop_constraint.bnf",
     "//docs/generated/sql/bnf:drop_database.bnf",
     "//docs/generated/sql/bnf:drop_ddl_stmt.bnf",
+    "//docs/generated/sql/bnf:drop_domain_stmt.bnf",
     "//docs/generated/sql/bnf:drop_external_connection_stmt.bnf",
     "//docs/generated/sql/bnf:drop_func_stmt.bnf",
     "//docs/generated/sql/bnf:drop_index.bnf",
@@ -194,9 +197,13 @@ DOCS_SRCS = [
     "//docs/generated/sql/bnf:legacy_transaction_stmt.bnf",
     "//docs/generated/sql/bnf:like_table_option_list.bnf",
     "//docs/generated/sql/bnf:limit_clause.bnf",
+    "//docs/generated/sql/bnf:listen_stmt.bnf",
+    "//docs/generated/sql/bnf:lock_table_stmt.bnf",
+    "//docs/generated/sql/bnf:merge_stmt.bnf",
     "//docs/generated/sql/bnf:move_cursor_stmt.bnf",
     "//docs/generated/sql/bnf:nonpreparable_set_stmt.bnf",
     "//docs/generated/sql/bnf:not_null_column_level.bnf",
+    "//docs/generated/sql/bnf:notify_stmt.bnf",
     "//docs/generated/sql/bnf:offset_clause.bnf",
     "//docs/generated/sql/bnf:on_conflict.bnf",
     "//docs/generated/sql/bnf:opt_frame_clause.bnf",
@@ -65,6 +65,7 @@ type rangeFeedConfig struct {
 	overSystemTable       bool
 	withDiff              bool
 	withFiltering         bool
+	eventFilter           *kvpb.RangeFeedEventFilter
 	withMetadata          bool
 	withMatchingOriginIDs []uint32
 	rangeObserver         RangeObserver
@@ -119,6 +120,15 @@ func WithFiltering() RangeFeedOption {
 	})
 }
 
+// WithEventFilter asks the rangefeed servers to drop value events that do not
+// match the given filter. Filtering is best-effort, so the consumer must still
+// be prepared to receive (and filter out) events that don't match.
+func WithEventFilter(filter *kvpb.RangeFeedEventFilter) RangeFeedOption {
+	return optionFunc(func(c *rangeFeedConfig) {
+		c.eventFilter = filter
+	})
+}
+
 func WithBulkDelivery() RangeFeedOption {
 	return optionFunc(func(c *rangeFeedConfig) {
 		c.bulkDelivery = true
@@ -639,6 +649,7 @@ func makeRangeFeedRequest(
 	startAfter hlc.Timestamp,
 	withDiff bool,
 	withFiltering bool,
+	eventFilter *kvpb.RangeFeedEventFilter,
 	withMatchingOriginIDs []uint32,
 	consumerID int64,
 	withBulkDelivery bool,
@@ -656,6 +667,7 @@ func makeRangeFeedRequest(
 		ConsumerID:            consumerID,
 		WithDiff:              withDiff,
 		WithFiltering:         withFiltering,
+		Filter:                eventFilter,
 		WithMatchingOriginIDs: withMatchingOriginIDs,
 		WithBulkDelivery:      withBulkDelivery,
 		AdmissionHeader: kvpb.AdmissionHeader{
@@ -38,6 +38,7 @@ type config struct {
 
 	withDiff              bool
 	withFiltering         bool
+	eventFilter           *kvpb.RangeFeedEventFilter
 	withMatchingOriginIDs []uint32
 	consumerID            int64
 	onUnrecoverableError  OnUnrecoverableError
@@ -154,6 +155,16 @@ func WithFiltering(withFiltering bool) Option {
 	})
 }
 
+// WithEventFilter makes an option to ask the rangefeed servers to drop value
+// events that do not match the given filter. Filtering is best-effort, so
+// handlers may still observe events that don't match. The filter does not
+// apply to the initial scan.
+func WithEventFilter(filter *kvpb.RangeFeedEventFilter) Option {
+	return optionFunc(func(c *config) {
+		c.eventFilter = filter
+	})
+}
+
 func WithOriginIDsMatching(originIDs ...uint32) Option {
 	return optionFunc(func(c *config) {
 		c.withMatchingOriginIDs = originIDs
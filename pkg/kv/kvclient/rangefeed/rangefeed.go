@@ -347,6 +347,9 @@ func (f *RangeFeed) run(ctx context.Context, frontier span.Frontier, resumeWithF
 	if f.withFiltering {
 		rangefeedOpts = append(rangefeedOpts, kvcoord.WithFiltering())
 	}
+	if f.eventFilter != nil {
+		rangefeedOpts = append(rangefeedOpts, kvcoord.WithEventFilter(f.eventFilter))
+	}
 	if len(f.withMatchingOriginIDs) != 0 {
 		rangefeedOpts = append(rangefeedOpts, kvcoord.WithMatchingOriginIDs(f.withMatchingOriginIDs...))
 	}
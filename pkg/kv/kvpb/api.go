@@ -2267,6 +2267,18 @@ func (rc ReplicationChanges) NonVoterRemovals() []roachpb.ReplicationTarget {
 	return rc.byType(roachpb.REMOVE_NON_VOTER)
 }
 
+// WitnessAdditions returns a slice of all contained replication changes that
+// add witnesses.
+func (rc ReplicationChanges) WitnessAdditions() []roachpb.ReplicationTarget {
+	return rc.byType(roachpb.ADD_WITNESS)
+}
+
+// WitnessRemovals returns a slice of all contained replication changes that
+// remove witnesses.
+func (rc ReplicationChanges) WitnessRemovals() []roachpb.ReplicationTarget {
+	return rc.byType(roachpb.REMOVE_WITNESS)
+}
+
 // Changes returns the changes requested by this AdminChangeReplicasRequest, taking
 // the deprecated method of doing so into account.
 func (acrr *AdminChangeReplicasRequest) Changes() []ReplicationChange {
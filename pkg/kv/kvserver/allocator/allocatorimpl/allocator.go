@@ -141,6 +141,8 @@ const (
 	AllocatorConsiderRebalance
 	AllocatorRangeUnavailable
 	AllocatorFinalizeAtomicReplicationChange
+	AllocatorAddWitness
+	AllocatorRemoveWitness
 	AllocatorMaxPriority
 )
 
@@ -204,7 +206,9 @@ func (a AllocatorAction) ReplicaStatus() ReplicaStatus {
 	if a == AllocatorRemoveVoter ||
 		a == AllocatorRemoveNonVoter ||
 		a == AllocatorAddVoter ||
-		a == AllocatorAddNonVoter {
+		a == AllocatorAddNonVoter ||
+		a == AllocatorAddWitness ||
+		a == AllocatorRemoveWitness {
 		s = Alive
 	} else if a == AllocatorReplaceDeadVoter ||
 		a == AllocatorReplaceDeadNonVoter ||
@@ -238,6 +242,8 @@ var allocatorActionNames = map[AllocatorAction]string{
 	AllocatorConsiderRebalance:               "consider rebalance",
 	AllocatorRangeUnavailable:                "range unavailable",
 	AllocatorFinalizeAtomicReplicationChange: "finalize conf change",
+	AllocatorAddWitness:                      "add witness",
+	AllocatorRemoveWitness:                   "remove witness",
 }
 
 func (a AllocatorAction) String() string {
@@ -284,6 +290,8 @@ func (a AllocatorAction) Priority() float64 {
 		return 10000
 	case AllocatorReplaceDecommissioningVoter:
 		return 5000
+	case AllocatorAddWitness:
+		return 2000
 	case AllocatorRemoveDeadVoter:
 		return 1000
 	case AllocatorRemoveDecommissioningVoter:
@@ -302,6 +310,8 @@ func (a AllocatorAction) Priority() float64 {
 		return 300
 	case AllocatorRemoveNonVoter:
 		return 200
+	case AllocatorRemoveWitness:
+		return 100
 	case AllocatorConsiderRebalance, AllocatorRangeUnavailable, AllocatorNoop:
 		return 0
 	default:
@@ -981,6 +991,10 @@ func (a *Allocator) ComputeAction(
 
 	action, priority = a.computeAction(ctx, storePool, conf, desc.Replicas().VoterDescriptors(),
 		desc.Replicas().NonVoterDescriptors())
+	if action == AllocatorConsiderRebalance {
+		// Voting and non-voting replicas are in order, look at the witnesses.
+		action, priority = a.computeWitnessAction(ctx, storePool, conf, desc.Replicas().WitnessDescriptors())
+	}
 	// Ensure that priority is never -1. Typically, computeAction return
 	// action.Priority(), but we sometimes modify the priority for specific
 	// actions like AllocatorAddVoter, AllocatorRemoveDeadVoter, and
@@ -1226,6 +1240,42 @@ func (a *Allocator) computeAction(
 	return action, action.Priority()
 }
 
+// computeWitnessAction determines the action to take on the WITNESS replicas of
+// a range whose voting and non-voting replicas don't need any repair.
+//
+// Dead witnesses are replaced by first adding a new witness and then removing
+// the dead one, which is preferred by RemoveWitness.
+func (a *Allocator) computeWitnessAction(
+	ctx context.Context,
+	storePool storepool.AllocatorStorePool,
+	conf *roachpb.SpanConfig,
+	witnessReplicas []roachpb.ReplicaDescriptor,
+) (action AllocatorAction, priority float64) {
+	const includeSuspectAndDrainingStores = true
+	haveWitnesses := len(witnessReplicas)
+	neededWitnesses := int(conf.NumWitnesses)
+	_, deadWitnesses := storePool.LiveAndDeadReplicas(witnessReplicas, includeSuspectAndDrainingStores)
+	decommissioningWitnesses := storePool.DecommissioningReplicas(witnessReplicas)
+	// Witnesses on dead or decommissioning stores don't count towards the
+	// desired number of witnesses.
+	healthyWitnesses := haveWitnesses - len(deadWitnesses) - len(decommissioningWitnesses)
+
+	if healthyWitnesses < neededWitnesses {
+		action = AllocatorAddWitness
+		log.KvDistribution.VEventf(ctx, 3, "%s - missing witness need=%d, have=%d, priority=%.2f",
+			action, neededWitnesses, healthyWitnesses, action.Priority())
+		return action, action.Priority()
+	}
+	if haveWitnesses > neededWitnesses {
+		action = AllocatorRemoveWitness
+		log.KvDistribution.VEventf(ctx, 3, "%s - need=%d, have=%d, priority=%.2f",
+			action, neededWitnesses, haveWitnesses, action.Priority())
+		return action, action.Priority()
+	}
+	action = AllocatorConsiderRebalance
+	return action, action.Priority()
+}
+
 // getReplicasForDiversityCalc returns the set of replica descriptors that
 // should be used for computing the diversity scores for a target when
 // allocating/removing/rebalancing a replica of `targetType`.
@@ -1311,7 +1361,7 @@ func (a *Allocator) AllocateTarget(
 	ctx context.Context,
 	storePool storepool.AllocatorStorePool,
 	conf *roachpb.SpanConfig,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	replacing *roachpb.ReplicaDescriptor,
 	replicaStatus ReplicaStatus,
 	targetType TargetReplicaType,
@@ -1348,6 +1398,7 @@ func (a *Allocator) AllocateTarget(
 		conf,
 		existingVoters,
 		existingNonVoters,
+		existingWitnesses,
 		decommissioningReplica,
 		options,
 		selector,
@@ -1403,7 +1454,7 @@ func (a *Allocator) CheckAvoidsFragileQuorum(
 	ctx context.Context,
 	storePool storepool.AllocatorStorePool,
 	conf *roachpb.SpanConfig,
-	existingVoters, remainingLiveNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, remainingLiveNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	replicaStatus ReplicaStatus,
 	replicaType TargetReplicaType,
 	newTarget roachpb.ReplicationTarget,
@@ -1429,7 +1480,7 @@ func (a *Allocator) CheckAvoidsFragileQuorum(
 			roachpb.ReplicaDescriptor{NodeID: newTarget.NodeID, StoreID: newTarget.StoreID},
 		)
 
-		_, _, err := a.AllocateVoter(ctx, storePool, conf, oldPlusNewReplicas, remainingLiveNonVoters, nil /* replacing */, replicaStatus)
+		_, _, err := a.AllocateVoter(ctx, storePool, conf, oldPlusNewReplicas, remainingLiveNonVoters, existingWitnesses, nil /* replacing */, replicaStatus)
 		return err
 	}
 
@@ -1438,16 +1489,16 @@ func (a *Allocator) CheckAvoidsFragileQuorum(
 
 // AllocateVoter returns a suitable store for a new allocation of a voting
 // replica with the required attributes. Nodes already accommodating existing
-// voting replicas are ruled out as targets.
+// voting replicas or witnesses are ruled out as targets.
 func (a *Allocator) AllocateVoter(
 	ctx context.Context,
 	storePool storepool.AllocatorStorePool,
 	conf *roachpb.SpanConfig,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	replacing *roachpb.ReplicaDescriptor,
 	replicaStatus ReplicaStatus,
 ) (roachpb.ReplicationTarget, string, error) {
-	return a.AllocateTarget(ctx, storePool, conf, existingVoters, existingNonVoters, replacing, replicaStatus, VoterTarget)
+	return a.AllocateTarget(ctx, storePool, conf, existingVoters, existingNonVoters, existingWitnesses, replacing, replicaStatus, VoterTarget)
 }
 
 // AllocateNonVoter returns a suitable store for a new allocation of a
@@ -1457,11 +1508,74 @@ func (a *Allocator) AllocateNonVoter(
 	ctx context.Context,
 	storePool storepool.AllocatorStorePool,
 	conf *roachpb.SpanConfig,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	replacing *roachpb.ReplicaDescriptor,
 	replicaStatus ReplicaStatus,
 ) (roachpb.ReplicationTarget, string, error) {
-	return a.AllocateTarget(ctx, storePool, conf, existingVoters, existingNonVoters, replacing, replicaStatus, NonVoterTarget)
+	return a.AllocateTarget(ctx, storePool, conf, existingVoters, existingNonVoters, existingWitnesses, replacing, replicaStatus, NonVoterTarget)
+}
+
+// AllocateWitness returns a suitable store for a new WITNESS replica. Witnesses
+// are placed on a store without a replica of the range, favoring diversity like
+// non-voters are, and must satisfy the constraints that apply to all replicas
+// of the range (see witnessSpanConfig).
+func (a *Allocator) AllocateWitness(
+	ctx context.Context,
+	storePool storepool.AllocatorStorePool,
+	conf *roachpb.SpanConfig,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
+) (roachpb.ReplicationTarget, string, error) {
+	witnessConf := witnessSpanConfig(conf)
+	return a.AllocateTarget(
+		ctx, storePool, &witnessConf, existingVoters, existingNonVoters, existingWitnesses,
+		nil /* replacing */, Alive, NonVoterTarget,
+	)
+}
+
+// witnessSpanConfig returns the span config used to place the WITNESS replicas
+// of a range with the given span config. Witnesses don't hold user data, so the
+// constraints that require a number of replicas in some place, which are meant
+// to place copies of the data, don't apply to them. The constraints and voter
+// constraints that apply to all replicas (or all voters, which witnesses are
+// in raft) still do, so that witnesses stay in the allowed localities.
+func witnessSpanConfig(conf *roachpb.SpanConfig) roachpb.SpanConfig {
+	witnessConf := *conf
+	witnessConf.Constraints = nil
+	witnessConf.VoterConstraints = nil
+	for _, c := range conf.Constraints {
+		if c.NumReplicas == 0 {
+			witnessConf.Constraints = append(witnessConf.Constraints, c)
+		}
+	}
+	for _, c := range conf.VoterConstraints {
+		if c.NumReplicas == 0 {
+			witnessConf.Constraints = append(witnessConf.Constraints, c)
+		}
+	}
+	return witnessConf
+}
+
+// excludeWitnessStores returns the stores of the list that aren't on the node
+// of one of the given WITNESS replicas. A store can only hold one replica of a
+// range, so voters and non-voters can't be added to the stores of witnesses,
+// and, like for other replicas, nodes with a witness are disregarded too.
+func excludeWitnessStores(
+	sl storepool.StoreList, witnesses []roachpb.ReplicaDescriptor,
+) storepool.StoreList {
+	if len(witnesses) == 0 {
+		return sl
+	}
+	stores := make([]roachpb.StoreDescriptor, 0, len(sl.Stores))
+	for _, s := range sl.Stores {
+		onWitnessNode := false
+		for _, w := range witnesses {
+			onWitnessNode = onWitnessNode || w.NodeID == s.Node.NodeID
+		}
+		if !onWitnessNode {
+			stores = append(stores, s)
+		}
+	}
+	return storepool.MakeStoreList(stores)
 }
 
 // AllocateTargetFromList returns a suitable store for a new allocation of a
@@ -1472,14 +1586,15 @@ func (a *Allocator) AllocateTargetFromList(
 	storePool storepool.AllocatorStorePool,
 	candidateStores storepool.StoreList,
 	conf *roachpb.SpanConfig,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	options ScorerOptions,
 	selector CandidateSelector,
 	allowMultipleReplsPerNode bool,
 	targetType TargetReplicaType,
 ) (roachpb.ReplicationTarget, string) {
 	return a.allocateTargetFromList(ctx, storePool, candidateStores, conf, existingVoters,
-		existingNonVoters, nil /* replacing */, options, selector, allowMultipleReplsPerNode, targetType)
+		existingNonVoters, existingWitnesses, nil /* replacing */, options, selector,
+		allowMultipleReplsPerNode, targetType)
 }
 
 func (a *Allocator) allocateTargetFromList(
@@ -1487,13 +1602,14 @@ func (a *Allocator) allocateTargetFromList(
 	storePool storepool.AllocatorStorePool,
 	candidateStores storepool.StoreList,
 	conf *roachpb.SpanConfig,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	replacing *roachpb.ReplicaDescriptor,
 	options ScorerOptions,
 	selector CandidateSelector,
 	allowMultipleReplsPerNode bool,
 	targetType TargetReplicaType,
 ) (roachpb.ReplicationTarget, string) {
+	candidateStores = excludeWitnessStores(candidateStores, existingWitnesses)
 	existingReplicas := append(existingVoters, existingNonVoters...)
 	if replacing != nil {
 		existingReplicas = append(existingReplicas, *replacing)
@@ -1751,6 +1867,35 @@ func (a Allocator) RemoveVoter(
 	)
 }
 
+// RemoveWitness returns a suitable WITNESS replica to remove from the provided
+// set. Witnesses on dead or decommissioning stores are removed first.
+// Otherwise, the target is picked the same way as for non-voters, subject to
+// the constraints that apply to witnesses (see witnessSpanConfig).
+func (a Allocator) RemoveWitness(
+	ctx context.Context,
+	storePool storepool.AllocatorStorePool,
+	conf *roachpb.SpanConfig,
+	existingVoters []roachpb.ReplicaDescriptor,
+	existingWitnesses []roachpb.ReplicaDescriptor,
+	options ScorerOptions,
+) (roachpb.ReplicationTarget, string, error) {
+	_, deadWitnesses := storePool.LiveAndDeadReplicas(existingWitnesses, true /* includeSuspectAndDrainingStores */)
+	if len(deadWitnesses) > 0 {
+		return roachpb.ReplicationTarget{
+			NodeID: deadWitnesses[0].NodeID, StoreID: deadWitnesses[0].StoreID,
+		}, "dead witness", nil
+	}
+	if decommissioning := storePool.DecommissioningReplicas(existingWitnesses); len(decommissioning) > 0 {
+		return roachpb.ReplicationTarget{
+			NodeID: decommissioning[0].NodeID, StoreID: decommissioning[0].StoreID,
+		}, "decommissioning witness", nil
+	}
+	witnessConf := witnessSpanConfig(conf)
+	return a.RemoveNonVoter(
+		ctx, storePool, &witnessConf, existingWitnesses, existingVoters, existingWitnesses, options,
+	)
+}
+
 // RemoveNonVoter returns a suitable non-voting replica to remove from the
 // provided set. It first attempts to randomly select a target from the set of
 // stores that have greater than the average number of replicas. Failing that,
@@ -1791,13 +1936,14 @@ func (a Allocator) RebalanceTarget(
 	storePool storepool.AllocatorStorePool,
 	conf *roachpb.SpanConfig,
 	raftStatus *raft.Status,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	rangeUsageInfo allocator.RangeUsageInfo,
 	filter storepool.StoreFilter,
 	targetType TargetReplicaType,
 	options ScorerOptions,
 ) (add, remove roachpb.ReplicationTarget, details string, ok bool) {
 	sl, _, _ := storePool.GetStoreList(filter)
+	sl = excludeWitnessStores(sl, existingWitnesses)
 
 	// If we're considering a rebalance due to an `AdminScatterRequest`, we'd like
 	// to ensure that we're returning a random rebalance target to a new store
@@ -2066,7 +2212,7 @@ func (a Allocator) RebalanceVoter(
 	storePool storepool.AllocatorStorePool,
 	conf *roachpb.SpanConfig,
 	raftStatus *raft.Status,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	rangeUsageInfo allocator.RangeUsageInfo,
 	filter storepool.StoreFilter,
 	options ScorerOptions,
@@ -2078,6 +2224,7 @@ func (a Allocator) RebalanceVoter(
 		raftStatus,
 		existingVoters,
 		existingNonVoters,
+		existingWitnesses,
 		rangeUsageInfo,
 		filter,
 		VoterTarget,
@@ -2102,7 +2249,7 @@ func (a Allocator) RebalanceNonVoter(
 	storePool storepool.AllocatorStorePool,
 	conf *roachpb.SpanConfig,
 	raftStatus *raft.Status,
-	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+	existingVoters, existingNonVoters, existingWitnesses []roachpb.ReplicaDescriptor,
 	rangeUsageInfo allocator.RangeUsageInfo,
 	filter storepool.StoreFilter,
 	options ScorerOptions,
@@ -2114,6 +2261,7 @@ func (a Allocator) RebalanceNonVoter(
 		raftStatus,
 		existingVoters,
 		existingNonVoters,
+		existingWitnesses,
 		rangeUsageInfo,
 		filter,
 		NonVoterTarget,
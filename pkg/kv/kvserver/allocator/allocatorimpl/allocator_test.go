@@ -579,7 +579,7 @@ func TestAllocatorSimpleRetrieval(t *testing.T) {
 		ctx,
 		sp,
 		simpleSpanConfig,
-		nil /* existingVoters */, nil /* existingNonVoters */, nil, /* replacing */
+		nil /* existingVoters */, nil /* existingNonVoters */, nil /* existingWitnesses */, nil, /* replacing */
 		Dead,
 	)
 	if err != nil {
@@ -601,7 +601,7 @@ func TestAllocatorNoAvailableDisks(t *testing.T) {
 		ctx,
 		sp,
 		simpleSpanConfig,
-		nil /* existingVoters */, nil /* existingNonVoters */, nil, /* replacing */
+		nil /* existingVoters */, nil /* existingNonVoters */, nil /* existingWitnesses */, nil, /* replacing */
 		Dead,
 	)
 	if !roachpb.Empty(result) {
@@ -639,7 +639,7 @@ func TestAllocatorFullDiskError(t *testing.T) {
 		ctx,
 		sp,
 		emptySpanConfig(),
-		nil /* existingVoters */, nil /* existingNonVoters */, nil, /* replacing */
+		nil /* existingVoters */, nil /* existingNonVoters */, nil /* existingWitnesses */, nil, /* replacing */
 		Dead,
 	)
 	require.Equal(t, result, roachpb.ReplicationTarget{})
@@ -741,6 +741,7 @@ func TestAllocatorAllocateVoterIOOverloadCheck(t *testing.T) {
 				test.conf,
 				nil,
 				nil,
+				nil, /* existingWitnesses */
 				nil,
 				Alive,
 			)
@@ -757,6 +758,7 @@ func TestAllocatorAllocateVoterIOOverloadCheck(t *testing.T) {
 				test.conf,
 				nil,
 				nil,
+				nil, /* existingWitnesses */
 				nil,
 				// Dead and Decommissioning should behave the same here, use either.
 				func() ReplicaStatus {
@@ -787,7 +789,7 @@ func TestAllocatorTwoDatacenters(t *testing.T) {
 		ctx,
 		sp,
 		multiDCConfigSSD,
-		nil /* existingVoters */, nil /* existingNonVoters */, nil, /* replacing */
+		nil /* existingVoters */, nil /* existingNonVoters */, nil /* existingWitnesses */, nil, /* replacing */
 		Dead,
 	)
 	if err != nil {
@@ -800,7 +802,7 @@ func TestAllocatorTwoDatacenters(t *testing.T) {
 		[]roachpb.ReplicaDescriptor{{
 			NodeID:  result1.NodeID,
 			StoreID: result1.StoreID,
-		}}, nil /* existingNonVoters */, nil, /* replacing */
+		}}, nil /* existingNonVoters */, nil /* existingWitnesses */, nil, /* replacing */
 		Dead,
 	)
 	if err != nil {
@@ -825,7 +827,7 @@ func TestAllocatorTwoDatacenters(t *testing.T) {
 				NodeID:  result2.NodeID,
 				StoreID: result2.StoreID,
 			},
-		}, nil /* existingNonVoters */, nil, /* replacing */
+		}, nil /* existingNonVoters */, nil /* existingWitnesses */, nil, /* replacing */
 		Dead,
 	)
 	if err == nil {
@@ -860,7 +862,7 @@ func TestAllocatorExistingReplica(t *testing.T) {
 				NodeID:  2,
 				StoreID: 2,
 			},
-		}, nil, /* existingNonVoters */
+		}, nil /* existingNonVoters */, nil, /* existingWitnesses */
 		nil, /* replacing */
 		Dead,
 	)
@@ -926,7 +928,7 @@ func TestAllocatorReplaceDecommissioningReplica(t *testing.T) {
 				StoreID:   2,
 				ReplicaID: 2,
 			},
-		}, nil, /* existingNonVoters */
+		}, nil /* existingNonVoters */, nil, /* existingWitnesses */
 		&roachpb.ReplicaDescriptor{
 			NodeID:    3,
 			StoreID:   3,
@@ -992,7 +994,7 @@ func TestAllocatorReplaceFailsOnConstrainedDecommissioningReplica(t *testing.T)
 				StoreID:   2,
 				ReplicaID: 2,
 			},
-		}, nil, /* existingNonVoters */
+		}, nil /* existingNonVoters */, nil, /* existingWitnesses */
 		&roachpb.ReplicaDescriptor{
 			NodeID:    3,
 			StoreID:   3,
@@ -1101,7 +1103,7 @@ func TestAllocatorMultipleStoresPerNode(t *testing.T) {
 	for _, tc := range testCases {
 		{
 			result, _, err := a.AllocateVoter(
-				ctx, sp, emptySpanConfig(), tc.existing, nil, nil,
+				ctx, sp, emptySpanConfig(), tc.existing, nil, nil /* existingWitnesses */, nil,
 				Dead,
 			)
 			if e, a := tc.expectTargetAllocate, !roachpb.Empty(result); e != a {
@@ -1121,6 +1123,7 @@ func TestAllocatorMultipleStoresPerNode(t *testing.T) {
 				nil,
 				tc.existing,
 				nil,
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				a.ScorerOptions(ctx),
@@ -1196,6 +1199,7 @@ func TestAllocatorMultipleStoresPerNodeLopsided(t *testing.T) {
 			nil,
 			ranges[i].InternalReplicas,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -1238,6 +1242,7 @@ func TestAllocatorMultipleStoresPerNodeLopsided(t *testing.T) {
 			nil,
 			ranges[i].InternalReplicas,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -1334,6 +1339,7 @@ func TestAllocatorRebalanceBasedOnRangeCount(t *testing.T) {
 			nil,
 			[]roachpb.ReplicaDescriptor{{NodeID: 3, StoreID: 3}},
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			options,
@@ -1443,6 +1449,7 @@ func TestAllocatorRebalanceDeadNodes(t *testing.T) {
 				nil,
 				c.existing,
 				nil,
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				a.ScorerOptions(ctx),
@@ -1728,6 +1735,7 @@ func TestAllocatorRebalanceByQPS(t *testing.T) {
 				nil,
 				[]roachpb.ReplicaDescriptor{{StoreID: subtest.testStores[0].StoreID}},
 				nil,
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				options,
@@ -1896,6 +1904,7 @@ func TestAllocatorRebalanceByCount(t *testing.T) {
 			nil,
 			[]roachpb.ReplicaDescriptor{{StoreID: stores[0].StoreID}},
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -2971,6 +2980,7 @@ func TestAllocatorRebalanceDifferentLocalitySizes(t *testing.T) {
 			nil,
 			tc.existing,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -3044,6 +3054,7 @@ func TestAllocatorRebalanceDifferentLocalitySizes(t *testing.T) {
 			nil,
 			tc.existing,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -3804,7 +3815,7 @@ func TestAllocatorConstraintsAndVoterConstraints(t *testing.T) {
 			// Allocate the voting replica first, before the non-voter. This is the
 			// order in which we'd expect the allocator to repair a given range. See
 			// TestAllocatorComputeAction.
-			voterTarget, _, err := a.AllocateVoter(ctx, sp, test.conf, test.existingVoters, test.existingNonVoters, nil, Dead)
+			voterTarget, _, err := a.AllocateVoter(ctx, sp, test.conf, test.existingVoters, test.existingNonVoters, nil /* existingWitnesses */, nil, Dead)
 			if test.shouldVoterAllocFail {
 				require.Errorf(t, err, "expected voter allocation to fail; got %v as a valid target instead", voterTarget)
 			} else {
@@ -3813,7 +3824,7 @@ func TestAllocatorConstraintsAndVoterConstraints(t *testing.T) {
 				test.existingVoters = append(test.existingVoters, replicas(voterTarget.StoreID)...)
 			}
 
-			nonVoterTarget, _, err := a.AllocateNonVoter(ctx, sp, test.conf, test.existingVoters, test.existingNonVoters, nil /* replacing */, Dead)
+			nonVoterTarget, _, err := a.AllocateNonVoter(ctx, sp, test.conf, test.existingVoters, test.existingNonVoters, nil /* existingWitnesses */, nil /* replacing */, Dead)
 			if test.shouldNonVoterAllocFail {
 				require.Errorf(t, err, "expected non-voter allocation to fail; got %v as a valid target instead", nonVoterTarget)
 			} else {
@@ -3887,7 +3898,7 @@ func TestAllocatorAllocateTargetLocality(t *testing.T) {
 				StoreID: storeID,
 			}
 		}
-		targetStore, details, err := a.AllocateVoter(ctx, sp, emptySpanConfig(), existingRepls, nil, nil, Dead)
+		targetStore, details, err := a.AllocateVoter(ctx, sp, emptySpanConfig(), existingRepls, nil, nil /* existingWitnesses */, nil, Dead)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -4013,6 +4024,7 @@ func TestAllocatorRebalanceTargetLocality(t *testing.T) {
 			nil,
 			existingRepls,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -4355,7 +4367,7 @@ func TestAllocatorNonVoterAllocationExcludesVoterNodes(t *testing.T) {
 			sg := gossiputil.NewStoreGossiper(g)
 			sg.GossipStores(test.stores, t)
 
-			result, _, err := a.AllocateNonVoter(ctx, sp, test.conf, test.existingVoters, test.existingNonVoters, nil /* replacing */, Dead)
+			result, _, err := a.AllocateNonVoter(ctx, sp, test.conf, test.existingVoters, test.existingNonVoters, nil /* existingWitnesses */, nil /* replacing */, Dead)
 			if test.shouldFail {
 				require.Error(t, err)
 				require.Regexp(t, test.expError, err)
@@ -4982,6 +4994,7 @@ func TestAllocatorRebalanceNonVoters(t *testing.T) {
 				nil,
 				test.existingVoters,
 				test.existingNonVoters,
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				a.ScorerOptions(ctx),
@@ -5115,6 +5128,7 @@ func TestAllocatorRebalanceIOOverloadCheck(t *testing.T) {
 				nil,
 				test.existingVoters,
 				[]roachpb.ReplicaDescriptor{},
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				options,
@@ -5334,6 +5348,7 @@ func TestAllocatorRebalanceMMAConflict(t *testing.T) {
 				nil,
 				test.existingVoters,
 				[]roachpb.ReplicaDescriptor{},
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				a.ScorerOptions(ctx),
@@ -5391,6 +5406,7 @@ func TestVotersCanRebalanceToNonVoterStores(t *testing.T) {
 		nil,
 		existingVoters,
 		existingNonVoters,
+		nil, /* existingWitnesses */
 		rangeUsageInfo,
 		storepool.StoreFilterThrottled,
 		a.ScorerOptions(ctx),
@@ -5451,6 +5467,7 @@ func TestNonVotersCannotRebalanceToVoterStores(t *testing.T) {
 		nil,
 		existingVoters,
 		existingNonVoters,
+		nil, /* existingWitnesses */
 		rangeUsageInfo,
 		storepool.StoreFilterThrottled,
 		a.ScorerOptions(ctx),
@@ -6286,6 +6303,7 @@ func TestRebalanceCandidatesNumReplicasConstraints(t *testing.T) {
 				nil,
 				existingRepls,
 				nil,
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				a.ScorerOptions(ctx),
@@ -8923,6 +8941,7 @@ func TestAllocatorRebalanceDeterminism(t *testing.T) {
 				nil,
 				replicas(1, 2, 5),
 				nil,
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				a.ScorerOptions(ctx),
@@ -8994,6 +9013,7 @@ func TestAllocatorRebalanceWithScatter(t *testing.T) {
 		nil,
 		replicas(1),
 		nil,
+		nil, /* existingWitnesses */
 		rangeUsageInfo,
 		storepool.StoreFilterThrottled,
 		a.ScorerOptions(ctx),
@@ -9008,6 +9028,7 @@ func TestAllocatorRebalanceWithScatter(t *testing.T) {
 		nil,
 		replicas(1),
 		nil,
+		nil, /* existingWitnesses */
 		rangeUsageInfo,
 		storepool.StoreFilterThrottled,
 		a.ScorerOptionsForScatter(ctx),
@@ -9123,6 +9144,7 @@ func TestAllocatorRebalanceAway(t *testing.T) {
 				nil,
 				existingReplicas,
 				nil,
+				nil, /* existingWitnesses */
 				rangeUsageInfo,
 				storepool.StoreFilterThrottled,
 				a.ScorerOptions(ctx),
@@ -9192,6 +9214,7 @@ func Example_rangeCountRebalancing() {
 			nil,
 			[]roachpb.ReplicaDescriptor{{NodeID: ts.Node.NodeID, StoreID: ts.StoreID}},
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			alloc.ScorerOptions(ctx),
@@ -9307,6 +9330,7 @@ func qpsBasedRebalanceFn(
 		nil,
 		[]roachpb.ReplicaDescriptor{{NodeID: candidate.Node.NodeID, StoreID: candidate.StoreID}},
 		nil,
+		nil, /* existingWitnesses */
 		rangeUsageInfo,
 		storepool.StoreFilterThrottled,
 		opts,
@@ -9709,7 +9733,7 @@ func TestNonVoterPrioritizationInVoterAdditions(t *testing.T) {
 	}
 
 	for i, tc := range testCases {
-		result, _, _ := a.AllocateVoter(ctx, sp, tc.spanConfig, tc.existingVoters, tc.existingNonVoters, nil, Alive)
+		result, _, _ := a.AllocateVoter(ctx, sp, tc.spanConfig, tc.existingVoters, tc.existingNonVoters, nil /* existingWitnesses */, nil, Alive)
 		assert.Equal(t, tc.expectedTargetAllocate, result, "Unexpected replication target returned by allocate voter in test %d", i)
 	}
 }
@@ -9859,6 +9883,7 @@ func TestAllocatorRebalanceTargetVoterConstraintUnsatisfied(t *testing.T) {
 			}
 			promote, demote, details, ok := a.RebalanceVoter(
 				ctx, sp, &tc.spanConfig, nil, tc.existingVoters, tc.existingNonVoters,
+				nil, /* existingWitnesses */
 				allocator.RangeUsageInfo{}, storepool.StoreFilterNone, a.ScorerOptions(ctx))
 			if tc.expectNoop {
 				require.False(t, ok, "expected ok to be false, details=%v", details)
@@ -10051,3 +10076,281 @@ func TestAllocatorPriorityInvariance(t *testing.T) {
 		}
 	}
 }
+
+// TestAllocatorComputeWitnessAction verifies that witnesses are added when
+// there are fewer healthy witnesses than configured, and removed when there
+// are more witnesses than configured.
+func TestAllocatorComputeWitnessAction(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	testCases := []struct {
+		name            string
+		numWitnesses    int32
+		witnesses       []roachpb.StoreID
+		live            []roachpb.StoreID
+		dead            []roachpb.StoreID
+		decommissioning []roachpb.StoreID
+		expectedAction  AllocatorAction
+	}{
+		{
+			name:           "missing witness",
+			numWitnesses:   1,
+			expectedAction: AllocatorAddWitness,
+		},
+		{
+			name:           "enough witnesses",
+			numWitnesses:   1,
+			witnesses:      []roachpb.StoreID{4},
+			live:           []roachpb.StoreID{4},
+			expectedAction: AllocatorConsiderRebalance,
+		},
+		{
+			name:           "dead witness",
+			numWitnesses:   1,
+			witnesses:      []roachpb.StoreID{4},
+			dead:           []roachpb.StoreID{4},
+			expectedAction: AllocatorAddWitness,
+		},
+		{
+			name:            "decommissioning witness",
+			numWitnesses:    1,
+			witnesses:       []roachpb.StoreID{4},
+			decommissioning: []roachpb.StoreID{4},
+			expectedAction:  AllocatorAddWitness,
+		},
+		{
+			name:           "replaced dead witness",
+			numWitnesses:   1,
+			witnesses:      []roachpb.StoreID{4, 5},
+			live:           []roachpb.StoreID{5},
+			dead:           []roachpb.StoreID{4},
+			expectedAction: AllocatorRemoveWitness,
+		},
+		{
+			name:           "too many witnesses",
+			numWitnesses:   1,
+			witnesses:      []roachpb.StoreID{4, 5},
+			live:           []roachpb.StoreID{4, 5},
+			expectedAction: AllocatorRemoveWitness,
+		},
+		{
+			name:           "no witnesses configured",
+			witnesses:      []roachpb.StoreID{4},
+			live:           []roachpb.StoreID{4},
+			expectedAction: AllocatorRemoveWitness,
+		},
+	}
+
+	ctx := context.Background()
+	stopper, _, sp, a, _ := CreateTestAllocator(ctx, 10, false /* deterministic */)
+	defer stopper.Stop(ctx)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStorePool(sp, tc.live, nil, tc.dead, tc.decommissioning, nil, nil)
+			witnesses := replicas(tc.witnesses...)
+			for i := range witnesses {
+				witnesses[i].Type = roachpb.WITNESS
+			}
+			conf := roachpb.SpanConfig{NumReplicas: 3, NumWitnesses: tc.numWitnesses}
+			action, priority := a.computeWitnessAction(ctx, sp, &conf, witnesses)
+			require.Equal(t, tc.expectedAction, action)
+			require.Equal(t, tc.expectedAction.Priority(), priority)
+		})
+	}
+}
+
+// TestAllocatorExcludesWitnessStores verifies that voters and non-voters are
+// never allocated or rebalanced to the stores of the range's witnesses.
+func TestAllocatorExcludesWitnessStores(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	witnesses := replicas(3)
+	witnesses[0].Type = roachpb.WITNESS
+
+	t.Run("allocate", func(t *testing.T) {
+		stopper, g, sp, a, _ := CreateTestAllocator(ctx, 10, false /* deterministic */)
+		defer stopper.Stop(ctx)
+		gossiputil.NewStoreGossiper(g).GossipStores(sameDCStores, t)
+
+		voter, _, err := a.AllocateVoter(
+			ctx, sp, emptySpanConfig(), replicas(1, 2, 4), nil /* existingNonVoters */, witnesses,
+			nil /* replacing */, Alive,
+		)
+		require.NoError(t, err)
+		require.Equal(t, roachpb.StoreID(5), voter.StoreID)
+
+		nonVoter, _, err := a.AllocateNonVoter(
+			ctx, sp, emptySpanConfig(), replicas(1, 2), replicas(4), witnesses,
+			nil /* replacing */, Alive,
+		)
+		require.NoError(t, err)
+		require.Equal(t, roachpb.StoreID(5), nonVoter.StoreID)
+	})
+
+	t.Run("rebalance", func(t *testing.T) {
+		stopper, g, sp, a, _ := CreateTestAllocator(ctx, 10, false /* deterministic */)
+		defer stopper.Stop(ctx)
+		// Store 3 has no ranges, so it is the natural rebalance target of the
+		// voters on stores 1 and 2.
+		var stores []*roachpb.StoreDescriptor
+		for i, rangeCount := range []int32{100, 100, 0} {
+			stores = append(stores, &roachpb.StoreDescriptor{
+				StoreID:  roachpb.StoreID(i + 1),
+				Node:     roachpb.NodeDescriptor{NodeID: roachpb.NodeID(i + 1)},
+				Capacity: roachpb.StoreCapacity{RangeCount: rangeCount, Capacity: 100, Available: 100},
+			})
+		}
+		gossiputil.NewStoreGossiper(g).GossipStores(stores, t)
+		conf := &roachpb.SpanConfig{NumReplicas: 2}
+		var rangeUsageInfo allocator.RangeUsageInfo
+
+		add, _, _, ok := a.RebalanceVoter(
+			ctx, sp, conf, nil /* raftStatus */, replicas(1, 2), nil, /* existingNonVoters */
+			nil /* existingWitnesses */, rangeUsageInfo, storepool.StoreFilterThrottled,
+			a.ScorerOptions(ctx),
+		)
+		require.True(t, ok)
+		require.Equal(t, roachpb.StoreID(3), add.StoreID)
+
+		_, _, _, ok = a.RebalanceVoter(
+			ctx, sp, conf, nil /* raftStatus */, replicas(1, 2), nil, /* existingNonVoters */
+			witnesses, rangeUsageInfo, storepool.StoreFilterThrottled, a.ScorerOptions(ctx),
+		)
+		require.False(t, ok)
+		_, _, _, ok = a.RebalanceNonVoter(
+			ctx, sp, conf, nil /* raftStatus */, replicas(1), replicas(2),
+			witnesses, rangeUsageInfo, storepool.StoreFilterThrottled, a.ScorerOptions(ctx),
+		)
+		require.False(t, ok)
+	})
+}
+
+// TestAllocatorAllocateWitness verifies that witnesses are placed on stores
+// without a replica of the range that satisfy the constraints that apply to
+// all replicas or all voters, while constraints on the number of replicas in
+// some place are ignored.
+func TestAllocatorAllocateWitness(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	testCases := []struct {
+		name                              string
+		conf                              *roachpb.SpanConfig
+		existingVoters, existingNonVoters []roachpb.ReplicaDescriptor
+		existingWitnesses                 []roachpb.StoreID
+		expected                          roachpb.StoreID
+		expError                          string
+	}{
+		{
+			name:     "constraint on all replicas",
+			conf:     multiDCConfigConstrainToA,
+			expected: 1,
+		},
+		{
+			// The voter constraint places voters in "b", and the constraint placing
+			// one replica in "a" doesn't apply to witnesses.
+			name:     "voter constraint",
+			conf:     multiDCConfigVoterAndNonVoter,
+			expected: 2,
+		},
+		{
+			name:           "only valid store has a voter",
+			conf:           multiDCConfigConstrainToA,
+			existingVoters: replicas(1),
+			expError:       "0 of 2 live stores are able to take a new replica for the range",
+		},
+		{
+			name:              "existing witness",
+			conf:              emptySpanConfig(),
+			existingWitnesses: []roachpb.StoreID{1},
+			expected:          2,
+		},
+		{
+			name:              "existing non-voter",
+			conf:              emptySpanConfig(),
+			existingNonVoters: replicas(2),
+			expected:          1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			stopper, g, sp, a, _ := CreateTestAllocator(ctx, 10, false /* deterministic */)
+			defer stopper.Stop(ctx)
+			gossiputil.NewStoreGossiper(g).GossipStores(multiDCStores, t)
+
+			witnesses := replicas(tc.existingWitnesses...)
+			for i := range witnesses {
+				witnesses[i].Type = roachpb.WITNESS
+			}
+			result, _, err := a.AllocateWitness(
+				ctx, sp, tc.conf, tc.existingVoters, tc.existingNonVoters, witnesses,
+			)
+			if tc.expError != "" {
+				require.Error(t, err)
+				require.Regexp(t, tc.expError, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result.StoreID)
+		})
+	}
+}
+
+// TestAllocatorRemoveWitness verifies that witnesses on dead or
+// decommissioning stores are removed first, and that otherwise witnesses that
+// violate the constraints that apply to them are removed.
+func TestAllocatorRemoveWitness(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	makeWitnesses := func(storeIDs ...roachpb.StoreID) []roachpb.ReplicaDescriptor {
+		witnesses := replicas(storeIDs...)
+		for i := range witnesses {
+			witnesses[i].Type = roachpb.WITNESS
+		}
+		return witnesses
+	}
+
+	t.Run("dead or decommissioning", func(t *testing.T) {
+		stopper, _, sp, a, _ := CreateTestAllocator(ctx, 10, false /* deterministic */)
+		defer stopper.Stop(ctx)
+		conf := &roachpb.SpanConfig{NumReplicas: 3, NumWitnesses: 1}
+
+		mockStorePool(sp, []roachpb.StoreID{1, 2, 3, 4}, nil, []roachpb.StoreID{5}, nil, nil, nil)
+		target, details, err := a.RemoveWitness(
+			ctx, sp, conf, replicas(1, 2, 3), makeWitnesses(4, 5), a.ScorerOptions(ctx),
+		)
+		require.NoError(t, err)
+		require.Equal(t, roachpb.StoreID(5), target.StoreID)
+		require.Equal(t, "dead witness", details)
+
+		mockStorePool(sp, []roachpb.StoreID{1, 2, 3, 5}, nil, nil, []roachpb.StoreID{4}, nil, nil)
+		target, details, err = a.RemoveWitness(
+			ctx, sp, conf, replicas(1, 2, 3), makeWitnesses(4, 5), a.ScorerOptions(ctx),
+		)
+		require.NoError(t, err)
+		require.Equal(t, roachpb.StoreID(4), target.StoreID)
+		require.Equal(t, "decommissioning witness", details)
+	})
+
+	t.Run("constraints", func(t *testing.T) {
+		stopper, g, sp, a, _ := CreateTestAllocator(ctx, 10, false /* deterministic */)
+		defer stopper.Stop(ctx)
+		gossiputil.NewStoreGossiper(g).GossipStores(multiDCStores, t)
+
+		// Only the witness in "a" satisfies the constraint.
+		target, _, err := a.RemoveWitness(
+			ctx, sp, multiDCConfigConstrainToA, nil /* existingVoters */, makeWitnesses(1, 2),
+			a.ScorerOptions(ctx),
+		)
+		require.NoError(t, err)
+		require.Equal(t, roachpb.StoreID(2), target.StoreID)
+	})
+}
@@ -166,6 +166,7 @@ func (rp ReplicaPlanner) ShouldPlanChange(
 
 	voterReplicas := desc.Replicas().VoterDescriptors()
 	nonVoterReplicas := desc.Replicas().NonVoterDescriptors()
+	witnessReplicas := desc.Replicas().WitnessDescriptors()
 	if !rp.knobs.DisableReplicaRebalancing {
 		scorerOptions := allocatorimpl.ScorerOptions(rp.allocator.ScorerOptions(ctx))
 		if rp.allocator.CountBasedRebalancingDisabled() {
@@ -179,6 +180,7 @@ func (rp ReplicaPlanner) ShouldPlanChange(
 			repl.RaftStatus(),
 			voterReplicas,
 			nonVoterReplicas,
+			witnessReplicas,
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			scorerOptions,
@@ -194,6 +196,7 @@ func (rp ReplicaPlanner) ShouldPlanChange(
 			repl.RaftStatus(),
 			voterReplicas,
 			nonVoterReplicas,
+			witnessReplicas,
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			scorerOptions,
@@ -308,6 +311,12 @@ func (rp ReplicaPlanner) PlanOneChange(
 		op, stats, err = rp.removeDead(ctx, repl, deadVoterReplicas, allocatorimpl.VoterTarget)
 	case allocatorimpl.AllocatorRemoveDeadNonVoter:
 		op, stats, err = rp.removeDead(ctx, repl, deadNonVoterReplicas, allocatorimpl.NonVoterTarget)
+
+	// Add or remove witnesses.
+	case allocatorimpl.AllocatorAddWitness:
+		op, err = rp.addWitness(ctx, repl, desc, conf, voterReplicas, nonVoterReplicas)
+	case allocatorimpl.AllocatorRemoveWitness:
+		op, err = rp.removeWitness(ctx, repl, desc, conf, voterReplicas)
 	// Rebalance replicas.
 	//
 	// NB: Rebalacing attempts to balance replica counts among stores of
@@ -375,7 +384,8 @@ func (rp ReplicaPlanner) addOrReplaceVoters(
 	// we're removing it (i.e. dead or decommissioning). If we left the replica in
 	// the slice, the allocator would not be guaranteed to pick a replica that
 	// fills the gap removeRepl leaves once it's gone.
-	newVoter, details, err := rp.allocator.AllocateVoter(ctx, rp.storePool, conf, remainingLiveVoters, remainingLiveNonVoters, replacing, replicaStatus)
+	existingWitnesses := desc.Replicas().WitnessDescriptors()
+	newVoter, details, err := rp.allocator.AllocateVoter(ctx, rp.storePool, conf, remainingLiveVoters, remainingLiveNonVoters, existingWitnesses, replacing, replicaStatus)
 	if err != nil {
 		return nil, stats, err
 	}
@@ -392,7 +402,7 @@ func (rp ReplicaPlanner) addOrReplaceVoters(
 	// quorum. For example, up-replicating from 1 to 2 replicas only makes sense
 	// if it is possible to be able to go to 3 replicas.
 	if err := rp.allocator.CheckAvoidsFragileQuorum(ctx, rp.storePool, conf,
-		existingVoters, remainingLiveNonVoters,
+		existingVoters, remainingLiveNonVoters, existingWitnesses,
 		replicaStatus, allocatorimpl.VoterTarget, newVoter, isReplace); err != nil {
 		// It does not seem possible to go to the next odd replica state. Note
 		// that AllocateVoter returns an allocatorError (a PurgatoryError)
@@ -454,7 +464,7 @@ func (rp ReplicaPlanner) addOrReplaceVoters(
 func (rp ReplicaPlanner) addOrReplaceNonVoters(
 	ctx context.Context,
 	repl AllocatorReplica,
-	_ *roachpb.RangeDescriptor,
+	desc *roachpb.RangeDescriptor,
 	conf *roachpb.SpanConfig,
 	existingNonVoters []roachpb.ReplicaDescriptor,
 	liveVoterReplicas, liveNonVoterReplicas []roachpb.ReplicaDescriptor,
@@ -467,7 +477,10 @@ func (rp ReplicaPlanner) addOrReplaceNonVoters(
 		replacing = &existingNonVoters[removeIdx]
 	}
 
-	newNonVoter, details, err := rp.allocator.AllocateNonVoter(ctx, rp.storePool, conf, liveVoterReplicas, liveNonVoterReplicas, replacing, replicaStatus)
+	newNonVoter, details, err := rp.allocator.AllocateNonVoter(
+		ctx, rp.storePool, conf, liveVoterReplicas, liveNonVoterReplicas,
+		desc.Replicas().WitnessDescriptors(), replacing, replicaStatus,
+	)
 	if err != nil {
 		return nil, stats, err
 	}
@@ -679,6 +692,59 @@ func (rp ReplicaPlanner) removeNonVoter(
 	return op, stats, nil
 }
 
+func (rp ReplicaPlanner) addWitness(
+	ctx context.Context,
+	repl AllocatorReplica,
+	desc *roachpb.RangeDescriptor,
+	conf *roachpb.SpanConfig,
+	existingVoters, existingNonVoters []roachpb.ReplicaDescriptor,
+) (op AllocationOp, _ error) {
+	target, details, err := rp.allocator.AllocateWitness(
+		ctx, rp.storePool, conf, existingVoters, existingNonVoters, desc.Replicas().WitnessDescriptors(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	log.KvDistribution.Infof(ctx, "adding witness %+v: %s",
+		target, rangeRaftProgress(repl.RaftStatus(), existingVoters))
+	op = AllocationChangeReplicasOp{
+		LeaseholderStore:  repl.StoreID(),
+		Usage:             repl.RangeUsageInfo(),
+		Chgs:              kvpb.MakeReplicationChanges(roachpb.ADD_WITNESS, target),
+		AllocatorPriority: allocatorimpl.AllocatorAddWitness.Priority(),
+		Reason:            kvserverpb.ReasonRangeUnderReplicated,
+		Details:           details,
+	}
+	return op, nil
+}
+
+func (rp ReplicaPlanner) removeWitness(
+	ctx context.Context,
+	repl AllocatorReplica,
+	desc *roachpb.RangeDescriptor,
+	conf *roachpb.SpanConfig,
+	existingVoters []roachpb.ReplicaDescriptor,
+) (op AllocationOp, _ error) {
+	target, details, err := rp.allocator.RemoveWitness(
+		ctx, rp.storePool, conf, existingVoters, desc.Replicas().WitnessDescriptors(),
+		rp.allocator.ScorerOptions(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	log.KvDistribution.Infof(ctx, "removing witness %+v: %s",
+		target, rangeRaftProgress(repl.RaftStatus(), existingVoters))
+	op = AllocationChangeReplicasOp{
+		LeaseholderStore:  repl.StoreID(),
+		Usage:             repl.RangeUsageInfo(),
+		Chgs:              kvpb.MakeReplicationChanges(roachpb.REMOVE_WITNESS, target),
+		AllocatorPriority: 0.0, // unused
+		Reason:            kvserverpb.ReasonRangeOverReplicated,
+		Details:           details,
+	}
+	return op, nil
+}
+
 func (rp ReplicaPlanner) removeDecommissioning(
 	ctx context.Context,
 	repl AllocatorReplica,
@@ -797,6 +863,7 @@ func (rp ReplicaPlanner) considerRebalance(
 		scorerOpts = rp.allocator.BaseScorerOptionsWithNoConvergence()
 	}
 	rangeUsageInfo := repl.RangeUsageInfo()
+	existingWitnesses := desc.Replicas().WitnessDescriptors()
 	addTarget, removeTarget, details, ok := rp.allocator.RebalanceVoter(
 		ctx,
 		rp.storePool,
@@ -804,6 +871,7 @@ func (rp ReplicaPlanner) considerRebalance(
 		repl.RaftStatus(),
 		existingVoters,
 		existingNonVoters,
+		existingWitnesses,
 		rangeUsageInfo,
 		storepool.StoreFilterThrottled,
 		scorerOpts,
@@ -819,6 +887,7 @@ func (rp ReplicaPlanner) considerRebalance(
 			repl.RaftStatus(),
 			existingVoters,
 			existingNonVoters,
+			existingWitnesses,
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			scorerOpts,
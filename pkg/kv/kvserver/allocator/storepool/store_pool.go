@@ -577,6 +577,12 @@ func (sp *StorePool) UpdateLocalStoreAfterRebalance(
 				detail.Desc.Capacity.CPUPerSecond -= rangeUsageInfo.RaftCPUNanosPerSecond
 			}
 		}
+	case roachpb.ADD_WITNESS:
+		// Witnesses don't store user data or serve requests, so they only
+		// contribute to the store's range count.
+		detail.Desc.Capacity.RangeCount++
+	case roachpb.REMOVE_WITNESS:
+		detail.Desc.Capacity.RangeCount--
 	default:
 		return
 	}
@@ -319,6 +319,7 @@ func TestAllocatorRebalanceTarget(t *testing.T) {
 			status,
 			replicas,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -345,6 +346,7 @@ func TestAllocatorRebalanceTarget(t *testing.T) {
 			status,
 			replicas,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -365,6 +367,7 @@ func TestAllocatorRebalanceTarget(t *testing.T) {
 			status,
 			replicas,
 			nil,
+			nil, /* existingWitnesses */
 			rangeUsageInfo,
 			storepool.StoreFilterThrottled,
 			a.ScorerOptions(ctx),
@@ -389,14 +392,14 @@ func TestAllocatorThrottled(t *testing.T) {
 	defer stopper.Stop(ctx)
 
 	// First test to make sure we would send the replica to purgatory.
-	_, _, err := a.AllocateVoter(ctx, sp, simpleSpanConfig, []roachpb.ReplicaDescriptor{}, nil, nil, allocatorimpl.Dead)
+	_, _, err := a.AllocateVoter(ctx, sp, simpleSpanConfig, []roachpb.ReplicaDescriptor{}, nil, nil /* existingWitnesses */, nil, allocatorimpl.Dead)
 	if _, ok := IsPurgatoryError(err); !ok {
 		t.Fatalf("expected a purgatory error, got: %+v", err)
 	}
 
 	// Second, test the normal case in which we can allocate to the store.
 	gossiputil.NewStoreGossiper(g).GossipStores(singleStore, t)
-	result, _, err := a.AllocateVoter(ctx, sp, simpleSpanConfig, []roachpb.ReplicaDescriptor{}, nil, nil, allocatorimpl.Dead)
+	result, _, err := a.AllocateVoter(ctx, sp, simpleSpanConfig, []roachpb.ReplicaDescriptor{}, nil, nil /* existingWitnesses */, nil, allocatorimpl.Dead)
 	if err != nil {
 		t.Fatalf("unable to perform allocation: %+v", err)
 	}
@@ -413,7 +416,7 @@ func TestAllocatorThrottled(t *testing.T) {
 	storeDetail.Lock()
 	storeDetail.ThrottledUntil = hlc.Timestamp{WallTime: timeutil.Now().Add(24 * time.Hour).UnixNano()}
 	storeDetail.Unlock()
-	_, _, err = a.AllocateVoter(ctx, sp, simpleSpanConfig, []roachpb.ReplicaDescriptor{}, nil, nil, allocatorimpl.Dead)
+	_, _, err = a.AllocateVoter(ctx, sp, simpleSpanConfig, []roachpb.ReplicaDescriptor{}, nil, nil /* existingWitnesses */, nil, allocatorimpl.Dead)
 	if _, ok := IsPurgatoryError(err); ok {
 		t.Fatalf("expected a non purgatory error, got: %+v", err)
 	}
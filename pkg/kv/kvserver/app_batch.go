@@ -73,6 +73,11 @@ func (s *appBatchStats) merge(ss appBatchStats) {
 // [^1]: https://github.com/cockroachdb/cockroach/issues/75729
 type appBatch struct {
 	appBatchStats
+	// witness is set if the replica applying the batch is a WITNESS. Witnesses
+	// take part in replication but don't store user keys, so writes to them
+	// are dropped when staging WriteBatches and AddSSTable ingestions are
+	// skipped.
+	witness bool
 	// TODO(tbg): this will absorb the following fields from replicaAppBatch:
 	//
 	// - batch
@@ -142,6 +147,12 @@ func (b *appBatch) addWriteBatch(
 	} else {
 		b.numMutations += mutations
 	}
+	if b.witness {
+		if err := stageWitnessWriteBatch(batch, wb.Data); err != nil {
+			return errors.Wrapf(err, "unable to apply WriteBatch on witness")
+		}
+		return nil
+	}
 	if err := batch.ApplyBatchRepr(wb.Data, false); err != nil {
 		return errors.Wrapf(err, "unable to apply WriteBatch")
 	}
@@ -173,7 +184,7 @@ func (b *appBatch) runPostAddTriggers(
 	// NB: any command which has an AddSSTable is non-trivial and will be
 	// applied in its own batch so it's not possible that any other commands
 	// which precede this command can shadow writes from this SSTable.
-	if res.AddSSTable != nil {
+	if res.AddSSTable != nil && !b.witness {
 		copied := addSSTablePreApply(
 			ctx,
 			env,
@@ -194,13 +205,15 @@ func (b *appBatch) runPostAddTriggers(
 			b.numMutations += int(added)
 		}
 	}
-	if res.LinkExternalSSTable != nil {
-		linkExternalSStablePreApply(
+	if res.LinkExternalSSTable != nil && !b.witness {
+		if err := linkExternalSStablePreApply(
 			ctx,
 			env,
 			kvpb.RaftTerm(cmd.Term),
 			cmd.Index(),
-			*res.LinkExternalSSTable)
+			*res.LinkExternalSSTable); err != nil {
+			return err
+		}
 	}
 
 	if res.Excise != nil {
@@ -0,0 +1,295 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package clustertrace
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/liveness/livenesspb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
+	"github.com/cockroachdb/errors"
+)
+
+// The files of a debug zip that a trace is loaded from, relative to the
+// debug directory at the root of the zip.
+const (
+	debugDir      = "debug"
+	nodesFile     = "nodes.json"
+	rangesPattern = "nodes/*/ranges.json"
+	// Debug zips of older versions wrote one file per range.
+	perRangePattern = "nodes/*/ranges/*.json"
+)
+
+// nodesResponse mirrors the parts of the serverpb.NodesResponse written to
+// nodes.json that a trace is loaded from.
+type nodesResponse struct {
+	Nodes []struct {
+		Desc struct {
+			NodeID   roachpb.NodeID   `json:"node_id"`
+			Locality roachpb.Locality `json:"locality"`
+		} `json:"desc"`
+		StoreStatuses []struct {
+			Desc struct {
+				StoreID  roachpb.StoreID `json:"store_id"`
+				Capacity struct {
+					Capacity         int64   `json:"capacity"`
+					QueriesPerSecond float64 `json:"queries_per_second"`
+					CPUPerSecond     float64 `json:"cpu_per_second"`
+				} `json:"capacity"`
+				NodeCapacity struct {
+					NodeCPURateCapacity int64 `json:"node_cpu_rate_capacity"`
+				} `json:"node_capacity"`
+			} `json:"desc"`
+		} `json:"store_statuses"`
+		NumCpus int32 `json:"num_cpus"`
+	} `json:"nodes"`
+	LivenessByNodeID map[roachpb.NodeID]livenesspb.NodeLivenessStatus `json:"liveness_by_node_id"`
+}
+
+// rangeInfo mirrors the parts of the serverpb.RangeInfo written to
+// ranges.json that a trace is loaded from. Each rangeInfo describes a single
+// replica of a range.
+type rangeInfo struct {
+	State struct {
+		State struct {
+			Desc  *roachpb.RangeDescriptor `json:"desc"`
+			Lease *roachpb.Lease           `json:"lease"`
+			Stats *enginepb.MVCCStats      `json:"stats"`
+		} `json:"state"`
+	} `json:"state"`
+	SourceStoreID roachpb.StoreID `json:"source_store_id"`
+	Stats         struct {
+		QueriesPerSecond    float64 `json:"queries_per_second"`
+		WritesPerSecond     float64 `json:"writes_per_second"`
+		ReadsPerSecond      float64 `json:"reads_per_second"`
+		WriteBytesPerSecond float64 `json:"write_bytes_per_second"`
+		ReadBytesPerSecond  float64 `json:"read_bytes_per_second"`
+		CPUTimePerSecond    float64 `json:"cpu_time_per_second"`
+	} `json:"stats"`
+	IsLeaseholder bool `json:"is_leaseholder"`
+}
+
+// LoadDebugZip loads a trace from the debug zip at the given path, which is
+// either the zip file or the directory it was extracted to. The debug zip
+// must have been collected with range information included.
+//
+// The nodes and stores of the trace are loaded from nodes.json, omitting
+// decommissioned nodes. The ranges and their load are loaded from the
+// ranges.json of each node: the descriptor of a range is taken from the
+// replica with the most recent descriptor, and its load from the leaseholder.
+// The CPU time of the followers is taken to be the cost of replicating the
+// range's writes, and is subtracted from the leaseholder's CPU time to obtain
+// the cost of evaluating the range's requests.
+//
+// The load of the ranges is not taken from the hot ranges report of the debug
+// zip (hot-ranges.json), which only includes the busiest ranges of each store.
+// Every range of the trace therefore carries the load reported for it in
+// ranges.json, however small.
+func LoadDebugZip(name string) (Trace, error) {
+	var fsys fs.FS
+	if info, err := os.Stat(name); err != nil {
+		return Trace{}, err
+	} else if info.IsDir() {
+		fsys = os.DirFS(name)
+	} else {
+		r, err := zip.OpenReader(name)
+		if err != nil {
+			return Trace{}, errors.Wrapf(err, "opening %s", name)
+		}
+		defer func() { _ = r.Close() }()
+		fsys = r
+	}
+	t, err := loadDebugZipFS(fsys)
+	return t, errors.Wrapf(err, "loading debug zip %s", name)
+}
+
+func loadDebugZipFS(fsys fs.FS) (Trace, error) {
+	if _, err := fs.Stat(fsys, path.Join(debugDir, nodesFile)); err == nil {
+		var err error
+		if fsys, err = fs.Sub(fsys, debugDir); err != nil {
+			return Trace{}, err
+		}
+	}
+
+	var nodes nodesResponse
+	if err := readJSON(fsys, nodesFile, &nodes); err != nil {
+		return Trace{}, err
+	}
+	var t Trace
+	for _, ns := range nodes.Nodes {
+		if nodes.LivenessByNodeID[ns.Desc.NodeID] == livenesspb.NodeLivenessStatus_DECOMMISSIONED {
+			continue
+		}
+		n := Node{
+			NodeID:               ns.Desc.NodeID,
+			Locality:             ns.Desc.Locality,
+			CPURateCapacityNanos: int64(ns.NumCpus) * 1e9,
+		}
+		for _, ss := range ns.StoreStatuses {
+			if c := ss.Desc.NodeCapacity.NodeCPURateCapacity; c > 0 {
+				n.CPURateCapacityNanos = c
+			}
+			n.Stores = append(n.Stores, Store{
+				StoreID:           ss.Desc.StoreID,
+				CapacityBytes:     ss.Desc.Capacity.Capacity,
+				QueriesPerSecond:  ss.Desc.Capacity.QueriesPerSecond,
+				CPUNanosPerSecond: ss.Desc.Capacity.CPUPerSecond,
+			})
+		}
+		t.Nodes = append(t.Nodes, n)
+	}
+
+	replicas := map[roachpb.RangeID][]rangeInfo{}
+	files, err := fs.Glob(fsys, rangesPattern)
+	if err != nil {
+		return Trace{}, err
+	}
+	for _, file := range files {
+		var infos []rangeInfo
+		if err := readJSON(fsys, file, &infos); err != nil {
+			return Trace{}, err
+		}
+		for _, info := range infos {
+			if desc := info.State.State.Desc; desc != nil {
+				replicas[desc.RangeID] = append(replicas[desc.RangeID], info)
+			}
+		}
+	}
+	if files, err = fs.Glob(fsys, perRangePattern); err != nil {
+		return Trace{}, err
+	}
+	for _, file := range files {
+		var info rangeInfo
+		if err := readJSON(fsys, file, &info); err != nil {
+			return Trace{}, err
+		}
+		if desc := info.State.State.Desc; desc != nil {
+			replicas[desc.RangeID] = append(replicas[desc.RangeID], info)
+		}
+	}
+	if len(replicas) == 0 {
+		return Trace{}, errors.New(
+			"no range information found; was the debug zip collected with --include-range-info?")
+	}
+	for _, infos := range replicas {
+		t.Ranges = append(t.Ranges, makeRange(infos))
+	}
+
+	t.normalize()
+	if err := t.Validate(); err != nil {
+		return Trace{}, err
+	}
+	return t, nil
+}
+
+// makeRange combines the information reported by the replicas of a range.
+func makeRange(infos []rangeInfo) Range {
+	// Use the most recent descriptor and lease known to any replica.
+	latest := infos[0]
+	var lease *roachpb.Lease
+	for _, info := range infos {
+		if info.State.State.Desc.Generation > latest.State.State.Desc.Generation {
+			latest = info
+		}
+		if l := info.State.State.Lease; l != nil && (lease == nil || l.Sequence > lease.Sequence) {
+			lease = l
+		}
+	}
+	desc := latest.State.State.Desc
+	r := Range{
+		RangeID:  desc.RangeID,
+		StartKey: desc.StartKey,
+	}
+	for _, repl := range desc.InternalReplicas {
+		switch repl.Type {
+		case roachpb.VOTER_FULL, roachpb.VOTER_INCOMING:
+			repl.Type = roachpb.VOTER_FULL
+		case roachpb.NON_VOTER, roachpb.VOTER_DEMOTING_NON_VOTER:
+			repl.Type = roachpb.NON_VOTER
+		default:
+			continue
+		}
+		r.Replicas = append(r.Replicas, repl)
+	}
+
+	// Find the leaseholder's report, which carries the load of the range. If
+	// no replica claims to be the leaseholder, use the busiest replica.
+	lhIdx := -1
+	for i, info := range infos {
+		if info.IsLeaseholder || (lease != nil && info.SourceStoreID == lease.Replica.StoreID) {
+			lhIdx = i
+			break
+		}
+	}
+	if lhIdx == -1 {
+		for i, info := range infos {
+			if lhIdx == -1 || info.Stats.QueriesPerSecond > infos[lhIdx].Stats.QueriesPerSecond {
+				lhIdx = i
+			}
+		}
+	}
+	lh := infos[lhIdx]
+	isVoter := func(storeID roachpb.StoreID) bool {
+		repl, ok := r.replica(storeID)
+		return ok && repl.Type == roachpb.VOTER_FULL
+	}
+	if isVoter(lh.SourceStoreID) {
+		r.Leaseholder = lh.SourceStoreID
+	} else if lease != nil && isVoter(lease.Replica.StoreID) {
+		r.Leaseholder = lease.Replica.StoreID
+	} else {
+		for _, repl := range r.Replicas {
+			if repl.Type == roachpb.VOTER_FULL {
+				r.Leaseholder = repl.StoreID
+				break
+			}
+		}
+	}
+	if stats := lh.State.State.Stats; stats != nil {
+		r.LogicalBytes = stats.Total()
+	} else if stats := latest.State.State.Stats; stats != nil {
+		r.LogicalBytes = stats.Total()
+	}
+
+	var raftCPU float64
+	var followers int
+	for i, info := range infos {
+		if i == lhIdx {
+			continue
+		}
+		raftCPU += info.Stats.CPUTimePerSecond
+		followers++
+	}
+	if followers > 0 {
+		raftCPU /= float64(followers)
+	}
+	r.Load = RangeLoad{
+		QueriesPerSecond:         lh.Stats.QueriesPerSecond,
+		ReadsPerSecond:           lh.Stats.ReadsPerSecond,
+		WritesPerSecond:          lh.Stats.WritesPerSecond,
+		ReadBytesPerSecond:       lh.Stats.ReadBytesPerSecond,
+		WriteBytesPerSecond:      lh.Stats.WriteBytesPerSecond,
+		RequestCPUNanosPerSecond: max(lh.Stats.CPUTimePerSecond-raftCPU, 0),
+		RaftCPUNanosPerSecond:    min(raftCPU, lh.Stats.CPUTimePerSecond),
+	}
+	return r
+}
+
+func readJSON(fsys fs.FS, name string, v interface{}) error {
+	b, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errors.Wrapf(err, "decoding %s", name)
+	}
+	return nil
+}
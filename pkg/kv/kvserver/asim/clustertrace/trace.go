@@ -0,0 +1,212 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package clustertrace loads the state and load of a real cluster from the
+// artifacts collected by `cockroach debug zip` and `cockroach debug tsdump`,
+// so that the cluster can be replayed in the allocation simulator.
+package clustertrace
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/errors"
+)
+
+// Trace is the state and load of a cluster at the time its artifacts were
+// collected.
+type Trace struct {
+	// Nodes are the nodes of the cluster, ordered by node ID.
+	Nodes []Node
+	// Ranges are the ranges of the cluster, ordered by start key.
+	Ranges []Range
+}
+
+// Node is a node of a traced cluster.
+type Node struct {
+	NodeID   roachpb.NodeID
+	Locality roachpb.Locality
+	// CPURateCapacityNanos is the CPU capacity of the node, in nanoseconds of
+	// CPU time per second. It is zero if unknown.
+	CPURateCapacityNanos int64
+	// Stores are the stores of the node, ordered by store ID.
+	Stores []Store
+}
+
+// Store is a store of a traced cluster.
+type Store struct {
+	StoreID roachpb.StoreID
+	// CapacityBytes is the disk capacity of the store.
+	CapacityBytes int64
+	// QueriesPerSecond and CPUNanosPerSecond are the load on the store that
+	// was observed in the cluster. They are not replayed, but are useful to
+	// compare against the load of the store in the simulation.
+	QueriesPerSecond  float64
+	CPUNanosPerSecond float64
+}
+
+// Range is a range of a traced cluster.
+type Range struct {
+	RangeID  roachpb.RangeID
+	StartKey roachpb.RKey
+	// Replicas are the replicas of the range. Only voters and non-voters are
+	// included; replicas in the midst of a replication change are included as
+	// the type they are becoming, and learners are omitted.
+	Replicas []roachpb.ReplicaDescriptor
+	// Leaseholder is the store holding the range's lease. If the leaseholder
+	// is unknown, this is the store of the range's first voter.
+	Leaseholder roachpb.StoreID
+	// LogicalBytes is the size of the range's MVCC data.
+	LogicalBytes int64
+	Load         RangeLoad
+}
+
+// NumVoters returns the number of voting replicas of the range.
+func (r Range) NumVoters() int {
+	var n int
+	for _, repl := range r.Replicas {
+		if repl.Type == roachpb.VOTER_FULL {
+			n++
+		}
+	}
+	return n
+}
+
+// RangeLoad is the load on a range, averaged over the period preceding the
+// collection of the trace.
+type RangeLoad struct {
+	// QueriesPerSecond is the number of batch requests served per second.
+	QueriesPerSecond float64
+	// ReadsPerSecond and WritesPerSecond are the number of keys read and
+	// written per second.
+	ReadsPerSecond  float64
+	WritesPerSecond float64
+	// ReadBytesPerSecond and WriteBytesPerSecond are the number of bytes read
+	// and written per second.
+	ReadBytesPerSecond  float64
+	WriteBytesPerSecond float64
+	// RequestCPUNanosPerSecond is the CPU time spent evaluating requests on the
+	// leaseholder, and RaftCPUNanosPerSecond the CPU time spent replicating
+	// writes on each replica.
+	RequestCPUNanosPerSecond float64
+	RaftCPUNanosPerSecond    float64
+}
+
+// Add returns the sum of the two range loads.
+func (l RangeLoad) Add(o RangeLoad) RangeLoad {
+	return RangeLoad{
+		QueriesPerSecond:         l.QueriesPerSecond + o.QueriesPerSecond,
+		ReadsPerSecond:           l.ReadsPerSecond + o.ReadsPerSecond,
+		WritesPerSecond:          l.WritesPerSecond + o.WritesPerSecond,
+		ReadBytesPerSecond:       l.ReadBytesPerSecond + o.ReadBytesPerSecond,
+		WriteBytesPerSecond:      l.WriteBytesPerSecond + o.WriteBytesPerSecond,
+		RequestCPUNanosPerSecond: l.RequestCPUNanosPerSecond + o.RequestCPUNanosPerSecond,
+		RaftCPUNanosPerSecond:    l.RaftCPUNanosPerSecond + o.RaftCPUNanosPerSecond,
+	}
+}
+
+// Stores returns the stores of the trace in the order of their nodes.
+func (t *Trace) Stores() []Store {
+	var stores []Store
+	for _, n := range t.Nodes {
+		stores = append(stores, n.Stores...)
+	}
+	return stores
+}
+
+// TotalLoad returns the sum of the load on all ranges of the trace.
+func (t *Trace) TotalLoad() RangeLoad {
+	var total RangeLoad
+	for _, r := range t.Ranges {
+		total = total.Add(r.Load)
+	}
+	return total
+}
+
+// TotalBytes returns the sum of the logical bytes of all ranges of the trace.
+func (t *Trace) TotalBytes() int64 {
+	var total int64
+	for _, r := range t.Ranges {
+		total += r.LogicalBytes
+	}
+	return total
+}
+
+// Validate returns an error if the trace cannot be replayed: when it has no
+// nodes or ranges, when a range has a replica on an unknown store, or when a
+// range's lease is not held by one of its voters.
+func (t *Trace) Validate() error {
+	if len(t.Nodes) == 0 {
+		return errors.New("trace has no nodes")
+	}
+	if len(t.Ranges) == 0 {
+		return errors.New("trace has no ranges")
+	}
+	stores := map[roachpb.StoreID]struct{}{}
+	for _, s := range t.Stores() {
+		if _, ok := stores[s.StoreID]; ok {
+			return errors.Errorf("duplicate store s%d", s.StoreID)
+		}
+		stores[s.StoreID] = struct{}{}
+	}
+	for _, r := range t.Ranges {
+		if len(r.Replicas) == 0 {
+			return errors.Errorf("r%d has no replicas", r.RangeID)
+		}
+		for _, repl := range r.Replicas {
+			if _, ok := stores[repl.StoreID]; !ok {
+				return errors.Errorf("r%d has a replica on unknown store s%d", r.RangeID, repl.StoreID)
+			}
+		}
+		if r.NumVoters() == 0 {
+			return errors.Errorf("r%d has no voters", r.RangeID)
+		}
+		if repl, ok := r.replica(r.Leaseholder); !ok || repl.Type != roachpb.VOTER_FULL {
+			return errors.Errorf("r%d has its lease on s%d, which has no voter", r.RangeID, r.Leaseholder)
+		}
+	}
+	return nil
+}
+
+func (r Range) replica(storeID roachpb.StoreID) (roachpb.ReplicaDescriptor, bool) {
+	for _, repl := range r.Replicas {
+		if repl.StoreID == storeID {
+			return repl, true
+		}
+	}
+	return roachpb.ReplicaDescriptor{}, false
+}
+
+// normalize sorts the nodes, stores and ranges of the trace.
+func (t *Trace) normalize() {
+	sort.Slice(t.Nodes, func(i, j int) bool {
+		return t.Nodes[i].NodeID < t.Nodes[j].NodeID
+	})
+	for _, n := range t.Nodes {
+		sort.Slice(n.Stores, func(i, j int) bool {
+			return n.Stores[i].StoreID < n.Stores[j].StoreID
+		})
+	}
+	// NB: redacted debug zips carry no keys, in which case the ranges are
+	// ordered by range ID.
+	sort.Slice(t.Ranges, func(i, j int) bool {
+		if c := t.Ranges[i].StartKey.Compare(t.Ranges[j].StartKey); c != 0 {
+			return c < 0
+		}
+		return t.Ranges[i].RangeID < t.Ranges[j].RangeID
+	})
+}
+
+// String returns a summary of the trace.
+func (t *Trace) String() string {
+	var buf strings.Builder
+	load := t.TotalLoad()
+	_, _ = fmt.Fprintf(&buf, "nodes=%d stores=%d ranges=%d bytes=%dMiB qps=%.0f request_cpu=%.2fvcpu",
+		len(t.Nodes), len(t.Stores()), len(t.Ranges), t.TotalBytes()>>20, load.QueriesPerSecond,
+		load.RequestCPUNanosPerSecond/1e9)
+	return buf.String()
+}
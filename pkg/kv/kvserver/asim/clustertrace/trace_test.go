@@ -0,0 +1,241 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package clustertrace
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/testutils/datapathutils"
+	"github.com/stretchr/testify/require"
+)
+
+func locality(zone string) roachpb.Locality {
+	return roachpb.Locality{Tiers: []roachpb.Tier{
+		{Key: "region", Value: "us-east1"},
+		{Key: "zone", Value: zone},
+	}}
+}
+
+func replicas(types ...roachpb.ReplicaType) []roachpb.ReplicaDescriptor {
+	var repls []roachpb.ReplicaDescriptor
+	for i, typ := range types {
+		repls = append(repls, roachpb.ReplicaDescriptor{
+			NodeID:    roachpb.NodeID(i + 1),
+			StoreID:   roachpb.StoreID(i + 1),
+			ReplicaID: roachpb.ReplicaID(i + 1),
+			Type:      typ,
+		})
+	}
+	return repls
+}
+
+// expectedTrace is the trace of the debug zip in testdata/debugzip. The
+// decommissioned n4 is omitted. r2 is reported with a stale descriptor and a
+// stale lease by some of its replicas, and r3 has a learner, which is omitted.
+var expectedTrace = Trace{
+	Nodes: []Node{
+		{NodeID: 1, Locality: locality("a"), CPURateCapacityNanos: 8e9, Stores: []Store{
+			{StoreID: 1, CapacityBytes: 100 << 30, QueriesPerSecond: 150, CPUNanosPerSecond: 4.5e6},
+		}},
+		{NodeID: 2, Locality: locality("b"), CPURateCapacityNanos: 16e9, Stores: []Store{
+			{StoreID: 2, CapacityBytes: 100 << 30, CPUNanosPerSecond: 1.4e6},
+		}},
+		{NodeID: 3, Locality: locality("c"), CPURateCapacityNanos: 8e9, Stores: []Store{
+			{StoreID: 3, CapacityBytes: 200 << 30, QueriesPerSecond: 50, CPUNanosPerSecond: 1.2e6},
+		}},
+	},
+	Ranges: []Range{
+		{
+			RangeID:      1,
+			Replicas:     replicas(roachpb.VOTER_FULL, roachpb.VOTER_FULL, roachpb.VOTER_FULL),
+			Leaseholder:  1,
+			LogicalBytes: 4 << 20,
+			Load: RangeLoad{
+				QueriesPerSecond:         100,
+				ReadsPerSecond:           300,
+				WritesPerSecond:          100,
+				ReadBytesPerSecond:       10000,
+				WriteBytesPerSecond:      5000,
+				RequestCPUNanosPerSecond: 1.5e6,
+				RaftCPUNanosPerSecond:    5e5,
+			},
+		},
+		{
+			RangeID:      2,
+			StartKey:     roachpb.RKey("m"),
+			Replicas:     replicas(roachpb.NON_VOTER, roachpb.VOTER_FULL, roachpb.VOTER_FULL),
+			Leaseholder:  3,
+			LogicalBytes: 2 << 20,
+			Load: RangeLoad{
+				QueriesPerSecond:         50,
+				WritesPerSecond:          200,
+				WriteBytesPerSecond:      20000,
+				RequestCPUNanosPerSecond: 7e5,
+				RaftCPUNanosPerSecond:    3e5,
+			},
+		},
+		{
+			RangeID:      3,
+			StartKey:     roachpb.RKey("x"),
+			Replicas:     replicas(roachpb.VOTER_FULL, roachpb.VOTER_FULL),
+			Leaseholder:  2,
+			LogicalBytes: 1 << 20,
+			Load: RangeLoad{
+				QueriesPerSecond:      20,
+				ReadsPerSecond:        40,
+				ReadBytesPerSecond:    800,
+				RaftCPUNanosPerSecond: 1e6,
+			},
+		},
+	},
+}
+
+func TestLoadDebugZip(t *testing.T) {
+	dir := datapathutils.TestDataPath(t, "debugzip")
+
+	t.Run("dir", func(t *testing.T) {
+		trace, err := LoadDebugZip(dir)
+		require.NoError(t, err)
+		require.Equal(t, expectedTrace, trace)
+		require.Equal(t,
+			"nodes=3 stores=3 ranges=3 bytes=7MiB qps=170 request_cpu=0.00vcpu", trace.String())
+	})
+
+	t.Run("zip", func(t *testing.T) {
+		name := filepath.Join(t.TempDir(), "debug.zip")
+		f, err := os.Create(name)
+		require.NoError(t, err)
+		w := zip.NewWriter(f)
+		require.NoError(t, w.AddFS(os.DirFS(dir)))
+		require.NoError(t, w.Close())
+		require.NoError(t, f.Close())
+
+		trace, err := LoadDebugZip(name)
+		require.NoError(t, err)
+		require.Equal(t, expectedTrace, trace)
+	})
+
+	t.Run("no ranges", func(t *testing.T) {
+		tmp := t.TempDir()
+		b, err := os.ReadFile(filepath.Join(dir, debugDir, nodesFile))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, nodesFile), b, 0644))
+		_, err = LoadDebugZip(tmp)
+		require.ErrorContains(t, err, "--include-range-info")
+	})
+}
+
+func TestApplyTSDump(t *testing.T) {
+	const tsdump = `cr.store.capacity,2026-01-01T00:00:00Z,1,1000
+cr.store.capacity,2026-01-01T00:10:00Z,1,2000
+cr.store.capacity,2026-01-01T00:20:00Z,1,3000
+cr.store.capacity,2026-01-01T00:10:00Z,1-2,9999
+cr.store.rebalancing.queriespersecond,2026-01-01T00:00:00Z,2,10
+cr.store.rebalancing.cpunanospersecond,2026-01-01T00:10:00Z,3,5e6
+cr.store.rebalancing.cpunanospersecond,2026-01-01T00:10:00Z,4,6e6
+cr.node.sys.cpu.user.ns,2026-01-01T00:10:00Z,1,7e6
+`
+	newTrace := func() Trace {
+		return Trace{Nodes: []Node{
+			{NodeID: 1, Stores: []Store{{StoreID: 1}, {StoreID: 2}}},
+			{NodeID: 2, Stores: []Store{{StoreID: 3}}},
+		}}
+	}
+
+	testCases := []struct {
+		desc     string
+		at       time.Time
+		expected []Store
+	}{
+		{
+			desc: "last",
+			expected: []Store{
+				{StoreID: 1, CapacityBytes: 3000},
+				{StoreID: 2, QueriesPerSecond: 10},
+				{StoreID: 3, CPUNanosPerSecond: 5e6},
+			},
+		},
+		{
+			desc: "at",
+			at:   time.Date(2026, 1, 1, 0, 15, 0, 0, time.UTC),
+			expected: []Store{
+				{StoreID: 1, CapacityBytes: 2000},
+				{StoreID: 2, QueriesPerSecond: 10},
+				{StoreID: 3, CPUNanosPerSecond: 5e6},
+			},
+		},
+		{
+			desc: "before",
+			at:   time.Date(2026, 1, 1, 0, 5, 0, 0, time.UTC),
+			expected: []Store{
+				{StoreID: 1, CapacityBytes: 1000},
+				{StoreID: 2, QueriesPerSecond: 10},
+				{StoreID: 3},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			trace := newTrace()
+			require.NoError(t, trace.ApplyTSDump(strings.NewReader(tsdump), tc.at))
+			require.Equal(t, tc.expected, trace.Stores())
+		})
+	}
+
+	t.Run("malformed", func(t *testing.T) {
+		trace := newTrace()
+		err := trace.ApplyTSDump(strings.NewReader("cr.store.capacity,yesterday,1,1000\n"), time.Time{})
+		require.ErrorContains(t, err, "parsing timestamp of cr.store.capacity")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		mutate   func(*Trace)
+		expected string
+	}{
+		{
+			desc:   "valid",
+			mutate: func(*Trace) {},
+		},
+		{
+			desc:     "unknown store",
+			mutate:   func(t *Trace) { t.Nodes = t.Nodes[:2] },
+			expected: "r1 has a replica on unknown store s3",
+		},
+		{
+			desc:     "lease on non-voter",
+			mutate:   func(t *Trace) { t.Ranges[1].Leaseholder = 1 },
+			expected: "r2 has its lease on s1, which has no voter",
+		},
+		{
+			desc:     "no ranges",
+			mutate:   func(t *Trace) { t.Ranges = nil },
+			expected: "trace has no ranges",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			trace := expectedTrace
+			trace.Nodes = append([]Node(nil), expectedTrace.Nodes...)
+			trace.Ranges = append([]Range(nil), expectedTrace.Ranges...)
+			tc.mutate(&trace)
+			err := trace.Validate()
+			if tc.expected == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package clustertrace
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/errors"
+)
+
+// The store time series of a tsdump that are applied to a trace.
+const (
+	storeCapacityMetric = "cr.store.capacity"
+	storeQPSMetric      = "cr.store.rebalancing.queriespersecond"
+	storeCPUMetric      = "cr.store.rebalancing.cpunanospersecond"
+)
+
+// ApplyTSDump updates the stores of the trace using the time series in the
+// given tsdump, which must be in the CSV format written by `cockroach debug
+// tsdump --format=csv`. A raw tsdump can be converted to this format with
+// `cockroach debug tsdump --format=csv <file>`.
+//
+// For each store, the value of each of the following time series at the
+// given time is applied, where the value at a time is that of the last
+// datapoint at or before it. If the time is zero, the last datapoint is used.
+//
+//   - cr.store.capacity: the disk capacity of the store.
+//   - cr.store.rebalancing.queriespersecond: the QPS observed on the store.
+//   - cr.store.rebalancing.cpunanospersecond: the CPU observed on the store.
+//
+// Time series of stores that are not part of the trace are ignored.
+func (t *Trace) ApplyTSDump(r io.Reader, at time.Time) error {
+	type datapoint struct {
+		ts    time.Time
+		value float64
+	}
+	values := map[string]map[roachpb.StoreID]datapoint{}
+	for _, name := range []string{storeCapacityMetric, storeQPSMetric, storeCPUMetric} {
+		values[name] = map[roachpb.StoreID]datapoint{}
+	}
+
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 4
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "reading tsdump")
+		}
+		name, tsStr, source, valueStr := record[0], record[1], record[2], record[3]
+		byStore, ok := values[name]
+		if !ok {
+			continue
+		}
+		// Sources of secondary tenants are suffixed with the tenant ID. Only
+		// the system tenant's stores are of interest.
+		if strings.Contains(source, "-") {
+			continue
+		}
+		storeID, err := strconv.Atoi(source)
+		if err != nil {
+			return errors.Wrapf(err, "parsing source of %s", name)
+		}
+		ts, err := time.Parse(time.RFC3339, tsStr)
+		if err != nil {
+			return errors.Wrapf(err, "parsing timestamp of %s", name)
+		}
+		value, err := strconv.ParseFloat(valueStr, 64)
+		if err != nil {
+			return errors.Wrapf(err, "parsing value of %s", name)
+		}
+		if !at.IsZero() && ts.After(at) {
+			continue
+		}
+		if prev, ok := byStore[roachpb.StoreID(storeID)]; !ok || !ts.Before(prev.ts) {
+			byStore[roachpb.StoreID(storeID)] = datapoint{ts: ts, value: value}
+		}
+	}
+
+	for i := range t.Nodes {
+		for j := range t.Nodes[i].Stores {
+			s := &t.Nodes[i].Stores[j]
+			if dp, ok := values[storeCapacityMetric][s.StoreID]; ok {
+				s.CapacityBytes = int64(dp.value)
+			}
+			if dp, ok := values[storeQPSMetric][s.StoreID]; ok {
+				s.QueriesPerSecond = dp.value
+			}
+			if dp, ok := values[storeCPUMetric][s.StoreID]; ok {
+				s.CPUNanosPerSecond = dp.value
+			}
+		}
+	}
+	return nil
+}
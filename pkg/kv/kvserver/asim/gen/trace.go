@@ -0,0 +1,217 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/clustertrace"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/config"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/state"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/workload"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/util/humanizeutil"
+)
+
+// DefaultTraceKeysPerRange is the default number of simulated keys that each
+// range of a cluster trace spans.
+const DefaultTraceKeysPerRange = 1000
+
+// The nodes and stores of a cluster trace are added to the simulated cluster
+// in the order of the trace, so the simulated store IDs are assigned
+// sequentially in that order. The ranges of a trace are laid out in order
+// over the simulated keyspace, each spanning KeysPerRange keys.
+
+// traceStoreIDs returns the simulated store ID of each store of the trace.
+func traceStoreIDs(t *clustertrace.Trace) map[roachpb.StoreID]state.StoreID {
+	ids := map[roachpb.StoreID]state.StoreID{}
+	for i, s := range t.Stores() {
+		ids[s.StoreID] = state.StoreID(i + 1)
+	}
+	return ids
+}
+
+// traceRangeSpan returns the simulated span of the i-th range of a trace.
+func traceRangeSpan(i int, keysPerRange int64) (startKey, endKey int64) {
+	startKey = int64(state.MinKey) + int64(i)*keysPerRange
+	return startKey, startKey + keysPerRange
+}
+
+// TraceCluster implements the ClusterGen interface. It generates the nodes
+// and stores of a cluster trace, with their localities and capacities.
+type TraceCluster struct {
+	Trace *clustertrace.Trace
+}
+
+var _ ClusterGen = TraceCluster{}
+
+// Generate returns a new simulator state containing the nodes and stores of
+// the trace. Nodes and stores whose capacity is unknown are given the default
+// capacity. There is no randomness in this cluster generation.
+func (tc TraceCluster) Generate(seed int64, settings *config.SimulationSettings) state.State {
+	s := state.NewState(settings)
+	for _, n := range tc.Trace.Nodes {
+		cpuCapacity := n.CPURateCapacityNanos
+		if cpuCapacity == 0 {
+			cpuCapacity = config.DefaultNodeCPURateCapacityNanos
+		}
+		node := s.AddNode(cpuCapacity, n.Locality)
+		for _, ts := range n.Stores {
+			store, ok := s.AddStore(node.NodeID())
+			if !ok {
+				panic(fmt.Sprintf("unable to add store s%d of n%d", ts.StoreID, n.NodeID))
+			}
+			capacity := ts.CapacityBytes
+			if capacity == 0 {
+				capacity = config.DefaultStoreDiskCapacityBytes
+			}
+			s.SetStoreCapacity(store.StoreID(), capacity)
+		}
+	}
+	return s
+}
+
+// String returns a summary of the trace, along with the traced store that
+// each simulated store corresponds to.
+func (tc TraceCluster) String() string {
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "trace: %s\nstores:", tc.Trace)
+	ids := traceStoreIDs(tc.Trace)
+	for _, s := range tc.Trace.Stores() {
+		_, _ = fmt.Fprintf(&buf, " s%d=s%d", ids[s.StoreID], s.StoreID)
+	}
+	return buf.String()
+}
+
+// Regions returns the regions and zones of the trace, as given by the region
+// and zone tiers of the localities of its nodes. Nodes whose locality has no
+// region tier are omitted.
+func (tc TraceCluster) Regions() []state.Region {
+	var regions []state.Region
+	regionIdx := map[string]int{}
+	for _, n := range tc.Trace.Nodes {
+		region, ok := n.Locality.Find("region")
+		if !ok {
+			continue
+		}
+		zone, _ := n.Locality.Find("zone")
+		idx, ok := regionIdx[region]
+		if !ok {
+			idx = len(regions)
+			regionIdx[region] = idx
+			regions = append(regions, state.Region{Name: region})
+		}
+		r := &regions[idx]
+		zoneIdx := -1
+		for i := range r.Zones {
+			if r.Zones[i].Name == zone {
+				zoneIdx = i
+			}
+		}
+		if zoneIdx == -1 {
+			zoneIdx = len(r.Zones)
+			r.Zones = append(r.Zones, state.NewZone(zone, 0, max(len(n.Stores), 1)))
+		}
+		r.Zones[zoneIdx].NodeCount++
+	}
+	return regions
+}
+
+// TraceRanges implements the RangeGen interface. It generates the ranges of a
+// cluster trace, with their replicas, leaseholders and sizes.
+type TraceRanges struct {
+	Trace *clustertrace.Trace
+	// KeysPerRange is the number of simulated keys that each range spans.
+	KeysPerRange int64
+}
+
+var _ RangeGen = TraceRanges{}
+
+func (tr TraceRanges) String() string {
+	return fmt.Sprintf("[%d,%d): %d ranges from trace, %dMiB",
+		state.MinKey, int64(state.MinKey)+int64(len(tr.Trace.Ranges))*tr.KeysPerRange,
+		len(tr.Trace.Ranges), tr.Trace.TotalBytes()>>20)
+}
+
+// Generate returns an updated simulator state, where the ranges of the trace
+// are loaded into the cluster generated by TraceCluster. The span config of
+// each range requires its number of voters and non-voters in the trace; the
+// constraints of the traced ranges are not known. There is no randomness in
+// this range generation.
+func (tr TraceRanges) Generate(
+	tag string, seed int64, settings *config.SimulationSettings, s state.State,
+) (state.State, string) {
+	ids := traceStoreIDs(tr.Trace)
+	rangesInfo := make(state.RangesInfo, len(tr.Trace.Ranges))
+	for i, r := range tr.Trace.Ranges {
+		var voters, nonVoters []state.StoreID
+		for _, repl := range r.Replicas {
+			if repl.Type == roachpb.VOTER_FULL {
+				voters = append(voters, ids[repl.StoreID])
+			} else {
+				nonVoters = append(nonVoters, ids[repl.StoreID])
+			}
+		}
+		conf := state.DefaultSpanConfigWithRF(len(voters))
+		conf.NumReplicas = int32(len(voters) + len(nonVoters))
+		startKey, _ := traceRangeSpan(i, tr.KeysPerRange)
+		rangesInfo[i] = state.RangeInfoWithReplicas(
+			state.Key(startKey), voters, nonVoters, ids[r.Leaseholder], &conf)
+		rangesInfo[i].Size = r.LogicalBytes
+	}
+	state.LoadRangeInfo(s, rangesInfo...)
+	return s, fmt.Sprintf("%s%s", tag, tr)
+}
+
+// TraceLoad implements the LoadGen interface. It generates the load of each
+// range of a cluster trace on the range's simulated span.
+//
+// The simulator counts each key accessed as a query. So that the QPS of each
+// simulated range matches the QPS of the traced range, the traced QPS is split
+// into reads and writes in the proportion of the keys read and written by the
+// traced range.
+type TraceLoad struct {
+	Trace *clustertrace.Trace
+	// KeysPerRange is the number of simulated keys that each range spans.
+	KeysPerRange int64
+}
+
+var _ LoadGen = TraceLoad{}
+
+// StringWithTag returns a summary of the load of the trace.
+func (tl TraceLoad) StringWithTag(tag string) string {
+	load := tl.Trace.TotalLoad()
+	return fmt.Sprintf("%s[%d,%d): trace replay [%.0fops/s, %.2f request-vcpus, %.2f raft-vcpus/replica, %s/s written]",
+		tag, state.MinKey, int64(state.MinKey)+int64(len(tl.Trace.Ranges))*tl.KeysPerRange,
+		load.QueriesPerSecond, load.RequestCPUNanosPerSecond/1e9, load.RaftCPUNanosPerSecond/1e9,
+		humanizeutil.IBytes(int64(load.WriteBytesPerSecond)))
+}
+
+// Generate returns a workload generator replaying the load of each range of
+// the trace, seeded with the provided seed.
+func (tl TraceLoad) Generate(seed int64, settings *config.SimulationSettings) []workload.Generator {
+	loads := make([]workload.SpanLoad, len(tl.Trace.Ranges))
+	for i, r := range tl.Trace.Ranges {
+		l := r.Load
+		var writeFraction float64
+		if keys := l.ReadsPerSecond + l.WritesPerSecond; keys > 0 {
+			writeFraction = l.WritesPerSecond / keys
+		}
+		startKey, endKey := traceRangeSpan(i, tl.KeysPerRange)
+		loads[i] = workload.SpanLoad{
+			StartKey:            startKey,
+			EndKey:              endKey,
+			ReadsPerSecond:      l.QueriesPerSecond * (1 - writeFraction),
+			WritesPerSecond:     l.QueriesPerSecond * writeFraction,
+			ReadBytesPerSecond:  l.ReadBytesPerSecond,
+			WriteBytesPerSecond: l.WriteBytesPerSecond,
+			RequestCPUPerSecond: l.RequestCPUNanosPerSecond,
+			RaftCPUPerSecond:    l.RaftCPUNanosPerSecond,
+		}
+	}
+	return []workload.Generator{workload.NewReplayGenerator(settings.StartTime, seed, loads)}
+}
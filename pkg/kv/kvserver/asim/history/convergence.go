@@ -0,0 +1,80 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/montanaflynn/stats"
+)
+
+// Convergence measures how quickly the stores of a simulation run converged
+// to a balanced value of a stat. The imbalance of the stat at a tick is its
+// max/mean over the stores.
+type Convergence struct {
+	// Initial and Final are the imbalance at the first tick at which the stat
+	// is nonzero on any store, and at the last tick.
+	Initial, Final float64
+	// Tick is the first tick from which the imbalance stayed at or below the
+	// threshold for the rest of the run, or -1 if the run did not converge.
+	Tick int
+	// Ticks is the number of ticks in the run.
+	Ticks int
+}
+
+// imbalance returns the max/mean of the values, and false if all of the values
+// are zero.
+func imbalance(values []float64) (float64, bool) {
+	mean, _ := stats.Mean(values)
+	if mean == 0 {
+		return 0, false
+	}
+	max, _ := stats.Max(values)
+	return max / mean, true
+}
+
+// ConvergenceForStat returns the convergence of the given stat to an
+// imbalance at or below the given threshold. Ticks at which the stat is zero
+// on every store are ignored, e.g. the ticks before load is first recorded. If
+// the stat is zero throughout, the run converged at the first tick.
+func (h *History) ConvergenceForStat(stat string, threshold float64) Convergence {
+	c := Convergence{Tick: -1, Ticks: len(h.Recorded)}
+	seen := false
+	for tick := range h.Recorded {
+		im, ok := imbalance(h.PerStoreValuesAt(tick, stat))
+		if !ok {
+			continue
+		}
+		if !seen {
+			c.Initial, seen = im, true
+		}
+		c.Final = im
+		if im > threshold {
+			c.Tick = -1
+		} else if c.Tick == -1 {
+			c.Tick = tick
+		}
+	}
+	if !seen {
+		c.Tick = 0
+	}
+	return c
+}
+
+// Convergence returns a string representation of the convergence of the given
+// stat to an imbalance at or below the given threshold. The interval at which
+// the history was recorded is used to report the time at which the stat
+// converged.
+func (h *History) Convergence(stat string, threshold float64, interval time.Duration) string {
+	c := h.ConvergenceForStat(stat, threshold)
+	converged := "never"
+	if c.Tick >= 0 {
+		converged = fmt.Sprintf("tick %d (%s)", c.Tick, time.Duration(c.Tick)*interval)
+	}
+	return fmt.Sprintf("max/mean %.2f -> %.2f, <= %.2f from %s of %d ticks",
+		c.Initial, c.Final, threshold, converged, c.Ticks)
+}
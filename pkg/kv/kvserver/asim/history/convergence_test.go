@@ -0,0 +1,62 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvergence(t *testing.T) {
+	// historyOf returns a history where the QPS of each store at each tick is
+	// given by qps[tick][store].
+	historyOf := func(qps ...[]int64) *History {
+		h := &History{}
+		for _, tick := range qps {
+			var sms []metrics.StoreMetrics
+			for i, v := range tick {
+				sms = append(sms, metrics.StoreMetrics{StoreID: int64(i + 1), QPS: v})
+			}
+			h.Recorded = append(h.Recorded, sms)
+		}
+		return h
+	}
+
+	testCases := []struct {
+		name     string
+		h        *History
+		expected Convergence
+		str      string
+	}{
+		{
+			name:     "converges",
+			h:        historyOf([]int64{0, 0}, []int64{300, 100}, []int64{250, 150}, []int64{200, 200}, []int64{210, 190}),
+			expected: Convergence{Initial: 1.5, Final: 1.05, Tick: 3, Ticks: 5},
+			str:      "max/mean 1.50 -> 1.05, <= 1.10 from tick 3 (3m0s) of 5 ticks",
+		},
+		{
+			name:     "diverges again",
+			h:        historyOf([]int64{200, 200}, []int64{300, 100}),
+			expected: Convergence{Initial: 1, Final: 1.5, Tick: -1, Ticks: 2},
+			str:      "max/mean 1.00 -> 1.50, <= 1.10 from never of 2 ticks",
+		},
+		{
+			name:     "no load",
+			h:        historyOf([]int64{0, 0}, []int64{0, 0}),
+			expected: Convergence{Tick: 0, Ticks: 2},
+			str:      "max/mean 0.00 -> 0.00, <= 1.10 from tick 0 (0s) of 2 ticks",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.h.ConvergenceForStat("qps", 1.1))
+			require.Equal(t, tc.str, tc.h.Convergence("qps", 1.1, time.Minute))
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/allocator/allocatorimpl"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/assertion"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/clustertrace"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/config"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/event"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/asim/gen"
@@ -76,6 +77,18 @@ var runAsimTests = envutil.EnvOrDefaultBool("COCKROACH_RUN_ASIM_TESTS", false)
 //     regions having 3 zones. complex: 28 nodes, 3 regions with a skewed
 //     number of nodes per region.
 //
+//   - "load_trace" path=<string> [tsdump=<string>] [at=<time>]
+//     [keys_per_range=<int>]
+//     Load the cluster, ranges and load of a real cluster from the debug zip
+//     (or the directory it was extracted to) at path, which must have been
+//     collected with --include-range-info. Relative paths are resolved against
+//     the testdata directory. If a tsdump in CSV format is given, the store
+//     capacities are taken from it at the given RFC3339 time, or from its last
+//     datapoints if no time is given. Each range is replayed over
+//     keys_per_range keys of the simulated keyspace, with the QPS, bytes and
+//     CPU it was observed to serve. The trace replaces any generated cluster,
+//     ranges and load. The default values are: keys_per_range=1000.
+//
 //   - "gen_ranges" [ranges=<int>]
 //     [placement_type=(even|skewed|weighted|replica_placement)]
 //     [repl_factor=<int>] [min_key=<int>] [max_key=<int>] [bytes_mib=<int>]
@@ -166,6 +179,10 @@ var runAsimTests = envutil.EnvOrDefaultBool("COCKROACH_RUN_ASIM_TESTS", false)
 //     random number generator that creates the seed used to generate each
 //     simulation sample. The default values are: duration=30m (30 minutes)
 //     samples=1 seed=random.
+//     If converge (e.g. converge=1.15) is given, the time at which the
+//     max/mean over the stores of each metric in metrics settled at or below
+//     it is printed as well, to compare how quickly the configurations in
+//     cfgs converge.
 //
 // To run all tests and rewrite the testdata files as well as generate the
 // artifacts in `testdata/generated`, you can use:
@@ -199,6 +216,10 @@ func TestDataDriven(t *testing.T) {
 			loadGen := gen.MultiLoad{}
 			var clusterGen gen.ClusterGen
 			var rangeGen gen.MultiRanges
+			// traceRangeGen and traceLoadGen are set by load_trace, and take
+			// precedence over rangeGen and loadGen.
+			var traceRangeGen gen.RangeGen
+			var traceLoadGen gen.LoadGen
 			settingsGen := gen.StaticSettings{Settings: config.DefaultSimulationSettings()}
 			var events []scheduled.ScheduledEvent
 			assertions := []assertion.SimulationAssertion{}
@@ -385,6 +406,39 @@ func TestDataDriven(t *testing.T) {
 					scanMustExist(t, d, "config", &cfg)
 					clusterGen = loadClusterInfo(cfg)
 					return ""
+				case "load_trace":
+					var tracePath, tsdumpPath string
+					var at time.Time
+					keysPerRange := int64(gen.DefaultTraceKeysPerRange)
+					scanMustExist(t, d, "path", &tracePath)
+					scanIfExists(t, d, "tsdump", &tsdumpPath)
+					if d.HasArg("at") {
+						var atStr string
+						scanMustExist(t, d, "at", &atStr)
+						var err error
+						at, err = time.Parse(time.RFC3339, atStr)
+						require.NoError(t, err)
+					}
+					scanIfExists(t, d, "keys_per_range", &keysPerRange)
+					resolve := func(p string) string {
+						if filepath.IsAbs(p) {
+							return p
+						}
+						return datapathutils.TestDataPath(t, p)
+					}
+
+					trace, err := clustertrace.LoadDebugZip(resolve(tracePath))
+					require.NoError(t, err)
+					if tsdumpPath != "" {
+						f, err := os.Open(resolve(tsdumpPath))
+						require.NoError(t, err)
+						require.NoError(t, trace.ApplyTSDump(f, at))
+						require.NoError(t, f.Close())
+					}
+					clusterGen = gen.TraceCluster{Trace: &trace}
+					traceRangeGen = gen.TraceRanges{Trace: &trace, KeysPerRange: keysPerRange}
+					traceLoadGen = gen.TraceLoad{Trace: &trace, KeysPerRange: keysPerRange}
+					return trace.String()
 				case "add_node":
 					var delay time.Duration
 					var numStores = 1
@@ -494,6 +548,7 @@ func TestDataDriven(t *testing.T) {
 					require.NoError(t, sniffarg.DoEnv("rewrite", &rewrite))
 					var cfgs []string    // configurations to run the simulation with
 					var metrics []string // metrics to summarize
+					var converge float64 // max/mean that metrics are expected to converge to
 
 					scanIfExists(t, d, "duration", &duration)
 					scanIfExists(t, d, "samples", &samples)
@@ -501,6 +556,7 @@ func TestDataDriven(t *testing.T) {
 					scanIfExists(t, d, "cfgs", &cfgs)
 					scanIfExists(t, d, "metrics", &metrics)
 					scanIfExists(t, d, "full", &full)
+					scanIfExists(t, d, "converge", &converge)
 
 					t.Logf("running eval for %s", name)
 
@@ -515,7 +571,13 @@ func TestDataDriven(t *testing.T) {
 						metricsMap[s] = struct{}{}
 					}
 
-					require.NotZero(t, rangeGen)
+					var evalRangeGen gen.RangeGen = rangeGen
+					var evalLoadGen gen.LoadGen = loadGen
+					if traceRangeGen != nil {
+						evalRangeGen, evalLoadGen = traceRangeGen, traceLoadGen
+					} else {
+						require.NotZero(t, rangeGen)
+					}
 
 					knownConfigurations := map[string]func(eg *gen.StaticEvents){
 						"sma-count": func(eg *gen.StaticEvents) {
@@ -592,7 +654,7 @@ func TestDataDriven(t *testing.T) {
 									tmpStrB = &strings.Builder{}
 								}
 								simulator := gen.GenerateSimulation(
-									duration, clusterGen, rangeGen, loadGen,
+									duration, clusterGen, evalRangeGen, evalLoadGen,
 									settingsGen, eventGen, seedGen.Int63(), tmpStrB, "\t",
 								)
 								if stateStrForOnce == "" {
@@ -618,7 +680,8 @@ func TestDataDriven(t *testing.T) {
 
 							for sample, h := range run.hs {
 								printStatsAndGenerateJSON(t, &buf, h, testName, sample+1, plotDir, hasher, rewrite,
-									settingsGen.Settings.TickInterval, metricsMap)
+									settingsGen.Settings.TickInterval, settingsGen.Settings.MetricsInterval,
+									metricsMap, converge)
 							}
 							artifactsHash := hasher.Sum64()
 
@@ -37,7 +37,9 @@ func printStatsAndGenerateJSON(
 	hasher hash.Hash,
 	rewrite bool,
 	tickInterval time.Duration,
+	metricsInterval time.Duration,
 	metricsMap map[string]struct{},
+	convergeThreshold float64,
 ) {
 	ts := metrics.MakeTS(h.Recorded)
 
@@ -78,6 +80,10 @@ func printStatsAndGenerateJSON(
 				thrashing := h.Thrashing(stat)
 				_, _ = fmt.Fprintf(buf, "%s#%d: thrash_pct: %s\n", stat, sample, thrashing)
 			}
+			if convergeThreshold > 0 {
+				_, _ = fmt.Fprintf(buf, "%s#%d: converge: %s\n", stat, sample,
+					h.Convergence(stat, convergeThreshold, metricsInterval))
+			}
 		}
 	}
 	if rewrite {
@@ -0,0 +1,98 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package workload
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// SpanLoad is the load on a span of keys [StartKey, EndKey), in units per
+// second. The CPU rates are in nanoseconds of CPU time per second.
+type SpanLoad struct {
+	StartKey, EndKey    int64
+	ReadsPerSecond      float64
+	WritesPerSecond     float64
+	ReadBytesPerSecond  float64
+	WriteBytesPerSecond float64
+	RequestCPUPerSecond float64
+	RaftCPUPerSecond    float64
+}
+
+// ReplayGenerator generates the load of a set of spans at a fixed rate, such
+// as the per-range load recorded in a real cluster. On each tick, it generates
+// one load event per span carrying the span's load since the last tick, at a
+// key chosen uniformly at random from the span. Fractional accesses, bytes
+// and CPU are deferred to later ticks, so that the load generated over time
+// matches the rates of each span exactly.
+type ReplayGenerator struct {
+	rand    *rand.Rand
+	start   time.Time
+	lastRun time.Time
+	loads   []SpanLoad
+	// emitted holds the accesses, bytes and CPU of each span that have been
+	// generated so far, indexed like loads.
+	emitted [][6]int64
+}
+
+var _ Generator = &ReplayGenerator{}
+
+// NewReplayGenerator returns a generator that replays the given span loads,
+// starting at the given time.
+func NewReplayGenerator(start time.Time, seed int64, loads []SpanLoad) *ReplayGenerator {
+	for _, l := range loads {
+		if l.EndKey <= l.StartKey {
+			panic(fmt.Sprintf("end key (%d) must be greater than start key (%d)", l.EndKey, l.StartKey))
+		}
+	}
+	return &ReplayGenerator{
+		rand:    rand.New(rand.NewSource(seed)),
+		start:   start,
+		lastRun: start,
+		loads:   loads,
+		emitted: make([][6]int64, len(loads)),
+	}
+}
+
+// Tick returns the load events up till time tick, from the last time the
+// workload generator was called.
+func (g *ReplayGenerator) Tick(maxTime time.Time) LoadBatch {
+	if !maxTime.After(g.lastRun) {
+		return LoadBatch{}
+	}
+	g.lastRun = maxTime
+	// NB: the load to generate is derived from the total elapsed time, rather
+	// than accumulated across ticks, to avoid accumulating rounding errors.
+	elapsed := maxTime.Sub(g.start).Seconds()
+
+	ret := make(LoadBatch, 0, len(g.loads))
+	for i, l := range g.loads {
+		e := &g.emitted[i]
+		take := func(idx int, rate float64) int64 {
+			n := int64(rate*elapsed) - e[idx]
+			e[idx] += n
+			return n
+		}
+		le := LoadEvent{
+			Reads:      take(0, l.ReadsPerSecond),
+			Writes:     take(1, l.WritesPerSecond),
+			ReadSize:   take(2, l.ReadBytesPerSecond),
+			WriteSize:  take(3, l.WriteBytesPerSecond),
+			RequestCPU: take(4, l.RequestCPUPerSecond),
+			RaftCPU:    take(5, l.RaftCPUPerSecond),
+		}
+		if le == (LoadEvent{}) {
+			continue
+		}
+		le.Key = l.StartKey + g.rand.Int63n(l.EndKey-l.StartKey)
+		ret = append(ret, le)
+	}
+	// NB: the spans are not required to be sorted or disjoint.
+	sort.Sort(ret)
+	return ret
+}
@@ -159,3 +159,61 @@ func TestRandWorkloadGenerator(t *testing.T) {
 		require.Equal(t, math.Round(tc.readRatio*100), math.Round((float64(stats.reads)/float64(stats.reads+stats.writes))*100))
 	}
 }
+
+// TestReplayGenerator asserts that the load generated by the replay generator
+// matches the rates of each span, including fractional rates that are carried
+// over between ticks, and that keys fall within their span.
+func TestReplayGenerator(t *testing.T) {
+	loads := []SpanLoad{
+		{
+			StartKey:            0,
+			EndKey:              100,
+			ReadsPerSecond:      10,
+			WritesPerSecond:     0.5,
+			ReadBytesPerSecond:  1000,
+			WriteBytesPerSecond: 50,
+			RequestCPUPerSecond: 1e6,
+			RaftCPUPerSecond:    2.5e4,
+		},
+		{
+			StartKey:        100,
+			EndKey:          101,
+			ReadsPerSecond:  0.1,
+			WritesPerSecond: 0.25,
+		},
+		{
+			StartKey: 101,
+			EndKey:   200,
+		},
+	}
+
+	start := time.Date(2022, 03, 21, 11, 0, 0, 0, time.UTC)
+	g := NewReplayGenerator(start, testingSeed, loads)
+	const ticks = 100
+	totals := make([]LoadEvent, len(loads))
+	for tick := 1; tick <= ticks; tick++ {
+		ops := g.Tick(start.Add(time.Duration(tick) * time.Second))
+		require.True(t, sort.IsSorted(ops))
+		for _, op := range ops {
+			var idx int
+			for idx = range loads {
+				if op.Key >= loads[idx].StartKey && op.Key < loads[idx].EndKey {
+					break
+				}
+			}
+			require.NotEqual(t, 2, idx, "unexpected load on span without load")
+			totals[idx].Reads += op.Reads
+			totals[idx].Writes += op.Writes
+			totals[idx].ReadSize += op.ReadSize
+			totals[idx].WriteSize += op.WriteSize
+			totals[idx].RequestCPU += op.RequestCPU
+			totals[idx].RaftCPU += op.RaftCPU
+		}
+	}
+
+	require.Equal(t, []LoadEvent{
+		{Reads: 1000, Writes: 50, ReadSize: 100000, WriteSize: 5000, RequestCPU: 1e8, RaftCPU: 2.5e6},
+		{Reads: 10, Writes: 25},
+		{},
+	}, totals)
+}
@@ -13,8 +13,10 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverpb"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/storage"
+	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
 )
 
 func init() {
@@ -53,6 +55,30 @@ func EvalLinkExternalSSTable(
 	path := args.ExternalFile.Path
 	log.VEventf(ctx, 1, "link External SSTable file %s in %s", path, args.ExternalFile.Locator)
 
+	if args.ReplaceExisting {
+		if err := checkReplaceExisting(ctx, readWriter, cArgs.EvalCtx, args); err != nil {
+			return result.Result{}, err
+		}
+		// The clock is read while holding latches on the span, so requests that
+		// replace overlapping data report increasing times.
+		resp.(*kvpb.LinkExternalSSTableResponse).LinkedAt = cArgs.EvalCtx.Clock().Now()
+		// The External SST contains exactly the data it replaces, so neither the
+		// MVCC stats nor the MVCC history of the range change.
+		return result.Result{
+			Replicated: kvserverpb.ReplicatedEvalResult{
+				LinkExternalSSTable: &kvserverpb.ReplicatedEvalResult_LinkExternalSSTable{
+					RemoteFileLoc:           args.ExternalFile.Locator,
+					RemoteFilePath:          path,
+					ApproximatePhysicalSize: args.ExternalFile.ApproximatePhysicalSize,
+					BackingFileSize:         args.ExternalFile.BackingFileSize,
+					Span:                    roachpb.Span{Key: start.Key, EndKey: end.Key},
+					HasRangeKey:             args.ExternalFile.HasRangeKey,
+					ReplaceExisting:         true,
+				},
+			},
+		}, nil
+	}
+
 	// MVCCStats in the linked sst are always estimates, as we currently compute
 	// them with back of the envelope calculations using backup file data.
 	s := *args.ExternalFile.MVCCStats
@@ -78,8 +104,84 @@ func EvalLinkExternalSSTable(
 				Span:                    roachpb.Span{Key: start.Key, EndKey: end.Key},
 				RemoteRewriteTimestamp:  rewriteTimestamp,
 				RemoteSyntheticPrefix:   args.ExternalFile.SyntheticPrefix,
+				HasRangeKey:             args.ExternalFile.HasRangeKey,
 			},
 			MVCCHistoryMutation: mvccHistoryMutation,
 		},
 	}, nil
 }
+
+// checkReplaceExisting checks that a LinkExternalSSTable request with
+// ReplaceExisting set can replace the existing data in its span with the
+// External SST. The span must not contain range-local keys, and the data in
+// the span must not have changed since the External SST was built: the span
+// must not hold data above the snapshot timestamp, the GC threshold must not
+// have advanced, and the MVCC stats of the data must match the expected ones.
+// The stats alone aren't enough, since a write and the garbage collection of
+// another version can leave them unchanged. The request holds write latches
+// across the span, so the checks see all the data visible to it. The External
+// SST can't be rewritten, since it must contain exactly the data it replaces.
+func checkReplaceExisting(
+	ctx context.Context,
+	reader storage.Reader,
+	evalCtx EvalContext,
+	args *kvpb.LinkExternalSSTableRequest,
+) error {
+	if args.ExternalFile.UseSyntheticSuffix || len(args.ExternalFile.SyntheticPrefix) > 0 {
+		return errors.New("cannot replace existing data with a rewritten External SSTable")
+	}
+	if args.ExpectedMVCCStats == nil {
+		return errors.New("expected MVCC stats are required to replace existing data")
+	}
+	if args.SnapshotTimestamp.IsEmpty() {
+		return errors.New("a snapshot timestamp is required to replace existing data")
+	}
+	userSpan := evalCtx.Desc().KeySpan().AsRawSpanWithNoLocals()
+	if !userSpan.Contains(args.Span()) {
+		return errors.Errorf("cannot replace existing data in %s, which is not within the range's span %s",
+			args.Span(), userSpan)
+	}
+	if gcThreshold := evalCtx.GetGCThreshold(); !gcThreshold.Equal(args.ExpectedGCThreshold) {
+		return errors.Errorf("GC threshold changed from %s to %s since the External SSTable was built",
+			args.ExpectedGCThreshold, gcThreshold)
+	}
+	isEmpty, err := storage.MVCCIsSpanEmpty(ctx, reader, storage.MVCCIsSpanEmptyOptions{
+		StartKey: args.Key,
+		EndKey:   args.EndKey,
+		StartTS:  args.SnapshotTimestamp,
+		EndTS:    hlc.MaxTimestamp,
+	})
+	if err != nil {
+		return err
+	}
+	if !isEmpty {
+		return errors.Errorf("data changed since the External SSTable was built: "+
+			"%s was written to above %s", args.Span(), args.SnapshotTimestamp)
+	}
+	ms, err := storage.ComputeStats(ctx, reader, args.Key, args.EndKey, evalCtx.Clock().PhysicalNow())
+	if err != nil {
+		return err
+	}
+	if !userDataStatsEqual(ms, *args.ExpectedMVCCStats) {
+		return errors.Errorf("data changed since the External SSTable was built: "+
+			"expected stats %+v, found %+v", *args.ExpectedMVCCStats, ms)
+	}
+	return nil
+}
+
+// userDataStatsEqual returns whether the given MVCC stats agree on the fields
+// that describe user data. The system fields, which change without writes to
+// user keys, and the age fields, which change with time, are ignored. Stats
+// that contain estimates are never equal.
+func userDataStatsEqual(a, b enginepb.MVCCStats) bool {
+	if a.ContainsEstimates != 0 || b.ContainsEstimates != 0 {
+		return false
+	}
+	return a.LiveBytes == b.LiveBytes && a.KeyBytes == b.KeyBytes &&
+		a.ValBytes == b.ValBytes && a.LiveCount == b.LiveCount &&
+		a.KeyCount == b.KeyCount && a.ValCount == b.ValCount &&
+		a.LockBytes == b.LockBytes && a.LockCount == b.LockCount &&
+		a.IntentBytes == b.IntentBytes && a.IntentCount == b.IntentCount &&
+		a.RangeKeyCount == b.RangeKeyCount && a.RangeKeyBytes == b.RangeKeyBytes &&
+		a.RangeValCount == b.RangeValCount && a.RangeValBytes == b.RangeValBytes
+}
@@ -0,0 +1,303 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package batcheval_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/batcheval"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/storage"
+	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLinkExternalSSTableReplaceExisting tests the evaluation of
+// LinkExternalSSTable requests that replace the existing data in a span.
+func TestLinkExternalSSTableReplaceExisting(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	eng := storage.NewDefaultInMemForTesting()
+	defer eng.Close()
+
+	desc := &roachpb.RangeDescriptor{
+		RangeID:  1,
+		StartKey: roachpb.RKey("a"),
+		EndKey:   roachpb.RKey("z"),
+	}
+	for i, key := range []string{"b", "c", "c", "m"} {
+		ts := hlc.Timestamp{WallTime: int64(i + 1)}
+		_, err := storage.MVCCPut(ctx, eng, roachpb.Key(key), ts,
+			roachpb.MakeValueFromString(key), storage.MVCCWriteOptions{})
+		require.NoError(t, err)
+	}
+	spanStats := func(span roachpb.Span) enginepb.MVCCStats {
+		ms, err := storage.ComputeStats(ctx, eng, span.Key, span.EndKey, 0 /* nowNanos */)
+		require.NoError(t, err)
+		return ms
+	}
+	withStats := func(span roachpb.Span, fn func(ms *enginepb.MVCCStats)) *enginepb.MVCCStats {
+		ms := spanStats(span)
+		fn(&ms)
+		return &ms
+	}
+	noop := func(*enginepb.MVCCStats) {}
+	rangeSpan := roachpb.Span{Key: roachpb.Key("a"), EndKey: roachpb.Key("z")}
+	chunkSpan := roachpb.Span{Key: roachpb.Key("b"), EndKey: roachpb.Key("d")}
+
+	testCases := []struct {
+		name     string
+		span     roachpb.Span
+		expected *enginepb.MVCCStats
+		suffix   bool
+		// snapshotTS defaults to a timestamp above all of the data.
+		snapshotTS hlc.Timestamp
+		// gcThreshold is the range's GC threshold at evaluation. The expected
+		// one is always empty.
+		gcThreshold hlc.Timestamp
+		expectErr   string
+	}{
+		{
+			name:     "matching stats",
+			span:     rangeSpan,
+			expected: withStats(rangeSpan, noop),
+		},
+		{
+			name:     "matching stats of part of the range",
+			span:     chunkSpan,
+			expected: withStats(chunkSpan, noop),
+		},
+		{
+			// The system and age fields change without writes to user keys.
+			name: "matching user data stats",
+			span: rangeSpan,
+			expected: withStats(rangeSpan, func(ms *enginepb.MVCCStats) {
+				ms.SysBytes, ms.SysCount, ms.GCBytesAge = 10, 1, 1000
+			}),
+		},
+		{
+			name:      "stats of another span",
+			span:      chunkSpan,
+			expected:  withStats(rangeSpan, noop),
+			expectErr: "data changed since the External SSTable was built",
+		},
+		{
+			name: "mismatched stats",
+			span: rangeSpan,
+			expected: withStats(rangeSpan, func(ms *enginepb.MVCCStats) {
+				ms.KeyCount++
+			}),
+			expectErr: "data changed since the External SSTable was built",
+		},
+		{
+			name: "estimated stats",
+			span: rangeSpan,
+			expected: withStats(rangeSpan, func(ms *enginepb.MVCCStats) {
+				ms.ContainsEstimates = 1
+			}),
+			expectErr: "data changed since the External SSTable was built",
+		},
+		{
+			name:       "written to above the snapshot timestamp",
+			span:       rangeSpan,
+			expected:   withStats(rangeSpan, noop),
+			snapshotTS: hlc.Timestamp{WallTime: 3},
+			expectErr:  "was written to above 0.000000003,0",
+		},
+		{
+			name:        "advanced GC threshold",
+			span:        rangeSpan,
+			expected:    withStats(rangeSpan, noop),
+			gcThreshold: hlc.Timestamp{WallTime: 2},
+			expectErr:   "GC threshold changed",
+		},
+		{
+			name:      "missing stats",
+			span:      rangeSpan,
+			expectErr: "expected MVCC stats are required",
+		},
+		{
+			name:      "span outside of the range",
+			span:      roachpb.Span{Key: roachpb.Key("b"), EndKey: roachpb.Key("zz")},
+			expected:  withStats(rangeSpan, noop),
+			expectErr: "which is not within the range's span",
+		},
+		{
+			name:      "synthetic suffix",
+			span:      rangeSpan,
+			expected:  withStats(rangeSpan, noop),
+			suffix:    true,
+			expectErr: "cannot replace existing data with a rewritten External SSTable",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := hlc.NewClockForTesting(timeutil.NewManualTime(timeutil.Unix(0, 123)))
+			evalCtx := (&batcheval.MockEvalCtx{
+				Desc: desc, Clock: clock, GCThreshold: tc.gcThreshold,
+			}).EvalContext()
+			snapshotTS := tc.snapshotTS
+			if snapshotTS.IsEmpty() {
+				snapshotTS = hlc.Timestamp{WallTime: 10}
+			}
+			var delta enginepb.MVCCStats
+			var resp kvpb.LinkExternalSSTableResponse
+			res, err := batcheval.EvalLinkExternalSSTable(ctx, eng, batcheval.CommandArgs{
+				EvalCtx: evalCtx,
+				Stats:   &delta,
+				Args: &kvpb.LinkExternalSSTableRequest{
+					RequestHeader: kvpb.RequestHeaderFromSpan(tc.span),
+					ExternalFile: kvpb.LinkExternalSSTableRequest_ExternalFile{
+						Locator:            "nodelocal://1/cold",
+						Path:               "r1/a.sst",
+						MVCCStats:          tc.expected,
+						UseSyntheticSuffix: tc.suffix,
+					},
+					ReplaceExisting:   true,
+					ExpectedMVCCStats: tc.expected,
+					SnapshotTimestamp: snapshotTS,
+				},
+			}, &resp)
+
+			if tc.expectErr != "" {
+				require.Regexp(t, tc.expectErr, err)
+				require.Nil(t, res.Replicated.LinkExternalSSTable)
+				return
+			}
+			require.NoError(t, err)
+			sst := res.Replicated.LinkExternalSSTable
+			require.NotNil(t, sst)
+			require.True(t, sst.ReplaceExisting)
+			require.Equal(t, tc.span, sst.Span)
+			require.Equal(t, "nodelocal://1/cold", sst.RemoteFileLoc)
+			require.Equal(t, "r1/a.sst", sst.RemoteFilePath)
+			require.Equal(t, hlc.Timestamp{WallTime: 123}, resp.LinkedAt)
+			// Neither the stats nor the MVCC history of the range change.
+			require.Equal(t, enginepb.MVCCStats{}, delta)
+			require.Nil(t, res.Replicated.MVCCHistoryMutation)
+		})
+	}
+}
+
+// TestLinkExternalSSTableReplaceExistingStatsCollision tests that a
+// LinkExternalSSTable request that replaces the existing data in a span fails
+// if the data changed since the External SST was built, even though the MVCC
+// stats of the span didn't change: the garbage collection of an old version of
+// a key and an overwrite of another key with a value of the same length cancel
+// each other out.
+func TestLinkExternalSSTableReplaceExistingStatsCollision(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	desc := &roachpb.RangeDescriptor{
+		RangeID:  1,
+		StartKey: roachpb.RKey("a"),
+		EndKey:   roachpb.RKey("z"),
+	}
+	span := roachpb.Span{Key: roachpb.Key("a"), EndKey: roachpb.Key("z")}
+	ts := func(wallTime int64) hlc.Timestamp { return hlc.Timestamp{WallTime: wallTime} }
+	put := func(eng storage.Engine, key, value string, ts hlc.Timestamp) {
+		_, err := storage.MVCCPut(ctx, eng, roachpb.Key(key), ts,
+			roachpb.MakeValueFromString(value), storage.MVCCWriteOptions{})
+		require.NoError(t, err)
+	}
+	spanStats := func(eng storage.Engine) enginepb.MVCCStats {
+		ms, err := storage.ComputeStats(ctx, eng, span.Key, span.EndKey, 0 /* nowNanos */)
+		require.NoError(t, err)
+		return ms
+	}
+
+	for _, tc := range []struct {
+		name        string
+		gc          bool
+		overwrite   bool
+		gcThreshold hlc.Timestamp
+		expectErr   string
+	}{
+		{
+			name:      "overwrite",
+			overwrite: true,
+			expectErr: "was written to above",
+		},
+		{
+			name:        "GC",
+			gc:          true,
+			gcThreshold: ts(2),
+			expectErr:   "GC threshold changed",
+		},
+		{
+			name:        "GC and overwrite",
+			gc:          true,
+			overwrite:   true,
+			gcThreshold: ts(2),
+			expectErr:   "GC threshold changed",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			eng := storage.NewDefaultInMemForTesting()
+			defer eng.Close()
+
+			put(eng, "a", "a1", ts(1))
+			put(eng, "a", "a2", ts(2))
+			put(eng, "b", "b1", ts(3))
+			// The External SST is built from the data at this point.
+			snapshotTS := ts(4)
+			expected := spanStats(eng)
+
+			if tc.gc {
+				require.NoError(t, storage.MVCCGarbageCollect(ctx, eng, nil, /* ms */
+					[]kvpb.GCRequest_GCKey{{Key: roachpb.Key("a"), Timestamp: ts(1)}}, ts(2)))
+			}
+			if tc.overwrite {
+				put(eng, "b", "b2", ts(5))
+			}
+			if tc.gc && tc.overwrite {
+				// The changes cancel each other out in the user data stats.
+				actual := spanStats(eng)
+				require.Equal(t, expected.LiveBytes, actual.LiveBytes)
+				require.Equal(t, expected.LiveCount, actual.LiveCount)
+				require.Equal(t, expected.KeyBytes, actual.KeyBytes)
+				require.Equal(t, expected.KeyCount, actual.KeyCount)
+				require.Equal(t, expected.ValBytes, actual.ValBytes)
+				require.Equal(t, expected.ValCount, actual.ValCount)
+			}
+
+			clock := hlc.NewClockForTesting(timeutil.NewManualTime(timeutil.Unix(0, 123)))
+			evalCtx := (&batcheval.MockEvalCtx{
+				Desc: desc, Clock: clock, GCThreshold: tc.gcThreshold,
+			}).EvalContext()
+			var delta enginepb.MVCCStats
+			var resp kvpb.LinkExternalSSTableResponse
+			res, err := batcheval.EvalLinkExternalSSTable(ctx, eng, batcheval.CommandArgs{
+				EvalCtx: evalCtx,
+				Stats:   &delta,
+				Args: &kvpb.LinkExternalSSTableRequest{
+					RequestHeader: kvpb.RequestHeaderFromSpan(span),
+					ExternalFile: kvpb.LinkExternalSSTableRequest_ExternalFile{
+						Locator:   "nodelocal://1/cold",
+						Path:      "r1/a.sst",
+						MVCCStats: &expected,
+					},
+					ReplaceExisting:   true,
+					ExpectedMVCCStats: &expected,
+					SnapshotTimestamp: snapshotTS,
+				},
+			}, &resp)
+			require.Regexp(t, tc.expectErr, err)
+			require.Nil(t, res.Replicated.LinkExternalSSTable)
+		})
+	}
+}
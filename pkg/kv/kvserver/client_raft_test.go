@@ -6913,3 +6913,80 @@ func TestInvalidConfChangeRejection(t *testing.T) {
 	// proposed an invalid configuration change.
 	require.True(t, errors.Is(pErr.GoError(), injErr), "%+v", pErr.GoError())
 }
+
+// TestWitnessTransfersRaftLeadershipAway verifies that a witness doesn't
+// campaign, and that if it becomes the raft leader anyway, it transfers the
+// leadership to a full voter at once so that the range remains available.
+func TestWitnessTransfersRaftLeadershipAway(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	tc := testcluster.StartTestCluster(t, 3, base.TestClusterArgs{
+		ReplicationMode: base.ReplicationManual,
+	})
+	defer tc.Stopper().Stop(ctx)
+
+	key := tc.ScratchRange(t)
+	desc := tc.AddVotersOrFatal(t, key, tc.Target(1))
+	desc, err := tc.Server(0).DB().AdminChangeReplicas(
+		ctx, key, desc, kvpb.MakeReplicationChanges(roachpb.ADD_WITNESS, tc.Target(2)),
+	)
+	require.NoError(t, err)
+	witnessDesc, ok := desc.GetReplicaDescriptor(tc.Target(2).StoreID)
+	require.True(t, ok)
+	require.Equal(t, roachpb.WITNESS, witnessDesc.Type)
+
+	send1 := tc.GetFirstStoreFromServer(t, 0).TestSender()
+	_, pErr := kv.SendWrapped(ctx, send1, incrementArgs(key, 1))
+	require.NoError(t, pErr.GoError())
+
+	repl1, err := tc.GetFirstStoreFromServer(t, 0).GetReplica(desc.RangeID)
+	require.NoError(t, err)
+	store3 := tc.GetFirstStoreFromServer(t, 2)
+	witness, err := store3.GetReplica(desc.RangeID)
+	require.NoError(t, err)
+
+	// The witness refuses to campaign.
+	witness.Campaign(ctx)
+	require.Equal(t, raftpb.StateFollower, witness.RaftStatus().RaftState)
+
+	testutils.SucceedsSoon(t, func() error {
+		if st := repl1.RaftStatus(); st.RaftState != raftpb.StateLeader {
+			return errors.Errorf("n1 is %s", st.RaftState)
+		}
+		return nil
+	})
+
+	// Force the witness to become the leader, as it would if it won an election
+	// after the election timeout, by transferring the leadership to it.
+	origTransfers := store3.Metrics().RangeRaftLeaderTransfers.Count()
+	repl1.TransferRaftLeadership(witness.ReplicaID())
+
+	// The witness transfers the leadership to one of the full voters.
+	testutils.SucceedsSoon(t, func() error {
+		if store3.Metrics().RangeRaftLeaderTransfers.Count() == origTransfers {
+			return errors.New("witness hasn't transferred the leadership away yet")
+		}
+		if st := witness.RaftStatus(); st.RaftState == raftpb.StateLeader {
+			return errors.New("witness is still the leader")
+		}
+		for _, i := range []int{0, 1} {
+			repl, err := tc.GetFirstStoreFromServer(t, i).GetReplica(desc.RangeID)
+			if err != nil {
+				return err
+			}
+			if repl.RaftStatus().RaftState == raftpb.StateLeader {
+				return nil
+			}
+		}
+		return errors.New("no full voter is the leader")
+	})
+
+	// The range is still available, and the witness didn't store the writes.
+	testutils.SucceedsSoon(t, func() error {
+		_, pErr := kv.SendWrapped(ctx, send1, incrementArgs(key, 1))
+		return pErr.GoError()
+	})
+	tc.WaitForValues(t, key, []int64{2, 2, 0})
+}
@@ -0,0 +1,550 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package kvserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/cloud"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverbase"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverpb"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvstorage"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/rditer"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/spanconfig"
+	"github.com/cockroachdb/cockroach/pkg/storage"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/ioctx"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
+	"github.com/cockroachdb/cockroach/pkg/util/uuid"
+	"github.com/cockroachdb/errors"
+)
+
+// coldStorageURI is the external storage URI of the remote locator that the
+// data of cold ranges is moved to.
+var coldStorageURI = settings.RegisterStringSetting(
+	settings.SystemOnly,
+	"kv.cold_storage.uri",
+	"the external storage URI that the data of ranges older than their "+
+		"cold_storage_after zone configuration is moved to; "+
+		"if empty, no data is moved to cold storage",
+	"",
+	settings.Sensitive,
+)
+
+// coldStorageQueueInterval is the minimum time between two checks of whether
+// a range can be moved to cold storage.
+var coldStorageQueueInterval = settings.RegisterDurationSetting(
+	settings.SystemOnly,
+	"kv.cold_storage_queue.interval",
+	"the minimum time between checks of whether a range can be moved to cold storage",
+	time.Hour,
+	settings.PositiveDuration,
+)
+
+// coldStorageTargetSSTSize is the target size of the SSTables that the data
+// of ranges is moved to cold storage in.
+var coldStorageTargetSSTSize = settings.RegisterByteSizeSetting(
+	settings.SystemOnly,
+	"kv.cold_storage.target_sst_size",
+	"the target size of the SSTables that the data of ranges is moved to cold storage in",
+	64<<20,
+	settings.PositiveInt,
+)
+
+// coldStorageDeletionGracePeriod is the minimum time that an SSTable in cold
+// storage must have been unreferenced before it is deleted.
+var coldStorageDeletionGracePeriod = settings.RegisterDurationSetting(
+	settings.SystemOnly,
+	"kv.cold_storage.deletion_grace_period",
+	"the minimum time after an SSTable in cold storage was created or replaced "+
+		"before it is deleted from cold storage",
+	24*time.Hour,
+	settings.PositiveDuration,
+)
+
+// coldStorageFileSuffix is the suffix of the objects in cold storage that
+// describe the SSTable with the same name without the suffix.
+const coldStorageFileSuffix = ".file"
+
+// coldStorageQueue moves the data of ranges that is older than the
+// cold_storage_after of the range's zone configuration to a remote locator.
+//
+// The queue runs on the leaseholder. It splits the range's user data in a
+// storage snapshot into SSTables of kv.cold_storage.target_sst_size. Each
+// SSTable whose data is older than cold_storage_after, and which is not
+// already stored remotely, is written to the remote locator configured by
+// kv.cold_storage.uri; SSTables with newer data are kept local. The queue then
+// links each SSTable in place of its span using a LinkExternalSSTable request
+// with ReplaceExisting set, which excises the local copy of the data on each
+// replica when it is applied. The request fails if the span was written to
+// since the snapshot was taken, in which case the SSTable is deleted.
+//
+// The linked SSTables are read through Pebble's remote storage, and are
+// cached on local disk by the secondary cache if one is configured. Snapshots
+// of cold ranges send the SSTables as metadata rather than streaming their
+// data. MVCC GC of cold data writes local tombstones which shadow the remote
+// data, and compactions rewrite remote data locally; once a span's local data
+// outweighs its remote data again, the queue moves it again.
+//
+// Each SSTable is described by a ColdStorageFile, which is stored next to it.
+// The queue on the leaseholder of the first range periodically deletes the
+// SSTables that no store references anymore: those whose span holds no
+// external data on any store, and those whose span was entirely replaced by
+// SSTables linked later. See sweepColdStorage.
+type coldStorageQueue struct {
+	*baseQueue
+}
+
+var _ queueImpl = &coldStorageQueue{}
+
+// newColdStorageQueue returns a new instance of coldStorageQueue.
+func newColdStorageQueue(store *Store) *coldStorageQueue {
+	q := &coldStorageQueue{}
+	q.baseQueue = newBaseQueue(
+		"coldStorage", q, store,
+		queueConfig{
+			maxSize:              defaultQueueMaxSize,
+			needsLease:           true,
+			needsSpanConfigs:     true,
+			acceptsUnsplitRanges: false,
+			successes:            store.metrics.ColdStorageQueueSuccesses,
+			failures:             store.metrics.ColdStorageQueueFailures,
+			pending:              store.metrics.ColdStorageQueuePending,
+			processingNanos:      store.metrics.ColdStorageQueueProcessingNanos,
+			processTimeoutFunc:   makeRateLimitedTimeoutFunc(rebalanceSnapshotRate),
+			disabledConfig:       kvserverbase.ColdStorageQueueEnabled,
+		},
+	)
+	return q
+}
+
+func (q *coldStorageQueue) shouldQueue(
+	ctx context.Context, now hlc.ClockTimestamp, repl *Replica, _ spanconfig.StoreReader,
+) (bool, float64) {
+	if repl.store.cfg.ColdStorageAccessor == nil ||
+		coldStorageURI.Get(&repl.store.ClusterSettings().SV) == "" {
+		return false, 0
+	}
+	desc, conf := repl.DescAndSpanConfig()
+	// The leaseholder of the first range sweeps the cold storage locator.
+	sweeps := desc.StartKey.Equal(roachpb.RKeyMin)
+	if !sweeps {
+		if !coldStorageEligible(desc, conf) {
+			return false, 0
+		}
+		// Only move ranges whose data is mostly stored locally. This is the same
+		// heuristic that snapshots use to decide whether to send external files.
+		span := desc.KeySpan().AsRawSpanWithNoLocals()
+		total, _, external, err := repl.store.StateEngine().ApproximateDiskBytes(span.Key, span.EndKey)
+		if err != nil {
+			log.VErrEventf(ctx, 2, "could not determine external bytes: %v", err)
+			return false, 0
+		}
+		if local := total - external; local <= external {
+			return false, 0
+		}
+	}
+	lpTS, err := repl.getQueueLastProcessed(ctx, q.name)
+	if err != nil {
+		return false, 0
+	}
+	return shouldQueueAgain(now.ToTimestamp(), lpTS, coldStorageQueueInterval.Get(&repl.store.ClusterSettings().SV))
+}
+
+// coldStorageEligible returns whether the range's data can be moved to cold
+// storage. System ranges are never moved.
+func coldStorageEligible(desc *roachpb.RangeDescriptor, conf *roachpb.SpanConfig) bool {
+	return conf.ColdStorageAfterSeconds > 0 &&
+		desc.StartKey.AsRawKey().Compare(keys.TableDataMin) >= 0
+}
+
+// coldStorageEnabled returns whether the replica's data may be moved to cold
+// storage.
+func (r *Replica) coldStorageEnabled() bool {
+	return coldStorageEligible(r.DescAndSpanConfig())
+}
+
+func (q *coldStorageQueue) process(
+	ctx context.Context, repl *Replica, _ spanconfig.StoreReader, _ float64,
+) (bool, error) {
+	uri := coldStorageURI.Get(&repl.store.ClusterSettings().SV)
+	if uri == "" || repl.store.cfg.ColdStorageAccessor == nil {
+		return false, nil
+	}
+	desc, conf := repl.DescAndSpanConfig()
+	sweeps := desc.StartKey.Equal(roachpb.RKeyMin)
+	if !sweeps && !coldStorageEligible(desc, conf) {
+		return false, nil
+	}
+
+	now := repl.store.Clock().Now()
+	if err := repl.setQueueLastProcessed(ctx, q.name, now); err != nil {
+		log.VErrEventf(ctx, 2, "failed to update last processed time: %v", err)
+	}
+	if sweeps {
+		return false, repl.store.sweepColdStorage(ctx, uri)
+	}
+	threshold := now.AddDuration(-time.Duration(conf.ColdStorageAfterSeconds) * time.Second)
+	moved, err := repl.moveToColdStorage(ctx, desc, uri, threshold)
+	if err != nil {
+		return false, err
+	}
+	return moved, nil
+}
+
+// moveToColdStorage moves the range's user data that is older than the given
+// threshold to the remote locator with the given URI, in SSTables of about
+// kv.cold_storage.target_sst_size. It returns whether any data was moved.
+func (r *Replica) moveToColdStorage(
+	ctx context.Context, desc *roachpb.RangeDescriptor, uri string, threshold hlc.Timestamp,
+) (bool, error) {
+	span := desc.KeySpan().AsRawSpanWithNoLocals()
+	// Writes applied after the snapshot is taken are above the closed timestamp
+	// read before it. Only data below the threshold is moved, so the threshold
+	// must not be above the closed timestamp for LinkExternalSSTable to detect
+	// these writes.
+	if closedTS := r.GetCurrentClosedTimestamp(ctx); closedTS.Less(threshold) {
+		log.VEventf(ctx, 2, "not moving range to cold storage: closed timestamp %s is below %s",
+			closedTS, threshold)
+		return false, nil
+	}
+	snap := r.store.TODOEngine().NewSnapshot(rditer.MakeReplicatedKeySpans(desc)...)
+	defer snap.Close()
+
+	sl := kvstorage.MakeStateLoader(desc.RangeID)
+	ms, err := sl.LoadMVCCStats(ctx, snap)
+	if err != nil {
+		return false, err
+	}
+	gcThreshold, err := sl.LoadGCThreshold(ctx, snap)
+	if err != nil {
+		return false, err
+	}
+	if ms.LockCount != 0 || ms.IntentCount != 0 {
+		log.VEventf(ctx, 2, "not moving range to cold storage: range has locks")
+		return false, nil
+	}
+	if ms.KeyCount == 0 && ms.RangeKeyCount == 0 {
+		return false, nil
+	}
+
+	es, err := r.store.cfg.ColdStorageAccessor.OpenURL(ctx, uri)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := es.Close(); err != nil {
+			log.Dev.Warningf(ctx, "failed to close cold storage: %v", err)
+		}
+	}()
+
+	targetSize := uint64(coldStorageTargetSSTSize.Get(&r.store.ClusterSettings().SV))
+	var moved bool
+	for start := span.Key; start != nil; {
+		// The SSTable must hold exactly the data that it replaces, including all
+		// MVCC revisions and value headers. It ends at a key boundary, so that
+		// all revisions of a key are in the same SSTable.
+		var sst storage.MemObject
+		summary, resume, err := storage.MVCCExportToSST(ctx, r.store.ClusterSettings(), snap, storage.MVCCExportOptions{
+			StartKey:               storage.MVCCKey{Key: start},
+			EndKey:                 span.EndKey,
+			EndTS:                  hlc.MaxTimestamp,
+			ExportAllRevisions:     true,
+			IncludeMVCCValueHeader: true,
+			TargetSize:             targetSize,
+		}, &sst)
+		if err != nil {
+			return moved, err
+		}
+		sstSpan := roachpb.Span{Key: start, EndKey: span.EndKey}
+		if resume.ResumeKey.Key != nil {
+			sstSpan.EndKey = resume.ResumeKey.Key
+		}
+		start = resume.ResumeKey.Key
+		if summary.DataSize == 0 {
+			continue
+		}
+		sstMoved, err := r.moveSpanToColdStorage(
+			ctx, snap, es, uri, sstSpan, sst.Bytes(), threshold, *gcThreshold)
+		if err != nil {
+			return moved, err
+		}
+		moved = moved || sstMoved
+	}
+	return moved, nil
+}
+
+// moveSpanToColdStorage links the given SSTable, which holds the data of the
+// given span in the snapshot, in place of that data, provided that all of the
+// data is older than the given threshold and is mostly stored locally. The
+// link fails if the span was written to above the threshold, or if the GC
+// threshold changed from the given one, since the snapshot was taken. It
+// returns whether the data was moved.
+func (r *Replica) moveSpanToColdStorage(
+	ctx context.Context,
+	snap storage.Reader,
+	es cloud.ExternalStorage,
+	uri string,
+	span roachpb.Span,
+	sst []byte,
+	threshold, gcThreshold hlc.Timestamp,
+) (bool, error) {
+	// NB: time-bound iteration makes this check cheap for cold data.
+	isEmpty, err := storage.MVCCIsSpanEmpty(ctx, snap, storage.MVCCIsSpanEmptyOptions{
+		StartKey: span.Key,
+		EndKey:   span.EndKey,
+		StartTS:  threshold,
+		EndTS:    hlc.MaxTimestamp,
+	})
+	if err != nil {
+		return false, err
+	}
+	if !isEmpty {
+		log.VEventf(ctx, 3, "keeping %s local: it has data newer than %s", span, threshold)
+		return false, nil
+	}
+	total, _, external, err := r.store.StateEngine().ApproximateDiskBytes(span.Key, span.EndKey)
+	if err != nil {
+		return false, err
+	}
+	if local := total - external; local <= external {
+		log.VEventf(ctx, 3, "not moving %s to cold storage: it is mostly stored remotely", span)
+		return false, nil
+	}
+	// The stats are computed from the snapshot, so that they are consistent with
+	// the data in the SSTable.
+	ms, err := storage.ComputeStats(ctx, snap, span.Key, span.EndKey, r.store.Clock().PhysicalNow())
+	if err != nil {
+		return false, err
+	}
+
+	path := fmt.Sprintf("r%d/%s.sst", r.RangeID, uuid.MakeV4())
+	file := kvserverpb.ColdStorageFile{Span: span, CreatedAt: r.store.Clock().Now()}
+	// The file is described before it is written, so that it is deleted by the
+	// sweep if the queue fails before linking it.
+	if err := writeColdStorageFile(ctx, es, path, &file); err != nil {
+		return false, err
+	}
+	if err := cloud.WriteFile(ctx, es, path, bytes.NewReader(sst)); err != nil {
+		return false, errors.CombineErrors(err, deleteColdStorageFile(ctx, es, path))
+	}
+	req := &kvpb.LinkExternalSSTableRequest{
+		RequestHeader: kvpb.RequestHeaderFromSpan(span),
+		ExternalFile: kvpb.LinkExternalSSTableRequest_ExternalFile{
+			Locator:                 uri,
+			Path:                    path,
+			BackingFileSize:         uint64(len(sst)),
+			ApproximatePhysicalSize: uint64(len(sst)),
+			MVCCStats:               &ms,
+			HasRangeKey:             ms.RangeKeyCount > 0,
+		},
+		ReplaceExisting:     true,
+		ExpectedMVCCStats:   &ms,
+		SnapshotTimestamp:   threshold,
+		ExpectedGCThreshold: gcThreshold,
+	}
+	b := &kv.Batch{}
+	b.AddRawRequest(req)
+	if err := r.store.DB().Run(ctx, b); err != nil {
+		// If the request may have been applied, the SSTable may be referenced,
+		// and is left to the sweep.
+		if !errors.HasType(err, (*kvpb.AmbiguousResultError)(nil)) {
+			err = errors.CombineErrors(err, deleteColdStorageFile(ctx, es, path))
+		}
+		return false, errors.Wrapf(err, "linking %s in cold storage", path)
+	}
+	file.LinkedAt = b.RawResponse().Responses[0].GetInner().(*kvpb.LinkExternalSSTableResponse).LinkedAt
+	if err := writeColdStorageFile(ctx, es, path, &file); err != nil {
+		// The SSTable is only deleted once its span holds no external data.
+		log.Dev.Warningf(ctx, "failed to record that %s was linked: %v", path, err)
+	}
+	r.store.metrics.ColdStorageQueueMovedSSTables.Inc(1)
+	r.store.metrics.ColdStorageQueueMovedBytes.Inc(int64(len(sst)))
+	log.KvExec.Infof(ctx, "moved data of %s older than %s to cold storage (%d bytes)",
+		span, threshold, len(sst))
+	return true, nil
+}
+
+// sweepColdStorage deletes the SSTables in the cold storage locator with the
+// given URI that are no longer referenced by any store. An SSTable can only be
+// referenced for keys in its span, and is unreferenced if either:
+//
+//   - no store holds external data in its span, or
+//   - its span was entirely replaced by SSTables linked after it, which excised
+//     the references to it on every replica that applied them.
+//
+// Since stores that hold stale replicas, and snapshots in flight, may still
+// reference an SSTable, SSTables are only deleted once they were created, and
+// replaced, at least kv.cold_storage.deletion_grace_period ago. This also
+// prevents the deletion of SSTables that are still being linked.
+func (s *Store) sweepColdStorage(ctx context.Context, uri string) error {
+	es, err := s.cfg.ColdStorageAccessor.OpenURL(ctx, uri)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := es.Close(); err != nil {
+			log.Dev.Warningf(ctx, "failed to close cold storage: %v", err)
+		}
+	}()
+
+	var paths []string
+	var files []kvserverpb.ColdStorageFile
+	if err := es.List(ctx, "", "", func(name string) error {
+		name = strings.TrimPrefix(name, "/")
+		if !strings.HasSuffix(name, coldStorageFileSuffix) {
+			return nil
+		}
+		file, err := readColdStorageFile(ctx, es, name)
+		if err != nil {
+			return err
+		}
+		paths = append(paths, strings.TrimSuffix(name, coldStorageFileSuffix))
+		files = append(files, file)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	cutoff := s.Clock().Now().AddDuration(-coldStorageDeletionGracePeriod.Get(&s.ClusterSettings().SV))
+	deletable := make([]bool, len(files))
+	var candidates []roachpb.Span
+	for i := range files {
+		if cutoff.LessEq(files[i].CreatedAt) {
+			continue
+		}
+		if coldStorageFileReplaced(files[i], files, cutoff) {
+			deletable[i] = true
+			continue
+		}
+		candidates = append(candidates, files[i].Span)
+	}
+	if len(candidates) > 0 && s.cfg.ColdStorageSpanStats != nil {
+		resp, err := s.cfg.ColdStorageSpanStats(ctx, &roachpb.SpanStatsRequest{
+			NodeID:        "0", // Fan out to all nodes.
+			Spans:         candidates,
+			SkipMvccStats: true,
+		})
+		if err != nil {
+			return err
+		}
+		// Stores that did not respond may reference any of the SSTables.
+		if len(resp.Errors) > 0 {
+			return errors.Newf("could not determine the external data of all stores: %s",
+				strings.Join(resp.Errors, "; "))
+		}
+		for i := range files {
+			if deletable[i] || cutoff.LessEq(files[i].CreatedAt) {
+				continue
+			}
+			if stats, ok := resp.SpanToStats[files[i].Span.String()]; ok && stats.ExternalFileBytes == 0 {
+				deletable[i] = true
+			}
+		}
+	}
+
+	for i, path := range paths {
+		if !deletable[i] {
+			continue
+		}
+		if err := deleteColdStorageFile(ctx, es, path); err != nil {
+			return err
+		}
+		s.metrics.ColdStorageQueueDeletedSSTables.Inc(1)
+		log.KvExec.Infof(ctx, "deleted unreferenced SSTable %s of %s from cold storage", path, files[i].Span)
+	}
+	return nil
+}
+
+// coldStorageFileReplaced returns whether the span of the given SSTable was
+// entirely replaced by SSTables that were linked after it, and before the
+// given cutoff. SSTables that are not known to have been linked neither
+// replace, nor are replaced by, other SSTables.
+func coldStorageFileReplaced(
+	file kvserverpb.ColdStorageFile, files []kvserverpb.ColdStorageFile, cutoff hlc.Timestamp,
+) bool {
+	if file.LinkedAt.IsEmpty() {
+		return false
+	}
+	var replaced roachpb.SpanGroup
+	for _, other := range files {
+		if other.LinkedAt.IsEmpty() || other.LinkedAt.LessEq(file.LinkedAt) || cutoff.LessEq(other.LinkedAt) {
+			continue
+		}
+		replaced.Add(other.Span)
+	}
+	return replaced.Encloses(file.Span)
+}
+
+// writeColdStorageFile writes the description of the SSTable with the given
+// path to cold storage.
+func writeColdStorageFile(
+	ctx context.Context, es cloud.ExternalStorage, path string, file *kvserverpb.ColdStorageFile,
+) error {
+	buf, err := protoutil.Marshal(file)
+	if err != nil {
+		return err
+	}
+	return cloud.WriteFile(ctx, es, path+coldStorageFileSuffix, bytes.NewReader(buf))
+}
+
+// readColdStorageFile reads the description of an SSTable from the object in
+// cold storage with the given name.
+func readColdStorageFile(
+	ctx context.Context, es cloud.ExternalStorage, name string,
+) (kvserverpb.ColdStorageFile, error) {
+	var file kvserverpb.ColdStorageFile
+	r, _, err := es.ReadFile(ctx, name, cloud.ReadOptions{NoFileSize: true})
+	if err != nil {
+		return file, err
+	}
+	defer r.Close(ctx)
+	buf, err := ioctx.ReadAll(ctx, r)
+	if err != nil {
+		return file, err
+	}
+	if err := protoutil.Unmarshal(buf, &file); err != nil {
+		return file, errors.Wrapf(err, "decoding %s", name)
+	}
+	return file, nil
+}
+
+// deleteColdStorageFile deletes the SSTable with the given path, and then its
+// description, from cold storage.
+func deleteColdStorageFile(ctx context.Context, es cloud.ExternalStorage, path string) error {
+	if err := es.Delete(ctx, path); err != nil {
+		return err
+	}
+	return es.Delete(ctx, path+coldStorageFileSuffix)
+}
+
+func (*coldStorageQueue) postProcessScheduled(
+	ctx context.Context, replica replicaInQueue, priority float64,
+) {
+}
+
+func (*coldStorageQueue) timer(_ time.Duration) time.Duration {
+	return 0
+}
+
+// purgatoryChan returns nil.
+func (*coldStorageQueue) purgatoryChan() <-chan time.Time {
+	return nil
+}
+
+func (*coldStorageQueue) updateChan() <-chan time.Time {
+	return nil
+}
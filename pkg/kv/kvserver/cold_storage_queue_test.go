@@ -0,0 +1,87 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package kvserver
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+// TestColdStorageFileReplaced tests the detection of SSTables in cold storage
+// whose span was replaced by SSTables linked after them.
+func TestColdStorageFileReplaced(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	file := func(start, end string, linkedAt int64) kvserverpb.ColdStorageFile {
+		return kvserverpb.ColdStorageFile{
+			Span:     roachpb.Span{Key: roachpb.Key(start), EndKey: roachpb.Key(end)},
+			LinkedAt: hlc.Timestamp{WallTime: linkedAt},
+		}
+	}
+	cutoff := hlc.Timestamp{WallTime: 100}
+
+	testCases := []struct {
+		name     string
+		file     kvserverpb.ColdStorageFile
+		others   []kvserverpb.ColdStorageFile
+		replaced bool
+	}{
+		{
+			name:     "replaced by one file",
+			file:     file("b", "d", 10),
+			others:   []kvserverpb.ColdStorageFile{file("a", "e", 20)},
+			replaced: true,
+		},
+		{
+			name:     "replaced by adjacent files",
+			file:     file("b", "d", 10),
+			others:   []kvserverpb.ColdStorageFile{file("b", "c", 20), file("c", "d", 30)},
+			replaced: true,
+		},
+		{
+			name:   "partially replaced",
+			file:   file("b", "d", 10),
+			others: []kvserverpb.ColdStorageFile{file("b", "c", 20), file("c1", "d", 30)},
+		},
+		{
+			name:   "replaced by an earlier file",
+			file:   file("b", "d", 10),
+			others: []kvserverpb.ColdStorageFile{file("a", "e", 5)},
+		},
+		{
+			// Stores may still reference the file until the grace period after its
+			// span was replaced.
+			name:   "replaced after the cutoff",
+			file:   file("b", "d", 10),
+			others: []kvserverpb.ColdStorageFile{file("a", "e", 100)},
+		},
+		{
+			name:   "replaced by a file that was not linked",
+			file:   file("b", "d", 10),
+			others: []kvserverpb.ColdStorageFile{file("a", "e", 0)},
+		},
+		{
+			// The file may have been linked at any time.
+			name:   "not linked",
+			file:   file("b", "d", 0),
+			others: []kvserverpb.ColdStorageFile{file("a", "e", 20)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			files := append([]kvserverpb.ColdStorageFile{tc.file}, tc.others...)
+			require.Equal(t, tc.replaced, coldStorageFileReplaced(tc.file, files, cutoff))
+		})
+	}
+}
@@ -361,6 +361,16 @@ func (r *Replica) ForceCampaign(ctx context.Context, raftStatus raft.BasicStatus
 	r.forceCampaignLocked(ctx, raftStatus)
 }
 
+// TransferRaftLeadership asks the replica, which must be the raft leader, to
+// transfer the leadership to the given replica. Unlike the transfers initiated
+// by kvserver, the target can be any voter, including a witness.
+func (r *Replica) TransferRaftLeadership(target roachpb.ReplicaID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mu.internalRaftGroup.TransferLeader(raftpb.PeerID(target))
+	r.store.enqueueRaftUpdateCheck(r.RangeID)
+}
+
 // LastAssignedLeaseIndexRLocked is like LastAssignedLeaseIndex, but requires
 // b.mu to be held in read mode.
 func (b *propBuf) LastAssignedLeaseIndexRLocked() kvpb.LeaseAppliedIndex {
@@ -407,13 +407,23 @@ func (kvSS *kvBatchSnapshotStrategy) Send(
 		}
 		return err
 	}
+	// Witnesses don't store user data, so they can't send snapshots. Neither
+	// the coordinator nor the delegated sender can be a witness, see
+	// sendSnapshotUsingDelegate and validateSnapshotDelegationRequest.
+	if isWitnessInDesc(snap.State.Desc, header.RaftMessageRequest.FromReplica.ReplicaID) {
+		return 0, errors.AssertionFailedf(
+			"witness %s cannot send a snapshot", header.RaftMessageRequest.FromReplica)
+	}
+	// Witnesses don't store user data, so snapshots sent to them omit the user
+	// span altogether. Applying the snapshot clears it on the recipient.
+	toWitness := isWitnessInDesc(snap.State.Desc, header.RaftMessageRequest.ToReplica.ReplicaID)
 	if err := rditer.IterateReplicaKeySpans(ctx, snap.State.Desc, snap.EngineSnap, rditer.SelectOpts{
 		Ranged: rditer.SelectRangedOptions{
 			SystemKeys: true,
 			LockTable:  true,
 			// In shared/external mode, the user span come from external SSTs and
 			// are not iterated over here.
-			UserKeys: !(header.SharedReplicate || header.ExternalReplicate),
+			UserKeys: !(header.SharedReplicate || header.ExternalReplicate) && !toWitness,
 		},
 		ReplicatedByRangeID:   true,
 		UnreplicatedByRangeID: false,
@@ -425,7 +435,7 @@ func (kvSS *kvBatchSnapshotStrategy) Send(
 	// If snapshots containing shared files are allowed, and this range is a
 	// non-system range, take advantage of shared storage to minimize the amount
 	// of data we're iterating on and sending over the network.
-	if header.SharedReplicate || header.ExternalReplicate {
+	if (header.SharedReplicate || header.ExternalReplicate) && !toWitness {
 		var sharedVisitor func(sst *pebble.SharedSSTMeta) error
 		if header.SharedReplicate {
 			sharedVisitor = func(sst *pebble.SharedSSTMeta) error {
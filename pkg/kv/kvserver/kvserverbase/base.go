@@ -115,6 +115,15 @@ var MVCCGCQueueEnabled = settings.RegisterBoolSetting(
 	true,
 )
 
+// ColdStorageQueueEnabled is a setting that controls whether the cold storage
+// queue is enabled.
+var ColdStorageQueueEnabled = settings.RegisterBoolSetting(
+	settings.SystemOnly,
+	"kv.cold_storage_queue.enabled",
+	"whether the cold storage queue is enabled",
+	true,
+)
+
 var allowMMA = envutil.EnvOrDefaultBool("COCKROACH_ALLOW_MMA", false)
 
 // LoadBasedRebalancingMode controls whether range rebalancing takes
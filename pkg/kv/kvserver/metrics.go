@@ -2225,6 +2225,48 @@ The messages are dropped to help these replicas to recover from I/O overload.`,
 		Measurement: "Processing Time",
 		Unit:        metric.Unit_NANOSECONDS,
 	}
+	metaColdStorageQueueSuccesses = metric.Metadata{
+		Name:        "queue.coldstorage.process.success",
+		Help:        "Number of replicas successfully processed by the cold storage queue",
+		Measurement: "Replicas",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaColdStorageQueueFailures = metric.Metadata{
+		Name:        "queue.coldstorage.process.failure",
+		Help:        "Number of replicas which failed processing in the cold storage queue",
+		Measurement: "Replicas",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaColdStorageQueuePending = metric.Metadata{
+		Name:        "queue.coldstorage.pending",
+		Help:        "Number of pending replicas in the cold storage queue",
+		Measurement: "Replicas",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaColdStorageQueueProcessingNanos = metric.Metadata{
+		Name:        "queue.coldstorage.processingnanos",
+		Help:        "Nanoseconds spent processing replicas in the cold storage queue",
+		Measurement: "Processing Time",
+		Unit:        metric.Unit_NANOSECONDS,
+	}
+	metaColdStorageQueueMovedSSTables = metric.Metadata{
+		Name:        "queue.coldstorage.moved.sstables",
+		Help:        "Number of SSTables of range data moved to cold storage by the cold storage queue",
+		Measurement: "SSTables",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaColdStorageQueueMovedBytes = metric.Metadata{
+		Name:        "queue.coldstorage.moved.bytes",
+		Help:        "Number of bytes of SSTables written to cold storage by the cold storage queue",
+		Measurement: "Storage",
+		Unit:        metric.Unit_BYTES,
+	}
+	metaColdStorageQueueDeletedSSTables = metric.Metadata{
+		Name:        "queue.coldstorage.deleted.sstables",
+		Help:        "Number of SSTables deleted from cold storage by the cold storage queue because no store referenced them",
+		Measurement: "SSTables",
+		Unit:        metric.Unit_COUNT,
+	}
 	metaReplicaGCQueueSuccesses = metric.Metadata{
 		Name:        "queue.replicagc.process.success",
 		Help:        "Number of replicas successfully processed by the replica GC queue",
@@ -3338,6 +3380,13 @@ type StoreMetrics struct {
 	ConsistencyQueueFailures                  *metric.Counter
 	ConsistencyQueuePending                   *metric.Gauge
 	ConsistencyQueueProcessingNanos           *metric.Counter
+	ColdStorageQueueSuccesses                 *metric.Counter
+	ColdStorageQueueFailures                  *metric.Counter
+	ColdStorageQueuePending                   *metric.Gauge
+	ColdStorageQueueProcessingNanos           *metric.Counter
+	ColdStorageQueueMovedSSTables             *metric.Counter
+	ColdStorageQueueMovedBytes                *metric.Counter
+	ColdStorageQueueDeletedSSTables           *metric.Counter
 	LeaseQueueSuccesses                       *metric.Counter
 	LeaseQueueFailures                        *metric.Counter
 	LeaseQueuePending                         *metric.Gauge
@@ -4153,6 +4202,13 @@ func newStoreMetrics(histogramWindow time.Duration) *StoreMetrics {
 		ConsistencyQueueFailures:                  metric.NewCounter(metaConsistencyQueueFailures),
 		ConsistencyQueuePending:                   metric.NewGauge(metaConsistencyQueuePending),
 		ConsistencyQueueProcessingNanos:           metric.NewCounter(metaConsistencyQueueProcessingNanos),
+		ColdStorageQueueSuccesses:                 metric.NewCounter(metaColdStorageQueueSuccesses),
+		ColdStorageQueueFailures:                  metric.NewCounter(metaColdStorageQueueFailures),
+		ColdStorageQueuePending:                   metric.NewGauge(metaColdStorageQueuePending),
+		ColdStorageQueueProcessingNanos:           metric.NewCounter(metaColdStorageQueueProcessingNanos),
+		ColdStorageQueueMovedSSTables:             metric.NewCounter(metaColdStorageQueueMovedSSTables),
+		ColdStorageQueueMovedBytes:                metric.NewCounter(metaColdStorageQueueMovedBytes),
+		ColdStorageQueueDeletedSSTables:           metric.NewCounter(metaColdStorageQueueDeletedSSTables),
 		LeaseQueueSuccesses:                       metric.NewCounter(metaLeaseQueueSuccesses),
 		LeaseQueueFailures:                        metric.NewCounter(metaLeaseQueueFailures),
 		LeaseQueuePending:                         metric.NewGauge(metaLeaseQueuePending),
@@ -182,7 +182,9 @@ func (as *AllocatorSync) NonMMAPreChangeReplicas(
 ) SyncChangeID {
 	var isMMARegistered bool
 	var mmaChange mmaprototype.PendingRangeChange
-	if kvserverbase.LoadBasedRebalancingModeIsMMA(&as.st.SV) {
+	// Witnesses don't carry user data or load, so mma doesn't model them.
+	hasWitnessChanges := len(changes.WitnessAdditions())+len(changes.WitnessRemovals()) > 0
+	if kvserverbase.LoadBasedRebalancingModeIsMMA(&as.st.SV) && !hasWitnessChanges {
 		var err error
 		mmaChange, err = convertReplicaChangeToMMA(desc, usage, changes, leaseholderStoreID)
 		if err != nil {
@@ -245,7 +245,7 @@ func TestBufferedSenderOnOverflowMultiStream(t *testing.T) {
 	// Add our stream to the stream manager.
 	sm.RegisteringStream(streamID1)
 	registered, d, _ := p.Register(ctx, h.span, hlc.Timestamp{}, nil, /* catchUpSnap */
-		false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, noBulkDelivery,
+		false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery,
 		sm.NewStream(streamID1, 1 /*rangeID*/))
 	require.True(t, registered)
 	sm.AddStream(streamID1, d)
@@ -253,7 +253,7 @@ func TestBufferedSenderOnOverflowMultiStream(t *testing.T) {
 	// Add a second stream to the stream manager.
 	sm.RegisteringStream(streamID2)
 	registered, d, _ = p.Register(ctx, h.span, hlc.Timestamp{}, nil, /* catchUpIter */
-		false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, noBulkDelivery,
+		false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery,
 		sm.NewStream(streamID2, 1 /*rangeID*/))
 	require.True(t, registered)
 	sm.AddStream(streamID2, d)
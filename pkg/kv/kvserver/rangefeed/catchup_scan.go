@@ -165,6 +165,7 @@ func (i *CatchUpSnapshot) CatchUpScan(
 	withDiff bool,
 	withFiltering bool,
 	withOmitRemote bool,
+	eventFilter *EventFilter,
 	bulkDeliverySize int,
 ) error {
 	var a bufalloc.ByteAllocator
@@ -198,8 +199,13 @@ func (i *CatchUpSnapshot) CatchUpScan(
 	outputEvents := func() error {
 		for i := len(reorderBuf) - 1; i >= 0; i-- {
 			e := reorderBuf[i]
-			if err := outputFn(&e); err != nil {
-				return err
+			// The previous value is only known once the older version has been
+			// visited, so value predicates are applied here rather than when the
+			// event is buffered.
+			if eventFilter.MatchesEvent(e.Val, withDiff) {
+				if err := outputFn(&e); err != nil {
+					return err
+				}
 			}
 			reorderBuf[i] = kvpb.RangeFeedEvent{} // Drop references to values to allow GC
 		}
@@ -354,6 +360,12 @@ func (i *CatchUpSnapshot) CatchUpScan(
 				return errors.AssertionFailedf("expected point key: %s", iter.UnsafeKey())
 			}
 		}
+		// Skip all versions of keys that the registration's event filter
+		// excludes. Previous values aren't needed for them either.
+		if !eventFilter.MatchesKey(key) {
+			iter.NextKey()
+			continue
+		}
 		unsafeValRaw, err := iter.UnsafeValue()
 		if err != nil {
 			return err
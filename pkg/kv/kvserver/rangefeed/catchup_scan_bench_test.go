@@ -53,7 +53,7 @@ func runCatchUpBenchmark(b *testing.B, emk engineMaker, opts benchOptions) (numE
 			err := snap.CatchUpScan(ctx, func(*kvpb.RangeFeedEvent) error {
 				counter++
 				return nil
-			}, opts.withDiff, false /* withFiltering */, false /* withOmitRemote */, noBulkDelivery)
+			}, opts.withDiff, false /* withFiltering */, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery)
 			if err != nil {
 				b.Fatalf("failed catchUp scan: %+v", err)
 			}
@@ -17,6 +17,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/storage/enginepb"
 	"github.com/cockroachdb/cockroach/pkg/testutils"
 	"github.com/cockroachdb/cockroach/pkg/testutils/storageutils"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
@@ -161,7 +162,7 @@ func TestCatchupScan(t *testing.T) {
 				require.NoError(t, snap.CatchUpScan(ctx, func(e *kvpb.RangeFeedEvent) error {
 					events = append(events, *e.Val)
 					return nil
-				}, withDiff, withFiltering, false /* withOmitRemote */, noBulkDelivery))
+				}, withDiff, withFiltering, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery))
 				if !(withFiltering && omitInRangefeeds) {
 					require.Equal(t, 7, len(events))
 				} else {
@@ -239,7 +240,7 @@ func TestCatchupScanOriginID(t *testing.T) {
 		require.NoError(t, snap.CatchUpScan(ctx, func(e *kvpb.RangeFeedEvent) error {
 			events = append(events, *e.Val)
 			return nil
-		}, false /* withDiff */, false /* withFiltering */, omitRemote, noBulkDelivery))
+		}, false /* withDiff */, false /* withFiltering */, omitRemote, nil /* eventFilter */, noBulkDelivery))
 		if omitRemote {
 			require.Equal(t, 1, len(events))
 		} else {
@@ -270,7 +271,7 @@ func TestCatchupScanInlineError(t *testing.T) {
 	require.NoError(t, err)
 	defer snap.Close()
 
-	err = snap.CatchUpScan(ctx, nil, false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, noBulkDelivery)
+	err = snap.CatchUpScan(ctx, nil, false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "unexpected inline value")
 }
@@ -320,9 +321,63 @@ func TestCatchupScanSeesOldIntent(t *testing.T) {
 	require.NoError(t, snap.CatchUpScan(ctx, func(e *kvpb.RangeFeedEvent) error {
 		keys[string(e.Val.Key)] = struct{}{}
 		return nil
-	}, true /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, noBulkDelivery))
+	}, true /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery))
 	require.Equal(t, map[string]struct{}{
 		"b": {},
 		"e": {},
 	}, keys)
 }
+
+func TestCatchupScanEventFilter(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	eng := storage.NewDefaultInMemForTesting(storage.If(smallEngineBlocks, storage.BlockSize(1)))
+	defer eng.Close()
+
+	// Row 1 is written with i = 5 and then updated to i = 6. Row 2 always has
+	// i = 7. Both rows also have a second column family.
+	put := func(key roachpb.Key, ts int64, v roachpb.Value) {
+		_, err := storage.MVCCPut(ctx, eng, key, hlc.Timestamp{WallTime: ts}, v, storage.MVCCWriteOptions{})
+		require.NoError(t, err)
+	}
+	put(makeTestRowKey(104, 1, 0), 2, makeTestRowValue(5, ""))
+	put(makeTestRowKey(104, 1, 0), 3, makeTestRowValue(6, ""))
+	put(makeTestRowKey(104, 1, 1), 2, makeTestRowValue(5, ""))
+	put(makeTestRowKey(104, 2, 0), 2, makeTestRowValue(7, ""))
+	put(makeTestRowKey(104, 2, 1), 2, makeTestRowValue(5, ""))
+
+	filter, err := NewEventFilter(&kvpb.RangeFeedEventFilter{
+		FamilyIDs: []uint32{0},
+		Predicates: []kvpb.RangeFeedValuePredicate{{
+			ColumnID: 2,
+			Op:       kvpb.RangeFeedValuePredicate_EQ,
+			Operand:  encoding.EncodeIntValue(nil, encoding.NoColumnID, 5),
+		}},
+	})
+	require.NoError(t, err)
+
+	testutils.RunTrueAndFalse(t, "withDiff", func(t *testing.T, withDiff bool) {
+		span := roachpb.Span{Key: keys.SystemSQLCodec.TablePrefix(104), EndKey: keys.SystemSQLCodec.TablePrefix(105)}
+		snap := NewCatchUpSnapshot(eng, span, hlc.Timestamp{WallTime: 1}, nil, nil, 0)
+		defer snap.Close()
+		var events []kvpb.RangeFeedValue
+		require.NoError(t, snap.CatchUpScan(ctx, func(e *kvpb.RangeFeedEvent) error {
+			events = append(events, *e.Val)
+			return nil
+		}, withDiff, false /* withFiltering */, false /* withOmitRemote */, filter, noBulkDelivery))
+
+		// Only events on column family 0 of row 1 can match. The update to i = 6
+		// is only emitted with diffs, since its previous value matches.
+		expected := []hlc.Timestamp{{WallTime: 2}}
+		if withDiff {
+			expected = append(expected, hlc.Timestamp{WallTime: 3})
+		}
+		require.Len(t, events, len(expected))
+		for i, ev := range events {
+			require.Equal(t, makeTestRowKey(104, 1, 0), ev.Key)
+			require.Equal(t, expected[i], ev.Value.Timestamp)
+		}
+	})
+}
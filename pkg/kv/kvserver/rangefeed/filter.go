@@ -6,8 +6,16 @@
 package rangefeed
 
 import (
+	"bytes"
+	"cmp"
+	"math"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
 	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
 	"github.com/cockroachdb/cockroach/pkg/util/interval"
+	"github.com/cockroachdb/errors"
 )
 
 // Filter informs the producer of logical operations of the information that a
@@ -48,3 +56,238 @@ func (r *Filter) NeedPrevVal(s roachpb.Span) bool {
 func (r *Filter) NeedVal(s roachpb.Span) bool {
 	return r.needVals.Overlaps(s.AsRange())
 }
+
+// EventFilter is the compiled form of a kvpb.RangeFeedEventFilter. It is used
+// by registrations to drop RangeFeedValue events, both during catch-up scans
+// and for live updates, that the client has indicated it is not interested in.
+// Filtering is conservative: an event is only dropped if it provably does not
+// match the filter. In particular, deletions, non-tuple values, and values
+// that do not contain a predicate's column are never dropped by a predicate.
+//
+// A nil *EventFilter matches every event.
+type EventFilter struct {
+	keyPrefixes []roachpb.Key
+	familyIDs   []uint32
+	predicates  []valuePredicate
+}
+
+// valuePredicate is a compiled kvpb.RangeFeedValuePredicate.
+type valuePredicate struct {
+	colID uint32
+	op    kvpb.RangeFeedValuePredicate_Op
+	typ   encoding.Type
+	// Exactly one of the following is populated, depending on typ.
+	i int64
+	f float64
+	b []byte
+}
+
+// NewEventFilter compiles the given filter, returning an error if it is
+// malformed. A nil or empty filter compiles to a nil *EventFilter.
+func NewEventFilter(filter *kvpb.RangeFeedEventFilter) (*EventFilter, error) {
+	if filter == nil ||
+		(len(filter.KeyPrefixes) == 0 && len(filter.FamilyIDs) == 0 && len(filter.Predicates) == 0) {
+		return nil, nil
+	}
+	f := &EventFilter{
+		keyPrefixes: filter.KeyPrefixes,
+		familyIDs:   filter.FamilyIDs,
+	}
+	for i := range filter.Predicates {
+		p, err := makeValuePredicate(&filter.Predicates[i])
+		if err != nil {
+			return nil, err
+		}
+		f.predicates = append(f.predicates, p)
+	}
+	return f, nil
+}
+
+func makeValuePredicate(pred *kvpb.RangeFeedValuePredicate) (valuePredicate, error) {
+	if _, ok := kvpb.RangeFeedValuePredicate_Op_name[int32(pred.Op)]; !ok {
+		return valuePredicate{}, errors.Errorf("unknown rangefeed predicate operator %d", pred.Op)
+	}
+	if pred.ColumnID == 0 {
+		return valuePredicate{}, errors.New("rangefeed predicate must specify a column ID")
+	}
+	_, _, colIDDelta, typ, err := encoding.DecodeValueTag(pred.Operand)
+	if err != nil {
+		return valuePredicate{}, errors.Wrap(err, "decoding rangefeed predicate operand")
+	}
+	if colIDDelta != encoding.NoColumnID {
+		return valuePredicate{}, errors.New("rangefeed predicate operand must not specify a column ID")
+	}
+	p := valuePredicate{colID: pred.ColumnID, op: pred.Op, typ: typ}
+	switch typ {
+	case encoding.Int:
+		_, p.i, err = encoding.DecodeIntValue(pred.Operand)
+	case encoding.Float:
+		_, p.f, err = encoding.DecodeFloatValue(pred.Operand)
+	case encoding.Bytes:
+		_, p.b, err = encoding.DecodeBytesValue(pred.Operand)
+	case encoding.True, encoding.False:
+		// The operand is fully described by its type.
+	default:
+		return valuePredicate{}, errors.Errorf("unsupported rangefeed predicate operand type %s", typ)
+	}
+	if err != nil {
+		return valuePredicate{}, errors.Wrap(err, "decoding rangefeed predicate operand")
+	}
+	return p, nil
+}
+
+// MatchesKey returns whether events on the given key may match the filter,
+// considering only its key prefixes and column families.
+func (f *EventFilter) MatchesKey(key roachpb.Key) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.keyPrefixes) > 0 {
+		found := false
+		for _, prefix := range f.keyPrefixes {
+			if bytes.HasPrefix(key, prefix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.familyIDs) > 0 {
+		famID, err := keys.DecodeFamilyKey(key)
+		if err != nil {
+			// Not a SQL column family key; the family filter does not apply.
+			return true
+		}
+		for _, id := range f.familyIDs {
+			if id == famID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// MatchesValue returns whether the given value may satisfy the predicates of
+// the filter. Deletions always match.
+func (f *EventFilter) MatchesValue(value []byte) bool {
+	if f == nil || len(f.predicates) == 0 || len(value) == 0 {
+		return true
+	}
+	tuple, err := roachpb.Value{RawBytes: value}.GetTuple()
+	if err != nil {
+		return true
+	}
+	for i := range f.predicates {
+		if !f.predicates[i].eval(tuple) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchesEvent returns whether the given RangeFeedValue event should be
+// emitted. If withDiff is set, the event is emitted if either its value or its
+// previous value satisfies the predicates of the filter.
+func (f *EventFilter) MatchesEvent(ev *kvpb.RangeFeedValue, withDiff bool) bool {
+	if f == nil {
+		return true
+	}
+	if !f.MatchesKey(ev.Key) {
+		return false
+	}
+	if f.MatchesValue(ev.Value.RawBytes) {
+		return true
+	}
+	return withDiff && f.MatchesValue(ev.PrevValue.RawBytes)
+}
+
+// eval returns false only if the tuple-encoded row value provably does not
+// satisfy the predicate.
+func (p *valuePredicate) eval(tuple []byte) bool {
+	var colID uint32
+	for len(tuple) > 0 {
+		typeOffset, dataOffset, colIDDelta, typ, err := encoding.DecodeValueTag(tuple)
+		if err != nil {
+			return true
+		}
+		colID += colIDDelta
+		if colID > p.colID {
+			// Columns are encoded in increasing order of ID, so the column is
+			// absent from this value. It may be NULL, or stored in another
+			// family; either way, we can't tell.
+			return true
+		}
+		if colID < p.colID {
+			n, err := encoding.PeekValueLengthWithOffsetsAndType(tuple, dataOffset, typ)
+			if err != nil {
+				return true
+			}
+			tuple = tuple[n:]
+			continue
+		}
+		return p.compare(tuple[typeOffset:], typ)
+	}
+	return true
+}
+
+// compare evaluates the predicate against the encoded datum, which starts at
+// its type tag.
+func (p *valuePredicate) compare(datum []byte, typ encoding.Type) bool {
+	var c int
+	switch {
+	case typ == encoding.Int && p.typ == encoding.Int:
+		_, i, err := encoding.DecodeIntValue(datum)
+		if err != nil {
+			return true
+		}
+		c = cmp.Compare(i, p.i)
+	case typ == encoding.Float && p.typ == encoding.Float:
+		_, f, err := encoding.DecodeFloatValue(datum)
+		if err != nil || math.IsNaN(f) || math.IsNaN(p.f) {
+			return true
+		}
+		c = cmp.Compare(f, p.f)
+	case typ == encoding.Bytes && p.typ == encoding.Bytes:
+		_, b, err := encoding.DecodeBytesValue(datum)
+		if err != nil {
+			return true
+		}
+		c = bytes.Compare(b, p.b)
+	case (typ == encoding.True || typ == encoding.False) &&
+		(p.typ == encoding.True || p.typ == encoding.False):
+		c = cmpBool(typ == encoding.True, p.typ == encoding.True)
+	default:
+		// Mismatched or unsupported types.
+		return true
+	}
+	switch p.op {
+	case kvpb.RangeFeedValuePredicate_EQ:
+		return c == 0
+	case kvpb.RangeFeedValuePredicate_NE:
+		return c != 0
+	case kvpb.RangeFeedValuePredicate_LT:
+		return c < 0
+	case kvpb.RangeFeedValuePredicate_LE:
+		return c <= 0
+	case kvpb.RangeFeedValuePredicate_GT:
+		return c > 0
+	case kvpb.RangeFeedValuePredicate_GE:
+		return c >= 0
+	default:
+		return true
+	}
+}
+
+func cmpBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
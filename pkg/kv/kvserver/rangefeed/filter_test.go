@@ -0,0 +1,152 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package rangefeed
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+// makeTestRowKey returns the key of the given column family of a row of a
+// table with an INT primary key.
+func makeTestRowKey(tableID uint32, pk int64, famID uint32) roachpb.Key {
+	k := keys.SystemSQLCodec.IndexPrefix(tableID, 1)
+	k = encoding.EncodeVarintAscending(k, pk)
+	return keys.MakeFamilyKey(k, famID)
+}
+
+// makeTestRowValue returns a tuple-encoded value with an INT column with ID 2
+// and, if name is non-empty, a STRING column with ID 3.
+func makeTestRowValue(i int64, name string) roachpb.Value {
+	var b []byte
+	b = encoding.EncodeIntValue(b, 2, i)
+	if name != "" {
+		b = encoding.EncodeBytesValue(b, 1, []byte(name))
+	}
+	var v roachpb.Value
+	v.SetTuple(b)
+	return v
+}
+
+func TestEventFilter(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	intOperand := func(i int64) []byte {
+		return encoding.EncodeIntValue(nil, encoding.NoColumnID, i)
+	}
+	strOperand := func(s string) []byte {
+		return encoding.EncodeBytesValue(nil, encoding.NoColumnID, []byte(s))
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		f, err := NewEventFilter(nil)
+		require.NoError(t, err)
+		require.Nil(t, f)
+		f, err = NewEventFilter(&kvpb.RangeFeedEventFilter{})
+		require.NoError(t, err)
+		require.Nil(t, f)
+		require.True(t, f.MatchesKey(roachpb.Key("a")))
+		require.True(t, f.MatchesValue(makeTestRowValue(1, "").RawBytes))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, pred := range []kvpb.RangeFeedValuePredicate{
+			{ColumnID: 0, Operand: intOperand(1)},
+			{ColumnID: 2, Op: 42, Operand: intOperand(1)},
+			{ColumnID: 2, Operand: nil},
+			{ColumnID: 2, Operand: encoding.EncodeIntValue(nil, 2, 1)},
+			{ColumnID: 2, Operand: encoding.EncodeNullValue(nil, encoding.NoColumnID)},
+		} {
+			_, err := NewEventFilter(&kvpb.RangeFeedEventFilter{
+				Predicates: []kvpb.RangeFeedValuePredicate{pred},
+			})
+			require.Error(t, err, "%+v", pred)
+		}
+	})
+
+	t.Run("keys", func(t *testing.T) {
+		f, err := NewEventFilter(&kvpb.RangeFeedEventFilter{
+			KeyPrefixes: []roachpb.Key{keys.SystemSQLCodec.TablePrefix(104)},
+			FamilyIDs:   []uint32{0, 2},
+		})
+		require.NoError(t, err)
+		require.True(t, f.MatchesKey(makeTestRowKey(104, 1, 0)))
+		require.False(t, f.MatchesKey(makeTestRowKey(104, 1, 1)))
+		require.True(t, f.MatchesKey(makeTestRowKey(104, 1, 2)))
+		require.False(t, f.MatchesKey(makeTestRowKey(105, 1, 0)))
+
+		// The family filter does not apply to keys that aren't SQL keys.
+		f, err = NewEventFilter(&kvpb.RangeFeedEventFilter{FamilyIDs: []uint32{1}})
+		require.NoError(t, err)
+		require.True(t, f.MatchesKey(roachpb.Key("a")))
+		require.False(t, f.MatchesKey(makeTestRowKey(104, 1, 0)))
+	})
+
+	t.Run("predicates", func(t *testing.T) {
+		for _, tc := range []struct {
+			pred  kvpb.RangeFeedValuePredicate
+			value roachpb.Value
+			match bool
+		}{
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: intOperand(5)},
+				value: makeTestRowValue(5, "a"), match: true},
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: intOperand(5)},
+				value: makeTestRowValue(6, "a"), match: false},
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_GT, Operand: intOperand(5)},
+				value: makeTestRowValue(6, "a"), match: true},
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_LE, Operand: intOperand(5)},
+				value: makeTestRowValue(6, "a"), match: false},
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 3, Op: kvpb.RangeFeedValuePredicate_NE, Operand: strOperand("a")},
+				value: makeTestRowValue(6, "a"), match: false},
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 3, Op: kvpb.RangeFeedValuePredicate_LT, Operand: strOperand("b")},
+				value: makeTestRowValue(6, "a"), match: true},
+			// A missing column may be NULL or stored in another family.
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 3, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: strOperand("b")},
+				value: makeTestRowValue(6, ""), match: true},
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 4, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: intOperand(1)},
+				value: makeTestRowValue(6, "a"), match: true},
+			// Mismatched types are never filtered out.
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: strOperand("b")},
+				value: makeTestRowValue(6, "a"), match: true},
+			// Neither are values that aren't tuples, nor deletions.
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: intOperand(5)},
+				value: roachpb.MakeValueFromString("foo"), match: true},
+			{pred: kvpb.RangeFeedValuePredicate{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: intOperand(5)},
+				value: roachpb.Value{}, match: true},
+		} {
+			f, err := NewEventFilter(&kvpb.RangeFeedEventFilter{
+				Predicates: []kvpb.RangeFeedValuePredicate{tc.pred},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.match, f.MatchesValue(tc.value.RawBytes), "%+v on %s", tc.pred, tc.value.PrettyPrint())
+		}
+	})
+
+	t.Run("with diff", func(t *testing.T) {
+		f, err := NewEventFilter(&kvpb.RangeFeedEventFilter{
+			Predicates: []kvpb.RangeFeedValuePredicate{
+				{ColumnID: 2, Op: kvpb.RangeFeedValuePredicate_EQ, Operand: intOperand(5)},
+			},
+		})
+		require.NoError(t, err)
+		ev := &kvpb.RangeFeedValue{
+			Key:       makeTestRowKey(104, 1, 0),
+			Value:     makeTestRowValue(6, ""),
+			PrevValue: makeTestRowValue(5, ""),
+		}
+		// The row no longer matches, but it used to.
+		require.False(t, f.MatchesEvent(ev, false /* withDiff */))
+		require.True(t, f.MatchesEvent(ev, true /* withDiff */))
+	})
+}
@@ -186,6 +186,10 @@ type Processor interface {
 	// updated operation filter that includes the operations required by the new
 	// registration.
 	//
+	// If eventFilter is non-nil, RangeFeedValue events that don't match it are
+	// not delivered to the registration, neither during the catch-up scan nor
+	// afterwards.
+	//
 	// NB: startTS is exclusive; the first possible event will be at startTS.Next().
 	Register(
 		streamCtx context.Context,
@@ -195,6 +199,7 @@ type Processor interface {
 		withDiff bool,
 		withFiltering bool,
 		withOmitRemote bool,
+		eventFilter *EventFilter,
 		bulkDeliverySize int,
 		stream Stream,
 	) (bool, Disconnector, *Filter)
@@ -73,6 +73,7 @@ func TestProcessorBasic(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r1Stream),
 		)
@@ -208,6 +209,7 @@ func TestProcessorBasic(t *testing.T) {
 			true,  /* withDiff */
 			true,  /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r2Stream),
 		)
@@ -321,6 +323,7 @@ func TestProcessorBasic(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r3Stream),
 		)
@@ -343,6 +346,7 @@ func TestProcessorBasic(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r4Stream),
 		)
@@ -371,6 +375,7 @@ func TestProcessorOmitRemote(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r1Stream),
 		)
@@ -397,6 +402,7 @@ func TestProcessorOmitRemote(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			true,  /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r2Stream),
 		)
@@ -457,6 +463,7 @@ func TestProcessorSlowConsumer(t *testing.T) {
 				false, /* withDiff */
 				false, /* withFiltering */
 				false, /* withOmitRemote */
+				nil,   /* eventFilter */
 				noBulkDelivery,
 				h.toBufferedStreamIfNeeded(r1Stream),
 			)
@@ -469,6 +476,7 @@ func TestProcessorSlowConsumer(t *testing.T) {
 				false, /* withDiff */
 				false, /* withFiltering */
 				false, /* withOmitRemote */
+				nil,   /* eventFilter */
 				noBulkDelivery,
 				h.toBufferedStreamIfNeeded(r2Stream),
 			)
@@ -567,6 +575,7 @@ func TestProcessorMemoryBudgetExceeded(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r1Stream),
 		)
@@ -625,6 +634,7 @@ func TestProcessorMemoryBudgetReleased(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r1Stream),
 		)
@@ -708,6 +718,7 @@ func TestProcessorInitializeResolvedTimestamp(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r1Stream),
 		)
@@ -1022,6 +1033,7 @@ func TestProcessorConcurrentStop(t *testing.T) {
 				s := newTestStream()
 				p.Register(s.ctx, h.span, hlc.Timestamp{}, nil, /* catchUpSnap */
 					false /* withDiff */, false /* withFiltering */, false, /* withOmitRemote */
+					nil, /* eventFilter */
 					noBulkDelivery,
 					h.toBufferedStreamIfNeeded(s))
 			}()
@@ -1098,6 +1110,7 @@ func TestProcessorRegistrationObservesOnlyNewEvents(t *testing.T) {
 				regs[s] = firstIdx
 				p.Register(s.ctx, h.span, hlc.Timestamp{}, nil, /* catchUpSnap */
 					false /* withDiff */, false /* withFiltering */, false, /* withOmitRemote */
+					nil, /* eventFilter */
 					noBulkDelivery,
 					h.toBufferedStreamIfNeeded(s))
 				regDone <- struct{}{}
@@ -1161,6 +1174,7 @@ func TestBudgetReleaseOnProcessorStop(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(rStream),
 		)
@@ -1243,6 +1257,7 @@ func TestBudgetReleaseOnLastStreamError(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(rStream),
 		)
@@ -1315,6 +1330,7 @@ func TestBudgetReleaseOnOneStreamError(t *testing.T) {
 			false, /* withDiff */
 			false, /* withFiltering */
 			false, /* withOmitRemote */
+			nil,   /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r1Stream),
 		)
@@ -1328,7 +1344,7 @@ func TestBudgetReleaseOnOneStreamError(t *testing.T) {
 			nil,   /* catchUpSnap */
 			false, /* withDiff */
 			false, /* withFiltering */
-			false /* withOmitRemote */, noBulkDelivery,
+			false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery,
 			h.toBufferedStreamIfNeeded(r2Stream),
 		)
 		h.syncEventAndRegistrations()
@@ -1499,6 +1515,7 @@ func TestProcessorBackpressure(t *testing.T) {
 		stream := newTestStream()
 		ok, _, _ := p.Register(stream.ctx, span, hlc.MinTimestamp, nil, /* catchUpSnap */
 			false /* withDiff */, false /* withFiltering */, false, /* withOmitRemote */
+			nil, /* eventFilter */
 			noBulkDelivery,
 			h.toBufferedStreamIfNeeded(stream))
 		require.True(t, ok)
@@ -1698,6 +1715,7 @@ func TestProcessorMemoryAccountingOnError(t *testing.T) {
 		false, /* withDiff */
 		false, /* withFiltering */
 		false, /* withOmitRemote */
+		nil,   /* eventFilter */
 		noBulkDelivery,
 		sm.NewStream(streamID, 1 /* rangeID */),
 	)
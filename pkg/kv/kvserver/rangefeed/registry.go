@@ -49,6 +49,10 @@ type registration interface {
 	// registration.
 	shouldPublishLogicalOp(hlc.Timestamp, logicalOpMetadata) bool
 
+	// matchesEventFilter returns false if the event is excluded by the
+	// registration's event filter.
+	matchesEventFilter(event *kvpb.RangeFeedEvent) bool
+
 	// runOutputLoop runs the output loop for the registration. The output loop is
 	// meant to be run in a separate goroutine.
 	runOutputLoop(ctx context.Context, forStacks roachpb.RangeID)
@@ -95,6 +99,7 @@ type baseRegistration struct {
 	withDiff         bool
 	withFiltering    bool
 	withOmitRemote   bool
+	eventFilter      *EventFilter
 	bulkDelivery     int
 	catchUpTimestamp hlc.Timestamp // exclusive
 	// removeRegFromProcessor is called to remove the registration from its
@@ -118,6 +123,7 @@ func newBaseRegistration(
 	withDiff bool,
 	withFiltering bool,
 	withOmitRemote bool,
+	eventFilter *EventFilter,
 	bulkDeliverySize int,
 	removeRegFromProcessor func(registration),
 ) baseRegistration {
@@ -129,6 +135,7 @@ func newBaseRegistration(
 		withDiff:               withDiff,
 		withFiltering:          withFiltering,
 		withOmitRemote:         withOmitRemote,
+		eventFilter:            eventFilter,
 		bulkDelivery:           bulkDeliverySize,
 		removeRegFromProcessor: removeRegFromProcessor,
 	}
@@ -177,6 +184,16 @@ func (r *baseRegistration) shouldPublishLogicalOp(
 	return true
 }
 
+func (r *baseRegistration) matchesEventFilter(event *kvpb.RangeFeedEvent) bool {
+	if r.eventFilter == nil {
+		return true
+	}
+	if t, ok := event.GetValue().(*kvpb.RangeFeedValue); ok {
+		return r.eventFilter.MatchesEvent(t, r.withDiff)
+	}
+	return true
+}
+
 func (r *baseRegistration) shouldUnregister() bool {
 	return r.shouldUnreg.Load()
 }
@@ -407,7 +424,7 @@ func (reg *registry) PublishToOverlapping(
 	}
 
 	reg.forOverlappingRegs(ctx, span, func(r registration) (bool, *kvpb.Error) {
-		if r.shouldPublishLogicalOp(minTS, valueMetadata) {
+		if r.shouldPublishLogicalOp(minTS, valueMetadata) && r.matchesEventFilter(event) {
 			r.publish(ctx, event, alloc)
 		}
 		return false, nil
@@ -346,6 +346,12 @@ func withOmitRemote(opt bool) registrationOption {
 	}
 }
 
+func withEventFilter(filter *EventFilter) registrationOption {
+	return func(cfg *testRegistrationConfig) {
+		cfg.eventFilter = filter
+	}
+}
+
 func withRegistrationType(regType registrationType) registrationOption {
 	return func(cfg *testRegistrationConfig) {
 		cfg.withRegistrationTestTypes = regType
@@ -390,6 +396,7 @@ type testRegistrationConfig struct {
 	withDiff                  bool
 	withFiltering             bool
 	withOmitRemote            bool
+	eventFilter               *EventFilter
 	withBulkDelivery          int
 	withRegistrationTestTypes registrationType
 	metrics                   *Metrics
@@ -414,6 +421,7 @@ func newTestRegistration(s *testStream, opts ...registrationOption) testRegistra
 			cfg.withDiff,
 			cfg.withFiltering,
 			cfg.withOmitRemote,
+			cfg.eventFilter,
 			cfg.withBulkDelivery,
 			5,
 			false, /* blockWhenFull */
@@ -430,6 +438,7 @@ func newTestRegistration(s *testStream, opts ...registrationOption) testRegistra
 			cfg.withDiff,
 			cfg.withFiltering,
 			cfg.withOmitRemote,
+			cfg.eventFilter,
 			cfg.withBulkDelivery,
 			5,
 			cfg.metrics,
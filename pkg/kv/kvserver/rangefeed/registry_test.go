@@ -231,6 +231,54 @@ func TestRegistryWithOmitOrigin(t *testing.T) {
 	})
 }
 
+// TestRegistryWithEventFilter verifies that a registration with an event filter
+// does not publish values that don't match it.
+func TestRegistryWithEventFilter(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	ctx := context.Background()
+
+	testutils.RunValues(t, "registration type=", registrationTestTypes, func(t *testing.T, rt registrationType) {
+		val := roachpb.Value{RawBytes: []byte("val"), Timestamp: hlc.Timestamp{WallTime: 1}}
+		ev1, ev2 := new(kvpb.RangeFeedEvent), new(kvpb.RangeFeedEvent)
+		ev1.MustSetValue(&kvpb.RangeFeedValue{Key: keyA, Value: val})
+		ev2.MustSetValue(&kvpb.RangeFeedValue{Key: keyB, Value: val})
+		ev3 := new(kvpb.RangeFeedEvent)
+		ev3.MustSetValue(&kvpb.RangeFeedCheckpoint{Span: spAC, ResolvedTS: hlc.Timestamp{WallTime: 1}})
+
+		filter, err := NewEventFilter(&kvpb.RangeFeedEventFilter{KeyPrefixes: []roachpb.Key{keyA}})
+		require.NoError(t, err)
+
+		reg := makeRegistry(NewMetrics())
+
+		sAC := newTestStream()
+		rAC := newTestRegistration(sAC, withRSpan(spAC), withRegistrationType(rt))
+		filteringStream := newTestStream()
+		filtering := newTestRegistration(filteringStream, withRSpan(spAC),
+			withEventFilter(filter), withRegistrationType(rt))
+
+		go rAC.runOutputLoop(ctx, 0)
+		go filtering.runOutputLoop(ctx, 0)
+
+		defer rAC.Disconnect(nil)
+		defer filtering.Disconnect(nil)
+
+		reg.Register(ctx, rAC)
+		reg.Register(ctx, filtering)
+
+		reg.PublishToOverlapping(ctx, spAC, ev1, logicalOpMetadata{}, nil /* alloc */)
+		reg.PublishToOverlapping(ctx, spAC, ev2, logicalOpMetadata{}, nil /* alloc */)
+		reg.PublishToOverlapping(ctx, spAC, ev3, logicalOpMetadata{}, nil /* alloc */)
+
+		require.NoError(t, reg.waitForCaughtUp(ctx, all))
+
+		require.Equal(t, []*kvpb.RangeFeedEvent{ev1, ev2, ev3}, sAC.GetAndClearEvents())
+		// Checkpoints are never filtered.
+		require.Equal(t, []*kvpb.RangeFeedEvent{ev1, ev3}, filteringStream.GetAndClearEvents())
+		require.Nil(t, sAC.Error())
+		require.Nil(t, filteringStream.Error())
+	})
+}
+
 func TestRegistryBasic(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 	ctx := context.Background()
@@ -91,6 +91,7 @@ func newUnbufferedRegistration(
 	withDiff bool,
 	withFiltering bool,
 	withOmitRemote bool,
+	eventFilter *EventFilter,
 	bulkDeliverySize int,
 	bufferSz int,
 	metrics *Metrics,
@@ -105,6 +106,7 @@ func newUnbufferedRegistration(
 			withDiff,
 			withFiltering,
 			withOmitRemote,
+			eventFilter,
 			bulkDeliverySize,
 			removeRegFromProcessor),
 		metrics: metrics,
@@ -258,7 +260,7 @@ func (ubr *unbufferedRegistration) maybeRunCatchUpScan(ctx context.Context) erro
 		ubr.metrics.RangeFeedCatchUpScanNanos.Inc(start.Elapsed().Nanoseconds())
 	}()
 	return catchUpSnap.CatchUpScan(ctx, ubr.stream.SendUnbuffered, ubr.withDiff, ubr.withFiltering,
-		ubr.withOmitRemote, ubr.bulkDelivery)
+		ubr.withOmitRemote, ubr.eventFilter, ubr.bulkDelivery)
 }
 
 // publishCatchUpBuffer sends all items from catchUpBuf to the sender.
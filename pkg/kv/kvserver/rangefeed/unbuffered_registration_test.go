@@ -50,7 +50,7 @@ func TestUnbufferedRegWithStreamManager(t *testing.T) {
 		for id := int64(0); id < 50; id++ {
 			sm.RegisteringStream(id)
 			registered, d, _ := p.Register(ctx, h.span, hlc.Timestamp{}, nil, /* catchUpSnap */
-				false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, noBulkDelivery,
+				false /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery,
 				sm.NewStream(id, r1))
 			require.True(t, registered)
 			sm.AddStream(id, d)
@@ -146,7 +146,7 @@ func TestUnbufferedRegCorrectnessOnDisconnect(t *testing.T) {
 	sm.RegisteringStream(s1)
 	registered, d, _ := p.Register(ctx, h.span, startTs,
 		makeCatchUpSnap(catchUpIter, span, startTs), /* catchUpSnap */
-		true /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, noBulkDelivery,
+		true /* withDiff */, false /* withFiltering */, false /* withOmitRemote */, nil /* eventFilter */, noBulkDelivery,
 		sm.NewStream(s1, r1))
 	sm.AddStream(s1, d)
 	require.True(t, registered)
@@ -137,6 +137,7 @@ func (b *replicaAppBatch) Stage(
 	}
 
 	// Stage the command's write batch in the application batch.
+	b.ab.witness = isWitnessInDesc(b.state.Desc, b.r.replicaID)
 	if err := b.ab.addWriteBatch(ctx, b.batch, cmd); err != nil {
 		return nil, err
 	}
@@ -296,8 +297,11 @@ func (b *replicaAppBatch) runPostAddTriggersReplicaOnly(
 	}
 	if res.LinkExternalSSTable != nil {
 		// All watching rangefeeds should error until we teach clients how to
-		// process linked external ssts.
-		b.r.disconnectRangefeedSpanWithErr(res.LinkExternalSSTable.Span, kvpb.NewError(errors.New("LinkExternalSSTable not supported in rangefeeds")))
+		// process linked external ssts. An external sst that replaces the
+		// existing data doesn't change any values, so rangefeeds are unaffected.
+		if !res.LinkExternalSSTable.ReplaceExisting {
+			b.r.disconnectRangefeedSpanWithErr(res.LinkExternalSSTable.Span, kvpb.NewError(errors.New("LinkExternalSSTable not supported in rangefeeds")))
+		}
 		res.LinkExternalSSTable = nil
 	}
 
@@ -460,6 +464,17 @@ func (b *replicaAppBatch) runPostAddTriggersReplicaOnly(
 		}
 	}
 
+	// Detect if this command turns us into a witness. If so, stage the removal
+	// of all of the user data we received while we were a learner. From here
+	// on, writes to user keys are dropped by appBatch.addWriteBatch.
+	if change := res.ChangeReplicas; change != nil && !b.changeRemovesReplica &&
+		!isWitnessInDesc(b.state.Desc, b.r.replicaID) &&
+		isWitnessInDesc(change.Desc, b.r.replicaID) {
+		if err := clearWitnessUserData(b.batch, change.Desc); err != nil {
+			return errors.Wrapf(err, "unable to clear user data of witness")
+		}
+	}
+
 	// Provide the command's corresponding logical operations to the Replica's
 	// rangefeed. Only do so if the WriteBatch is non-nil, in which case the
 	// rangefeed requires there to be a corresponding logical operation log or
@@ -1349,6 +1349,59 @@ func (r *Replica) changeReplicasImpl(
 		}
 	}
 
+	if adds := targets.WitnessAdditions; len(adds) > 0 {
+		// Witnesses are added as learners first so that they receive an initial
+		// snapshot (which carries the range's replicated metadata but no user
+		// data) and are caught up on the log before they start voting. Each
+		// learner is then promoted to a witness through a simple configuration
+		// change.
+		desc, err = r.initializeRaftLearners(
+			ctx, desc, senderName, senderQueuePriority, reason, details, adds, roachpb.LEARNER,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, target := range adds {
+			iChgs := []internalReplicationChange{{target: target, typ: internalChangeTypePromoteLearnerToWitness}}
+			var err error
+			desc, err = execChangeReplicasTxn(ctx, r.store.cfg.Tracer(), desc, reason, details, iChgs,
+				changeReplicasTxnArgs{
+					db:                                   r.store.DB(),
+					liveAndDeadReplicas:                  r.store.cfg.StorePool.LiveAndDeadReplicas,
+					logChange:                            r.store.logChange,
+					testForceJointConfig:                 r.store.TestingKnobs().ReplicationAlwaysUseJointConfig,
+					testAllowDangerousReplicationChanges: r.store.TestingKnobs().AllowDangerousReplicationChanges,
+				})
+			if err != nil {
+				// Don't leave a learner replica lying around if we didn't succeed in
+				// promoting it to a witness.
+				log.KvDistribution.Infof(ctx, "could not promote %v to witness, rolling back: %v", adds, err)
+				for _, target := range adds {
+					r.tryRollbackRaftLearner(ctx, r.Desc(), target, reason, details)
+				}
+				return nil, err
+			}
+		}
+	}
+
+	if removals := targets.WitnessRemovals; len(removals) > 0 {
+		for _, rem := range removals {
+			iChgs := []internalReplicationChange{{target: rem, typ: internalChangeTypeRemoveWitness}}
+			var err error
+			desc, err = execChangeReplicasTxn(ctx, r.store.cfg.Tracer(), desc, reason, details, iChgs,
+				changeReplicasTxnArgs{
+					db:                                   r.store.DB(),
+					liveAndDeadReplicas:                  r.store.cfg.StorePool.LiveAndDeadReplicas,
+					logChange:                            r.store.logChange,
+					testForceJointConfig:                 r.store.TestingKnobs().ReplicationAlwaysUseJointConfig,
+					testAllowDangerousReplicationChanges: r.store.TestingKnobs().AllowDangerousReplicationChanges,
+				})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	if len(targets.VoterDemotions) > 0 {
 		// If we demoted or swapped any voters with non-voters, we likely are in a
 		// joint config or have learners on the range. Let's exit the joint config
@@ -1365,6 +1418,7 @@ type TargetsForReplicationChanges struct {
 	VoterDemotions, NonVoterPromotions  []roachpb.ReplicationTarget
 	VoterAdditions, VoterRemovals       []roachpb.ReplicationTarget
 	NonVoterAdditions, NonVoterRemovals []roachpb.ReplicationTarget
+	WitnessAdditions, WitnessRemovals   []roachpb.ReplicationTarget
 }
 
 // SynthesizeTargetsByChangeType groups replication changes in the
@@ -1391,6 +1445,11 @@ func SynthesizeTargetsByChangeType(
 	result.NonVoterAdditions = subtractTargets(chgs.NonVoterAdditions(), chgs.VoterRemovals())
 	result.NonVoterRemovals = subtractTargets(chgs.NonVoterRemovals(), chgs.VoterAdditions())
 
+	// Witnesses are never swapped with other replica types; they are always
+	// added and removed on their own.
+	result.WitnessAdditions = chgs.WitnessAdditions()
+	result.WitnessRemovals = chgs.WitnessRemovals()
+
 	return result
 }
 
@@ -1649,6 +1708,11 @@ func validateAdditionsPerStore(
 					return errors.AssertionFailedf(
 						"trying to add a non-voter to a store that already has a %s", t)
 				}
+			case roachpb.WITNESS:
+				// Witnesses cannot be swapped with other replica types, so no
+				// replica of any type may be added to a store that has one.
+				return errors.AssertionFailedf(
+					"trying to add(%+v) to a store that already has a %s", chg, t)
 			default:
 				return errors.AssertionFailedf("store(%d) being added to already contains a"+
 					" replica of an unexpected type: %s", storeID, t)
@@ -1683,6 +1747,11 @@ func validateRemovals(desc *roachpb.RangeDescriptor, chgsByStoreID changesByStor
 					return errors.AssertionFailedf("type of replica being removed (%s) does not match"+
 						" expectation for change: %+v", t, chg)
 				}
+			case roachpb.WITNESS:
+				if chg.ChangeType != roachpb.REMOVE_WITNESS {
+					return errors.AssertionFailedf("type of replica being removed (%s) does not match"+
+						" expectation for change: %+v", t, chg)
+				}
 			default:
 				return errors.AssertionFailedf("unexpected replica type for removal %+v: %s", chg, t)
 			}
@@ -2227,6 +2296,11 @@ const (
 	// https://github.com/cockroachdb/cockroach/pull/40268
 	internalChangeTypeRemoveLearner
 	internalChangeTypeRemoveNonVoter
+	// internalChangeTypePromoteLearnerToWitness turns a learner that has
+	// received its initial snapshot into a WITNESS. Since a witness is never in
+	// a transitional state, this is always a simple configuration change.
+	internalChangeTypePromoteLearnerToWitness
+	internalChangeTypeRemoveWitness
 )
 
 // internalReplicationChange is a replication target together with an internal
@@ -2312,6 +2386,31 @@ func prepareChangeReplicasTrigger(
 						prevTyp, chg.target)
 				}
 				removed = append(removed, rDesc)
+			case internalChangeTypePromoteLearnerToWitness:
+				if useJoint {
+					return nil, errors.Errorf("cannot promote target %v to WITNESS in a joint config",
+						chg.target)
+				}
+				rDesc, prevTyp, ok := updatedDesc.SetReplicaType(chg.target.NodeID, chg.target.StoreID, roachpb.WITNESS)
+				if !ok || prevTyp != roachpb.LEARNER {
+					return nil, errors.Errorf("cannot promote target %v which is missing as LEARNER",
+						chg.target)
+				}
+				added = append(added, rDesc)
+			case internalChangeTypeRemoveWitness:
+				if useJoint {
+					return nil, errors.Errorf("cannot remove WITNESS target %v in a joint config",
+						chg.target)
+				}
+				rDesc, ok := updatedDesc.RemoveReplica(chg.target.NodeID, chg.target.StoreID)
+				if !ok {
+					return nil, errors.Errorf("target %v not found", chg.target)
+				}
+				if prevTyp := rDesc.Type; prevTyp != roachpb.WITNESS {
+					return nil, errors.Errorf("cannot remove %s target %v, not a WITNESS",
+						prevTyp, chg.target)
+				}
+				removed = append(removed, rDesc)
 			case internalChangeTypeDemoteVoterToLearner:
 				rDesc, ok := updatedDesc.GetReplicaDescriptor(chg.target.StoreID)
 				if !ok {
@@ -2692,17 +2791,22 @@ func recordRangeEventsInLog(
 	logChange logChangeFn,
 ) error {
 	for _, repDesc := range repDescs {
-		isNonVoter := repDesc.Type == roachpb.NON_VOTER
 		var typ roachpb.ReplicaChangeType
-		if added {
-			typ = roachpb.ADD_VOTER
-			if isNonVoter {
+		switch repDesc.Type {
+		case roachpb.NON_VOTER:
+			typ = roachpb.REMOVE_NON_VOTER
+			if added {
 				typ = roachpb.ADD_NON_VOTER
 			}
-		} else {
+		case roachpb.WITNESS:
+			typ = roachpb.REMOVE_WITNESS
+			if added {
+				typ = roachpb.ADD_WITNESS
+			}
+		default:
 			typ = roachpb.REMOVE_VOTER
-			if isNonVoter {
-				typ = roachpb.REMOVE_NON_VOTER
+			if added {
+				typ = roachpb.ADD_VOTER
 			}
 		}
 		if err := logChange(
@@ -2962,6 +3066,13 @@ func (r *Replica) sendSnapshotUsingDelegate(
 		return err
 	}
 
+	if sender.IsWitness() {
+		// A witness doesn't store user data, so it can't coordinate a snapshot.
+		// It also never stays the raft leader for long, see
+		// maybeTransferRaftLeadershipAwayFromWitnessLocked.
+		return errors.Errorf("skipping snapshot; %s is a witness", sender)
+	}
+
 	if destPaused {
 		// If the destination is paused, be more hesitant to send snapshots. The destination being
 		// paused implies that we have recently checked that it's not required for quorum, and that
@@ -3090,6 +3201,11 @@ func (r *Replica) validateSnapshotDelegationRequest(
 	ctx context.Context, req *kvserverpb.DelegateSendSnapshotRequest,
 ) error {
 	desc := r.Desc()
+	// Witnesses don't store user data, so they can't send snapshots.
+	if isWitnessInDesc(desc, r.replicaID) {
+		return errors.Errorf("%s: witness cannot send a snapshot", r)
+	}
+
 	// If the delegate doesn't know about a generation change (its index is lower
 	// than the leaseholders) the snapshot it sends may be useless, so don't
 	// attempt to send it and instead return an error.
@@ -3306,9 +3422,11 @@ func (r *Replica) followerSendSnapshot(
 
 	// Use external replication if we aren't using shared
 	// replication, are dealing with a non-system range, are on at
-	// least 24.1, and our store has external files.
+	// least 24.1, and our store has external files. Ranges that
+	// move their data to cold storage always send their external
+	// files as metadata, since the files are shared by all replicas.
 	externalReplicate := !sharedReplicate && nonSystemRange &&
-		externalFileSnapshotting.Get(&r.store.ClusterSettings().SV)
+		(externalFileSnapshotting.Get(&r.store.ClusterSettings().SV) || r.coldStorageEnabled())
 	if externalReplicate {
 		start := snap.State.Desc.StartKey.AsRawKey()
 		end := snap.State.Desc.EndKey.AsRawKey()
@@ -3867,6 +3985,7 @@ func RelocateOne(
 			conf,
 			existingVoters,
 			existingNonVoters,
+			desc.Replicas().WitnessDescriptors(),
 			allocator.ScorerOptions(ctx),
 			allocator.NewBestCandidateSelector(),
 			// NB: Allow the allocator to return target stores that might be on the
@@ -129,6 +129,13 @@ func TestValidateReplicationChanges(t *testing.T) {
 			{NodeID: 1, StoreID: 1},
 		},
 	}
+	twoVotersAndAWitness := &roachpb.RangeDescriptor{
+		InternalReplicas: []roachpb.ReplicaDescriptor{
+			{NodeID: 1, StoreID: 1},
+			{NodeID: 2, StoreID: 2},
+			{NodeID: 3, StoreID: 3, Type: roachpb.WITNESS},
+		},
+	}
 
 	type testCase struct {
 		name          string
@@ -411,6 +418,39 @@ func TestValidateReplicationChanges(t *testing.T) {
 			shouldFail:    true,
 			expErrorRegex: "trying to remove a replica that doesn't exist",
 		},
+		{
+			name:      "add a witness to another node",
+			rangeDesc: twoVotersAndAWitness,
+			changes: kvpb.ReplicationChanges{
+				{ChangeType: roachpb.ADD_WITNESS, Target: roachpb.ReplicationTarget{NodeID: 4, StoreID: 4}},
+			},
+		},
+		{
+			name:      "remove a witness",
+			rangeDesc: twoVotersAndAWitness,
+			changes: kvpb.ReplicationChanges{
+				{ChangeType: roachpb.REMOVE_WITNESS, Target: roachpb.ReplicationTarget{NodeID: 3, StoreID: 3}},
+			},
+		},
+		{
+			name:      "remove a witness as a voter",
+			rangeDesc: twoVotersAndAWitness,
+			changes: kvpb.ReplicationChanges{
+				{ChangeType: roachpb.REMOVE_VOTER, Target: roachpb.ReplicationTarget{NodeID: 3, StoreID: 3}},
+			},
+			shouldFail:    true,
+			expErrorRegex: "type of replica being removed \\(WITNESS\\) does not match",
+		},
+		{
+			name:      "add a voter to a store that has a witness",
+			rangeDesc: twoVotersAndAWitness,
+			changes: kvpb.ReplicationChanges{
+				{ChangeType: roachpb.ADD_VOTER, Target: roachpb.ReplicationTarget{NodeID: 3, StoreID: 3}},
+				{ChangeType: roachpb.REMOVE_WITNESS, Target: roachpb.ReplicationTarget{NodeID: 3, StoreID: 3}},
+			},
+			shouldFail:    true,
+			expErrorRegex: "to a store that already has a WITNESS",
+		},
 	}
 
 	for _, test := range tests {
@@ -433,6 +473,7 @@ func TestSynthesizeTargetsByChangeType(t *testing.T) {
 		expPromotions, expDemotions               []int32
 		expVoterAdditions, expVoterRemovals       []int32
 		expNonVoterAdditions, expNonVoterRemovals []int32
+		expWitnessAdditions, expWitnessRemovals   []int32
 	}
 
 	mkTarget := func(t int32) roachpb.ReplicationTarget {
@@ -481,6 +522,20 @@ func TestSynthesizeTargetsByChangeType(t *testing.T) {
 			},
 			expNonVoterRemovals: []int32{2},
 		},
+		{
+			name: "simple witness addition",
+			changes: []kvpb.ReplicationChange{
+				{ChangeType: roachpb.ADD_WITNESS, Target: mkTarget(2)},
+			},
+			expWitnessAdditions: []int32{2},
+		},
+		{
+			name: "simple witness removal",
+			changes: []kvpb.ReplicationChange{
+				{ChangeType: roachpb.REMOVE_WITNESS, Target: mkTarget(2)},
+			},
+			expWitnessRemovals: []int32{2},
+		},
 		{
 			name: "promote non_voter to voter",
 			changes: []kvpb.ReplicationChange{
@@ -605,6 +660,8 @@ func TestSynthesizeTargetsByChangeType(t *testing.T) {
 			require.Equal(t, result.VoterRemovals, mkTargetList(test.expVoterRemovals))
 			require.Equal(t, result.NonVoterAdditions, mkTargetList(test.expNonVoterAdditions))
 			require.Equal(t, result.NonVoterRemovals, mkTargetList(test.expNonVoterRemovals))
+			require.Equal(t, result.WitnessAdditions, mkTargetList(test.expWitnessAdditions))
+			require.Equal(t, result.WitnessRemovals, mkTargetList(test.expWitnessRemovals))
 		})
 	}
 }
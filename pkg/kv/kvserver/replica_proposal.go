@@ -754,7 +754,7 @@ func linkExternalSStablePreApply(
 	term kvpb.RaftTerm,
 	index kvpb.RaftIndex,
 	sst kvserverpb.ReplicatedEvalResult_LinkExternalSSTable,
-) {
+) error {
 	log.KvExec.VInfof(ctx, 1,
 		"linking external sstable %s (size %d, span %s) from %s (size %d) at rewrite ts %s, synth prefix %s",
 		sst.RemoteFilePath,
@@ -795,6 +795,7 @@ func linkExternalSStablePreApply(
 		SyntheticSuffix:   syntheticSuffix,
 		SyntheticPrefix:   syntheticPrefix,
 		HasPointKey:       true,
+		HasRangeKey:       sst.HasRangeKey,
 	}
 	tBegin := timeutil.Now()
 	defer func() {
@@ -805,11 +806,21 @@ func linkExternalSStablePreApply(
 		}
 	}()
 
-	_, ingestErr := env.eng.IngestExternalFiles(ctx, []pebble.ExternalFile{externalFile})
+	var ingestErr error
+	if sst.ReplaceExisting {
+		// The ExternalSST holds the same data as the span, which is excised
+		// atomically with the ingestion so that the local copy of the data is
+		// dropped.
+		_, ingestErr = env.eng.IngestAndExciseFiles(
+			ctx, nil /* paths */, nil /* shared */, []pebble.ExternalFile{externalFile}, sst.Span)
+	} else {
+		_, ingestErr = env.eng.IngestExternalFiles(ctx, []pebble.ExternalFile{externalFile})
+	}
 	if ingestErr != nil {
-		log.KvExec.Fatalf(ctx, "while ingesting %s: %v", sst.RemoteFilePath, ingestErr)
+		return errors.Wrapf(ingestErr, "while ingesting %s", sst.RemoteFilePath)
 	}
 	log.Eventf(ctx, "ingested SSTable at index %d, term %d: external %s", index, term, sst.RemoteFilePath)
+	return nil
 }
 
 // ingestViaCopy writes the SST to ingestPath (with rate limiting) and then ingests it
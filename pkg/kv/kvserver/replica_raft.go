@@ -1223,6 +1223,12 @@ func (r *Replica) handleRaftReadyRaftMuLocked(
 		// previously the leader.
 		becameLeader = leaderID == r.replicaID
 	}
+	if becameLeader {
+		// A witness that won an election must not wait for the next tick to
+		// hand the leadership over.
+		r.maybeTransferRaftLeadershipAwayFromWitnessLocked(
+			ctx, r.leaseStatusAtRLocked(ctx, r.store.Clock().NowAsClockTimestamp()))
+	}
 	r.mu.Unlock()
 
 	// When becoming the leader, proactively add the replica to the replicate
@@ -1433,7 +1439,9 @@ func (r *Replica) tick(
 		return false, nil
 	}
 
-	r.maybeTransferRaftLeadershipToLeaseholderLocked(ctx, leaseStatus)
+	if !r.maybeTransferRaftLeadershipAwayFromWitnessLocked(ctx, leaseStatus) {
+		r.maybeTransferRaftLeadershipToLeaseholderLocked(ctx, leaseStatus)
+	}
 
 	// Eagerly acquire or extend leases. This only works for unquiesced ranges. We
 	// never quiesce expiration leases, but for epoch leases we fall back to the
@@ -2526,7 +2534,14 @@ func shouldCampaignOnLeaseRequestRedirect(
 // (pre)votes without campaigning themselves. Followers and pre-candidates will
 // also grant any number of pre-votes, both for themselves and anyone else
 // that's eligible.
+//
+// Witnesses never campaign, since they must not become the leader. See
+// maybeTransferRaftLeadershipAwayFromWitnessLocked.
 func (r *Replica) campaignLocked(ctx context.Context) {
+	if isWitnessInDesc(r.descRLocked(), r.replicaID) {
+		log.VEventf(ctx, 3, "not campaigning as a witness")
+		return
+	}
 	log.VEventf(ctx, 3, "campaigning")
 	if err := r.mu.internalRaftGroup.Campaign(); err != nil {
 		log.VEventf(ctx, 1, "failed to campaign: %s", err)
@@ -2547,6 +2562,10 @@ func (r *Replica) campaignLocked(ctx context.Context) {
 // to complete #129796. See the comment in raft.go about how even a local
 // fortification check is not enough to make MsgTimeoutNow safe.
 func (r *Replica) forceCampaignLocked(ctx context.Context, raftStatus raft.BasicStatus) {
+	if isWitnessInDesc(r.descRLocked(), r.replicaID) {
+		log.VEventf(ctx, 3, "not force campaigning as a witness")
+		return
+	}
 	log.VEventf(ctx, 3, "force campaigning")
 	msg := raftpb.Message{
 		To: raftpb.PeerID(r.replicaID),
@@ -245,6 +245,11 @@ func (r *Replica) RangeFeed(
 		return nil, errors.Errorf("multiple origin IDs and OriginID != 0 not supported yet")
 	}
 
+	eventFilter, err := rangefeed.NewEventFilter(args.Filter)
+	if err != nil {
+		return nil, err
+	}
+
 	// If the RangeFeed is performing a catch-up scan then it will observe all
 	// values above args.Timestamp. If the RangeFeed is requesting previous
 	// values for every update then it will also need to look for the version
@@ -327,7 +332,8 @@ func (r *Replica) RangeFeed(
 		bulkDeliverySize = int(rangeFeedBulkDeliverySize.Get(&r.store.ClusterSettings().SV))
 	}
 	p, disconnector, err := r.registerWithRangefeedRaftMuLocked(
-		streamCtx, rSpan, args.Timestamp, catchUpSnap, args.WithDiff, args.WithFiltering, omitRemote, bulkDeliverySize, stream,
+		streamCtx, rSpan, args.Timestamp, catchUpSnap, args.WithDiff, args.WithFiltering, omitRemote, eventFilter,
+		bulkDeliverySize, stream,
 	)
 	r.raftMu.Unlock()
 
@@ -435,6 +441,7 @@ func (r *Replica) registerWithRangefeedRaftMuLocked(
 	withDiff bool,
 	withFiltering bool,
 	withOmitRemote bool,
+	eventFilter *rangefeed.EventFilter,
 	bulkDeliverySize int,
 	stream rangefeed.Stream,
 ) (rangefeed.Processor, rangefeed.Disconnector, error) {
@@ -456,8 +463,8 @@ func (r *Replica) registerWithRangefeedRaftMuLocked(
 	p := r.rangefeedMu.proc
 
 	if p != nil {
-		reg, disconnector, filter := p.Register(streamCtx, span, startTS, catchUpSnap, withDiff, withFiltering, withOmitRemote, bulkDeliverySize,
-			stream)
+		reg, disconnector, filter := p.Register(streamCtx, span, startTS, catchUpSnap, withDiff, withFiltering, withOmitRemote, eventFilter,
+			bulkDeliverySize, stream)
 		if reg {
 			// Registered successfully with an existing processor.
 			// Update the rangefeed filter to avoid filtering ops
@@ -532,7 +539,7 @@ func (r *Replica) registerWithRangefeedRaftMuLocked(
 	// this ensures that the only time the registration fails is during
 	// server shutdown.
 	reg, disconnector, filter := p.Register(streamCtx, span, startTS, catchUpSnap, withDiff,
-		withFiltering, withOmitRemote, bulkDeliverySize, stream)
+		withFiltering, withOmitRemote, eventFilter, bulkDeliverySize, stream)
 	if !reg {
 		select {
 		case <-r.store.Stopper().ShouldQuiesce():
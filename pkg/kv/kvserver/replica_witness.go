@@ -0,0 +1,163 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package kvserver
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/kvserverpb"
+	"github.com/cockroachdb/cockroach/pkg/raft/raftpb"
+	"github.com/cockroachdb/cockroach/pkg/raft/tracker"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/storage"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/pebble"
+)
+
+// isWitnessInDesc returns whether the replica with the given ID is a WITNESS
+// in the given range descriptor.
+func isWitnessInDesc(desc *roachpb.RangeDescriptor, replicaID roachpb.ReplicaID) bool {
+	if desc == nil {
+		return false
+	}
+	repl, ok := desc.GetReplicaDescriptorByID(replicaID)
+	return ok && repl.IsWitness()
+}
+
+// stageWitnessWriteBatch stages the given WriteBatch representation in the
+// batch, skipping all of its mutations to user (i.e. global) keys. Witnesses
+// keep the replicated range-local state (the range descriptor, the lease, the
+// applied state, etc) and the lock table, but never store user data.
+func stageWitnessWriteBatch(batch storage.Batch, repr []byte) error {
+	r, err := storage.NewBatchReader(repr)
+	if err != nil {
+		return err
+	}
+	for r.Next() {
+		ek, err := r.EngineKey()
+		if err != nil {
+			return err
+		}
+		if !keys.IsLocal(ek.Key) {
+			continue
+		}
+		switch kind := r.KeyKind(); kind {
+		case pebble.InternalKeyKindDelete, pebble.InternalKeyKindSingleDelete:
+			if err := batch.PutInternalPointKey(&pebble.InternalKey{
+				UserKey: r.Key(),
+				Trailer: pebble.MakeInternalKeyTrailer(0, kind),
+			}, nil /* value */); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindSet, pebble.InternalKeyKindSetWithDelete,
+			pebble.InternalKeyKindMerge, pebble.InternalKeyKindDeleteSized:
+			if err := batch.PutInternalPointKey(&pebble.InternalKey{
+				UserKey: r.Key(),
+				Trailer: pebble.MakeInternalKeyTrailer(0, kind),
+			}, r.Value()); err != nil {
+				return err
+			}
+		case pebble.InternalKeyKindRangeDelete:
+			end, err := r.EndKey()
+			if err != nil {
+				return err
+			}
+			if err := batch.ClearRawEncodedRange(r.Key(), end); err != nil {
+				return err
+			}
+		default:
+			// Range keys are only ever written to the user keyspace.
+			return errors.AssertionFailedf("unexpected %s entry for local key %s", kind, ek.Key)
+		}
+	}
+	return r.Error()
+}
+
+// clearWitnessUserData stages the removal of all user keys of the range in the
+// batch. It is used when a learner, which received a full snapshot, is
+// promoted to a WITNESS.
+func clearWitnessUserData(batch storage.Batch, desc *roachpb.RangeDescriptor) error {
+	span := desc.KeySpan().AsRawSpanWithNoLocals()
+	return batch.ClearRawRange(span.Key, span.EndKey, true /* pointKeys */, true /* rangeKeys */)
+}
+
+// maybeTransferRaftLeadershipAwayFromWitnessLocked transfers the raft
+// leadership away from this replica if it is the leader and a WITNESS, and
+// returns whether it is one. Witnesses vote so that the range can reach a
+// quorum, but they never campaign on their own initiative (see
+// campaignLocked). They can still win an election after the election timeout
+// though, and must then hand the leadership over at once: they can't hold the
+// lease, which needs to be collocated with the leadership, nor catch up other
+// replicas from their state since they don't store user data.
+//
+// The leadership is transferred to the leaseholder if it is a non-witness
+// voter, and otherwise to the non-witness voter which is the furthest ahead
+// in the log.
+func (r *Replica) maybeTransferRaftLeadershipAwayFromWitnessLocked(
+	ctx context.Context, leaseStatus kvserverpb.LeaseStatus,
+) bool {
+	raftStatus := r.mu.internalRaftGroup.BasicStatus()
+	if raftStatus.RaftState != raftpb.StateLeader {
+		return false
+	}
+	desc := r.descRLocked()
+	if !isWitnessInDesc(desc, r.replicaID) {
+		return false
+	}
+	target, ok := witnessLeadershipTransferTarget(desc, r.replicaID, leaseStatus,
+		func(id roachpb.ReplicaID) *tracker.Progress {
+			return r.mu.internalRaftGroup.ReplicaProgress(raftpb.PeerID(id))
+		})
+	if !ok {
+		log.VEventf(ctx, 1, "witness is raft leader but there is no voter to transfer the leadership to")
+		return true
+	}
+	if raftStatus.LeadTransferee == raftpb.PeerID(target) {
+		return true
+	}
+	log.VEventf(ctx, 1, "transferring raft leadership away from witness to replica ID %v", target)
+	r.store.metrics.RangeRaftLeaderTransfers.Inc(1)
+	r.mu.internalRaftGroup.TransferLeader(raftpb.PeerID(target))
+	r.store.enqueueRaftUpdateCheck(r.RangeID)
+	return true
+}
+
+// witnessLeadershipTransferTarget returns the replica that a WITNESS raft
+// leader with the given ID should transfer the leadership to. Only full voters
+// other than the witness itself are eligible.
+func witnessLeadershipTransferTarget(
+	desc *roachpb.RangeDescriptor,
+	witnessID roachpb.ReplicaID,
+	leaseStatus kvserverpb.LeaseStatus,
+	progress func(roachpb.ReplicaID) *tracker.Progress,
+) (roachpb.ReplicaID, bool) {
+	eligible := func(repl roachpb.ReplicaDescriptor) bool {
+		return repl.ReplicaID != witnessID && repl.Type == roachpb.VOTER_FULL
+	}
+	if leaseStatus.IsValid() {
+		if lh, ok := desc.GetReplicaDescriptorByID(leaseStatus.Lease.Replica.ReplicaID); ok && eligible(lh) {
+			return lh.ReplicaID, true
+		}
+	}
+	var target roachpb.ReplicaID
+	var targetMatch uint64
+	found := false
+	for _, repl := range desc.Replicas().Descriptors() {
+		if !eligible(repl) {
+			continue
+		}
+		var match uint64
+		if pr := progress(repl.ReplicaID); pr != nil {
+			match = pr.Match
+		}
+		if !found || match > targetMatch {
+			target, targetMatch, found = repl.ReplicaID, match, true
+		}
+	}
+	return target, found
+}
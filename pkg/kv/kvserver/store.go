@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/cloud"
 	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/config"
 	"github.com/cockroachdb/cockroach/pkg/config/zonepb"
@@ -909,6 +910,7 @@ type Store struct {
 	scanner              *replicaScanner             // Replica scanner
 	consistencyQueue     *consistencyQueue           // Replica consistency check queue
 	consistencyLimiter   *quotapool.RateLimiter      // Rate limits consistency checks
+	coldStorageQueue     *coldStorageQueue           // Cold storage queue
 	metrics              *StoreMetrics
 	intentResolver       *intentresolver.IntentResolver
 	recoveryMgr          txnrecovery.Manager
@@ -1302,6 +1304,14 @@ type StoreConfig struct {
 	// SharedStorageEnabled stores whether this store is configured with a
 	// shared.Storage instance and can accept shared snapshots.
 	SharedStorageEnabled bool
+	// ColdStorageAccessor is used to write the SSTables of ranges that are
+	// moved to cold storage. If nil, ranges are never moved to cold storage.
+	ColdStorageAccessor *cloud.EarlyBootExternalStorageAccessor
+	// ColdStorageSpanStats returns the stats of spans across all nodes. It is
+	// used to determine whether any store still references the SSTables in cold
+	// storage. If nil, only SSTables whose data was replaced by newer SSTables
+	// are deleted from cold storage.
+	ColdStorageSpanStats func(context.Context, *roachpb.SpanStatsRequest) (*roachpb.SpanStatsResponse, error)
 
 	// KVAdmissionController is used for admission control.
 	KVAdmissionController kvadmission.Controller
@@ -1776,12 +1786,14 @@ func NewStore(
 		s.raftLogQueue = newRaftLogQueue(s, s.db)
 		s.raftSnapshotQueue = newRaftSnapshotQueue(s)
 		s.consistencyQueue = newConsistencyQueue(s)
+		s.coldStorageQueue = newColdStorageQueue(s)
 		// NOTE: If more queue types are added, please also add them to the list of
 		// queues on the EnqueueRange debug page as defined in
 		// pkg/ui/src/views/reports/containers/enqueueRange/index.tsx
 		s.scanner.AddQueues(
 			s.mvccGCQueue, s.mergeQueue, s.splitQueue, s.replicateQueue, s.replicaGCQueue,
-			s.raftLogQueue, s.raftSnapshotQueue, s.consistencyQueue, s.leaseQueue)
+			s.raftLogQueue, s.raftSnapshotQueue, s.consistencyQueue, s.leaseQueue,
+			s.coldStorageQueue)
 		tsDS := s.cfg.TimeSeriesDataStore
 		if s.cfg.TestingKnobs.TimeSeriesDataStore != nil {
 			tsDS = s.cfg.TestingKnobs.TimeSeriesDataStore
@@ -4006,8 +4018,10 @@ func (s *Store) AllocatorCheckRange(
 		return action, roachpb.ReplicationTarget{}, sp.FinishAndGetConfiguredRecording(), err
 	}
 
+	witnesses := desc.Replicas().WitnessDescriptors()
 	target, _, err := s.allocator.AllocateTarget(ctx, storePool, &conf,
-		filteredVoters, filteredNonVoters, replacing, action.ReplicaStatus(), action.TargetReplicaType(),
+		filteredVoters, filteredNonVoters, witnesses, replacing, action.ReplicaStatus(),
+		action.TargetReplicaType(),
 	)
 	if err == nil {
 		log.Eventf(ctx, "found valid allocation of %s target %v", action.TargetReplicaType(), target)
@@ -4020,6 +4034,7 @@ func (s *Store) AllocatorCheckRange(
 			&conf,
 			desc.Replicas().VoterDescriptors(),
 			filteredVoters,
+			witnesses,
 			action.ReplicaStatus(),
 			action.TargetReplicaType(),
 			target,
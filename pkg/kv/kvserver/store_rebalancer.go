@@ -1113,6 +1113,7 @@ func (sr *StoreRebalancer) getRebalanceTargetsBasedOnLoad(
 			rbCtx.candidateReplica.RaftStatus(),
 			finalVoterTargets,
 			finalNonVoterTargets,
+			rbCtx.rangeDesc.Replicas().WitnessDescriptors(),
 			rbCtx.candidateReplica.RangeUsageInfo(),
 			storepool.StoreFilterSuspect,
 			allocatorimpl.VoterTarget,
@@ -1178,6 +1179,7 @@ func (sr *StoreRebalancer) getRebalanceTargetsBasedOnLoad(
 			rbCtx.candidateReplica.RaftStatus(),
 			finalVoterTargets,
 			finalNonVoterTargets,
+			rbCtx.rangeDesc.Replicas().WitnessDescriptors(),
 			rbCtx.candidateReplica.RangeUsageInfo(),
 			storepool.StoreFilterSuspect,
 			allocatorimpl.NonVoterTarget,
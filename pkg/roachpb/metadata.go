@@ -484,6 +484,12 @@ func (r ReplicaDescriptor) IsNonVoter() bool {
 	}
 }
 
+// IsWitness returns true if the replica is a witness. Can be used as a filter
+// for ReplicaDescriptors.Filter.
+func (r ReplicaDescriptor) IsWitness() bool {
+	return r.Type == WITNESS
+}
+
 // PercentilesFromData derives percentiles from a slice of data points.
 // Sorts the input data if it isn't already sorted.
 func PercentilesFromData(data []float64) Percentiles {
@@ -84,6 +84,10 @@ func predNonVoter(rDesc ReplicaDescriptor) bool {
 	return rDesc.Type == NON_VOTER
 }
 
+func predWitness(rDesc ReplicaDescriptor) bool {
+	return rDesc.Type == WITNESS
+}
+
 func predVoterOrNonVoter(rDesc ReplicaDescriptor) bool {
 	return predVoterFullOrIncoming(rDesc) || predNonVoter(rDesc)
 }
@@ -221,6 +225,19 @@ func (d ReplicaSet) NonVoterDescriptors() []ReplicaDescriptor {
 	return d.FilterToDescriptors(predNonVoter)
 }
 
+// Witnesses returns a ReplicaSet containing only the witnesses in `d`.
+// Witnesses take part in Raft elections and count towards the quorum, but they
+// don't store user data. They are therefore not included in Voters(), which
+// callers use to find replicas that can serve requests or hold the lease.
+func (d ReplicaSet) Witnesses() ReplicaSet {
+	return d.Filter(predWitness)
+}
+
+// WitnessDescriptors returns the witness replica descriptors in the set.
+func (d ReplicaSet) WitnessDescriptors() []ReplicaDescriptor {
+	return d.FilterToDescriptors(predWitness)
+}
+
 // VoterFullAndNonVoterDescriptors returns the descriptors of
 // VOTER_FULL/NON_VOTER replicas in the set. This set will not contain learners
 // or, during an atomic replication change, incoming or outgoing voters.
@@ -309,7 +326,7 @@ func (d ReplicaSet) InAtomicReplicationChange() bool {
 		case VOTER_INCOMING, VOTER_OUTGOING, VOTER_DEMOTING_LEARNER,
 			VOTER_DEMOTING_NON_VOTER:
 			return true
-		case VOTER_FULL, LEARNER, NON_VOTER:
+		case VOTER_FULL, LEARNER, NON_VOTER, WITNESS:
 		default:
 			panic(fmt.Sprintf("unknown replica type %d", rDesc.Type))
 		}
@@ -332,6 +349,18 @@ func (d ReplicaSet) ConfState() raftpb.ConfState {
 			if joint {
 				cs.VotersOutgoing = append(cs.VotersOutgoing, id)
 			}
+		case WITNESS:
+			// Raft has no notion of witnesses: they must count towards the quorum
+			// of both the incoming and the outgoing config, so they're voters as
+			// far as raft is concerned. Raft would thus let them become the leader,
+			// which they must never remain since they don't store user data and
+			// can't hold the lease. kvserver enforces this: witnesses never
+			// campaign and transfer the leadership away as soon as they acquire
+			// it.
+			cs.Voters = append(cs.Voters, id)
+			if joint {
+				cs.VotersOutgoing = append(cs.VotersOutgoing, id)
+			}
 		case VOTER_INCOMING:
 			cs.Voters = append(cs.Voters, id)
 		case VOTER_OUTGOING:
@@ -422,15 +451,21 @@ func (d ReplicaSet) ReplicationStatus(
 	votersOldGroup := d.FilterToDescriptors(ReplicaDescriptor.IsVoterOldConfig)
 	liveVotersOldGroup := d.FilterToDescriptors(isBoth(ReplicaDescriptor.IsVoterOldConfig, liveFunc))
 
-	n := len(votersOldGroup)
+	// Witnesses are never in a transitional state, so they're part of both the
+	// outgoing and the incoming group. They count towards availability, but not
+	// towards the replication factor of voters.
+	witnesses := d.FilterToDescriptors(ReplicaDescriptor.IsWitness)
+	liveWitnesses := d.FilterToDescriptors(isBoth(ReplicaDescriptor.IsWitness, liveFunc))
+
+	n := len(votersOldGroup) + len(witnesses)
 	// Empty groups succeed by default, to match the Raft implementation.
-	availableOutgoingGroup := (n == 0) || (len(liveVotersOldGroup) >= n/2+1)
+	availableOutgoingGroup := (n == 0) || (len(liveVotersOldGroup)+len(liveWitnesses) >= n/2+1)
 
 	votersNewGroup := d.FilterToDescriptors(ReplicaDescriptor.IsVoterNewConfig)
 	liveVotersNewGroup := d.FilterToDescriptors(isBoth(ReplicaDescriptor.IsVoterNewConfig, liveFunc))
 
-	n = len(votersNewGroup)
-	availableIncomingGroup := len(liveVotersNewGroup) >= n/2+1
+	n = len(votersNewGroup) + len(witnesses)
+	availableIncomingGroup := len(liveVotersNewGroup)+len(liveWitnesses) >= n/2+1
 
 	res.Available = availableIncomingGroup && availableOutgoingGroup
 
@@ -493,9 +528,9 @@ func (d ReplicaSet) Subtract(o ReplicaSet) []ReplicaDescriptor {
 // IsAddition returns true if `c` refers to a replica addition operation.
 func (c ReplicaChangeType) IsAddition() bool {
 	switch c {
-	case ADD_NON_VOTER, ADD_VOTER:
+	case ADD_NON_VOTER, ADD_VOTER, ADD_WITNESS:
 		return true
-	case REMOVE_NON_VOTER, REMOVE_VOTER:
+	case REMOVE_NON_VOTER, REMOVE_VOTER, REMOVE_WITNESS:
 		return false
 	default:
 		panic(fmt.Sprintf("unexpected ReplicaChangeType %s", c))
@@ -505,9 +540,9 @@ func (c ReplicaChangeType) IsAddition() bool {
 // IsRemoval returns true if `c` refers a replica removal operation.
 func (c ReplicaChangeType) IsRemoval() bool {
 	switch c {
-	case ADD_NON_VOTER, ADD_VOTER:
+	case ADD_NON_VOTER, ADD_VOTER, ADD_WITNESS:
 		return false
-	case REMOVE_NON_VOTER, REMOVE_VOTER:
+	case REMOVE_NON_VOTER, REMOVE_VOTER, REMOVE_WITNESS:
 		return true
 	default:
 		panic(fmt.Sprintf("unexpected ReplicaChangeType %s", c))
@@ -563,6 +598,11 @@ func CheckCanReceiveLease(
 		return errors.AssertionFailedf("node ID mismatch: %d != %d",
 			repDesc.NodeID, wouldbeLeaseholder.NodeID)
 	}
+	if repDesc.IsWitness() {
+		// Witnesses don't store user data, so they can never serve requests as
+		// the leaseholder.
+		return ErrReplicaCannotHoldLease
+	}
 	if !(repDesc.IsVoterNewConfig() ||
 		(repDesc.IsVoterOldConfig() && replDescs.containsVoterIncoming() && wasLastLeaseholder)) {
 		// We allow a demoting / incoming voter to receive the lease if there's an incoming voter.
@@ -177,6 +177,24 @@ func TestReplicaDescriptorsConfState(t *testing.T) {
 			[]ReplicaDescriptor{rd(VOTER_OUTGOING, 1), rd(VOTER_DEMOTING_LEARNER, 2), rd(VOTER_INCOMING, 3), rd(VOTER_INCOMING, 4), rd(LEARNER, 5)},
 			"Voters:[3 4] VotersOutgoing:[1 2] Learners:[5] LearnersNext:[2] AutoLeave:false",
 		},
+		// Witnesses are voters as far as raft is concerned, even though they
+		// must never be the leader.
+		{
+			[]ReplicaDescriptor{rd(VOTER_FULL, 1), rd(VOTER_FULL, 2), rd(WITNESS, 3)},
+			"Voters:[1 2 3] VotersOutgoing:[] Learners:[] LearnersNext:[] AutoLeave:false",
+		},
+		// A witness is part of both the incoming and outgoing config during an
+		// atomic replication change between the other replicas.
+		{
+			[]ReplicaDescriptor{rd(VOTER_FULL, 1), rd(VOTER_OUTGOING, 2), rd(VOTER_INCOMING, 3), rd(WITNESS, 4)},
+			"Voters:[1 3 4] VotersOutgoing:[1 2 4] Learners:[] LearnersNext:[] AutoLeave:false",
+		},
+		// A witness stays a voter in both configs while another voter is
+		// demoted.
+		{
+			[]ReplicaDescriptor{rd(VOTER_FULL, 1), rd(WITNESS, 2), rd(VOTER_DEMOTING_LEARNER, 3), rd(LEARNER, 4)},
+			"Voters:[1 2] VotersOutgoing:[1 2 3] Learners:[4] LearnersNext:[3] AutoLeave:false",
+		},
 	}
 
 	for _, test := range tests {
@@ -234,6 +252,18 @@ func TestReplicaDescriptorsCanMakeProgress(t *testing.T) {
 			{false, rd(VOTER_FULL, 2)},
 			{true, rd(VOTER_DEMOTING_LEARNER, 3)},
 		}, false},
+		// Two out of three voters alive, counting a witness.
+		{[]descWithLiveness{
+			{true, rd(VOTER_FULL, 1)},
+			{false, rd(VOTER_FULL, 2)},
+			{true, rd(WITNESS, 3)},
+		}, true},
+		// Two out of three voters dead, one of which is a witness.
+		{[]descWithLiveness{
+			{true, rd(VOTER_FULL, 1)},
+			{false, rd(VOTER_FULL, 2)},
+			{false, rd(WITNESS, 3)},
+		}, false},
 		// Two out of three voters dead, and they're all incoming voters. (This
 		// can't happen in practice because it means there were zero voters prior
 		// to the conf change, but still this result is correct, similar to others
@@ -411,3 +441,23 @@ func TestReplicaSetOperations(t *testing.T) {
 		}
 	})
 }
+
+func TestReplicaSetWitnesses(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	rs := MakeReplicaSet([]ReplicaDescriptor{
+		rd(VOTER_FULL, 1), rd(VOTER_FULL, 2), rd(WITNESS, 3), rd(NON_VOTER, 4),
+	})
+	// Witnesses don't store data, so they aren't voters from the point of view
+	// of the rest of the system.
+	require.Equal(t, []ReplicaDescriptor{rd(VOTER_FULL, 1), rd(VOTER_FULL, 2)}, rs.VoterDescriptors())
+	require.Equal(t, []ReplicaDescriptor{rd(WITNESS, 3)}, rs.WitnessDescriptors())
+	require.False(t, rs.InAtomicReplicationChange())
+
+	// Witnesses can never hold the lease.
+	require.NoError(t, CheckCanReceiveLease(rd(VOTER_FULL, 1), rs, false /* wasLastLeaseholder */))
+	require.ErrorIs(t,
+		CheckCanReceiveLease(rd(WITNESS, 3), rs, false /* wasLastLeaseholder */), ErrReplicaCannotHoldLease)
+	require.ErrorIs(t,
+		CheckCanReceiveLease(rd(WITNESS, 3), rs, true /* wasLastLeaseholder */), ErrReplicaCannotHoldLease)
+}
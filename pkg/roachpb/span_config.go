@@ -83,6 +83,12 @@ func (s *SpanConfig) ValidateSystemTargetSpanConfig() error {
 	if s.NumVoters != 0 {
 		return errors.AssertionFailedf("NumVoters set on system span config")
 	}
+	if s.NumWitnesses != 0 {
+		return errors.AssertionFailedf("NumWitnesses set on system span config")
+	}
+	if s.ColdStorageAfterSeconds != 0 {
+		return errors.AssertionFailedf("ColdStorageAfterSeconds set on system span config")
+	}
 	if len(s.Constraints) != 0 {
 		return errors.AssertionFailedf("Constraints set on system span config")
 	}
@@ -866,7 +866,11 @@ func (cfg *Config) CreateEngines(ctx context.Context) (Engines, error) {
 			addCfgOpt(storage.RemoteStorageFactory(cfg.EarlyBootExternalStorageAccessor))
 			if sharedStorage != nil {
 				addCfgOpt(storage.SharedStorage(sharedStorage))
-				addCfgOpt(storage.SecondaryCache(storage.SecondaryCacheBytes(cfg.StorageConfig.SharedStorage.Cache, du)))
+			}
+			// The secondary cache holds objects from shared storage as well as the
+			// external SSTables of ranges that were moved to cold storage.
+			if cacheSize := cfg.StorageConfig.SharedStorage.Cache; cacheSize.IsSet() {
+				addCfgOpt(storage.SecondaryCache(storage.SecondaryCacheBytes(cacheSize, du)))
 			}
 			addCfgOpt(storage.DiskMonitor(monitor))
 			// If the spec contains Pebble options, set those too.
@@ -963,6 +963,7 @@ func NewServer(cfg Config, stopper *stop.Stopper) (serverctl.ServerStartupInterf
 		RangefeedBudgetFactory:       rangeFeedBudgetFactory,
 		RaftEntriesMonitor:           raftEntriesMonitor,
 		SharedStorageEnabled:         cfg.StorageConfig.SharedStorage.URI != "",
+		ColdStorageAccessor:          cfg.EarlyBootExternalStorageAccessor,
 		SystemConfigProvider:         systemConfigWatcher,
 		SpanConfigSubscriber:         spanConfig.subscriber,
 		RangeLogWriter:               rangeLogWriter,
@@ -1325,6 +1326,9 @@ func NewServer(cfg Config, stopper *stop.Stopper) (serverctl.ServerStartupInterf
 	sStatus.setStmtDiagnosticsRequester(sqlServer.execCfg.StmtDiagnosticsRecorder)
 	sStatus.setTxnDiagnosticsRequester(sqlServer.execCfg.TxnDiagnosticsRecorder)
 	sStatus.baseStatusServer.sqlServer = sqlServer
+	// The stores, which are created when the node starts, use the status server
+	// to find the SSTables in cold storage that no store references.
+	node.storeCfg.ColdStorageSpanStats = sStatus.SpanStats
 
 	// Create a server controller.
 	sc := newServerController(ctx,
@@ -48,6 +48,8 @@ var fields = [config.NumFields]Field{
 	constraints,
 	voterConstraints,
 	leasePreferences,
+	numWitnesses,
+	coldStorageAfter,
 }
 
 const (
@@ -60,4 +62,6 @@ const (
 	constraints      = constraintsConjunctionField(config.Constraints)
 	voterConstraints = constraintsConjunctionField(config.VoterConstraints)
 	leasePreferences = leasePreferencesField(config.LeasePreferences)
+	numWitnesses     = int32Field(config.NumWitnesses)
+	coldStorageAfter = int32Field(config.ColdStorageAfter)
 )
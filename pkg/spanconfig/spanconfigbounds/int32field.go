@@ -30,6 +30,10 @@ func (f int32Field) FieldBound(b *Bounds) ValueBounds {
 			return b.NumReplicas
 		case numVoters:
 			return b.NumVoters
+		case numWitnesses:
+			return b.NumWitnesses
+		case coldStorageAfter:
+			return b.ColdStorageAfterSeconds
 		case gcTTLSeconds:
 			return b.GCTTLSeconds
 		default:
@@ -55,6 +59,10 @@ func (f int32Field) fieldValue(c *roachpb.SpanConfig) *int32 {
 		return &c.NumReplicas
 	case numVoters:
 		return &c.NumVoters
+	case numWitnesses:
+		return &c.NumWitnesses
+	case coldStorageAfter:
+		return &c.ColdStorageAfterSeconds
 	case gcTTLSeconds:
 		return &c.GCPolicy.TTLSeconds
 	default:
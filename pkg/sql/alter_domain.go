@@ -0,0 +1,266 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/errors"
+)
+
+type alterDomainNode struct {
+	zeroInputPlanNode
+	n    *tree.AlterDomain
+	desc *typedesc.Mutable
+}
+
+// alterDomainNode implements planNode. We set n here to satisfy the linter.
+var _ planNode = &alterDomainNode{n: nil}
+
+// AlterDomain alters a domain type.
+// Privileges: ownership of the domain.
+func (p *planner) AlterDomain(ctx context.Context, n *tree.AlterDomain) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"ALTER DOMAIN",
+	); err != nil {
+		return nil, err
+	}
+
+	desc, err := p.resolveMutableDomainDescriptor(ctx, n.Type, true /* required */)
+	if err != nil {
+		return nil, err
+	}
+
+	// The user needs ownership privilege to alter the domain.
+	if err := p.canModifyType(ctx, desc); err != nil {
+		return nil, err
+	}
+
+	return &alterDomainNode{
+		n:    n,
+		desc: desc,
+	}, nil
+}
+
+// resolveMutableDomainDescriptor resolves the type with the given name and
+// returns an error if it is not a domain.
+func (p *planner) resolveMutableDomainDescriptor(
+	ctx context.Context, name *tree.UnresolvedObjectName, required bool,
+) (*typedesc.Mutable, error) {
+	_, desc, err := p.ResolveMutableTypeDescriptor(ctx, name, required)
+	if err != nil || desc == nil {
+		return nil, err
+	}
+	if desc.Kind != descpb.TypeDescriptor_DOMAIN {
+		return nil, pgerror.Newf(pgcode.WrongObjectType,
+			"%q is not a domain", tree.AsStringWithFQNames(name, &p.semaCtx.Annotations))
+	}
+	return desc, nil
+}
+
+func (n *alterDomainNode) startExec(params runParams) error {
+	telemetry.Inc(sqltelemetry.SchemaChangeAlterCounterWithExtra("domain", n.n.Cmd.TelemetryName()))
+	ctx, p := params.ctx, params.p
+	domain := n.desc.Domain
+
+	switch t := n.n.Cmd.(type) {
+	case *tree.AlterDomainSetDefault:
+		domain.DefaultExpr = nil
+		if t.Default != nil {
+			def, err := p.sanitizeDomainDefault(ctx, t.Default, domain.BaseType)
+			if err != nil {
+				return err
+			}
+			domain.DefaultExpr = def
+		}
+	case *tree.AlterDomainSetNotNull:
+		if t.NotNull && !domain.NotNull {
+			if err := validateDomainValues(ctx, p.InternalSQLTxn(), n.desc, nil /* check */); err != nil {
+				return err
+			}
+		}
+		domain.NotNull = t.NotNull
+	case *tree.AlterDomainAddConstraint:
+		c := &t.Constraint
+		switch {
+		case c.NotNull:
+			if !domain.NotNull && !t.NotValid {
+				if err := validateDomainValues(ctx, p.InternalSQLTxn(), n.desc, nil /* check */); err != nil {
+					return err
+				}
+			}
+			domain.NotNull = true
+		case c.Null:
+			// ADD NULL is accepted for compatibility and has no effect.
+		default:
+			chk, err := p.makeDomainCheckConstraint(ctx, n.desc.GetName(), domain, c)
+			if err != nil {
+				return err
+			}
+			// The constraint is enforced for new values right away, and the values
+			// already stored in columns of the domain are validated by the type
+			// schema change job.
+			chk.Validity = descpb.ConstraintValidity_Validating
+			if t.NotValid {
+				chk.Validity = descpb.ConstraintValidity_Unvalidated
+			}
+			domain.Checks = append(domain.Checks, chk)
+		}
+	case *tree.AlterDomainDropConstraint:
+		idx := findDomainCheck(domain, string(t.Constraint))
+		if idx < 0 {
+			if t.IfExists {
+				p.BufferClientNotice(ctx, pgnotice.Newf(
+					"constraint %q of domain %q does not exist, skipping", t.Constraint, n.desc.GetName(),
+				))
+				return nil
+			}
+			return pgerror.Newf(pgcode.UndefinedObject,
+				"constraint %q of domain %q does not exist", t.Constraint, n.desc.GetName())
+		}
+		domain.Checks = append(domain.Checks[:idx], domain.Checks[idx+1:]...)
+	case *tree.AlterDomainValidateConstraint:
+		idx := findDomainCheck(domain, string(t.Constraint))
+		if idx < 0 {
+			return pgerror.Newf(pgcode.UndefinedObject,
+				"constraint %q of domain %q does not exist", t.Constraint, n.desc.GetName())
+		}
+		chk := &domain.Checks[idx]
+		if chk.Validity != descpb.ConstraintValidity_Unvalidated {
+			return nil
+		}
+		if err := validateDomainValues(ctx, p.InternalSQLTxn(), n.desc, chk); err != nil {
+			return err
+		}
+		chk.Validity = descpb.ConstraintValidity_Validated
+	default:
+		return errors.AssertionFailedf("unknown alter domain cmd %s", t)
+	}
+
+	if err := p.writeTypeSchemaChange(
+		ctx, n.desc, tree.AsStringWithFQNames(n.n, p.Ann()),
+	); err != nil {
+		return err
+	}
+	return p.logEvent(ctx, n.desc.ID, &eventpb.AlterType{
+		TypeName: tree.AsStringWithFQNames(n.n.Type, p.Ann()),
+	})
+}
+
+// findDomainCheck returns the index of the CHECK constraint of the domain
+// with the given name, or -1 if there is none.
+func findDomainCheck(domain *descpb.TypeDescriptor_Domain, name string) int {
+	for i := range domain.Checks {
+		if domain.Checks[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateDomainValues checks that all the values stored in table columns of
+// the given domain type satisfy the given CHECK constraint. If check is nil,
+// it instead checks that none of the values are NULL.
+func validateDomainValues(
+	ctx context.Context,
+	txn descs.Txn,
+	typeDesc catalog.TypeDescriptor,
+	check *descpb.TypeDescriptor_Domain_CheckConstraint,
+) error {
+	var checkExpr tree.Expr
+	if check != nil {
+		var err error
+		if checkExpr, err = parser.ParseExpr(check.Expr); err != nil {
+			return err
+		}
+	}
+	typeOID := typedesc.TypeIDToOID(typeDesc.GetID())
+	for i := 0; i < typeDesc.NumReferencingDescriptors(); i++ {
+		id := typeDesc.GetReferencingDescriptorID(i)
+		desc, err := txn.Descriptors().ByIDWithoutLeased(txn.KV()).Get().Desc(ctx, id)
+		if err != nil {
+			return err
+		}
+		tbl, ok := desc.(catalog.TableDescriptor)
+		if !ok || !tbl.IsPhysicalTable() || tbl.Dropped() {
+			continue
+		}
+		for _, col := range tbl.AccessibleColumns() {
+			if col.GetType().Oid() != typeOID {
+				continue
+			}
+			if err := validateDomainValuesInColumn(ctx, txn, tbl, col, check, checkExpr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateDomainValuesInColumn(
+	ctx context.Context,
+	txn isql.Txn,
+	tbl catalog.TableDescriptor,
+	col catalog.Column,
+	check *descpb.TypeDescriptor_Domain_CheckConstraint,
+	checkExpr tree.Expr,
+) error {
+	colItem := &tree.ColumnItem{ColumnName: col.ColName()}
+	var pred tree.Expr = &tree.IsNullExpr{Expr: colItem}
+	if check != nil {
+		expr, err := tree.SimpleVisit(checkExpr, func(e tree.Expr) (bool, tree.Expr, error) {
+			if n, ok := e.(*tree.UnresolvedName); ok && n.NumParts == 1 && n.Parts[0] == domainValueColumnName {
+				return false, colItem, nil
+			}
+			return true, e, nil
+		})
+		if err != nil {
+			return err
+		}
+		pred = &tree.NotExpr{Expr: &tree.ParenExpr{Expr: expr}}
+	}
+	query := fmt.Sprintf(
+		`SELECT 1 FROM [%d AS t] WHERE %s LIMIT 1`, tbl.GetID(), tree.AsStringWithFlags(pred, tree.FmtSerializable),
+	)
+	row, err := txn.QueryRowEx(
+		ctx, "validate-domain-constraint", txn.KV(), sessiondata.NodeUserSessionDataOverride, query,
+	)
+	if err != nil {
+		return err
+	}
+	if row == nil {
+		return nil
+	}
+	if check == nil {
+		return pgerror.Newf(pgcode.NotNullViolation,
+			"column %q of table %q contains null values", col.GetName(), tbl.GetName())
+	}
+	return pgerror.WithConstraintName(pgerror.Newf(pgcode.CheckViolation,
+		"column %q of table %q contains values that violate the new constraint",
+		col.GetName(), tbl.GetName()), check.Name)
+}
+
+func (n *alterDomainNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *alterDomainNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *alterDomainNode) Close(ctx context.Context)           {}
+func (n *alterDomainNode) ReadingOwnWrites()                   {}
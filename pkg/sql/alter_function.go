@@ -74,6 +74,9 @@ func (n *alterFunctionOptionsNode) startExec(params runParams) error {
 	if err != nil {
 		return err
 	}
+	if err := checkRoutineAggregateKind(fnDesc, false /* aggregate */, "ALTER", "alter"); err != nil {
+		return err
+	}
 	// TODO(chengxiong): add validation that a function can not be altered if it's
 	// referenced by other objects. This is needed when want to allow function
 	// references. Need to think about in what condition a function can be altered
@@ -173,6 +176,9 @@ func (n *alterFunctionRenameNode) startExec(params runParams) error {
 			pgcode.UndefinedFunction, "could not find a procedure named %q", &n.n.Function.FuncName,
 		)
 	}
+	if err := checkRoutineAggregateKind(fnDesc, n.n.Aggregate, "ALTER", "alter"); err != nil {
+		return err
+	}
 	oldFnName, err := params.p.getQualifiedFunctionName(params.ctx, fnDesc)
 	if err != nil {
 		return err
@@ -285,6 +291,9 @@ func (n *alterFunctionSetOwnerNode) startExec(params runParams) error {
 			pgcode.UndefinedFunction, "could not find a procedure named %q", &n.n.Function.FuncName,
 		)
 	}
+	if err := checkRoutineAggregateKind(fnDesc, n.n.Aggregate, "ALTER", "alter"); err != nil {
+		return err
+	}
 	newOwner, err := decodeusername.FromRoleSpec(
 		params.p.SessionData(), username.PurposeValidation, n.n.NewOwner,
 	)
@@ -359,6 +368,9 @@ func (n *alterFunctionSetSchemaNode) startExec(params runParams) error {
 			pgcode.UndefinedFunction, "could not find a procedure named %q", &n.n.Function.FuncName,
 		)
 	}
+	if err := checkRoutineAggregateKind(fnDesc, n.n.Aggregate, "ALTER", "alter"); err != nil {
+		return err
+	}
 	oldFnName, err := params.p.getQualifiedFunctionName(params.ctx, fnDesc)
 	if err != nil {
 		return err
@@ -510,6 +522,7 @@ func toSchemaOverloadSignature(fnDesc *funcdesc.Mutable) descpb.SchemaDescriptor
 		ReturnType:  fnDesc.ReturnType.Type,
 		ReturnSet:   fnDesc.ReturnType.ReturnSet,
 		IsProcedure: fnDesc.IsProcedure(),
+		IsAggregate: fnDesc.IsAggregate(),
 	}
 	for paramIdx, param := range fnDesc.Params {
 		class := funcdesc.ToTreeRoutineParamClass(param.Class)
@@ -520,6 +533,9 @@ func toSchemaOverloadSignature(fnDesc *funcdesc.Mutable) descpb.SchemaDescriptor
 			ret.OutParamOrdinals = append(ret.OutParamOrdinals, int32(paramIdx))
 			ret.OutParamTypes = append(ret.OutParamTypes, param.Type)
 		}
+		if class == tree.RoutineParamVariadic {
+			ret.IsVariadic = true
+		}
 		if param.DefaultExpr != nil {
 			ret.DefaultExprs = append(ret.DefaultExprs, *param.DefaultExpr)
 		}
@@ -96,6 +96,11 @@ func (p *planner) AlterTable(ctx context.Context, n *tree.AlterTable) (planNode,
 		return newZeroNode(nil /* columns */), nil
 	}
 
+	if tableDesc.IsForeignTable() {
+		return nil, pgerror.Newf(pgcode.WrongObjectType,
+			"%s is a foreign table and cannot be modified", tree.ErrNameString(tableDesc.GetName()))
+	}
+
 	// This check for CREATE privilege is kept for backwards compatibility.
 	if err := p.CheckPrivilege(ctx, tableDesc, privilege.CREATE); err != nil {
 		return nil, pgerror.Wrapf(err, pgcode.InsufficientPrivilege,
@@ -201,6 +206,11 @@ func (n *alterTableNode) startExec(params runParams) error {
 				return pgerror.Newf(pgcode.InvalidColumnDefinition,
 					"multiple primary keys for table %q are not allowed", tn.Object())
 			}
+			if catalog.FindColumnByTreeName(n.tableDesc, t.ColumnDef.Name) == nil {
+				if err := checkNoInheritingTables(n.tableDesc, "ADD COLUMN"); err != nil {
+					return err
+				}
+			}
 			var err error
 			params.p.runWithOptions(resolveFlags{contextDatabaseID: n.tableDesc.ParentID}, func() {
 				err = params.p.addColumnImpl(params, n, tn, n.tableDesc, t)
@@ -216,6 +226,11 @@ func (n *alterTableNode) startExec(params runParams) error {
 			}
 			switch d := t.ConstraintDef.(type) {
 			case *tree.UniqueConstraintTableDef:
+				if d.Deferrable.IsDeferrable() && !d.WithoutIndex {
+					if err := checkDeferrableUniqueIndex(d); err != nil {
+						return err
+					}
+				}
 				if d.WithoutIndex {
 					if err := addUniqueWithoutIndexTableDef(
 						params.ctx,
@@ -230,6 +245,39 @@ func (n *alterTableNode) startExec(params runParams) error {
 					); err != nil {
 						return err
 					}
+					// Exclusion constraints are backed by an inverted index, which is
+					// built like one created by CREATE INDEX.
+					if idxDef := exclusionIndexTableDef(n.tableDesc, d); idxDef != nil {
+						idx, err := makeIndexDescriptor(params, tree.CreateIndex{
+							Table:   *tn,
+							Type:    idxDef.Type,
+							Columns: idxDef.Columns,
+						}, n.tableDesc)
+						if err != nil {
+							return err
+						}
+						idx.Version = descpb.StrictIndexColumnIDGuaranteesVersion
+						*idx, err = params.p.configureIndexDescForNewIndexPartitioning(
+							params.ctx, n.tableDesc, *idx, nil, /* partitionBy */
+						)
+						if err != nil {
+							return err
+						}
+						if err := n.tableDesc.AddIndexMutationMaybeWithTempIndex(
+							idx, descpb.DescriptorMutation_ADD,
+						); err != nil {
+							return err
+						}
+						version := params.ExecCfg().Settings.Version.ActiveVersion(params.ctx)
+						if err := n.tableDesc.AllocateIDs(params.ctx, version); err != nil {
+							return err
+						}
+						if err := params.p.configureZoneConfigForNewIndexPartitioning(
+							params.ctx, n.tableDesc, *idx,
+						); err != nil {
+							return err
+						}
+					}
 					continue
 				}
 
@@ -312,6 +360,17 @@ func (n *alterTableNode) startExec(params runParams) error {
 				if err := idx.FillColumns(columns); err != nil {
 					return err
 				}
+				if d.Deferrable.IsDeferrable() {
+					// The constraint is enforced by a UNIQUE WITHOUT INDEX
+					// constraint with the same name as the index, which is added
+					// below, so the index must be named now.
+					if idx.Name == "" {
+						if idx.Name, err = tabledesc.BuildIndexName(n.tableDesc, &idx); err != nil {
+							return err
+						}
+					}
+					idx.Unique = false
+				}
 
 				if d.Predicate != nil {
 					expr, err := schemaexpr.ValidatePartialIndexPredicate(
@@ -357,6 +416,13 @@ func (n *alterTableNode) startExec(params runParams) error {
 				); err != nil {
 					return err
 				}
+				if d.Deferrable.IsDeferrable() {
+					if err := addDeferrableUniqueIndexConstraint(
+						params.ctx, n.tableDesc, &idx, d.Deferrable, NonEmptyTable, t.ValidationBehavior,
+					); err != nil {
+						return err
+					}
+				}
 
 				if n.tableDesc.IsLocalityRegionalByRow() {
 					if err := params.p.checkNoRegionChangeUnderway(
@@ -368,6 +434,9 @@ func (n *alterTableNode) startExec(params runParams) error {
 					}
 				}
 			case *tree.CheckConstraintTableDef:
+				if err := checkNoInheritingTables(n.tableDesc, "ADD CHECK constraint"); err != nil {
+					return err
+				}
 				var err error
 				params.p.runWithOptions(resolveFlags{contextDatabaseID: n.tableDesc.ParentID}, func() {
 					ckBuilder := schemaexpr.MakeCheckConstraintBuilder(params.ctx, *tn, n.tableDesc, &params.p.semaCtx)
@@ -534,6 +603,9 @@ func (n *alterTableNode) startExec(params runParams) error {
 				)
 			}
 
+			if err := params.p.checkColumnNotInherited(params.ctx, tableDesc, "drop", t.Column); err != nil {
+				return err
+			}
 			colDroppedViews, err := dropColumnImpl(params, tn, tableDesc, tableDesc.GetRowLevelTTL(), t)
 			if err != nil {
 				return err
@@ -867,6 +939,11 @@ func (n *alterTableNode) startExec(params runParams) error {
 		case *tree.AlterTableSetRLSMode:
 			return pgerror.New(pgcode.FeatureNotSupported,
 				"ALTER TABLE ... ROW LEVEL SECURITY is only implemented in the declarative schema changer")
+		case *tree.AlterTableInherit:
+			if err := params.p.alterTableInherit(params.ctx, n.tableDesc, t); err != nil {
+				return err
+			}
+			descriptorChanged = true
 		default:
 			return errors.AssertionFailedf("unsupported alter command: %T", cmd)
 		}
@@ -967,6 +1044,12 @@ func applyColumnMutation(
 ) error {
 	switch t := mut.(type) {
 	case *tree.AlterTableAlterColumnType:
+		if err := checkNoInheritingTables(tableDesc, "ALTER COLUMN TYPE"); err != nil {
+			return err
+		}
+		if err := params.p.checkColumnNotInherited(ctx, tableDesc, "alter", t.Column); err != nil {
+			return err
+		}
 		return AlterColumnType(ctx, tableDesc, col, t, params, cmds, tn)
 
 	case *tree.AlterTableSetDefault:
@@ -1660,12 +1743,47 @@ func insertJSONStatistic(
 		fullStatisticIDValue = s.FullStatisticID
 	}
 
+	args := []interface{}{
+		tableID,
+		name,
+		columnIDs,
+		s.CreatedAt,
+		s.RowCount,
+		s.DistinctCount,
+		s.NullCount,
+		s.AvgSize,
+		histogram,
+		predicateValue,
+		fullStatisticIDValue,
+	}
+	if s.ID != 0 {
+		args = append([]interface{}{s.ID}, args...)
+	}
+
+	// The dependencyDegrees column only exists once the cluster has been
+	// upgraded, so it is only written if there are degrees to store.
+	var degreesCol, degreesPlaceholder string
+	if len(s.DependencyDegrees) > 0 {
+		if !params.p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_TableStatisticsDependencyDegrees) {
+			return pgerror.New(pgcode.FeatureNotSupported,
+				"cannot inject dependency degrees until the cluster upgrade is finalized")
+		}
+		degrees := tree.NewDArray(types.Float)
+		for _, d := range s.DependencyDegrees {
+			if err := degrees.Append(tree.NewDFloat(tree.DFloat(d))); err != nil {
+				return err
+			}
+		}
+		args = append(args, degrees)
+		degreesCol, degreesPlaceholder = `, "dependencyDegrees"`, fmt.Sprintf(", $%d", len(args))
+	}
+
 	if s.ID != 0 {
 		_ /* rows */, err := txn.Exec(
 			ctx,
 			"insert-stats",
 			txn.KV(),
-			`INSERT INTO system.table_statistics (
+			fmt.Sprintf(`INSERT INTO system.table_statistics (
 					"statisticID",
 					"tableID",
 					"name",
@@ -1677,20 +1795,9 @@ func insertJSONStatistic(
 					"avgSize",
 					histogram,
 					"partialPredicate",
-					"fullStatisticID"
-				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
-			s.ID,
-			tableID,
-			name,
-			columnIDs,
-			s.CreatedAt,
-			s.RowCount,
-			s.DistinctCount,
-			s.NullCount,
-			s.AvgSize,
-			histogram,
-			predicateValue,
-			fullStatisticIDValue,
+					"fullStatisticID"%s
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12%s)`, degreesCol, degreesPlaceholder),
+			args...,
 		)
 		return err
 	} else {
@@ -1698,7 +1805,7 @@ func insertJSONStatistic(
 			ctx,
 			"insert-stats",
 			txn.KV(),
-			`INSERT INTO system.table_statistics (
+			fmt.Sprintf(`INSERT INTO system.table_statistics (
 					"tableID",
 					"name",
 					"columnIDs",
@@ -1709,19 +1816,9 @@ func insertJSONStatistic(
 					"avgSize",
 					histogram,
 					"partialPredicate",
-					"fullStatisticID"
-				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
-			tableID,
-			name,
-			columnIDs,
-			s.CreatedAt,
-			s.RowCount,
-			s.DistinctCount,
-			s.NullCount,
-			s.AvgSize,
-			histogram,
-			predicateValue,
-			fullStatisticIDValue,
+					"fullStatisticID"%s
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11%s)`, degreesCol, degreesPlaceholder),
+			args...,
 		)
 		return err
 	}
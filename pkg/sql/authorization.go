@@ -737,6 +737,32 @@ func (p *planner) UserHasGlobalPrivilegeOrRoleOption(
 	return false, nil
 }
 
+// IsExemptFromRowLevelSecurity returns whether the current user is exempt from
+// the row-level security policies of the given table. This mirrors the checks
+// done by the optimizer: the policies do not apply if row-level security is
+// not enabled for the table, to admins, to users with the BYPASSRLS privilege
+// or role option, and to the table owner unless row-level security is forced.
+//
+// It is used by features that read table data without going through the
+// optimizer, and so cannot apply the policies themselves.
+func (p *planner) IsExemptFromRowLevelSecurity(
+	ctx context.Context, desc catalog.TableDescriptor,
+) (bool, error) {
+	if !desc.IsRowLevelSecurityEnabled() {
+		return true, nil
+	}
+	if isAdmin, err := p.HasAdminRole(ctx); err != nil || isAdmin {
+		return isAdmin, err
+	}
+	if bypassRLS, err := p.HasGlobalPrivilegeOrRoleOption(ctx, privilege.BYPASSRLS); err != nil || bypassRLS {
+		return bypassRLS, err
+	}
+	if desc.IsRowLevelSecurityForced() {
+		return false, nil
+	}
+	return p.HasOwnership(ctx, desc)
+}
+
 // CheckGlobalPrivilegeOrRoleOption implements the AuthorizationAccessor interface.
 func (p *planner) CheckGlobalPrivilegeOrRoleOption(
 	ctx context.Context, privilege privilege.Kind,
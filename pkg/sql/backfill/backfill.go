@@ -89,6 +89,11 @@ type ColumnBackfiller struct {
 	updateExprs []tree.TypedExpr
 	evalCtx     *eval.Context
 
+	// domainCheckers checks the values of the added columns whose type is a
+	// domain against the constraints of the domain. It is nil if there are no
+	// such columns, and otherwise has an entry for each added column.
+	domainCheckers []*schemaexpr.DomainChecker
+
 	fetcher     row.Fetcher
 	fetcherCols []descpb.ColumnID
 	colIdxMap   catalog.TableColMap
@@ -124,12 +129,14 @@ func (cb *ColumnBackfiller) init(
 	evalCtx *eval.Context,
 	defaultExprs []tree.TypedExpr,
 	computedExprs []tree.TypedExpr,
+	domainCheckers []*schemaexpr.DomainChecker,
 	desc catalog.TableDescriptor,
 	mon *mon.BytesMonitor,
 	rowMetrics *rowinfra.Metrics,
 	traceKV bool,
 ) error {
 	cb.evalCtx = evalCtx
+	cb.domainCheckers = domainCheckers
 	cb.updateCols = append(cb.added, cb.dropped...)
 	// Populate default or computed values.
 	cb.updateExprs = make([]tree.TypedExpr, len(cb.updateCols))
@@ -214,7 +221,13 @@ func (cb *ColumnBackfiller) InitForLocalUse(
 	if err != nil {
 		return err
 	}
-	return cb.init(ctx, txn, evalCtx, defaultExprs, computedExprs, desc, mon, rowMetrics, traceKV)
+	domainCheckers, err := schemaexpr.MakeDomainCheckers(ctx, cb.added, semaCtx)
+	if err != nil {
+		return err
+	}
+	return cb.init(
+		ctx, txn, evalCtx, defaultExprs, computedExprs, domainCheckers, desc, mon, rowMetrics, traceKV,
+	)
 }
 
 // InitForDistributedUse initializes a ColumnBackfiller for use as part of a
@@ -233,6 +246,7 @@ func (cb *ColumnBackfiller) InitForDistributedUse(
 	// to make a copy.
 	evalCtx := flowCtx.NewEvalCtx()
 	var defaultExprs, computedExprs []tree.TypedExpr
+	var domainCheckers []*schemaexpr.DomainChecker
 	// Install type metadata in the target descriptors, as well as resolve any
 	// user defined types in the column expressions.
 	if err := flowCtx.Cfg.DB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
@@ -264,7 +278,8 @@ func (cb *ColumnBackfiller) InitForDistributedUse(
 		if err != nil {
 			return err
 		}
-		return nil
+		domainCheckers, err = schemaexpr.MakeDomainCheckers(ctx, cb.added, &semaCtx)
+		return err
 	}); err != nil {
 		return err
 	}
@@ -275,7 +290,10 @@ func (cb *ColumnBackfiller) InitForDistributedUse(
 
 	rowMetrics := flowCtx.GetRowMetrics()
 	// The txn will be set on the fetcher in RunColumnBackfillChunk.
-	return cb.init(ctx, nil /* txn */, evalCtx, defaultExprs, computedExprs, desc, mon, rowMetrics, flowCtx.TraceKV)
+	return cb.init(
+		ctx, nil /* txn */, evalCtx, defaultExprs, computedExprs, domainCheckers, desc, mon, rowMetrics,
+		flowCtx.TraceKV,
+	)
 }
 
 // Close frees the resources used by the ColumnBackfiller.
@@ -397,6 +415,11 @@ func (cb *ColumnBackfiller) RunColumnBackfillChunk(
 			if j < len(cb.added) && !cb.added[j].IsNullable() && val == tree.DNull {
 				return roachpb.Key{}, sqlerrors.NewNonNullViolationError(cb.added[j].GetName())
 			}
+			if j < len(cb.added) && cb.domainCheckers != nil && cb.domainCheckers[j] != nil {
+				if err := cb.domainCheckers[j].Check(ctx, cb.evalCtx, val); err != nil {
+					return roachpb.Key{}, err
+				}
+			}
 
 			// Added computed column values should be usable for the next
 			// added columns being backfilled. They have already been type
@@ -921,6 +944,10 @@ type IndexBackfiller struct {
 	// Map of columns which need to be evaluated to their expressions.
 	colExprs map[descpb.ColumnID]tree.TypedExpr
 
+	// Map of added columns whose type is a domain to the checkers of the
+	// constraints of the domain.
+	domainCheckers map[descpb.ColumnID]*schemaexpr.DomainChecker
+
 	// predicates is a map of indexes to partial index predicate expressions. It
 	// includes entries for partial indexes only.
 	predicates map[descpb.IndexID]tree.TypedExpr
@@ -1003,6 +1030,9 @@ func (ib *IndexBackfiller) InitForLocalUse(
 	if err != nil {
 		return err
 	}
+	if ib.domainCheckers, err = makeDomainCheckersByID(ctx, ib.addedCols, semaCtx); err != nil {
+		return err
+	}
 
 	// Add the columns referenced in the predicate to valNeededForCol so that
 	// columns necessary to evaluate the predicate expression are fetched.
@@ -1117,6 +1147,24 @@ func constructExprs(
 	return predicates, colExprs, referencedColumns, nil
 }
 
+// makeDomainCheckersByID returns the checkers of the constraints of the given
+// columns whose type is a domain, by column ID.
+func makeDomainCheckersByID(
+	ctx context.Context, cols []catalog.Column, semaCtx *tree.SemaContext,
+) (map[descpb.ColumnID]*schemaexpr.DomainChecker, error) {
+	checkers, err := schemaexpr.MakeDomainCheckers(ctx, cols, semaCtx)
+	if err != nil || checkers == nil {
+		return nil, err
+	}
+	byID := make(map[descpb.ColumnID]*schemaexpr.DomainChecker)
+	for i, c := range checkers {
+		if c != nil {
+			byID[cols[i].GetID()] = c
+		}
+	}
+	return byID, nil
+}
+
 // InitForDistributedUse initializes an IndexBackfiller for use as part of a
 // backfill operation executing as part of a distributed flow. In this use, the
 // backfill operation manages its own transactions. This separation is necessary
@@ -1184,6 +1232,10 @@ func (ib *IndexBackfiller) InitForDistributedUse(
 		predicates, colExprs, referencedColumns, err = constructExprs(
 			ctx, desc, ib.added, ib.cols, ib.addedCols, ib.computedCols, evalCtx, &semaCtx,
 		)
+		if err != nil {
+			return err
+		}
+		ib.domainCheckers, err = makeDomainCheckersByID(ctx, ib.addedCols, &semaCtx)
 		return err
 	}); err != nil {
 		return err
@@ -1450,6 +1502,13 @@ func (ib *IndexBackfiller) BuildIndexEntriesChunk(
 			colID := cols[i].GetID()
 			texpr, ok := ib.colExprs[colID]
 			if !ok {
+				// The value of an added column without a default is NULL, which
+				// may violate the constraints of its domain.
+				if c, ok := ib.domainCheckers[colID]; ok {
+					if err := c.Check(ctx, ib.evalCtx, tree.DNull); err != nil {
+						return err
+					}
+				}
 				continue
 			}
 			val, err := eval.Expr(ctx, ib.evalCtx, texpr)
@@ -1479,6 +1538,11 @@ func (ib *IndexBackfiller) BuildIndexEntriesChunk(
 			if val == tree.DNull && !cols[i].IsNullable() {
 				return sqlerrors.NewNonNullViolationError(cols[i].GetName())
 			}
+			if c, ok := ib.domainCheckers[colID]; ok {
+				if err := c.Check(ctx, ib.evalCtx, val); err != nil {
+					return err
+				}
+			}
 			ib.rowVals[colIdx] = val
 		}
 		return nil
@@ -1496,7 +1560,7 @@ func (ib *IndexBackfiller) BuildIndexEntriesChunk(
 
 		// First populate default values, then populate computed expressions which
 		// may reference default values.
-		if len(ib.colExprs) > 0 {
+		if len(ib.colExprs) > 0 || len(ib.domainCheckers) > 0 {
 			if err := evaluateExprs(ib.addedCols); err != nil {
 				return nil, nil, memUsedPerChunk, err
 			}
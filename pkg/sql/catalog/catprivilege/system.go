@@ -83,6 +83,9 @@ var (
 		catconstants.TransactionDiagnosticsTableName,
 		catconstants.StatementHintsTableName,
 		catconstants.InspectErrorsTableName,
+		catconstants.NotificationsTableName,
+		catconstants.PublicationsTableName,
+		catconstants.ReplicationSlotsTableName,
 	}
 
 	readWriteSystemSequences = []catconstants.SystemTableName{
@@ -238,7 +238,14 @@ func (desc *TableDescriptor) IsReadOnly() bool {
 
 // IsPhysicalTable implements the TableDescriptor interface.
 func (desc *TableDescriptor) IsPhysicalTable() bool {
-	return desc.IsSequence() || (desc.IsTable() && !desc.IsVirtualTable()) || desc.MaterializedView()
+	return desc.IsSequence() ||
+		(desc.IsTable() && !desc.IsVirtualTable() && !desc.IsForeignTable()) ||
+		desc.MaterializedView()
+}
+
+// IsForeignTable implements the TableDescriptor interface.
+func (desc *TableDescriptor) IsForeignTable() bool {
+	return desc.Foreign != nil
 }
 
 // IsAs implements the TableDescriptor interface.
@@ -334,6 +341,11 @@ func (u *UniqueWithoutIndexConstraint) IsPartial() bool {
 	return u.Predicate != ""
 }
 
+// IsExclusion returns true if the constraint is an exclusion constraint.
+func (u *UniqueWithoutIndexConstraint) IsExclusion() bool {
+	return len(u.ExclusionOperators) > 0
+}
+
 // GetParentID implements the catalog.NameKeyHaver interface.
 func (ni NameInfo) GetParentID() ID {
 	return ni.ParentID
@@ -363,10 +363,14 @@ type TableDescriptor interface {
 	// virtual Table (like the information_schema tables) and thus doesn't
 	// need to be physically stored.
 	IsVirtualTable() bool
+	// IsForeignTable returns true if the TableDescriptor describes a foreign
+	// table, whose rows are read from files in external storage.
+	IsForeignTable() bool
 	// IsPhysicalTable returns true if the TableDescriptor actually describes a
 	// physical Table that needs to be stored in the kv layer, as opposed to a
-	// different resource like a view or a virtual table. Physical tables have
-	// primary keys, column families, and indexes (unlike virtual tables).
+	// different resource like a view, a virtual table or a foreign table.
+	// Physical tables have primary keys, column families, and indexes (unlike
+	// virtual tables).
 	// Sequences count as physical tables because their values are stored in
 	// the KV layer.
 	IsPhysicalTable() bool
@@ -709,6 +713,11 @@ type TableDescriptor interface {
 	// GetDependsOnFunctions returns the IDs of all functions that this view
 	// depends on. It's only non-nil if IsView is true.
 	GetDependsOnFunctions() []descpb.ID
+	// GetInheritsFrom returns the IDs of the parent tables this table inherits
+	// columns and CHECK constraints from, in declaration order.
+	GetInheritsFrom() []descpb.ID
+	// GetInheritedBy returns the IDs of the tables that inherit from this one.
+	GetInheritedBy() []descpb.ID
 
 	// AllConstraints returns all constraints in this table, regardless if
 	// they're enforced yet or not. The ordering of the constraints within this
@@ -845,6 +854,9 @@ type TableDescriptor interface {
 	// histogramBucketsCount value is valid, otherwise this has not been set at
 	// the table level.
 	HistogramBucketsCount() (histogramBucketsCount uint32, ok bool)
+	// GetStatsColumnGroups returns the groups of columns on which statistics
+	// are collected in addition to the default set of column statistics.
+	GetStatsColumnGroups() []descpb.StatsColumnGroup
 	// IsRefreshViewRequired indicates if a REFRESH VIEW operation needs to be called
 	// on a materialized view.
 	IsRefreshViewRequired() bool
@@ -854,6 +866,10 @@ type TableDescriptor interface {
 	// IsSchemaLocked returns true if we don't allow performing schema changes
 	// on this table descriptor.
 	IsSchemaLocked() bool
+	// LockTableBlocksDML returns true if INSERT, UPDATE, UPSERT and DELETE
+	// statements on this table acquire a table-level lock in ROW EXCLUSIVE
+	// mode.
+	LockTableBlocksDML() bool
 	// IsPrimaryKeySwapMutation returns true if the mutation is a primary key
 	// swap mutation or a secondary index used by the declarative schema changer
 	// for a primary index swap.
@@ -935,6 +951,11 @@ type TypeDescriptor interface {
 	// nil otherwise.
 	AsCompositeTypeDescriptor() CompositeTypeDescriptor
 
+	// AsDomainTypeDescriptor returns this instance cast to
+	// DomainTypeDescriptor if this type is a domain type,
+	// nil otherwise.
+	AsDomainTypeDescriptor() DomainTypeDescriptor
+
 	// AsTableImplicitRecordTypeDescriptor returns this instance cast to
 	// TableImplicitRecordTypeDescriptor if this type is an implicit table record
 	// type, nil otherwise.
@@ -1022,6 +1043,28 @@ type CompositeTypeDescriptor interface {
 	GetElementType(ordinal int) *types.T
 }
 
+// DomainTypeDescriptor is the TypeDescriptor subtype for domain types, which
+// are base types with additional constraints.
+type DomainTypeDescriptor interface {
+	NonAliasTypeDescriptor
+
+	// BaseType returns the underlying type of the domain.
+	BaseType() *types.T
+
+	// IsNotNull returns true if the domain does not allow NULL values.
+	IsNotNull() bool
+
+	// DefaultExpr returns the serialized default expression of the domain, if
+	// any.
+	DefaultExpr() (string, bool)
+
+	// NumChecks returns the number of CHECK constraints of the domain.
+	NumChecks() int
+
+	// GetCheck returns the CHECK constraint of the domain at the given ordinal.
+	GetCheck(ordinal int) *descpb.TypeDescriptor_Domain_CheckConstraint
+}
+
 // TableImplicitRecordTypeDescriptor is the TypeDescriptor subtype for the
 // record type implicitly defined by a table.
 type TableImplicitRecordTypeDescriptor interface {
@@ -1107,6 +1150,10 @@ type FunctionDescriptor interface {
 	// returns false if the descriptor represents a user-defined function.
 	IsProcedure() bool
 
+	// IsAggregate returns true if the descriptor represents a user-defined
+	// aggregate created with CREATE AGGREGATE.
+	IsAggregate() bool
+
 	// GetSecurity returns the security specification of this function.
 	GetSecurity() catpb.Function_Security
 }
@@ -209,6 +209,17 @@ func (desc *immutable) ValidateSelf(vea catalog.ValidationErrorAccumulator) {
 			vea.Report(errors.AssertionFailedf("type not set for arg %d", i))
 		}
 	}
+	if agg := desc.Aggregate; agg != nil {
+		if agg.StateType == nil {
+			vea.Report(errors.AssertionFailedf("aggregate state type not set"))
+		}
+		if agg.StateFunctionOID == 0 {
+			vea.Report(errors.AssertionFailedf("aggregate state function not set"))
+		}
+		if desc.IsProcedure() || desc.ReturnType.ReturnSet {
+			vea.Report(errors.AssertionFailedf("aggregate must be a non-set-returning function"))
+		}
+	}
 
 	vp := funcinfo.MakeVolatilityProperties(desc.Volatility, desc.LeakProof)
 	vea.Report(vp.Validate())
@@ -507,6 +518,11 @@ func (desc *immutable) ForEachUDTDependentForHydration(fn func(t *types.T) error
 			return iterutil.Map(err)
 		}
 	}
+	if desc.Aggregate != nil && catid.IsOIDUserDefined(desc.Aggregate.StateType.Oid()) {
+		if err := fn(desc.Aggregate.StateType); err != nil {
+			return iterutil.Map(err)
+		}
+	}
 	if !catid.IsOIDUserDefined(desc.ReturnType.Type.Oid()) {
 		return nil
 	}
@@ -518,6 +534,9 @@ func (desc *immutable) MaybeRequiresTypeHydration() bool {
 	if catid.IsOIDUserDefined(desc.ReturnType.Type.Oid()) {
 		return true
 	}
+	if desc.Aggregate != nil && catid.IsOIDUserDefined(desc.Aggregate.StateType.Oid()) {
+		return true
+	}
 	for i := range desc.Params {
 		if catid.IsOIDUserDefined(desc.Params[i].Type.Oid()) {
 			return true
@@ -1042,6 +1061,9 @@ func (desc *immutable) ToOverload() (ret *tree.Overload, err error) {
 		if tree.IsInParamClass(class) {
 			signatureTypes = append(signatureTypes, tree.ParamType{Name: param.Name, Typ: param.Type})
 		}
+		if class == tree.RoutineParamVariadic {
+			ret.Variadic = true
+		}
 		routineParam := tree.RoutineParam{
 			Name:  tree.Name(param.Name),
 			Type:  param.Type,
@@ -1069,6 +1091,16 @@ func (desc *immutable) ToOverload() (ret *tree.Overload, err error) {
 	if desc.ReturnType.ReturnSet {
 		ret.Class = tree.GeneratorClass
 	}
+	if agg := desc.Aggregate; agg != nil {
+		ret.Class = tree.AggregateClass
+		ret.UserDefinedAggregate = &tree.UserDefinedAggregate{
+			StateFunc:   agg.StateFunctionOID,
+			StateType:   agg.StateType,
+			FinalFunc:   agg.FinalFunctionOID,
+			CombineFunc: agg.CombineFunctionOID,
+			InitCond:    agg.InitialCondition,
+		}
+	}
 	ret.SecurityMode = desc.getCreateExprSecurity()
 
 	return ret, nil
@@ -1150,6 +1182,11 @@ func (desc *immutable) IsProcedure() bool {
 	return desc.FunctionDescriptor.IsProcedure
 }
 
+// IsAggregate implements the FunctionDescriptor interface.
+func (desc *immutable) IsAggregate() bool {
+	return desc.FunctionDescriptor.Aggregate != nil
+}
+
 func (desc *immutable) getCreateExprLang() tree.RoutineLanguage {
 	switch desc.Lang {
 	case catpb.Function_SQL:
@@ -19,8 +19,9 @@ import (
 // of input column descriptors, or nil if none of the input column descriptors
 // have default expressions.
 // The length of the result slice matches the length of the input column descriptors.
-// For every column that has no default expression, a NULL expression is reported
-// as default.
+// For every column that has no default expression, the default expression of
+// its type is reported if the type is a domain with a default, and otherwise a
+// NULL expression.
 func MakeDefaultExprs(
 	ctx context.Context,
 	cols []catalog.Column,
@@ -33,7 +34,7 @@ func MakeDefaultExprs(
 	// defaults map as the defaults are all NULL.
 	haveDefaults := false
 	for _, col := range cols {
-		if col.HasDefault() {
+		if _, ok := defaultExprStr(col); ok {
 			haveDefaults = true
 			break
 		}
@@ -46,8 +47,8 @@ func MakeDefaultExprs(
 	defaultExprs := make([]tree.TypedExpr, 0, len(cols))
 	exprStrings := make([]string, 0, len(cols))
 	for _, col := range cols {
-		if col.HasDefault() {
-			exprStrings = append(exprStrings, col.GetDefaultExpr())
+		if exprStr, ok := defaultExprStr(col); ok {
+			exprStrings = append(exprStrings, exprStr)
 		}
 	}
 	exprs, err := parserutils.ParseExprs(exprStrings)
@@ -57,7 +58,7 @@ func MakeDefaultExprs(
 
 	defExprIdx := 0
 	for _, col := range cols {
-		if !col.HasDefault() {
+		if _, ok := defaultExprStr(col); !ok {
 			defaultExprs = append(defaultExprs, tree.DNull)
 			continue
 		}
@@ -83,6 +84,19 @@ func MakeDefaultExprs(
 	return defaultExprs, nil
 }
 
+// defaultExprStr returns the serialized default expression of the given
+// column. If the column has no default expression, the default expression of
+// its type is returned if the type is a domain with a default.
+func defaultExprStr(col catalog.Column) (string, bool) {
+	if col.HasDefault() {
+		return col.GetDefaultExpr(), true
+	}
+	if domain := col.GetType().TypeMeta.DomainData; domain != nil && domain.DefaultExpr != nil {
+		return *domain.DefaultExpr, true
+	}
+	return "", false
+}
+
 // ProcessColumnSet returns columns in cols, and other writable
 // columns in tableDesc that fulfills a given criteria in inSet.
 func ProcessColumnSet(
@@ -0,0 +1,139 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package schemaexpr
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/parserutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+)
+
+// assertDomainConstraintFnName is the name of the builtin that raises an
+// error when a value violates a constraint of a domain.
+const assertDomainConstraintFnName = "crdb_internal.assert_domain_constraint"
+
+// domainValueName is the name by which the CHECK constraints of a domain
+// refer to the value being checked.
+const domainValueName = "value"
+
+// DomainChecker checks values against the NOT NULL and CHECK constraints of a
+// domain type. It is used where values are written without being planned by
+// the optimizer, which otherwise enforces the constraints of domains.
+type DomainChecker struct {
+	expr  tree.TypedExpr
+	value domainValueContainer
+}
+
+// domainValueContainer is the IndexedVarContainer of the expression of a
+// DomainChecker. The value being checked is the only IndexedVar.
+type domainValueContainer struct {
+	typ *types.T
+	val tree.Datum
+}
+
+var _ eval.IndexedVarContainer = &domainValueContainer{}
+
+// IndexedVarEval implements eval.IndexedVarContainer.
+func (c *domainValueContainer) IndexedVarEval(idx int) (tree.Datum, error) {
+	return c.val, nil
+}
+
+// IndexedVarResolvedType implements tree.IndexedVarContainer.
+func (c *domainValueContainer) IndexedVarResolvedType(idx int) *types.T {
+	return c.typ
+}
+
+// MakeDomainChecker returns a DomainChecker for the given type, or nil if the
+// type is not a domain or if the domain has no constraints.
+func MakeDomainChecker(
+	ctx context.Context, typ *types.T, semaCtx *tree.SemaContext,
+) (*DomainChecker, error) {
+	domain := typ.TypeMeta.DomainData
+	if !typ.IsDomain() || !domain.HasConstraints() {
+		return nil, nil
+	}
+	c := &DomainChecker{value: domainValueContainer{typ: typ.DomainBaseType()}}
+	value := tree.NewOrdinalReference(0)
+	assert := func(input, satisfied tree.Expr, constraintName string) tree.Expr {
+		return &tree.FuncExpr{
+			Func: tree.WrapFunction(assertDomainConstraintFnName),
+			Exprs: tree.Exprs{
+				input, satisfied, tree.NewDString(typ.Name()), tree.NewDString(constraintName),
+			},
+		}
+	}
+
+	// The checks are nested so that the expression evaluates to the value if
+	// it satisfies all of them. An empty constraint name refers to the NOT NULL
+	// constraint of the domain.
+	var expr tree.Expr = value
+	if domain.NotNull {
+		expr = assert(expr, &tree.IsNotNullExpr{Expr: value}, "" /* constraintName */)
+	}
+	for i := range domain.CheckExprs {
+		check, err := parserutils.ParseExpr(domain.CheckExprs[i])
+		if err != nil {
+			return nil, err
+		}
+		check, err = tree.SimpleVisit(check, func(e tree.Expr) (bool, tree.Expr, error) {
+			if n, ok := e.(*tree.UnresolvedName); ok && n.NumParts == 1 && n.Parts[0] == domainValueName {
+				return false, value, nil
+			}
+			return true, e, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		expr = assert(expr, check, domain.CheckNames[i])
+	}
+
+	oldIVarContainer := semaCtx.IVarContainer
+	defer func() { semaCtx.IVarContainer = oldIVarContainer }()
+	semaCtx.IVarContainer = &c.value
+	typedExpr, err := tree.TypeCheck(ctx, expr, semaCtx, c.value.typ)
+	if err != nil {
+		return nil, err
+	}
+	c.expr = typedExpr
+	return c, nil
+}
+
+// MakeDomainCheckers returns a DomainChecker for each of the given columns.
+// The checker of a column is nil if its type is not a domain with constraints.
+// The returned slice is nil if there are no such columns.
+func MakeDomainCheckers(
+	ctx context.Context, cols []catalog.Column, semaCtx *tree.SemaContext,
+) ([]*DomainChecker, error) {
+	var checkers []*DomainChecker
+	for i, col := range cols {
+		c, err := MakeDomainChecker(ctx, col.GetType(), semaCtx)
+		if err != nil {
+			return nil, err
+		}
+		if c == nil {
+			continue
+		}
+		if checkers == nil {
+			checkers = make([]*DomainChecker, len(cols))
+		}
+		checkers[i] = c
+	}
+	return checkers, nil
+}
+
+// Check returns an error if the given value violates a constraint of the
+// domain.
+func (c *DomainChecker) Check(ctx context.Context, evalCtx *eval.Context, val tree.Datum) error {
+	c.value.val = val
+	evalCtx.PushIVarContainer(&c.value)
+	defer evalCtx.PopIVarContainer()
+	_, err := eval.Expr(ctx, evalCtx, c.expr)
+	return err
+}
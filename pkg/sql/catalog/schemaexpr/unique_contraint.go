@@ -10,7 +10,11 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 )
@@ -50,3 +54,68 @@ func ValidateUniqueWithoutIndexPredicate(
 	}
 	return expr, nil
 }
+
+// ValidateExclusionOperators verifies that the operators of an exclusion
+// constraint are supported for the given column types. If they are, it returns
+// the symbols of the operators, as stored in the constraint descriptor.
+//
+// Exclusion constraints support the = and && operators, for column types which
+// define them.
+func ValidateExclusionOperators(
+	colTypes []*types.T, ops []treecmp.ComparisonOperator,
+) ([]string, error) {
+	symbols := make([]string, len(ops))
+	for i, op := range ops {
+		if _, err := ParseExclusionOperator(op.Symbol.String()); err != nil {
+			return nil, err
+		}
+		typ := colTypes[i]
+		if _, ok := tree.CmpOps[op.Symbol].LookupImpl(typ, typ); !ok {
+			return nil, pgerror.Newf(pgcode.UndefinedFunction,
+				"operator does not exist: %s %s %s", typ.SQLString(), op.Symbol, typ.SQLString())
+		}
+		symbols[i] = op.Symbol.String()
+	}
+	return symbols, nil
+}
+
+// ParseExclusionOperator returns the comparison operator with the given symbol,
+// if it is supported in exclusion constraints.
+func ParseExclusionOperator(symbol string) (treecmp.ComparisonOperatorSymbol, error) {
+	switch symbol {
+	case treecmp.EQ.String():
+		return treecmp.EQ, nil
+	case treecmp.Overlaps.String():
+		return treecmp.Overlaps, nil
+	}
+	return 0, pgerror.Newf(pgcode.FeatureNotSupported,
+		"operator %s is not supported in exclusion constraints", symbol)
+}
+
+// ExclusionIndexColumns returns the ordinals of the columns of an exclusion
+// constraint that make up the inverted index backing it. The columns compared
+// with = are the prefix columns of the index, and the first column compared
+// with && is its inverted column. It returns false if none of the columns
+// compared with && can be indexed by an inverted index, in which case the
+// constraint is checked with a scan of the entire table.
+func ExclusionIndexColumns(
+	colTypes []*types.T, ops []treecmp.ComparisonOperator,
+) (ords []int, ok bool) {
+	invertedOrd := -1
+	for i, op := range ops {
+		switch op.Symbol {
+		case treecmp.EQ:
+			if colinfo.ColumnTypeIsIndexable(colTypes[i]) {
+				ords = append(ords, i)
+			}
+		case treecmp.Overlaps:
+			if invertedOrd == -1 && colinfo.ColumnTypeIsInvertedIndexable(colTypes[i]) {
+				invertedOrd = i
+			}
+		}
+	}
+	if invertedOrd == -1 {
+		return nil, false
+	}
+	return append(ords, invertedOrd), true
+}
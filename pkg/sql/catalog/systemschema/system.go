@@ -366,8 +366,9 @@ CREATE TABLE system.table_statistics (
 	"avgSize"            INT8       NOT NULL DEFAULT 0,
 	"partialPredicate"   STRING,
 	"fullStatisticID"    INT8,
+	"dependencyDegrees"  FLOAT8[],
 	CONSTRAINT "primary" PRIMARY KEY ("tableID", "statisticID"),
-	FAMILY "fam_0_tableID_statisticID_name_columnIDs_createdAt_rowCount_distinctCount_nullCount_histogram" ("tableID", "statisticID", name, "columnIDs", "createdAt", "rowCount", "distinctCount", "nullCount", histogram, "avgSize", "partialPredicate", "fullStatisticID")
+	FAMILY "fam_0_tableID_statisticID_name_columnIDs_createdAt_rowCount_distinctCount_nullCount_histogram" ("tableID", "statisticID", name, "columnIDs", "createdAt", "rowCount", "distinctCount", "nullCount", histogram, "avgSize", "partialPredicate", "fullStatisticID", "dependencyDegrees")
 );`
 
 	// locations are used to map a locality specified by a node to geographic
@@ -1397,6 +1398,71 @@ CREATE TABLE public.inspect_errors (
     CONSTRAINT "primary" PRIMARY KEY ("row_id" ASC),
     INDEX hash_idx (hash ASC),
     FAMILY "primary" (row_id, hash, fingerprint, hint, created_at)
+  );`
+
+	// NotificationsTableSchema defines the schema for the system.notifications
+	// table, which is used to deliver the notifications sent by NOTIFY and
+	// pg_notify() to the sessions that LISTEN on their channel. Rows are
+	// inserted in the notifying transaction and picked up by a rangefeed on
+	// every node once it commits; they are deleted shortly afterwards.
+	// * id: a unique ID used as the primary key.
+	// * channel: the channel the notification was sent on.
+	// * payload: the payload of the notification.
+	// * pid: the backend PID of the notifying session.
+	// * seq: the position of the notification within its transaction.
+	// * created_at: the timestamp when the notification was sent.
+	NotificationsTableSchema = `
+  CREATE TABLE system.notifications (
+    id         UUID NOT NULL DEFAULT gen_random_uuid(),
+    channel    STRING NOT NULL,
+    payload    STRING NOT NULL,
+    pid        INT4 NOT NULL,
+    seq        INT4 NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT "primary" PRIMARY KEY (id ASC),
+    FAMILY "primary" (id, channel, payload, pid, seq, created_at)
+  );`
+
+	// PublicationsTableSchema defines the schema for the system.publications
+	// table, which stores the publications created by CREATE PUBLICATION. A
+	// publication is a set of tables of a database whose changes are streamed
+	// to logical replication subscribers.
+	// * database_id: the ID of the database the publication belongs to.
+	// * name: the name of the publication.
+	// * owner: the user that created the publication.
+	// * all_tables: whether the publication was created FOR ALL TABLES.
+	// * table_ids: the IDs of the published tables, if not all_tables.
+	// * created_at: the timestamp when the publication was created.
+	PublicationsTableSchema = `
+  CREATE TABLE system.publications (
+    database_id INT8 NOT NULL,
+    name        STRING NOT NULL,
+    owner       STRING NOT NULL,
+    all_tables  BOOL NOT NULL,
+    table_ids   INT8[] NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT "primary" PRIMARY KEY (database_id ASC, name ASC),
+    FAMILY "primary" (database_id, name, owner, all_tables, table_ids, created_at)
+  );`
+
+	// ReplicationSlotsTableSchema defines the schema for the
+	// system.replication_slots table, which stores the logical replication
+	// slots created by CREATE_REPLICATION_SLOT over a replication connection.
+	// * slot_name: the name of the slot.
+	// * database_id: the ID of the database the slot was created in.
+	// * plugin: the output plugin of the slot.
+	// * confirmed_flush_lsn: the LSN up to which the subscriber has confirmed
+	//   receipt of the changes; streaming resumes from this LSN.
+	// * created_at: the timestamp when the slot was created.
+	ReplicationSlotsTableSchema = `
+  CREATE TABLE system.replication_slots (
+    slot_name           STRING NOT NULL,
+    database_id         INT8 NOT NULL,
+    plugin              STRING NOT NULL,
+    confirmed_flush_lsn INT8 NOT NULL,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT "primary" PRIMARY KEY (slot_name ASC),
+    FAMILY "primary" (slot_name, database_id, plugin, confirmed_flush_lsn, created_at)
   );`
 )
 
@@ -1442,7 +1508,7 @@ const SystemDatabaseName = catconstants.SystemDatabaseName
 // release version).
 //
 // NB: Don't set this to clusterversion.Latest; use a specific version instead.
-var SystemDatabaseSchemaBootstrapVersion = clusterversion.V26_1_Start.Version()
+var SystemDatabaseSchemaBootstrapVersion = clusterversion.V26_1_AddSystemPublicationsTables.Version()
 
 // MakeSystemDatabaseDesc constructs a copy of the system database
 // descriptor.
@@ -1642,6 +1708,9 @@ func MakeSystemTables() []SystemTable {
 		TransactionDiagnosticsRequestsTable,
 		TransactionDiagnosticsTable,
 		StatementHintsTable,
+		NotificationsTable,
+		PublicationsTable,
+		ReplicationSlotsTable,
 	}
 }
 
@@ -2310,6 +2379,7 @@ var (
 				{Name: "avgSize", ID: 10, Type: types.Int, DefaultExpr: &zeroIntString},
 				{Name: "partialPredicate", ID: 11, Type: types.String, Nullable: true},
 				{Name: "fullStatisticID", ID: 12, Type: types.Int, Nullable: true},
+				{Name: "dependencyDegrees", ID: 13, Type: types.FloatArray, Nullable: true},
 			},
 			[]descpb.ColumnFamilyDescriptor{
 				{
@@ -2328,8 +2398,9 @@ var (
 						"avgSize",
 						"partialPredicate",
 						"fullStatisticID",
+						"dependencyDegrees",
 					},
-					ColumnIDs: []descpb.ColumnID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+					ColumnIDs: []descpb.ColumnID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13},
 				},
 			},
 			descpb.IndexDescriptor{
@@ -5475,6 +5546,101 @@ var (
 			},
 		),
 	)
+
+	NotificationsTable = makeSystemTable(
+		NotificationsTableSchema,
+		systemTable(
+			catconstants.NotificationsTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "id", ID: 1, Type: types.Uuid, DefaultExpr: &genRandomUUIDString},
+				{Name: "channel", ID: 2, Type: types.String},
+				{Name: "payload", ID: 3, Type: types.String},
+				{Name: "pid", ID: 4, Type: types.Int4},
+				{Name: "seq", ID: 5, Type: types.Int4},
+				{Name: "created_at", ID: 6, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:        "primary",
+					ID:          0,
+					ColumnNames: []string{"id", "channel", "payload", "pid", "seq", "created_at"},
+					ColumnIDs:   []descpb.ColumnID{1, 2, 3, 4, 5, 6},
+				},
+			},
+			descpb.IndexDescriptor{
+				Name:                "primary",
+				ID:                  1,
+				Unique:              true,
+				KeyColumnNames:      []string{"id"},
+				KeyColumnDirections: singleASC,
+				KeyColumnIDs:        []descpb.ColumnID{1},
+			},
+		),
+	)
+
+	PublicationsTable = makeSystemTable(
+		PublicationsTableSchema,
+		systemTable(
+			catconstants.PublicationsTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "database_id", ID: 1, Type: types.Int},
+				{Name: "name", ID: 2, Type: types.String},
+				{Name: "owner", ID: 3, Type: types.String},
+				{Name: "all_tables", ID: 4, Type: types.Bool},
+				{Name: "table_ids", ID: 5, Type: types.IntArray},
+				{Name: "created_at", ID: 6, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:        "primary",
+					ID:          0,
+					ColumnNames: []string{"database_id", "name", "owner", "all_tables", "table_ids", "created_at"},
+					ColumnIDs:   []descpb.ColumnID{1, 2, 3, 4, 5, 6},
+				},
+			},
+			descpb.IndexDescriptor{
+				Name:                "primary",
+				ID:                  1,
+				Unique:              true,
+				KeyColumnNames:      []string{"database_id", "name"},
+				KeyColumnDirections: []catenumpb.IndexColumn_Direction{catenumpb.IndexColumn_ASC, catenumpb.IndexColumn_ASC},
+				KeyColumnIDs:        []descpb.ColumnID{1, 2},
+			},
+		),
+	)
+
+	ReplicationSlotsTable = makeSystemTable(
+		ReplicationSlotsTableSchema,
+		systemTable(
+			catconstants.ReplicationSlotsTableName,
+			descpb.InvalidID, // dynamically assigned
+			[]descpb.ColumnDescriptor{
+				{Name: "slot_name", ID: 1, Type: types.String},
+				{Name: "database_id", ID: 2, Type: types.Int},
+				{Name: "plugin", ID: 3, Type: types.String},
+				{Name: "confirmed_flush_lsn", ID: 4, Type: types.Int},
+				{Name: "created_at", ID: 5, Type: types.TimestampTZ, DefaultExpr: &nowTZString},
+			},
+			[]descpb.ColumnFamilyDescriptor{
+				{
+					Name:        "primary",
+					ID:          0,
+					ColumnNames: []string{"slot_name", "database_id", "plugin", "confirmed_flush_lsn", "created_at"},
+					ColumnIDs:   []descpb.ColumnID{1, 2, 3, 4, 5},
+				},
+			},
+			descpb.IndexDescriptor{
+				Name:                "primary",
+				ID:                  1,
+				Unique:              true,
+				KeyColumnNames:      []string{"slot_name"},
+				KeyColumnDirections: singleASC,
+				KeyColumnIDs:        []descpb.ColumnID{1},
+			},
+		),
+	)
 )
 
 // SpanConfigurationsTableName represents system.span_configurations.
@@ -629,6 +629,10 @@ type UniqueWithoutIndexConstraint interface {
 
 	// ParentTableID returns the ID of the table this constraint applies to.
 	ParentTableID() descpb.ID
+
+	// IsExclusion returns true iff this is an exclusion constraint, which
+	// compares its columns with ExclusionOperators instead of equality.
+	IsExclusion() bool
 }
 
 // PrimaryKeySwap is an interface around a primary key swap mutation.
@@ -1039,10 +1043,17 @@ func ColumnNeedsBackfill(col Column) bool {
 	//  - computed columns
 	//  - non-nullable columns (note: if a non-nullable column doesn't have a
 	//    default value, the backfill will fail unless the table is empty).
+	//  - columns of a domain type with constraints, which the backfill checks,
+	//    or with a default value, which is used if the column has none.
+	domain := col.GetType().TypeMeta.DomainData
+	if domain.HasConstraints() {
+		return true
+	}
 	if col.HasNullDefault() {
 		return false
 	}
-	return col.HasDefault() || !col.IsNullable() || col.IsComputed()
+	return col.HasDefault() || !col.IsNullable() || col.IsComputed() ||
+		(domain != nil && domain.DefaultExpr != nil)
 }
 
 // GetConstraintType finds the type of constraint.
@@ -1739,6 +1739,12 @@ func (desc *Mutable) MakeMutationComplete(m descpb.DescriptorMutation) error {
 			// indexes with the new indexes that have been backfilled already.
 			desc.SetPrimaryIndex(t.MaterializedViewRefresh.NewPrimaryIndex)
 			desc.SetPublicNonPrimaryIndexes(t.MaterializedViewRefresh.NewIndexes)
+			// Record the timestamp as of which the data was computed, which is
+			// where the next incremental refresh starts from.
+			desc.RefreshedAsOf = hlc.Timestamp{}
+			if t.MaterializedViewRefresh.ShouldBackfill {
+				desc.RefreshedAsOf = t.MaterializedViewRefresh.AsOf
+			}
 		}
 
 	case descpb.DescriptorMutation_DROP:
@@ -2601,9 +2607,15 @@ func (desc *wrapper) GetStorageParams(spaceBetweenEqual bool) ([]string, error)
 	if count, ok := desc.HistogramBucketsCount(); ok {
 		appendStorageParam(`sql_stats_histogram_buckets_count`, fmt.Sprintf("%d", count))
 	}
+	if groups := desc.formatStatsColumnGroups(); groups != "" {
+		appendStorageParam(`sql_stats_column_groups`, lexbase.EscapeSQLString(groups))
+	}
 	if desc.IsSchemaLocked() {
 		appendStorageParam(`schema_locked`, `true`)
 	}
+	if desc.LockTableBlocksDML() {
+		appendStorageParam(`lock_table_blocks_dml`, `true`)
+	}
 	if usingFK := desc.GetRegionalByRowUsingConstraint(); usingFK != descpb.ConstraintID(0) {
 		// NOTE: when validating the descriptor, we check that the referenced
 		// constraint exists, so this should never fail.
@@ -2701,6 +2713,33 @@ func (desc *wrapper) HistogramSamplesCount() (histogramSamplesCount uint32, ok b
 	return *desc.HistogramSamples, true
 }
 
+// formatStatsColumnGroups returns the groups of columns of the table setting
+// sql_stats_column_groups, in the format accepted by the setting. Groups that
+// contain dropped columns are omitted.
+func (desc *wrapper) formatStatsColumnGroups() string {
+	fmtCtx := tree.NewFmtCtx(tree.FmtSimple)
+	for _, group := range desc.StatsColumnGroups {
+		names := make(tree.NameList, 0, len(group.ColumnIDs))
+		for _, id := range group.ColumnIDs {
+			col := catalog.FindColumnByID(desc, id)
+			if col == nil || col.Dropped() {
+				break
+			}
+			names = append(names, col.ColName())
+		}
+		if len(names) != len(group.ColumnIDs) {
+			continue
+		}
+		if fmtCtx.Buffer.Len() > 0 {
+			fmtCtx.WriteString(", ")
+		}
+		fmtCtx.WriteByte('(')
+		fmtCtx.FormatNode(&names)
+		fmtCtx.WriteByte(')')
+	}
+	return fmtCtx.CloseAndGetString()
+}
+
 // HistogramBucketsCount implements the TableDescriptor interface.
 func (desc *wrapper) HistogramBucketsCount() (histogramBucketsCount uint32, ok bool) {
 	if desc.HistogramBuckets == nil {
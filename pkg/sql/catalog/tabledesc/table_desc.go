@@ -684,6 +684,11 @@ func (desc *wrapper) IsSchemaLocked() bool {
 	return desc.SchemaLocked
 }
 
+// LockTableBlocksDML implements the TableDescriptor interface.
+func (desc *wrapper) LockTableBlocksDML() bool {
+	return desc.LockTableBlocksDML
+}
+
 // IsPrimaryKeySwapMutation implements the TableDescriptor interface.
 func (desc *wrapper) IsPrimaryKeySwapMutation(m *descpb.DescriptorMutation) bool {
 	switch t := m.Descriptor_.(type) {
@@ -120,6 +120,13 @@ func (desc *wrapper) GetReferencedDescIDs(
 	for _, ref := range desc.GetDependedOnBy() {
 		ids.Add(ref.ID)
 	}
+	// Add inheritance parents and children.
+	for _, id := range desc.GetInheritsFrom() {
+		ids.Add(id)
+	}
+	for _, id := range desc.GetInheritedBy() {
+		ids.Add(id)
+	}
 	// Add trigger dependencies. NOTE: routine references are included above in
 	// the call to GetAllReferencedFunctionIDs().
 	for _, t := range desc.Triggers {
@@ -227,6 +234,11 @@ func (desc *wrapper) ValidateForwardReferences(
 		vea.Report(desc.validateOutboundFK(fk.ForeignKeyDesc(), vdg))
 	}
 
+	// Check inheritance parents.
+	for _, id := range desc.InheritsFrom {
+		vea.Report(desc.validateInheritanceRef(id, vdg))
+	}
+
 	// Check partitioning is correctly set.
 	// We only check these for active indexes, as inactive indexes may be in the
 	// process of being backfilled without PartitionAllBy.
@@ -301,6 +313,14 @@ func (desc *wrapper) ValidateBackReferences(
 		vea.Report(desc.validateInboundFK(&desc.InboundFKs[i], vdg))
 	}
 
+	// Check that inheritance links have matching back-references.
+	for _, id := range desc.InheritsFrom {
+		vea.Report(desc.validateInheritanceBackReference(id, vdg, false /* isParent */))
+	}
+	for _, id := range desc.InheritedBy {
+		vea.Report(desc.validateInheritanceBackReference(id, vdg, true /* isParent */))
+	}
+
 	// Check all functions referenced by constraint exists.
 	for _, cst := range desc.Checks {
 		fnIDs, err := desc.GetAllReferencedFunctionIDsInConstraint(cst.ConstraintID)
@@ -738,6 +758,59 @@ func (desc *wrapper) validateInboundFK(
 		backref.Name, desc.Name, originTable.GetName())
 }
 
+func (desc *wrapper) validateInheritanceRef(
+	parentID descpb.ID, vdg catalog.ValidationDescGetter,
+) error {
+	parent, err := vdg.GetTableDescriptor(parentID)
+	if err != nil {
+		return errors.Wrapf(err, "invalid inheritance: missing parent table=%d", parentID)
+	}
+	if parent.Dropped() {
+		return errors.AssertionFailedf("inherited table %q (%d) is dropped",
+			parent.GetName(), parent.GetID())
+	}
+	return nil
+}
+
+// validateInheritanceBackReference checks that the table identified by id
+// refers back to this table. If isParent is set, this table is the parent and
+// id identifies one of its children; otherwise id identifies one of its
+// parents.
+func (desc *wrapper) validateInheritanceBackReference(
+	id descpb.ID, vdg catalog.ValidationDescGetter, isParent bool,
+) error {
+	other, err := vdg.GetTableDescriptor(id)
+	if err != nil {
+		if isParent {
+			return errors.Wrapf(err, "invalid inheritance backreference: missing table=%d", id)
+		}
+		// Missing parents are reported by ValidateForwardReferences.
+		return nil
+	}
+	if other.Dropped() {
+		if isParent {
+			return errors.AssertionFailedf("inheriting table %q (%d) is dropped",
+				other.GetName(), other.GetID())
+		}
+		return nil
+	}
+	refs := other.GetInheritedBy()
+	if isParent {
+		refs = other.GetInheritsFrom()
+	}
+	for _, ref := range refs {
+		if ref == desc.ID {
+			return nil
+		}
+	}
+	if isParent {
+		return errors.AssertionFailedf("missing inheritance forward reference to %q from %q",
+			desc.Name, other.GetName())
+	}
+	return errors.AssertionFailedf("missing inheritance back reference to %q from %q",
+		desc.Name, other.GetName())
+}
+
 func (desc *wrapper) matchingPartitionbyAll(indexI catalog.Index) bool {
 	primaryIndexPartitioning := desc.PrimaryIndex.KeyColumnIDs[:desc.PrimaryIndex.Partitioning.NumColumns]
 	indexPartitioning := indexI.IndexDesc().KeyColumnIDs[:indexI.PartitioningColumnCount()]
@@ -880,6 +953,10 @@ func (desc *wrapper) ValidateSelf(vea catalog.ValidationErrorAccumulator) {
 
 	desc.validateAutoStatsSettings(vea)
 
+	if desc.IsForeignTable() {
+		desc.validateForeignTable(vea)
+	}
+
 	if desc.IsSequence() {
 		return
 	}
@@ -1359,6 +1436,21 @@ func (desc *wrapper) validateColumns() error {
 	return nil
 }
 
+// validateForeignTable validates the fields specific to foreign tables. The
+// rows of a foreign table are not stored in the KV layer, so it has neither
+// indexes nor column families.
+func (desc *wrapper) validateForeignTable(vea catalog.ValidationErrorAccumulator) {
+	if !desc.IsTable() || desc.IsVirtualTable() {
+		vea.Report(errors.AssertionFailedf("foreign table descriptor is not a table"))
+	}
+	if desc.Foreign.Server == "" {
+		vea.Report(errors.AssertionFailedf("foreign table has no server"))
+	}
+	if desc.PrimaryIndex.ID != 0 || len(desc.Indexes) > 0 || len(desc.Families) > 0 {
+		vea.Report(errors.AssertionFailedf("foreign table has indexes or column families"))
+	}
+}
+
 func (desc *wrapper) validateColumnFamilies(columnsByID map[descpb.ColumnID]catalog.Column) error {
 	if len(desc.Families) < 1 {
 		return errors.Newf("at least 1 column family must be specified")
@@ -1603,6 +1695,15 @@ func (desc *wrapper) validateUniqueWithoutIndexConstraints(
 			seen.Add(int(colID))
 		}
 
+		if c.IsExclusion() {
+			if ops := c.UniqueWithoutIndexDesc().ExclusionOperators; len(ops) != c.NumKeyColumns() {
+				return errors.Newf(
+					"exclusion constraint %q has %d operators for %d columns",
+					c.GetName(), len(ops), c.NumKeyColumns(),
+				)
+			}
+		}
+
 		if c.IsPartial() {
 			expr, err := parserutils.ParseExpr(c.GetPredicate())
 			if err != nil {
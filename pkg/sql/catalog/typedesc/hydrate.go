@@ -154,4 +154,20 @@ func ensureTypeMetadataIsHydrated(
 			}
 		}
 	}
+	if d := maybeDesc.AsDomainTypeDescriptor(); d != nil {
+		n := d.NumChecks()
+		tm.DomainData = &types.DomainMetadata{
+			NotNull:    d.IsNotNull(),
+			CheckNames: make([]string, n),
+			CheckExprs: make([]string, n),
+		}
+		if def, ok := d.DefaultExpr(); ok {
+			tm.DomainData.DefaultExpr = &def
+		}
+		for i := 0; i < n; i++ {
+			c := d.GetCheck(i)
+			tm.DomainData.CheckNames[i] = c.Name
+			tm.DomainData.CheckExprs[i] = c.Expr
+		}
+	}
 }
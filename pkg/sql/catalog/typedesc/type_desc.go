@@ -502,6 +502,12 @@ func (desc *immutable) ValidateSelf(vea catalog.ValidationErrorAccumulator) {
 		if desc.Composite == nil {
 			vea.Report(errors.AssertionFailedf("COMPOSITE type desc has nil composite type"))
 		}
+	case descpb.TypeDescriptor_DOMAIN:
+		if desc.Domain == nil {
+			vea.Report(errors.AssertionFailedf("DOMAIN type desc has nil domain"))
+		} else if desc.Domain.BaseType == nil {
+			vea.Report(errors.AssertionFailedf("DOMAIN type desc has nil base type"))
+		}
 	case descpb.TypeDescriptor_TABLE_IMPLICIT_RECORD_TYPE:
 		vea.Report(errors.AssertionFailedf("invalid type descriptor: kind %s should never be serialized or validated", desc.Kind.String()))
 	default:
@@ -610,6 +616,14 @@ func (desc *immutable) ValidateForwardReferences(
 		}
 	}
 
+	if d := desc.AsDomainTypeDescriptor(); d != nil && d.BaseType().UserDefined() {
+		// Domains over user-defined types are currently not supported, but this
+		// should be validated elsewhere.
+		vea.Report(errors.AssertionFailedf("invalid reference to user-defined type %q from domain %q",
+			d.BaseType().String(), desc.GetName(),
+		))
+	}
+
 	if c := desc.AsCompositeTypeDescriptor(); c != nil {
 		for i := 0; i < c.NumElements(); i++ {
 			t := c.GetElementType(i)
@@ -771,6 +785,12 @@ func (desc *immutable) AsTypesT() *types.T {
 			contents,
 			labels,
 		)
+	case descpb.TypeDescriptor_DOMAIN:
+		return types.MakeDomain(
+			catid.TypeIDToOID(desc.GetID()),
+			catid.TypeIDToOID(desc.ArrayTypeID),
+			desc.Domain.BaseType,
+		)
 	}
 	panic(errors.AssertionFailedf("unsupported descriptor kind %s", desc.Kind.String()))
 }
@@ -1025,6 +1045,14 @@ func (desc *immutable) AsCompositeTypeDescriptor() catalog.CompositeTypeDescript
 	return nil
 }
 
+// AsDomainTypeDescriptor implements the catalog.TypeDescriptor interface.
+func (desc *immutable) AsDomainTypeDescriptor() catalog.DomainTypeDescriptor {
+	if desc.Kind == descpb.TypeDescriptor_DOMAIN {
+		return desc
+	}
+	return nil
+}
+
 // AsTableImplicitRecordTypeDescriptor implements the catalog.TypeDescriptor
 // interface.
 func (desc *immutable) AsTableImplicitRecordTypeDescriptor() catalog.TableImplicitRecordTypeDescriptor {
@@ -1051,6 +1079,34 @@ func (desc *immutable) GetElementType(ordinal int) *types.T {
 	return desc.Composite.Elements[ordinal].ElementType
 }
 
+// BaseType implements the catalog.DomainTypeDescriptor interface.
+func (desc *immutable) BaseType() *types.T {
+	return desc.Domain.BaseType
+}
+
+// IsNotNull implements the catalog.DomainTypeDescriptor interface.
+func (desc *immutable) IsNotNull() bool {
+	return desc.Domain.NotNull
+}
+
+// DefaultExpr implements the catalog.DomainTypeDescriptor interface.
+func (desc *immutable) DefaultExpr() (string, bool) {
+	if desc.Domain.DefaultExpr == nil {
+		return "", false
+	}
+	return *desc.Domain.DefaultExpr, true
+}
+
+// NumChecks implements the catalog.DomainTypeDescriptor interface.
+func (desc *immutable) NumChecks() int {
+	return len(desc.Domain.Checks)
+}
+
+// GetCheck implements the catalog.DomainTypeDescriptor interface.
+func (desc *immutable) GetCheck(ordinal int) *descpb.TypeDescriptor_Domain_CheckConstraint {
+	return &desc.Domain.Checks[ordinal]
+}
+
 // ForEachRegionInSuperRegion implements the catalog.RegionEnumTypeDescriptor
 // interface.
 func (desc *immutable) ForEachRegionInSuperRegion(
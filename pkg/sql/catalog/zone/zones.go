@@ -7,6 +7,7 @@ package zone
 
 import (
 	"context"
+	"math"
 	"sort"
 
 	"github.com/cockroachdb/cockroach/pkg/base"
@@ -89,6 +90,11 @@ func init() {
 			RequiredType: types.Int,
 			Setter:       func(c *zonepb.ZoneConfig, d tree.Datum) { c.NumVoters = proto.Int32(int32(tree.MustBeDInt(d))) },
 		},
+		{
+			Field:        config.NumWitnesses,
+			RequiredType: types.Int,
+			Setter:       func(c *zonepb.ZoneConfig, d tree.Datum) { c.NumWitnesses = proto.Int32(int32(tree.MustBeDInt(d))) },
+		},
 		{
 			Field:        config.GCTTL,
 			RequiredType: types.Int,
@@ -96,6 +102,18 @@ func init() {
 				c.GC = &zonepb.GCPolicy{TTLSeconds: int32(tree.MustBeDInt(d))}
 			},
 		},
+		{
+			Field:        config.ColdStorageAfter,
+			RequiredType: types.Interval,
+			Setter: func(c *zonepb.ZoneConfig, d tree.Datum) {
+				// Out of range intervals are rejected by ZoneConfig.Validate().
+				secs, ok := tree.MustBeDInterval(d).AsInt64()
+				if !ok || secs > math.MaxInt32 {
+					secs = -1
+				}
+				c.ColdStorageAfterSeconds = proto.Int32(int32(secs))
+			},
+		},
 		{
 			Field:        config.Constraints,
 			RequiredType: types.String,
@@ -32,6 +32,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/log"
 	"github.com/cockroachdb/cockroach/pkg/util/retry"
 	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/redact"
 	pbtypes "github.com/gogo/protobuf/types"
 )
 
@@ -227,6 +228,19 @@ func nonMatchingRowQuery(
 		targetCols[i] = fmt.Sprintf("t.%s", tree.NameString(referencedColNames[i]))
 		on[i] = fmt.Sprintf("%s = %s", qualifiedSrcCols[i], targetCols[i])
 	}
+	srcFilter := strings.Join(srcWhere, " AND ")
+	// Sufficient to check the first column to see whether there was no matching row
+	noMatch := fmt.Sprintf("%s IS NULL", targetCols[0])
+	if fk.Match == semenumpb.Match_PARTIAL {
+		// Under MATCH PARTIAL, rows with some NULL columns must match on the
+		// non-NULL columns. A matching row has a non-NULL value in at least one
+		// of the target columns.
+		for i := 0; i < nCols; i++ {
+			on[i] = fmt.Sprintf("(%s IS NULL OR %s)", qualifiedSrcCols[i], on[i])
+		}
+		srcFilter = fmt.Sprintf("(%s)", strings.Join(srcWhere, " OR "))
+		noMatch = strings.Join(targetCols, " IS NULL AND ") + " IS NULL"
+	}
 
 	limit := ""
 	if limitResults {
@@ -238,16 +252,15 @@ func nonMatchingRowQuery(
 			LEFT OUTER JOIN
 			[%[5]d AS target] AS t
 			ON %[6]s
-		 WHERE %[7]s IS NULL %[8]s`,
+		 WHERE %[7]s %[8]s`,
 		strings.Join(qualifiedSrcCols, ", "), // 1
 		strings.Join(srcCols, ", "),          // 2
 		srcTbl.GetID(),                       // 3
-		strings.Join(srcWhere, " AND "),      // 4
+		srcFilter,                            // 4
 		targetTbl.GetID(),                    // 5
 		strings.Join(on, " AND "),            // 6
-		// Sufficient to check the first column to see whether there was no matching row
-		targetCols[0], // 7
-		limit,         // 8
+		noMatch,                              // 7
+		limit,                                // 8
 	)
 	if indexIDForValidation != 0 {
 		query = fmt.Sprintf(
@@ -256,17 +269,16 @@ func nonMatchingRowQuery(
 			LEFT OUTER JOIN
 			[%[6]d AS target] AS t
 			ON %[7]s
-		 WHERE %[8]s IS NULL %[9]s`,
+		 WHERE %[8]s %[9]s`,
 			strings.Join(qualifiedSrcCols, ", "), // 1
 			strings.Join(srcCols, ", "),          // 2
 			srcTbl.GetID(),                       // 3
 			indexIDForValidation,                 // 4
-			strings.Join(srcWhere, " AND "),      // 5
+			srcFilter,                            // 5
 			targetTbl.GetID(),                    // 6
 			strings.Join(on, " AND "),            // 7
-			// Sufficient to check the first column to see whether there was no matching row
-			targetCols[0], // 8
-			limit,         // 9
+			noMatch,                              // 8
+			limit,                                // 9
 		)
 	}
 	return query, originColNames, nil
@@ -280,7 +292,7 @@ func nonMatchingRowQuery(
 func validateForeignKey(
 	ctx context.Context,
 	txn isql.Txn,
-	srcTable *tabledesc.Mutable,
+	srcTable catalog.TableDescriptor,
 	targetTable catalog.TableDescriptor,
 	fk *descpb.ForeignKeyConstraint,
 	indexIDForValidation descpb.IndexID,
@@ -305,7 +317,7 @@ func validateForeignKey(
 
 		log.Dev.Infof(ctx, "validating MATCH FULL FK %q (%q [%v] -> %q [%v]) with query %q",
 			fk.Name,
-			srcTable.Name, colNames,
+			srcTable.GetName(), colNames,
 			targetTable.GetName(), referencedColumnNames,
 			query,
 		)
@@ -330,7 +342,7 @@ func validateForeignKey(
 
 	log.Dev.Infof(ctx, "validating FK %q (%q [%v] -> %q [%v]) with query %q",
 		fk.Name,
-		srcTable.Name, colNames, targetTable.GetName(), referencedColumnNames,
+		srcTable.GetName(), colNames, targetTable.GetName(), referencedColumnNames,
 		query,
 	)
 
@@ -342,7 +354,7 @@ func validateForeignKey(
 	if values.Len() > 0 {
 		return pgerror.WithConstraintName(pgerror.Newf(pgcode.ForeignKeyViolation,
 			"foreign key violation: %q row %s has no match in %q",
-			srcTable.Name, formatValues(colNames, values), targetTable.GetName()), fk.Name)
+			srcTable.GetName(), formatValues(colNames, values), targetTable.GetName()), fk.Name)
 	}
 	return nil
 }
@@ -506,12 +518,10 @@ func (p *planner) RevalidateUniqueConstraint(
 	// Check UNIQUE WITHOUT INDEX constraints.
 	for _, uc := range tableDesc.EnforcedUniqueConstraintsWithoutIndex() {
 		if uc.GetName() == constraintName {
-			return validateUniqueConstraint(
+			return validateUniqueWithoutIndexConstraint(
 				ctx,
 				tableDesc,
-				uc.GetName(),
-				uc.CollectKeyColumnIDs().Ordered(),
-				uc.GetPredicate(),
+				uc,
 				0, /* indexIDForValidation */
 				p.InternalSQLTxn(),
 				p.User(),
@@ -586,12 +596,10 @@ func RevalidateUniqueConstraintsInTable(
 	// Check UNIQUE WITHOUT INDEX constraints.
 	for _, uc := range tableDesc.EnforcedUniqueConstraintsWithoutIndex() {
 		if uc.IsConstraintValidated() {
-			if err := validateUniqueConstraint(
+			if err := validateUniqueWithoutIndexConstraint(
 				ctx,
 				tableDesc,
-				uc.GetName(),
-				uc.CollectKeyColumnIDs().Ordered(),
-				uc.GetPredicate(),
+				uc,
 				0, /* indexIDForValidation */
 				txn,
 				user,
@@ -647,24 +655,64 @@ func validateUniqueConstraint(
 
 	sessionDataOverride := sessiondata.NoSessionDataOverride
 	sessionDataOverride.User = user
-	// We are likely to have performed a lot of work before getting here (e.g.
-	// importing the data), so we want to make an effort in order to run the
-	// validation query without error in order to not fail the whole operation.
-	// Thus, we allow up to 5 retries with an exponential backoff for an
-	// allowlist of errors.
-	//
-	// We choose to explicitly perform the retry here rather than propagate the
-	// error as "job retryable" and relying on the jobs framework to do the
-	// retries in order to not waste (a lot of) work that was performed before
-	// we got here.
-	var values tree.Datums
+	values, err := queryValidationRowWithRetry(
+		ctx, txn, "validate unique constraint", sessionDataOverride, query,
+	)
+	if err != nil {
+		return err
+	}
+	if values.Len() > 0 {
+		valuesStr := make([]string, len(values))
+		for i := range values {
+			valuesStr[i] = values[i].String()
+		}
+		// Note: this error message mirrors the message produced by Postgres
+		// when it fails to add a unique index due to duplicated keys.
+		errMsg := "could not create unique constraint"
+		if preExisting {
+			errMsg = "failed to validate unique constraint"
+		}
+		return errors.WithDetail(
+			pgerror.WithConstraintName(
+				pgerror.Newf(
+					pgcode.UniqueViolation, "%s %q", errMsg, constraintName,
+				),
+				constraintName,
+			),
+			fmt.Sprintf(
+				"Key (%s)=(%s) is duplicated.", strings.Join(colNames, ","), strings.Join(valuesStr, ","),
+			),
+		)
+	}
+	return nil
+}
+
+// queryValidationRowWithRetry runs a constraint validation query which returns
+// at most one row.
+//
+// We are likely to have performed a lot of work before getting here (e.g.
+// importing the data), so we want to make an effort in order to run the
+// validation query without error in order to not fail the whole operation.
+// Thus, we allow up to 5 retries with an exponential backoff for an allowlist
+// of errors.
+//
+// We choose to explicitly perform the retry here rather than propagate the
+// error as "job retryable" and relying on the jobs framework to do the retries
+// in order to not waste (a lot of) work that was performed before we got here.
+func queryValidationRowWithRetry(
+	ctx context.Context,
+	txn isql.Txn,
+	opName redact.RedactableString,
+	sessionDataOverride sessiondata.InternalExecutorOverride,
+	query string,
+) (values tree.Datums, err error) {
 	retryOptions := retry.Options{
 		InitialBackoff: 20 * time.Millisecond,
 		Multiplier:     1.5,
 		MaxRetries:     5,
 	}
 	for r := retry.StartWithCtx(ctx, retryOptions); r.Next(); {
-		values, err = txn.QueryRowEx(ctx, "validate unique constraint", txn.KV(), sessionDataOverride, query)
+		values, err = txn.QueryRowEx(ctx, opName, txn.KV(), sessionDataOverride, query)
 		if err == nil {
 			break
 		}
@@ -675,28 +723,169 @@ func validateUniqueConstraint(
 			log.Dev.Infof(ctx, "retrying the validation query because of %v", err)
 			continue
 		}
+		return nil, err
+	}
+	return values, err
+}
+
+// validateUniqueWithoutIndexConstraint verifies that all the rows in the
+// srcTable satisfy the given UNIQUE WITHOUT INDEX or exclusion constraint. See
+// validateUniqueConstraint for a description of the other arguments.
+func validateUniqueWithoutIndexConstraint(
+	ctx context.Context,
+	srcTable catalog.TableDescriptor,
+	uc catalog.UniqueWithoutIndexConstraint,
+	indexIDForValidation descpb.IndexID,
+	txn isql.Txn,
+	user username.SQLUsername,
+	preExisting bool,
+) error {
+	if uc.IsExclusion() {
+		return validateExclusionConstraint(
+			ctx, srcTable, uc, indexIDForValidation, txn, user, preExisting,
+		)
+	}
+	return validateUniqueConstraint(
+		ctx,
+		srcTable,
+		uc.GetName(),
+		uc.CollectKeyColumnIDs().Ordered(),
+		uc.GetPredicate(),
+		indexIDForValidation,
+		txn,
+		user,
+		preExisting,
+	)
+}
+
+// conflictingRowQuery generates and returns a SELECT query that returns a pair
+// of distinct rows which conflict according to the given exclusion constraint.
+// The query is of the form:
+//
+// SELECT s.a, s.b, t.a, t.b
+// FROM (SELECT a, b, k FROM tbl WHERE a IS NOT NULL AND b IS NOT NULL AND (pred)) AS s
+// INNER JOIN (SELECT a, b, k FROM tbl WHERE a IS NOT NULL AND b IS NOT NULL AND (pred)) AS t
+// ON s.a = t.a AND s.b && t.b AND (s.k) != (t.k)
+// LIMIT 1
+//
+// where k are the primary key columns of the table, which are used to avoid
+// comparing a row with itself. If indexIDForValidation is non-zero, it is used
+// to hint the scans, and its key columns are used instead of the primary key
+// of srcTbl.
+func conflictingRowQuery(
+	srcTbl catalog.TableDescriptor,
+	uc catalog.UniqueWithoutIndexConstraint,
+	indexIDForValidation descpb.IndexID,
+) (sql string, colNames []string, _ error) {
+	desc := uc.UniqueWithoutIndexDesc()
+	colNames, err := catalog.ColumnNamesForIDs(srcTbl, desc.ColumnIDs)
+	if err != nil {
+		return "", nil, err
+	}
+	pkIndex := srcTbl.GetPrimaryIndex()
+	if indexIDForValidation != 0 {
+		if pkIndex, err = catalog.MustFindIndexByID(srcTbl, indexIDForValidation); err != nil {
+			return "", nil, err
+		}
+	}
+	pkNames, err := catalog.ColumnNamesForIDs(srcTbl, pkIndex.IndexDesc().KeyColumnIDs)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var srcCols, srcWhere []string
+	var sCols, tCols, sKey, tKey, on []string
+	for i, n := range colNames {
+		col := tree.NameString(n)
+		srcCols = append(srcCols, col)
+		srcWhere = append(srcWhere, fmt.Sprintf("%s IS NOT NULL", col))
+		sCols = append(sCols, "s."+col)
+		tCols = append(tCols, "t."+col)
+		on = append(on, fmt.Sprintf("s.%[1]s %[2]s t.%[1]s", col, desc.ExclusionOperators[i]))
+	}
+	for _, n := range pkNames {
+		col := tree.NameString(n)
+		srcCols = append(srcCols, col)
+		sKey = append(sKey, "s."+col)
+		tKey = append(tKey, "t."+col)
+	}
+	on = append(on, fmt.Sprintf("(%s) != (%s)", strings.Join(sKey, ", "), strings.Join(tKey, ", ")))
+	if pred := uc.GetPredicate(); pred != "" {
+		srcWhere = append(srcWhere, fmt.Sprintf("(%s)", pred))
+	}
+
+	from := fmt.Sprintf("[%d AS tbl]", srcTbl.GetID())
+	if indexIDForValidation != 0 {
+		from = fmt.Sprintf("[%d AS tbl]@[%d]", srcTbl.GetID(), indexIDForValidation)
+	}
+	src := fmt.Sprintf("SELECT %s FROM %s WHERE %s",
+		strings.Join(srcCols, ", "), from, strings.Join(srcWhere, " AND "),
+	)
+	query := fmt.Sprintf(
+		`SELECT %[1]s, %[2]s FROM (%[3]s) AS s INNER JOIN (%[3]s) AS t ON %[4]s LIMIT 1`,
+		strings.Join(sCols, ", "), // 1
+		strings.Join(tCols, ", "), // 2
+		src,                       // 3
+		strings.Join(on, " AND "), // 4
+	)
+	return query, colNames, nil
+}
+
+// validateExclusionConstraint verifies that no two rows in the srcTable
+// conflict according to the given exclusion constraint. See
+// validateUniqueConstraint for a description of the other arguments.
+func validateExclusionConstraint(
+	ctx context.Context,
+	srcTable catalog.TableDescriptor,
+	uc catalog.UniqueWithoutIndexConstraint,
+	indexIDForValidation descpb.IndexID,
+	txn isql.Txn,
+	user username.SQLUsername,
+	preExisting bool,
+) error {
+	query, colNames, err := conflictingRowQuery(srcTable, uc, indexIDForValidation)
+	if err != nil {
+		return err
+	}
+
+	log.Dev.Infof(ctx, "validating exclusion constraint %q (%q [%v]) with query %q",
+		uc.GetName(),
+		srcTable.GetName(),
+		colNames,
+		query,
+	)
+
+	sessionDataOverride := sessiondata.NoSessionDataOverride
+	sessionDataOverride.User = user
+	values, err := queryValidationRowWithRetry(
+		ctx, txn, "validate exclusion constraint", sessionDataOverride, query,
+	)
+	if err != nil {
 		return err
 	}
 	if values.Len() > 0 {
+		n := len(colNames)
 		valuesStr := make([]string, len(values))
 		for i := range values {
 			valuesStr[i] = values[i].String()
 		}
 		// Note: this error message mirrors the message produced by Postgres
-		// when it fails to add a unique index due to duplicated keys.
-		errMsg := "could not create unique constraint"
+		// when it fails to add an exclusion constraint due to conflicting rows.
+		errMsg := "could not create exclusion constraint"
 		if preExisting {
-			errMsg = "failed to validate unique constraint"
+			errMsg = "failed to validate exclusion constraint"
 		}
+		cols := strings.Join(colNames, ", ")
 		return errors.WithDetail(
 			pgerror.WithConstraintName(
 				pgerror.Newf(
-					pgcode.UniqueViolation, "%s %q", errMsg, constraintName,
+					pgcode.ExclusionViolation, "%s %q", errMsg, uc.GetName(),
 				),
-				constraintName,
+				uc.GetName(),
 			),
 			fmt.Sprintf(
-				"Key (%s)=(%s) is duplicated.", strings.Join(colNames, ","), strings.Join(valuesStr, ","),
+				"Key (%s)=(%s) conflicts with key (%s)=(%s).",
+				cols, strings.Join(valuesStr[:n], ", "), cols, strings.Join(valuesStr[n:], ", "),
 			),
 		)
 	}
@@ -341,6 +341,7 @@ func canWrap(mode sessiondatapb.VectorizeExecMode, core *execinfrapb.ProcessorCo
 	case core.VectorMutationSearch != nil:
 	case core.CompactBackups != nil:
 		return errCoreNotWorthWrapping
+	case core.ForeignScan != nil:
 	default:
 		err := errors.AssertionFailedf("unexpected processor core %q", core)
 		if buildutil.CrdbTestBuild {
@@ -899,6 +900,11 @@ func NewColOperator(
 					if flowCtx.TraceKV {
 						return false
 					}
+					// Sampling the rows is only supported by the
+					// ColBatchScan.
+					if core.TableReader.SampleRows {
+						return false
+					}
 					// The current implementation of non-default locking
 					// strength as well as of SKIP LOCKED wait policy require
 					// being able to access to the full keys after the
@@ -1046,6 +1052,19 @@ func NewColOperator(
 				break
 			}
 
+			input, inputTypes := inputs[0].Root, spec.Input[0].ColumnTypes
+			if len(aggSpec.GroupingSets) > 0 {
+				// Every input tuple is aggregated once for each grouping set,
+				// extended with the virtual grouping set columns. The
+				// aggregations might be modified to account for the empty
+				// grouping sets.
+				expandedSpec := *aggSpec
+				input, inputTypes, expandedSpec.Aggregations = colexec.NewGroupingSetsExpander(
+					getStreamingAllocator(ctx, args, flowCtx), input, inputTypes, aggSpec,
+				)
+				aggSpec = &expandedSpec
+			}
+
 			var needHash bool
 			needHash, err = execagg.NeedHashAggregator(aggSpec)
 			if err != nil {
@@ -1054,14 +1073,14 @@ func NewColOperator(
 			// Make a copy of the evalCtx since we're modifying it below.
 			evalCtx := flowCtx.NewEvalCtx()
 			newAggArgs := &colexecagg.NewAggregatorArgs{
-				Input:             inputs[0].Root,
-				InputTypes:        spec.Input[0].ColumnTypes,
+				Input:             input,
+				InputTypes:        inputTypes,
 				Spec:              aggSpec,
 				EvalCtx:           evalCtx,
 				EstimatedRowCount: args.Spec.EstimatedRowCount,
 			}
 			newAggArgs.Constructors, newAggArgs.ConstArguments, newAggArgs.OutputTypes, err = colexecagg.ProcessAggregations(
-				ctx, evalCtx, args.SemaCtx, aggSpec.Aggregations, spec.Input[0].ColumnTypes,
+				ctx, evalCtx, args.SemaCtx, aggSpec.Aggregations, inputTypes,
 			)
 			if err != nil {
 				return r, err
@@ -1104,7 +1123,7 @@ func NewColOperator(
 					newHashAggArgs, sqArgs, hashAggregatorMemMonitorName := makeNewHashAggregatorArgs(
 						ctx, flowCtx, args, opName, newAggArgs, factory,
 					)
-					sqArgs.Types = spec.Input[0].ColumnTypes
+					sqArgs.Types = inputTypes
 					inMemoryHashAggregator := colexec.NewHashAggregator(
 						ctx, newHashAggArgs, sqArgs,
 					)
@@ -1121,7 +1140,7 @@ func NewColOperator(
 					// error even when used by the external hash aggregator).
 					evalCtx.SingleDatumAggMemAccount = ehaMemAccount
 					diskSpiller := colexecdisk.NewOneInputDiskSpiller(
-						inputs[0].Root, inMemoryHashAggregator.(colexecop.BufferingInMemoryOperator),
+						input, inMemoryHashAggregator.(colexecop.BufferingInMemoryOperator),
 						hashAggregatorMemMonitorName,
 						func(input colexecop.Operator) colexecop.Operator {
 							newAggArgs := *newAggArgs
@@ -0,0 +1,212 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package colexec
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/col/coldata"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecop"
+	"github.com/cockroachdb/cockroach/pkg/sql/colmem"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfrapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+)
+
+// groupingSetsExpanderOp is an operator that returns each input batch once for
+// each of the grouping sets of an aggregator, extended with the virtual
+// grouping set columns described in execinfrapb.AggregatorSpec. The input
+// columns themselves are not modified.
+//
+// If there are empty grouping sets, the aggregator must output a row for each
+// of them even if the input is empty. To that end, the batches are further
+// extended with boolean filter columns, which are used by the aggregations
+// that are not over virtual grouping set columns only. If the input is empty,
+// the operator returns a single batch with a row for each empty grouping set
+// for which all the filters are false.
+type groupingSetsExpanderOp struct {
+	colexecop.OneInputHelper
+
+	allocator  *colmem.Allocator
+	expansions []execinfrapb.GroupingSetExpansion
+	// outputTypes are the types of the input columns followed by the types of
+	// the virtual columns.
+	outputTypes  []*types.T
+	numInputCols int
+	// filterSrcCols has one element for each of the virtual filter columns:
+	// the input column with the original filter of the aggregations, or -1 if
+	// they have no filter.
+	filterSrcCols  []int
+	numEmptySets   int
+	virtualVecs    []*coldata.Vec
+	batch          coldata.Batch
+	nextExpansion  int
+	seenInputBatch bool
+	done           bool
+}
+
+var _ colexecop.Operator = &groupingSetsExpanderOp{}
+
+// NewGroupingSetsExpander returns an operator that expands its input for the
+// grouping sets of the given aggregator spec. It also returns the types of
+// the expanded batches, and the aggregations of the spec which must be
+// computed over them instead of spec.Aggregations.
+func NewGroupingSetsExpander(
+	allocator *colmem.Allocator,
+	input colexecop.Operator,
+	inputTypes []*types.T,
+	spec *execinfrapb.AggregatorSpec,
+) (colexecop.Operator, []*types.T, []execinfrapb.AggregatorSpec_Aggregation) {
+	outputTypes := make([]*types.T, len(inputTypes), len(inputTypes)+spec.NumGroupingSetsCols())
+	copy(outputTypes, inputTypes)
+	for i := 0; i < 1+len(spec.GroupingFuncs); i++ {
+		outputTypes = append(outputTypes, types.Int)
+	}
+	for _, c := range spec.GroupingSetsCols() {
+		outputTypes = append(outputTypes, inputTypes[c])
+	}
+	expansions := spec.MakeGroupingSetExpansions()
+	numEmptySets := 0
+	for i := range expansions {
+		if expansions[i].Empty {
+			numEmptySets++
+		}
+	}
+	aggregations := spec.Aggregations
+	var filterSrcCols []int
+	if numEmptySets > 0 {
+		firstFilterCol := len(outputTypes)
+		aggregations = make([]execinfrapb.AggregatorSpec_Aggregation, len(spec.Aggregations))
+		copy(aggregations, spec.Aggregations)
+		for i := range aggregations {
+			agg := &aggregations[i]
+			if spec.IsGroupingSetsColsAggregation(agg, len(inputTypes)) {
+				continue
+			}
+			srcCol := -1
+			if agg.FilterColIdx != nil {
+				srcCol = int(*agg.FilterColIdx)
+			}
+			idx := 0
+			for idx < len(filterSrcCols) && filterSrcCols[idx] != srcCol {
+				idx++
+			}
+			if idx == len(filterSrcCols) {
+				filterSrcCols = append(filterSrcCols, srcCol)
+				outputTypes = append(outputTypes, types.Bool)
+			}
+			filterCol := uint32(firstFilterCol + idx)
+			agg.FilterColIdx = &filterCol
+		}
+	}
+	return &groupingSetsExpanderOp{
+		OneInputHelper: colexecop.MakeOneInputHelper(input),
+		allocator:      allocator,
+		expansions:     expansions,
+		outputTypes:    outputTypes,
+		numInputCols:   len(inputTypes),
+		filterSrcCols:  filterSrcCols,
+		numEmptySets:   numEmptySets,
+		virtualVecs:    make([]*coldata.Vec, len(outputTypes)-len(inputTypes)),
+	}, outputTypes, aggregations
+}
+
+func (e *groupingSetsExpanderOp) Next() coldata.Batch {
+	if e.done {
+		return coldata.ZeroBatch
+	}
+	if e.batch == nil || e.nextExpansion == len(e.expansions) {
+		e.batch = e.Input.Next()
+		if e.batch.Length() == 0 {
+			e.done = true
+			if !e.seenInputBatch && e.numEmptySets > 0 {
+				return e.emptyGroupingSetsBatch()
+			}
+			return e.batch
+		}
+		e.seenInputBatch = true
+		e.nextExpansion = 0
+		for i := e.numInputCols; i < len(e.outputTypes); i++ {
+			e.allocator.MaybeAppendColumn(e.batch, e.outputTypes[i], i)
+			e.virtualVecs[i-e.numInputCols] = e.batch.ColVec(i)
+		}
+	}
+	expansion := &e.expansions[e.nextExpansion]
+	e.nextExpansion++
+
+	// Selection vectors are increasing, so setting the virtual columns for
+	// all tuples up to the last selected one covers all selected tuples.
+	n := e.batch.Length()
+	if sel := e.batch.Selection(); sel != nil {
+		n = sel[n-1] + 1
+	}
+	e.allocator.PerformOperation(e.virtualVecs, func() {
+		for i, v := range expansion.Values {
+			col := e.virtualVecs[i].Int64()
+			for j := 0; j < n; j++ {
+				col[j] = v
+			}
+		}
+		groupingVecs := e.virtualVecs[len(expansion.Values) : len(expansion.Values)+len(expansion.Cols)]
+		for i, c := range expansion.Cols {
+			if c < 0 {
+				groupingVecs[i].Nulls().SetNullRange(0, n)
+				continue
+			}
+			groupingVecs[i].Copy(coldata.SliceArgs{
+				Src:       e.batch.ColVec(c),
+				SrcEndIdx: n,
+			})
+		}
+		filterVecs := e.virtualVecs[len(expansion.Values)+len(expansion.Cols):]
+		for i, c := range e.filterSrcCols {
+			if c < 0 {
+				col := filterVecs[i].Bool()
+				for j := 0; j < n; j++ {
+					col[j] = true
+				}
+				continue
+			}
+			filterVecs[i].Copy(coldata.SliceArgs{
+				Src:       e.batch.ColVec(c),
+				SrcEndIdx: n,
+			})
+		}
+	})
+	return e.batch
+}
+
+// emptyGroupingSetsBatch returns the batch with a row for each of the empty
+// grouping sets which is returned when the input is empty. The input columns
+// and the virtual grouping columns are NULL, and the filters are false.
+func (e *groupingSetsExpanderOp) emptyGroupingSetsBatch() coldata.Batch {
+	batch := e.allocator.NewMemBatchWithFixedCapacity(e.outputTypes, e.numEmptySets)
+	e.allocator.PerformOperation(batch.ColVecs(), func() {
+		for i := 0; i < e.numInputCols; i++ {
+			batch.ColVec(i).Nulls().SetNullRange(0, e.numEmptySets)
+		}
+		row := 0
+		for k := range e.expansions {
+			expansion := &e.expansions[k]
+			if !expansion.Empty {
+				continue
+			}
+			colIdx := e.numInputCols
+			for _, v := range expansion.Values {
+				batch.ColVec(colIdx).Int64()[row] = v
+				colIdx++
+			}
+			for range expansion.Cols {
+				batch.ColVec(colIdx).Nulls().SetNull(row)
+				colIdx++
+			}
+			for range e.filterSrcCols {
+				batch.ColVec(colIdx).Bool()[row] = false
+				colIdx++
+			}
+			row++
+		}
+	})
+	batch.SetLength(e.numEmptySets)
+	return batch
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package colexec
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/colexec/colexectestutils"
+	"github.com/cockroachdb/cockroach/pkg/sql/colexecop"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfrapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupingSetsExpander(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	// GROUP BY ROLLUP (@1, @2) with GROUPING(@2, @1). The virtual columns are
+	// the grouping level, the GROUPING() value and the copies of @1 and @2.
+	spec := &execinfrapb.AggregatorSpec{
+		GroupingSets: []execinfrapb.AggregatorSpec_ColumnSet{
+			{Cols: []uint32{0, 1}},
+			{Cols: []uint32{0}},
+			{},
+		},
+		GroupingFuncs: []execinfrapb.AggregatorSpec_ColumnSet{
+			{Cols: []uint32{1, 0}},
+		},
+	}
+	typs := []*types.T{types.Int, types.String, types.Int}
+	tuples := colexectestutils.Tuples{
+		{1, "a", 10},
+		{2, "b", 20},
+	}
+	expected := colexectestutils.Tuples{
+		{1, "a", 10, 0, 0, 1, "a"},
+		{2, "b", 20, 0, 0, 2, "b"},
+		{1, "a", 10, 1, 2, 1, nil},
+		{2, "b", 20, 1, 2, 2, nil},
+		{1, "a", 10, 2, 3, nil, nil},
+		{2, "b", 20, 2, 3, nil, nil},
+	}
+	colexectestutils.RunTestsWithTyps(
+		t, testAllocator, []colexectestutils.Tuples{tuples}, [][]*types.T{typs}, expected,
+		colexectestutils.UnorderedVerifier,
+		func(input []colexecop.Operator) (colexecop.Operator, error) {
+			op, _ := NewGroupingSetsExpander(testAllocator, input[0], typs, spec)
+			return op, nil
+		},
+	)
+}
+
+func TestGroupingSetsExpanderEmptyInput(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	// SELECT GROUPING(@1), count(*) GROUP BY ROLLUP (@1). The virtual columns
+	// are the grouping level, the GROUPING() value, the copy of @1 and the
+	// filter of count(*), which excludes the row of the empty grouping set.
+	spec := &execinfrapb.AggregatorSpec{
+		GroupingSets: []execinfrapb.AggregatorSpec_ColumnSet{
+			{Cols: []uint32{0}},
+			{},
+		},
+		GroupingFuncs: []execinfrapb.AggregatorSpec_ColumnSet{
+			{Cols: []uint32{0}},
+		},
+		Aggregations: []execinfrapb.AggregatorSpec_Aggregation{
+			{Func: execinfrapb.AnyNotNull, ColIdx: []uint32{3}},
+			{Func: execinfrapb.CountRows},
+		},
+	}
+	typs := []*types.T{types.Int, types.Int}
+	_, _, aggregations := NewGroupingSetsExpander(testAllocator, nil /* input */, typs, spec)
+	require.Nil(t, aggregations[0].FilterColIdx)
+	require.NotNil(t, aggregations[1].FilterColIdx)
+	require.Equal(t, uint32(5), *aggregations[1].FilterColIdx)
+
+	colexectestutils.RunTestsWithTyps(
+		t, testAllocator, []colexectestutils.Tuples{{}}, [][]*types.T{typs},
+		colexectestutils.Tuples{{nil, nil, 1, 1, nil, false}},
+		colexectestutils.UnorderedVerifier,
+		func(input []colexecop.Operator) (colexecop.Operator, error) {
+			op, _, _ := NewGroupingSetsExpander(testAllocator, input[0], typs, spec)
+			return op, nil
+		},
+	)
+}
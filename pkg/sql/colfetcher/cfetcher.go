@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/colexecerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/colmem"
 	"github.com/cockroachdb/cockroach/pkg/sql/execinfra/execreleasable"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfrapb"
 	"github.com/cockroachdb/cockroach/pkg/sql/row"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowenc/keyside"
 	"github.com/cockroachdb/cockroach/pkg/sql/rowinfra"
@@ -271,6 +272,11 @@ type cFetcher struct {
 	// stableKVs indicates whether the KVs returned by nextKVer are stable (i.e.
 	// are not invalidated) across NextKV() calls.
 	stableKVs bool
+	// sampler, if set, decides whether each row is included in a sample (see
+	// TableReaderSpec.SampleRows). sampled records the decisions for the rows of
+	// the current batch.
+	sampler *execinfrapb.RowSampler
+	sampled []bool
 	// bytesRead, kvPairsRead, and batchRequestsIssued store the total number of
 	// bytes read, key-values pairs read, and of BatchRequests issued,
 	// respectively, by this cFetcher throughout its lifetime in case when the
@@ -1043,6 +1049,9 @@ func (cf *cFetcher) NextBatch(ctx context.Context) (coldata.Batch, error) {
 			// memory accounting - oids are fixed length values and, thus, have
 			// already been accounted for when the batch was allocated.
 			emitBatch := cf.accountingHelper.AccountForSet(cf.machine.rowIdx)
+			if cf.sampler != nil {
+				cf.sampled = append(cf.sampled[:cf.machine.rowIdx], cf.sampler.SampleRow(cf.machine.lastRowPrefix))
+			}
 			cf.machine.rowIdx++
 			cf.shiftState()
 
@@ -1506,7 +1515,7 @@ func (cf *cFetcher) Release() {
 	}
 	colvecs := cf.machine.colvecs
 	colvecs.Reset()
-	*cf = cFetcher{scratch: cf.scratch}
+	*cf = cFetcher{scratch: cf.scratch, sampled: cf.sampled[:0]}
 	cf.scratch.decoding = cf.scratch.decoding[:0]
 	cf.scratch.nextKVKey = cf.scratch.nextKVKey[:0]
 	cf.scratch.nextKVRawBytes = cf.scratch.nextKVRawBytes[:0]
@@ -43,6 +43,8 @@ type colBatchScanBase struct {
 	batchBytesLimit        rowinfra.BytesLimit
 	parallelize            bool
 	ignoreMisplannedRanges bool
+	// sampler is set if the rows are sampled (see TableReaderSpec.SampleRows).
+	sampler *execinfrapb.RowSampler
 	// tracingSpan is created when the stats should be collected for the query
 	// execution, and it will be finished when closing the operator.
 	tracingSpan *tracing.Span
@@ -194,6 +196,10 @@ func newColBatchScanBase(
 		parallelize:            spec.Parallelize,
 		ignoreMisplannedRanges: flowCtx.Local || spec.IgnoreMisplannedRanges,
 	}
+	if spec.SampleRows {
+		sampler := spec.MakeRowSampler()
+		s.sampler = &sampler
+	}
 	return s, bsHeader, tableArgs, nil
 }
 
@@ -237,17 +243,41 @@ func (s *ColBatchScan) Init(ctx context.Context) {
 
 // Next is part of the colexecop.Operator interface.
 func (s *ColBatchScan) Next() coldata.Batch {
-	bat, err := s.cf.NextBatch(s.Ctx)
-	if err != nil {
-		colexecerror.InternalError(err)
+	for {
+		bat, err := s.cf.NextBatch(s.Ctx)
+		if err != nil {
+			colexecerror.InternalError(err)
+		}
+		if bat.Selection() != nil {
+			colexecerror.InternalError(errors.AssertionFailedf("unexpectedly a selection vector is set on the batch coming from CFetcher"))
+		}
+		s.mu.Lock()
+		s.mu.rowsRead += int64(bat.Length())
+		s.mu.Unlock()
+		if s.sampler == nil || bat.Length() == 0 {
+			return bat
+		}
+		if s.sampleBatch(bat) > 0 {
+			return bat
+		}
 	}
-	if bat.Selection() != nil {
-		colexecerror.InternalError(errors.AssertionFailedf("unexpectedly a selection vector is set on the batch coming from CFetcher"))
+}
+
+// sampleBatch sets a selection vector on the batch that only includes the
+// rows sampled by the cFetcher and returns the number of these rows.
+func (s *ColBatchScan) sampleBatch(bat coldata.Batch) int {
+	n := bat.Length()
+	bat.SetSelection(true)
+	sel := bat.Selection()
+	idx := 0
+	for i := 0; i < n; i++ {
+		if s.cf.sampled[i] {
+			sel[idx] = i
+			idx++
+		}
 	}
-	s.mu.Lock()
-	s.mu.rowsRead += int64(bat.Length())
-	s.mu.Unlock()
-	return bat
+	bat.SetLength(idx)
+	return idx
 }
 
 // DrainMeta is part of the colexecop.MetadataSource interface.
@@ -358,6 +388,7 @@ func NewColBatchScan(
 		fetcher.Release()
 		return nil, nil, err
 	}
+	fetcher.sampler = base.sampler
 	if shouldCollectStats {
 		if flowTxn := flowCtx.EvalCtx.Txn; flowTxn != nil {
 			base.ContentionEventsListener.Init(flowTxn.ID())
@@ -1281,6 +1281,11 @@ func (s *Server) newConnExecutor(
 	}
 
 	ex.extraTxnState.underOuterTxn = underOuterTxn
+	if executorType == executorTypeExec && s.cfg.NotificationRegistry != nil {
+		ex.notifications = newSessionNotifications(s.cfg.NotificationRegistry, func() {
+			_ /* err */ = stmtBuf.Push(ctx, DeliverNotifications{})
+		})
+	}
 	ex.extraTxnState.prepStmtsNamespace.prepStmts.Init(ctx)
 	ex.extraTxnState.prepStmtsNamespace.portals = make(map[string]PreparedPortal)
 	ex.extraTxnState.prepStmtsNamespace.portalsSnapshot = make(map[string]PreparedPortal)
@@ -1376,6 +1381,10 @@ func (ex *connExecutor) close(ctx context.Context, closeType closeType) {
 		log.Dev.Warningf(ctx, "error closing cursors: %v", err)
 	}
 
+	if ex.notifications != nil {
+		ex.notifications.close()
+	}
+
 	// Free any memory used by the stats collector.
 	ex.statsCollector.Close(ctx, ex.planner.extendedEvalCtx.SessionID)
 
@@ -1576,6 +1585,10 @@ type connExecutor struct {
 		// validateDbZoneConfig should the DB zone config on commit.
 		validateDbZoneConfig bool
 
+		// deferredConstraints tracks the DEFERRABLE constraints whose checks
+		// are postponed until commit.
+		deferredConstraints deferredConstraints
+
 		// txnCounter keeps track of how many SQL txns have been open since
 		// the start of the session. This is used for logging, to
 		// distinguish statements that belong to separate SQL transactions.
@@ -1868,6 +1881,10 @@ type connExecutor struct {
 	// responds to user queries or an internal one.
 	executorType executorType
 
+	// notifications contains the LISTEN/NOTIFY state of the session. It is nil
+	// for internal executors, which cannot receive notifications.
+	notifications *sessionNotifications
+
 	// hasCreatedTemporarySchema is set if the executor has created a
 	// temporary schema, which requires special cleanup on close.
 	hasCreatedTemporarySchema bool
@@ -2112,6 +2129,10 @@ func (ex *connExecutor) resetExtraTxnState(ctx context.Context, ev txnEvent, pay
 	ex.extraTxnState.upgradedToSerializable = false
 	ex.extraTxnState.hasAdminRoleCache = HasAdminRoleCache{}
 	ex.extraTxnState.createdSequences = nil
+	ex.extraTxnState.deferredConstraints.reset()
+	if ex.notifications != nil {
+		ex.notifications.finishTxn(ev.eventType == txnCommit)
+	}
 
 	if ex.extraTxnState.skipResettingSchemaObjects {
 		if ex.extraTxnState.shouldResetSyntheticDescriptors {
@@ -2585,6 +2606,11 @@ func (ex *connExecutor) execCmd() (retErr error) {
 		//   was created when the statement started executing (via the
 		//   reset() method).
 		ex.statsCollector.PhaseTimes().SetSessionPhaseTime(sessionphase.SessionQueryServiced, crtime.NowMono())
+	case StartReplication:
+		ex.phaseTimes.SetSessionPhaseTime(sessionphase.SessionQueryReceived, tcmd.TimeReceived)
+		replicationRes := ex.clientComm.CreateStartReplicationResult(tcmd, pos)
+		res = replicationRes
+		ev, payload = ex.execStartReplication(ctx, tcmd, replicationRes)
 	case DrainRequest:
 		// We received a drain request. We terminate immediately if we're not in a
 		// transaction. If we are in a transaction, we'll finish as soon as a Sync
@@ -2598,6 +2624,11 @@ func (ex *connExecutor) execCmd() (retErr error) {
 	case Flush:
 		// Closing the res will flush the connection's buffer.
 		res = ex.clientComm.CreateFlushResult(pos)
+	case DeliverNotifications:
+		// Notifications were queued for the session. Closing the res sends them
+		// and flushes the connection's buffer.
+		res = ex.clientComm.CreateFlushResult(pos)
+		ex.maybeDeliverNotifications(res)
 	default:
 		panic(errors.AssertionFailedf("unsupported command type: %T", cmd))
 	}
@@ -2665,6 +2696,12 @@ func (ex *connExecutor) execCmd() (retErr error) {
 	// we're staying in place or rewinding - the statement will be executed
 	// again.
 	if advInfo.code != stayInPlace && advInfo.code != rewind {
+		if _, ok := cmd.(Sync); ok {
+			// Deliver the notifications received while the transaction was open,
+			// now that the transaction is finished.
+			ex.maybeDeliverNotifications(res)
+		}
+
 		// Close the result. In case of an execution error, the result might have
 		// its error set already or it might not.
 		resErr := res.Err()
@@ -2871,10 +2908,14 @@ func (ex *connExecutor) updateTxnRewindPosMaybe(
 				// Can't advance.
 			case CopyOut:
 				// Can't advance.
+			case StartReplication:
+				// Can't advance.
 			case DrainRequest:
 				canAdvance = true
 			case Flush:
 				canAdvance = true
+			case DeliverNotifications:
+				canAdvance = true
 			default:
 				panic(errors.AssertionFailedf("unsupported cmd: %T", cmd))
 			}
@@ -3934,6 +3975,13 @@ func (ex *connExecutor) resetEvalCtx(evalCtx *extendedEvalContext, txn *kv.Txn,
 	evalCtx.SkipNormalize = false
 	evalCtx.SchemaChangerState = ex.extraTxnState.schemaChangerState
 	evalCtx.DescIDGenerator = ex.getDescIDGenerator()
+	// An executor running under an outer txn does not commit it, so it cannot
+	// defer constraint checks.
+	evalCtx.deferredConstraints = nil
+	if !ex.extraTxnState.underOuterTxn {
+		evalCtx.deferredConstraints = &ex.extraTxnState.deferredConstraints
+	}
+	evalCtx.notifications = ex.notifications
 
 	// See resetPlanner for more context on setting the maximum timestamp for
 	// AOST read retries.
@@ -4825,6 +4873,8 @@ func (sc *StatementCounters) incrementCount(ex *connExecutor, stmt tree.Statemen
 	case *tree.Delete:
 		sc.DeleteCount.Inc(dbName, appName)
 		sc.CRUDQueryCount.Inc(dbName, appName)
+	case *tree.Merge:
+		sc.CRUDQueryCount.Inc(dbName, appName)
 	case *tree.CommitTransaction:
 		sc.TxnCommitCount.Inc(dbName, appName)
 	case *tree.RollbackTransaction:
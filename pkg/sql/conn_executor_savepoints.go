@@ -101,6 +101,8 @@ func (ex *connExecutor) execSavepointInOpenState(
 		commitOnRelease: commitOnRelease,
 		kvToken:         token,
 		numDDL:          ex.extraTxnState.numDDL,
+
+		deferredConstraints: ex.extraTxnState.deferredConstraints.snapshot(),
 	}
 	savepoints.push(sp)
 	ex.sessionDataStack.PushTopClone()
@@ -215,6 +217,7 @@ func (ex *connExecutor) execRollbackToSavepointInOpenState(
 	if err := ex.popSavepointsToIdx(s, idx); err != nil {
 		return ex.makeErrEvent(err, s)
 	}
+	ex.extraTxnState.deferredConstraints.restore(entry.deferredConstraints)
 
 	if entry.kvToken.Initial() {
 		return eventTxnRestart{}, nil
@@ -292,6 +295,7 @@ func (ex *connExecutor) execRollbackToSavepointInAbortedState(
 	if err := ex.popSavepointsToIdx(s, idx); err != nil {
 		return ex.makeErrEvent(err, s)
 	}
+	ex.extraTxnState.deferredConstraints.restore(entry.deferredConstraints)
 
 	if err := ex.state.mu.txn.RollbackToSavepoint(ctx, entry.kvToken); err != nil {
 		return ex.makeErrEvent(err, s)
@@ -360,6 +364,11 @@ type savepoint struct {
 	// more DDL statements were executed since the savepoint's creation.
 	// TODO(knz): support partial DDL cancellation in pending txns.
 	numDDL int
+
+	// deferredConstraints is the state of the DEFERRABLE constraints of the
+	// transaction (see SET CONSTRAINTS) at the time the savepoint was created.
+	// It is restored when rolling back to the savepoint.
+	deferredConstraints deferredConstraintsState
 }
 
 type savepointStack []savepoint
@@ -14,7 +14,9 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/col/coldata"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/notify"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/pgrepltree"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgwirebase"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
@@ -434,6 +436,46 @@ func (c CopyOut) String() string {
 
 var _ Command = CopyOut{}
 
+// StartReplication is the command for execution of the START_REPLICATION
+// command of the streaming replication protocol. Like CopyIn, it takes
+// control of the connection: the changes are streamed to the client in a
+// CopyBoth stream, and the client's progress reports are read from the
+// connection until the client ends the stream.
+type StartReplication struct {
+	ParsedStmt statements.Statement[tree.Statement]
+	Stmt       *pgrepltree.StartReplication
+	// Conn is the network connection. Execution of the command takes control
+	// of the connection.
+	Conn pgwirebase.Conn
+	// ReplicationDone is used to signal that control of the connection is
+	// being handed back to the network routine.
+	ReplicationDone struct {
+		// WaitGroup is decremented once execution finishes.
+		*sync.WaitGroup
+		// Once is used to decrement the WaitGroup exactly once.
+		*sync.Once
+	}
+	// TimeReceived is the time at which the message was received
+	// from the client. Used to compute the service latency.
+	TimeReceived crtime.Mono
+}
+
+// command implements the Command interface.
+func (StartReplication) command() string { return "start replication" }
+
+// isExtendedProtocolCmd implements the Command interface.
+func (StartReplication) isExtendedProtocolCmd() bool { return false }
+
+func (s StartReplication) String() string {
+	str := "(empty)"
+	if s.Stmt != nil {
+		str = s.Stmt.String()
+	}
+	return fmt.Sprintf("StartReplication: %s", str)
+}
+
+var _ Command = StartReplication{}
+
 // DrainRequest represents a notice that the server is draining and command
 // processing should stop soon.
 //
@@ -452,6 +494,26 @@ func (DrainRequest) String() string {
 
 var _ Command = DrainRequest{}
 
+// DeliverNotifications represents a notice that asynchronous notifications
+// are pending for the session, which should deliver them to the client if it
+// is idle.
+//
+// DeliverNotifications commands don't produce results other than the
+// notifications themselves.
+type DeliverNotifications struct{}
+
+// command implements the Command interface.
+func (DeliverNotifications) command() string { return "deliver notifications" }
+
+// isExtendedProtocolCmd implements the Command interface.
+func (DeliverNotifications) isExtendedProtocolCmd() bool { return false }
+
+func (DeliverNotifications) String() string {
+	return "DeliverNotifications"
+}
+
+var _ Command = DeliverNotifications{}
+
 // SendError is a command that, upon execution, send a specific error to the
 // client. This is used by pgwire to schedule errors to be sent at an
 // appropriate time.
@@ -777,6 +839,9 @@ type ClientComm interface {
 	CreateCopyInResult(cmd CopyIn, pos CmdPos) CopyInResult
 	// CreateCopyOutResult creates a result for a Copy-out command.
 	CreateCopyOutResult(cmd CopyOut, pos CmdPos) CopyOutResult
+	// CreateStartReplicationResult creates a result for a StartReplication
+	// command.
+	CreateStartReplicationResult(cmd StartReplication, pos CmdPos) StartReplicationResult
 	// CreateDrainResult creates a result for a Drain command.
 	CreateDrainResult(pos CmdPos) DrainResult
 
@@ -990,6 +1055,18 @@ type DrainResult interface {
 	ResultBase
 }
 
+// NotificationSender is implemented by the results that can deliver
+// asynchronous notifications, sent with NOTIFY, to the client.
+type NotificationSender interface {
+	// BufferNotification appends a notification to the result.
+	// This gets flushed only when the result is closed.
+	BufferNotification(n notify.Notification)
+
+	// BufferNotice appends a notice to the result. It is used to tell the
+	// client about notifications that could not be delivered.
+	BufferNotice(notice pgnotice.Notice)
+}
+
 // EmptyQueryResult represents the result of an empty query (a query
 // representing a blank string).
 type EmptyQueryResult interface {
@@ -1022,6 +1099,26 @@ type CopyOutResult interface {
 	SendCopyDone(ctx context.Context) error
 }
 
+// StartReplicationResult represents the result of a StartReplication command.
+// Closing this result sends a CommandComplete message to the client, which is
+// only valid once the CopyBoth stream has been ended with SendCopyDone.
+type StartReplicationResult interface {
+	ResultBase
+
+	// SendCopyBoth starts the CopyBoth stream and flushes it to the client.
+	SendCopyBoth(ctx context.Context) error
+
+	// SendCopyData adds a CopyData message to the result. isHeader must be
+	// false.
+	SendCopyData(ctx context.Context, copyData []byte, isHeader bool) error
+
+	// FlushCopyData delivers the CopyData messages added so far to the client.
+	FlushCopyData(ctx context.Context) error
+
+	// SendCopyDone ends the server's side of the CopyBoth stream.
+	SendCopyDone(ctx context.Context) error
+}
+
 // ClientLock is an interface returned by ClientComm.lockCommunication(). It
 // represents a lock on the delivery of results to a SQL client. While such a
 // lock is used, no more results are delivered. The lock itself can be used to
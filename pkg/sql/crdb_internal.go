@@ -60,6 +60,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/clusterunique"
 	"github.com/cockroachdb/cockroach/pkg/sql/contentionpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/hintpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/idxusage"
 	"github.com/cockroachdb/cockroach/pkg/sql/isql"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
@@ -232,6 +233,7 @@ var crdbInternal = virtualSchema{
 		catconstants.CrdbInternalStoreLivenessSupportFrom:           crdbInternalStoreLivenessSupportFromTable,
 		catconstants.CrdbInternalStoreLivenessSupportFor:            crdbInternalStoreLivenessSupportForTable,
 		catconstants.CrdbInternalClusterInspectErrorsViewID:         crdbInternalClusterInspectErrorsView,
+		catconstants.CrdbInternalPlanBaselinesTableID:               crdbInternalPlanBaselinesTable,
 	},
 	validWithNoDatabaseContext: true,
 }
@@ -3162,7 +3164,7 @@ func writeCreateTypeDescRow(
 			typeList[i].Label = tree.Name(c.GetElementLabel(i))
 		}
 		typeVariety = tree.Composite
-	} else {
+	} else if typeDesc.AsDomainTypeDescriptor() == nil {
 		return false, errors.AssertionFailedf("unknown type descriptor kind %s", typeDesc.GetKind())
 	}
 
@@ -3170,12 +3172,17 @@ func writeCreateTypeDescRow(
 	if err != nil {
 		return false, err
 	}
-	node := &tree.CreateType{
+	var node tree.Statement = &tree.CreateType{
 		Variety:           typeVariety,
 		TypeName:          name,
 		CompositeTypeList: typeList,
 		EnumLabels:        enumLabels,
 	}
+	if d := typeDesc.AsDomainTypeDescriptor(); d != nil {
+		if node, err = makeCreateDomainNode(name, d); err != nil {
+			return false, err
+		}
+	}
 
 	createStatement := tree.AsString(node)
 
@@ -3192,7 +3199,7 @@ func writeCreateTypeDescRow(
 		tree.NewDInt(tree.DInt(typeDesc.GetID())), // descriptor_id
 		tree.NewDString(typeDesc.GetName()),       // descriptor_name
 		tree.NewDString(createStatement),          // create_statement
-		enumLabelsDatum,                           // empty for composite types
+		enumLabelsDatum,                           // empty for composite and domain types
 	)
 }
 
@@ -3360,6 +3367,10 @@ func createRoutinePopulate(
 				// otherwise.
 				continue
 			}
+			if fnDesc.IsAggregate() {
+				// User-defined aggregates have no CREATE FUNCTION representation.
+				continue
+			}
 			treeNode, err := fnDesc.ToCreateExpr()
 			treeNode.Name.ObjectNamePrefix = tree.ObjectNamePrefix{
 				ExplicitSchema: true,
@@ -3433,6 +3444,9 @@ func createRoutinePopulateByFnIndex(
 		}
 		return false, err
 	}
+	if fnDesc.IsAggregate() {
+		return false, nil
+	}
 	scID := fnDesc.GetParentSchemaID()
 	sc, err := descs.GetCatalogDescriptorGetter(p.Descriptors(), p.txn, &p.EvalContext().Settings.SV).WithoutNonPublic().Get().Schema(ctx, scID)
 	if err != nil || sc == nil {
@@ -9699,3 +9713,32 @@ CREATE VIEW crdb_internal.cluster_inspect_errors AS
 	},
 	comment: `wrapper over system.inspect_errors`,
 }
+
+var crdbInternalPlanBaselinesTable = virtualSchemaTable{
+	comment: `plan baselines of statement fingerprints (reads system.statement_hints)`,
+	schema: `
+CREATE TABLE crdb_internal.plan_baselines (
+  hint_id        INT NOT NULL,
+  fingerprint    STRING NOT NULL,
+  plan_gist      STRING NOT NULL,
+  accepted       BOOL NOT NULL,
+  estimated_cost FLOAT NOT NULL,
+  sample_sql     STRING NOT NULL,
+  created_at     TIMESTAMPTZ NOT NULL
+);`,
+	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		return forEachPlanBaseline(ctx, p, nil /* fingerprint */, func(
+			hintID, fingerprint tree.Datum, baseline *hintpb.PlanBaseline, createdAt tree.Datum,
+		) error {
+			return addRow(
+				hintID,
+				fingerprint,
+				tree.NewDString(baseline.PlanGist),
+				tree.MakeDBool(tree.DBool(baseline.Accepted)),
+				tree.NewDFloat(tree.DFloat(baseline.EstimatedCost)),
+				tree.NewDString(baseline.SampleSQL),
+				createdAt,
+			)
+		})
+	},
+}
@@ -0,0 +1,461 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catprivilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/funcdesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/errors"
+)
+
+type createAggregateNode struct {
+	zeroInputPlanNode
+	n *tree.CreateAggregate
+
+	dbDesc catalog.DatabaseDescriptor
+	scDesc catalog.SchemaDescriptor
+}
+
+// aggregateSupportFunc is a resolved component function of a user-defined
+// aggregate.
+type aggregateSupportFunc struct {
+	name *tree.RoutineName
+	ol   *tree.Overload
+}
+
+// CreateAggregate creates a user-defined aggregate function.
+// Privileges: CREATE on the schema, EXECUTE on the component functions.
+func (p *planner) CreateAggregate(ctx context.Context, n *tree.CreateAggregate) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"CREATE AGGREGATE",
+	); err != nil {
+		return nil, err
+	}
+
+	db, sc, _, err := p.ResolveTargetObject(ctx, n.Name.ToUnresolvedObjectName())
+	if err != nil {
+		return nil, err
+	}
+	if sc.SchemaKind() == catalog.SchemaTemporary {
+		return nil, unimplemented.NewWithIssue(104687, "cannot create user-defined functions under a temporary schema")
+	}
+	return &createAggregateNode{n: n, dbDesc: db, scDesc: sc}, nil
+}
+
+func (n *createAggregateNode) ReadingOwnWrites() {}
+
+func (n *createAggregateNode) startExec(params runParams) error {
+	if err := params.p.canCreateOnSchema(
+		params.ctx, n.scDesc.GetID(), n.dbDesc.GetID(), params.p.User(), skipCheckPublicSchema,
+	); err != nil {
+		return err
+	}
+
+	telemetry.Inc(sqltelemetry.SchemaChangeCreateCounter("aggregate"))
+
+	mutScDesc, err := params.p.descCollection.MutableByName(params.p.Txn()).Schema(params.ctx, n.dbDesc, n.scDesc.GetName())
+	if err != nil {
+		return err
+	}
+
+	var retErr error
+	params.p.runWithOptions(resolveFlags{contextDatabaseID: n.dbDesc.GetID()}, func() {
+		retErr = func() error {
+			pbParams, argTypes, err := n.makeAggregateParams(params)
+			if err != nil {
+				return err
+			}
+			agg, retType, vol, funcDeps, err := n.resolveAggregateDefinition(params, argTypes)
+			if err != nil {
+				return err
+			}
+
+			// Try to look up an existing function.
+			existing, err := params.p.matchRoutine(
+				params.ctx, &tree.RoutineObj{FuncName: n.n.Name, Params: n.n.Params},
+				false /* required */, tree.UDFRoutine|tree.ProcedureRoutine, false, /* inDropContext */
+			)
+			if err != nil {
+				return err
+			}
+
+			var fnDesc *funcdesc.Mutable
+			if existing != nil {
+				if !n.n.Replace {
+					return pgerror.Newf(
+						pgcode.DuplicateFunction,
+						"function %q already exists with same argument types",
+						n.n.Name.Object(),
+					)
+				}
+				fnDesc, err = params.p.checkPrivilegesForDropFunction(
+					params.ctx, funcdesc.UserDefinedFunctionOIDToID(existing.Oid),
+				)
+				if err != nil {
+					return err
+				}
+				if !fnDesc.IsAggregate() {
+					formatStr := "%q is a function"
+					if fnDesc.IsProcedure() {
+						formatStr = "%q is a procedure"
+					}
+					return errors.WithDetailf(
+						pgerror.Newf(pgcode.WrongObjectType, "cannot change routine kind"),
+						formatStr,
+						fnDesc.Name,
+					)
+				}
+				if !retType.Equivalent(fnDesc.ReturnType.Type) {
+					return pgerror.Newf(pgcode.InvalidFunctionDefinition, "cannot change return type of existing function")
+				}
+				if err := params.p.removeAggregateReferences(params.ctx, fnDesc); err != nil {
+					return err
+				}
+			} else {
+				fnDesc, err = n.newAggregateDesc(params, mutScDesc, pbParams, retType)
+				if err != nil {
+					return err
+				}
+			}
+
+			fnDesc.Aggregate = agg
+			fnDesc.Volatility = aggregateVolatilityToProto(vol)
+			if err := n.addAggregateReferences(params, fnDesc, funcDeps); err != nil {
+				return err
+			}
+
+			if existing == nil {
+				if err := params.p.createDescriptor(
+					params.ctx, fnDesc, tree.AsStringWithFQNames(&n.n.Name, params.Ann()),
+				); err != nil {
+					return err
+				}
+				mutScDesc.AddFunction(fnDesc.GetName(), toSchemaOverloadSignature(fnDesc))
+				if err := params.p.writeSchemaDescChange(params.ctx, mutScDesc, "Create Aggregate"); err != nil {
+					return err
+				}
+			} else if err := params.p.writeFuncSchemaChange(params.ctx, fnDesc); err != nil {
+				return err
+			}
+
+			fnName := tree.MakeQualifiedRoutineName(n.dbDesc.GetName(), n.scDesc.GetName(), n.n.Name.String())
+			event := eventpb.CreateFunction{
+				FunctionName: fnName.FQString(),
+				IsReplace:    existing != nil,
+			}
+			return params.p.logEvent(params.ctx, fnDesc.GetID(), &event)
+		}()
+	})
+	return retErr
+}
+
+func (*createAggregateNode) Next(params runParams) (bool, error) { return false, nil }
+func (*createAggregateNode) Values() tree.Datums                 { return tree.Datums{} }
+func (*createAggregateNode) Close(ctx context.Context)           {}
+
+// makeAggregateParams resolves the parameters of the aggregate, returning them
+// along with the types of the aggregated arguments.
+func (n *createAggregateNode) makeAggregateParams(
+	params runParams,
+) ([]descpb.FunctionDescriptor_Parameter, []*types.T, error) {
+	if len(n.n.Params) == 0 {
+		return nil, nil, unimplemented.NewWithIssue(74775, "aggregates without arguments are not supported")
+	}
+	pbParams := make([]descpb.FunctionDescriptor_Parameter, len(n.n.Params))
+	argTypes := make([]*types.T, len(n.n.Params))
+	for i, param := range n.n.Params {
+		if tree.IsOutParamClass(param.Class) {
+			return nil, nil, pgerror.New(pgcode.InvalidFunctionDefinition, "aggregates cannot have output arguments")
+		}
+		pbParam, err := makeFunctionParam(params.ctx, params.p.SemaCtx(), param, params.p)
+		if err != nil {
+			return nil, nil, err
+		}
+		pbParams[i] = pbParam
+		argTypes[i] = pbParam.Type
+	}
+	return pbParams, argTypes, nil
+}
+
+// resolveAggregateDefinition resolves the component functions of the
+// aggregate and validates their signatures. It returns the aggregate
+// definition to store in the descriptor, the result type of the aggregate,
+// its volatility, and the IDs of the user-defined functions it depends on.
+func (n *createAggregateNode) resolveAggregateDefinition(
+	params runParams, argTypes []*types.T,
+) (
+	agg *descpb.FunctionDescriptor_Aggregate,
+	retType *types.T,
+	vol volatility.V,
+	funcDeps catalog.DescriptorIDSet,
+	err error,
+) {
+	ctx, p := params.ctx, params.p
+	stateType, err := tree.ResolveType(ctx, n.n.StateType, p)
+	if err != nil {
+		return nil, nil, 0, catalog.DescriptorIDSet{}, err
+	}
+	if stateType.IsPolymorphicType() {
+		return nil, nil, 0, catalog.DescriptorIDSet{}, unimplemented.NewWithIssue(
+			74775, "polymorphic aggregate state types are not supported",
+		)
+	}
+	agg = &descpb.FunctionDescriptor_Aggregate{StateType: stateType}
+	vol = volatility.Leakproof
+	addFunc := func(fn aggregateSupportFunc) {
+		if fn.ol.Volatility > vol {
+			vol = fn.ol.Volatility
+		}
+		if fn.ol.Type == tree.UDFRoutine {
+			funcDeps.Add(funcdesc.UserDefinedFunctionOIDToID(fn.ol.Oid))
+		}
+	}
+
+	// The state transition function is called with the current state followed
+	// by the aggregated arguments, and must return the new state.
+	sfuncArgs := append([]*types.T{stateType}, argTypes...)
+	sfunc, err := p.resolveAggregateSupportFunc(ctx, &n.n.StateFunc, sfuncArgs)
+	if err != nil {
+		return nil, nil, 0, catalog.DescriptorIDSet{}, err
+	}
+	if typ := sfunc.ol.InferReturnTypeFromInputArgTypes(sfuncArgs); !typ.Equivalent(stateType) {
+		return nil, nil, 0, catalog.DescriptorIDSet{}, pgerror.Newf(pgcode.DatatypeMismatch,
+			"return type of transition function %s is not %s", sfunc.name, stateType.SQLStringForError(),
+		)
+	}
+	agg.StateFunctionOID = sfunc.ol.Oid
+	addFunc(sfunc)
+
+	if n.n.InitCond != nil {
+		if _, _, err := tree.ParseAndRequireString(stateType, *n.n.InitCond, p.EvalContext()); err != nil {
+			return nil, nil, 0, catalog.DescriptorIDSet{}, errors.Wrapf(err, "invalid initial condition")
+		}
+		agg.InitialCondition = n.n.InitCond
+	} else if !sfunc.ol.CalledOnNullInput && (len(argTypes) == 0 || !argTypes[0].Equivalent(stateType)) {
+		// A strict transition function with a NULL initial state uses the first
+		// non-NULL input as the initial state, so it must have the state type.
+		return nil, nil, 0, catalog.DescriptorIDSet{}, pgerror.New(pgcode.InvalidFunctionDefinition,
+			"must not omit initial value when transition function is strict and transition type is not compatible with input type",
+		)
+	}
+
+	retType = stateType
+	if n.n.FinalFunc != nil {
+		ffuncArgs := []*types.T{stateType}
+		ffunc, err := p.resolveAggregateSupportFunc(ctx, n.n.FinalFunc, ffuncArgs)
+		if err != nil {
+			return nil, nil, 0, catalog.DescriptorIDSet{}, err
+		}
+		retType = ffunc.ol.InferReturnTypeFromInputArgTypes(ffuncArgs)
+		agg.FinalFunctionOID = ffunc.ol.Oid
+		addFunc(ffunc)
+	}
+
+	if n.n.CombineFunc != nil {
+		cfuncArgs := []*types.T{stateType, stateType}
+		cfunc, err := p.resolveAggregateSupportFunc(ctx, n.n.CombineFunc, cfuncArgs)
+		if err != nil {
+			return nil, nil, 0, catalog.DescriptorIDSet{}, err
+		}
+		if typ := cfunc.ol.InferReturnTypeFromInputArgTypes(cfuncArgs); !typ.Equivalent(stateType) {
+			return nil, nil, 0, catalog.DescriptorIDSet{}, pgerror.Newf(pgcode.DatatypeMismatch,
+				"return type of combine function %s is not %s", cfunc.name, stateType.SQLStringForError(),
+			)
+		}
+		agg.CombineFunctionOID = cfunc.ol.Oid
+		addFunc(cfunc)
+	}
+	return agg, retType, vol, funcDeps, nil
+}
+
+// resolveAggregateSupportFunc resolves a component function of a user-defined
+// aggregate with exactly the given argument types. Both builtin and
+// user-defined functions are allowed.
+func (p *planner) resolveAggregateSupportFunc(
+	ctx context.Context, name *tree.RoutineName, argTypes []*types.T,
+) (aggregateSupportFunc, error) {
+	path := p.CurrentSearchPath()
+	fnDef, err := p.ResolveFunction(
+		ctx, tree.MakeUnresolvedFunctionName(name.ToUnresolvedObjectName().ToUnresolvedName()), &path,
+	)
+	if err != nil {
+		return aggregateSupportFunc{}, err
+	}
+	routineObj := tree.RoutineObj{FuncName: *name, Params: make(tree.RoutineParams, len(argTypes))}
+	for i, typ := range argTypes {
+		routineObj.Params[i] = tree.RoutineParam{Type: typ, Class: tree.RoutineParamDefault}
+	}
+	qol, err := fnDef.MatchOverload(
+		ctx, p, &routineObj, &path, tree.BuiltinRoutine|tree.UDFRoutine,
+		false /* inDropContext */, false, /* tryDefaultExprs */
+	)
+	if err != nil {
+		return aggregateSupportFunc{}, err
+	}
+	// Fetch the full overload so that the volatility and null input behavior of
+	// user-defined functions are known.
+	fnName, ol, err := p.ResolveFunctionByOID(ctx, qol.Oid)
+	if err != nil {
+		return aggregateSupportFunc{}, err
+	}
+	if ol.Class != tree.NormalClass {
+		return aggregateSupportFunc{}, pgerror.Newf(pgcode.InvalidFunctionDefinition,
+			"function %s is not an ordinary function", fnName,
+		)
+	}
+	if ol.Type == tree.UDFRoutine {
+		desc, err := p.FunctionDesc(ctx, ol.Oid)
+		if err != nil {
+			return aggregateSupportFunc{}, err
+		}
+		if err := p.CheckPrivilege(ctx, desc, privilege.EXECUTE); err != nil {
+			return aggregateSupportFunc{}, err
+		}
+	}
+	return aggregateSupportFunc{name: fnName, ol: ol}, nil
+}
+
+// newAggregateDesc constructs the descriptor of a new user-defined aggregate.
+func (n *createAggregateNode) newAggregateDesc(
+	params runParams,
+	scDesc catalog.SchemaDescriptor,
+	pbParams []descpb.FunctionDescriptor_Parameter,
+	retType *types.T,
+) (*funcdesc.Mutable, error) {
+	funcDescID, err := params.EvalContext().DescIDGenerator.GenerateUniqueDescID(params.ctx)
+	if err != nil {
+		return nil, err
+	}
+	privileges, err := catprivilege.CreatePrivilegesFromDefaultPrivileges(
+		n.dbDesc.GetDefaultPrivilegeDescriptor(),
+		scDesc.GetDefaultPrivilegeDescriptor(),
+		n.dbDesc.GetID(),
+		params.SessionData().User(),
+		privilege.Routines,
+	)
+	if err != nil {
+		return nil, err
+	}
+	fnDesc := funcdesc.NewMutableFunctionDescriptor(
+		funcDescID,
+		n.dbDesc.GetID(),
+		scDesc.GetID(),
+		string(n.n.Name.ObjectName),
+		pbParams,
+		retType,
+		false, /* returnSet */
+		false, /* isProcedure */
+		privileges,
+	)
+	return &fnDesc, nil
+}
+
+// addAggregateReferences adds references from the aggregate to the
+// user-defined functions and types it depends on, along with the
+// corresponding back references.
+func (n *createAggregateNode) addAggregateReferences(
+	params runParams, fnDesc *funcdesc.Mutable, funcDeps catalog.DescriptorIDSet,
+) error {
+	fnDesc.DependsOnFunctions = funcDeps.Ordered()
+	for _, id := range fnDesc.DependsOnFunctions {
+		backRefDesc, err := params.p.Descriptors().MutableByID(params.p.Txn()).Function(params.ctx, id)
+		if err != nil {
+			return err
+		}
+		if dbID := backRefDesc.GetParentID(); dbID != n.dbDesc.GetID() {
+			return pgerror.Newf(pgcode.FeatureNotSupported, "dependent function %s cannot be from another database",
+				backRefDesc.GetName())
+		}
+		if err := backRefDesc.AddFunctionReference(fnDesc.ID); err != nil {
+			return err
+		}
+		if err := params.p.writeFuncSchemaChange(params.ctx, backRefDesc); err != nil {
+			return err
+		}
+	}
+
+	var typeDeps catalog.DescriptorIDSet
+	addTypeDeps := func(typ *types.T) {
+		if typ.UserDefined() {
+			typeDeps = typeDeps.Union(typedesc.GetTypeDescriptorClosure(typ))
+		}
+	}
+	for _, param := range fnDesc.Params {
+		addTypeDeps(param.Type)
+	}
+	addTypeDeps(fnDesc.ReturnType.Type)
+	addTypeDeps(fnDesc.Aggregate.StateType)
+	for _, id := range typeDeps.Ordered() {
+		if isTable, err := params.p.descIsTable(params.ctx, id); err != nil {
+			return err
+		} else if isTable {
+			return unimplemented.NewWithIssue(74775, "table record types are not supported in user-defined aggregates")
+		}
+		jobDesc := fmt.Sprintf("updating type back reference %d for aggregate %d", id, fnDesc.ID)
+		if err := params.p.addTypeBackReference(params.ctx, id, fnDesc.ID, jobDesc); err != nil {
+			return err
+		}
+	}
+	fnDesc.DependsOnTypes = typeDeps.Ordered()
+	return nil
+}
+
+// removeAggregateReferences removes the back references to the aggregate from
+// the functions and types it depends on. It is used when an aggregate is
+// replaced.
+func (p *planner) removeAggregateReferences(ctx context.Context, fnDesc *funcdesc.Mutable) error {
+	for _, id := range fnDesc.DependsOnFunctions {
+		backRefDesc, err := p.Descriptors().MutableByID(p.txn).Function(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := backRefDesc.RemoveFunctionReference(fnDesc.ID); err != nil {
+			return err
+		}
+		if err := p.writeFuncSchemaChange(ctx, backRefDesc); err != nil {
+			return err
+		}
+	}
+	fnDesc.DependsOnFunctions = nil
+	jobDesc := fmt.Sprintf("updating type back reference %d for aggregate %d", fnDesc.DependsOnTypes, fnDesc.ID)
+	if err := p.removeTypeBackReferences(ctx, fnDesc.DependsOnTypes, fnDesc.ID, jobDesc); err != nil {
+		return err
+	}
+	fnDesc.DependsOnTypes = nil
+	return nil
+}
+
+// aggregateVolatilityToProto converts the volatility of a user-defined
+// aggregate, derived from its component functions, to its descriptor form.
+func aggregateVolatilityToProto(v volatility.V) catpb.Function_Volatility {
+	switch v {
+	case volatility.Leakproof, volatility.Immutable:
+		return catpb.Function_IMMUTABLE
+	case volatility.Stable:
+		return catpb.Function_STABLE
+	default:
+		return catpb.Function_VOLATILE
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catprivilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/funcdesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+)
+
+type createDomainNode struct {
+	zeroInputPlanNode
+	n        *tree.CreateDomain
+	typeName *tree.TypeName
+	dbDesc   catalog.DatabaseDescriptor
+}
+
+// Use to satisfy the linter.
+var _ planNode = &createDomainNode{n: nil}
+
+// CreateDomain creates a domain type.
+// Privileges: CREATE on the database and schema.
+func (p *planner) CreateDomain(ctx context.Context, n *tree.CreateDomain) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		"CREATE DOMAIN",
+	); err != nil {
+		return nil, err
+	}
+
+	// Resolve the desired new type name.
+	typeName, db, err := resolveNewTypeName(ctx, p, n.TypeName)
+	if err != nil {
+		return nil, err
+	}
+	n.TypeName.SetAnnotation(&p.semaCtx.Annotations, typeName)
+	return &createDomainNode{
+		n:        n,
+		typeName: typeName,
+		dbDesc:   db,
+	}, nil
+}
+
+func (n *createDomainNode) startExec(params runParams) error {
+	telemetry.Inc(sqltelemetry.SchemaChangeCreateCounter("domain"))
+	ctx, p := params.ctx, params.p
+
+	schema, err := getCreateTypeParams(ctx, p, n.typeName, n.dbDesc)
+	if err != nil {
+		return err
+	}
+
+	baseType, err := tree.ResolveType(ctx, n.n.BaseType, p.semaCtx.TypeResolver)
+	if err != nil {
+		return err
+	}
+	if err := p.checkDomainBaseType(ctx, baseType); err != nil {
+		return err
+	}
+
+	domain := &descpb.TypeDescriptor_Domain{BaseType: baseType}
+	if n.n.Default != nil {
+		def, err := p.sanitizeDomainDefault(ctx, n.n.Default, baseType)
+		if err != nil {
+			return err
+		}
+		domain.DefaultExpr = def
+	}
+	var hasNull bool
+	for i := range n.n.Constraints {
+		c := &n.n.Constraints[i]
+		switch {
+		case c.NotNull, c.Null:
+			if (c.NotNull && hasNull) || (c.Null && domain.NotNull) {
+				return pgerror.New(pgcode.Syntax, "conflicting NULL/NOT NULL constraints")
+			}
+			domain.NotNull = domain.NotNull || c.NotNull
+			hasNull = hasNull || c.Null
+		default:
+			chk, err := p.makeDomainCheckConstraint(ctx, n.typeName.Object(), domain, c)
+			if err != nil {
+				return err
+			}
+			domain.Checks = append(domain.Checks, chk)
+		}
+	}
+
+	id, err := params.EvalContext().DescIDGenerator.GenerateUniqueDescID(ctx)
+	if err != nil {
+		return err
+	}
+	privs, err := catprivilege.CreatePrivilegesFromDefaultPrivileges(
+		n.dbDesc.GetDefaultPrivilegeDescriptor(),
+		schema.GetDefaultPrivilegeDescriptor(),
+		n.dbDesc.GetID(),
+		params.SessionData().User(),
+		privilege.Types,
+	)
+	if err != nil {
+		return err
+	}
+	typeDesc := typedesc.NewBuilder(&descpb.TypeDescriptor{
+		Name:           n.typeName.Type(),
+		ID:             id,
+		ParentID:       n.dbDesc.GetID(),
+		ParentSchemaID: schema.GetID(),
+		Kind:           descpb.TypeDescriptor_DOMAIN,
+		Domain:         domain,
+		Version:        1,
+		Privileges:     privs,
+	}).BuildCreatedMutableType()
+
+	return p.finishCreateType(ctx, params.EvalContext(), n.typeName, typeDesc, n.dbDesc, schema)
+}
+
+// checkDomainBaseType returns an error if the given type cannot be used as the
+// base type of a domain.
+func (p *planner) checkDomainBaseType(ctx context.Context, typ *types.T) error {
+	switch typ.Family() {
+	case types.AnyFamily, types.VoidFamily, types.TriggerFamily, types.TupleFamily:
+		return pgerror.Newf(pgcode.DatatypeMismatch,
+			"%q is not a valid base type for a domain", typ.SQLStandardName())
+	}
+	if err := tree.CheckUnsupportedType(ctx, &p.semaCtx, typ); err != nil {
+		return err
+	}
+	if typ.UserDefined() {
+		return unimplemented.New("domain-user-defined-base-type",
+			"domains over user-defined types are not yet supported")
+	}
+	return nil
+}
+
+// sanitizeDomainDefault type checks the DEFAULT expression of a domain and
+// returns its serialized form. A nil string is returned for DEFAULT NULL.
+func (p *planner) sanitizeDomainDefault(
+	ctx context.Context, expr tree.Expr, baseType *types.T,
+) (*string, error) {
+	typedExpr, err := schemaexpr.SanitizeVarFreeExpr(
+		ctx, expr, baseType, tree.DomainDefaultExpr, &p.semaCtx, volatility.Volatile, true, /* allowAssignmentCast */
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := funcdesc.MaybeFailOnUDFUsage(
+		typedExpr, tree.DomainDefaultExpr, p.ExecCfg().Settings.Version.ActiveVersion(ctx),
+	); err != nil {
+		return nil, err
+	}
+	if typedExpr == tree.DNull {
+		return nil, nil
+	}
+	s := tree.Serialize(typedExpr)
+	return &s, nil
+}
+
+// domainValueColumnName is the name by which CHECK constraints of a domain
+// refer to the value being checked.
+const domainValueColumnName = "value"
+
+// makeDomainCheckConstraint type checks the given CHECK constraint of a
+// domain and returns its descriptor representation. If the constraint is not
+// named, a name that does not conflict with the existing constraints of the
+// domain is generated.
+func (p *planner) makeDomainCheckConstraint(
+	ctx context.Context,
+	domainName string,
+	domain *descpb.TypeDescriptor_Domain,
+	c *tree.DomainConstraint,
+) (descpb.TypeDescriptor_Domain_CheckConstraint, error) {
+	expr, err := validateDomainCheckExpr(
+		ctx, &p.semaCtx, p.ExecCfg().Settings.Version.ActiveVersion(ctx), domainName, domain.BaseType, c.Check,
+	)
+	if err != nil {
+		return descpb.TypeDescriptor_Domain_CheckConstraint{}, err
+	}
+	hasCheck := func(name string) bool {
+		for i := range domain.Checks {
+			if domain.Checks[i].Name == name {
+				return true
+			}
+		}
+		return false
+	}
+	name := string(c.Name)
+	if name == "" {
+		name = domainName + "_check"
+		for i := 1; hasCheck(name); i++ {
+			name = fmt.Sprintf("%s_check%d", domainName, i)
+		}
+	} else if hasCheck(name) {
+		return descpb.TypeDescriptor_Domain_CheckConstraint{}, pgerror.Newf(pgcode.DuplicateObject,
+			"constraint %q for domain %q already exists", name, domainName)
+	}
+	return descpb.TypeDescriptor_Domain_CheckConstraint{Name: name, Expr: expr}, nil
+}
+
+// validateDomainCheckExpr type checks the expression of a CHECK constraint of
+// a domain, in which VALUE refers to the value being checked, and returns its
+// serialized form.
+func validateDomainCheckExpr(
+	ctx context.Context,
+	semaCtx *tree.SemaContext,
+	version clusterversion.ClusterVersion,
+	domainName string,
+	baseType *types.T,
+	expr tree.Expr,
+) (string, error) {
+	const valueColumnID = catid.ColumnID(1)
+	tn := tree.MakeUnqualifiedTableName(tree.Name(domainName))
+	getAllNonDropColumnsFn := func() colinfo.ResultColumns {
+		return colinfo.ResultColumns{{Name: domainValueColumnName, Typ: baseType}}
+	}
+	columnLookupByNameFn := func(
+		columnName tree.Name,
+	) (exists, accessible, computed bool, id catid.ColumnID, typ *types.T) {
+		if columnName != domainValueColumnName {
+			return false, false, false, 0, nil
+		}
+		return true, true, false, valueColumnID, baseType
+	}
+	serialized, _, _, err := schemaexpr.DequalifyAndValidateExprImpl(
+		ctx, expr, types.Bool, tree.DomainCheckExpr, semaCtx, volatility.Immutable, &tn, version,
+		getAllNonDropColumnsFn, columnLookupByNameFn,
+	)
+	return serialized, err
+}
+
+// makeCreateDomainNode reconstructs the CREATE DOMAIN statement of the given
+// domain type.
+func makeCreateDomainNode(
+	name *tree.UnresolvedObjectName, domain catalog.DomainTypeDescriptor,
+) (*tree.CreateDomain, error) {
+	node := &tree.CreateDomain{TypeName: name, BaseType: domain.BaseType()}
+	if def, ok := domain.DefaultExpr(); ok {
+		expr, err := parser.ParseExpr(def)
+		if err != nil {
+			return nil, err
+		}
+		node.Default = expr
+	}
+	if domain.IsNotNull() {
+		node.Constraints = append(node.Constraints, tree.DomainConstraint{NotNull: true})
+	}
+	for i := 0; i < domain.NumChecks(); i++ {
+		chk := domain.GetCheck(i)
+		expr, err := parser.ParseExpr(chk.Expr)
+		if err != nil {
+			return nil, err
+		}
+		node.Constraints = append(node.Constraints, tree.DomainConstraint{
+			Name:  tree.Name(chk.Name),
+			Check: expr,
+		})
+	}
+	return node, nil
+}
+
+func (n *createDomainNode) Next(params runParams) (bool, error) { return false, nil }
+func (n *createDomainNode) Values() tree.Datums                 { return tree.Datums{} }
+func (n *createDomainNode) Close(ctx context.Context)           {}
+func (n *createDomainNode) ReadingOwnWrites()                   {}
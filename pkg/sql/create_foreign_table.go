@@ -0,0 +1,349 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn"
+	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn/connectionpb"
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/server/telemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catprivilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
+	"github.com/cockroachdb/cockroach/pkg/sql/syntheticprivilege"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/errors"
+)
+
+const createForeignTableOp = "CREATE FOREIGN TABLE"
+
+type createForeignTableNode struct {
+	zeroInputPlanNode
+	n      *tree.CreateForeignTable
+	dbDesc catalog.DatabaseDescriptor
+}
+
+// CreateForeignTable represents a CREATE FOREIGN TABLE statement.
+func (p *planner) CreateForeignTable(
+	ctx context.Context, n *tree.CreateForeignTable,
+) (planNode, error) {
+	if err := checkSchemaChangeEnabled(
+		ctx,
+		p.ExecCfg(),
+		createForeignTableOp,
+	); err != nil {
+		return nil, err
+	}
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_ForeignTables) {
+		return nil, pgerror.New(pgcode.FeatureNotSupported,
+			"CREATE FOREIGN TABLE requires the cluster to be upgraded to v26.1")
+	}
+
+	un := n.Table.ToUnresolvedObjectName()
+	dbDesc, _, prefix, err := p.ResolveTargetObject(ctx, un)
+	if err != nil {
+		return nil, err
+	}
+	n.Table.ObjectNamePrefix = prefix
+
+	return &createForeignTableNode{
+		n:      n,
+		dbDesc: dbDesc,
+	}, nil
+}
+
+// ReadingOwnWrites implements the planNodeReadingOwnWrites interface.
+func (n *createForeignTableNode) ReadingOwnWrites() {}
+
+func (n *createForeignTableNode) startExec(params runParams) error {
+	telemetry.Inc(sqltelemetry.SchemaChangeCreateCounter("foreign_table"))
+	p := params.p
+
+	schemaDesc, err := getSchemaForCreateTable(params, n.dbDesc, tree.PersistencePermanent,
+		&n.n.Table, tree.ResolveRequireTableDesc, n.n.IfNotExists)
+	if err != nil {
+		if sqlerrors.IsRelationAlreadyExistsError(err) && n.n.IfNotExists {
+			return nil
+		}
+		return err
+	}
+
+	// The files of the table are read through the External Connection of the
+	// server, so the user must be allowed to use it.
+	server := string(n.n.Server)
+	ec, err := externalconn.LoadExternalConnection(params.ctx, server, p.InternalSQLTxn())
+	if err != nil {
+		var notFoundErr *externalconn.ExternalConnectionNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return pgerror.Newf(pgcode.UndefinedObject, "server %q does not exist", server)
+		}
+		return err
+	}
+	if ec.ConnectionType() != connectionpb.TypeStorage {
+		return pgerror.Newf(pgcode.WrongObjectType,
+			"external connection %q of type %s is not a server", server, ec.ConnectionType())
+	}
+	if err := p.CheckPrivilege(params.ctx, &syntheticprivilege.ExternalConnectionPrivilege{
+		ConnectionName: server,
+	}, privilege.USAGE); err != nil {
+		return err
+	}
+
+	foreign, err := p.makeForeignTableDescriptor(params.ctx, server, n.n.Options)
+	if err != nil {
+		return err
+	}
+
+	id, err := p.EvalContext().DescIDGenerator.GenerateUniqueDescID(params.ctx)
+	if err != nil {
+		return err
+	}
+	privs, err := catprivilege.CreatePrivilegesFromDefaultPrivileges(
+		n.dbDesc.GetDefaultPrivilegeDescriptor(),
+		schemaDesc.GetDefaultPrivilegeDescriptor(),
+		n.dbDesc.GetID(),
+		p.User(),
+		privilege.Tables,
+	)
+	if err != nil {
+		return err
+	}
+
+	// creationTime is initialized to a zero value and populated at read time.
+	// See the comment in desc.MaybeIncrementVersion.
+	var creationTime hlc.Timestamp
+	desc := tabledesc.InitTableDescriptor(
+		id, n.dbDesc.GetID(), schemaDesc.GetID(), n.n.Table.Table(), creationTime, privs,
+		tree.PersistencePermanent,
+	)
+	desc.Foreign = foreign
+	for _, def := range n.n.Defs {
+		d, ok := def.(*tree.ColumnTableDef)
+		if !ok {
+			return pgerror.New(pgcode.FeatureNotSupported,
+				"foreign tables cannot have constraints, indexes or column families")
+		}
+		if err := checkForeignTableColumnDef(d); err != nil {
+			return err
+		}
+		cdd, err := tabledesc.MakeColumnDefDescs(
+			params.ctx, d, &p.semaCtx, p.EvalContext(), tree.ColumnDefaultExprInNewTable,
+		)
+		if err != nil {
+			return err
+		}
+		if typ := cdd.ColumnDescriptor.Type; typ.UserDefined() {
+			return pgerror.Newf(pgcode.FeatureNotSupported,
+				"column %q of a foreign table cannot have user-defined type %s",
+				d.Name, typ.SQLString())
+		}
+		desc.AddColumn(cdd.ColumnDescriptor)
+	}
+	if len(desc.Columns) == 0 {
+		return pgerror.New(pgcode.InvalidTableDefinition,
+			"foreign tables must have at least one column")
+	}
+	// The rows of a foreign table are not written by the cluster, so there is
+	// nothing to backfill and the table can be made public immediately.
+	desc.State = descpb.DescriptorState_PUBLIC
+	version := p.ExecCfg().Settings.Version.ActiveVersion(params.ctx)
+	if err := desc.AllocateIDs(params.ctx, version); err != nil {
+		return err
+	}
+
+	if err := p.createDescriptor(
+		params.ctx, &desc, tree.AsStringWithFQNames(n.n, params.Ann()),
+	); err != nil {
+		return err
+	}
+	if err := validateDescriptor(params.ctx, p, &desc); err != nil {
+		return err
+	}
+
+	// Log Create Table event. This is an auditable log event and is
+	// recorded in the same transaction as the table descriptor update.
+	return p.logEvent(params.ctx,
+		desc.ID,
+		&eventpb.CreateTable{
+			TableName: n.n.Table.FQString(),
+		})
+}
+
+// checkForeignTableColumnDef returns an error if the column definition uses
+// anything other than a name, a type and a nullability constraint. The rows
+// of a foreign table are never written by the cluster, so defaults, computed
+// expressions and constraints other than NOT NULL are meaningless.
+func checkForeignTableColumnDef(d *tree.ColumnTableDef) error {
+	switch {
+	case d.IsSerial || d.GeneratedIdentity.IsGeneratedAsIdentity:
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"column %q of a foreign table cannot be serial or an identity column", d.Name)
+	case d.HasDefaultExpr() || d.HasOnUpdateExpr() || d.IsComputed():
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"column %q of a foreign table cannot have a default, ON UPDATE or computed expression",
+			d.Name)
+	case d.PrimaryKey.IsPrimaryKey || d.Unique.IsUnique || d.HasFKConstraint() ||
+		len(d.CheckExprs) > 0:
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"column %q of a foreign table cannot have constraints other than NOT NULL", d.Name)
+	case d.Hidden || d.HasColumnFamily():
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"column %q of a foreign table cannot be hidden or have a column family", d.Name)
+	}
+	return nil
+}
+
+// makeForeignTableDescriptor returns the ForeignTableDescriptor of a foreign
+// table of the given server with the given options.
+func (p *planner) makeForeignTableDescriptor(
+	ctx context.Context, server string, options tree.ForeignOptions,
+) (*descpb.ForeignTableDescriptor, error) {
+	exprEval := p.ExprEvaluator(createForeignTableOp)
+	opts := make(map[string]string, len(options))
+	for _, opt := range options {
+		key := string(opt.Key)
+		if _, ok := opts[key]; ok {
+			return nil, pgerror.Newf(pgcode.Syntax, "option %q specified more than once", key)
+		}
+		val, err := exprEval.String(ctx, opt.Value)
+		if err != nil {
+			return nil, err
+		}
+		opts[key] = val
+	}
+
+	foreign := &descpb.ForeignTableDescriptor{Server: server}
+	var ok bool
+	if foreign.Filename, ok = opts["filename"]; !ok || foreign.Filename == "" {
+		return nil, pgerror.New(pgcode.InvalidParameterValue, `option "filename" is required`)
+	}
+	delete(opts, "filename")
+
+	format := &foreign.Format
+	switch f := strings.ToLower(opts["format"]); f {
+	case "", "csv":
+		format.Format = roachpb.IOFileFormat_CSV
+		format.Csv.Comma = ','
+	case "parquet":
+		format.Format = roachpb.IOFileFormat_Parquet
+	default:
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+			"unsupported format %q, expected 'csv' or 'parquet'", f)
+	}
+	delete(opts, "format")
+
+	switch c := strings.ToLower(opts["compression"]); c {
+	case "", "auto":
+		format.Compression = roachpb.IOFileFormat_Auto
+	case "none":
+		format.Compression = roachpb.IOFileFormat_None
+	case "gzip":
+		format.Compression = roachpb.IOFileFormat_Gzip
+	case "bzip":
+		format.Compression = roachpb.IOFileFormat_Bzip
+	default:
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue, "unsupported compression %q", c)
+	}
+	delete(opts, "compression")
+
+	if format.Format == roachpb.IOFileFormat_CSV {
+		singleRune := func(key string) (int32, bool, error) {
+			val, ok := opts[key]
+			if !ok {
+				return 0, false, nil
+			}
+			delete(opts, key)
+			r, size := utf8.DecodeRuneInString(val)
+			if size == 0 || size != len(val) {
+				return 0, false, pgerror.Newf(pgcode.InvalidParameterValue,
+					"option %q must be a single character", key)
+			}
+			return r, true, nil
+		}
+		if r, ok, err := singleRune("delimiter"); err != nil {
+			return nil, err
+		} else if ok {
+			format.Csv.Comma = r
+		}
+		if r, ok, err := singleRune("comment"); err != nil {
+			return nil, err
+		} else if ok {
+			format.Csv.Comment = r
+		}
+		if null, ok := opts["null"]; ok {
+			format.Csv.NullEncoding = &null
+			delete(opts, "null")
+		}
+		if header, ok := opts["header"]; ok {
+			delete(opts, "header")
+			switch strings.ToLower(header) {
+			case "true":
+				format.Csv.Skip = 1
+			case "false":
+			default:
+				return nil, pgerror.New(pgcode.InvalidParameterValue,
+					`option "header" must be 'true' or 'false'`)
+			}
+		}
+	}
+
+	for _, opt := range options {
+		if _, ok := opts[string(opt.Key)]; ok {
+			return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+				"invalid option %q for a %s foreign table", opt.Key, format.Format)
+		}
+	}
+	return foreign, nil
+}
+
+// foreignTableOptions returns the options of CREATE FOREIGN TABLE that create
+// a foreign table with the given ForeignTableDescriptor.
+func foreignTableOptions(foreign *descpb.ForeignTableDescriptor) tree.ForeignOptions {
+	opts := tree.ForeignOptions{{Key: "filename", Value: tree.NewStrVal(foreign.Filename)}}
+	add := func(key, val string) {
+		opts = append(opts, tree.KVOption{Key: tree.Name(key), Value: tree.NewStrVal(val)})
+	}
+	format := &foreign.Format
+	switch format.Format {
+	case roachpb.IOFileFormat_CSV:
+		add("format", "csv")
+		if format.Csv.Comma != ',' {
+			add("delimiter", string(format.Csv.Comma))
+		}
+		if format.Csv.Comment != 0 {
+			add("comment", string(format.Csv.Comment))
+		}
+		if format.Csv.NullEncoding != nil {
+			add("null", *format.Csv.NullEncoding)
+		}
+		if format.Csv.Skip > 0 {
+			add("header", "true")
+		}
+	case roachpb.IOFileFormat_Parquet:
+		add("format", "parquet")
+	}
+	if format.Compression != roachpb.IOFileFormat_Auto {
+		add("compression", strings.ToLower(format.Compression.String()))
+	}
+	return opts
+}
+
+func (n *createForeignTableNode) Next(runParams) (bool, error) { return false, nil }
+func (n *createForeignTableNode) Values() tree.Datums          { return tree.Datums{} }
+func (n *createForeignTableNode) Close(context.Context)        {}
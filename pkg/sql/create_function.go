@@ -155,11 +155,15 @@ func (n *createFunctionNode) createNewFunction(
 	var outParamOrdinals []int32
 	var outParamTypes []*types.T
 	var defaultExprs []string
+	var isVariadic bool
 	for paramIdx, param := range udfDesc.Params {
 		class := funcdesc.ToTreeRoutineParamClass(param.Class)
 		if tree.IsInParamClass(class) {
 			signatureTypes = append(signatureTypes, param.Type)
 		}
+		if class == tree.RoutineParamVariadic {
+			isVariadic = true
+		}
 		if class == tree.RoutineParamOut {
 			outParamOrdinals = append(outParamOrdinals, int32(paramIdx))
 			outParamTypes = append(outParamTypes, param.Type)
@@ -179,6 +183,7 @@ func (n *createFunctionNode) createNewFunction(
 			OutParamOrdinals: outParamOrdinals,
 			OutParamTypes:    outParamTypes,
 			DefaultExprs:     defaultExprs,
+			IsVariadic:       isVariadic,
 		},
 	)
 	if err := params.p.writeSchemaDescChange(params.ctx, scDesc, "Create Function"); err != nil {
@@ -195,10 +200,12 @@ func (n *createFunctionNode) replaceFunction(
 	existing *tree.QualifiedOverload,
 ) error {
 
-	if n.cf.IsProcedure != udfDesc.IsProcedure() {
+	if n.cf.IsProcedure != udfDesc.IsProcedure() || udfDesc.IsAggregate() {
 		formatStr := "%q is a function"
 		if udfDesc.IsProcedure() {
 			formatStr = "%q is a procedure"
+		} else if udfDesc.IsAggregate() {
+			formatStr = "%q is an aggregate function"
 		}
 		return errors.WithDetailf(
 			pgerror.Newf(pgcode.WrongObjectType, "cannot change routine kind"),
@@ -251,11 +258,15 @@ func (n *createFunctionNode) replaceFunction(
 	var outParamOrdinals []int32
 	var outParamTypes []*types.T
 	var defaultExprs []string
+	var isVariadic bool
 	for i, p := range n.cf.Params {
 		udfDesc.Params[i], err = makeFunctionParam(params.ctx, params.p.SemaCtx(), p, params.p)
 		if err != nil {
 			return err
 		}
+		if p.Class == tree.RoutineParamVariadic {
+			isVariadic = true
+		}
 		if p.Class == tree.RoutineParamOut {
 			outParamOrdinals = append(outParamOrdinals, int32(i))
 			outParamTypes = append(outParamTypes, udfDesc.Params[i].Type)
@@ -309,11 +320,12 @@ func (n *createFunctionNode) replaceFunction(
 		return err
 	}
 
-	// We allow two types of "signature changes":
-	// - reordering OUT parameters in respect to input ones, and
-	// - changing the DEFAULT expression.
+	// We allow three types of "signature changes":
+	// - reordering OUT parameters in respect to input ones,
+	// - changing the DEFAULT expression, and
+	// - changing whether the last input parameter is VARIADIC.
 	signatureChanged := len(existing.OutParamOrdinals) != len(outParamOrdinals) ||
-		len(existing.DefaultExprs) != len(defaultExprs)
+		len(existing.DefaultExprs) != len(defaultExprs) || existing.Variadic != isVariadic
 	for i := 0; !signatureChanged && i < len(outParamOrdinals); i++ {
 		signatureChanged = existing.OutParamOrdinals[i] != outParamOrdinals[i] ||
 			!existing.OutParamTypes.GetAt(i).Equivalent(outParamTypes[i])
@@ -344,6 +356,7 @@ func (n *createFunctionNode) replaceFunction(
 				OutParamOrdinals: outParamOrdinals,
 				OutParamTypes:    outParamTypes,
 				DefaultExprs:     defaultExprs,
+				IsVariadic:       isVariadic,
 			},
 		); err != nil {
 			return err
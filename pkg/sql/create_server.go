@@ -0,0 +1,67 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// externalStorageWrapper is the only foreign-data wrapper. A server of this
+// wrapper is an External Connection to an external storage URI, and the
+// foreign tables of the server read files from that storage.
+const externalStorageWrapper = "external_storage"
+
+type createServerNode struct {
+	zeroInputPlanNode
+	n *tree.CreateExternalConnection
+}
+
+// CreateServer represents a CREATE SERVER statement. The server is stored as
+// an External Connection with the name of the server.
+func (p *planner) CreateServer(ctx context.Context, n *tree.CreateServer) (planNode, error) {
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_ForeignTables) {
+		return nil, pgerror.New(pgcode.FeatureNotSupported,
+			"CREATE SERVER requires the cluster to be upgraded to v26.1")
+	}
+	if n.Wrapper != externalStorageWrapper {
+		return nil, pgerror.Newf(pgcode.UndefinedObject,
+			"foreign-data wrapper %q does not exist", n.Wrapper)
+	}
+	var uri tree.Expr
+	for _, opt := range n.Options {
+		switch opt.Key {
+		case "uri":
+			uri = opt.Value
+		default:
+			return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+				"invalid option %q for foreign-data wrapper %q", opt.Key, n.Wrapper)
+		}
+	}
+	if uri == nil {
+		return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+			"option %q is required for foreign-data wrapper %q", "uri", n.Wrapper)
+	}
+	return &createServerNode{n: &tree.CreateExternalConnection{
+		ConnectionLabelSpec: tree.LabelSpec{
+			IfNotExists: n.IfNotExists,
+			Label:       tree.NewStrVal(string(n.Name)),
+		},
+		As: uri,
+	}}, nil
+}
+
+func (c *createServerNode) startExec(params runParams) error {
+	return params.p.createExternalConnection(params, c.n)
+}
+
+func (c *createServerNode) Next(_ runParams) (bool, error) { return false, nil }
+func (c *createServerNode) Values() tree.Datums            { return nil }
+func (c *createServerNode) Close(_ context.Context)        {}
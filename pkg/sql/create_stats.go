@@ -263,6 +263,12 @@ func (n *createStatsNode) makeJobRecord(ctx context.Context) (*jobs.Record, erro
 		)
 	}
 
+	if tableDesc.IsForeignTable() {
+		return nil, pgerror.New(
+			pgcode.WrongObjectType, "cannot create statistics on foreign tables",
+		)
+	}
+
 	if stats.DisallowedOnSystemTable(tableDesc.GetID()) {
 		return nil, pgerror.Newf(
 			pgcode.WrongObjectType, "cannot create statistics on system.%s", tableDesc.GetName(),
@@ -354,6 +360,9 @@ func (n *createStatsNode) makeJobRecord(ctx context.Context) (*jobs.Record, erro
 			// with a single column that doesn't use an inverted index.
 			HasHistogram:        len(columnIDs) == 1 && !isInvIndex,
 			HistogramMaxBuckets: defaultHistogramBuckets,
+			// Estimate the functional dependencies between the columns of all
+			// explicitly requested multi-column stats.
+			HasDependencies: len(columnIDs) > 1,
 		}}
 		// Make histograms for inverted index column types.
 		if len(columnIDs) == 1 && isInvIndex {
@@ -739,6 +748,37 @@ func createStatsDefaultColumns(
 		nonIdxCols++
 	}
 
+	// Add stats on the column groups chosen with the table setting
+	// sql_stats_column_groups, including the degrees of functional dependency
+	// between their columns. These are collected even if multi-column stats
+	// are disabled, since the groups were chosen explicitly.
+EachGroup:
+	for _, group := range desc.GetStatsColumnGroups() {
+		colIDs := make([]descpb.ColumnID, len(group.ColumnIDs))
+		for i, colID := range group.ColumnIDs {
+			col := catalog.FindColumnByID(desc, colID)
+			if col == nil || !col.Public() || isUnsupportedVirtual(col) {
+				continue EachGroup
+			}
+			colIDs[i] = colID
+		}
+		if !sortAndTrackStatsExists(colIDs) {
+			colStats = append(colStats, jobspb.CreateStatsDetails_ColStat{
+				ColumnIDs:       colIDs,
+				HasDependencies: true,
+			})
+			continue
+		}
+		// The group is the prefix of an index, for which stats were already
+		// requested above.
+		key := stats.MakeSortedColStatKey(colIDs)
+		for i := range colStats {
+			if !colStats[i].Inverted && stats.MakeSortedColStatKey(colStats[i].ColumnIDs) == key {
+				colStats[i].HasDependencies = true
+			}
+		}
+	}
+
 	return colStats, nil
 }
 
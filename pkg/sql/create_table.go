@@ -409,9 +409,13 @@ func (n *createTableNode) startExec(params runParams) error {
 			return err
 		}
 
-		// If we have a single statement txn we want to run CTAS async, and
-		// consequently ensure it gets queued as a SchemaChange.
-		if params.extendedEvalCtx.TxnIsSingleStmt {
+		if n.n.AsWithNoData {
+			// WITH NO DATA only creates the table, which is then a regular
+			// table that does not need to be backfilled.
+			desc.CreateQuery = ""
+		} else if params.extendedEvalCtx.TxnIsSingleStmt {
+			// If we have a single statement txn we want to run CTAS async, and
+			// consequently ensure it gets queued as a SchemaChange.
 			desc.State = descpb.DescriptorState_ADD
 		}
 	} else {
@@ -542,7 +546,7 @@ func (n *createTableNode) startExec(params runParams) error {
 
 	// If we are in a multi-statement txn or the source has placeholders, we
 	// execute the CTAS query synchronously.
-	if n.n.As() && !params.extendedEvalCtx.TxnIsSingleStmt {
+	if n.n.As() && !n.n.AsWithNoData && !params.extendedEvalCtx.TxnIsSingleStmt {
 		err = func() error {
 			// The data fill portion of CREATE AS must operate on a read snapshot,
 			// so that it doesn't end up observing its own writes.
@@ -713,6 +717,9 @@ func addUniqueWithoutIndexColumnTableDef(
 		string(d.Unique.ConstraintName),
 		[]string{string(d.Name)},
 		"", /* predicate */
+		tree.ConstraintNotDeferrable,
+		"",  /* exclusionMethod */
+		nil, /* exclusionOps */
 		ts,
 		validationBehavior,
 	); err != nil {
@@ -735,7 +742,11 @@ func addUniqueWithoutIndexTableDef(
 	validationBehavior tree.ValidationBehavior,
 	semaCtx *tree.SemaContext,
 ) error {
-	if !sessionData.EnableUniqueWithoutIndexConstraints {
+	// Exclusion constraints are always created without an index, so they are
+	// not gated by the session setting. Neither are deferrable constraints,
+	// since that is how SHOW CREATE renders deferrable UNIQUE constraints.
+	if !sessionData.EnableUniqueWithoutIndexConstraints && !d.IsExclusion() &&
+		!d.Deferrable.IsDeferrable() {
 		return pgerror.New(pgcode.FeatureNotSupported,
 			"unique constraints without an index are not yet supported",
 		)
@@ -773,20 +784,107 @@ func addUniqueWithoutIndexTableDef(
 	// Add a unique constraint.
 	colNames := make([]string, len(d.Columns))
 	for i := range colNames {
+		if d.IsExclusion() && d.Columns[i].Expr != nil {
+			return unimplemented.NewWithIssueDetail(46657, "exclude using expression",
+				"expressions are not supported in exclusion constraints")
+		}
 		colNames[i] = string(d.Columns[i].Column)
 	}
 	if err := ResolveUniqueWithoutIndexConstraint(
-		ctx, desc, string(d.Name), colNames, predicate, ts, validationBehavior,
+		ctx, desc, string(d.Name), colNames, predicate, d.Deferrable,
+		string(d.ExcludeUsing), d.ExcludeOperators, ts, validationBehavior,
 	); err != nil {
 		return err
 	}
 	return nil
 }
 
+// exclusionIndexTableDef returns the definition of the inverted index that
+// backs the given exclusion constraint, so that the constraint can be checked
+// with an inverted join instead of a scan of the entire table. It returns nil
+// if the constraint cannot be backed by an inverted index (see
+// schemaexpr.ExclusionIndexColumns), or if one of its columns does not exist.
+func exclusionIndexTableDef(
+	desc catalog.TableDescriptor, d *tree.UniqueConstraintTableDef,
+) *tree.IndexTableDef {
+	if !d.IsExclusion() {
+		return nil
+	}
+	colTypes := make([]*types.T, len(d.Columns))
+	for i := range d.Columns {
+		col := catalog.FindColumnByTreeName(desc, d.Columns[i].Column)
+		if col == nil || d.Columns[i].Expr != nil {
+			return nil
+		}
+		colTypes[i] = col.GetType()
+	}
+	ords, ok := schemaexpr.ExclusionIndexColumns(colTypes, d.ExcludeOperators)
+	if !ok {
+		return nil
+	}
+	columns := make(tree.IndexElemList, len(ords))
+	for i, ord := range ords {
+		columns[i] = tree.IndexElem{Column: d.Columns[ord].Column}
+	}
+	return &tree.IndexTableDef{Columns: columns, Type: idxtype.INVERTED}
+}
+
+// checkDeferrableUniqueIndex returns an error if the given DEFERRABLE unique
+// constraint, which is declared with an index, cannot be enforced by a
+// DEFERRABLE UNIQUE WITHOUT INDEX constraint (see
+// addDeferrableUniqueIndexConstraint).
+func checkDeferrableUniqueIndex(d *tree.UniqueConstraintTableDef) error {
+	var kind string
+	if d.PrimaryKey {
+		kind = "primary key"
+	} else if d.Sharded != nil {
+		kind = "hash-sharded unique"
+	} else {
+		for i := range d.Columns {
+			if d.Columns[i].Expr != nil {
+				kind = "expression unique"
+				break
+			}
+		}
+	}
+	if kind == "" {
+		return nil
+	}
+	return unimplemented.NewWithIssueDetailf(31632, kind,
+		"DEFERRABLE %s constraints are not supported", kind)
+}
+
+// addDeferrableUniqueIndexConstraint adds the DEFERRABLE UNIQUE WITHOUT INDEX
+// constraint that enforces a DEFERRABLE unique constraint declared with the
+// given index. Index uniqueness is enforced by the KV layer as each row is
+// written, so it cannot be postponed until the end of the transaction.
+// Instead, the index is created as a non-unique index, and the constraint,
+// which has the same name and columns, is checked by the optimizer using the
+// index.
+func addDeferrableUniqueIndexConstraint(
+	ctx context.Context,
+	desc *tabledesc.Mutable,
+	idx *descpb.IndexDescriptor,
+	deferrable tree.ConstraintDeferrability,
+	ts TableState,
+	validationBehavior tree.ValidationBehavior,
+) error {
+	// Columns added by implicit partitioning are not part of the constraint.
+	colNames := idx.KeyColumnNames[idx.Partitioning.NumImplicitColumns:]
+	return ResolveUniqueWithoutIndexConstraint(
+		ctx, desc, idx.Name, colNames, idx.Predicate, deferrable,
+		"" /* exclusionMethod */, nil /* exclusionOps */, ts, validationBehavior,
+	)
+}
+
 // ResolveUniqueWithoutIndexConstraint looks up the columns mentioned in a
 // UNIQUE WITHOUT INDEX constraint and adds metadata representing that
 // constraint to the descriptor.
 //
+// If exclusionOps is not empty, the constraint is an EXCLUDE USING constraint
+// which compares each column with the corresponding operator instead of
+// equality.
+//
 // The passed validationBehavior is used to determine whether or not preexisting
 // entries in the table need to be validated against the unique constraint being
 // added. This only applies for existing tables, not new tables.
@@ -796,9 +894,16 @@ func ResolveUniqueWithoutIndexConstraint(
 	constraintName string,
 	colNames []string,
 	predicate string,
+	deferrable tree.ConstraintDeferrability,
+	exclusionMethod string,
+	exclusionOps []treecmp.ComparisonOperator,
 	ts TableState,
 	validationBehavior tree.ValidationBehavior,
 ) error {
+	kind := "unique"
+	if len(exclusionOps) > 0 {
+		kind = "exclusion"
+	}
 	var colSet catalog.TableColSet
 	cols := make([]catalog.Column, len(colNames))
 	for i, name := range colNames {
@@ -809,16 +914,32 @@ func ResolveUniqueWithoutIndexConstraint(
 		// Ensure that the columns don't have duplicates.
 		if colSet.Contains(col.GetID()) {
 			return pgerror.Newf(pgcode.DuplicateColumn,
-				"column %q appears twice in unique constraint", col.GetName())
+				"column %q appears twice in %s constraint", col.GetName(), kind)
 		}
 		colSet.Add(col.GetID())
 		cols[i] = col
 	}
 
+	var exclusionSymbols []string
+	if len(exclusionOps) > 0 {
+		colTypes := make([]*types.T, len(cols))
+		for i, col := range cols {
+			colTypes[i] = col.GetType()
+		}
+		var err error
+		if exclusionSymbols, err = schemaexpr.ValidateExclusionOperators(colTypes, exclusionOps); err != nil {
+			return err
+		}
+	}
+
 	// Verify we are not writing a constraint over the same name.
 	if constraintName == "" {
+		defaultName := fmt.Sprintf("unique_%s", strings.Join(colNames, "_"))
+		if len(exclusionOps) > 0 {
+			defaultName = fmt.Sprintf("%s_%s_excl", tbl.GetName(), strings.Join(colNames, "_"))
+		}
 		constraintName = tabledesc.GenerateUniqueName(
-			fmt.Sprintf("unique_%s", strings.Join(colNames, "_")),
+			defaultName,
 			func(p string) bool {
 				return catalog.FindConstraintByName(tbl, p) != nil
 			},
@@ -844,12 +965,18 @@ func ResolveUniqueWithoutIndexConstraint(
 	}
 
 	uc := descpb.UniqueWithoutIndexConstraint{
-		Name:         constraintName,
-		TableID:      tbl.ID,
-		ColumnIDs:    columnIDs,
-		Predicate:    predicate,
-		Validity:     validity,
-		ConstraintID: tbl.NextConstraintID,
+		Name:              constraintName,
+		TableID:           tbl.ID,
+		ColumnIDs:         columnIDs,
+		Predicate:         predicate,
+		Validity:          validity,
+		ConstraintID:      tbl.NextConstraintID,
+		Deferrable:        deferrable.IsDeferrable(),
+		InitiallyDeferred: deferrable == tree.ConstraintInitiallyDeferred,
+	}
+	if len(exclusionSymbols) > 0 {
+		uc.ExclusionOperators = exclusionSymbols
+		uc.ExclusionIndexMethod = exclusionMethod
 	}
 	tbl.NextConstraintID++
 	if ts == NewTable {
@@ -1123,6 +1250,8 @@ func ResolveFK(
 		OnUpdate:            tree.ForeignKeyReferenceActionValue[d.Actions.Update],
 		Match:               tree.CompositeKeyMatchMethodValue[d.Match],
 		ConstraintID:        tbl.NextConstraintID,
+		Deferrable:          d.Deferrable.IsDeferrable(),
+		InitiallyDeferred:   d.Deferrable == tree.ConstraintInitiallyDeferred,
 	}
 	tbl.NextConstraintID++
 	if ts == NewTable {
@@ -1896,7 +2025,22 @@ func NewTableDesc(
 		}
 	}
 
+	// deferrableUniqueIndexNames maps the DEFERRABLE unique constraints that are
+	// declared with an index to the names of their (non-unique) indexes. See
+	// addDeferrableUniqueIndexConstraint.
+	deferrableUniqueIndexNames := make(map[*tree.UniqueConstraintTableDef]string)
+	// Exclusion constraints are backed by inverted indexes, which are created
+	// along with the indexes of the table. They are not added to n.Defs, so
+	// that the statement is unchanged if the transaction is retried.
+	defs := n.Defs
 	for _, def := range n.Defs {
+		if d, ok := def.(*tree.UniqueConstraintTableDef); ok {
+			if idxDef := exclusionIndexTableDef(&desc, d); idxDef != nil {
+				defs = append(defs[:len(defs):len(defs)], idxDef)
+			}
+		}
+	}
+	for _, def := range defs {
 		switch d := def.(type) {
 		case *tree.ColumnTableDef, *tree.LikeTableDef:
 			// pass, handled above.
@@ -2040,6 +2184,11 @@ func NewTableDesc(
 				// We will add the unique constraint below.
 				break
 			}
+			if d.Deferrable.IsDeferrable() {
+				if err := checkDeferrableUniqueIndex(d); err != nil {
+					return nil, err
+				}
+			}
 			// If the index is named, ensure that the name is unique. Unnamed
 			// indexes will be given a unique auto-generated name later on when
 			// AllocateIDs is called.
@@ -2097,6 +2246,19 @@ func NewTableDesc(
 			if err := idx.FillColumns(columns); err != nil {
 				return nil, err
 			}
+			if d.Deferrable.IsDeferrable() {
+				// The constraint is enforced by a UNIQUE WITHOUT INDEX constraint
+				// with the same name as the index, which is added below, so the
+				// index must be named now.
+				if idx.Name == "" {
+					var err error
+					if idx.Name, err = tabledesc.BuildIndexName(&desc, &idx); err != nil {
+						return nil, err
+					}
+				}
+				idx.Unique = false
+				deferrableUniqueIndexNames[d] = idx.Name
+			}
 			// Specifying a partitioning on a PRIMARY KEY constraint should be disallowed by the
 			// syntax, but do a sanity check.
 			if d.PrimaryKey && d.PartitionByIndex.ContainsPartitioningClause() {
@@ -2341,6 +2503,16 @@ func NewTableDesc(
 				); err != nil {
 					return nil, err
 				}
+			} else if name, ok := deferrableUniqueIndexNames[d]; ok {
+				idx, err := catalog.MustFindIndexByName(&desc, name)
+				if err != nil {
+					return nil, err
+				}
+				if err := addDeferrableUniqueIndexConstraint(
+					ctx, &desc, idx.IndexDesc(), d.Deferrable, NewTable, tree.ValidationDefault,
+				); err != nil {
+					return nil, err
+				}
 			}
 
 		case *tree.IndexTableDef, *tree.FamilyTableDef, *tree.LikeTableDef:
@@ -2516,6 +2688,16 @@ func newTableDesc(
 		n.Defs = newDefs
 	}
 
+	// Add the columns and CHECK constraints of the tables listed in the
+	// INHERITS clause.
+	inheritedDefs, parents, err := expandInheritedTableDefs(params, n)
+	if err != nil {
+		return nil, err
+	}
+	if inheritedDefs != nil {
+		n.Defs = inheritedDefs
+	}
+
 	// Process any SERIAL columns to remove the SERIAL type, as required by
 	// NewTableDesc.
 	colNameToOwnedSeq, err := createSequencesForSerialColumns(
@@ -2562,6 +2744,13 @@ func newTableDesc(
 		return nil, err
 	}
 
+	// Link the new table to its parents, which are written along with the other
+	// affected tables.
+	for _, parent := range parents {
+		addInheritanceLink(ret, parent)
+		affected[parent.ID] = parent
+	}
+
 	// We need to ensure sequence ownerships so that column owned sequences are
 	// correctly dropped when a column/table is dropped.
 	for colName, seqDesc := range colNameToOwnedSeq {
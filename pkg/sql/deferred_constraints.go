@@ -0,0 +1,504 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/semenumpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// constraintCheckMode is the mode of a DEFERRABLE constraint in a transaction,
+// as set by SET CONSTRAINTS.
+type constraintCheckMode uint8
+
+const (
+	// constraintCheckDefault means that the constraint is checked according to
+	// its INITIALLY DEFERRED / INITIALLY IMMEDIATE declaration.
+	constraintCheckDefault constraintCheckMode = iota
+	// constraintCheckImmediate means that the constraint is checked at the end
+	// of each statement.
+	constraintCheckImmediate
+	// constraintCheckDeferred means that the constraint is checked at the end
+	// of the transaction.
+	constraintCheckDeferred
+)
+
+// deferredConstraintKey identifies a constraint in a deferredConstraints.
+type deferredConstraintKey struct {
+	tableID descpb.ID
+	name    string
+}
+
+// deferredViolation is a violation of a constraint that was found by the check
+// of a statement while the constraint was deferred.
+type deferredViolation struct {
+	// keyVals contains the values of the constraint's columns in the violating
+	// row.
+	keyVals tree.Datums
+	// err is the error that the check returned for the violating row. It is
+	// returned if the violation still exists when it is revalidated.
+	err error
+}
+
+// pendingConstraint is a deferred constraint that must be revalidated before
+// the transaction commits.
+type pendingConstraint struct {
+	key deferredConstraintKey
+	// violations contains the violations found while the constraint was
+	// deferred. Only the rows with these key values are revalidated.
+	violations []deferredViolation
+}
+
+// deferredConstraints tracks the DEFERRABLE constraints of a SQL transaction.
+// It stores the modes set with SET CONSTRAINTS, and the constraints that were
+// violated while deferred and must be validated before the transaction
+// commits.
+//
+// The post-query checks of a statement can run in parallel, so all accesses
+// are protected by a mutex.
+type deferredConstraints struct {
+	mu struct {
+		syncutil.Mutex
+		deferredConstraintsState
+	}
+}
+
+// deferredConstraintsState is the state of a deferredConstraints. It is
+// captured when a savepoint is created, and restored when the transaction
+// rolls back to the savepoint.
+type deferredConstraintsState struct {
+	// allMode is the mode set by SET CONSTRAINTS ALL.
+	allMode constraintCheckMode
+	// modes contains the modes set for specific constraints, which take
+	// precedence over allMode.
+	modes map[deferredConstraintKey]constraintCheckMode
+	// pending contains the constraints that must be validated at commit, in
+	// the order in which they were first deferred.
+	pending []pendingConstraint
+}
+
+// reset clears all state at the end of a transaction.
+func (dc *deferredConstraints) reset() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.mu.deferredConstraintsState = deferredConstraintsState{}
+}
+
+// snapshot returns a copy of the current state, which can later be restored.
+func (dc *deferredConstraints) snapshot() deferredConstraintsState {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return dc.mu.deferredConstraintsState.clone()
+}
+
+// restore restores a state returned by snapshot. The modes set and the
+// violations found after the snapshot was taken are discarded. The state can
+// be restored multiple times.
+func (dc *deferredConstraints) restore(state deferredConstraintsState) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.mu.deferredConstraintsState = state.clone()
+}
+
+// clone returns a copy of the state that does not share memory with it.
+func (s *deferredConstraintsState) clone() deferredConstraintsState {
+	res := deferredConstraintsState{
+		allMode: s.allMode,
+		modes:   maps.Clone(s.modes),
+		pending: make([]pendingConstraint, len(s.pending)),
+	}
+	for i := range s.pending {
+		res.pending[i] = pendingConstraint{
+			key:        s.pending[i].key,
+			violations: slices.Clone(s.pending[i].violations),
+		}
+	}
+	return res
+}
+
+// setAll sets the mode of all DEFERRABLE constraints, overriding the modes set
+// for specific constraints.
+func (dc *deferredConstraints) setAll(mode constraintCheckMode) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	dc.mu.allMode = mode
+	dc.mu.modes = nil
+}
+
+// set sets the mode of the given constraints.
+func (dc *deferredConstraints) set(keys []deferredConstraintKey, mode constraintCheckMode) {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	if dc.mu.modes == nil {
+		dc.mu.modes = make(map[deferredConstraintKey]constraintCheckMode, len(keys))
+	}
+	for _, k := range keys {
+		dc.mu.modes[k] = mode
+	}
+}
+
+// maybeDefer is called when the check of a DEFERRABLE constraint found a
+// violating row. If the constraint is currently deferred, the violation is
+// recorded for validation at commit and maybeDefer returns true. Otherwise,
+// the violation must be reported immediately.
+func (dc *deferredConstraints) maybeDefer(e *exec.DeferrableConstraintError) bool {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	key := deferredConstraintKey{tableID: descpb.ID(e.TableID), name: e.Name}
+	mode, ok := dc.mu.modes[key]
+	if !ok {
+		mode = dc.mu.allMode
+	}
+	switch mode {
+	case constraintCheckImmediate:
+		return false
+	case constraintCheckDefault:
+		if !e.InitiallyDeferred {
+			return false
+		}
+	}
+	violation := deferredViolation{keyVals: e.KeyVals, err: e.Cause}
+	for i := range dc.mu.pending {
+		if dc.mu.pending[i].key == key {
+			dc.mu.pending[i].violations = append(dc.mu.pending[i].violations, violation)
+			return true
+		}
+	}
+	dc.mu.pending = append(dc.mu.pending, pendingConstraint{
+		key: key, violations: []deferredViolation{violation},
+	})
+	return true
+}
+
+// takePending removes and returns the pending constraints for which include
+// returns true. If include is nil, all pending constraints are returned.
+func (dc *deferredConstraints) takePending(
+	include func(deferredConstraintKey) bool,
+) []pendingConstraint {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	var taken, remaining []pendingConstraint
+	for _, pc := range dc.mu.pending {
+		if include == nil || include(pc.key) {
+			taken = append(taken, pc)
+		} else {
+			remaining = append(remaining, pc)
+		}
+	}
+	dc.mu.pending = remaining
+	return taken
+}
+
+// constraintDeferrability returns whether the given constraint is DEFERRABLE
+// and whether it is INITIALLY DEFERRED.
+func constraintDeferrability(c catalog.Constraint) (deferrable, initiallyDeferred bool) {
+	if fk := c.AsForeignKey(); fk != nil {
+		desc := fk.ForeignKeyDesc()
+		return desc.Deferrable, desc.InitiallyDeferred
+	}
+	if uwi := c.AsUniqueWithoutIndex(); uwi != nil {
+		desc := uwi.UniqueWithoutIndexDesc()
+		return desc.Deferrable, desc.InitiallyDeferred
+	}
+	return false, false
+}
+
+// validatePendingConstraints revalidates the rows that violated the given
+// constraints while their checks were deferred. The error of the original
+// check is returned for the first violation that still exists. Constraints
+// which have been dropped since are skipped.
+func validatePendingConstraints(
+	ctx context.Context, txn descs.Txn, user username.SQLUsername, pending []pendingConstraint,
+) error {
+	sessionDataOverride := sessiondata.NoSessionDataOverride
+	sessionDataOverride.User = user
+	for _, pc := range pending {
+		tbl, err := txn.Descriptors().ByIDWithoutLeased(txn.KV()).Get().Table(ctx, pc.key.tableID)
+		if err != nil {
+			return err
+		}
+		if tbl.Dropped() {
+			continue
+		}
+		c := catalog.FindConstraintByName(tbl, pc.key.name)
+		if c == nil {
+			continue
+		}
+		if uwi := c.AsUniqueWithoutIndex(); uwi != nil && uwi.IsExclusion() {
+			// A row that conflicts with a violating row under an exclusion
+			// constraint does not necessarily conflict with the other rows,
+			// so the whole table is validated.
+			if err := validateUniqueWithoutIndexConstraint(
+				ctx, tbl, uwi, 0 /* indexIDForValidation */, txn, user, true, /* preExisting */
+			); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, v := range pc.violations {
+			var query string
+			if fk := c.AsForeignKey(); fk != nil {
+				targetTable, err := txn.Descriptors().ByIDWithoutLeased(txn.KV()).Get().Table(
+					ctx, fk.GetReferencedTableID(),
+				)
+				if err != nil {
+					return err
+				}
+				if query, err = deferredFKViolationQuery(
+					tbl, targetTable, fk.ForeignKeyDesc(), v.keyVals,
+				); err != nil {
+					return err
+				}
+			} else if uwi := c.AsUniqueWithoutIndex(); uwi != nil {
+				if query, err = deferredUniqueViolationQuery(tbl, uwi); err != nil {
+					return err
+				}
+			} else {
+				continue
+			}
+			if query == "" {
+				continue
+			}
+			args := make([]interface{}, len(v.keyVals))
+			for i := range v.keyVals {
+				args[i] = v.keyVals[i]
+			}
+			row, err := txn.QueryRowEx(
+				ctx, "validate-deferred-constraint", txn.KV(), sessionDataOverride, query, args...,
+			)
+			if err != nil {
+				return err
+			}
+			if row != nil {
+				return v.err
+			}
+		}
+	}
+	return nil
+}
+
+// deferredFKViolationQuery returns a query that returns a row if a violation
+// of the given foreign key, found while the constraint was deferred, still
+// exists: i.e., if a row of the origin table still has the violating key values
+// and no row of the referenced table matches them. The key values are passed
+// as placeholders. An empty query is returned if the key values cannot violate
+// the constraint.
+func deferredFKViolationQuery(
+	srcTbl, targetTbl catalog.TableDescriptor, fk *descpb.ForeignKeyConstraint, keyVals tree.Datums,
+) (string, error) {
+	originColNames, err := catalog.ColumnNamesForIDs(srcTbl, fk.OriginColumnIDs)
+	if err != nil {
+		return "", err
+	}
+	referencedColNames, err := catalog.ColumnNamesForIDs(targetTbl, fk.ReferencedColumnIDs)
+	if err != nil {
+		return "", err
+	}
+	srcWhere := make([]string, 0, len(keyVals))
+	targetWhere := make([]string, 0, len(keyVals))
+	for i := range keyVals {
+		// The origin row may only violate a MATCH FULL constraint with NULL
+		// key values, so they are compared with IS NOT DISTINCT FROM.
+		srcWhere = append(srcWhere, fmt.Sprintf(
+			"s.%s IS NOT DISTINCT FROM $%d", tree.NameString(originColNames[i]), i+1,
+		))
+		if keyVals[i] == tree.DNull && fk.Match == semenumpb.Match_PARTIAL {
+			// Under MATCH PARTIAL, only the non-NULL columns must match.
+			continue
+		}
+		targetWhere = append(targetWhere, fmt.Sprintf(
+			"t.%s = $%d", tree.NameString(referencedColNames[i]), i+1,
+		))
+	}
+	if len(targetWhere) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf(
+		`SELECT 1 FROM [%d AS s] WHERE %s AND NOT EXISTS (SELECT 1 FROM [%d AS t] WHERE %s) LIMIT 1`,
+		srcTbl.GetID(), strings.Join(srcWhere, " AND "),
+		targetTbl.GetID(), strings.Join(targetWhere, " AND "),
+	), nil
+}
+
+// deferredUniqueViolationQuery returns a query that returns a row if a
+// violation of the given unique constraint, found while the constraint was
+// deferred, still exists: i.e., if more than one row still has the violating
+// key values. The key values are passed as placeholders.
+func deferredUniqueViolationQuery(
+	srcTbl catalog.TableDescriptor, uc catalog.UniqueWithoutIndexConstraint,
+) (string, error) {
+	colNames, err := catalog.ColumnNamesForIDs(srcTbl, uc.UniqueWithoutIndexDesc().ColumnIDs)
+	if err != nil {
+		return "", err
+	}
+	srcWhere := make([]string, 0, len(colNames)+1)
+	for i, n := range colNames {
+		srcWhere = append(srcWhere, fmt.Sprintf("%s = $%d", tree.NameString(n), i+1))
+	}
+	if pred := uc.GetPredicate(); pred != "" {
+		srcWhere = append(srcWhere, fmt.Sprintf("(%s)", pred))
+	}
+	return fmt.Sprintf(
+		`SELECT count(*) FROM [%d AS tbl] WHERE %s HAVING count(*) > 1`,
+		srcTbl.GetID(), strings.Join(srcWhere, " AND "),
+	), nil
+}
+
+// validateDeferredConstraints validates the constraints whose checks were
+// deferred until the end of the transaction. It must be called before the
+// transaction commits.
+func (ex *connExecutor) validateDeferredConstraints(ctx context.Context) error {
+	pending := ex.extraTxnState.deferredConstraints.takePending(nil /* include */)
+	if len(pending) == 0 {
+		return nil
+	}
+	return validatePendingConstraints(
+		ctx, ex.planner.InternalSQLTxn(), ex.planner.User(), pending,
+	)
+}
+
+// SetConstraints sets the checking mode of DEFERRABLE constraints for the
+// current transaction.
+// Privileges: None.
+//
+//	Notes: postgres does not require privileges either.
+func (p *planner) SetConstraints(ctx context.Context, n *tree.SetConstraints) (planNode, error) {
+	dc := p.extendedEvalCtx.deferredConstraints
+	if dc == nil {
+		return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+			"SET CONSTRAINTS is not supported in this context")
+	}
+	if p.extendedEvalCtx.TxnImplicit {
+		// Like postgres, warn and do nothing outside of an explicit transaction.
+		p.BufferClientNotice(ctx, pgerror.WithSeverity(pgerror.New(pgcode.NoActiveSQLTransaction,
+			"SET CONSTRAINTS can only be used in transaction blocks"), "WARNING"))
+		return newZeroNode(nil /* columns */), nil
+	}
+	mode := constraintCheckImmediate
+	if n.Deferred {
+		mode = constraintCheckDeferred
+	}
+
+	var keys []deferredConstraintKey
+	if len(n.Names) == 0 {
+		dc.setAll(mode)
+	} else {
+		var err error
+		if keys, err = p.resolveDeferrableConstraints(ctx, n.Names); err != nil {
+			return nil, err
+		}
+		dc.set(keys, mode)
+	}
+
+	if !n.Deferred {
+		// Changing the mode of a constraint to IMMEDIATE checks the pending
+		// violations of that constraint immediately, like in postgres.
+		var include func(deferredConstraintKey) bool
+		if keys != nil {
+			include = func(k deferredConstraintKey) bool {
+				for _, key := range keys {
+					if k == key {
+						return true
+					}
+				}
+				return false
+			}
+		}
+		pending := dc.takePending(include)
+		if err := validatePendingConstraints(ctx, p.InternalSQLTxn(), p.User(), pending); err != nil {
+			return nil, err
+		}
+	}
+	return newZeroNode(nil /* columns */), nil
+}
+
+// resolveDeferrableConstraints resolves the constraint names of a SET
+// CONSTRAINTS statement. Unqualified names are looked up in all the schemas of
+// the search path of the current database.
+func (p *planner) resolveDeferrableConstraints(
+	ctx context.Context, names tree.TableNames,
+) ([]deferredConstraintKey, error) {
+	db, err := p.Descriptors().ByNameWithLeased(p.txn).Get().Database(ctx, p.CurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	tables, err := p.Descriptors().GetAllTablesInDatabase(ctx, p.txn, db)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []deferredConstraintKey
+	for i := range names {
+		name := &names[i]
+		var scNames []string
+		if name.ExplicitSchema {
+			scNames = []string{name.Schema()}
+		} else {
+			iter := p.SessionData().SearchPath.Iter()
+			for scName, ok := iter.Next(); ok; scName, ok = iter.Next() {
+				scNames = append(scNames, scName)
+			}
+		}
+		var scIDs catalog.DescriptorIDSet
+		for _, scName := range scNames {
+			sc, err := p.Descriptors().ByNameWithLeased(p.txn).MaybeGet().Schema(ctx, db, scName)
+			if err != nil {
+				return nil, err
+			}
+			if sc == nil {
+				if name.ExplicitSchema {
+					return nil, pgerror.Newf(pgcode.InvalidSchemaName, "schema %q does not exist", scName)
+				}
+				continue
+			}
+			scIDs.Add(sc.GetID())
+		}
+
+		found := false
+		if err := tables.ForEachDescriptor(func(desc catalog.Descriptor) error {
+			tbl, err := catalog.AsTableDescriptor(desc)
+			if err != nil {
+				return err
+			}
+			if tbl.Dropped() || !scIDs.Contains(tbl.GetParentSchemaID()) {
+				return nil
+			}
+			c := catalog.FindConstraintByName(tbl, string(name.ObjectName))
+			if c == nil {
+				return nil
+			}
+			found = true
+			if deferrable, _ := constraintDeferrability(c); !deferrable {
+				return pgerror.Newf(pgcode.WrongObjectType,
+					"constraint %q is not deferrable", c.GetName())
+			}
+			keys = append(keys, deferredConstraintKey{tableID: tbl.GetID(), name: c.GetName()})
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, pgerror.Newf(pgcode.UndefinedObject,
+				"constraint %q does not exist", string(name.ObjectName))
+		}
+	}
+	return keys, nil
+}
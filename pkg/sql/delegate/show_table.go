@@ -248,6 +248,7 @@ func (d *delegator) delegateShowConstraints(n *tree.ShowConstraints) (tree.State
            WHEN 'p' THEN 'PRIMARY KEY'
            WHEN 'u' THEN 'UNIQUE'
            WHEN 'c' THEN 'CHECK'
+           WHEN 'x' THEN 'EXCLUDE'
            WHEN 'f' THEN 'FOREIGN KEY'
            ELSE c.contype::TEXT
         END AS constraint_type,
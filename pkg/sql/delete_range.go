@@ -102,6 +102,11 @@ func (d *deleteRangeNode) startExec(params runParams) error {
 	}
 
 	ctx := params.ctx
+	if err := acquireDMLTableLock(
+		ctx, params.p.txn, params.ExecCfg().Codec, d.desc, params.p.SessionData(),
+	); err != nil {
+		return err
+	}
 	log.VEvent(ctx, 2, "fast delete: skipping scan")
 	// TODO(yuzefovich): why are we making a copy of spans?
 	spans := make([]roachpb.Span, len(d.spans))
@@ -63,6 +63,11 @@ func (n *discardNode) startExec(params runParams) error {
 			return err
 		}
 
+		// UNLISTEN *
+		if sn := params.p.extendedEvalCtx.notifications; sn != nil {
+			sn.listenActions = append(sn.listenActions, listenAction{unlisten: true})
+		}
+
 	case tree.DiscardModeSequences:
 		params.p.sessionDataMutatorIterator.ApplyOnEachMutator(func(m sessionmutator.SessionDataMutator) {
 			m.Data.SequenceState = sessiondata.NewSequenceState()
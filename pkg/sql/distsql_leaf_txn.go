@@ -279,6 +279,7 @@ func planSafeForReducedLeaf(processors []physicalplan.Processor) bool {
 			return unsafeCore
 		case core.CompactBackups != nil:
 			return unoptimizedProcessor
+		case core.ForeignScan != nil: // always safe
 		default:
 			if buildutil.CrdbTestBuild {
 				panic(errors.AssertionFailedf("unknown processor core"))
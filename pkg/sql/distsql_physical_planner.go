@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"time"
@@ -476,6 +477,7 @@ func (dsp *DistSQLPlanner) mustWrapNode(planCtx *PlanningCtx, node planNode) boo
 	case *distinctNode:
 	case *exportNode:
 	case *filterNode:
+	case *foreignScanNode:
 	case *groupNode:
 	case *indexJoinNode:
 	case *invertedFilterNode:
@@ -566,6 +568,12 @@ func checkSupportForPlanNode(
 		}
 		return checkSupportForPlanNode(ctx, n.input, distSQLVisitor, sd, txnHasBufferedWrites)
 
+	case *foreignScanNode:
+		// There are no statistics for foreign tables, and their files are read
+		// in parallel by all nodes, so distribution is recommended.
+		log.VEventf(ctx, 2, "foreign table scan recommends plan distribution")
+		return shouldDistribute, nil
+
 	case *groupNode:
 		rec, err := checkSupportForPlanNode(ctx, n.input, distSQLVisitor, sd, txnHasBufferedWrites)
 		if err != nil {
@@ -575,6 +583,18 @@ func checkSupportForPlanNode(
 			if agg.distsqlBlocklist {
 				return cannotDistribute, newQueryNotSupportedErrorf("aggregate %q cannot be executed with distsql", agg.funcName)
 			}
+			if ud := agg.userDefined; ud != nil {
+				// The component functions of a user-defined aggregate are
+				// evaluated by every aggregator that runs a stage of it. The
+				// aggregate can be distributed, and evaluated in two stages if it
+				// has a combine function, only if they are builtins, since
+				// routines cannot be executed remotely.
+				for _, expr := range []tree.TypedExpr{ud.Transition, ud.Final, ud.Combine} {
+					if err := checkExprForDistSQL(expr, distSQLVisitor); err != nil {
+						return cannotDistribute, err
+					}
+				}
+			}
 		}
 		// Don't force distribution if we expect to process small number of
 		// rows.
@@ -2139,6 +2159,13 @@ func initTableReaderSpecTemplate(
 		return nil, execinfrapb.PostProcessSpec{}, err
 	}
 
+	if n.sampleMethod != tree.TableSampleNone {
+		s.SampleRows = true
+		s.SampleProbability = n.sampleProbability
+		s.SampleSeed = n.sampleSeed
+		s.SampleBlocks = n.sampleMethod == tree.TableSampleSystem
+	}
+
 	var post execinfrapb.PostProcessSpec
 	if n.hardLimit != 0 {
 		post.Limit = uint64(n.hardLimit)
@@ -2158,6 +2185,13 @@ func (dsp *DistSQLPlanner) createTableReaders(
 		return nil, err
 	}
 
+	spans := n.spans
+	if n.sampleMethod == tree.TableSampleSystem {
+		if spans, err = dsp.sampleRanges(ctx, planCtx, n, spec); err != nil {
+			return nil, err
+		}
+	}
+
 	p := planCtx.NewPhysicalPlan()
 	err = dsp.planTableReaders(
 		ctx,
@@ -2167,7 +2201,7 @@ func (dsp *DistSQLPlanner) createTableReaders(
 			spec:                spec,
 			post:                post,
 			desc:                n.desc,
-			spans:               n.spans,
+			spans:               spans,
 			reverse:             n.reverse,
 			parallelize:         n.parallelize,
 			estimatedRowCount:   n.estimatedRowCount,
@@ -2179,6 +2213,87 @@ func (dsp *DistSQLPlanner) createTableReaders(
 	return p, err
 }
 
+// minSampledRanges is the number of ranges that TABLESAMPLE SYSTEM aims to
+// scan. If the scan covers too few ranges for a sample of the requested
+// probability to include that many, more ranges are scanned, and only some of
+// their rows are returned, so that small tables aren't sampled all or nothing.
+const minSampledRanges = 8
+
+// sampledRange is the part of a scanned span within a single range.
+type sampledRange struct {
+	// rangeStart is the start key of the range.
+	rangeStart roachpb.RKey
+	// span is the part of the scanned span within the range.
+	span roachpb.Span
+}
+
+// sampleRanges implements TABLESAMPLE SYSTEM. The spans of the scan are split
+// at range boundaries, as read from the range descriptors, and only the spans
+// of the sampled ranges are scanned, so the unsampled ranges are never read.
+// The TableReaders return the rows of the scanned ranges with the probability
+// in the spec, which sampleRanges adjusts so that each row is returned with
+// the requested probability (see chooseSampledRanges).
+//
+// Whether a range is sampled depends on the seed and its start key, so the
+// same rows are sampled for the same seed as long as the range boundaries
+// don't change.
+func (dsp *DistSQLPlanner) sampleRanges(
+	ctx context.Context, planCtx *PlanningCtx, n *scanNode, spec *execinfrapb.TableReaderSpec,
+) (roachpb.Spans, error) {
+	it := planCtx.spanIter
+	var ranges []sampledRange
+	for _, sp := range n.spans {
+		for it.Seek(ctx, sp, kvcoord.Ascending); ; it.Next(ctx) {
+			if !it.Valid() {
+				return nil, it.Error()
+			}
+			desc := it.Desc()
+			piece := sp
+			if start := desc.StartKey.AsRawKey(); start.Compare(piece.Key) > 0 {
+				piece.Key = start
+			}
+			if end := desc.EndKey.AsRawKey(); len(piece.EndKey) != 0 && end.Compare(piece.EndKey) < 0 {
+				piece.EndKey = end
+			}
+			ranges = append(ranges, sampledRange{rangeStart: desc.StartKey, span: piece})
+			if !it.NeedAnother() {
+				break
+			}
+		}
+	}
+	sampler := spec.MakeRowSampler()
+	spans, rowProbability := chooseSampledRanges(ranges, &sampler, spec.SampleProbability)
+	spec.SampleProbability = rowProbability
+	return spans, nil
+}
+
+// chooseSampledRanges returns the spans of the sampled ranges among the given
+// ones, in order, and the probability with which the rows of the sampled
+// ranges must be returned for each row to be returned with the given
+// probability overall. Each range is sampled with probability at least
+// probability, and high enough to expect minSampledRanges sampled ranges.
+//
+// If no range is sampled, the span of the first range is returned along with a
+// row probability of zero, so that the plan still has a TableReader.
+func chooseSampledRanges(
+	ranges []sampledRange, sampler *execinfrapb.RowSampler, probability float64,
+) (_ roachpb.Spans, rowProbability float64) {
+	if len(ranges) == 0 {
+		return nil, probability
+	}
+	rangeProbability := math.Min(1, math.Max(probability, minSampledRanges/float64(len(ranges))))
+	var spans roachpb.Spans
+	for _, r := range ranges {
+		if sampler.SampleRange(r.rangeStart, rangeProbability) {
+			spans = append(spans, r.span)
+		}
+	}
+	if len(spans) == 0 {
+		return roachpb.Spans{ranges[0].span}, 0
+	}
+	return spans, probability / rangeProbability
+}
+
 // tableReaderPlanningInfo is a utility struct that contains the information
 // needed to perform the physical planning of table readers once the specs have
 // been created. See scanNode to get more context on some of the fields.
@@ -2549,6 +2664,13 @@ type aggregatorPlanningInfo struct {
 	allowPartialDistribution bool
 	estimatedRowCount        uint64
 	finalizeLastStageCb      func(*physicalplan.PhysicalPlan) // will be nil in the spec factory
+
+	// groupingSets and groupingFuncs, if set, contain the grouping sets and
+	// the arguments of the GROUPING() functions (see groupNode). In that case,
+	// the aggregations start with an ANY_NOT_NULL aggregation over each of the
+	// groupCols.
+	groupingSets  [][]exec.NodeColumnOrdinal
+	groupingFuncs [][]exec.NodeColumnOrdinal
 }
 
 // addAggregators adds aggregators corresponding to a groupNode and updates the plan to
@@ -2559,11 +2681,22 @@ func (dsp *DistSQLPlanner) addAggregators(
 	aggregations := make([]execinfrapb.AggregatorSpec_Aggregation, len(n.funcs))
 	argumentsColumnTypes := make([][]*types.T, len(n.funcs))
 	for i, fholder := range n.funcs {
-		funcIdx, err := execinfrapb.GetAggregateFuncIdx(fholder.funcName)
-		if err != nil {
-			return err
+		if fholder.userDefined != nil {
+			aggregations[i].Func = execinfrapb.UserDefined
+			var err error
+			aggregations[i].UserDefined, err = makeUserDefinedAggregationSpec(
+				ctx, planCtx, fholder.userDefined, fholder.resultType,
+			)
+			if err != nil {
+				return err
+			}
+		} else {
+			funcIdx, err := execinfrapb.GetAggregateFuncIdx(fholder.funcName)
+			if err != nil {
+				return err
+			}
+			aggregations[i].Func = execinfrapb.AggregatorSpec_Func(funcIdx)
 		}
-		aggregations[i].Func = execinfrapb.AggregatorSpec_Func(funcIdx)
 		aggregations[i].Distinct = fholder.isDistinct
 		for _, renderIdx := range fholder.argRenderIdxs {
 			aggregations[i].ColIdx = append(aggregations[i].ColIdx, uint32(p.PlanToStreamColMap[renderIdx]))
@@ -2592,6 +2725,8 @@ func (dsp *DistSQLPlanner) addAggregators(
 		isScalar:             n.isScalar,
 		groupCols:            n.groupCols,
 		groupColOrdering:     n.groupColOrdering,
+		groupingSets:         n.groupingSets,
+		groupingFuncs:        n.groupingFuncs,
 		inputMergeOrdering:   dsp.convertOrdering(planReqOrdering(n.input), p.PlanToStreamColMap),
 		reqOrdering:          n.reqOrdering,
 		estimatedRowCount:    n.estimatedRowCount,
@@ -2599,6 +2734,81 @@ func (dsp *DistSQLPlanner) addAggregators(
 	})
 }
 
+// userDefinedDistAggregationInfo describes the multi-stage evaluation of a
+// user-defined aggregate with a combine function: the local stage accumulates
+// the state, and the final stage merges the states with the combine function.
+var userDefinedDistAggregationInfo = physicalplan.DistAggregationInfo{
+	LocalStage: []execinfrapb.AggregatorSpec_Func{execinfrapb.UserDefined},
+	FinalStage: []physicalplan.FinalStageInfo{
+		{
+			Fn:        execinfrapb.UserDefined,
+			LocalIdxs: []uint32{0},
+		},
+	},
+}
+
+// distAggregationInfo returns the blueprint for planning the given
+// aggregation in multiple stages, or false if it does not support a local
+// stage.
+func distAggregationInfo(
+	agg *execinfrapb.AggregatorSpec_Aggregation,
+) (physicalplan.DistAggregationInfo, bool) {
+	if agg.Func == execinfrapb.UserDefined {
+		return userDefinedDistAggregationInfo, !agg.UserDefined.Combine.Empty()
+	}
+	info, ok := physicalplan.DistAggregationTable[agg.Func]
+	return info, ok
+}
+
+// userDefinedAggregationStage returns a copy of the given user-defined
+// aggregation which evaluates the given stage.
+func userDefinedAggregationStage(
+	agg *execinfrapb.AggregatorSpec_UserDefinedAggregation,
+	stage execinfrapb.AggregatorSpec_UserDefinedAggregation_Stage,
+) *execinfrapb.AggregatorSpec_UserDefinedAggregation {
+	res := *agg
+	res.Stage = stage
+	return &res
+}
+
+// getAggregationOutputType returns the output type of the given aggregation
+// when applied on the given types.
+func getAggregationOutputType(
+	agg *execinfrapb.AggregatorSpec_Aggregation, argTypes []*types.T,
+) (*types.T, error) {
+	if agg.UserDefined != nil {
+		return agg.UserDefined.OutputType(), nil
+	}
+	return execagg.GetAggregateOutputType(agg.Func, argTypes)
+}
+
+// makeUserDefinedAggregationSpec returns the specification of a user-defined
+// aggregate with the given result type.
+func makeUserDefinedAggregationSpec(
+	ctx context.Context, planCtx *PlanningCtx, info *exec.UserDefinedAggInfo, resultType *types.T,
+) (*execinfrapb.AggregatorSpec_UserDefinedAggregation, error) {
+	spec := &execinfrapb.AggregatorSpec_UserDefinedAggregation{
+		StateType:     info.StateType,
+		ResultType:    resultType,
+		InitCond:      info.InitCond,
+		Strict:        info.Strict,
+		CombineStrict: info.CombineStrict,
+	}
+	var ef physicalplan.ExprFactory
+	ef.Init(ctx, planCtx, nil /* indexVarMap */)
+	var err error
+	if spec.Transition, err = ef.Make(info.Transition); err != nil {
+		return nil, err
+	}
+	if spec.Final, err = ef.Make(info.Final); err != nil {
+		return nil, err
+	}
+	if spec.Combine, err = ef.Make(info.Combine); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
 // planAggregators plans the aggregator processors. An evaluator stage is added
 // if necessary.
 // Invariants assumed:
@@ -2630,12 +2840,28 @@ func (dsp *DistSQLPlanner) planAggregators(
 	var argTypes []*types.T
 
 	groupCols := make([]uint32, len(info.groupCols))
+	// groupColOrdinals are the plan column ordinals of the groupCols, or -1 for
+	// the virtual grouping set columns.
+	groupColOrdinals := make([]int, len(info.groupCols))
 	for i, idx := range info.groupCols {
 		groupCols[i] = uint32(p.PlanToStreamColMap[idx])
+		groupColOrdinals[i] = int(idx)
 	}
-	orderedGroupCols := make([]uint32, len(info.groupColOrdering))
+	groupColOrdering := info.groupColOrdering
+	var groupingSets, groupingFuncs []execinfrapb.AggregatorSpec_ColumnSet
+	if info.groupingSets != nil {
+		groupingSets, groupingFuncs, inputTypes, groupCols = planGroupingSets(p, info, inputTypes, groupCols)
+		groupColOrdinals = make([]int, len(groupCols))
+		for i := range groupColOrdinals {
+			groupColOrdinals[i] = -1
+		}
+		// Each input row is expanded once for each grouping set, so the
+		// aggregation over the virtual grouping set columns is never streaming.
+		groupColOrdering = nil
+	}
+	orderedGroupCols := make([]uint32, len(groupColOrdering))
 	var orderedGroupColSet intsets.Fast
-	for i, c := range info.groupColOrdering {
+	for i, c := range groupColOrdering {
 		orderedGroupCols[i] = uint32(p.PlanToStreamColMap[c.ColIdx])
 		orderedGroupColSet.Add(c.ColIdx)
 	}
@@ -2658,6 +2884,8 @@ func (dsp *DistSQLPlanner) planAggregators(
 	//      TODO(yuzefovich): we could consider lifting the condition 5. by
 	//      changing the distribution of the hash joiner stager.
 	planHashGroupJoin := planCtx.ExtendedEvalCtx.SessionData().ExperimentalHashGroupJoinEnabled
+	// The hash group-joiner does not support grouping sets.
+	planHashGroupJoin = planHashGroupJoin && groupingSets == nil
 	if planHashGroupJoin { // condition 1.
 		planHashGroupJoin = func() bool {
 			prevStageProc := p.Processors[p.ResultRouters[0]].Spec
@@ -2721,8 +2949,9 @@ func (dsp *DistSQLPlanner) planAggregators(
 	}
 
 	// We can have a local stage of distinct processors if all aggregation
-	// functions are distinct.
-	allDistinct := true
+	// functions are distinct. This is not the case with grouping sets, whose
+	// grouping columns are aggregated with ANY_NOT_NULL.
+	allDistinct := groupingSets == nil
 	for _, e := range info.aggregations {
 		if !e.Distinct {
 			allDistinct = false
@@ -2799,7 +3028,7 @@ func (dsp *DistSQLPlanner) planAggregators(
 				break
 			}
 			// Check that the function supports a local stage.
-			if _, ok := physicalplan.DistAggregationTable[e.Func]; !ok {
+			if _, ok := distAggregationInfo(&e); !ok {
 				multiStage = false
 				break
 			}
@@ -2825,6 +3054,8 @@ func (dsp *DistSQLPlanner) planAggregators(
 			GroupCols:        groupCols,
 			OrderedGroupCols: orderedGroupCols,
 			OutputOrdering:   finalOutputOrdering,
+			GroupingSets:     groupingSets,
+			GroupingFuncs:    groupingFuncs,
 		}
 	} else {
 		// Some aggregations might need multiple aggregation as part of
@@ -2838,8 +3069,8 @@ func (dsp *DistSQLPlanner) planAggregators(
 		nLocalAgg := 0
 		nFinalAgg := 0
 		needRender := false
-		for _, e := range info.aggregations {
-			info := physicalplan.DistAggregationTable[e.Func]
+		for i := range info.aggregations {
+			info, _ := distAggregationInfo(&info.aggregations[i])
 			nLocalAgg += len(info.LocalStage)
 			nFinalAgg += len(info.FinalStage)
 			if info.FinalRendering != nil {
@@ -2874,7 +3105,7 @@ func (dsp *DistSQLPlanner) planAggregators(
 		// to all final aggregations.
 		finalIdx := 0
 		for _, e := range info.aggregations {
-			info := physicalplan.DistAggregationTable[e.Func]
+			info, _ := distAggregationInfo(&e)
 
 			// relToAbsLocalIdx maps each local stage for the given
 			// aggregation e to its final index in localAggs.  This
@@ -2896,6 +3127,11 @@ func (dsp *DistSQLPlanner) planAggregators(
 					ColIdx:       e.ColIdx,
 					FilterColIdx: e.FilterColIdx,
 				}
+				if e.UserDefined != nil {
+					localAgg.UserDefined = userDefinedAggregationStage(
+						e.UserDefined, execinfrapb.AggregatorSpec_UserDefinedAggregation_PARTIAL,
+					)
+				}
 
 				isNewAgg := true
 				for j, prevLocalAgg := range localAggs {
@@ -2922,7 +3158,7 @@ func (dsp *DistSQLPlanner) planAggregators(
 					for _, c := range e.ColIdx {
 						argTypes = append(argTypes, inputTypes[c])
 					}
-					outputType, err := execagg.GetAggregateOutputType(localFunc, argTypes)
+					outputType, err := getAggregationOutputType(&localAgg, argTypes)
 					if err != nil {
 						return err
 					}
@@ -2946,6 +3182,11 @@ func (dsp *DistSQLPlanner) planAggregators(
 					Func:   finalInfo.Fn,
 					ColIdx: argIdxs,
 				}
+				if e.UserDefined != nil {
+					finalAgg.UserDefined = userDefinedAggregationStage(
+						e.UserDefined, execinfrapb.AggregatorSpec_UserDefinedAggregation_FINAL,
+					)
+				}
 
 				isNewAgg := true
 				for i, prevFinalAgg := range finalAggs {
@@ -2973,7 +3214,7 @@ func (dsp *DistSQLPlanner) planAggregators(
 							// types for the current aggregation e.
 							argTypes = append(argTypes, intermediateTypes[argIdxs[i]])
 						}
-						outputType, err := execagg.GetAggregateOutputType(finalInfo.Fn, argTypes)
+						outputType, err := getAggregationOutputType(&finalAgg, argTypes)
 						if err != nil {
 							return err
 						}
@@ -3011,19 +3252,19 @@ func (dsp *DistSQLPlanner) planAggregators(
 				intermediateTypes = append(intermediateTypes, inputTypes[groupColIdx])
 			}
 			finalGroupCols[i] = uint32(idx)
-			if orderedGroupColSet.Contains(int(info.groupCols[i])) {
+			if groupColOrdinals[i] >= 0 && orderedGroupColSet.Contains(groupColOrdinals[i]) {
 				finalOrderedGroupCols = append(finalOrderedGroupCols, uint32(idx))
 			}
 		}
 
 		// Create the merge ordering for the local stage (this will be maintained
 		// for results going into the final stage).
-		ordCols := make([]execinfrapb.Ordering_Column, len(info.groupColOrdering))
-		for i, o := range info.groupColOrdering {
+		ordCols := make([]execinfrapb.Ordering_Column, len(groupColOrdering))
+		for i, o := range groupColOrdering {
 			// Find the group column.
 			found := false
-			for j, col := range info.groupCols {
-				if int(col) == o.ColIdx {
+			for j, col := range groupColOrdinals {
+				if col == o.ColIdx {
 					ordCols[i].ColIdx = finalGroupCols[j]
 					found = true
 					break
@@ -3039,12 +3280,17 @@ func (dsp *DistSQLPlanner) planAggregators(
 			}
 		}
 
+		// The grouping sets are expanded in the local stage; the final stage
+		// groups the local results on the grouping level and the virtual
+		// grouping columns like a regular aggregation.
 		localAggsSpec := execinfrapb.AggregatorSpec{
 			Type:             aggType,
 			Aggregations:     localAggs,
 			GroupCols:        groupCols,
 			OrderedGroupCols: orderedGroupCols,
 			OutputOrdering:   execinfrapb.Ordering{Columns: ordCols},
+			GroupingSets:     groupingSets,
+			GroupingFuncs:    groupingFuncs,
 		}
 
 		if planHashGroupJoin {
@@ -3092,8 +3338,8 @@ func (dsp *DistSQLPlanner) planAggregators(
 			finalIdx := 0
 			var ef physicalplan.ExprFactory
 			ef.Init(ctx, planCtx, nil /* indexVarMap */)
-			for i, e := range info.aggregations {
-				info := physicalplan.DistAggregationTable[e.Func]
+			for i := range info.aggregations {
+				info, _ := distAggregationInfo(&info.aggregations[i])
 				if info.FinalRendering == nil {
 					// mappedIdx corresponds to the index
 					// location of the result for this
@@ -3140,13 +3386,14 @@ func (dsp *DistSQLPlanner) planAggregators(
 	// Set up the final stage.
 
 	finalOutTypes := make([]*types.T, len(info.aggregations))
-	for i, agg := range info.aggregations {
+	for i := range info.aggregations {
+		agg := &info.aggregations[i]
 		argTypes = argTypes[:0]
 		for _, c := range agg.ColIdx {
 			argTypes = append(argTypes, inputTypes[c])
 		}
 		argTypes = append(argTypes, info.argumentsColumnTypes[i]...)
-		returnTyp, err := execagg.GetAggregateOutputType(agg.Func, argTypes)
+		returnTyp, err := getAggregationOutputType(agg, argTypes)
 		if err != nil {
 			return err
 		}
@@ -3173,10 +3420,14 @@ func (dsp *DistSQLPlanner) planAggregators(
 			finalOutTypes,
 			dsp.convertOrdering(info.reqOrdering, p.PlanToStreamColMap),
 		)
-	} else if len(finalAggsSpec.GroupCols) == 0 || len(p.ResultRouters) == 1 {
-		// No GROUP BY, or we have a single stream. Use a single final aggregator.
-		// If the previous stage was all on a single node, put the final
-		// aggregator there. Otherwise, bring the results back on this node.
+	} else if len(finalAggsSpec.GroupCols) == 0 || len(p.ResultRouters) == 1 ||
+		finalAggsSpec.GroupingSets != nil {
+		// No GROUP BY, or we have a single stream, or a single-stage
+		// aggregation over grouping sets (whose grouping columns are not part
+		// of the input stream, so it cannot be distributed by them). Use a
+		// single final aggregator. If the previous stage was all on a single
+		// node, put the final aggregator there. Otherwise, bring the results
+		// back on this node.
 		node := dsp.gatewaySQLInstanceID
 		if prevStageNode != 0 {
 			node = prevStageNode
@@ -3253,6 +3504,67 @@ func (dsp *DistSQLPlanner) planAggregators(
 	return nil
 }
 
+// planGroupingSets returns the grouping sets and GROUPING() functions of the
+// aggregator specification for the given planning info, as well as the types
+// of the input rows extended with the virtual grouping set columns and the
+// grouping columns, which are the grouping level and the virtual grouping
+// columns (see execinfrapb.AggregatorSpec). The ANY_NOT_NULL aggregations over
+// the grouping columns are remapped to the virtual grouping columns, which are
+// NULL for the grouping sets that do not contain them, and an aggregation is
+// added for each of the GROUPING() functions.
+func planGroupingSets(
+	p *PhysicalPlan, info *aggregatorPlanningInfo, inputTypes []*types.T, inputGroupCols []uint32,
+) (
+	groupingSets, groupingFuncs []execinfrapb.AggregatorSpec_ColumnSet,
+	extendedTypes []*types.T,
+	groupCols []uint32,
+) {
+	toStreamCols := func(cols []exec.NodeColumnOrdinal) []uint32 {
+		res := make([]uint32, len(cols))
+		for i, c := range cols {
+			res[i] = uint32(p.PlanToStreamColMap[c])
+		}
+		return res
+	}
+	groupingSets = make([]execinfrapb.AggregatorSpec_ColumnSet, len(info.groupingSets))
+	for i, set := range info.groupingSets {
+		groupingSets[i].Cols = toStreamCols(set)
+	}
+	groupingFuncs = make([]execinfrapb.AggregatorSpec_ColumnSet, len(info.groupingFuncs))
+	for i, args := range info.groupingFuncs {
+		groupingFuncs[i].Cols = toStreamCols(args)
+	}
+	spec := execinfrapb.AggregatorSpec{GroupingSets: groupingSets, GroupingFuncs: groupingFuncs}
+	groupingSetsCols := spec.GroupingSetsCols()
+
+	numInputCols := len(inputTypes)
+	extendedTypes = make([]*types.T, 0, numInputCols+spec.NumGroupingSetsCols())
+	extendedTypes = append(extendedTypes, inputTypes...)
+	for i := 0; i < 1+len(groupingFuncs); i++ {
+		extendedTypes = append(extendedTypes, types.Int)
+	}
+	firstGroupingCol := len(extendedTypes)
+	groupCols = make([]uint32, 0, 1+len(groupingSetsCols))
+	groupCols = append(groupCols, uint32(numInputCols))
+	for i, c := range groupingSetsCols {
+		extendedTypes = append(extendedTypes, inputTypes[c])
+		groupCols = append(groupCols, uint32(firstGroupingCol+i))
+	}
+
+	for i, c := range inputGroupCols {
+		idx := sort.Search(len(groupingSetsCols), func(j int) bool { return groupingSetsCols[j] >= c })
+		info.aggregations[i].ColIdx = []uint32{uint32(firstGroupingCol + idx)}
+	}
+	for i := range groupingFuncs {
+		info.aggregations = append(info.aggregations, execinfrapb.AggregatorSpec_Aggregation{
+			Func:   execinfrapb.AnyNotNull,
+			ColIdx: []uint32{uint32(numInputCols + 1 + i)},
+		})
+		info.argumentsColumnTypes = append(info.argumentsColumnTypes, nil)
+	}
+	return groupingSets, groupingFuncs, extendedTypes, groupCols
+}
+
 func (dsp *DistSQLPlanner) createPlanForIndexJoin(
 	ctx context.Context, planCtx *PlanningCtx, n *indexJoinNode,
 ) (*PhysicalPlan, error) {
@@ -3507,6 +3819,12 @@ func (dsp *DistSQLPlanner) planLookupJoin(
 		}
 	}
 
+	// A join reader that switches to a hash join scans the whole lookup index,
+	// so only a single join reader may switch.
+	if len(p.ResultRouters) == 1 {
+		joinReaderSpec.AdaptiveLookupRowCount = planInfo.estimatedTableRowCount
+	}
+
 	// Instantiate one join reader for every stream. This is also necessary for
 	// correctness of paired-joins where this join is the second join -- it is
 	// necessary to have a one-to-one relationship between the first and second
@@ -4057,6 +4375,9 @@ func (dsp *DistSQLPlanner) createPhysPlanForPlanNode(
 			return nil, err
 		}
 
+	case *foreignScanNode:
+		plan, err = dsp.createPlanForForeignScan(ctx, planCtx, n)
+
 	case *groupNode:
 		plan, err = dsp.createPhysPlanForPlanNode(ctx, planCtx, n.input)
 		if err != nil {
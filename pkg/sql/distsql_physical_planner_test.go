@@ -48,6 +48,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/testutils/skip"
 	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
 	"github.com/cockroachdb/cockroach/pkg/util"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
 	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
 	"github.com/cockroachdb/cockroach/pkg/util/log"
@@ -2092,3 +2093,85 @@ func TestClosestInstances(t *testing.T) {
 		})
 	}
 }
+
+// TestChooseSampledRanges verifies that TABLESAMPLE SYSTEM returns about the
+// requested fraction of the rows of tables with rowid and string primary keys,
+// whose keys share long prefixes, whether the table has few ranges or many.
+func TestChooseSampledRanges(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	const numRows = 10000
+	const probability = 0.3
+	prefix := roachpb.Key("\xf0\x89")
+	// The unique_rowid() values generated by a few nodes within a short time
+	// only differ in their last bytes.
+	rowidKeys := make([]roachpb.Key, numRows)
+	const timestamp = 176_000_000_000_000
+	for i := range rowidKeys {
+		rowid := (timestamp+int64(i/4))<<15 | int64(i%4+1)
+		rowidKeys[i] = encoding.EncodeVarintAscending(prefix.Clone(), rowid)
+	}
+	stringKeys := make([]roachpb.Key, numRows)
+	for i := range stringKeys {
+		stringKeys[i] = encoding.EncodeStringAscending(prefix.Clone(), fmt.Sprintf("customer-%08d", i))
+	}
+
+	for _, tc := range []struct {
+		name string
+		keys []roachpb.Key
+	}{
+		{name: "rowid", keys: rowidKeys},
+		{name: "string", keys: stringKeys},
+	} {
+		// With few ranges, all ranges are scanned and the rows are sampled by
+		// their keys. With many ranges, whole ranges are sampled.
+		for _, numRanges := range []int{1, 4, 1000} {
+			t.Run(fmt.Sprintf("%s/ranges=%d", tc.name, numRanges), func(t *testing.T) {
+				ranges := make([]sampledRange, numRanges)
+				for i := range ranges {
+					start, end := prefix, prefix.PrefixEnd()
+					if i > 0 {
+						start = tc.keys[i*numRows/numRanges]
+					}
+					if i < numRanges-1 {
+						end = tc.keys[(i+1)*numRows/numRanges]
+					}
+					ranges[i] = sampledRange{
+						rangeStart: roachpb.RKey(start),
+						span:       roachpb.Span{Key: start, EndKey: end},
+					}
+				}
+				spec := execinfrapb.TableReaderSpec{
+					SampleRows:        true,
+					SampleProbability: probability,
+					SampleSeed:        42,
+					SampleBlocks:      true,
+				}
+				sampler := spec.MakeRowSampler()
+				spans, rowProbability := chooseSampledRanges(ranges, &sampler, probability)
+				if numRanges < minSampledRanges {
+					require.Len(t, spans, numRanges)
+					require.Equal(t, probability, rowProbability)
+				} else {
+					require.Less(t, len(spans), numRanges/2)
+					require.Equal(t, 1.0, rowProbability)
+				}
+
+				spec.SampleProbability = rowProbability
+				rowSampler := spec.MakeRowSampler()
+				sampled := 0
+				for _, k := range tc.keys {
+					scanned := false
+					for _, sp := range spans {
+						scanned = scanned || sp.ContainsKey(k)
+					}
+					if scanned && rowSampler.SampleRow(k) {
+						sampled++
+					}
+				}
+				require.InDelta(t, probability, float64(sampled)/numRows, 0.05)
+			})
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"net/url"
+	"path"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/cloud"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfrapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/physicalplan"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
+)
+
+// foreignTableURI returns the URI of the files of the given foreign table,
+// which refers to the external connection of the server of the table. The
+// path of the URI may contain wildcards.
+func foreignTableURI(desc catalog.TableDescriptor) string {
+	foreign := desc.TableDesc().Foreign
+	uri := url.URL{
+		Scheme: "external",
+		Host:   foreign.Server,
+		Path:   path.Join("/", foreign.Filename),
+	}
+	return uri.String()
+}
+
+// expandForeignTableFiles returns the URIs of all files of the given foreign
+// table. The wildcards in the file name of the table are expanded by listing
+// the external storage.
+func expandForeignTableFiles(
+	ctx context.Context,
+	execCfg *ExecutorConfig,
+	user username.SQLUsername,
+	desc catalog.TableDescriptor,
+) ([]string, error) {
+	file := foreignTableURI(desc)
+	uri, err := url.Parse(file)
+	if err != nil {
+		return nil, err
+	}
+	prefix := cloud.GetPrefixBeforeWildcard(uri.Path)
+	if len(prefix) == len(uri.Path) {
+		return []string{file}, nil
+	}
+	pattern := uri.Path[len(prefix):]
+	uri.Path = prefix
+	s, err := execCfg.DistSQLSrv.ExternalStorageFromURI(ctx, uri.String(), user)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+	var files []string
+	if err := s.List(ctx, "", "", func(s string) error {
+		ok, err := path.Match(pattern, s)
+		if ok {
+			uri.Path = prefix + s
+			files = append(files, uri.String())
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, pgerror.Newf(pgcode.UndefinedFile,
+			"no files matched %q in prefix %q of foreign table %q", pattern, prefix, desc.GetName())
+	}
+	return files, nil
+}
+
+// createPlanForForeignScan creates a physical plan for a foreignScanNode. The
+// files of the table are assigned round-robin to ForeignScan processors on all
+// instances that can be used for the query.
+func (dsp *DistSQLPlanner) createPlanForForeignScan(
+	ctx context.Context, planCtx *PlanningCtx, n *foreignScanNode,
+) (*PhysicalPlan, error) {
+	if planCtx.planner == nil {
+		return nil, errors.AssertionFailedf("foreign tables can only be scanned by a planner")
+	}
+	user := planCtx.planner.User()
+	files, err := expandForeignTableFiles(ctx, planCtx.planner.ExecCfg(), user, n.desc)
+	if err != nil {
+		return nil, err
+	}
+
+	instances := []base.SQLInstanceID{dsp.gatewaySQLInstanceID}
+	if !planCtx.IsLocal() {
+		all, err := dsp.GetAllInstancesByLocality(ctx, planCtx.localityFilter)
+		if err != nil {
+			return nil, err
+		}
+		instances = instances[:0]
+		for _, instance := range all {
+			instances = append(instances, instance.InstanceID)
+		}
+	}
+	if len(instances) > len(files) {
+		instances = instances[:len(files)]
+	}
+	log.VEventf(ctx, 2, "planning %d foreign scan processors for %d files", len(instances), len(files))
+
+	neededCols := make([]uint32, len(n.neededCols))
+	for i, ord := range n.neededCols {
+		neededCols[i] = uint32(ord)
+	}
+	corePlacements := make([]physicalplan.ProcessorCorePlacement, len(instances))
+	for i := range corePlacements {
+		corePlacements[i].SQLInstanceID = instances[i]
+		corePlacements[i].Core.ForeignScan = &execinfrapb.ForeignScanSpec{
+			Table:         *n.desc.TableDesc(),
+			NeededColumns: neededCols,
+			UserProto:     user.EncodeProto(),
+		}
+	}
+	for i, file := range files {
+		spec := corePlacements[i%len(corePlacements)].Core.ForeignScan
+		spec.URIs = append(spec.URIs, file)
+	}
+
+	typs, err := getTypesForPlanResult(n, nil /* planToStreamColMap */)
+	if err != nil {
+		return nil, err
+	}
+	p := planCtx.NewPhysicalPlan()
+	p.AddNoInputStage(
+		corePlacements, execinfrapb.PostProcessSpec{}, typs, execinfrapb.Ordering{},
+		planCtx.associateWithPlanNode(n),
+	)
+	p.PlanToStreamColMap = identityMap(p.PlanToStreamColMap, len(n.columns))
+	return p, nil
+}
@@ -10,6 +10,7 @@ import (
 	"math"
 	"time"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
 	"github.com/cockroachdb/cockroach/pkg/kv"
 	"github.com/cockroachdb/cockroach/pkg/settings"
@@ -43,6 +44,7 @@ type requestedStat struct {
 	histogramMaxBuckets uint32
 	name                string
 	inverted            bool
+	dependencies        bool
 }
 
 // histogramSamples is the number of sample rows to be collected for histogram
@@ -193,7 +195,7 @@ func (dsp *DistSQLPlanner) createAndAttachSamplers(
 	// For partial statistics this loop should only iterate once
 	// since we only support one reqStat at a time.
 	for _, s := range reqStats {
-		if s.histogram {
+		if s.histogram || s.dependencies {
 			var histogramSamplesCount uint32
 			if tableSampleCount, ok := desc.HistogramSamplesCount(); ok {
 				histogramSamplesCount = tableSampleCount
@@ -693,10 +695,11 @@ func (dsp *DistSQLPlanner) createStatsPlan(
 	sampledColumnIDs := make([]descpb.ColumnID, len(requestedCols))
 	for _, s := range reqStats {
 		spec := execinfrapb.SketchSpec{
-			GenerateHistogram:   s.histogram,
-			HistogramMaxBuckets: s.histogramMaxBuckets,
-			Columns:             make([]uint32, len(s.columns)),
-			StatName:            s.name,
+			GenerateHistogram:    s.histogram,
+			HistogramMaxBuckets:  s.histogramMaxBuckets,
+			Columns:              make([]uint32, len(s.columns)),
+			StatName:             s.name,
+			GenerateDependencies: s.dependencies,
 		}
 		for i, colID := range s.columns {
 			colIdx, ok := colIdxMap.Get(colID)
@@ -778,6 +781,9 @@ func (dsp *DistSQLPlanner) createPlanForCreateStats(
 	histogramCollectionEnabled := stats.HistogramClusterMode.Get(&dsp.st.SV)
 	tableDesc := tabledesc.NewBuilder(&details.Table).BuildImmutableTable()
 	defaultHistogramBuckets := stats.GetDefaultHistogramBuckets(&dsp.st.SV, tableDesc)
+	// The degrees of functional dependency can only be stored once
+	// system.table_statistics has the dependencyDegrees column.
+	dependenciesEnabled := dsp.st.Version.IsActive(ctx, clusterversion.V26_1_TableStatisticsDependencyDegrees)
 	for i := 0; i < len(reqStats); i++ {
 		histogram := details.ColumnStats[i].HasHistogram && histogramCollectionEnabled
 		var histogramMaxBuckets = defaultHistogramBuckets
@@ -793,6 +799,7 @@ func (dsp *DistSQLPlanner) createPlanForCreateStats(
 			histogramMaxBuckets: histogramMaxBuckets,
 			name:                details.Name,
 			inverted:            details.ColumnStats[i].Inverted,
+			dependencies:        details.ColumnStats[i].HasDependencies && dependenciesEnabled && len(details.ColumnStats[i].ColumnIDs) > 1,
 		}
 	}
 
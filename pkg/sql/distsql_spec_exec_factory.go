@@ -223,6 +223,12 @@ func (e *distSQLSpecExecFactory) constructValues(
 func (e *distSQLSpecExecFactory) ConstructScan(
 	table cat.Table, index cat.Index, params exec.ScanParams, reqOrdering exec.OutputOrdering,
 ) (exec.Node, error) {
+	if table.IsForeignTable() {
+		return nil, unimplemented.NewWithIssue(47473, "experimental opt-driven distsql planning: foreign tables")
+	}
+	if params.SampleMethod != tree.TableSampleNone {
+		return nil, unimplemented.NewWithIssue(47473, "experimental opt-driven distsql planning: TABLESAMPLE")
+	}
 	if table.IsVirtualTable() {
 		return constructVirtualScan(
 			e, e.planner, table, index, params, reqOrdering,
@@ -644,6 +650,9 @@ func (e *distSQLSpecExecFactory) constructAggregators(
 		i := len(groupCols) + j
 		spec := &aggregationSpecs[i]
 		agg := &aggregations[j]
+		if agg.UserDefined != nil {
+			return nil, unimplemented.NewWithIssue(74775, "experimental opt-driven distsql planning: user-defined aggregates")
+		}
 		argumentsColumnTypes[i], err = populateAggFuncSpec(
 			e.ctx, spec, agg.FuncName, agg.Distinct, agg.ArgCols,
 			agg.ConstArgs, agg.Filter, planCtx, physPlan,
@@ -695,6 +704,18 @@ func (e *distSQLSpecExecFactory) ConstructGroupBy(
 	)
 }
 
+func (e *distSQLSpecExecFactory) ConstructGroupingSets(
+	input exec.Node,
+	groupCols []exec.NodeColumnOrdinal,
+	sets [][]exec.NodeColumnOrdinal,
+	groupingFuncs [][]exec.NodeColumnOrdinal,
+	aggregations []exec.AggInfo,
+	estimatedRowCount uint64,
+	estimatedInputRowCount uint64,
+) (exec.Node, error) {
+	return nil, unimplemented.NewWithIssue(47473, "experimental opt-driven distsql planning: grouping sets")
+}
+
 func (e *distSQLSpecExecFactory) ConstructScalarGroupBy(
 	input exec.Node, aggregations []exec.AggInfo, estimatedInputRowCount uint64,
 ) (exec.Node, error) {
@@ -920,6 +941,7 @@ func (e *distSQLSpecExecFactory) ConstructLookupJoin(
 	remoteOnlyLookups bool,
 	reverseScans bool,
 	parallelize bool,
+	estimatedTableRowCount uint64,
 ) (exec.Node, error) {
 	physPlan, plan := getPhysPlan(input)
 	var planNodesToClose []planNode
@@ -969,6 +991,7 @@ func (e *distSQLSpecExecFactory) ConstructLookupJoin(
 			remoteOnlyLookups:          remoteOnlyLookups,
 			reverseScans:               reverseScans,
 			parallelize:                parallelize,
+			estimatedTableRowCount:     estimatedTableRowCount,
 		}
 		if onCond != tree.DBoolTrue {
 			planInfo.onCond = onCond
@@ -1457,6 +1480,7 @@ func (e *distSQLSpecExecFactory) ConstructUpsert(
 	arbiterIndexes cat.IndexOrdinals,
 	arbiterConstraints cat.UniqueOrdinals,
 	canaryCol exec.NodeColumnOrdinal,
+	deleteCol exec.NodeColumnOrdinal,
 	insertCols exec.TableColumnOrdinalSet,
 	fetchCols exec.TableColumnOrdinalSet,
 	updateCols exec.TableColumnOrdinalSet,
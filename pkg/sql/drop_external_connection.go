@@ -43,22 +43,27 @@ func (p *planner) dropExternalConnection(params runParams, n *tree.DropExternalC
 	if err != nil {
 		return errors.Wrap(err, "failed to resolve External Connection name")
 	}
+	return p.dropExternalConnectionByName(params.ctx, name)
+}
 
+// dropExternalConnectionByName drops the External Connection with the given
+// name, along with the privileges granted on it.
+func (p *planner) dropExternalConnectionByName(ctx context.Context, name string) error {
 	// Check that the user has DROP privileges on the External Connection object.
 	ecPrivilege := &syntheticprivilege.ExternalConnectionPrivilege{
 		ConnectionName: name,
 	}
-	if err := p.CheckPrivilege(params.ctx, ecPrivilege, privilege.DROP); err != nil {
+	if err := p.CheckPrivilege(ctx, ecPrivilege, privilege.DROP); err != nil {
 		return err
 	}
 
 	// DROP EXTERNAL CONNECTION is only allowed for users with the `DROP`
 	// privilege on this object. We run the query as `node` since the user might
 	// not have `SELECT` on the system table.
-	if _ /* rows */, err = params.p.InternalSQLTxn().ExecEx(
-		params.ctx,
+	if _ /* rows */, err := p.InternalSQLTxn().ExecEx(
+		ctx,
 		dropExternalConnectionOp,
-		params.p.Txn(),
+		p.Txn(),
 		sessiondata.NodeUserSessionDataOverride,
 		`DELETE FROM system.external_connections WHERE connection_name = $1`, name,
 	); err != nil {
@@ -67,10 +72,10 @@ func (p *planner) dropExternalConnection(params runParams, n *tree.DropExternalC
 
 	// We must also DELETE all rows from system.privileges that refer to
 	// external connection.
-	if _, err = params.p.InternalSQLTxn().ExecEx(
-		params.ctx,
+	if _, err := p.InternalSQLTxn().ExecEx(
+		ctx,
 		dropExternalConnectionOp,
-		params.p.Txn(),
+		p.Txn(),
 		sessiondata.NodeUserSessionDataOverride,
 		`DELETE FROM system.privileges WHERE path = $1`, ecPrivilege.GetPath(),
 	); err != nil {
@@ -73,6 +73,9 @@ func (p *planner) DropFunction(ctx context.Context, n *tree.DropRoutine) (ret pl
 		if err != nil {
 			return nil, err
 		}
+		if err := checkRoutineAggregateKind(mut, n.Aggregate, "DROP", "drop"); err != nil {
+			return nil, err
+		}
 		if n.DropBehavior != tree.DropCascade && len(mut.DependedOnBy) > 0 {
 			dependedOnByIDs := make([]descpb.ID, 0, len(mut.DependedOnBy))
 			for _, ref := range mut.DependedOnBy {
@@ -161,6 +164,24 @@ func (p *planner) matchRoutine(
 	return &ol, nil
 }
 
+// checkRoutineAggregateKind returns an error if the given function is a
+// user-defined aggregate and the statement does not target aggregates, or vice
+// versa. stmt and verb are used to build the hint, e.g. "DROP" and "drop".
+func checkRoutineAggregateKind(
+	fnDesc catalog.FunctionDescriptor, aggregate bool, stmt, verb string,
+) error {
+	if fnDesc.IsAggregate() == aggregate {
+		return nil
+	}
+	if aggregate {
+		return pgerror.Newf(pgcode.WrongObjectType, "function %s is not an aggregate", fnDesc.GetName())
+	}
+	return errors.WithHintf(
+		pgerror.Newf(pgcode.WrongObjectType, "%s is an aggregate function", fnDesc.GetName()),
+		"Use %s AGGREGATE to %s aggregate functions.", stmt, verb,
+	)
+}
+
 func (p *planner) checkPrivilegesForDropFunction(
 	ctx context.Context, fnID descpb.ID,
 ) (*funcdesc.Mutable, error) {
@@ -0,0 +1,85 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn"
+	"github.com/cockroachdb/cockroach/pkg/cloud/externalconn/connectionpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+	"github.com/cockroachdb/errors"
+)
+
+type dropServerNode struct {
+	zeroInputPlanNode
+	n *tree.DropServer
+}
+
+// DropServer represents a DROP SERVER statement. The External Connection of
+// the server is dropped.
+func (p *planner) DropServer(_ context.Context, n *tree.DropServer) (planNode, error) {
+	if n.DropBehavior == tree.DropCascade {
+		return nil, unimplemented.Newf("DROP SERVER...CASCADE", "drop server cascade not supported")
+	}
+	return &dropServerNode{n: n}, nil
+}
+
+func (d *dropServerNode) startExec(params runParams) error {
+	p := params.p
+	for _, name := range d.n.Names {
+		ec, err := externalconn.LoadExternalConnection(params.ctx, string(name), p.InternalSQLTxn())
+		if err != nil {
+			var notFoundErr *externalconn.ExternalConnectionNotFoundError
+			if errors.As(err, &notFoundErr) {
+				if d.n.IfExists {
+					continue
+				}
+				return pgerror.Newf(pgcode.UndefinedObject, "server %q does not exist", name)
+			}
+			return err
+		}
+		if ec.ConnectionType() != connectionpb.TypeStorage {
+			return pgerror.Newf(pgcode.WrongObjectType,
+				"external connection %q of type %s is not a server", name, ec.ConnectionType())
+		}
+		if err := p.checkServerHasNoForeignTables(params.ctx, string(name)); err != nil {
+			return err
+		}
+		if err := p.dropExternalConnectionByName(params.ctx, string(name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkServerHasNoForeignTables returns an error if a foreign table that has
+// not been dropped refers to the server with the given name.
+func (p *planner) checkServerHasNoForeignTables(ctx context.Context, server string) error {
+	all, err := p.Descriptors().GetAll(ctx, p.Txn())
+	if err != nil {
+		return err
+	}
+	return all.ForEachDescriptor(func(desc catalog.Descriptor) error {
+		tbl, ok := desc.(catalog.TableDescriptor)
+		if !ok || tbl.Dropped() || !tbl.IsForeignTable() || tbl.TableDesc().Foreign.Server != server {
+			return nil
+		}
+		return errors.WithHint(
+			pgerror.Newf(pgcode.DependentObjectsStillExist,
+				"cannot drop server %q because foreign table %q depends on it", server, tbl.GetName()),
+			"drop the foreign tables of the server first",
+		)
+	})
+}
+
+func (d *dropServerNode) Next(_ runParams) (bool, error) { return false, nil }
+func (d *dropServerNode) Values() tree.Datums            { return nil }
+func (d *dropServerNode) Close(_ context.Context)        {}
@@ -90,6 +90,9 @@ func (p *planner) DropTable(ctx context.Context, n *tree.DropTable) (planNode, e
 				}
 			}
 		}
+		if err := p.canDropInheritedTable(ctx, droppedDesc, td, n.DropBehavior); err != nil {
+			return nil, err
+		}
 		if err := p.canRemoveAllTableOwnedSequences(ctx, droppedDesc, n.DropBehavior); err != nil {
 			return nil, err
 		}
@@ -262,6 +265,18 @@ func (p *planner) dropTableImpl(
 	}
 	tableDesc.InboundFKs = nil
 
+	// Remove the table from the inheriting tables of its parents, and drop the
+	// tables inheriting from it, assuming that we wouldn't have made it to this
+	// point if `cascade` wasn't enabled.
+	if err := p.removeInheritanceBackReferences(ctx, tableDesc); err != nil {
+		return droppedViews, err
+	}
+	cascadedViews, err := p.dropInheritingTables(ctx, tableDesc, droppingParent, jobDesc, behavior)
+	if err != nil {
+		return droppedViews, err
+	}
+	droppedViews = append(droppedViews, cascadedViews...)
+
 	// Remove sequence dependencies.
 	for _, col := range tableDesc.PublicColumns() {
 		if err := p.removeSequenceDependencies(ctx, tableDesc, col); err != nil {
@@ -111,6 +111,21 @@ func (p *planner) DropType(ctx context.Context, n *tree.DropType) (planNode, err
 	return node, nil
 }
 
+// DropDomain drops one or more domain types. The domains are dropped like any
+// other user-defined type once they have been verified to be domains.
+func (p *planner) DropDomain(ctx context.Context, n *tree.DropDomain) (planNode, error) {
+	for _, name := range n.Names {
+		if _, err := p.resolveMutableDomainDescriptor(ctx, name, !n.IfExists); err != nil {
+			return nil, err
+		}
+	}
+	return p.DropType(ctx, &tree.DropType{
+		Names:        n.Names,
+		IfExists:     n.IfExists,
+		DropBehavior: n.DropBehavior,
+	})
+}
+
 func (p *planner) canDropTypeDesc(
 	ctx context.Context, desc *typedesc.Mutable, behavior tree.DropBehavior,
 ) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/errors"
 )
 
 // errorIfRowsNode wraps another planNode and returns an error if the wrapped
@@ -38,8 +39,22 @@ func (n *errorIfRowsNode) Next(params runParams) (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	if ok {
-		return false, n.mkErr(n.input.Values())
+	for ok {
+		checkErr := n.mkErr(n.input.Values())
+		var deferrableErr *exec.DeferrableConstraintError
+		if !errors.As(checkErr, &deferrableErr) {
+			return false, checkErr
+		}
+		// The check enforces a DEFERRABLE constraint. If the constraint is
+		// currently deferred, the violating rows are revalidated at commit
+		// instead, so all of them must be recorded.
+		dc := params.extendedEvalCtx.deferredConstraints
+		if dc == nil || !dc.maybeDefer(deferrableErr) {
+			return false, deferrableErr.Cause
+		}
+		if ok, err = n.input.Next(params); err != nil {
+			return false, err
+		}
 	}
 	return false, nil
 }
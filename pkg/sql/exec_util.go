@@ -69,6 +69,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/hints"
 	"github.com/cockroachdb/cockroach/pkg/sql/idxusage"
 	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/notify"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt"
 	"github.com/cockroachdb/cockroach/pkg/sql/optionalnodeliveness"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
@@ -1655,6 +1656,10 @@ type ExecutorConfig struct {
 	StatementHintsCache *hints.StatementHintsCache
 	VecIndexManager     *vecindex.Manager
 
+	// NotificationRegistry delivers the notifications sent with NOTIFY to
+	// the sessions of this node that LISTEN on their channel.
+	NotificationRegistry *notify.Registry
+
 	SchemaChangerMetrics *SchemaChangerMetrics
 	FeatureFlagMetrics   *featureflag.DenialMetrics
 	RowMetrics           *rowinfra.Metrics
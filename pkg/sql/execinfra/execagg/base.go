@@ -109,6 +109,20 @@ func GetAggregateConstructor(
 		paramTypes[len(aggInfo.ColIdx)+j] = d.ResolvedType()
 		arguments[j] = d
 	}
+	if aggInfo.Func == execinfrapb.UserDefined {
+		if aggInfo.UserDefined == nil {
+			return nil, nil, nil, errors.AssertionFailedf("user-defined aggregate without definition")
+		}
+		var def *userDefinedAggregateDef
+		def, err = newUserDefinedAggregateDef(ctx, evalCtx, semaCtx, aggInfo.UserDefined, paramTypes)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		constructor = func(evalCtx *eval.Context, _ tree.Datums) eval.AggregateFunc {
+			return def.newAggregate(evalCtx)
+		}
+		return constructor, arguments, aggInfo.UserDefined.OutputType(), nil
+	}
 	constructor, outputType, err = getAggregateInfo(aggInfo.Func, paramTypes)
 	return
 }
@@ -147,7 +161,9 @@ func (p *ParamTypesAllocator) alloc(n int) (_ []*types.T, err error) {
 }
 
 // GetAggregateOutputType returns the output type for the given aggregate
-// function when applied on the given types.
+// function when applied on the given types. It cannot be used for
+// user-defined aggregates, whose output type is part of their specification
+// (see AggregatorSpec_UserDefinedAggregation.OutputType).
 //
 // inputTypes argument can be mutated by the caller.
 func GetAggregateOutputType(
@@ -0,0 +1,255 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package execagg
+
+import (
+	"context"
+	"unsafe"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfra/execexpr"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfrapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/mon"
+	"github.com/cockroachdb/errors"
+)
+
+// userDefinedAggregateDef is the deserialized form of a
+// execinfrapb.AggregatorSpec_UserDefinedAggregation, shared by all instances
+// of the aggregate.
+type userDefinedAggregateDef struct {
+	stage execinfrapb.AggregatorSpec_UserDefinedAggregation_Stage
+
+	// transition, final and combine are the component function expressions.
+	// final and combine are nil if the aggregate does not have the
+	// corresponding function.
+	transition tree.TypedExpr
+	final      tree.TypedExpr
+	combine    tree.TypedExpr
+
+	// transitionTypes are the types of the indexed variables referenced by
+	// transition: the state followed by the aggregated arguments.
+	transitionTypes []*types.T
+	// stateTypes are the types of the indexed variables referenced by final
+	// and combine.
+	stateTypes []*types.T
+
+	// initState is the initial value of the state.
+	initState     tree.Datum
+	strict        bool
+	combineStrict bool
+}
+
+// newUserDefinedAggregateDef deserializes the given user-defined aggregation
+// for the given types of the aggregated arguments.
+func newUserDefinedAggregateDef(
+	ctx context.Context,
+	evalCtx *eval.Context,
+	semaCtx *tree.SemaContext,
+	spec *execinfrapb.AggregatorSpec_UserDefinedAggregation,
+	paramTypes []*types.T,
+) (*userDefinedAggregateDef, error) {
+	def := &userDefinedAggregateDef{
+		stage:           spec.Stage,
+		transitionTypes: append([]*types.T{spec.StateType}, paramTypes...),
+		stateTypes:      []*types.T{spec.StateType, spec.StateType},
+		initState:       tree.DNull,
+		strict:          spec.Strict,
+		combineStrict:   spec.CombineStrict,
+	}
+	prepare := func(expr execinfrapb.Expression, typs []*types.T) (tree.TypedExpr, error) {
+		if expr.Empty() {
+			return nil, nil
+		}
+		if expr.LocalExpr != nil {
+			return expr.LocalExpr, nil
+		}
+		return execexpr.DeserializeExpr(ctx, expr, typs, semaCtx, evalCtx)
+	}
+	var err error
+	if def.transition, err = prepare(spec.Transition, def.transitionTypes); err != nil {
+		return nil, err
+	}
+	if def.final, err = prepare(spec.Final, def.stateTypes[:1]); err != nil {
+		return nil, err
+	}
+	if def.combine, err = prepare(spec.Combine, def.stateTypes); err != nil {
+		return nil, err
+	}
+	if def.transition == nil {
+		return nil, errors.AssertionFailedf("user-defined aggregate has no transition function")
+	}
+	if def.stage == execinfrapb.AggregatorSpec_UserDefinedAggregation_FINAL && def.combine == nil {
+		return nil, errors.AssertionFailedf("user-defined aggregate has no combine function")
+	}
+	if spec.InitCond != nil {
+		def.initState, _, err = tree.ParseAndRequireString(spec.StateType, *spec.InitCond, evalCtx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return def, nil
+}
+
+// newAggregate returns a new instance of the user-defined aggregate.
+func (def *userDefinedAggregateDef) newAggregate(evalCtx *eval.Context) eval.AggregateFunc {
+	a := &userDefinedAggregate{
+		def:     def,
+		evalCtx: evalCtx,
+		state:   def.initState,
+	}
+	if evalCtx.SingleDatumAggMemAccount == nil {
+		acc := evalCtx.Planner.Mon().MakeBoundAccount()
+		a.acc, a.ownsAcc = &acc, true
+	} else {
+		a.acc = evalCtx.SingleDatumAggMemAccount
+	}
+	return a
+}
+
+// userDefinedAggregate evaluates a user-defined aggregate created with CREATE
+// AGGREGATE. Depending on the stage, it either accumulates the aggregated
+// arguments into the state using the transition function, or merges states
+// produced by partial aggregations using the combine function.
+//
+// The component functions follow the Postgres semantics for strict functions:
+// a strict transition function is not called for rows with NULL arguments, and
+// if the state is NULL, it is replaced by the first argument of the first such
+// row.
+type userDefinedAggregate struct {
+	def     *userDefinedAggregateDef
+	evalCtx *eval.Context
+	state   tree.Datum
+	// row contains the values of the indexed variables of the component
+	// function expression being evaluated.
+	row tree.Datums
+	// typs contains the types of the indexed variables in row.
+	typs []*types.T
+
+	acc          *mon.BoundAccount
+	ownsAcc      bool
+	accountedFor int64
+}
+
+var _ eval.AggregateFunc = &userDefinedAggregate{}
+var _ eval.IndexedVarContainer = &userDefinedAggregate{}
+
+// IndexedVarEval is part of the eval.IndexedVarContainer interface.
+func (a *userDefinedAggregate) IndexedVarEval(idx int) (tree.Datum, error) {
+	return a.row[idx], nil
+}
+
+// IndexedVarResolvedType is part of the tree.IndexedVarContainer interface.
+func (a *userDefinedAggregate) IndexedVarResolvedType(idx int) *types.T {
+	return a.typs[idx]
+}
+
+// evalWithRow evaluates the given component function expression with the
+// given values for its indexed variables.
+func (a *userDefinedAggregate) evalWithRow(
+	ctx context.Context, expr tree.TypedExpr, row tree.Datums, typs []*types.T,
+) (tree.Datum, error) {
+	a.row, a.typs = row, typs
+	a.evalCtx.PushIVarContainer(a)
+	defer a.evalCtx.PopIVarContainer()
+	return eval.Expr(ctx, a.evalCtx, expr)
+}
+
+// Add is part of the eval.AggregateFunc interface.
+func (a *userDefinedAggregate) Add(
+	ctx context.Context, firstArg tree.Datum, otherArgs ...tree.Datum,
+) error {
+	if a.def.stage == execinfrapb.AggregatorSpec_UserDefinedAggregation_FINAL {
+		return a.combine(ctx, firstArg)
+	}
+	if a.def.strict {
+		if firstArg == tree.DNull {
+			return nil
+		}
+		for _, arg := range otherArgs {
+			if arg == tree.DNull {
+				return nil
+			}
+		}
+		if a.state == tree.DNull {
+			// The first non-NULL input becomes the initial state. CREATE
+			// AGGREGATE ensures that the first argument has the state type in
+			// this case.
+			return a.setState(ctx, firstArg)
+		}
+	}
+	row := make(tree.Datums, 0, len(otherArgs)+2)
+	row = append(row, a.state, firstArg)
+	row = append(row, otherArgs...)
+	state, err := a.evalWithRow(ctx, a.def.transition, row, a.def.transitionTypes)
+	if err != nil {
+		return err
+	}
+	return a.setState(ctx, state)
+}
+
+// combine merges the given state produced by a partial aggregation into the
+// state of the aggregate.
+func (a *userDefinedAggregate) combine(ctx context.Context, other tree.Datum) error {
+	if a.def.combineStrict {
+		if other == tree.DNull {
+			return nil
+		}
+		if a.state == tree.DNull {
+			return a.setState(ctx, other)
+		}
+	}
+	state, err := a.evalWithRow(ctx, a.def.combine, tree.Datums{a.state, other}, a.def.stateTypes)
+	if err != nil {
+		return err
+	}
+	return a.setState(ctx, state)
+}
+
+func (a *userDefinedAggregate) setState(ctx context.Context, state tree.Datum) error {
+	newUsage := int64(state.Size())
+	if err := a.acc.Grow(ctx, newUsage-a.accountedFor); err != nil {
+		return err
+	}
+	a.accountedFor = newUsage
+	a.state = state
+	return nil
+}
+
+// Result is part of the eval.AggregateFunc interface.
+func (a *userDefinedAggregate) Result() (tree.Datum, error) {
+	if a.def.stage == execinfrapb.AggregatorSpec_UserDefinedAggregation_PARTIAL || a.def.final == nil {
+		return a.state, nil
+	}
+	return a.evalWithRow(
+		context.TODO(), a.def.final, tree.Datums{a.state}, a.def.stateTypes[:1],
+	)
+}
+
+// Reset is part of the eval.AggregateFunc interface.
+func (a *userDefinedAggregate) Reset(ctx context.Context) {
+	a.state = a.def.initState
+	a.acc.Shrink(ctx, a.accountedFor)
+	a.accountedFor = 0
+}
+
+// Close is part of the eval.AggregateFunc interface.
+func (a *userDefinedAggregate) Close(ctx context.Context) {
+	if a.ownsAcc {
+		a.acc.Close(ctx)
+	} else {
+		a.acc.Shrink(ctx, a.accountedFor)
+	}
+	a.accountedFor = 0
+}
+
+const sizeOfUserDefinedAggregate = int64(unsafe.Sizeof(userDefinedAggregate{}))
+
+// Size is part of the eval.AggregateFunc interface.
+func (a *userDefinedAggregate) Size() int64 {
+	return sizeOfUserDefinedAggregate
+}
@@ -56,6 +56,11 @@ func (m *ReadImportDataSpec) User() username.SQLUsername {
 	return m.UserProto.Decode()
 }
 
+// User accesses the user field.
+func (m *ForeignScanSpec) User() username.SQLUsername {
+	return m.UserProto.Decode()
+}
+
 // User accesses the user field.
 func (m *ChangeAggregatorSpec) User() username.SQLUsername {
 	return m.UserProto.Decode()
@@ -247,6 +247,11 @@ func (s *ComponentStats) formatStats(fn func(suffix string, value interface{}))
 	if s.Output.NumTuples.HasValue() {
 		fn("rows output", humanizeutil.Count(s.Output.NumTuples.Value()))
 	}
+
+	// Join stats.
+	if s.Join.SwitchedToHashJoin {
+		fn("switched to hash join", nil)
+	}
 }
 
 // Union creates a new ComponentStats that contains all statistics in either the
@@ -384,6 +389,9 @@ func (s *ComponentStats) Union(other *ComponentStats) *ComponentStats {
 		result.Output.NumTuples = other.Output.NumTuples
 	}
 
+	// Join stats.
+	result.Join.SwitchedToHashJoin = result.Join.SwitchedToHashJoin || other.Join.SwitchedToHashJoin
+
 	// Flow stats.
 	if !result.FlowStats.MaxMemUsage.HasValue() {
 		result.FlowStats.MaxMemUsage = other.FlowStats.MaxMemUsage
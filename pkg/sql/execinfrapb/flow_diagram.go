@@ -146,6 +146,13 @@ func (a *AggregatorSpec) summary() (string, []string) {
 	if len(a.OrderedGroupCols) > 0 {
 		details = append(details, fmt.Sprintf("Ordered: %s", colListStr(a.OrderedGroupCols)))
 	}
+	if len(a.GroupingSets) > 0 {
+		sets := make([]string, len(a.GroupingSets))
+		for i := range a.GroupingSets {
+			sets[i] = "(" + colListStr(a.GroupingSets[i].Cols) + ")"
+		}
+		details = append(details, fmt.Sprintf("Grouping sets: %s", strings.Join(sets, ", ")))
+	}
 	for _, agg := range a.Aggregations {
 		var buf bytes.Buffer
 		buf.WriteString(agg.Func.String())
@@ -156,6 +163,9 @@ func (a *AggregatorSpec) summary() (string, []string) {
 		}
 		buf.WriteString(colListStr(agg.ColIdx))
 		buf.WriteByte(')')
+		if agg.UserDefined != nil && agg.UserDefined.Stage != AggregatorSpec_UserDefinedAggregation_FULL {
+			fmt.Fprintf(&buf, " %s", agg.UserDefined.Stage)
+		}
 		if agg.FilterColIdx != nil {
 			fmt.Fprintf(&buf, " FILTER @%d", *agg.FilterColIdx+1)
 		}
@@ -223,6 +233,14 @@ func (tr *TableReaderSpec) summary() (string, []string) {
 		))
 	}
 
+	if tr.SampleRows {
+		unit := "rows"
+		if tr.SampleBlocks {
+			unit = "rows of sampled ranges"
+		}
+		details = append(details, fmt.Sprintf("Sample: %g%% of %s", tr.SampleProbability*100, unit))
+	}
+
 	return "TableReader", details
 }
 
@@ -669,6 +687,11 @@ func (s *ExportSpec) summary() (string, []string) {
 	return "Exporter", []string{s.Destination}
 }
 
+// summary implements the diagramCellType interface.
+func (s *ForeignScanSpec) summary() (string, []string) {
+	return "ForeignScan", []string{fmt.Sprintf("Files: %d", len(s.URIs))}
+}
+
 // summary implements the diagramCellType interface.
 func (s *BulkRowWriterSpec) summary() (string, []string) {
 	return "BulkRowWriterSpec", []string{}
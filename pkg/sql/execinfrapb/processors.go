@@ -6,11 +6,16 @@
 package execinfrapb
 
 import (
+	"encoding/binary"
+	"hash/fnv"
 	"unicode/utf8"
 	"unsafe"
 
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treewindow"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/intsets"
 	"github.com/cockroachdb/errors"
 )
 
@@ -35,6 +40,10 @@ func (a AggregatorSpec_Aggregation) Equals(b AggregatorSpec_Aggregation) bool {
 	if a.Func != b.Func || a.Distinct != b.Distinct {
 		return false
 	}
+	if a.UserDefined != nil || b.UserDefined != nil {
+		// User-defined aggregations are never de-duplicated.
+		return false
+	}
 	if a.FilterColIdx == nil {
 		if b.FilterColIdx != nil {
 			return false
@@ -55,6 +64,15 @@ func (a AggregatorSpec_Aggregation) Equals(b AggregatorSpec_Aggregation) bool {
 	return true
 }
 
+// OutputType returns the type of the values produced by the given stage of the
+// user-defined aggregation.
+func (u *AggregatorSpec_UserDefinedAggregation) OutputType() *types.T {
+	if u.Stage == AggregatorSpec_UserDefinedAggregation_PARTIAL {
+		return u.StateType
+	}
+	return u.ResultType
+}
+
 // IsScalar returns whether the aggregate function is in scalar context.
 func (spec *AggregatorSpec) IsScalar() bool {
 	switch spec.Type {
@@ -78,6 +96,98 @@ func (spec *AggregatorSpec) IsRowCount() bool {
 		spec.IsScalar()
 }
 
+// GroupingSetsCols returns the input columns that are part of any of the
+// grouping sets of the aggregator, in increasing order.
+func (spec *AggregatorSpec) GroupingSetsCols() []uint32 {
+	var allCols intsets.Fast
+	for _, set := range spec.GroupingSets {
+		for _, c := range set.Cols {
+			allCols.Add(int(c))
+		}
+	}
+	res := make([]uint32, 0, allCols.Len())
+	allCols.ForEach(func(c int) {
+		res = append(res, uint32(c))
+	})
+	return res
+}
+
+// NumGroupingSetsCols returns the number of virtual columns with which the
+// input rows are extended when the aggregator has grouping sets: the grouping
+// level, followed by one column for each GROUPING() function and one column
+// for each of the GroupingSetsCols. It returns 0 if there are no grouping sets.
+func (spec *AggregatorSpec) NumGroupingSetsCols() int {
+	if len(spec.GroupingSets) == 0 {
+		return 0
+	}
+	return 1 + len(spec.GroupingFuncs) + len(spec.GroupingSetsCols())
+}
+
+// IsGroupingSetsColsAggregation returns whether the given aggregation has only
+// virtual grouping set columns as arguments and no filter, given the number of
+// input columns of the aggregator. When the input is empty, these are the only
+// aggregations that are computed over a row for each empty grouping set.
+func (spec *AggregatorSpec) IsGroupingSetsColsAggregation(
+	agg *AggregatorSpec_Aggregation, numInputCols int,
+) bool {
+	if len(agg.ColIdx) == 0 || agg.FilterColIdx != nil {
+		return false
+	}
+	for _, c := range agg.ColIdx {
+		if int(c) < numInputCols {
+			return false
+		}
+	}
+	return true
+}
+
+// GroupingSetExpansion describes how an input row is extended for one of the
+// grouping sets of an AggregatorSpec.
+type GroupingSetExpansion struct {
+	// Values are the values of the grouping level and of the GROUPING()
+	// virtual columns.
+	Values []int64
+	// Cols has one element for each of the virtual grouping columns: the input
+	// column it is a copy of, or -1 if it is NULL for the grouping set.
+	Cols []int
+	// Empty is set if the grouping set has no columns.
+	Empty bool
+}
+
+// MakeGroupingSetExpansions returns the expansion of the input rows for each
+// of the grouping sets of the aggregator.
+func (spec *AggregatorSpec) MakeGroupingSetExpansions() []GroupingSetExpansion {
+	allCols := spec.GroupingSetsCols()
+	res := make([]GroupingSetExpansion, len(spec.GroupingSets))
+	for i, set := range spec.GroupingSets {
+		var setCols intsets.Fast
+		for _, c := range set.Cols {
+			setCols.Add(int(c))
+		}
+		res[i].Values = make([]int64, 0, 1+len(spec.GroupingFuncs))
+		res[i].Values = append(res[i].Values, int64(i))
+		for _, fn := range spec.GroupingFuncs {
+			var mask int64
+			for _, c := range fn.Cols {
+				mask <<= 1
+				if !setCols.Contains(int(c)) {
+					mask |= 1
+				}
+			}
+			res[i].Values = append(res[i].Values, mask)
+		}
+		res[i].Empty = len(set.Cols) == 0
+		res[i].Cols = make([]int, len(allCols))
+		for j, c := range allCols {
+			res[i].Cols[j] = -1
+			if setCols.Contains(int(c)) {
+				res[i].Cols[j] = int(c)
+			}
+		}
+	}
+	return res
+}
+
 // GetWindowFuncIdx converts the window function name to the enum value with
 // the same string representation.
 func GetWindowFuncIdx(funcName string) (int32, error) {
@@ -189,6 +299,66 @@ func (spec *JoinReaderSpec) IsIndexJoin() bool {
 	return len(spec.LookupColumns) == 0 && spec.LookupExpr.Empty()
 }
 
+// RowSampler chooses the rows returned by a TableReader that samples rows,
+// and the ranges scanned for TABLESAMPLE SYSTEM. Whether a row is sampled only
+// depends on the seed and on the full key of the row, so the same rows are
+// sampled no matter how the spans are partitioned, and the rows sampled by
+// different TableReaders are independent. Hashing the full key rather than
+// some of its bytes keeps the sample uniform for keys that share long
+// prefixes, like those of unique_rowid() or time-ordered columns.
+type RowSampler struct {
+	seed        [8]byte
+	probability float64
+}
+
+// MakeRowSampler returns the RowSampler for a TableReader with SampleRows set.
+func (tr *TableReaderSpec) MakeRowSampler() RowSampler {
+	s := RowSampler{probability: tr.SampleProbability}
+	binary.BigEndian.PutUint64(s.seed[:], uint64(tr.SampleSeed))
+	return s
+}
+
+// Sample domains distinguish the hashes of rows from the hashes of ranges, so
+// that whether a range is sampled is independent of whether the row at its
+// start key is.
+const (
+	sampleDomainRow byte = iota
+	sampleDomainRange
+)
+
+// sample returns whether the row or range identified by the given domain and
+// bytes is included in a sample with the given probability.
+func (s *RowSampler) sample(domain byte, id []byte, probability float64) bool {
+	h := fnv.New64a()
+	_, _ = h.Write(s.seed[:])
+	_, _ = h.Write([]byte{domain})
+	_, _ = h.Write(id)
+	// FNV barely changes the top bits of the hash for keys that only differ in
+	// their last bytes, like consecutive integers, so mix the bits of the hash
+	// with the finalizer of MurmurHash3 before using its top 53 bits as a
+	// uniformly distributed fraction.
+	x := h.Sum64()
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return float64(x>>11)/(1<<53) < probability
+}
+
+// SampleRow returns whether the row with the given key (excluding the column
+// family suffix) should be included in the sample.
+func (s *RowSampler) SampleRow(key roachpb.Key) bool {
+	return s.sample(sampleDomainRow, key, s.probability)
+}
+
+// SampleRange returns whether the range with the given start key should be
+// scanned with the given probability. It is used by TABLESAMPLE SYSTEM (see
+// TableReaderSpec.SampleBlocks).
+func (s *RowSampler) SampleRange(startKey roachpb.RKey, probability float64) bool {
+	return s.sample(sampleDomainRange, startKey, probability)
+}
+
 // init performs some sanity checks for the invariants required by the
 // upperBuffer type.
 func init() {
@@ -135,7 +135,10 @@ type QueryLevelStats struct {
 	// UsedFollowerRead indicates whether at least some reads were served by the
 	// follower replicas.
 	UsedFollowerRead bool
-	ClientTime       time.Duration
+	// SwitchedToHashJoin indicates whether at least one adaptive lookup join
+	// switched to a hash join over a full scan of the lookup index.
+	SwitchedToHashJoin bool
+	ClientTime         time.Duration
 }
 
 // QueryLevelStatsWithErr is the same as QueryLevelStats, but also tracks
@@ -192,6 +195,7 @@ func (s *QueryLevelStats) Accumulate(other QueryLevelStats) {
 	s.KVNodeIDs = util.CombineUnique(s.KVNodeIDs, other.KVNodeIDs)
 	s.Regions = util.CombineUnique(s.Regions, other.Regions)
 	s.UsedFollowerRead = s.UsedFollowerRead || other.UsedFollowerRead
+	s.SwitchedToHashJoin = s.SwitchedToHashJoin || other.SwitchedToHashJoin
 	s.ClientTime += other.ClientTime
 }
 
@@ -265,6 +269,7 @@ func (a *TraceAnalyzer) ProcessStats() {
 		// Aggregate both KV and SQL regions into the same field.
 		s.Regions = util.CombineUnique(s.Regions, stats.KV.Regions)
 		s.UsedFollowerRead = s.UsedFollowerRead || stats.KV.UsedFollowerRead
+		s.SwitchedToHashJoin = s.SwitchedToHashJoin || stats.Join.SwitchedToHashJoin
 		s.KVBytesRead += int64(stats.KV.BytesRead.Value())
 		s.KVPairsRead += int64(stats.KV.KVPairsRead.Value())
 		s.KVRowsRead += int64(stats.KV.TuplesRead.Value())
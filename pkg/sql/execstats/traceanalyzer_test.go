@@ -265,6 +265,7 @@ func TestQueryLevelStatsAccumulate(t *testing.T) {
 		KVNodeIDs:                          []int32{1, 2},
 		Regions:                            []string{"east-usA"},
 		UsedFollowerRead:                   false,
+		SwitchedToHashJoin:                 false,
 		ClientTime:                         time.Second,
 	}
 	bEvent := kvpb.ContentionEvent{Duration: 14 * time.Second}
@@ -301,6 +302,7 @@ func TestQueryLevelStatsAccumulate(t *testing.T) {
 		KVNodeIDs:                          []int32{1, 3},
 		Regions:                            []string{"east-usB"},
 		UsedFollowerRead:                   true,
+		SwitchedToHashJoin:                 true,
 		ClientTime:                         2 * time.Second,
 	}
 	expected := execstats.QueryLevelStats{
@@ -336,6 +338,7 @@ func TestQueryLevelStatsAccumulate(t *testing.T) {
 		KVNodeIDs:                          []int32{1, 2, 3},
 		Regions:                            []string{"east-usA", "east-usB"},
 		UsedFollowerRead:                   true,
+		SwitchedToHashJoin:                 true,
 		ClientTime:                         3 * time.Second,
 	}
 
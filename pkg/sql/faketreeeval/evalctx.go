@@ -348,6 +348,11 @@ func (*DummyEvalPlanner) PLpgSQLCloseCursor(_ tree.Name) error {
 	return errors.WithStack(errEvalPlanner)
 }
 
+// SendNotification is part of the eval.Planner interface.
+func (*DummyEvalPlanner) SendNotification(_ context.Context, _, _ string) error {
+	return errors.WithStack(errEvalPlanner)
+}
+
 // PLpgSQLFetchCursor is part of the Planner interface.
 func (*DummyEvalPlanner) PLpgSQLFetchCursor(
 	context.Context, *tree.CursorStmt,
@@ -604,6 +609,13 @@ func (ep *DummyEvalPlanner) InsertStatementHint(
 	return 0, nil
 }
 
+// AcceptPlanBaseline is part of the eval.Planner interface.
+func (ep *DummyEvalPlanner) AcceptPlanBaseline(
+	ctx context.Context, hintID int64, verify bool,
+) (bool, error) {
+	return false, nil
+}
+
 // DummyPrivilegedAccessor implements the tree.PrivilegedAccessor interface by returning errors.
 type DummyPrivilegedAccessor struct{}
 
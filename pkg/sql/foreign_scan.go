@@ -0,0 +1,46 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// foreignScanNode represents a scan over the files of a foreign table. The
+// files are read by ForeignScan processors, so the node can only be executed
+// through DistSQL.
+type foreignScanNode struct {
+	zeroInputPlanNode
+
+	desc catalog.TableDescriptor
+
+	// neededCols are the ordinals of the public columns of the table that
+	// are produced by the scan, in the order in which they are produced.
+	neededCols []int
+
+	columns colinfo.ResultColumns
+}
+
+func (n *foreignScanNode) startExec(params runParams) error {
+	panic("foreignScanNode cannot be run in local mode")
+}
+
+// Next is part of the planNode interface.
+func (n *foreignScanNode) Next(params runParams) (bool, error) {
+	panic("foreignScanNode cannot be run in local mode")
+}
+
+// Values is part of the planNode interface.
+func (n *foreignScanNode) Values() tree.Datums {
+	panic("foreignScanNode cannot be run in local mode")
+}
+
+// Close is part of the planNode interface.
+func (n *foreignScanNode) Close(ctx context.Context) {}
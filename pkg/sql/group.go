@@ -11,6 +11,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
 )
 
 // A groupNode implements the planNode interface and handles the grouping logic.
@@ -32,6 +33,15 @@ type groupNode struct {
 	// even if there are no input rows, e.g. SELECT MIN(x) FROM t.
 	isScalar bool
 
+	// groupingSets, if set, contains the grouping sets of a GROUP BY ROLLUP,
+	// CUBE or GROUPING SETS clause, as subsets of groupCols. In that case, the
+	// group columns of the output are NULL for the grouping sets that do not
+	// contain them, and the output is followed by the value of a GROUPING()
+	// function for each element of groupingFuncs, which contains their
+	// arguments.
+	groupingSets  [][]exec.NodeColumnOrdinal
+	groupingFuncs [][]exec.NodeColumnOrdinal
+
 	// funcs contains the information about all aggregate functions.
 	funcs []*aggregateFuncHolder
 
@@ -80,6 +90,10 @@ type aggregateFuncHolder struct {
 	// distsqlBlocklist is set when this function cannot be evaluated in
 	// distributed fashion.
 	distsqlBlocklist bool
+	// userDefined is set if this is a user-defined aggregate, in which case
+	// resultType is its result type.
+	userDefined *exec.UserDefinedAggInfo
+	resultType  *types.T
 }
 
 // newAggregateFuncHolder creates an aggregateFuncHolder.
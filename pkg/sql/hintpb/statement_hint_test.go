@@ -39,4 +39,8 @@ func TestFromToBytes(t *testing.T) {
 	}
 	testRT(&InjectHints{})
 	testRT(&InjectHints{DonorSQL: "SELECT * FROM t"})
+	testRT(&PlanBaseline{})
+	testRT(&PlanBaseline{
+		PlanGist: "AgHQAQIAAwAAAAMGAg==", EstimatedCost: 10.5, Accepted: true, SampleSQL: "SELECT * FROM t WHERE a = 1",
+	})
 }
@@ -77,6 +77,10 @@ type StatementHintsCache struct {
 		// hintCache, not for hintedHashes.
 		// TODO(drewk): consider making this a metric.
 		numInternalQueries int
+
+		// pendingCaptures contains the plans that are being captured as plan
+		// baselines by async tasks. See CapturePlanBaselineAsync.
+		pendingCaptures map[planCaptureKey]struct{}
 	}
 
 	// Used to start/coordinate the rangefeed.
@@ -107,3 +107,68 @@ func InsertHintIntoDB(
 	// local node's cache.
 	return int64(tree.MustBeDInt(row[0])), nil
 }
+
+// GetStatementHintFromDB reads the hint with the given ID from the
+// system.statement_hints table, along with the statement fingerprint to which
+// it applies. ok is false if there is no such hint.
+func GetStatementHintFromDB(
+	ctx context.Context, txn isql.Txn, hintID int64,
+) (fingerprint string, hint hintpb.StatementHintUnion, ok bool, err error) {
+	const opName = "get-statement-hint"
+	const getHintStmt = `SELECT "fingerprint", "hint" FROM system.statement_hints WHERE "row_id" = $1`
+	row, err := txn.QueryRowEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		getHintStmt, hintID,
+	)
+	if err != nil || row == nil {
+		return "", hintpb.StatementHintUnion{}, false, err
+	}
+	hint, err = hintpb.FromBytes([]byte(tree.MustBeDBytes(row[1])))
+	if err != nil {
+		return "", hintpb.StatementHintUnion{}, false, err
+	}
+	return string(tree.MustBeDString(row[0])), hint, true, nil
+}
+
+// GetFingerprintHintsFromDB reads the hints for the given statement
+// fingerprint from the system.statement_hints table, using the given
+// transaction. The returned slices have the same length, and are in order of
+// hint ID.
+func GetFingerprintHintsFromDB(
+	ctx context.Context, txn isql.Txn, fingerprint string,
+) (hintIDs []int64, hints []hintpb.StatementHintUnion, _ error) {
+	const opName = "get-fingerprint-hints"
+	const getHintsStmt = `
+    SELECT "row_id", "hint"
+    FROM system.statement_hints
+    WHERE "hash" = fnv64($1) AND "fingerprint" = $1
+    ORDER BY "row_id" ASC`
+	rows, err := txn.QueryBufferedEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		getHintsStmt, fingerprint,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, row := range rows {
+		hint, err := hintpb.FromBytes([]byte(tree.MustBeDBytes(row[1])))
+		if err != nil {
+			return nil, nil, err
+		}
+		hintIDs = append(hintIDs, int64(tree.MustBeDInt(row[0])))
+		hints = append(hints, hint)
+	}
+	return hintIDs, hints, nil
+}
+
+// DeleteHintFromDB deletes the hint with the given ID from the
+// system.statement_hints table. It returns false if there was no such hint.
+func DeleteHintFromDB(ctx context.Context, txn isql.Txn, hintID int64) (bool, error) {
+	const opName = "delete-statement-hint"
+	const deleteStmt = `DELETE FROM system.statement_hints WHERE "row_id" = $1`
+	n, err := txn.ExecEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		deleteStmt, hintID,
+	)
+	return n > 0, err
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package hints
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/hintpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/logtags"
+)
+
+// maxPlanBaselinesPerFingerprint is the maximum number of plan baselines that
+// are captured for a single statement fingerprint. Once it is reached, new
+// plans for the fingerprint are no longer captured.
+const maxPlanBaselinesPerFingerprint = 10
+
+// planCaptureKey identifies a plan that is being captured as a plan baseline.
+type planCaptureKey struct {
+	fingerprint string
+	planGist    string
+}
+
+// CanCapturePlanBaseline returns true if the plan with the given gist can be
+// captured as a new plan baseline of a statement fingerprint with the given
+// hints, i.e., if none of the hints is a baseline with the same plan gist and
+// the fingerprint does not yet have the maximum number of baselines.
+func CanCapturePlanBaseline(stmtHints []hintpb.StatementHintUnion, planGist string) bool {
+	var numBaselines int
+	for i := range stmtHints {
+		if baseline, ok := stmtHints[i].GetValue().(*hintpb.PlanBaseline); ok {
+			if baseline.PlanGist == planGist {
+				return false
+			}
+			numBaselines++
+		}
+	}
+	return numBaselines < maxPlanBaselinesPerFingerprint
+}
+
+// CapturePlanBaseline records the plan with the given gist and estimated cost
+// as a candidate plan baseline for the given statement fingerprint. sampleSQL
+// is the statement for which the plan was chosen. Candidates are not used by
+// the optimizer until they are accepted. Nothing is recorded if the plan
+// cannot be captured (see CanCapturePlanBaseline). It returns true if a new
+// baseline was recorded.
+func CapturePlanBaseline(
+	ctx context.Context,
+	txn isql.Txn,
+	fingerprint, planGist, sampleSQL string,
+	estimatedCost float64,
+) (bool, error) {
+	_, fingerprintHints, err := GetFingerprintHintsFromDB(ctx, txn, fingerprint)
+	if err != nil {
+		return false, err
+	}
+	if !CanCapturePlanBaseline(fingerprintHints, planGist) {
+		return false, nil
+	}
+	var hint hintpb.StatementHintUnion
+	hint.SetValue(&hintpb.PlanBaseline{
+		PlanGist: planGist, EstimatedCost: estimatedCost, SampleSQL: sampleSQL,
+	})
+	if _, err := InsertHintIntoDB(ctx, txn, fingerprint, hint); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// AcceptPlanBaseline marks the plan baseline with the given hint ID as
+// accepted, and any other accepted baseline for the same statement fingerprint
+// as no longer accepted. The modified hints are replaced by new rows with new
+// hint IDs, so that the change is noticed by the statement hints cache and by
+// cached plans for the fingerprint. It returns the new hint ID of the accepted
+// baseline.
+func AcceptPlanBaseline(ctx context.Context, txn isql.Txn, hintID int64) (int64, error) {
+	fingerprint, hint, ok, err := GetStatementHintFromDB(ctx, txn, hintID)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, errors.Newf("statement hint %d does not exist", hintID)
+	}
+	if _, ok := hint.GetValue().(*hintpb.PlanBaseline); !ok {
+		return 0, errors.Newf("statement hint %d is not a plan baseline", hintID)
+	}
+	hintIDs, fingerprintHints, err := GetFingerprintHintsFromDB(ctx, txn, fingerprint)
+	if err != nil {
+		return 0, err
+	}
+	newHintID := hintID
+	for i := range fingerprintHints {
+		baseline, ok := fingerprintHints[i].GetValue().(*hintpb.PlanBaseline)
+		if !ok {
+			continue
+		}
+		accept := hintIDs[i] == hintID
+		if baseline.Accepted == accept {
+			continue
+		}
+		newBaseline := *baseline
+		newBaseline.Accepted = accept
+		var newHint hintpb.StatementHintUnion
+		newHint.SetValue(&newBaseline)
+		id, err := replaceHintInDB(ctx, txn, hintIDs[i], fingerprint, newHint)
+		if err != nil {
+			return 0, err
+		}
+		if accept {
+			newHintID = id
+		}
+	}
+	return newHintID, nil
+}
+
+// replaceHintInDB replaces the hint with the given ID by a new row with the
+// given hint, preserving its creation time. The row is replaced rather than
+// updated in place because the statement hints cache only notices changes to
+// the set of hints for a fingerprint hash. It returns the new hint ID.
+func replaceHintInDB(
+	ctx context.Context,
+	txn isql.Txn,
+	hintID int64,
+	fingerprint string,
+	hint hintpb.StatementHintUnion,
+) (int64, error) {
+	const opName = "replace-statement-hint"
+	hintBytes, err := hintpb.ToBytes(hint)
+	if err != nil {
+		return 0, err
+	}
+	const deleteStmt = `DELETE FROM system.statement_hints WHERE "row_id" = $1 RETURNING "created_at"`
+	row, err := txn.QueryRowEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		deleteStmt, hintID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	if row == nil {
+		return 0, errors.Newf("statement hint %d does not exist", hintID)
+	}
+	const insertStmt = `
+    INSERT INTO system.statement_hints ("fingerprint", "hint", "created_at")
+    VALUES ($1, $2, $3)
+    RETURNING "row_id"`
+	row, err = txn.QueryRowEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		insertStmt, fingerprint, hintBytes, row[0],
+	)
+	if err != nil {
+		return 0, err
+	}
+	return int64(tree.MustBeDInt(row[0])), nil
+}
+
+// CapturePlanBaselineAsync launches an async task which records the plan with
+// the given gist and estimated cost as a candidate plan baseline for the given
+// statement fingerprint (see CapturePlanBaseline). Callers are expected to
+// check CanCapturePlanBaseline against the cached hints of the fingerprint
+// first, so that no transaction is started once the fingerprint has the
+// maximum number of baselines. Captures of the same plan that are already in
+// progress on this node are skipped.
+func (c *StatementHintsCache) CapturePlanBaselineAsync(
+	ctx context.Context, fingerprint, planGist, sampleSQL string, estimatedCost float64,
+) {
+	key := planCaptureKey{fingerprint: fingerprint, planGist: planGist}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.mu.pendingCaptures[key]; ok {
+		return
+	}
+	if c.mu.pendingCaptures == nil {
+		c.mu.pendingCaptures = make(map[planCaptureKey]struct{})
+	}
+	c.mu.pendingCaptures[key] = struct{}{}
+
+	// The capture should not be canceled along with the statement that
+	// triggered it, so it runs with a new context that only inherits the log
+	// tags of the statement.
+	const opName = "capture-plan-baseline"
+	if err := c.stopper.RunAsyncTask(context.Background(), opName, func(taskCtx context.Context) {
+		defer func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			delete(c.mu.pendingCaptures, key)
+		}()
+		taskCtx = logtags.AddTags(taskCtx, logtags.FromContext(ctx))
+		taskCtx, cancel := c.stopper.WithCancelOnQuiesce(taskCtx)
+		defer cancel()
+		var captured bool
+		if err := c.db.Txn(taskCtx, func(ctx context.Context, txn isql.Txn) (err error) {
+			captured, err = CapturePlanBaseline(ctx, txn, fingerprint, planGist, sampleSQL, estimatedCost)
+			return err
+		}); err != nil {
+			log.Dev.Warningf(taskCtx, "failed to capture plan baseline: %v", err)
+			return
+		}
+		if captured {
+			log.VEventf(taskCtx, 1, "captured plan baseline %s for fingerprint %s", planGist, fingerprint)
+		}
+	}); err != nil {
+		// The stopper is quiescing.
+		delete(c.mu.pendingCaptures, key)
+	}
+}
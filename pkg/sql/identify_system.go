@@ -54,7 +54,8 @@ func (p *planner) IdentifySystem(
 	ctx context.Context, n *pgrepltree.IdentifySystem,
 ) (planNode, error) {
 	return &identifySystemNode{
-		// TODO(#105130): correctly populate this field.
+		// The current WAL position is the read timestamp of the transaction,
+		// encoded like the LSNs streamed by START_REPLICATION.
 		lsn:       lsnutil.HLCToLSN(p.Txn().ReadTimestamp()),
 		clusterID: p.ExecCfg().NodeInfo.LogicalClusterID().String(),
 		database:  p.SessionData().Database,
@@ -0,0 +1,251 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfra"
+	"github.com/cockroachdb/cockroach/pkg/sql/execinfrapb"
+	"github.com/cockroachdb/cockroach/pkg/sql/row"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowexec"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/ctxgroup"
+	"github.com/cockroachdb/cockroach/pkg/util/parquet"
+	"github.com/cockroachdb/errors"
+)
+
+const foreignScanProcessorName = "foreignScanProcessor"
+
+// foreignScanProcessor is a processor that does not take any inputs. It reads
+// the rows of a foreign table from the files in its spec using the readers of
+// IMPORT. The files are read by a worker goroutine started in Start(), which
+// sends the rows over an internally maintained channel to Next().
+type foreignScanProcessor struct {
+	execinfra.ProcessorBase
+
+	spec execinfrapb.ForeignScanSpec
+	desc catalog.TableDescriptor
+
+	cancel context.CancelFunc
+	wg     ctxgroup.Group
+	rowCh  chan tree.Datums
+	// readErr is the error returned by the worker goroutine. It must only be
+	// accessed after rowCh is closed.
+	readErr error
+
+	row rowenc.EncDatumRow
+}
+
+var (
+	_ execinfra.Processor = &foreignScanProcessor{}
+	_ execinfra.RowSource = &foreignScanProcessor{}
+)
+
+func newForeignScanProcessor(
+	ctx context.Context,
+	flowCtx *execinfra.FlowCtx,
+	processorID int32,
+	spec execinfrapb.ForeignScanSpec,
+	post *execinfrapb.PostProcessSpec,
+) (execinfra.Processor, error) {
+	fsp := &foreignScanProcessor{
+		spec:  spec,
+		desc:  tabledesc.NewBuilder(&spec.Table).BuildImmutableTable(),
+		rowCh: make(chan tree.Datums),
+	}
+	if !fsp.desc.IsForeignTable() {
+		return nil, errors.AssertionFailedf("%q is not a foreign table", fsp.desc.GetName())
+	}
+	cols := fsp.desc.PublicColumns()
+	outputTypes := make([]*types.T, len(spec.NeededColumns))
+	for i, ord := range spec.NeededColumns {
+		outputTypes[i] = cols[ord].GetType()
+	}
+	fsp.row = make(rowenc.EncDatumRow, len(outputTypes))
+	if err := fsp.Init(ctx, fsp, post, outputTypes, flowCtx, processorID, nil, /* memMonitor */
+		execinfra.ProcStateOpts{
+			// This processor doesn't have any inputs to drain.
+			InputsToDrain: nil,
+			TrailingMetaCallback: func() []execinfrapb.ProducerMetadata {
+				fsp.close()
+				return nil
+			},
+		}); err != nil {
+		return nil, err
+	}
+	return fsp, nil
+}
+
+// Start is part of the RowSource interface.
+func (fsp *foreignScanProcessor) Start(ctx context.Context) {
+	ctx = fsp.StartInternal(ctx, foreignScanProcessorName)
+
+	grpCtx, cancel := context.WithCancel(ctx)
+	fsp.cancel = cancel
+	fsp.wg = ctxgroup.WithContext(grpCtx)
+	fsp.wg.GoCtx(func(ctx context.Context) error {
+		defer close(fsp.rowCh)
+		fsp.readErr = fsp.readFiles(ctx)
+		return nil
+	})
+}
+
+// Next is part of the RowSource interface.
+func (fsp *foreignScanProcessor) Next() (rowenc.EncDatumRow, *execinfrapb.ProducerMetadata) {
+	for fsp.State == execinfra.StateRunning {
+		datums, ok := <-fsp.rowCh
+		if !ok {
+			fsp.MoveToDraining(fsp.readErr)
+			break
+		}
+		for i := range datums {
+			fsp.row[i] = rowenc.DatumToEncDatumUnsafe(fsp.OutputTypes[i], datums[i])
+		}
+		if outRow := fsp.ProcessRowHelper(fsp.row); outRow != nil {
+			return outRow, nil
+		}
+	}
+	return nil, fsp.DrainHelper()
+}
+
+// ConsumerClosed is part of the RowSource interface.
+func (fsp *foreignScanProcessor) ConsumerClosed() {
+	fsp.close()
+}
+
+func (fsp *foreignScanProcessor) close() {
+	// fsp.Closed is set by fsp.InternalClose().
+	if fsp.Closed {
+		return
+	}
+
+	if fsp.cancel != nil {
+		fsp.cancel()
+	}
+	_ = fsp.wg.Wait()
+
+	fsp.InternalClose()
+}
+
+// emit sends the given row to Next(). The optimizer relies on the NOT NULL
+// constraints of the table, so rows that violate them are rejected.
+func (fsp *foreignScanProcessor) emit(ctx context.Context, row tree.Datums) error {
+	cols := fsp.desc.PublicColumns()
+	for i, ord := range fsp.spec.NeededColumns {
+		if row[i] == tree.DNull && !cols[ord].IsNullable() {
+			return sqlerrors.NewNonNullViolationError(cols[ord].GetName())
+		}
+	}
+	select {
+	case fsp.rowCh <- row:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// readFiles reads all files of the spec and emits their rows.
+func (fsp *foreignScanProcessor) readFiles(ctx context.Context) error {
+	format := fsp.desc.TableDesc().Foreign.Format
+	var fileFunc func(context.Context, *fileReader, int32, int64, chan string) error
+	switch format.Format {
+	case roachpb.IOFileFormat_CSV:
+		fileFunc = fsp.readCSVFile
+	case roachpb.IOFileFormat_Parquet:
+		fileFunc = fsp.readParquetFile
+	default:
+		return errors.AssertionFailedf("unsupported foreign table format %s", format.Format)
+	}
+	dataFiles := make(map[int32]string, len(fsp.spec.URIs))
+	for i, uri := range fsp.spec.URIs {
+		dataFiles[int32(i)] = uri
+	}
+	return readInputFiles(
+		ctx, dataFiles, nil /* resumePos */, format, fileFunc, fsp.FlowCtx.Cfg.ExternalStorage,
+		fsp.spec.User(),
+	)
+}
+
+func (fsp *foreignScanProcessor) readCSVFile(
+	ctx context.Context, input *fileReader, _ int32, _ int64, _ chan string,
+) error {
+	opts := fsp.desc.TableDesc().Foreign.Format.Csv
+	visibleCols := fsp.desc.VisibleColumns()
+	producer, consumer := newCSVPipeline(&csvInputReader{
+		numExpectedDataCols: len(visibleCols),
+		opts:                opts,
+	}, input)
+
+	// The consumer only needs the parts of the converter that are used to
+	// parse the fields of the needed columns.
+	semaCtx := tree.MakeSemaContext(nil /* resolver */)
+	conv := &row.DatumRowConverter{
+		Datums:          make(tree.Datums, len(fsp.spec.NeededColumns)),
+		EvalCtx:         fsp.FlowCtx.NewEvalCtx(),
+		SemaCtx:         &semaCtx,
+		VisibleCols:     visibleCols,
+		VisibleColTypes: make([]*types.T, len(visibleCols)),
+	}
+	for i, col := range visibleCols {
+		conv.VisibleColTypes[i] = col.GetType()
+	}
+	for _, ord := range fsp.spec.NeededColumns {
+		conv.TargetColOrds.Add(int(ord))
+	}
+
+	var rowNum int64
+	for producer.Scan() {
+		rowNum++
+		if rowNum <= int64(opts.Skip) {
+			continue
+		}
+		record, err := producer.Row()
+		if err != nil {
+			return err
+		}
+		if err := consumer.FillDatums(ctx, record, rowNum, conv); err != nil {
+			return err
+		}
+		if err := fsp.emit(ctx, append(tree.Datums(nil), conv.Datums...)); err != nil {
+			return err
+		}
+	}
+	return producer.Err()
+}
+
+func (fsp *foreignScanProcessor) readParquetFile(
+	ctx context.Context, input *fileReader, _ int32, _ int64, _ chan string,
+) error {
+	// Parquet files have their metadata at the end, so the whole file has to
+	// be read into memory first.
+	buf, err := io.ReadAll(input)
+	if err != nil {
+		return err
+	}
+	cols := fsp.desc.PublicColumns()
+	names := make([]string, len(fsp.spec.NeededColumns))
+	typs := make([]*types.T, len(fsp.spec.NeededColumns))
+	for i, ord := range fsp.spec.NeededColumns {
+		names[i] = cols[ord].GetName()
+		typs[i] = cols[ord].GetType()
+	}
+	return parquet.ReadRows(bytes.NewReader(buf), names, typs, func(row tree.Datums) error {
+		return fsp.emit(ctx, row)
+	})
+}
+
+func init() {
+	rowexec.NewForeignScanProcessor = newForeignScanProcessor
+}
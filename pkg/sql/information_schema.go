@@ -819,7 +819,7 @@ https://www.postgresql.org/docs/9.5/infoschema-constraint-column-usage.html`,
 					cols = refTable.ForeignKeyReferencedColumns(fk)
 				} else if uwi := c.AsUniqueWithIndex(); uwi != nil {
 					cols = table.IndexKeyColumns(uwi)
-				} else if uwoi := c.AsUniqueWithoutIndex(); uwoi != nil {
+				} else if uwoi := c.AsUniqueWithoutIndex(); uwoi != nil && !uwoi.IsExclusion() {
 					cols = table.UniqueWithoutIndexColumns(uwoi)
 				}
 				for _, col := range cols {
@@ -863,7 +863,7 @@ https://www.postgresql.org/docs/9.5/infoschema-key-column-usage.html`,
 					cols = table.ForeignKeyOriginColumns(fk)
 				} else if uwi := c.AsUniqueWithIndex(); uwi != nil {
 					cols = table.IndexKeyColumns(uwi)
-				} else if uwoi := c.AsUniqueWithoutIndex(); uwoi != nil {
+				} else if uwoi := c.AsUniqueWithoutIndex(); uwoi != nil && !uwoi.IsExclusion() {
 					cols = table.UniqueWithoutIndexColumns(uwoi)
 				}
 				for pos, col := range cols {
@@ -1459,6 +1459,10 @@ https://www.postgresql.org/docs/9.5/infoschema-table-constraints.html`,
 				tbNameStr := tree.NewDString(table.GetName())
 
 				for _, c := range table.AllConstraints() {
+					// Like Postgres, exclusion constraints are not included.
+					if u := c.AsUniqueWithoutIndex(); u != nil && u.IsExclusion() {
+						continue
+					}
 					kind := catconstants.ConstraintTypeUnique
 					if c.AsCheck() != nil {
 						kind = catconstants.ConstraintTypeCheck
@@ -1467,16 +1471,17 @@ https://www.postgresql.org/docs/9.5/infoschema-table-constraints.html`,
 					} else if u := c.AsUniqueWithIndex(); u != nil && u.Primary() {
 						kind = catconstants.ConstraintTypePK
 					}
+					deferrable, initiallyDeferred := constraintDeferrability(c)
 					if err := addRow(
-						dbNameStr,                     // constraint_catalog
-						scNameStr,                     // constraint_schema
-						tree.NewDString(c.GetName()),  // constraint_name
-						dbNameStr,                     // table_catalog
-						scNameStr,                     // table_schema
-						tbNameStr,                     // table_name
-						tree.NewDString(string(kind)), // constraint_type
-						yesOrNoDatum(false),           // is_deferrable
-						yesOrNoDatum(false),           // initially_deferred
+						dbNameStr,                       // constraint_catalog
+						scNameStr,                       // constraint_schema
+						tree.NewDString(c.GetName()),    // constraint_name
+						dbNameStr,                       // table_catalog
+						scNameStr,                       // table_schema
+						tbNameStr,                       // table_name
+						tree.NewDString(string(kind)),   // constraint_type
+						yesOrNoDatum(deferrable),        // is_deferrable
+						yesOrNoDatum(initiallyDeferred), // initially_deferred
 					); err != nil {
 						return err
 					}
@@ -1656,6 +1661,7 @@ var (
 	tableTypeBaseTable  = tree.NewDString("BASE TABLE")
 	tableTypeView       = tree.NewDString("VIEW")
 	tableTypeTemporary  = tree.NewDString("LOCAL TEMPORARY")
+	tableTypeForeign    = tree.NewDString("FOREIGN")
 )
 
 var informationSchemaTablesTable = virtualSchemaTable{
@@ -1680,6 +1686,9 @@ https://www.postgresql.org/docs/9.5/infoschema-tables.html`,
 				} else if table.IsView() {
 					tableType = tableTypeView
 					insertable = noString
+				} else if table.IsForeignTable() {
+					tableType = tableTypeForeign
+					insertable = noString
 				} else if table.IsTemporary() {
 					tableType = tableTypeTemporary
 				}
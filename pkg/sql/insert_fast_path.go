@@ -255,7 +255,9 @@ func (r *insertFastPathRun) addFKChecks(
 				return c.errorForRow(inputRow)
 			}
 			// We have a row with only NULLS, or a row with some NULLs and match
-			// method PARTIAL. We can skip this FK check for this row.
+			// method SIMPLE. We can skip this FK check for this row. (The checks of
+			// MATCH PARTIAL FKs on columns that can be NULL are not simple lookups,
+			// so they never use the fast path.)
 			continue
 		}
 
@@ -1143,6 +1143,7 @@ func (m execNodeTraceMetadata) annotateExplain(
 						nodeStats.SQLCPUTime.MaybeAdd(stats.Exec.CPUTime)
 					}
 					nodeStats.UsedFollowerRead = nodeStats.UsedFollowerRead || stats.KV.UsedFollowerRead
+					nodeStats.SwitchedToHashJoin = nodeStats.SwitchedToHashJoin || stats.Join.SwitchedToHashJoin
 				}
 			}
 			// If we didn't get statistics for all processors, we don't show the
@@ -962,6 +962,9 @@ func applyOverrides(o sessiondata.InternalExecutorOverride, sd *sessiondata.Sess
 	if o.AlwaysDistributeFullScans {
 		sd.AlwaysDistributeFullScans = true
 	}
+	if o.AllowMaterializedViewMutation {
+		sd.AllowMaterializedViewMutation = true
+	}
 	// For 25.2, we're being conservative and explicitly disabling buffered
 	// writes for the internal executor.
 	// TODO(yuzefovich): remove this for 25.3.
@@ -1685,6 +1688,13 @@ func (icc *internalClientComm) CreateCopyOutResult(cmd CopyOut, pos CmdPos) Copy
 	panic("unimplemented")
 }
 
+// CreateStartReplicationResult is part of the ClientComm interface.
+func (icc *internalClientComm) CreateStartReplicationResult(
+	cmd StartReplication, pos CmdPos,
+) StartReplicationResult {
+	panic("unimplemented")
+}
+
 // CreateDrainResult is part of the ClientComm interface.
 func (icc *internalClientComm) CreateDrainResult(pos CmdPos) DrainResult {
 	panic("unimplemented")
@@ -165,6 +165,14 @@ func (i *internalCommandResult) SendCopyOut(
 	return errors.AssertionFailedf("SendCopyOut not supported by internal session")
 }
 
+func (i *internalCommandResult) SendCopyBoth(ctx context.Context) error {
+	return errors.AssertionFailedf("SendCopyBoth not supported by internal session")
+}
+
+func (i *internalCommandResult) FlushCopyData(ctx context.Context) error {
+	return errors.AssertionFailedf("FlushCopyData not supported by internal session")
+}
+
 func (i *internalCommandResult) SetPortalOutput(
 	ctx context.Context, cols colinfo.ResultColumns, formatCodes []pgwirebase.FormatCode,
 ) {
@@ -166,6 +166,13 @@ func (i *resultBuffer) CreateCopyOutResult(cmd sql.CopyOut, pos sql.CmdPos) sql.
 	return i.newCommand(pos)
 }
 
+// CreateStartReplicationResult implements ClientComm.
+func (i *resultBuffer) CreateStartReplicationResult(
+	cmd sql.StartReplication, pos sql.CmdPos,
+) sql.StartReplicationResult {
+	return i.newCommand(pos)
+}
+
 // CreateDeleteResult implements ClientComm.
 func (i *resultBuffer) CreateDeleteResult(pos sql.CmdPos) sql.DeleteResult {
 	return i.newCommand(pos)
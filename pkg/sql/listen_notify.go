@@ -0,0 +1,209 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/notify"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// sessionNotifications contains the LISTEN/NOTIFY state of a session.
+//
+// LISTEN and UNLISTEN only take effect when the transaction that executes them
+// commits, so they are recorded in listenActions and applied by finishTxn.
+// NOTIFY writes a row to system.notifications in the transaction, which makes
+// the notification visible to the listeners only once the transaction commits.
+type sessionNotifications struct {
+	registry *notify.Registry
+	// push asks the connExecutor to deliver the pending notifications, by
+	// pushing a DeliverNotifications command into its StmtBuf.
+	push func()
+	// listener is created when the session first listens on a channel.
+	listener *notify.Listener
+	// wakePending is set when a DeliverNotifications command has been pushed
+	// and not yet processed, to avoid pushing one for every notification.
+	wakePending atomic.Bool
+
+	// listenActions are the LISTEN and UNLISTEN statements executed by the
+	// current transaction, in order.
+	listenActions []listenAction
+	// seq numbers the notifications sent by the current transaction, so they
+	// are delivered in the order they were sent.
+	seq int32
+}
+
+// listenAction is a LISTEN or UNLISTEN statement. An empty channel with
+// unlisten set denotes UNLISTEN *.
+type listenAction struct {
+	channel  string
+	unlisten bool
+}
+
+func newSessionNotifications(registry *notify.Registry, push func()) *sessionNotifications {
+	return &sessionNotifications{registry: registry, push: push}
+}
+
+// wake is called by the listener when a notification is queued for the
+// session.
+func (sn *sessionNotifications) wake() {
+	if sn.wakePending.CompareAndSwap(false, true) {
+		sn.push()
+	}
+}
+
+// finishTxn applies the LISTEN and UNLISTEN statements of the transaction if
+// it committed, and resets the per-transaction state.
+func (sn *sessionNotifications) finishTxn(commit bool) {
+	if commit {
+		for _, a := range sn.listenActions {
+			switch {
+			case !a.unlisten:
+				if sn.listener == nil {
+					sn.listener = sn.registry.NewListener(sn.wake)
+				}
+				sn.listener.Listen(a.channel)
+			case sn.listener == nil:
+				// Not listening on any channel.
+			case a.channel == "":
+				sn.listener.UnlistenAll()
+			default:
+				sn.listener.Unlisten(a.channel)
+			}
+		}
+	}
+	sn.listenActions = nil
+	sn.seq = 0
+}
+
+// drain sends the notifications queued for the session to the client.
+func (sn *sessionNotifications) drain(res NotificationSender) {
+	sn.wakePending.Store(false)
+	if sn.listener == nil {
+		return
+	}
+	// Unlike Postgres, which makes NOTIFY fail when its notification queue is
+	// full, notifications are dropped or missed by the listening sessions, so
+	// they are told about it with a notice.
+	dropped, missed := sn.listener.TakeLosses()
+	if dropped > 0 {
+		res.BufferNotice(pgnotice.Newf(
+			"%d notifications were dropped because too many were queued for this session", dropped,
+		))
+	}
+	if missed {
+		res.BufferNotice(pgnotice.Newf(
+			"notifications may have been missed because their delivery was interrupted",
+		))
+	}
+	for _, n := range sn.listener.Drain() {
+		res.BufferNotification(n)
+	}
+}
+
+// close stops listening on all channels when the session ends.
+func (sn *sessionNotifications) close() {
+	if sn.listener != nil {
+		sn.listener.UnlistenAll()
+	}
+}
+
+// maybeDeliverNotifications sends the pending notifications of the session to
+// the client through res, if the session is not inside a transaction. As in
+// Postgres, notifications are never delivered in the middle of a transaction.
+func (ex *connExecutor) maybeDeliverNotifications(res ResultBase) {
+	if ex.notifications == nil {
+		return
+	}
+	if !ex.idleConn() {
+		// The notifications will be delivered at the end of the transaction,
+		// when the Sync command is processed.
+		ex.notifications.wakePending.Store(false)
+		return
+	}
+	if sender, ok := res.(NotificationSender); ok {
+		ex.notifications.drain(sender)
+	}
+}
+
+// Listen implements the LISTEN statement.
+// See https://www.postgresql.org/docs/current/sql-listen.html for details.
+func (p *planner) Listen(ctx context.Context, n *tree.Listen) (planNode, error) {
+	channel := string(n.ChannelName)
+	if err := notify.ValidateChannel(channel); err != nil {
+		return nil, err
+	}
+	return &delayedNode{
+		name: n.String(),
+		constructor: func(ctx context.Context, p *planner) (planNode, error) {
+			sn := p.extendedEvalCtx.notifications
+			if sn == nil {
+				return nil, pgerror.New(pgcode.FeatureNotSupported,
+					"LISTEN is only supported in client sessions")
+			}
+			sn.listenActions = append(sn.listenActions, listenAction{channel: channel})
+			return newZeroNode(nil /* columns */), nil
+		},
+	}, nil
+}
+
+// Unlisten implements the UNLISTEN statement.
+// See https://www.postgresql.org/docs/current/sql-unlisten.html for details.
+func (p *planner) Unlisten(ctx context.Context, n *tree.Unlisten) (planNode, error) {
+	return &delayedNode{
+		name: n.String(),
+		constructor: func(ctx context.Context, p *planner) (planNode, error) {
+			// Sessions that cannot listen have nothing to unlisten from.
+			if sn := p.extendedEvalCtx.notifications; sn != nil {
+				a := listenAction{unlisten: true}
+				if !n.Star {
+					a.channel = string(n.ChannelName)
+				}
+				sn.listenActions = append(sn.listenActions, a)
+			}
+			return newZeroNode(nil /* columns */), nil
+		},
+	}, nil
+}
+
+// Notify implements the NOTIFY statement.
+// See https://www.postgresql.org/docs/current/sql-notify.html for details.
+func (p *planner) Notify(ctx context.Context, n *tree.Notify) (planNode, error) {
+	var payload string
+	if n.Payload != nil {
+		payload = *n.Payload
+	}
+	return &delayedNode{
+		name: n.String(),
+		constructor: func(ctx context.Context, p *planner) (planNode, error) {
+			return newZeroNode(nil /* columns */), p.SendNotification(ctx, string(n.ChannelName), payload)
+		},
+	}, nil
+}
+
+// SendNotification is part of the eval.Planner interface.
+func (p *planner) SendNotification(ctx context.Context, channel, payload string) error {
+	if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_AddSystemNotificationsTable) {
+		return pgerror.New(pgcode.FeatureNotSupported,
+			"NOTIFY is not supported until the cluster upgrade is finalized")
+	}
+	var seq int32
+	if sn := p.extendedEvalCtx.notifications; sn != nil {
+		sn.seq++
+		seq = sn.seq
+	}
+	return notify.InsertNotification(ctx, p.InternalSQLTxn(), notify.Notification{
+		Channel: channel,
+		Payload: payload,
+		PID:     int32(p.extendedEvalCtx.QueryCancelKey.GetPGBackendPID()),
+	}, seq)
+}
@@ -0,0 +1,295 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver/concurrency/lock"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catalogkeys"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/util/encoding"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+	"github.com/cockroachdb/cockroach/pkg/util/uuid"
+	"github.com/cockroachdb/errors"
+)
+
+type lockTableNode struct {
+	zeroInputPlanNode
+	n *tree.LockTable
+}
+
+// LockTable acquires table-level locks on the given tables until the end of
+// the transaction.
+// Privileges: SELECT, INSERT, UPDATE or DELETE on the table for ACCESS SHARE
+// mode; INSERT, UPDATE or DELETE for ROW EXCLUSIVE mode; UPDATE or DELETE for
+// the other modes.
+//
+//	Notes: postgres also accepts TRUNCATE and MAINTAIN.
+func (p *planner) LockTable(ctx context.Context, n *tree.LockTable) (planNode, error) {
+	return &lockTableNode{n: n}, nil
+}
+
+func (n *lockTableNode) startExec(params runParams) error {
+	p := params.p
+	if p.extendedEvalCtx.TxnImplicit {
+		return pgerror.Newf(pgcode.NoActiveSQLTransaction,
+			"LOCK TABLE can only be used in transaction blocks")
+	}
+	if n.n.Mode > tree.LockTableRowExclusive && p.EvalContext().TxnReadOnly {
+		return readOnlyError("LOCK TABLE")
+	}
+
+	// Resolve all the tables and check the privileges before acquiring any
+	// lock.
+	tables := make([]catalog.TableDescriptor, len(n.n.Tables))
+	for i := range n.n.Tables {
+		desc, err := p.ResolveExistingObjectEx(
+			params.ctx, n.n.Tables[i].ToUnresolvedObjectName(), true /* required */, tree.ResolveRequireTableOrViewDesc,
+		)
+		if err != nil {
+			return err
+		}
+		if desc.IsVirtualTable() {
+			return pgerror.Newf(pgcode.WrongObjectType, "cannot lock virtual table %q", desc.GetName())
+		}
+		if desc.IsView() && !desc.MaterializedView() {
+			return unimplemented.Newf("lock view", "LOCK TABLE is not supported on view %q", desc.GetName())
+		}
+		if err := p.checkLockTablePrivilege(params.ctx, desc, n.n.Mode); err != nil {
+			return err
+		}
+		tables[i] = desc
+	}
+
+	for _, desc := range tables {
+		if n.n.Mode >= tree.LockTableShare && !desc.LockTableBlocksDML() {
+			p.BufferClientNotice(params.ctx, errors.WithHint(
+				pgnotice.Newf("LOCK TABLE does not block INSERT, UPDATE, UPSERT and DELETE statements on table %q",
+					desc.GetName()),
+				"Set the lock_table_blocks_dml storage parameter of the table to make these "+
+					"statements acquire a lock in ROW EXCLUSIVE mode.",
+			))
+		}
+		if err := acquireTableLock(
+			params.ctx, p.txn, p.ExecCfg().Codec, desc, n.n.Mode, n.n.NoWait, p.SessionData(),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// acquireDMLTableLock acquires the table-level lock in ROW EXCLUSIVE mode that
+// INSERT, UPDATE, UPSERT and DELETE statements take on a table with the
+// lock_table_blocks_dml storage parameter, which makes them conflict with LOCK
+// TABLE in SHARE and stronger modes. It is a no-op for other tables, so that
+// DML statements do not pay for the lock on tables that are never locked.
+func acquireDMLTableLock(
+	ctx context.Context,
+	txn *kv.Txn,
+	codec keys.SQLCodec,
+	desc catalog.TableDescriptor,
+	sd *sessiondata.SessionData,
+) error {
+	if !desc.LockTableBlocksDML() {
+		return nil
+	}
+	return acquireTableLock(ctx, txn, codec, desc, tree.LockTableRowExclusive, false /* noWait */, sd)
+}
+
+// acquireTableLock acquires a table-level lock in the given mode on behalf of
+// the given transaction.
+func acquireTableLock(
+	ctx context.Context,
+	txn *kv.Txn,
+	codec keys.SQLCodec,
+	desc catalog.TableDescriptor,
+	mode tree.LockTableMode,
+	noWait bool,
+	sd *sessiondata.SessionData,
+) error {
+	b := txn.NewBatch()
+	if noWait {
+		b.Header.WaitPolicy = lock.WaitPolicy_Error
+	}
+	if sd != nil {
+		b.Header.LockTimeout = sd.LockTimeout
+		b.Header.DeadlockTimeout = sd.DeadlockTimeout
+	}
+	for _, tl := range makeTableLocks(codec, desc.GetID(), mode, txn.ID()) {
+		if tl.endKey == nil {
+			b.AddRawRequest(&kvpb.GetRequest{
+				RequestHeader:      kvpb.RequestHeader{Key: tl.key},
+				KeyLockingStrength: tl.strength,
+				// Table locks are taken explicitly to exclude other transactions,
+				// so they must not be lost on lease transfers or range merges.
+				KeyLockingDurability: lock.Replicated,
+				LockNonExisting:      true,
+			})
+		} else {
+			b.AddRawRequest(&kvpb.ScanRequest{
+				RequestHeader:        kvpb.RequestHeader{Key: tl.key, EndKey: tl.endKey},
+				KeyLockingStrength:   tl.strength,
+				KeyLockingDurability: lock.Replicated,
+			})
+		}
+	}
+	if err := txn.Run(ctx, b); err != nil {
+		return convertLockTableError(err, desc)
+	}
+	return nil
+}
+
+func (*lockTableNode) Next(runParams) (bool, error) { return false, nil }
+func (*lockTableNode) Values() tree.Datums          { return nil }
+func (*lockTableNode) Close(context.Context)        {}
+
+// checkLockTablePrivilege checks that the user has one of the privileges that
+// are needed to lock the table in the given mode.
+func (p *planner) checkLockTablePrivilege(
+	ctx context.Context, desc catalog.TableDescriptor, mode tree.LockTableMode,
+) error {
+	var privs []privilege.Kind
+	switch {
+	case mode == tree.LockTableAccessShare:
+		privs = []privilege.Kind{privilege.SELECT, privilege.INSERT, privilege.UPDATE, privilege.DELETE}
+	case mode == tree.LockTableRowExclusive:
+		privs = []privilege.Kind{privilege.INSERT, privilege.UPDATE, privilege.DELETE}
+	default:
+		privs = []privilege.Kind{privilege.UPDATE, privilege.DELETE}
+	}
+	for _, priv := range privs {
+		if ok, err := p.HasPrivilege(ctx, desc, priv, p.User()); err != nil || ok {
+			return err
+		}
+	}
+	return sqlerrors.NewInsufficientPrivilegeOnDescriptorError(p.User(), privs,
+		string(desc.DescriptorType()), desc.GetName())
+}
+
+// tableLock is a lock that is acquired on behalf of a table-level lock. If
+// endKey is set, the lock is not acquired: the span is scanned with the given
+// strength instead, which waits for the conflicting locks held on the keys of
+// the span by other transactions.
+type tableLock struct {
+	key, endKey roachpb.Key
+	strength    lock.Strength
+}
+
+// numTableLockLevels is the number of keys on which the modes of table-level
+// locks are encoded.
+const numTableLockLevels = 4
+
+// makeTableLocks returns the KV locks that implement a table-level lock in the
+// given mode on behalf of the transaction with the given ID.
+//
+// Every mode acquires a shared lock on the table's descriptor, which makes it
+// conflict with schema changes of the table. Beyond that, the Postgres lock
+// conflict matrix is encoded on numTableLockLevels keys of the table: the four
+// modes that do not conflict with themselves acquire a shared lock on a single
+// level, and the four modes that do acquire exclusive locks on a range of
+// levels that ends with the last one:
+//
+//	level                   0  1  2  3
+//	ACCESS SHARE            S
+//	ROW SHARE                  S
+//	ROW EXCLUSIVE                 S
+//	SHARE                            S
+//	SHARE UPDATE EXCLUSIVE           X
+//	SHARE ROW EXCLUSIVE           X  X
+//	EXCLUSIVE                  X  X  X
+//	ACCESS EXCLUSIVE        X  X  X  X
+//
+// ROW EXCLUSIVE and SHARE conflict with each other but not with themselves,
+// which cannot be expressed with shared and exclusive locks on common keys.
+// Instead, each of them acquires an exclusive lock on a key of its level that
+// is private to the transaction, and scans the private keys of the other mode
+// with a shared strength, which waits for the transactions that hold the other
+// mode. Since both modes lock their private key before scanning, two
+// transactions that acquire them concurrently cannot both miss each other.
+func makeTableLocks(
+	codec keys.SQLCodec, id descpb.ID, mode tree.LockTableMode, txnID uuid.UUID,
+) []tableLock {
+	var from, to int
+	str := lock.Exclusive
+	switch mode {
+	case tree.LockTableAccessShare:
+		from, to, str = 0, 0, lock.Shared
+	case tree.LockTableRowShare:
+		from, to, str = 1, 1, lock.Shared
+	case tree.LockTableRowExclusive:
+		from, to, str = 2, 2, lock.Shared
+	case tree.LockTableShare:
+		from, to, str = 3, 3, lock.Shared
+	case tree.LockTableShareUpdateExclusive:
+		from, to = 3, 3
+	case tree.LockTableShareRowExclusive:
+		from, to = 2, 3
+	case tree.LockTableExclusive:
+		from, to = 1, 3
+	case tree.LockTableAccessExclusive:
+		from, to = 0, numTableLockLevels-1
+	}
+	locks := make([]tableLock, 0, to-from+4)
+	locks = append(locks, tableLock{
+		key:      catalogkeys.MakeDescMetadataKey(codec, id),
+		strength: lock.Shared,
+	})
+	for level := from; level <= to; level++ {
+		locks = append(locks, tableLock{key: makeTableLockKey(codec, id, level), strength: str})
+	}
+	var privateLevel, otherLevel int
+	switch mode {
+	case tree.LockTableRowExclusive:
+		privateLevel, otherLevel = 2, 3
+	case tree.LockTableShare:
+		privateLevel, otherLevel = 3, 2
+	default:
+		return locks
+	}
+	other := makeTableLockKey(codec, id, otherLevel)
+	return append(locks,
+		tableLock{
+			key:      append(makeTableLockKey(codec, id, privateLevel), txnID.GetBytes()...),
+			strength: lock.Exclusive,
+		},
+		tableLock{key: other.Next(), endKey: other.PrefixEnd(), strength: lock.Shared},
+	)
+}
+
+// makeTableLockKey returns the key of the given level of table-level locks.
+// The key lies in index 0 of the table, which never contains any data.
+func makeTableLockKey(codec keys.SQLCodec, id descpb.ID, level int) roachpb.Key {
+	return encoding.EncodeUvarintAscending(codec.IndexPrefix(uint32(id), 0), uint64(level))
+}
+
+// convertLockTableError converts the error returned when a table-level lock
+// could not be acquired into a user friendly SQL error.
+func convertLockTableError(err error, desc catalog.TableDescriptor) error {
+	var wiErr *kvpb.WriteIntentError
+	if !errors.As(err, &wiErr) {
+		return err
+	}
+	if wiErr.Reason == kvpb.WriteIntentError_REASON_LOCK_TIMEOUT {
+		return pgerror.Newf(pgcode.LockNotAvailable,
+			"canceling statement due to lock timeout on relation %q", desc.GetName())
+	}
+	return pgerror.Newf(pgcode.LockNotAvailable,
+		"could not obtain lock on relation %q", desc.GetName())
+}
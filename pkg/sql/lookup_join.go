@@ -92,6 +92,10 @@ type lookupJoinPlanningInfo struct {
 	// Streamer API is used.
 	parallelize bool
 
+	// estimatedTableRowCount is the optimizer's estimate of the number of rows
+	// in the lookup table, or zero if it is unknown.
+	estimatedTableRowCount uint64
+
 	// finalizeLastStageCb will be nil in the spec factory.
 	finalizeLastStageCb func(*physicalplan.PhysicalPlan)
 }
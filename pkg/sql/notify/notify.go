@@ -0,0 +1,584 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package notify implements the cluster-wide transport for the Postgres
+// LISTEN/NOTIFY asynchronous notification mechanism.
+//
+// NOTIFY inserts a row into the system.notifications table in the notifying
+// transaction, so a notification becomes visible if and only if its
+// transaction commits. Every node runs a Registry, which watches the table
+// with a rangefeed and hands the committed notifications to the Listeners of
+// the local sessions that listen on the corresponding channel. The rows are
+// deleted by a periodic cleanup once they have been delivered.
+package notify
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed/rangefeedbuffer"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed/rangefeedcache"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc/valueside"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/metric"
+	"github.com/cockroachdb/cockroach/pkg/util/retry"
+	"github.com/cockroachdb/cockroach/pkg/util/stop"
+	"github.com/cockroachdb/cockroach/pkg/util/syncutil"
+)
+
+// retention is how long notifications are kept in the system.notifications
+// table before they are deleted.
+var retention = settings.RegisterDurationSetting(
+	settings.ApplicationLevel,
+	"sql.notifications.retention",
+	"how long notifications sent by NOTIFY are kept in system.notifications "+
+		"before they are deleted",
+	10*time.Minute,
+	settings.DurationWithMinimum(time.Minute),
+)
+
+// cleanupInterval is how often each node deletes expired notifications.
+var cleanupInterval = settings.RegisterDurationSetting(
+	settings.ApplicationLevel,
+	"sql.notifications.cleanup_interval",
+	"how often expired notifications are deleted from system.notifications; "+
+		"0 disables the cleanup",
+	time.Minute,
+	settings.NonNegativeDuration,
+)
+
+const (
+	// MaxChannelLength is the maximum length of a channel name in bytes. It
+	// matches the maximum length of an identifier in Postgres.
+	MaxChannelLength = 63
+
+	// MaxPayloadLength is the maximum length of a notification payload in
+	// bytes. It matches the Postgres limit.
+	MaxPayloadLength = 7999
+
+	// maxPendingNotifications is the maximum number of notifications that are
+	// queued for a session that does not consume them. Older notifications are
+	// dropped once the limit is reached, which the session is told about with a
+	// notice (see Listener.TakeLosses).
+	maxPendingNotifications = 10000
+
+	// rangefeedBufferSize is the size of the internal buffer used by the
+	// rangefeed watcher to ensure emitted events are in timestamp order.
+	rangefeedBufferSize = 1 << 16
+
+	// cleanupBatchSize is the number of rows deleted by each statement of the
+	// cleanup.
+	cleanupBatchSize = 1000
+)
+
+var (
+	metaDroppedNotifications = metric.Metadata{
+		Name:        "sql.notifications.dropped",
+		Help:        "Number of notifications sent by NOTIFY that were dropped because too many were queued for a listening session",
+		Measurement: "Notifications",
+		Unit:        metric.Unit_COUNT,
+	}
+	metaRangefeedRestarts = metric.Metadata{
+		Name:        "sql.notifications.rangefeed_restarts",
+		Help:        "Number of times the rangefeed that delivers the notifications sent by NOTIFY was restarted, possibly missing notifications",
+		Measurement: "Restarts",
+		Unit:        metric.Unit_COUNT,
+	}
+)
+
+// Metrics are the metrics of a Registry.
+type Metrics struct {
+	DroppedNotifications *metric.Counter
+	RangefeedRestarts    *metric.Counter
+}
+
+// MetricStruct implements the metric.Struct interface.
+func (*Metrics) MetricStruct() {}
+
+var _ metric.Struct = (*Metrics)(nil)
+
+// Notification is an asynchronous notification sent with NOTIFY or
+// pg_notify().
+type Notification struct {
+	Channel string
+	Payload string
+	// PID is the backend PID of the notifying session.
+	PID int32
+}
+
+// ValidateChannel returns an error if the given string is not a valid channel
+// name.
+func ValidateChannel(channel string) error {
+	if channel == "" {
+		return pgerror.New(pgcode.InvalidParameterValue, "channel name cannot be empty")
+	}
+	if len(channel) > MaxChannelLength {
+		return pgerror.New(pgcode.InvalidParameterValue, "channel name too long")
+	}
+	return nil
+}
+
+// InsertNotification adds the given notification to the system.notifications
+// table within the given transaction. seq is the position of the notification
+// within the transaction; it determines the order in which notifications
+// sent by the same transaction are delivered.
+func InsertNotification(ctx context.Context, txn isql.Txn, n Notification, seq int32) error {
+	const opName = "insert-notification"
+	if err := ValidateChannel(n.Channel); err != nil {
+		return err
+	}
+	if len(n.Payload) > MaxPayloadLength {
+		return pgerror.New(pgcode.InvalidParameterValue, "payload string too long")
+	}
+	const insertStmt = `INSERT INTO system.notifications ("channel", "payload", "pid", "seq") VALUES ($1, $2, $3, $4)`
+	_, err := txn.ExecEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		insertStmt, n.Channel, n.Payload, n.PID, seq,
+	)
+	return err
+}
+
+// Registry delivers the notifications committed to the system.notifications
+// table to the Listeners of the local sessions. There is one Registry per
+// node.
+//
+// Notifications are only delivered once the rangefeed frontier has passed
+// their commit timestamp, so that they are delivered in commit order. If the
+// rangefeed has to be restarted, the notifications committed while it was
+// down are not delivered; the listening sessions are told about it with a
+// notice, and the restart is counted in the metrics.
+type Registry struct {
+	clock   *hlc.Clock
+	f       *rangefeed.Factory
+	stopper *stop.Stopper
+	codec   keys.SQLCodec
+	db      isql.DB
+	st      *cluster.Settings
+	metrics *Metrics
+
+	// decoder and datumAlloc are used to decode the rows of the table. They
+	// are only accessed by the rangefeed watcher goroutine.
+	decoder    valueside.Decoder
+	datumAlloc tree.DatumAlloc
+
+	mu struct {
+		syncutil.Mutex
+		// channels maps each channel to the set of Listeners on it.
+		channels map[string]map[*Listener]struct{}
+	}
+}
+
+// NewRegistry creates a new Registry. Start must be called before any
+// notifications are delivered.
+func NewRegistry(
+	clock *hlc.Clock,
+	f *rangefeed.Factory,
+	stopper *stop.Stopper,
+	codec keys.SQLCodec,
+	db isql.DB,
+	st *cluster.Settings,
+) *Registry {
+	r := &Registry{
+		clock:   clock,
+		f:       f,
+		stopper: stopper,
+		codec:   codec,
+		db:      db,
+		st:      st,
+		metrics: &Metrics{
+			DroppedNotifications: metric.NewCounter(metaDroppedNotifications),
+			RangefeedRestarts:    metric.NewCounter(metaRangefeedRestarts),
+		},
+		decoder: valueside.MakeDecoder(systemschema.NotificationsTable.PublicColumns()),
+	}
+	r.mu.channels = make(map[string]map[*Listener]struct{})
+	return r
+}
+
+// Metrics returns the metrics of the Registry.
+func (r *Registry) Metrics() *Metrics {
+	return r.metrics
+}
+
+// Start begins watching for notifications in the system.notifications table
+// and periodically deleting the expired ones. The table may not exist yet if
+// the cluster has not been upgraded, in which case the Registry waits for it
+// in the background.
+func (r *Registry) Start(ctx context.Context, sysTableResolver catalog.SystemTableIDResolver) error {
+	return r.stopper.RunAsyncTask(ctx, "notifications-registry", func(ctx context.Context) {
+		ctx, cancel := r.stopper.WithCancelOnQuiesce(ctx)
+		defer cancel()
+		var tableID descpb.ID
+		for re := retry.StartWithCtx(ctx, retry.Options{
+			InitialBackoff: time.Second,
+			MaxBackoff:     time.Minute,
+		}); re.Next(); {
+			id, err := sysTableResolver.LookupSystemTableID(ctx, systemschema.NotificationsTable.GetName())
+			if err != nil {
+				log.Dev.Warningf(ctx, "failed to look up the notifications table: %v", err)
+				continue
+			}
+			if id != descpb.InvalidID {
+				tableID = id
+				break
+			}
+		}
+		if tableID == descpb.InvalidID {
+			// The server is shutting down.
+			return
+		}
+		if err := r.startRangefeed(ctx, tableID); err != nil {
+			log.Dev.Warningf(ctx, "failed to start the notifications rangefeed: %v", err)
+			return
+		}
+		r.runCleanup(ctx)
+	})
+}
+
+// startRangefeed starts a rangefeed on the primary index of the
+// system.notifications table.
+func (r *Registry) startRangefeed(ctx context.Context, tableID descpb.ID) error {
+	prefix := r.codec.IndexPrefix(uint32(tableID), uint32(systemschema.NotificationsTable.GetPrimaryIndexID()))
+	span := roachpb.Span{Key: prefix, EndKey: prefix.PrefixEnd()}
+	watcher := rangefeedcache.NewWatcher(
+		"notifications-watcher",
+		r.clock, r.f,
+		rangefeedBufferSize,
+		[]roachpb.Span{span},
+		false, /* withPrevValue */
+		false, /* withRowTSInInitialScan */
+		r.translateEvent,
+		r.onUpdate,
+		nil, /* knobs */
+	)
+	// The watcher retries until the stopper stops. This function only returns
+	// an error if we're shutting down.
+	return rangefeedcache.Start(ctx, r.stopper, watcher, r.onRangefeedError)
+}
+
+// onRangefeedError is called when the rangefeed fails and is about to be
+// restarted. The notifications committed until the restart completes are not
+// delivered, so every Listener is told that it may have missed some.
+func (r *Registry) onRangefeedError(err error) {
+	r.metrics.RangefeedRestarts.Inc(1)
+	var listeners []*Listener
+	func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		seen := make(map[*Listener]struct{})
+		for _, channelListeners := range r.mu.channels {
+			for l := range channelListeners {
+				if _, ok := seen[l]; !ok {
+					seen[l] = struct{}{}
+					listeners = append(listeners, l)
+				}
+			}
+		}
+	}()
+	for _, l := range listeners {
+		l.markMissed()
+	}
+}
+
+// bufferEvent is a notification observed by the rangefeed.
+type bufferEvent struct {
+	ts  hlc.Timestamp
+	seq int32
+	n   Notification
+}
+
+// Timestamp implements the rangefeedbuffer.Event interface.
+func (e *bufferEvent) Timestamp() hlc.Timestamp {
+	return e.ts
+}
+
+var _ rangefeedbuffer.Event = &bufferEvent{}
+
+func (r *Registry) translateEvent(
+	ctx context.Context, kv *kvpb.RangeFeedValue,
+) (*bufferEvent, bool) {
+	if !kv.Value.IsPresent() {
+		// Ignore the deletions of the cleanup.
+		return nil, false
+	}
+	ev, err := r.decodeRow(kv.Value)
+	if err != nil {
+		log.Dev.Warningf(ctx, "failed to decode notifications row %v: %v", kv.Key, err)
+		return nil, false
+	}
+	ev.ts = kv.Timestamp()
+	return ev, true
+}
+
+// decodeRow decodes the non-key columns of a row of the system.notifications
+// table.
+func (r *Registry) decodeRow(v roachpb.Value) (*bufferEvent, error) {
+	bytes, err := v.GetTuple()
+	if err != nil {
+		return nil, err
+	}
+	datums, err := r.decoder.Decode(&r.datumAlloc, bytes)
+	if err != nil {
+		return nil, err
+	}
+	ev := &bufferEvent{}
+	if d := datums[1]; d != tree.DNull {
+		ev.n.Channel = string(tree.MustBeDString(d))
+	}
+	if d := datums[2]; d != tree.DNull {
+		ev.n.Payload = string(tree.MustBeDString(d))
+	}
+	if d := datums[3]; d != tree.DNull {
+		ev.n.PID = int32(tree.MustBeDInt(d))
+	}
+	if d := datums[4]; d != tree.DNull {
+		ev.seq = int32(tree.MustBeDInt(d))
+	}
+	return ev, nil
+}
+
+func (r *Registry) onUpdate(ctx context.Context, update rangefeedcache.Update[*bufferEvent]) {
+	if update.Type == rangefeedcache.CompleteUpdate {
+		// The initial scan returns the notifications that were sent before the
+		// rangefeed started, which have either already been delivered or
+		// cannot be delivered in order anymore.
+		log.Dev.Info(ctx, "notifications rangefeed completed initial scan")
+		return
+	}
+	events := update.Events
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].ts != events[j].ts {
+			return events[i].ts.Less(events[j].ts)
+		}
+		return events[i].seq < events[j].seq
+	})
+	// Like Postgres, deliver only the first of identical notifications sent by
+	// the same transaction, which are identified by their commit timestamp and
+	// sender.
+	var txnTS hlc.Timestamp
+	sent := make(map[Notification]struct{})
+	for _, ev := range events {
+		if ev.ts != txnTS {
+			txnTS = ev.ts
+			clear(sent)
+		}
+		if _, ok := sent[ev.n]; ok {
+			continue
+		}
+		sent[ev.n] = struct{}{}
+		r.deliver(ev.n)
+	}
+}
+
+// deliver hands the given notification to the Listeners on its channel.
+func (r *Registry) deliver(n Notification) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for l := range r.mu.channels[n.Channel] {
+		l.enqueue(n)
+	}
+}
+
+// runCleanup periodically deletes the expired notifications until the server
+// shuts down.
+func (r *Registry) runCleanup(ctx context.Context) {
+	intervalChangedCh := make(chan struct{}, 1)
+	cleanupInterval.SetOnChange(&r.st.SV, func(ctx context.Context) {
+		select {
+		case intervalChangedCh <- struct{}{}:
+		default:
+		}
+	})
+	for {
+		var timer <-chan time.Time
+		if interval := cleanupInterval.Get(&r.st.SV); interval != 0 {
+			timer = time.After(interval)
+		}
+		select {
+		case <-timer:
+		case <-intervalChangedCh:
+			continue
+		case <-ctx.Done():
+			return
+		}
+		if err := r.deleteExpired(ctx); err != nil {
+			log.Dev.Warningf(ctx, "failed to delete expired notifications: %v", err)
+		}
+	}
+}
+
+// deleteExpired deletes the notifications that are older than the retention
+// period.
+func (r *Registry) deleteExpired(ctx context.Context) error {
+	const opName = "delete-expired-notifications"
+	const deleteStmt = `DELETE FROM system.notifications WHERE created_at < $1 LIMIT $2`
+	cutoff := r.clock.PhysicalTime().Add(-retention.Get(&r.st.SV))
+	for {
+		n, err := r.db.Executor().ExecEx(
+			ctx, opName, nil /* txn */, sessiondata.NodeUserSessionDataOverride,
+			deleteStmt, cutoff, cleanupBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+		if n < cleanupBatchSize {
+			return nil
+		}
+	}
+}
+
+// NewListener returns a new Listener that is not listening on any channel.
+// wake is called whenever a notification is queued for the Listener. It must
+// not block nor call back into the Registry.
+func (r *Registry) NewListener(wake func()) *Listener {
+	l := &Listener{r: r, wake: wake}
+	l.mu.channels = make(map[string]struct{})
+	return l
+}
+
+// Listener queues the notifications sent on the channels that a session
+// listens on, until the session delivers them to its client. It is safe for
+// concurrent use.
+type Listener struct {
+	r    *Registry
+	wake func()
+
+	mu struct {
+		syncutil.Mutex
+		channels map[string]struct{}
+		pending  []Notification
+		// dropped is the number of notifications that were dropped because
+		// pending was full, since the last call to TakeLosses.
+		dropped int
+		// missed is set if the rangefeed was restarted since the last call to
+		// TakeLosses.
+		missed bool
+	}
+}
+
+// Listen starts listening on the given channel. It is a no-op if the Listener
+// is already listening on the channel.
+func (l *Listener) Listen(channel string) {
+	l.r.mu.Lock()
+	defer l.r.mu.Unlock()
+	listeners, ok := l.r.mu.channels[channel]
+	if !ok {
+		listeners = make(map[*Listener]struct{})
+		l.r.mu.channels[channel] = listeners
+	}
+	listeners[l] = struct{}{}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.mu.channels[channel] = struct{}{}
+}
+
+// Unlisten stops listening on the given channel. It is a no-op if the Listener
+// is not listening on the channel.
+func (l *Listener) Unlisten(channel string) {
+	l.r.mu.Lock()
+	defer l.r.mu.Unlock()
+	l.unlistenLocked(channel)
+}
+
+// UnlistenAll stops listening on all channels.
+func (l *Listener) UnlistenAll() {
+	l.r.mu.Lock()
+	defer l.r.mu.Unlock()
+	for _, channel := range l.Channels() {
+		l.unlistenLocked(channel)
+	}
+}
+
+// unlistenLocked stops listening on the given channel. The Registry's mutex
+// must be held.
+func (l *Listener) unlistenLocked(channel string) {
+	if listeners, ok := l.r.mu.channels[channel]; ok {
+		delete(listeners, l)
+		if len(listeners) == 0 {
+			delete(l.r.mu.channels, channel)
+		}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.mu.channels, channel)
+}
+
+// Channels returns the channels the Listener is listening on, in sorted order.
+func (l *Listener) Channels() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	channels := make([]string, 0, len(l.mu.channels))
+	for channel := range l.mu.channels {
+		channels = append(channels, channel)
+	}
+	sort.Strings(channels)
+	return channels
+}
+
+// Drain returns the queued notifications, in the order they were committed,
+// and empties the queue.
+func (l *Listener) Drain() []Notification {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	pending := l.mu.pending
+	l.mu.pending = nil
+	return pending
+}
+
+// TakeLosses returns the number of notifications that were dropped because
+// too many were queued, and whether notifications may have been missed because
+// the rangefeed was restarted, since the last call.
+func (l *Listener) TakeLosses() (dropped int, missed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	dropped, missed = l.mu.dropped, l.mu.missed
+	l.mu.dropped, l.mu.missed = 0, false
+	return dropped, missed
+}
+
+// enqueue queues the given notification and wakes up the session.
+func (l *Listener) enqueue(n Notification) {
+	func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if len(l.mu.pending) >= maxPendingNotifications {
+			// Drop the oldest notification rather than growing without bound
+			// if the session does not consume its notifications.
+			copy(l.mu.pending, l.mu.pending[1:])
+			l.mu.pending = l.mu.pending[:len(l.mu.pending)-1]
+			l.mu.dropped++
+			l.r.metrics.DroppedNotifications.Inc(1)
+		}
+		l.mu.pending = append(l.mu.pending, n)
+	}()
+	l.wake()
+}
+
+// markMissed records that notifications may have been missed and wakes up the
+// session to tell it about them.
+func (l *Listener) markMissed() {
+	func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.mu.missed = true
+	}()
+	l.wake()
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package notify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed/rangefeedcache"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryDelivery(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+	ctx := context.Background()
+
+	r := NewRegistry(nil, nil, nil, keys.SystemSQLCodec, nil, nil)
+	var wakes1, wakes2 int
+	l1 := r.NewListener(func() { wakes1++ })
+	l2 := r.NewListener(func() { wakes2++ })
+	l1.Listen("a")
+	l1.Listen("b")
+	l2.Listen("b")
+	require.Equal(t, []string{"a", "b"}, l1.Channels())
+	require.Equal(t, []string{"b"}, l2.Channels())
+
+	ts := func(walltime int64) hlc.Timestamp { return hlc.Timestamp{WallTime: walltime} }
+	update := func(events ...*bufferEvent) {
+		r.onUpdate(ctx, rangefeedcache.Update[*bufferEvent]{
+			Type:   rangefeedcache.IncrementalUpdate,
+			Events: events,
+		})
+	}
+
+	// The initial scan is not delivered.
+	r.onUpdate(ctx, rangefeedcache.Update[*bufferEvent]{
+		Type:   rangefeedcache.CompleteUpdate,
+		Events: []*bufferEvent{{ts: ts(1), n: Notification{Channel: "a", Payload: "old"}}},
+	})
+	require.Empty(t, l1.Drain())
+
+	// Notifications are delivered in commit order, then in the order they were
+	// sent, and identical notifications of the same transaction are delivered
+	// once.
+	update(
+		&bufferEvent{ts: ts(3), seq: 0, n: Notification{Channel: "a", Payload: "3"}},
+		&bufferEvent{ts: ts(2), seq: 2, n: Notification{Channel: "b", Payload: "2b", PID: 7}},
+		&bufferEvent{ts: ts(2), seq: 1, n: Notification{Channel: "a", Payload: "2a", PID: 7}},
+		&bufferEvent{ts: ts(2), seq: 3, n: Notification{Channel: "b", Payload: "2b", PID: 7}},
+		&bufferEvent{ts: ts(2), seq: 4, n: Notification{Channel: "c", Payload: "2c", PID: 7}},
+		&bufferEvent{ts: ts(2), seq: 5, n: Notification{Channel: "a", Payload: "2a", PID: 7}},
+	)
+	require.Equal(t, []Notification{
+		{Channel: "a", Payload: "2a", PID: 7},
+		{Channel: "b", Payload: "2b", PID: 7},
+		{Channel: "a", Payload: "3"},
+	}, l1.Drain())
+	require.Equal(t, []Notification{{Channel: "b", Payload: "2b", PID: 7}}, l2.Drain())
+	require.Equal(t, 3, wakes1)
+	require.Equal(t, 1, wakes2)
+	require.Empty(t, l1.Drain())
+
+	l1.Unlisten("b")
+	update(&bufferEvent{ts: ts(4), n: Notification{Channel: "b", Payload: "4"}})
+	require.Empty(t, l1.Drain())
+	require.Len(t, l2.Drain(), 1)
+
+	l1.UnlistenAll()
+	l2.UnlistenAll()
+	require.Empty(t, l1.Channels())
+	require.Empty(t, r.mu.channels)
+}
+
+func TestListenerQueueLimit(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	r := NewRegistry(nil, nil, nil, keys.SystemSQLCodec, nil, nil)
+	l := r.NewListener(func() {})
+	l.Listen("a")
+	for i := 0; i < maxPendingNotifications+1; i++ {
+		r.deliver(Notification{Channel: "a", PID: int32(i)})
+	}
+	pending := l.Drain()
+	require.Len(t, pending, maxPendingNotifications)
+	require.Equal(t, int32(1), pending[0].PID)
+
+	// The dropped notification is reported once, and counted in the metrics.
+	dropped, missed := l.TakeLosses()
+	require.Equal(t, 1, dropped)
+	require.False(t, missed)
+	require.Equal(t, int64(1), r.Metrics().DroppedNotifications.Count())
+	dropped, _ = l.TakeLosses()
+	require.Zero(t, dropped)
+}
+
+func TestListenerRangefeedRestart(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	r := NewRegistry(nil, nil, nil, keys.SystemSQLCodec, nil, nil)
+	var wakes int
+	l1 := r.NewListener(func() { wakes++ })
+	l2 := r.NewListener(func() {})
+	l1.Listen("a")
+	l1.Listen("b")
+
+	// Only the Listeners that listen on a channel may miss notifications, and
+	// each of them is woken up once.
+	r.onRangefeedError(errors.New("boom"))
+	require.Equal(t, 1, wakes)
+	require.Equal(t, int64(1), r.Metrics().RangefeedRestarts.Count())
+	_, missed := l1.TakeLosses()
+	require.True(t, missed)
+	_, missed = l1.TakeLosses()
+	require.False(t, missed)
+	_, missed = l2.TakeLosses()
+	require.False(t, missed)
+}
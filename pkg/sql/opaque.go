@@ -102,6 +102,8 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.alterDefaultPrivileges(ctx, n)
 	case *tree.AlterExternalConnection:
 		return p.AlterExternalConnection(ctx, n)
+	case *tree.AlterDomain:
+		return p.AlterDomain(ctx, n)
 	case *tree.AlterFunctionOptions:
 		return p.AlterFunctionOptions(ctx, n)
 	case *tree.AlterRoutineRename:
@@ -171,8 +173,12 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		// it can't have placeholder arguments, and the execution can use the same
 		// logic as if it were a simple query. This matches the Postgres behavior.
 		return &zeroNode{}, nil
+	case *tree.CreateAggregate:
+		return p.CreateAggregate(ctx, n)
 	case *tree.CreateDatabase:
 		return p.CreateDatabase(ctx, n)
+	case *tree.CreateDomain:
+		return p.CreateDomain(ctx, n)
 	case *tree.CreateIndex:
 		return p.CreateIndex(ctx, n)
 	case *tree.CreatePolicy:
@@ -191,6 +197,12 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.CreateExtension(ctx, n)
 	case *tree.CreateExternalConnection:
 		return p.CreateExternalConnection(ctx, n)
+	case *tree.CreateForeignTable:
+		return p.CreateForeignTable(ctx, n)
+	case *tree.CreatePublication:
+		return p.CreatePublication(ctx, n)
+	case *tree.CreateServer:
+		return p.CreateServer(ctx, n)
 	case *tree.CreateTenant:
 		return p.CreateTenantNode(ctx, n)
 	case *tree.CheckExternalConnection:
@@ -205,6 +217,8 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.Discard(ctx, n)
 	case *tree.DropDatabase:
 		return p.DropDatabase(ctx, n)
+	case *tree.DropDomain:
+		return p.DropDomain(ctx, n)
 	case *tree.DropRoutine:
 		return p.DropFunction(ctx, n)
 	case *tree.DropIndex:
@@ -219,6 +233,10 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.DropSchema(ctx, n)
 	case *tree.DropSequence:
 		return p.DropSequence(ctx, n)
+	case *tree.DropPublication:
+		return p.DropPublication(ctx, n)
+	case *tree.DropServer:
+		return p.DropServer(ctx, n)
 	case *tree.DropTable:
 		return p.DropTable(ctx, n)
 	case *tree.DropTenant:
@@ -235,8 +253,14 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.Grant(ctx, n)
 	case *tree.GrantRole:
 		return p.GrantRole(ctx, n)
+	case *tree.Listen:
+		return p.Listen(ctx, n)
+	case *tree.LockTable:
+		return p.LockTable(ctx, n)
 	case *tree.MoveCursor:
 		return p.MoveCursor(ctx, &n.CursorStmt)
+	case *tree.Notify:
+		return p.Notify(ctx, n)
 	case *tree.ReassignOwnedBy:
 		return p.ReassignOwnedBy(ctx, n)
 	case *tree.RefreshMaterializedView:
@@ -263,6 +287,8 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.Scrub(ctx, n)
 	case *tree.SetClusterSetting:
 		return p.SetClusterSetting(ctx, n)
+	case *tree.SetConstraints:
+		return p.SetConstraints(ctx, n)
 	case *tree.SetZoneConfig:
 		return p.SetZoneConfig(ctx, n)
 	case *tree.SetVar:
@@ -285,6 +311,8 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.ShowExternalConnection(ctx, n)
 	case *tree.ShowHistogram:
 		return p.ShowHistogram(ctx, n)
+	case *tree.ShowPlanBaselines:
+		return p.ShowPlanBaselines(ctx, n)
 	case *tree.ShowTableStats:
 		return p.ShowTableStats(ctx, n)
 	case *tree.ShowTenant:
@@ -309,6 +337,10 @@ func planOpaque(ctx context.Context, p *planner, stmt tree.Statement) (planNode,
 		return p.Unlisten(ctx, n)
 	case *pgrepltree.IdentifySystem:
 		return p.IdentifySystem(ctx, n)
+	case *pgrepltree.CreateReplicationSlot:
+		return p.CreateReplicationSlot(ctx, n)
+	case *pgrepltree.DropReplicationSlot:
+		return p.DropReplicationSlot(ctx, n)
 	case tree.PlanHookStatement:
 		plan, err := p.maybePlanHook(ctx, stmt)
 		if err != nil {
@@ -345,6 +377,7 @@ func init() {
 		&tree.AlterDatabaseDropSecondaryRegion{},
 		&tree.AlterDatabaseSetZoneConfigExtension{},
 		&tree.AlterDefaultPrivileges{},
+		&tree.AlterDomain{},
 		&tree.AlterFunctionOptions{},
 		&tree.AlterRoutineRename{},
 		&tree.AlterRoutineSetOwner{},
@@ -378,10 +411,15 @@ func init() {
 		&tree.CommentOnType{},
 		&tree.CommitPrepared{},
 		&tree.CopyTo{},
+		&tree.CreateAggregate{},
 		&tree.CreateDatabase{},
+		&tree.CreateDomain{},
 		&tree.CreateExtension{},
 		&tree.CreateExternalConnection{},
 		&tree.AlterExternalConnection{},
+		&tree.CreateForeignTable{},
+		&tree.CreatePublication{},
+		&tree.CreateServer{},
 		&tree.CreateTenant{},
 		&tree.CreateIndex{},
 		&tree.CreatePolicy{},
@@ -394,6 +432,7 @@ func init() {
 		&tree.DeclareCursor{},
 		&tree.Discard{},
 		&tree.DropDatabase{},
+		&tree.DropDomain{},
 		&tree.DropExternalConnection{},
 		&tree.DropRoutine{},
 		&tree.DropTrigger{},
@@ -403,6 +442,8 @@ func init() {
 		&tree.DropRole{},
 		&tree.DropSchema{},
 		&tree.DropSequence{},
+		&tree.DropPublication{},
+		&tree.DropServer{},
 		&tree.DropTable{},
 		&tree.DropTenant{},
 		&tree.DropType{},
@@ -410,7 +451,10 @@ func init() {
 		&tree.FetchCursor{},
 		&tree.Grant{},
 		&tree.GrantRole{},
+		&tree.Listen{},
+		&tree.LockTable{},
 		&tree.MoveCursor{},
+		&tree.Notify{},
 		&tree.ReassignOwnedBy{},
 		&tree.RefreshMaterializedView{},
 		&tree.RenameColumn{},
@@ -424,6 +468,7 @@ func init() {
 		&tree.Scatter{},
 		&tree.Scrub{},
 		&tree.SetClusterSetting{},
+		&tree.SetConstraints{},
 		&tree.SetZoneConfig{},
 		&tree.SetVar{},
 		&tree.SetTransaction{},
@@ -435,6 +480,7 @@ func init() {
 		&tree.ShowCreateExternalConnections{},
 		&tree.ShowExternalConnections{},
 		&tree.ShowHistogram{},
+		&tree.ShowPlanBaselines{},
 		&tree.ShowInspectErrors{},
 		&tree.ShowTableStats{},
 		&tree.ShowTenant{},
@@ -449,6 +495,8 @@ func init() {
 		&tree.Unlisten{},
 
 		&pgrepltree.IdentifySystem{},
+		&pgrepltree.CreateReplicationSlot{},
+		&pgrepltree.DropReplicationSlot{},
 
 		// planHook-based statements.
 		&tree.Inspect{},
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 )
 
@@ -38,6 +39,12 @@ type Table interface {
 	// information_schema tables.
 	IsVirtualTable() bool
 
+	// IsForeignTable returns true if this table is a foreign table, whose rows
+	// are read from files in external storage when it's queried. Foreign tables
+	// are also virtual tables, since they are not stored in the KV layer and
+	// have no statistics.
+	IsForeignTable() bool
+
 	// IsSystemTable returns true if this table is a special system table.
 	IsSystemTable() bool
 
@@ -205,6 +212,14 @@ type Table interface {
 
 	// Policies returns all the policies defined for this table.
 	Policies() *Policies
+
+	// InheritingTableCount returns the number of tables that directly inherit
+	// from this table (see CREATE TABLE ... INHERITS).
+	InheritingTableCount() int
+
+	// InheritingTableID returns the StableID of the ith table that directly
+	// inherits from this table, where i < InheritingTableCount.
+	InheritingTableID(i int) StableID
 }
 
 // CheckConstraint represents a check constraint on a table. Check constraints
@@ -271,6 +286,13 @@ type TableStatistic interface {
 	// inverted index histograms, this will always return types.Bytes.
 	HistogramType() *types.T
 
+	// DependencyDegrees returns the degrees of functional dependency between
+	// the columns of a multi-column statistic, or nil if they were not
+	// collected. The ith degree is the estimated fraction of rows with a
+	// non-NULL value in the ith column for which that value determines the
+	// values of the other columns.
+	DependencyDegrees() []float64
+
 	// IsPartial returns true if this statistic was collected with USING EXTREMES
 	// or with a WHERE clause.
 	IsPartial() bool
@@ -355,6 +377,17 @@ type ForeignKeyConstraint interface {
 	// UpdateReferenceAction returns the action to be performed if the foreign key
 	// constraint would be violated by an update.
 	UpdateReferenceAction() tree.ReferenceAction
+
+	// Deferrable is true if the checks for this constraint can be postponed
+	// until the end of the transaction with SET CONSTRAINTS. Deferrable
+	// constraints are never reported as Validated, since they may be violated
+	// within a transaction.
+	Deferrable() bool
+
+	// InitiallyDeferred is true if the checks for this constraint are postponed
+	// until the end of the transaction unless SET CONSTRAINTS ... IMMEDIATE is
+	// used.
+	InitiallyDeferred() bool
 }
 
 // UniqueConstraint represents a uniqueness constraint. UniqueConstraints may
@@ -408,6 +441,28 @@ type UniqueConstraint interface {
 	// satisfied when building functional dependencies for the table. This enables
 	// additional optimizations, such as omission of uniqueness checks.
 	UniquenessGuaranteedByAnotherIndex() bool
+
+	// Deferrable is true if the checks for this constraint can be postponed
+	// until the end of the transaction with SET CONSTRAINTS. Deferrable
+	// constraints are never reported as Validated, since they may be violated
+	// within a transaction.
+	Deferrable() bool
+
+	// InitiallyDeferred is true if the checks for this constraint are postponed
+	// until the end of the transaction unless SET CONSTRAINTS ... IMMEDIATE is
+	// used.
+	InitiallyDeferred() bool
+
+	// IsExclusion is true if this is an exclusion constraint (EXCLUDE USING).
+	// Two rows violate an exclusion constraint if each of their columns
+	// compares true with the corresponding ExclusionOperator, rather than if
+	// they are equal. Exclusion constraints do not make their columns a key, so
+	// they are never reported as Validated.
+	IsExclusion() bool
+
+	// ExclusionOperator returns the operator used to compare the ith column of
+	// an exclusion constraint. It is only valid if IsExclusion is true.
+	ExclusionOperator(i int) treecmp.ComparisonOperatorSymbol
 }
 
 // UniqueOrdinal identifies a unique constraint (in the context of a Table).
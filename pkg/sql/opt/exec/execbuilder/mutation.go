@@ -211,6 +211,10 @@ func (b *Builder) tryBuildFastPathInsert(
 	uniqChecks := make([]exec.InsertFastPathCheck, len(ins.UniqueChecks))
 	for i := range ins.FastPathUniqueChecks {
 		c := &ins.FastPathUniqueChecks[i]
+		if tab.Unique(c.CheckOrdinal).Deferrable() {
+			// The fast path cannot postpone checks of deferrable constraints.
+			return execPlan{}, colOrdMap{}, false, nil
+		}
 		if len(c.DatumsFromConstraint) == 0 {
 			// We need at least one DatumsFromConstraint in order to perform
 			// uniqueness checks during fast-path insert. Even if DatumsFromConstraint
@@ -280,6 +284,10 @@ func (b *Builder) tryBuildFastPathInsert(
 			return execPlan{}, colOrdMap{}, false, nil
 		}
 		fk := tab.OutboundForeignKey(c.FKOrdinal)
+		if fk.Deferrable() {
+			// The fast path cannot postpone checks of deferrable constraints.
+			return execPlan{}, colOrdMap{}, false, nil
+		}
 		lookupJoin, isLookupJoin := c.Check.(*memo.LookupJoinExpr)
 		if !isLookupJoin || lookupJoin.JoinType != opt.AntiJoinOp {
 			// Not a lookup anti-join.
@@ -511,7 +519,7 @@ func (b *Builder) buildUpsert(ups *memo.UpsertExpr) (_ execPlan, outputCols colO
 	// ups.UpdateCols are both empty).
 	colList := appendColsWhenPresent(
 		ups.InsertCols, ups.FetchCols, ups.UpdateCols, opt.OptionalColList{ups.CanaryCol},
-		ups.CheckCols, ups.PartialIndexPutCols, ups.PartialIndexDelCols,
+		opt.OptionalColList{ups.DeleteCol}, ups.CheckCols, ups.PartialIndexPutCols, ups.PartialIndexDelCols,
 		ups.VectorIndexPutPartitionCols, ups.VectorIndexPutQuantizedVecCols,
 		ups.VectorIndexDelPartitionCols,
 	)
@@ -535,6 +543,15 @@ func (b *Builder) buildUpsert(ups *memo.UpsertExpr) (_ execPlan, outputCols colO
 				errors.AssertionFailedf("canary column not found")
 		}
 	}
+	deleteCol := exec.NodeColumnOrdinal(-1)
+	if ups.DeleteCol != 0 {
+		// The delete column comes right after the canary column.
+		deleteCol = canaryCol + 1
+		if ups.CanaryCol == 0 || colList[deleteCol] != ups.DeleteCol {
+			return execPlan{}, colOrdMap{},
+				errors.AssertionFailedf("delete column not found")
+		}
+	}
 	insertColOrds := ordinalSetFromColList(ups.InsertCols)
 	fetchColOrds := ordinalSetFromColList(ups.FetchCols)
 	updateColOrds := ordinalSetFromColList(ups.UpdateCols)
@@ -546,6 +563,7 @@ func (b *Builder) buildUpsert(ups *memo.UpsertExpr) (_ execPlan, outputCols colO
 		ups.ArbiterIndexes,
 		ups.ArbiterConstraints,
 		canaryCol,
+		deleteCol,
 		insertColOrds,
 		fetchColOrds,
 		updateColOrds,
@@ -859,6 +877,7 @@ func (b *Builder) buildUniqueChecks(checks memo.UniqueChecksExpr) error {
 			return err
 		}
 		// Wrap the query in an error node.
+		uc := md.Table(c.Table).Unique(c.CheckOrdinal)
 		mkErr := func(row tree.Datums) error {
 			keyVals := make(tree.Datums, len(c.KeyCols))
 			for i, col := range c.KeyCols {
@@ -868,7 +887,13 @@ func (b *Builder) buildUniqueChecks(checks memo.UniqueChecksExpr) error {
 				}
 				keyVals[i] = row[ord]
 			}
-			return mkUniqueCheckErr(md, c, keyVals)
+			err := mkUniqueCheckErr(md, c, keyVals)
+			if uc.Deferrable() {
+				err = newDeferrableCheckErr(
+					md.Table(c.Table).ID(), uc.Name(), uc.InitiallyDeferred(), keyVals, err,
+				)
+			}
+			return err
 		}
 		node, err := b.factory.ConstructErrorIfRows(query.root, mkErr)
 		if err != nil {
@@ -891,6 +916,7 @@ func (b *Builder) buildFKChecks(checks memo.FKChecksExpr) error {
 			return err
 		}
 		// Wrap the query in an error node.
+		fk := fkCheckConstraint(md, c)
 		mkErr := func(row tree.Datums) error {
 			keyVals := make(tree.Datums, len(c.KeyCols))
 			for i, col := range c.KeyCols {
@@ -900,7 +926,13 @@ func (b *Builder) buildFKChecks(checks memo.FKChecksExpr) error {
 				}
 				keyVals[i] = row[ord]
 			}
-			return mkFKCheckErr(md, c, keyVals)
+			err := mkFKCheckErr(md, c, keyVals)
+			if fk.Deferrable() {
+				err = newDeferrableCheckErr(
+					md.Table(c.OriginTable).ID(), fk.Name(), fk.InitiallyDeferred(), keyVals, err,
+				)
+			}
+			return err
 		}
 		node, err := b.factory.ConstructErrorIfRows(query.root, mkErr)
 		if err != nil {
@@ -911,12 +943,40 @@ func (b *Builder) buildFKChecks(checks memo.FKChecksExpr) error {
 	return nil
 }
 
+// fkCheckConstraint returns the foreign key constraint enforced by the given
+// check.
+func fkCheckConstraint(md *opt.Metadata, c *memo.FKChecksItem) cat.ForeignKeyConstraint {
+	if c.FKOutbound {
+		return md.Table(c.OriginTable).OutboundForeignKey(c.FKOrdinal)
+	}
+	return md.Table(c.ReferencedTable).InboundForeignKey(c.FKOrdinal)
+}
+
+// newDeferrableCheckErr wraps the error generated by the check of a DEFERRABLE
+// constraint in an exec.DeferrableConstraintError, which allows the execution
+// engine to postpone the check of the violating row until the end of the
+// transaction.
+func newDeferrableCheckErr(
+	tabID cat.StableID, name string, initiallyDeferred bool, keyVals tree.Datums, err error,
+) error {
+	return &exec.DeferrableConstraintError{
+		TableID:           tabID,
+		Name:              name,
+		InitiallyDeferred: initiallyDeferred,
+		KeyVals:           keyVals,
+		Cause:             err,
+	}
+}
+
 // mkUniqueCheckErr generates a user-friendly error describing a uniqueness
 // violation. The keyVals are the values that correspond to the
 // cat.UniqueConstraint columns.
 func mkUniqueCheckErr(md *opt.Metadata, c *memo.UniqueChecksItem, keyVals tree.Datums) error {
 	tabMeta := md.TableMeta(c.Table)
 	uc := tabMeta.Table.Unique(c.CheckOrdinal)
+	if uc.IsExclusion() {
+		return mkExclusionCheckErr(tabMeta.Table, uc, keyVals)
+	}
 	constraintName := uc.Name()
 	var msg, details bytes.Buffer
 
@@ -953,6 +1013,44 @@ func mkUniqueCheckErr(md *opt.Metadata, c *memo.UniqueChecksItem, keyVals tree.D
 	)
 }
 
+// mkExclusionCheckErr returns the error for a violation of an exclusion
+// constraint, found by a unique check.
+func mkExclusionCheckErr(tab cat.Table, uc cat.UniqueConstraint, keyVals tree.Datums) error {
+	constraintName := uc.Name()
+	var msg, details bytes.Buffer
+
+	// Generate an error of the form:
+	//   ERROR:  conflicting key value violates exclusion constraint "foo"
+	//   DETAIL: Key (k)=({1,2}) conflicts with existing key.
+	msg.WriteString("conflicting key value violates exclusion constraint ")
+	lexbase.EncodeEscapedSQLIdent(&msg, constraintName)
+
+	details.WriteString("Key (")
+	for i := 0; i < uc.ColumnCount(); i++ {
+		if i > 0 {
+			details.WriteString(", ")
+		}
+		col := tab.Column(uc.ColumnOrdinal(tab, i))
+		details.WriteString(string(col.ColName()))
+	}
+	details.WriteString(")=(")
+	for i, d := range keyVals {
+		if i > 0 {
+			details.WriteString(", ")
+		}
+		details.WriteString(d.String())
+	}
+	details.WriteString(") conflicts with existing key.")
+
+	return errors.WithDetail(
+		pgerror.WithConstraintName(
+			pgerror.Newf(pgcode.ExclusionViolation, "%s", msg.String()),
+			constraintName,
+		),
+		details.String(),
+	)
+}
+
 // mkUniqueCheckErrWithoutColNames is a simpler version of mkUniqueCheckErr that
 // omits column names from the error details.
 func mkUniqueCheckErrWithoutColNames(
@@ -1059,9 +1157,10 @@ func mkFKCheckErr(md *opt.Metadata, c *memo.FKChecksItem, keyVals tree.Datums) e
 			if i > 0 {
 				details.WriteString(", ")
 			}
-			if d == tree.DNull {
+			if d == tree.DNull && fk.MatchMethod() != tree.MatchPartial {
 				// If we see a NULL, this must be a MATCH FULL failure (otherwise the
-				// row would have been filtered out).
+				// row would have been filtered out). Under MATCH PARTIAL, the non-NULL
+				// values did not match any row.
 				sawNull = true
 				break
 			}
@@ -205,6 +205,9 @@ func (b *Builder) buildRelational(e memo.RelExpr) (_ execPlan, outputCols colOrd
 	case *memo.GroupByExpr, *memo.ScalarGroupByExpr:
 		ep, outputCols, err = b.buildGroupBy(e)
 
+	case *memo.GroupingSetsExpr:
+		ep, outputCols, err = b.buildGroupingSets(t)
+
 	case *memo.DistinctOnExpr, *memo.EnsureDistinctOnExpr, *memo.UpsertDistinctOnExpr,
 		*memo.EnsureUpsertDistinctOnExpr:
 		ep, outputCols, err = b.buildDistinct(t)
@@ -750,7 +753,11 @@ func (b *Builder) scanParams(
 	// more than txn_rows_read_err+1 rows on any single scan. Adding a hard limit
 	// of txn_rows_read_err+1 ensures that the results will still be correct since
 	// the conn_executor will return an error if the limit is actually reached.
+	//
+	// Sampled scans are skipped, since the limit would apply before the rows
+	// are sampled.
 	if txnRowsReadErr := b.evalCtx.SessionData().TxnRowsReadErr; txnRowsReadErr > 0 &&
+		scan.Sample.Empty() &&
 		(hardLimit == 0 || hardLimit > txnRowsReadErr+1) &&
 		(!maxResultsOk || maxResults > uint64(txnRowsReadErr+1)) {
 		hardLimit = txnRowsReadErr + 1
@@ -809,6 +816,13 @@ func (b *Builder) scanParams(
 		return exec.ScanParams{}, colOrdMap{}, errors.AssertionFailedf("scan can't provide required ordering")
 	}
 
+	// Without a REPEATABLE clause, a different sample is returned each time the
+	// plan is executed.
+	sampleSeed := scan.Sample.Seed
+	if !scan.Sample.Empty() && !scan.Sample.Repeatable {
+		sampleSeed = b.evalCtx.GetRNG().Int63()
+	}
+
 	return exec.ScanParams{
 		NeededCols:         needed,
 		IndexConstraint:    scan.Constraint,
@@ -821,6 +835,9 @@ func (b *Builder) scanParams(
 		EstimatedRowCount:  rowCount,
 		StatsCreatedAt:     statsCreatedAt,
 		LocalityOptimized:  scan.LocalityOptimized,
+		SampleMethod:       scan.Sample.Method,
+		SampleProbability:  scan.Sample.Probability,
+		SampleSeed:         sampleSeed,
 	}, outputMap, nil
 }
 
@@ -1780,6 +1797,128 @@ func (b *Builder) buildGroupBy(groupBy memo.RelExpr) (_ execPlan, outputCols col
 	}
 
 	aggregations := *groupBy.Child(1).(*memo.AggregationsExpr)
+	aggInfos, err := b.buildAggregations(aggregations, inputCols, outputCols, len(groupingColIdx))
+	if err != nil {
+		return execPlan{}, colOrdMap{}, err
+	}
+
+	var ep execPlan
+	if groupBy.Op() == opt.ScalarGroupByOp {
+		scalarGroupBy := groupBy.(*memo.ScalarGroupByExpr)
+		var inputRowCount uint64
+		if inputRelProps := scalarGroupBy.Input.Relational(); inputRelProps.Statistics().Available {
+			inputRowCount = uint64(math.Ceil(inputRelProps.Statistics().RowCount))
+		}
+		ep.root, err = b.factory.ConstructScalarGroupBy(input.root, aggInfos, inputRowCount)
+	} else {
+		groupBy := groupBy.(*memo.GroupByExpr)
+		var groupingColOrder colinfo.ColumnOrdering
+		groupingColOrder, err = sqlOrdering(ordering.StreamingGroupingColOrdering(
+			&groupBy.GroupingPrivate, &groupBy.RequiredPhysical().Ordering,
+		), inputCols)
+		if err != nil {
+			return execPlan{}, colOrdMap{}, err
+		}
+		var reqOrd exec.OutputOrdering
+		reqOrd, err = reqOrdering(groupBy, outputCols)
+		if err != nil {
+			return execPlan{}, colOrdMap{}, err
+		}
+		orderType := exec.GroupingOrderType(groupBy.GroupingOrderType(&groupBy.RequiredPhysical().Ordering))
+		var rowCount, inputRowCount uint64
+		if relProps := groupBy.Relational(); relProps.Statistics().Available {
+			rowCount = uint64(math.Ceil(relProps.Statistics().RowCount))
+		}
+		if inputRelProps := groupBy.Input.Relational(); inputRelProps.Statistics().Available {
+			inputRowCount = uint64(math.Ceil(inputRelProps.Statistics().RowCount))
+		}
+		ep.root, err = b.factory.ConstructGroupBy(
+			input.root, groupingColIdx, groupingColOrder, aggInfos, reqOrd, orderType, rowCount, inputRowCount,
+		)
+	}
+	if err != nil {
+		return execPlan{}, colOrdMap{}, err
+	}
+	return ep, outputCols, nil
+}
+
+func (b *Builder) buildGroupingSets(
+	groupingSets *memo.GroupingSetsExpr,
+) (_ execPlan, outputCols colOrdMap, err error) {
+	input, inputCols, err := b.buildRelational(groupingSets.Input)
+	// The input column map is only used for the lifetime of this function, so
+	// free the map afterward.
+	defer b.colOrdsAlloc.Free(inputCols)
+	if err != nil {
+		return execPlan{}, colOrdMap{}, err
+	}
+
+	private := &groupingSets.GroupingSetsPrivate
+	getOrdinals := func(cols opt.ColList) ([]exec.NodeColumnOrdinal, error) {
+		ords := make([]exec.NodeColumnOrdinal, len(cols))
+		for i, col := range cols {
+			if ords[i], err = getNodeColumnOrdinal(inputCols, col); err != nil {
+				return nil, err
+			}
+		}
+		return ords, nil
+	}
+	groupingColIdx, err := getOrdinals(private.GroupingCols)
+	if err != nil {
+		return execPlan{}, colOrdMap{}, err
+	}
+	sets := make([][]exec.NodeColumnOrdinal, len(private.Sets))
+	for i := range private.Sets {
+		if sets[i], err = getOrdinals(private.Sets[i].ToList()); err != nil {
+			return execPlan{}, colOrdMap{}, err
+		}
+	}
+	groupingFuncs := make([][]exec.NodeColumnOrdinal, len(private.GroupingFuncs))
+	for i := range private.GroupingFuncs {
+		if groupingFuncs[i], err = getOrdinals(private.GroupingFuncs[i].Args); err != nil {
+			return execPlan{}, colOrdMap{}, err
+		}
+	}
+
+	// The output columns are the output columns of the grouping columns,
+	// followed by the aggregations and the GROUPING() functions.
+	outputCols = b.colOrdsAlloc.Alloc()
+	for i, col := range private.OutCols {
+		outputCols.Set(col, i)
+	}
+	aggregations := groupingSets.Aggregations
+	aggInfos, err := b.buildAggregations(aggregations, inputCols, outputCols, len(groupingColIdx))
+	if err != nil {
+		return execPlan{}, colOrdMap{}, err
+	}
+	for i := range private.GroupingFuncs {
+		outputCols.Set(private.GroupingFuncs[i].Col, len(groupingColIdx)+len(aggInfos)+i)
+	}
+
+	var rowCount, inputRowCount uint64
+	if relProps := groupingSets.Relational(); relProps.Statistics().Available {
+		rowCount = uint64(math.Ceil(relProps.Statistics().RowCount))
+	}
+	if inputRelProps := groupingSets.Input.Relational(); inputRelProps.Statistics().Available {
+		inputRowCount = uint64(math.Ceil(inputRelProps.Statistics().RowCount))
+	}
+	var ep execPlan
+	ep.root, err = b.factory.ConstructGroupingSets(
+		input.root, groupingColIdx, sets, groupingFuncs, aggInfos, rowCount, inputRowCount,
+	)
+	if err != nil {
+		return execPlan{}, colOrdMap{}, err
+	}
+	return ep, outputCols, nil
+}
+
+// buildAggregations builds the exec.AggInfo of each of the given aggregations,
+// whose arguments are columns of the input described by inputCols. The output
+// column of the i-th aggregation is mapped to the ordinal firstOrd+i in
+// outputCols.
+func (b *Builder) buildAggregations(
+	aggregations memo.AggregationsExpr, inputCols, outputCols colOrdMap, firstOrd int,
+) (_ []exec.AggInfo, err error) {
 	aggInfos := make([]exec.AggInfo, len(aggregations))
 	// There will be roughly one column per aggregation.
 	argCols := make([]exec.NodeColumnOrdinal, 0, len(aggregations))
@@ -1792,11 +1931,11 @@ func (b *Builder) buildGroupBy(groupBy memo.RelExpr) (_ execPlan, outputCols col
 		if aggFilter, ok := agg.(*memo.AggFilterExpr); ok {
 			filter, ok := aggFilter.Filter.(*memo.VariableExpr)
 			if !ok {
-				return execPlan{}, colOrdMap{}, errors.AssertionFailedf("only VariableOp args supported")
+				return nil, errors.AssertionFailedf("only VariableOp args supported")
 			}
 			filterOrd, err = getNodeColumnOrdinal(inputCols, filter.Col)
 			if err != nil {
-				return execPlan{}, colOrdMap{}, err
+				return nil, err
 			}
 			agg = aggFilter.Input
 		}
@@ -1807,6 +1946,35 @@ func (b *Builder) buildGroupBy(groupBy memo.RelExpr) (_ execPlan, outputCols col
 			agg = aggDistinct.Input
 		}
 
+		if uda, ok := agg.(*memo.UserDefinedAggExpr); ok {
+			for j := range uda.Args {
+				variable, ok := uda.Args[j].(*memo.VariableExpr)
+				if !ok {
+					return nil, errors.AssertionFailedf("only VariableOp args supported")
+				}
+				ord, err := getNodeColumnOrdinal(inputCols, variable.Col)
+				if err != nil {
+					return nil, err
+				}
+				argCols = append(argCols, ord)
+			}
+			udInfo, err := b.buildUserDefinedAggInfo(uda.Def)
+			if err != nil {
+				return nil, err
+			}
+			aggInfos[i] = exec.AggInfo{
+				FuncName:    uda.Def.Name,
+				Distinct:    distinct,
+				ResultType:  item.Agg.DataType(),
+				ArgCols:     argCols[:len(argCols):len(argCols)],
+				Filter:      filterOrd,
+				UserDefined: udInfo,
+			}
+			outputCols.Set(item.Col, firstOrd+i)
+			argCols = argCols[len(argCols):]
+			continue
+		}
+
 		name, overload := memo.FindAggregateOverload(agg)
 
 		// Accumulate variable arguments in argCols and constant arguments in
@@ -1815,16 +1983,16 @@ func (b *Builder) buildGroupBy(groupBy memo.RelExpr) (_ execPlan, outputCols col
 			child := agg.Child(j)
 			if variable, ok := child.(*memo.VariableExpr); ok {
 				if len(constArgs) != 0 {
-					return execPlan{}, colOrdMap{}, errors.Errorf("constant args must come after variable args")
+					return nil, errors.Errorf("constant args must come after variable args")
 				}
 				ord, err := getNodeColumnOrdinal(inputCols, variable.Col)
 				if err != nil {
-					return execPlan{}, colOrdMap{}, err
+					return nil, err
 				}
 				argCols = append(argCols, ord)
 			} else {
 				if len(argCols) == 0 {
-					return execPlan{}, colOrdMap{}, errors.Errorf("a constant arg requires at least one variable arg")
+					return nil, errors.Errorf("a constant arg requires at least one variable arg")
 				}
 				if constArgs == nil {
 					// Lazily allocate constArgs.
@@ -1843,51 +2011,53 @@ func (b *Builder) buildGroupBy(groupBy memo.RelExpr) (_ execPlan, outputCols col
 			Filter:           filterOrd,
 			DistsqlBlocklist: overload.DistsqlBlocklist,
 		}
-		outputCols.Set(item.Col, len(groupingColIdx)+i)
+		outputCols.Set(item.Col, firstOrd+i)
 		// Slice argCols and constArgs so the rest of their capacity can be
 		// reused.
 		argCols = argCols[len(argCols):]
 		constArgs = constArgs[len(constArgs):]
 	}
 
-	var ep execPlan
-	if groupBy.Op() == opt.ScalarGroupByOp {
-		scalarGroupBy := groupBy.(*memo.ScalarGroupByExpr)
-		var inputRowCount uint64
-		if inputRelProps := scalarGroupBy.Input.Relational(); inputRelProps.Statistics().Available {
-			inputRowCount = uint64(math.Ceil(inputRelProps.Statistics().RowCount))
-		}
-		ep.root, err = b.factory.ConstructScalarGroupBy(input.root, aggInfos, inputRowCount)
-	} else {
-		groupBy := groupBy.(*memo.GroupByExpr)
-		var groupingColOrder colinfo.ColumnOrdering
-		groupingColOrder, err = sqlOrdering(ordering.StreamingGroupingColOrdering(
-			&groupBy.GroupingPrivate, &groupBy.RequiredPhysical().Ordering,
-		), inputCols)
-		if err != nil {
-			return execPlan{}, colOrdMap{}, err
-		}
-		var reqOrd exec.OutputOrdering
-		reqOrd, err = reqOrdering(groupBy, outputCols)
-		if err != nil {
-			return execPlan{}, colOrdMap{}, err
-		}
-		orderType := exec.GroupingOrderType(groupBy.GroupingOrderType(&groupBy.RequiredPhysical().Ordering))
-		var rowCount, inputRowCount uint64
-		if relProps := groupBy.Relational(); relProps.Statistics().Available {
-			rowCount = uint64(math.Ceil(relProps.Statistics().RowCount))
-		}
-		if inputRelProps := groupBy.Input.Relational(); inputRelProps.Statistics().Available {
-			inputRowCount = uint64(math.Ceil(inputRelProps.Statistics().RowCount))
-		}
-		ep.root, err = b.factory.ConstructGroupBy(
-			input.root, groupingColIdx, groupingColOrder, aggInfos, reqOrd, orderType, rowCount, inputRowCount,
-		)
+	return aggInfos, nil
+}
+
+// buildUserDefinedAggInfo builds the component functions of a user-defined
+// aggregate. The state column is mapped to the first indexed variable, followed
+// by the aggregated arguments in the transition function and by the other state
+// in the combine function.
+func (b *Builder) buildUserDefinedAggInfo(
+	def *memo.UDADefinition,
+) (*exec.UserDefinedAggInfo, error) {
+	info := &exec.UserDefinedAggInfo{
+		StateType:     def.StateType,
+		InitCond:      def.InitCond,
+		Strict:        def.Strict,
+		CombineStrict: def.CombineStrict,
+	}
+	build := func(expr opt.ScalarExpr, cols ...opt.ColumnID) (tree.TypedExpr, error) {
+		if expr == nil {
+			return nil, nil
+		}
+		ivarMap := b.colOrdsAlloc.Alloc()
+		defer b.colOrdsAlloc.Free(ivarMap)
+		for i, col := range cols {
+			ivarMap.Set(col, i)
+		}
+		ctx := makeBuildScalarCtx(ivarMap)
+		return b.buildScalar(&ctx, expr)
 	}
-	if err != nil {
-		return execPlan{}, colOrdMap{}, err
+	var err error
+	transitionCols := append([]opt.ColumnID{def.StateCol}, def.ArgCols...)
+	if info.Transition, err = build(def.Transition, transitionCols...); err != nil {
+		return nil, err
 	}
-	return ep, outputCols, nil
+	if info.Final, err = build(def.Final, def.StateCol); err != nil {
+		return nil, err
+	}
+	if info.Combine, err = build(def.Combine, def.StateCol, def.OtherStateCol); err != nil {
+		return nil, err
+	}
+	return info, nil
 }
 
 func (b *Builder) buildDistinct(
@@ -3038,6 +3208,10 @@ func (b *Builder) buildLookupJoin(
 			break
 		}
 	}
+	var estimatedTableRowCount uint64
+	if tableStats, ok := memo.GetTableStats(md, join.Table); ok && tableStats.Available {
+		estimatedTableRowCount = uint64(tableStats.RowCount)
+	}
 	var res execPlan
 	res.root, err = b.factory.ConstructLookupJoin(
 		joinType,
@@ -3058,6 +3232,7 @@ func (b *Builder) buildLookupJoin(
 		join.RemoteOnlyLookups,
 		reverse,
 		parallelize,
+		estimatedTableRowCount,
 	)
 	if err != nil {
 		return execPlan{}, colOrdMap{}, err
@@ -4440,6 +4615,7 @@ var boundedStalenessAllowList = map[opt.Operator]struct{}{
 	opt.ProjectOp:          {},
 	opt.GroupByOp:          {},
 	opt.ScalarGroupByOp:    {},
+	opt.GroupingSetsOp:     {},
 	opt.DistinctOnOp:       {},
 	opt.DistributeOp:       {},
 	opt.EnsureDistinctOnOp: {},
@@ -299,6 +299,9 @@ func (e *emitter) nodeName(n *Node) (name string, _ error) {
 		if a.Table == nil {
 			return "scan", nil
 		}
+		if a.Table.IsForeignTable() {
+			return "foreign table", nil
+		}
 		if a.Table.IsVirtualTable() {
 			return "virtual table", nil
 		}
@@ -416,6 +419,7 @@ var nodeNames = [...]string{
 	exportOp:               "export",
 	filterOp:               "filter",
 	groupByOp:              "", // This node does not have a fixed name.
+	groupingSetsOp:         "group (grouping sets)",
 	hashJoinOp:             "", // This node does not have a fixed name.
 	indexJoinOp:            "index join",
 	insertFastPathOp:       "insert fast path",
@@ -527,6 +531,9 @@ func (e *emitter) emitNodeAttributes(ctx context.Context, evalCtx *eval.Context,
 		if s.UsedFollowerRead {
 			e.ob.AddField("used follower read", "")
 		}
+		if s.SwitchedToHashJoin {
+			e.ob.AddField("switched to hash join", "")
+		}
 		if s.RowCount.HasValue() {
 			actualRowCount = s.RowCount.Value()
 			hasActualRowCount = true
@@ -728,6 +735,10 @@ func (e *emitter) emitNodeAttributes(ctx context.Context, evalCtx *eval.Context,
 			ob.Attr("limit", "")
 		}
 
+		if a.Params.SampleMethod != tree.TableSampleNone {
+			ob.Attrf("sample", "%s (%g%%)", a.Params.SampleMethod, a.Params.SampleProbability*100)
+		}
+
 		if a.Params.Parallelize {
 			ob.VAttr("parallel", "")
 		}
@@ -804,6 +815,18 @@ func (e *emitter) emitNodeAttributes(ctx context.Context, evalCtx *eval.Context,
 			a.Aggregations, a.GroupCols, a.GroupColOrdering, false, /* isScalar */
 		)
 
+	case groupingSetsOp:
+		a := n.args.(*groupingSetsArgs)
+		inputCols := a.Input.Columns()
+		e.emitGroupByAttributes(
+			inputCols, a.Aggregations, a.GroupCols, nil /* groupColOrdering */, false, /* isScalar */
+		)
+		sets := make([]string, len(a.Sets))
+		for i, set := range a.Sets {
+			sets[i] = fmt.Sprintf("(%s)", printColumnList(inputCols, set))
+		}
+		ob.Attr("grouping sets", strings.Join(sets, ", "))
+
 	case scalarGroupByOp:
 		a := n.args.(*scalarGroupByArgs)
 		e.emitGroupByAttributes(
@@ -248,6 +248,108 @@ func DecodePlanGistToPlan(s string, cat cat.Catalog) (plan *Plan, err error) {
 	return plan, nil
 }
 
+// PlanShapeFromGist returns the shape of the plan with the given gist. An
+// error is returned if the plan reads a table or index that no longer exists.
+func PlanShapeFromGist(gist string, catalog cat.Catalog) (_ *exec.PlanShape, retErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			// This code allows us to propagate internal errors without having
+			// to add error checks everywhere throughout the code. This is only
+			// possible because the code does not update shared state and does
+			// not manipulate locks.
+			if ok, e := errorutil.ShouldCatch(r); ok {
+				retErr = e
+			} else {
+				// Other panic objects can't be considered "safe" and thus are
+				// propagated as crashes that terminate the session.
+				panic(r)
+			}
+		}
+	}()
+
+	plan, err := DecodePlanGistToPlan(gist, catalog)
+	if err != nil {
+		return nil, err
+	}
+	shape := exec.MakePlanShape(gist)
+	addIndex := func(table cat.Table, index cat.Index) error {
+		_, unknownTab := table.(*unknownTable)
+		_, unknownIdx := index.(*unknownIndex)
+		if unknownTab || unknownIdx {
+			return errors.New("plan refers to a table or index that no longer exists")
+		}
+		shape.AddIndex(table, index)
+		return nil
+	}
+	var walk func(n *Node) error
+	walk = func(n *Node) error {
+		if n == nil {
+			return nil
+		}
+		var err error
+		switch n.op {
+		case scanOp:
+			a := n.args.(*scanArgs)
+			err = addIndex(a.Table, a.Index)
+		case indexJoinOp:
+			a := n.args.(*indexJoinArgs)
+			shape.AddJoin(exec.IndexJoin)
+			if _, ok := a.Table.(*unknownTable); ok {
+				err = addIndex(a.Table, &unknownIndex{})
+			} else {
+				err = addIndex(a.Table, a.Table.Index(cat.PrimaryIndex))
+			}
+		case lookupJoinOp:
+			a := n.args.(*lookupJoinArgs)
+			shape.AddJoin(exec.LookupJoin)
+			err = addIndex(a.Table, a.Index)
+		case invertedJoinOp:
+			a := n.args.(*invertedJoinArgs)
+			shape.AddJoin(exec.InvertedJoin)
+			err = addIndex(a.Table, a.Index)
+		case zigzagJoinOp:
+			a := n.args.(*zigzagJoinArgs)
+			shape.AddJoin(exec.ZigZagJoin)
+			if err = addIndex(a.LeftTable, a.LeftIndex); err == nil {
+				err = addIndex(a.RightTable, a.RightIndex)
+			}
+		case hashJoinOp:
+			if len(n.args.(*hashJoinArgs).LeftEqCols) == 0 {
+				shape.AddJoin(exec.CrossJoin)
+			} else {
+				shape.AddJoin(exec.HashJoin)
+			}
+		case mergeJoinOp:
+			shape.AddJoin(exec.MergeJoin)
+		case applyJoinOp:
+			shape.AddJoin(exec.ApplyJoin)
+		}
+		if err != nil {
+			return err
+		}
+		for _, child := range n.children {
+			if err := walk(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(plan.Root); err != nil {
+		return nil, err
+	}
+	for i := range plan.Subqueries {
+		if err := walk(plan.Subqueries[i].Root.(*Node)); err != nil {
+			return nil, err
+		}
+	}
+	for _, check := range plan.Checks {
+		if err := walk(check); err != nil {
+			return nil, err
+		}
+	}
+	return &shape, nil
+}
+
 func (d *planGistDecoder) decodeOp() execOperator {
 	val, err := d.buf.ReadByte()
 	if err != nil || val == 0 {
@@ -525,6 +627,10 @@ func (u *unknownTable) IsVirtualTable() bool {
 	return false
 }
 
+func (u *unknownTable) IsForeignTable() bool {
+	return false
+}
+
 func (u *unknownTable) IsSystemTable() bool {
 	return false
 }
@@ -685,6 +791,14 @@ func (u *unknownTable) IsRowLevelSecurityForced() bool { return false }
 // Policies is part of the cat.Table interface.
 func (u *unknownTable) Policies() *cat.Policies { return nil }
 
+// InheritingTableCount is part of the cat.Table interface.
+func (u *unknownTable) InheritingTableCount() int { return 0 }
+
+// InheritingTableID is part of the cat.Table interface.
+func (u *unknownTable) InheritingTableID(i int) cat.StableID {
+	panic(errors.AssertionFailedf("not implemented"))
+}
+
 var _ cat.Table = &unknownTable{}
 
 // unknownTable implements the cat.Index interface and is used to represent
@@ -125,6 +125,17 @@ func getResultColumns(
 		a := args.(*groupByArgs)
 		return groupByColumns(inputs[0], a.GroupCols, a.Aggregations), nil
 
+	case groupingSetsOp:
+		if len(inputs) == 0 {
+			return nil, nil
+		}
+		a := args.(*groupingSetsArgs)
+		cols := groupByColumns(inputs[0], a.GroupCols, a.Aggregations)
+		for range a.GroupingFuncs {
+			cols = append(cols, colinfo.ResultColumn{Name: "grouping", Typ: types.Int})
+		}
+		return cols, nil
+
 	case scalarGroupByOp:
 		if len(inputs) == 0 {
 			return nil, nil
@@ -153,6 +153,14 @@ type ScanParams struct {
 	// to work correctly, the execution engine must create a local DistSQL plan
 	// for the main query (subqueries and postqueries need not be local).
 	LocalityOptimized bool
+	// If SampleMethod is set, the scan only returns a pseudo-random sample of
+	// the rows of the table, as requested by a TABLESAMPLE clause. Each row
+	// (BERNOULLI) or range (SYSTEM) is included in the sample with
+	// probability SampleProbability. SampleSeed seeds the choice of rows or
+	// ranges.
+	SampleMethod      tree.TableSampleMethod
+	SampleProbability float64
+	SampleSeed        int64
 }
 
 // OutputOrdering indicates the required output ordering on a Node that is being
@@ -243,6 +251,37 @@ type AggInfo struct {
 	// DistsqlBlocklist is set to true when this aggregate function cannot be
 	// evaluated in distributed fashion.
 	DistsqlBlocklist bool
+
+	// UserDefined is set if this is a user-defined aggregate created with
+	// CREATE AGGREGATE, in which case FuncName is the name of the aggregate.
+	UserDefined *UserDefinedAggInfo
+}
+
+// UserDefinedAggInfo describes how to evaluate a user-defined aggregate.
+type UserDefinedAggInfo struct {
+	// StateType is the type of the state of the aggregate.
+	StateType *types.T
+
+	// InitCond is the initial value of the state in its textual form. It is nil
+	// if the initial state is NULL.
+	InitCond *string
+
+	// Transition, Final and Combine evaluate the component functions of the
+	// aggregate. They reference the state as the first indexed variable.
+	// Transition references the aggregated arguments as the following indexed
+	// variables, and Combine references the state being merged as the second
+	// indexed variable. Final and Combine are nil if the aggregate does not
+	// have the corresponding function.
+	Transition tree.TypedExpr
+	Final      tree.TypedExpr
+	Combine    tree.TypedExpr
+
+	// Strict is true if the transition function is not called on NULL inputs.
+	Strict bool
+
+	// CombineStrict is true if the combine function is not called on NULL
+	// states.
+	CombineStrict bool
 }
 
 // WindowInfo represents the information about a window function that must be
@@ -396,6 +435,32 @@ type InsertFastPathCheck struct {
 // relevant row.
 type MkErrFn func(tree.Datums) error
 
+// DeferrableConstraintError is returned by the MkErrFn of a check query that
+// enforces a DEFERRABLE constraint. The execution engine unwraps it and, if the
+// constraint is currently deferred (see SET CONSTRAINTS), postpones the check
+// until the end of the transaction instead of returning the error.
+type DeferrableConstraintError struct {
+	// TableID is the ID of the table on which the constraint is defined.
+	TableID cat.StableID
+	// Name is the name of the constraint.
+	Name string
+	// InitiallyDeferred is true if the constraint is deferred unless it has
+	// been made immediate with SET CONSTRAINTS.
+	InitiallyDeferred bool
+	// KeyVals contains the values of the constraint's columns in the violating
+	// row. They are used to revalidate only the violating rows at the end of
+	// the transaction.
+	KeyVals tree.Datums
+	// Cause is the constraint violation error.
+	Cause error
+}
+
+// Error implements the error interface.
+func (e *DeferrableConstraintError) Error() string { return e.Cause.Error() }
+
+// Unwrap returns the constraint violation error.
+func (e *DeferrableConstraintError) Unwrap() error { return e.Cause }
+
 // ExplainFactory is an extension of Factory used when constructing a plan that
 // can be explained. It allows annotation of nodes with extra information.
 type ExplainFactory interface {
@@ -557,6 +622,9 @@ type ExecutionStats struct {
 	// UsedFollowerRead indicates whether at least some reads were served by the
 	// follower replicas.
 	UsedFollowerRead bool
+	// SwitchedToHashJoin indicates whether an adaptive lookup join switched to
+	// a hash join over a full scan of the lookup index.
+	SwitchedToHashJoin bool
 }
 
 // RLSPoliciesApplied contains information about the row-level security policies
@@ -0,0 +1,66 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package exec
+
+import (
+	"slices"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
+)
+
+// PlanShape describes the rough shape of a plan: the indexes that are used to
+// read each table, and the join algorithms that are used. It is used to steer
+// the optimizer towards plans with the same shape as an accepted plan baseline.
+type PlanShape struct {
+	// gist is the gist of the plan that the shape was derived from.
+	gist string
+
+	// indexes maps the ID of each table that is read by the plan to the IDs of
+	// the indexes that are used to read it.
+	indexes map[cat.StableID][]cat.StableID
+
+	// joins contains the join algorithms used by the plan.
+	joins [NumJoinAlgorithms]bool
+}
+
+// MakePlanShape returns an empty shape for the plan with the given gist.
+func MakePlanShape(gist string) PlanShape {
+	return PlanShape{gist: gist}
+}
+
+// Gist returns the gist of the plan that the shape was derived from.
+func (s *PlanShape) Gist() string {
+	return s.gist
+}
+
+// AddIndex records that the plan reads the given index of the given table.
+func (s *PlanShape) AddIndex(table cat.Table, index cat.Index) {
+	if s.indexes == nil {
+		s.indexes = make(map[cat.StableID][]cat.StableID)
+	}
+	tabID, idxID := table.ID(), index.ID()
+	if !slices.Contains(s.indexes[tabID], idxID) {
+		s.indexes[tabID] = append(s.indexes[tabID], idxID)
+	}
+}
+
+// AddJoin records that the plan uses the given join algorithm.
+func (s *PlanShape) AddJoin(alg JoinAlgorithm) {
+	s.joins[alg] = true
+}
+
+// AllowsIndex returns true if reading the given index of the given table
+// conforms to the shape, i.e., if the table is not read by the plan, or if it
+// is read using the index.
+func (s *PlanShape) AllowsIndex(tabID, idxID cat.StableID) bool {
+	idxIDs, ok := s.indexes[tabID]
+	return !ok || slices.Contains(idxIDs, idxID)
+}
+
+// AllowsJoin returns true if the given join algorithm is used by the plan.
+func (s *PlanShape) AllowsJoin(alg JoinAlgorithm) bool {
+	return s.joins[alg]
+}
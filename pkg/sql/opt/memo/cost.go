@@ -36,7 +36,7 @@ type Cost struct {
 // member will have a lower cost.
 var MaxCost = Cost{
 	C:         math.Inf(+1),
-	Penalties: HugeCostPenalty | FullScanPenalty | UnboundedCardinalityPenalty,
+	Penalties: HugeCostPenalty | FullScanPenalty | PlanShapePenalty | UnboundedCardinalityPenalty,
 }
 
 // Less returns true if this cost is lower than the given cost.
@@ -105,6 +105,12 @@ const (
 	// plan is possible.
 	FullScanPenalty
 
+	// PlanShapePenalty is true if the operator or any of its descendants do not
+	// conform to the shape of the accepted plan baseline of the statement. It
+	// makes the optimizer prefer plans with the same shape as the baseline, if
+	// one is still possible.
+	PlanShapePenalty
+
 	// UnboundedCardinalityPenalty is true if the operator or any of its
 	// descendants have no guaranteed upperbound on the number of rows that they
 	// can produce. See props.AnyCardinality.
@@ -121,8 +127,9 @@ const (
 // Where:
 //
 //	<Cost> is the floating point cost value.
-//	<Penalties> contains "H", "F", or "U" for HugeCostPenalty, FullScanPenalty,
-//	  and UnboundedCardinalityPenalty, respectively.
+//	<Penalties> contains "H", "F", "B", or "U" for HugeCostPenalty,
+//	  FullScanPenalty, PlanShapePenalty, and UnboundedCardinalityPenalty,
+//	  respectively.
 //	<aux> contains the number of full scans and unbounded reads.
 //
 // For example, the summary "1.23:HF:5f6u" indicates a cost of 1.23 with the
@@ -137,6 +144,9 @@ func (c Cost) Summary() string {
 	if c.Penalties&FullScanPenalty != 0 {
 		sb.WriteByte('F')
 	}
+	if c.Penalties&PlanShapePenalty != 0 {
+		sb.WriteByte('B')
+	}
 	if c.Penalties&UnboundedCardinalityPenalty != 0 {
 		sb.WriteByte('U')
 	}
@@ -38,6 +38,8 @@ func TestCostLess(t *testing.T) {
 		{Cost{C: 1.0, Penalties: HugeCostPenalty}, MaxCost, true},
 		{Cost{C: 2.0}, Cost{C: 1.0, Penalties: UnboundedCardinalityPenalty}, true},
 		{Cost{C: 1.0, Penalties: UnboundedCardinalityPenalty}, Cost{C: 2.0}, false},
+		{Cost{C: 2.0, Penalties: UnboundedCardinalityPenalty}, Cost{C: 1.0, Penalties: PlanShapePenalty}, true},
+		{Cost{C: 1.0, Penalties: PlanShapePenalty}, Cost{C: 2.0, Penalties: FullScanPenalty}, true},
 		// Auxiliary information should not affect the comparison.
 		{Cost{C: 1.0, aux: testAux{0, 0}}, Cost{C: 1.0, aux: testAux{1, 1}}, false},
 		{Cost{C: 1.0, aux: testAux{1, 1}}, Cost{C: 1.0, aux: testAux{0, 0}}, false},
@@ -82,6 +84,8 @@ func TestCostSummary(t *testing.T) {
 		{Cost{C: 1.23, Penalties: HugeCostPenalty}, "1.23:H:0f0u"},
 		{Cost{C: 1.23, Penalties: FullScanPenalty}, "1.23:F:0f0u"},
 		{Cost{C: 1.23, Penalties: UnboundedCardinalityPenalty}, "1.23:U:0f0u"},
+		{Cost{C: 1.23, Penalties: PlanShapePenalty}, "1.23:B:0f0u"},
+		{Cost{C: 1.23, Penalties: FullScanPenalty | PlanShapePenalty | UnboundedCardinalityPenalty}, "1.23:FBU:0f0u"},
 		{Cost{C: 1.23, Penalties: HugeCostPenalty | FullScanPenalty | UnboundedCardinalityPenalty}, "1.23:HFU:0f0u"},
 		{Cost{C: 1.23, Penalties: HugeCostPenalty | FullScanPenalty | UnboundedCardinalityPenalty}, "1.23:HFU:0f0u"},
 		{Cost{C: 1.23, aux: testAux{5, 0}}, "1.23::5f0u"},
@@ -447,6 +447,37 @@ func (sf *ScanFlags) onlyNotVisibleIndexFlagOn() bool {
 	return *sf == ScanFlags{DisableNotVisibleIndex: true}
 }
 
+// TableSample describes how a Scan samples the rows of its table, as requested
+// by a TABLESAMPLE clause. The zero value indicates that all rows are scanned.
+type TableSample struct {
+	// Method is the sampling method, or tree.TableSampleNone if the scan is not
+	// sampled.
+	Method tree.TableSampleMethod
+
+	// Probability is the probability that each row (BERNOULLI) or range
+	// (SYSTEM) is included in the sample, between 0 and 1.
+	Probability float64
+
+	// Repeatable is true if the sample has a REPEATABLE clause. If so, Seed
+	// seeds the pseudo-random choice of rows or ranges. Otherwise a new seed is
+	// chosen each time the plan is executed.
+	Repeatable bool
+	Seed       int64
+}
+
+// Empty returns true if the scan is not sampled.
+func (ts *TableSample) Empty() bool {
+	return ts.Method == tree.TableSampleNone
+}
+
+// String returns a string representation of the sample.
+func (ts *TableSample) String() string {
+	if !ts.Repeatable {
+		return fmt.Sprintf("%s (%g)", ts.Method, ts.Probability*100)
+	}
+	return fmt.Sprintf("%s (%g) repeatable (%d)", ts.Method, ts.Probability*100, ts.Seed)
+}
+
 // JoinFlags stores restrictions on the join execution method, derived from
 // hints for a join specified in the query (see tree.JoinTableExpr).  It is a
 // bitfield where each bit indicates if a certain type of join is disallowed or
@@ -774,6 +805,58 @@ type UDFDefinition struct {
 	ResultBufferID RoutineResultBufferID
 }
 
+// UDADefinition describes a user-defined aggregate created with
+// CREATE AGGREGATE. The component functions of the aggregate are represented as
+// scalar expressions that reference the StateCol, ArgCols, and OtherStateCol
+// columns, which are replaced by the current values during execution.
+type UDADefinition struct {
+	// Name is the name of the aggregate.
+	Name string
+
+	// Typ is the return type of the aggregate.
+	Typ *types.T
+
+	// StateType is the type of the aggregate's state value.
+	StateType *types.T
+
+	// InitCond is the string representation of the initial value of the state.
+	// If nil, the initial state is NULL.
+	InitCond *string
+
+	// Volatility is the maximum volatility of the component functions.
+	Volatility volatility.V
+
+	// StateCol is the column representing the current state in Transition,
+	// Final, and Combine.
+	StateCol opt.ColumnID
+
+	// ArgCols are the columns representing the aggregated arguments in
+	// Transition.
+	ArgCols opt.ColList
+
+	// OtherStateCol is the column representing the state produced by another
+	// partial aggregation in Combine.
+	OtherStateCol opt.ColumnID
+
+	// Transition computes the next state from StateCol and ArgCols.
+	Transition opt.ScalarExpr
+
+	// Final computes the result of the aggregate from StateCol. It is nil if the
+	// aggregate has no final function, in which case the state is the result.
+	Final opt.ScalarExpr
+
+	// Combine merges StateCol and OtherStateCol. It is nil if the aggregate has
+	// no combine function, in which case the aggregate cannot be distributed.
+	Combine opt.ScalarExpr
+
+	// Strict is true if the transition function is not called on NULL inputs.
+	Strict bool
+
+	// CombineStrict is true if the combine function is not called on NULL
+	// inputs.
+	CombineStrict bool
+}
+
 // ExceptionBlock contains the information needed to match and handle errors in
 // the EXCEPTION block of a routine defined with PLpgSQL.
 type ExceptionBlock struct {
@@ -861,14 +944,16 @@ func (f *WindowFrame) String() string {
 }
 
 // IsCanonical returns true if the ScanPrivate indicates an original unaltered
-// primary index Scan operator (i.e. unconstrained and not limited).
+// primary index Scan operator (i.e. unconstrained, not limited and not
+// sampled).
 // s.InvertedConstraint is implicitly nil because a primary index cannot
 // be inverted.
 func (s *ScanPrivate) IsCanonical() bool {
 	return s.Index == cat.PrimaryIndex &&
 		s.Constraint == nil &&
 		s.HardLimit == 0 &&
-		!s.LocalityOptimized
+		!s.LocalityOptimized &&
+		s.Sample.Empty()
 }
 
 // IsUnfiltered returns true if the ScanPrivate will produce all rows in the
@@ -878,7 +963,8 @@ func (s *ScanPrivate) IsUnfiltered(md *opt.Metadata) bool {
 		s.InvertedConstraint == nil &&
 		s.HardLimit == 0 &&
 		s.PartialIndexPredicate(md) == nil &&
-		s.Locking.WaitPolicy != tree.LockWaitSkipLocked
+		s.Locking.WaitPolicy != tree.LockWaitSkipLocked &&
+		s.Sample.Empty()
 }
 
 // IsFullIndexScan returns true if the ScanPrivate will produce all rows in the
@@ -1479,6 +1565,36 @@ func (g *GroupingPrivate) GroupingOrderType(required *props.OrderingChoice) Grou
 	return PartialStreaming
 }
 
+// GroupingSetList is the list of grouping sets of a GroupingSets expression.
+type GroupingSetList []opt.ColSet
+
+// HasEmptySet returns true if one of the grouping sets has no columns.
+func (l GroupingSetList) HasEmptySet() bool {
+	for i := range l {
+		if l[i].Empty() {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupingFunc is a GROUPING() function computed by a GroupingSets expression.
+// Its value is an integer bit mask where the bit for the last argument is the
+// least significant; a bit is set if the argument is not part of the grouping
+// set of the row.
+type GroupingFunc struct {
+	// Col is the output column of the function.
+	Col opt.ColumnID
+
+	// Args are the arguments of the function, which are grouping columns of
+	// the GroupingSets expression.
+	Args opt.ColList
+}
+
+// GroupingFuncs is the list of GROUPING() functions of a GroupingSets
+// expression.
+type GroupingFuncs []GroupingFunc
+
 // IsConstantsAndPlaceholders returns true if all values in the list are
 // constant, placeholders or tuples containing constants, placeholders or other
 // such nested tuples.
@@ -391,6 +391,26 @@ func (f *ExprFmtCtx) formatRelational(e RelExpr, tp treeprinter.Node) {
 			tp.Childf("error: \"%s\"", private.ErrorOnDup)
 		}
 
+	case *GroupingSetsExpr:
+		if !f.HasFlags(ExprFmtHideColumns) {
+			f.formatRelColList(e, tp, "grouping columns:", t.GroupingCols)
+			f.formatRelColList(e, tp, "grouping output columns:", t.OutCols)
+			f.Buffer.Reset()
+			f.Buffer.WriteString("grouping sets:")
+			for _, set := range t.Sets {
+				f.space()
+				f.Buffer.WriteString(set.String())
+			}
+			tp.Child(f.Buffer.String())
+			for i := range t.GroupingFuncs {
+				heading := fmt.Sprintf("%s arguments:", f.ColumnString(t.GroupingFuncs[i].Col))
+				f.formatColList(tp, heading, t.GroupingFuncs[i].Args, opt.ColSet{} /* notNullCols */)
+			}
+		}
+		if !f.HasFlags(ExprFmtHidePhysProps) && !t.Ordering.Any() {
+			tp.Childf("internal-ordering: %s", t.Ordering)
+		}
+
 	case *TopKExpr:
 		if !f.HasFlags(ExprFmtHidePhysProps) && !t.Ordering.Any() {
 			tp.Childf("internal-ordering: %s", t.Ordering)
@@ -544,6 +564,9 @@ func (f *ExprFmtCtx) formatRelational(e RelExpr, tp treeprinter.Node) {
 			}
 			tp.Child(b.String())
 		}
+		if !private.Sample.Empty() {
+			tp.Childf("sample: %s", private.Sample.String())
+		}
 		f.formatLocking(tp, private.Locking)
 
 	case *InvertedFilterExpr:
@@ -688,6 +711,9 @@ func (f *ExprFmtCtx) formatRelational(e RelExpr, tp treeprinter.Node) {
 			}
 			if t.CanaryCol != 0 {
 				f.formatRelColList(e, tp, "canary column:", opt.ColList{t.CanaryCol})
+				if t.DeleteCol != 0 {
+					f.formatRelColList(e, tp, "delete column:", opt.ColList{t.DeleteCol})
+				}
 				f.formatOptionalColList(e, tp, "fetch columns:", t.FetchCols)
 				f.formatMutationCols(e, tp, "insert-mapping:", t.InsertCols, t.Table)
 				f.formatMutationCols(e, tp, "update-mapping:", t.UpdateCols, t.Table)
@@ -928,6 +954,9 @@ func (f *ExprFmtCtx) formatRelational(e RelExpr, tp treeprinter.Node) {
 			if cost.Penalties&HugeCostPenalty != 0 {
 				b.WriteString(" huge-cost-penalty")
 			}
+			if cost.Penalties&PlanShapePenalty != 0 {
+				b.WriteString(" plan-shape-penalty")
+			}
 			if cost.Penalties&UnboundedCardinalityPenalty != 0 {
 				b.WriteString(" unbounded-cardinality")
 			}
@@ -1203,6 +1232,21 @@ func (f *ExprFmtCtx) formatScalarWithLabel(
 		formatUDFInputAndBody(udf, tp)
 		return
 
+	case opt.UserDefinedAggOp:
+		uda := scalar.(*UserDefinedAggExpr)
+		fmt.Fprintf(f.Buffer, "user-defined-agg: %s", uda.Def.Name)
+		f.FormatScalarProps(scalar)
+		tp = tp.Child(f.Buffer.String())
+		formatRoutineArgs(uda.Args, tp)
+		f.formatExpr(uda.Def.Transition, tp.Child("transition"))
+		if uda.Def.Final != nil {
+			f.formatExpr(uda.Def.Final, tp.Child("final"))
+		}
+		if uda.Def.Combine != nil {
+			f.formatExpr(uda.Def.Combine, tp.Child("combine"))
+		}
+		return
+
 	case opt.TxnControlOp:
 		controlExpr := scalar.(*TxnControlExpr)
 		fmt.Fprintf(f.Buffer, "%s; CALL %s", controlExpr.TxnOp, controlExpr.Def.Name)
@@ -1416,7 +1460,7 @@ func (f *ExprFmtCtx) formatScalarPrivate(scalar opt.ScalarExpr) {
 			fmt.Fprintf(f.Buffer, " col=%v", t.RequestedCol)
 		}
 
-	case *SubqueryExpr, *ExistsExpr:
+	case *SubqueryExpr, *ExistsExpr, *UniqueExpr:
 		// We don't want to show the OriginalExpr.
 		private = nil
 
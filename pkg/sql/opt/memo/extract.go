@@ -124,6 +124,14 @@ func ExtractAggInputColumns(e opt.ScalarExpr) opt.ColSet {
 		panic(errors.AssertionFailedf("not an Aggregate"))
 	}
 
+	if uda, ok := e.(*UserDefinedAggExpr); ok {
+		for i := range uda.Args {
+			if variable, ok := uda.Args[i].(*VariableExpr); ok {
+				res.Add(variable.Col)
+			}
+		}
+	}
+
 	for i, n := 0, e.ChildCount(); i < n; i++ {
 		if variable, ok := e.Child(i).(*VariableExpr); ok {
 			res.Add(variable.Col)
@@ -151,6 +159,14 @@ func AddAggInputColumns(cols opt.ColSet, e opt.ScalarExpr) opt.ColSet {
 		panic(errors.AssertionFailedf("not an Aggregate"))
 	}
 
+	if uda, ok := e.(*UserDefinedAggExpr); ok {
+		for i := range uda.Args {
+			if variable, ok := uda.Args[i].(*VariableExpr); ok {
+				cols.Add(variable.Col)
+			}
+		}
+	}
+
 	for i, n := 0, e.ChildCount(); i < n; i++ {
 		if variable, ok := e.Child(i).(*VariableExpr); ok {
 			cols.Add(variable.Col)
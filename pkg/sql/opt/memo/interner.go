@@ -526,10 +526,31 @@ func (h *hasher) HashScanFlags(val ScanFlags) {
 	}
 }
 
+func (h *hasher) HashTableSample(val TableSample) {
+	h.HashUint64(uint64(val.Method))
+	h.HashFloat64(val.Probability)
+	h.HashBool(val.Repeatable)
+	h.HashInt64(val.Seed)
+}
+
 func (h *hasher) HashJoinFlags(val JoinFlags) {
 	h.HashUint64(uint64(val))
 }
 
+func (h *hasher) HashGroupingSetList(val GroupingSetList) {
+	for i := range val {
+		h.HashUint64(uint64(val[i].Len()))
+		h.HashColSet(val[i])
+	}
+}
+
+func (h *hasher) HashGroupingFuncs(val GroupingFuncs) {
+	for i := range val {
+		h.HashColumnID(val[i].Col)
+		h.HashColList(val[i].Args)
+	}
+}
+
 func (h *hasher) HashFKCascades(val FKCascades) {
 	for i := range val {
 		h.HashUint64(uint64(reflect.ValueOf(val[i].Builder).Pointer()))
@@ -792,6 +813,10 @@ func (h *hasher) HashUDFDefinition(val *UDFDefinition) {
 	h.HashUint64(uint64(reflect.ValueOf(val).Pointer()))
 }
 
+func (h *hasher) HashUDADefinition(val *UDADefinition) {
+	h.HashUint64(uint64(reflect.ValueOf(val).Pointer()))
+}
+
 func (h *hasher) HashStoredProcTxnOp(val tree.StoredProcTxnOp) {
 	h.HashUint64(uint64(val))
 }
@@ -1007,10 +1032,38 @@ func (h *hasher) IsScanFlagsEqual(l, r ScanFlags) bool {
 	return l == r
 }
 
+func (h *hasher) IsTableSampleEqual(l, r TableSample) bool {
+	return l == r
+}
+
 func (h *hasher) IsJoinFlagsEqual(l, r JoinFlags) bool {
 	return l == r
 }
 
+func (h *hasher) IsGroupingSetListEqual(l, r GroupingSetList) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	for i := range l {
+		if !l[i].Equals(r[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (h *hasher) IsGroupingFuncsEqual(l, r GroupingFuncs) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	for i := range l {
+		if l[i].Col != r[i].Col || !l[i].Args.Equals(r[i].Args) {
+			return false
+		}
+	}
+	return true
+}
+
 func (h *hasher) IsFKCascadesEqual(l, r FKCascades) bool {
 	if len(l) != len(r) {
 		return false
@@ -1318,6 +1371,10 @@ func (h *hasher) IsLiteralRowsEqual(l, r *opt.LiteralRows) bool {
 	return l == r
 }
 
+func (h *hasher) IsUDADefinitionEqual(l, r *UDADefinition) bool {
+	return l == r
+}
+
 func (h *hasher) IsUDFDefinitionEqual(l, r *UDFDefinition) bool {
 	if len(l.Body) != len(r.Body) {
 		return false
@@ -392,6 +392,17 @@ func TestInterner(t *testing.T) {
 			{val1: ScanLimit(0), val2: ScanLimit(1), equal: false},
 		}},
 
+		{hashFn: in.hasher.HashTableSample, eqFn: in.hasher.IsTableSampleEqual, variations: []testVariation{
+			// Use unnamed fields so that compilation fails if a new field is
+			// added to TableSample.
+			{val1: TableSample{tree.TableSampleNone, 0, false, 0}, val2: TableSample{}, equal: true},
+			{val1: TableSample{Method: tree.TableSampleBernoulli}, val2: TableSample{Method: tree.TableSampleSystem}, equal: false},
+			{val1: TableSample{Probability: 0.1}, val2: TableSample{Probability: 0.1}, equal: true},
+			{val1: TableSample{Probability: 0.1}, val2: TableSample{Probability: 0.2}, equal: false},
+			{val1: TableSample{Repeatable: true}, val2: TableSample{Repeatable: false}, equal: false},
+			{val1: TableSample{Seed: 1}, val2: TableSample{Seed: 2}, equal: false},
+		}},
+
 		{hashFn: in.hasher.HashScanFlags, eqFn: in.hasher.IsScanFlagsEqual, variations: []testVariation{
 			// Use unnamed fields so that compilation fails if a new field is
 			// added to ScanFlags.
@@ -525,6 +536,20 @@ func TestInterner(t *testing.T) {
 			{val1: cat.UniqueOrdinals{1, 2}, val2: cat.UniqueOrdinals{1, 2, 3}, equal: false},
 		}},
 
+		{hashFn: in.hasher.HashGroupingSetList, eqFn: in.hasher.IsGroupingSetListEqual, variations: []testVariation{
+			{val1: GroupingSetList{}, val2: GroupingSetList{}, equal: true},
+			{val1: GroupingSetList{opt.MakeColSet(1, 2), opt.ColSet{}}, val2: GroupingSetList{opt.MakeColSet(1, 2), opt.ColSet{}}, equal: true},
+			{val1: GroupingSetList{opt.MakeColSet(1), opt.MakeColSet(2)}, val2: GroupingSetList{opt.MakeColSet(1, 2)}, equal: false},
+			{val1: GroupingSetList{opt.MakeColSet(1), opt.ColSet{}}, val2: GroupingSetList{opt.ColSet{}, opt.MakeColSet(1)}, equal: false},
+		}},
+
+		{hashFn: in.hasher.HashGroupingFuncs, eqFn: in.hasher.IsGroupingFuncsEqual, variations: []testVariation{
+			{val1: GroupingFuncs{}, val2: GroupingFuncs{}, equal: true},
+			{val1: GroupingFuncs{{Col: 3, Args: opt.ColList{1, 2}}}, val2: GroupingFuncs{{Col: 3, Args: opt.ColList{1, 2}}}, equal: true},
+			{val1: GroupingFuncs{{Col: 3, Args: opt.ColList{1, 2}}}, val2: GroupingFuncs{{Col: 3, Args: opt.ColList{2, 1}}}, equal: false},
+			{val1: GroupingFuncs{{Col: 3, Args: opt.ColList{1}}}, val2: GroupingFuncs{{Col: 4, Args: opt.ColList{1}}}, equal: false},
+		}},
+
 		{hashFn: in.hasher.HashSchemaDeps, eqFn: in.hasher.IsSchemaDepsEqual, variations: []testVariation{
 			{val1: viewDeps1, val2: viewDeps1, equal: true},
 			{val1: viewDeps1, val2: viewDeps2, equal: false},
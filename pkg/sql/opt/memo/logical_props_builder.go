@@ -612,6 +612,106 @@ func (b *logicalPropsBuilder) buildScalarGroupByProps(
 	b.buildGroupingExprProps(scalarGroupBy, rel)
 }
 
+func (b *logicalPropsBuilder) buildGroupingSetsProps(
+	groupingSets *GroupingSetsExpr, rel *props.Relational,
+) {
+	BuildSharedProps(groupingSets, &rel.Shared, b.evalCtx)
+
+	inputProps := groupingSets.Input.Relational()
+	hasEmptySet := groupingSets.Sets.HasEmptySet()
+
+	// Output Columns
+	// --------------
+	// Output columns are the union of the output columns of the grouping
+	// columns, the columns from the aggregate projection list and the columns
+	// of the GROUPING() functions.
+	rel.OutputCols = groupingSets.OutCols.ToSet()
+	for i := range groupingSets.Aggregations {
+		rel.OutputCols.Add(groupingSets.Aggregations[i].Col)
+	}
+	for i := range groupingSets.GroupingFuncs {
+		rel.OutputCols.Add(groupingSets.GroupingFuncs[i].Col)
+	}
+
+	// Not Null Columns
+	// ----------------
+	// The output column of a grouping column is not null if the grouping column
+	// is not null and part of all the grouping sets. The GROUPING() functions
+	// never return NULL.
+	for i, col := range groupingSets.GroupingCols {
+		if !inputProps.NotNullCols.Contains(col) {
+			continue
+		}
+		inAllSets := true
+		for _, set := range groupingSets.Sets {
+			if !set.Contains(col) {
+				inAllSets = false
+				break
+			}
+		}
+		if inAllSets {
+			rel.NotNullCols.Add(groupingSets.OutCols[i])
+		}
+	}
+	for i := range groupingSets.GroupingFuncs {
+		rel.NotNullCols.Add(groupingSets.GroupingFuncs[i].Col)
+	}
+	for i := range groupingSets.Aggregations {
+		item := &groupingSets.Aggregations[i]
+		agg := ExtractAggFunc(item.Agg)
+
+		// Some aggregates never return NULL, regardless of input.
+		if opt.AggregateIsNeverNull(agg.Op()) {
+			rel.NotNullCols.Add(item.Col)
+			continue
+		}
+
+		// If there is a possibility that the aggregate function has zero input
+		// rows, then it may return NULL. This is possible with an empty grouping
+		// set and with AggFilter.
+		if hasEmptySet || item.Agg.Op() == opt.AggFilterOp {
+			continue
+		}
+
+		// Most aggregate functions return a non-NULL result if they have at least
+		// one input row with non-NULL argument value, and if all argument values are non-NULL.
+		if opt.AggregateIsNeverNullOnNonNullInput(agg.Op()) {
+			inputCols := ExtractAggInputColumns(agg)
+			if inputCols.SubsetOf(inputProps.NotNullCols) {
+				rel.NotNullCols.Add(item.Col)
+			}
+		}
+	}
+
+	// Outer Columns
+	// -------------
+	// Outer columns were derived by BuildSharedProps; remove any that are bound
+	// by input columns.
+	rel.OuterCols.DifferenceWith(inputProps.OutputCols)
+
+	// Functional Dependencies
+	// -----------------------
+	// The output columns of the grouping columns do not form a key, since the
+	// groups of different grouping sets can have the same values. There are no
+	// functional dependencies.
+
+	// Cardinality
+	// -----------
+	// Each grouping set acts like a GroupBy, or like a ScalarGroupBy if it is
+	// empty.
+	n := uint32(len(groupingSets.Sets))
+	rel.Cardinality = inputProps.Cardinality.AsLowAs(1).Product(props.Cardinality{Min: n, Max: n})
+	if hasEmptySet {
+		rel.Cardinality = rel.Cardinality.AtLeast(props.OneCardinality)
+	}
+
+	// Statistics
+	// ----------
+	if !b.disableStats {
+		b.sb.buildGroupingSets(groupingSets, rel)
+	}
+}
+
 func (b *logicalPropsBuilder) buildDistinctOnProps(
 	distinctOn *DistinctOnExpr, rel *props.Relational,
 ) {
@@ -1890,7 +1990,7 @@ func BuildSharedProps(e opt.Expr, shared *props.Shared, evalCtx *eval.Context) {
 			shared.VolatilitySet.AddImmutable()
 		}
 
-	case *SubqueryExpr, *ExistsExpr, *AnyExpr, *ArrayFlattenExpr:
+	case *SubqueryExpr, *ExistsExpr, *UniqueExpr, *AnyExpr, *ArrayFlattenExpr:
 		shared.HasSubquery = true
 		if hasOuterCols(e.Child(0)) {
 			shared.HasCorrelatedSubquery = true
@@ -1915,6 +2015,9 @@ func BuildSharedProps(e opt.Expr, shared *props.Shared, evalCtx *eval.Context) {
 		shared.HasUDF = true
 		shared.VolatilitySet.Add(t.Def.Volatility)
 
+	case *UserDefinedAggExpr:
+		shared.VolatilitySet.Add(t.Def.Volatility)
+
 	default:
 		if opt.IsUnaryOp(e) {
 			inputType := e.Child(0).(opt.ScalarExpr).DataType()
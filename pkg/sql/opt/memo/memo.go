@@ -221,6 +221,11 @@ type Memo struct {
 	// memo staleness calculation.
 	txnIsoLevel isolation.Level
 
+	// planBaselineGist is the gist of the accepted plan baseline that the memo
+	// was optimized towards, or the empty string if there was none. It is set
+	// via a call to SetPlanBaselineGist.
+	planBaselineGist string
+
 	// curRank is the highest currently in-use scalar expression rank.
 	curRank opt.ScalarRank
 
@@ -576,6 +581,20 @@ func (m *Memo) IsOptimized() bool {
 	return m.rootExpr != nil && m.rootExpr.RequiredPhysical() != nil
 }
 
+// PlanBaselineGist returns the gist of the accepted plan baseline that the memo
+// was optimized towards, or the empty string if there was none. An optimized
+// memo must not be reused for a statement with a different accepted plan
+// baseline.
+func (m *Memo) PlanBaselineGist() string {
+	return m.planBaselineGist
+}
+
+// SetPlanBaselineGist records the gist of the accepted plan baseline that the
+// memo is optimized towards.
+func (m *Memo) SetPlanBaselineGist(gist string) {
+	m.planBaselineGist = gist
+}
+
 // OptimizationCost returns a rough estimate of the cost of optimization of the
 // memo. It is dependent on the number of tables in the metadata, based on the
 // reasoning that queries with more tables likely have more joins, which tend
@@ -490,6 +490,9 @@ func (sb *statisticsBuilder) colStat(colSet opt.ColSet, e RelExpr) *props.Column
 		opt.UpsertDistinctOnOp, opt.EnsureUpsertDistinctOnOp:
 		return sb.colStatGroupBy(colSet, e)
 
+	case opt.GroupingSetsOp:
+		return sb.colStatGroupingSets(colSet, e.(*GroupingSetsExpr))
+
 	case opt.LimitOp:
 		return sb.colStatLimit(colSet, e.(*LimitExpr))
 
@@ -619,10 +622,12 @@ func (sb *statisticsBuilder) colStatLeaf(
 		}
 	} else {
 		distinctCount := 1.0
+		maxDistinctCount := 0.0
 		nullCount := s.RowCount
 		colSet.ForEach(func(i opt.ColumnID) {
 			colStatLeaf := sb.colStatLeaf(opt.MakeColSet(i), s, fd, notNullCols)
 			distinctCount *= colStatLeaf.DistinctCount
+			maxDistinctCount = max(maxDistinctCount, colStatLeaf.DistinctCount)
 			// Multiply by the expected chance of collisions with nulls already
 			// collected.
 			nullCount *= colStatLeaf.NullCount / s.RowCount
@@ -631,6 +636,14 @@ func (sb *statisticsBuilder) colStatLeaf(
 		colStat, _ = s.ColStats.Lookup(colSet)
 		colStat.DistinctCount = min(distinctCount, s.RowCount)
 		colStat.NullCount = min(nullCount, s.RowCount)
+		// If the degree of functional dependency between the columns of a base
+		// table was measured by a statistic on a superset of the columns, use it
+		// to interpolate between the distinct count of fully dependent columns
+		// (the largest single-column distinct count) and that of independent
+		// columns.
+		if degree, ok := sb.dependencyDegreeFromTableStats(colSet); ok && maxDistinctCount < colStat.DistinctCount {
+			colStat.DistinctCount = maxDistinctCount + (colStat.DistinctCount-maxDistinctCount)*(1-degree)
+		}
 	}
 
 	return colStat
@@ -893,6 +906,11 @@ func (sb *statisticsBuilder) buildScan(scan *ScanExpr, relProps *props.Relationa
 	s.VirtualCols.UnionWith(inputStats.VirtualCols)
 	pred := scan.PartialIndexPredicate(sb.md)
 
+	// A sampled scan only returns a fraction of the rows of the table.
+	if !scan.Sample.Empty() {
+		s.ApplySelectivity(props.MakeSelectivity(scan.Sample.Probability))
+	}
+
 	// If the constraints and pred are nil, then this scan is an unconstrained
 	// scan on a non-partial index. The stats of the scan are the same as the
 	// underlying table stats.
@@ -2161,6 +2179,89 @@ func (sb *statisticsBuilder) colStatGroupBy(
 	return colStat
 }
 
+// +---------------+
+// | Grouping Sets |
+// +---------------+
+
+func (sb *statisticsBuilder) buildGroupingSets(
+	groupingSets *GroupingSetsExpr, relProps *props.Relational,
+) {
+	s := relProps.Statistics()
+	if zeroCardinality := s.Init(relProps, sb.minRowCount); zeroCardinality {
+		// Short cut if cardinality is 0.
+		return
+	}
+	s.Available = sb.availabilityFromInput(groupingSets)
+
+	// The row count is the sum of the number of groups of each grouping set,
+	// which is estimated like for a GroupBy, or is exactly one for an empty
+	// grouping set.
+	inputStats := sb.statsFromChild(groupingSets, 0 /* childIdx */)
+	s.RowCount = 0
+	for _, set := range groupingSets.Sets {
+		if set.Empty() {
+			s.RowCount++
+			continue
+		}
+		inputColStat := sb.colStatFromChild(set, groupingSets, 0 /* childIdx */)
+		s.RowCount += min(inputColStat.DistinctCount, inputStats.RowCount)
+	}
+
+	sb.finalizeFromCardinality(relProps)
+}
+
+func (sb *statisticsBuilder) colStatGroupingSets(
+	colSet opt.ColSet, groupingSets *GroupingSetsExpr,
+) *props.ColumnStatistic {
+	relProps := groupingSets.Relational()
+	s := relProps.Statistics()
+	colStat, _ := s.ColStats.Add(colSet)
+
+	// Map the output columns of the grouping columns to the input columns.
+	var inputCols opt.ColSet
+	onlyGroupingCols := true
+	for c, ok := colSet.Next(0); ok; c, ok = colSet.Next(c + 1) {
+		idx, found := groupingSets.OutCols.Find(c)
+		if !found {
+			onlyGroupingCols = false
+			break
+		}
+		inputCols.Add(groupingSets.GroupingCols[idx])
+	}
+
+	if !onlyGroupingCols {
+		// Some of the requested columns are aggregates or GROUPING() functions.
+		// Estimate the distinct count to be the same as the row count.
+		colStat.DistinctCount = s.RowCount
+		colStat.NullCount = 0
+	} else {
+		// The rows of each grouping set contain the distinct values of the
+		// requested columns which are part of the set, and NULL for the other
+		// columns.
+		colStat.DistinctCount = 0
+		numSetsWithNulls := 0
+		for _, set := range groupingSets.Sets {
+			cols := inputCols.Intersection(set)
+			if !cols.Equals(inputCols) {
+				numSetsWithNulls++
+			}
+			if cols.Empty() {
+				colStat.DistinctCount++
+				continue
+			}
+			inputColStat := sb.colStatFromChild(cols, groupingSets, 0 /* childIdx */)
+			colStat.DistinctCount += inputColStat.DistinctCount
+		}
+		colStat.NullCount = s.RowCount * float64(numSetsWithNulls) / float64(len(groupingSets.Sets))
+	}
+
+	if colSet.Intersects(relProps.NotNullCols) {
+		colStat.NullCount = 0
+	}
+	sb.finalizeFromRowCountAndDistinctCounts(colStat, s)
+	return colStat
+}
+
 // +--------+
 // | Set Op |
 // +--------+
@@ -4307,6 +4408,12 @@ func (sb *statisticsBuilder) selectivityFromMultiColDistinctCounts(
 		// Scale the fdStrength so it ranges between 0 and 1.
 		fdStrength = (fdStrength - minFdStrength) / (1 - minFdStrength)
 	}
+	// If the degree of functional dependency between the columns was measured
+	// when the table statistics were collected, use it instead of the estimate
+	// derived from the distinct counts.
+	if degree, ok := sb.dependencyDegreeFromTableStats(multiColSet); ok {
+		fdStrength = degree
+	}
 
 	// These variables correspond to min_distinct, max_distinct, and distinct_range
 	// in the comment above the function definition.
@@ -4401,6 +4508,65 @@ func (sb *statisticsBuilder) selectivityFromMultiColDistinctCounts(
 		))
 }
 
+// dependencyDegreeFromTableStats returns the degree of functional dependency
+// between the given columns of a base table, if it was collected by the most
+// recent statistic on those columns or on a superset of them. The degree is the
+// largest degree to which one of the columns determines the others, so that a
+// degree of 1 means that the distinct count of the columns equals the largest
+// distinct count of a single column.
+//
+// If a column determines the other columns of a superset to some degree, it
+// determines the other columns of the subset to at least that degree, so the
+// degrees measured on a superset are lower bounds for the subset. The largest
+// of these bounds is returned.
+func (sb *statisticsBuilder) dependencyDegreeFromTableStats(cols opt.ColSet) (float64, bool) {
+	var tabID opt.TableID
+	for col, ok := cols.Next(0); ok; col, ok = cols.Next(col + 1) {
+		colTabID := sb.md.ColumnMeta(col).Table
+		if colTabID == 0 || (tabID != 0 && colTabID != tabID) {
+			return 0, false
+		}
+		tabID = colTabID
+	}
+	if tabID == 0 {
+		return 0, false
+	}
+	tab := sb.md.Table(tabID)
+	var degree float64
+	var found bool
+	// The statistics are ordered from most to least recent, so only the first
+	// statistic on each set of columns is used.
+	var seen []opt.ColSet
+StatLoop:
+	for i := 0; i < tab.StatisticCount(); i++ {
+		stat := tab.Statistic(i)
+		degrees := stat.DependencyDegrees()
+		if stat.IsPartial() || stat.ColumnCount() < cols.Len() || len(degrees) != stat.ColumnCount() {
+			continue
+		}
+		var statCols opt.ColSet
+		for j := 0; j < stat.ColumnCount(); j++ {
+			statCols.Add(tabID.ColumnID(stat.ColumnOrdinal(j)))
+		}
+		if !cols.SubsetOf(statCols) {
+			continue
+		}
+		for _, prev := range seen {
+			if prev.Equals(statCols) {
+				continue StatLoop
+			}
+		}
+		seen = append(seen, statCols)
+		for j, d := range degrees {
+			if cols.Contains(tabID.ColumnID(stat.ColumnOrdinal(j))) {
+				degree = max(degree, d)
+				found = true
+			}
+		}
+	}
+	return min(degree, 1), found
+}
+
 // correlationFromMultiColDistinctCounts returns the correlation between the
 // given set of columns, as indicated by multi-column stats. It is a number
 // between 0 and 1, where 0 means the columns are completely independent, and 1
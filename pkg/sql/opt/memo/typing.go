@@ -208,6 +208,8 @@ func init() {
 	typingFuncMap[opt.LeadOp] = typeAsFirstArg
 	typingFuncMap[opt.NthValueOp] = typeAsFirstArg
 
+	typingFuncMap[opt.UserDefinedAggOp] = typeUserDefinedAgg
+
 	typingFuncMap[opt.MergeStatsMetadataOp] = typeAsFirstArg
 	typingFuncMap[opt.MergeStatementStatsOp] = typeAsFirstArg
 	typingFuncMap[opt.MergeTransactionStatsOp] = typeAsFirstArg
@@ -393,6 +395,11 @@ func typeCast(e opt.ScalarExpr) *types.T {
 	return e.(*CastExpr).Typ
 }
 
+// typeUserDefinedAgg returns the type of a user-defined aggregate operator.
+func typeUserDefinedAgg(e opt.ScalarExpr) *types.T {
+	return e.(*UserDefinedAggExpr).Def.Typ
+}
+
 // typeUDFCall returns the type of a UDF call operator
 func typeUDFCall(e opt.ScalarExpr) *types.T {
 	return e.(*UDFCallExpr).Def.Typ
@@ -877,6 +877,30 @@ func (c *CustomFuncs) ConvertSubToExistsPrivate(sub *memo.SubqueryPrivate) *memo
 	}
 }
 
+// ConstructDuplicateRows constructs an expression that returns one row for
+// each group of equal rows in the given input that contains more than one row.
+// Rows with a NULL value in any column are ignored, since they are never equal
+// to another row in the context of a UNIQUE predicate. See the
+// ConvertUniqueToNotExists rule for more details.
+func (c *CustomFuncs) ConstructDuplicateRows(input memo.RelExpr) memo.RelExpr {
+	cols := input.Relational().OutputCols
+	notNullFilters := make(memo.FiltersExpr, 0, cols.Len())
+	for col, ok := cols.Next(0); ok; col, ok = cols.Next(col + 1) {
+		notNullFilters = append(notNullFilters, c.f.ConstructFiltersItem(
+			c.f.ConstructIsNot(c.f.ConstructVariable(col), memo.NullSingleton),
+		))
+	}
+	countCol := c.f.Metadata().AddColumn("count_rows", types.Int)
+	groupBy := c.f.ConstructGroupBy(
+		c.f.ConstructSelect(input, notNullFilters),
+		memo.AggregationsExpr{c.f.ConstructAggregationsItem(c.f.ConstructCountRows(), countCol)},
+		&memo.GroupingPrivate{GroupingCols: cols},
+	)
+	return c.f.ConstructSelect(groupBy, memo.FiltersExpr{c.f.ConstructFiltersItem(
+		c.f.ConstructGt(c.f.ConstructVariable(countCol), c.IntConst(tree.NewDInt(1))),
+	)})
+}
+
 // ConstructBinary builds a dynamic binary expression, given the binary
 // operator's type and its two arguments.
 func (c *CustomFuncs) ConstructBinary(op opt.Operator, left, right opt.ScalarExpr) opt.ScalarExpr {
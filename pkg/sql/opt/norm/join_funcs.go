@@ -376,7 +376,7 @@ func (c *CustomFuncs) MapJoinOpFilter(
 			}
 			return c.f.ConstructVariable(opt.ColumnID(outCol))
 
-		case *memo.SubqueryExpr, *memo.ExistsExpr, *memo.AnyExpr:
+		case *memo.SubqueryExpr, *memo.ExistsExpr, *memo.UniqueExpr, *memo.AnyExpr:
 			// There are no correlated subqueries, so we don't need to recurse here.
 			return nd
 		}
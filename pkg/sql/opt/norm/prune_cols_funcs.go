@@ -76,6 +76,9 @@ func (c *CustomFuncs) neededMutationCols(
 	if private.CanaryCol != 0 {
 		cols.Add(private.CanaryCol)
 	}
+	if private.DeleteCol != 0 {
+		cols.Add(private.DeleteCol)
+	}
 	cols.UnionWith(private.TriggerCols)
 
 	if private.WithID != 0 {
@@ -136,10 +139,13 @@ func (c *CustomFuncs) NeededMutationFetchCols(
 	//   3. For Upsert, the corresponding FETCH column is needed when there is
 	//      no corresponding UPDATE column. In that case, either the INSERT or
 	//      FETCH column becomes the RETURN column, so both must be available
-	//      for the CASE expression.
+	//      for the CASE expression. An Upsert that can delete rows (see
+	//      DeleteCol) returns the FETCH columns of the deleted rows, like
+	//      Delete.
+	isDelete := op == opt.DeleteOp || private.DeleteCol != 0
 	for ord, col := range private.ReturnCols {
 		if col != 0 {
-			if op == opt.DeleteOp || len(private.UpdateCols) == 0 || private.UpdateCols[ord] == 0 {
+			if isDelete || len(private.UpdateCols) == 0 || private.UpdateCols[ord] == 0 {
 				cols.Add(tabMeta.MetaID.ColumnID(ord))
 			}
 		}
@@ -147,6 +153,12 @@ func (c *CustomFuncs) NeededMutationFetchCols(
 
 	switch op {
 	case opt.UpdateOp, opt.UpsertOp:
+		if private.DeleteCol != 0 {
+			// The Upsert was built for a MERGE statement that can delete existing
+			// rows, so it needs the same columns as a Delete.
+			c.addDeleteFetchCols(tabMeta, &cols)
+		}
+
 		// Determine set of target table columns that need to be updated.
 		var updateCols opt.ColSet
 		for ord, col := range private.UpdateCols {
@@ -223,27 +235,33 @@ func (c *CustomFuncs) NeededMutationFetchCols(
 		}
 
 	case opt.DeleteOp:
-		// Add in all strict key columns from all indexes, since these are needed
-		// to compose the keys of rows to delete. Include mutation indexes, since
-		// it is necessary to delete rows even from indexes that are being added
-		// or dropped.
-		for i, n := 0, tabMeta.Table.DeletableIndexCount(); i < n; i++ {
-			cols.UnionWith(tabMeta.IndexKeyColumnsMapInverted(i))
-		}
-
-		// Add inbound foreign keys that may require a check or cascade.
-		for i, n := 0, tabMeta.Table.InboundForeignKeyCount(); i < n; i++ {
-			inboundFK := tabMeta.Table.InboundForeignKey(i)
-			for j, m := 0, inboundFK.ColumnCount(); j < m; j++ {
-				ord := inboundFK.ReferencedColumnOrdinal(tabMeta.Table, j)
-				cols.Add(tabMeta.MetaID.ColumnID(ord))
-			}
-		}
+		c.addDeleteFetchCols(tabMeta, &cols)
 	}
 
 	return cols
 }
 
+// addDeleteFetchCols adds to cols the FetchCols that are needed to delete rows
+// from the given table.
+func (c *CustomFuncs) addDeleteFetchCols(tabMeta *opt.TableMeta, cols *opt.ColSet) {
+	// Add in all strict key columns from all indexes, since these are needed
+	// to compose the keys of rows to delete. Include mutation indexes, since
+	// it is necessary to delete rows even from indexes that are being added
+	// or dropped.
+	for i, n := 0, tabMeta.Table.DeletableIndexCount(); i < n; i++ {
+		cols.UnionWith(tabMeta.IndexKeyColumnsMapInverted(i))
+	}
+
+	// Add inbound foreign keys that may require a check or cascade.
+	for i, n := 0, tabMeta.Table.InboundForeignKeyCount(); i < n; i++ {
+		inboundFK := tabMeta.Table.InboundForeignKey(i)
+		for j, m := 0, inboundFK.ColumnCount(); j < m; j++ {
+			ord := inboundFK.ReferencedColumnOrdinal(tabMeta.Table, j)
+			cols.Add(tabMeta.MetaID.ColumnID(ord))
+		}
+	}
+}
+
 // CanPruneCols returns true if the target expression has extra columns that are
 // not needed at this level of the tree, and can be eliminated by one of the
 // PruneCols rules. CanPruneCols uses the PruneCols property to determine the
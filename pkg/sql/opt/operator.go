@@ -337,7 +337,8 @@ func AggregateIgnoresNulls(op Operator) bool {
 		return true
 
 	case ArrayAggOp, ArrayCatAggOp, ConcatAggOp, ConstAggOp, CountRowsOp,
-		FirstAggOp, JsonAggOp, JsonbAggOp, JsonObjectAggOp, JsonbObjectAggOp:
+		FirstAggOp, JsonAggOp, JsonbAggOp, JsonObjectAggOp, JsonbObjectAggOp,
+		UserDefinedAggOp:
 		return false
 
 	default:
@@ -366,6 +367,11 @@ func AggregateIsNullOnEmpty(op Operator) bool {
 	case CountOp, CountRowsOp, RegressionCountOp:
 		return false
 
+	case UserDefinedAggOp:
+		// A user-defined aggregate returns its initial state (or the result of
+		// the final function applied to it) when there are no input values.
+		return false
+
 	default:
 		panic(errors.AssertionFailedf("unhandled op %s", redact.Safe(op)))
 	}
@@ -393,7 +399,7 @@ func AggregateIsNeverNullOnNonNullInput(op Operator) bool {
 		return true
 
 	case VarianceOp, StdDevOp, CorrOp, CovarSampOp, RegressionInterceptOp,
-		RegressionR2Op, RegressionSlopeOp, STExtentOp, STMakeLineOp:
+		RegressionR2Op, RegressionSlopeOp, STExtentOp, STMakeLineOp, UserDefinedAggOp:
 		// These aggregations can return NULL even with non-null input values.
 		return false
 
@@ -445,7 +451,8 @@ func AggregatesCanMerge(inner, outer Operator) bool {
 		VarPopOp, CovarPopOp, CovarSampOp, RegressionAvgXOp, RegressionAvgYOp,
 		RegressionInterceptOp, RegressionR2Op, RegressionSlopeOp, RegressionSXXOp,
 		RegressionSXYOp, RegressionSYYOp, RegressionCountOp, MergeStatsMetadataOp,
-		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp:
+		MergeStatementStatsOp, MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp,
+		UserDefinedAggOp:
 		return false
 
 	default:
@@ -471,7 +478,7 @@ func AggregateIgnoresDuplicates(op Operator) bool {
 		CovarSampOp, RegressionAvgXOp, RegressionAvgYOp, RegressionInterceptOp,
 		RegressionR2Op, RegressionSlopeOp, RegressionSXXOp, RegressionSXYOp,
 		RegressionSYYOp, RegressionCountOp, MergeStatsMetadataOp, MergeStatementStatsOp,
-		MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp:
+		MergeTransactionStatsOp, MergeAggregatedStmtMetadataOp, UserDefinedAggOp:
 		return false
 
 	default:
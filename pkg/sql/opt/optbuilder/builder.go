@@ -154,6 +154,15 @@ type Builder struct {
 	// insideDataSource is true when we are processing a data source.
 	insideDataSource bool
 
+	// tableSample is the sample requested by the TABLESAMPLE clause of the data
+	// source being built, if any. It is consumed by buildScan.
+	tableSample *memo.TableSample
+
+	// scanOnly is set if the data source being built is prefixed with ONLY, in
+	// which case the tables inheriting from it are not scanned along with it.
+	// It is consumed when the table name is resolved.
+	scanOnly bool
+
 	// insideNestedPLpgSQLCall is true when we are processing a nested PLpgSQL
 	// CALL statement.
 	insideNestedPLpgSQLCall bool
@@ -358,7 +367,7 @@ func (b *Builder) buildStmt(
 	if b.insideViewDef {
 		// A blocklist of statements that can't be used from inside a view.
 		switch stmt := stmt.(type) {
-		case *tree.Delete, *tree.Insert, *tree.Update, *tree.CreateTable, *tree.CreateView,
+		case *tree.Delete, *tree.Insert, *tree.Update, *tree.Merge, *tree.CreateTable, *tree.CreateView,
 			*tree.Split, *tree.Unsplit, *tree.Relocate, *tree.RelocateRange,
 			*tree.ControlJobs, *tree.ControlSchedules, *tree.CancelQueries, *tree.CancelSessions,
 			*tree.CreateRoutine:
@@ -372,7 +381,7 @@ func (b *Builder) buildStmt(
 	if b.insideFuncDef {
 		switch stmt := stmt.(type) {
 		case *tree.Select, tree.SelectStatement:
-		case *tree.Insert, *tree.Update, *tree.Delete:
+		case *tree.Insert, *tree.Update, *tree.Delete, *tree.Merge:
 		case *tree.Call:
 		case *tree.DoBlock:
 			if !b.evalCtx.Settings.Version.ActiveVersion(b.ctx).IsActive(clusterversion.V25_1) {
@@ -410,6 +419,11 @@ func (b *Builder) buildStmt(
 			return b.buildUpdate(stmt, inScope)
 		})
 
+	case *tree.Merge:
+		return b.processWiths(stmt.With, inScope, func(inScope *scope) *scope {
+			return b.buildMerge(stmt, inScope)
+		})
+
 	case *tree.CreateTable:
 		return b.buildCreateTable(stmt, inScope)
 
@@ -159,7 +159,7 @@ func (b *Builder) buildCreateFunction(cf *tree.CreateRoutine, inScope *scope) (o
 	// When multiple OUT parameters are present, parameter names become the
 	// labels in the output RECORD type.
 	var outParamNames []string
-	var sawDefaultExpr, sawPolymorphicInParam, sawPolymorphicOutParam bool
+	var sawDefaultExpr, sawVariadicParam, sawPolymorphicInParam, sawPolymorphicOutParam bool
 	for i := range cf.Params {
 		param := &cf.Params[i]
 		typ, err := tree.ResolveType(b.ctx, param.Type, b.semaCtx.TypeResolver)
@@ -186,6 +186,25 @@ func (b *Builder) buildCreateFunction(cf *tree.CreateRoutine, inScope *scope) (o
 		if param.Class == tree.RoutineParamInOut && param.Name == "" {
 			panic(unimplemented.NewWithIssue(121251, "unnamed INOUT parameters are not yet supported"))
 		}
+		if sawVariadicParam {
+			// Only OUT parameters of functions can follow the VARIADIC
+			// parameter.
+			if param.IsInParam() {
+				panic(pgerror.Newf(pgcode.InvalidFunctionDefinition,
+					"VARIADIC parameter must be the last input parameter"))
+			}
+			if cf.IsProcedure {
+				panic(pgerror.Newf(pgcode.InvalidFunctionDefinition,
+					"VARIADIC parameter must be the last parameter"))
+			}
+		}
+		if param.Class == tree.RoutineParamVariadic {
+			if typ.Family() != types.ArrayFamily {
+				panic(pgerror.Newf(pgcode.InvalidFunctionDefinition,
+					"VARIADIC parameter must be an array"))
+			}
+			sawVariadicParam = true
+		}
 		if param.IsInParam() {
 			if typ.Family() == types.VoidFamily {
 				panic(pgerror.Newf(pgcode.InvalidFunctionDefinition, "SQL functions cannot have arguments of type VOID"))
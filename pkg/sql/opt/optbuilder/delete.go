@@ -64,6 +64,9 @@ func (b *Builder) buildDelete(del *tree.Delete, inScope *scope) (outScope *scope
 			"cannot delete from view \"%s\"", tab.Name(),
 		))
 	}
+	if outScope, ok := b.buildInheritedTableMutation(tab, del, inScope); ok {
+		return outScope
+	}
 
 	if refColumns != nil {
 		panic(pgerror.Newf(pgcode.Syntax,
@@ -0,0 +1,195 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package optbuilder
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/opt"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/builtins/builtinsregistry"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+)
+
+// assertDomainConstraintFnName is the name of the builtin that raises an
+// error when a value violates a constraint of a domain.
+const assertDomainConstraintFnName = "crdb_internal.assert_domain_constraint"
+
+// buildDomainCheck wraps the given scalar expression, which has the given
+// domain type, in calls to crdb_internal.assert_domain_constraint which raise
+// an error if the value violates the NOT NULL or CHECK constraints of the
+// domain. The input is returned unchanged if the type is not a domain or if the
+// domain has no constraints.
+func (b *Builder) buildDomainCheck(input opt.ScalarExpr, typ *types.T) opt.ScalarExpr {
+	if !typ.IsDomain() || !typ.TypeMeta.DomainData.HasConstraints() {
+		return input
+	}
+	if v, ok := input.(*memo.VariableExpr); ok {
+		return b.buildDomainCheckForCol(v.Col, typ)
+	}
+
+	// Project the value in a single-row subquery so that it is evaluated only
+	// once, regardless of the number of constraints that refer to it.
+	f := b.factory
+	valueCol := f.Metadata().AddColumn("value", typ)
+	values := f.ConstructValues(
+		memo.ScalarListExpr{f.ConstructTuple(memo.ScalarListExpr{input}, types.MakeTuple([]*types.T{typ}))},
+		&memo.ValuesPrivate{Cols: opt.ColList{valueCol}, ID: f.Metadata().NextUniqueID()},
+	)
+	checkedCol := f.Metadata().AddColumn("", typ)
+	project := f.ConstructProject(
+		values,
+		memo.ProjectionsExpr{f.ConstructProjectionsItem(b.buildDomainCheckForCol(valueCol, typ), checkedCol)},
+		opt.ColSet{},
+	)
+	return f.ConstructSubquery(project, &memo.SubqueryPrivate{})
+}
+
+// buildDomainCheckForCol builds the checks of the constraints of the given
+// domain type for the value of the given column.
+func (b *Builder) buildDomainCheckForCol(col opt.ColumnID, typ *types.T) opt.ScalarExpr {
+	f := b.factory
+	domain := typ.TypeMeta.DomainData
+	domainName := tree.NewDString(typ.Name())
+	out := opt.ScalarExpr(f.ConstructVariable(col))
+	if domain.NotNull {
+		satisfied := f.ConstructIsNot(f.ConstructVariable(col), memo.NullSingleton)
+		out = b.constructDomainAssert(out, satisfied, domainName, tree.NewDString(""))
+	}
+	if len(domain.CheckExprs) == 0 {
+		return out
+	}
+
+	// The CHECK constraints refer to the value being checked as VALUE, and are
+	// type checked against the base type of the domain.
+	valueScope := b.allocScope()
+	valueScope.cols = append(valueScope.cols, scopeColumn{
+		name: scopeColName("value"),
+		typ:  typ.DomainBaseType(),
+		id:   col,
+	})
+	for i := range domain.CheckExprs {
+		expr, err := parser.ParseExpr(domain.CheckExprs[i])
+		if err != nil {
+			panic(err)
+		}
+		texpr := valueScope.resolveAndRequireType(expr, types.Bool)
+		satisfied := b.buildScalar(texpr, valueScope, nil /* outScope */, nil /* outCol */, nil /* colRefs */)
+		out = b.constructDomainAssert(out, satisfied, domainName, tree.NewDString(domain.CheckNames[i]))
+	}
+	return out
+}
+
+// constructDomainAssert constructs a call to
+// crdb_internal.assert_domain_constraint, which returns the given value if
+// satisfied is not false, and otherwise raises an error for the given
+// constraint of the domain. An empty constraint name refers to the NOT NULL
+// constraint of the domain.
+func (b *Builder) constructDomainAssert(
+	value, satisfied opt.ScalarExpr, domainName, constraintName *tree.DString,
+) opt.ScalarExpr {
+	props, overloads := builtinsregistry.GetBuiltinProperties(assertDomainConstraintFnName)
+	if len(overloads) != 1 {
+		panic(errors.AssertionFailedf("expected one overload for %s", assertDomainConstraintFnName))
+	}
+	f := b.factory
+	return f.ConstructFunction(
+		memo.ScalarListExpr{
+			value,
+			satisfied,
+			f.ConstructConstVal(domainName, types.String),
+			f.ConstructConstVal(constraintName, types.String),
+		},
+		&memo.FunctionPrivate{
+			Name:       assertDomainConstraintFnName,
+			Typ:        value.DataType(),
+			Properties: props,
+			Overload:   &overloads[0],
+		},
+	)
+}
+
+// addDomainConstraintChecks builds a projection that wraps the values of the
+// columns in colIDs that have a domain type in checks of the constraints of
+// the domain. The given list is updated to refer to the checked values. If
+// guard is not nil, the values are only checked for the rows for which it is
+// true.
+func (mb *mutationBuilder) addDomainConstraintChecks(
+	colIDs opt.OptionalColList, guard opt.ScalarExpr,
+) {
+	var projectionsScope *scope
+	for ord, colID := range colIDs {
+		if colID == 0 {
+			continue
+		}
+		targetCol := mb.tab.Column(ord)
+		typ := targetCol.DatumType()
+		if !typ.IsDomain() || !typ.TypeMeta.DomainData.HasConstraints() {
+			continue
+		}
+		scalar := mb.b.buildDomainCheckForCol(colID, typ)
+		if guard != nil {
+			f := mb.b.factory
+			scalar = f.ConstructCase(
+				memo.TrueSingleton,
+				memo.ScalarListExpr{f.ConstructWhen(guard, scalar)},
+				f.ConstructVariable(colID),
+			)
+		}
+
+		// Lazily create the new scope.
+		if projectionsScope == nil {
+			projectionsScope = mb.outScope.replace()
+			projectionsScope.appendColumnsFromScope(mb.outScope)
+		}
+		name := scopeColName(targetCol.ColName()).WithMetadataName(
+			fmt.Sprintf("%s_domain_check", targetCol.ColName()),
+		)
+		scopeCol := mb.b.synthesizeColumn(projectionsScope, name, typ, nil /* expr */, scalar)
+		colIDs[ord] = scopeCol.id
+	}
+
+	if projectionsScope != nil {
+		mb.b.constructProjectForScope(mb.outScope, projectionsScope)
+		mb.outScope = projectionsScope
+	}
+}
+
+// addDomainConstraintChecksForUpsert adds the checks of the constraints of
+// domain-typed columns for an UPSERT or INSERT .. ON CONFLICT statement. The
+// insert values are checked for all rows, and the update values are only
+// checked for the rows that conflict with an existing row.
+func (mb *mutationBuilder) addDomainConstraintChecksForUpsert() {
+	insertColIDs := make(opt.OptionalColList, len(mb.insertColIDs))
+	copy(insertColIDs, mb.insertColIDs)
+	mb.addDomainConstraintChecks(mb.insertColIDs, nil /* guard */)
+
+	// Update values that are the same as the insert values have already been
+	// checked.
+	updateColIDs := make(opt.OptionalColList, len(mb.updateColIDs))
+	for ord, colID := range mb.updateColIDs {
+		if colID != 0 && colID == insertColIDs[ord] {
+			mb.updateColIDs[ord] = mb.insertColIDs[ord]
+		} else {
+			updateColIDs[ord] = colID
+		}
+	}
+	if updateColIDs.IsEmpty() {
+		return
+	}
+	f := mb.b.factory
+	mb.addDomainConstraintChecks(
+		updateColIDs, f.ConstructIsNot(f.ConstructVariable(mb.canaryColID), memo.NullSingleton),
+	)
+	for ord, colID := range updateColIDs {
+		if colID != 0 {
+			mb.updateColIDs[ord] = colID
+		}
+	}
+}
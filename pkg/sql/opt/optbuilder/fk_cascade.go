@@ -18,6 +18,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/errorutil"
 	"github.com/cockroachdb/cockroach/pkg/util/intsets"
 	"github.com/cockroachdb/errors"
@@ -63,6 +64,12 @@ type onDeleteCascadeBuilder struct {
 	// built.
 	oldValues opt.ColList
 
+	// deleteCol is the column from the mutation input that is true for the
+	// rows that are deleted, or 0 if all the rows of the mutation input are
+	// deleted. It is set for MERGE statements (see mutationBuilder.deleteColID).
+	// Like oldValues, it must be remapped to the new memo.
+	deleteCol opt.ColumnID
+
 	// stmtTreeInitFn returns a statementTree that tracks the mutations in
 	// ancestor statements. It may be unset if there are no ancestor statements.
 	stmtTreeInitFn func() statementTree
@@ -71,13 +78,14 @@ type onDeleteCascadeBuilder struct {
 var _ memo.PostQueryBuilder = &onDeleteCascadeBuilder{}
 
 func (mb *mutationBuilder) newOnDeleteCascadeBuilder(
-	fkInboundOrdinal int, childTable cat.Table, oldValues opt.ColList,
+	fkInboundOrdinal int, childTable cat.Table, oldValues opt.ColList, deleteCol opt.ColumnID,
 ) *onDeleteCascadeBuilder {
 	return &onDeleteCascadeBuilder{
 		mutatedTable:     mb.tab,
 		fkInboundOrdinal: fkInboundOrdinal,
 		childTable:       childTable,
 		oldValues:        oldValues,
+		deleteCol:        deleteCol,
 		stmtTreeInitFn:   mb.b.stmtTree.GetInitFnForPostQuery(),
 	}
 }
@@ -112,8 +120,9 @@ func (cb *onDeleteCascadeBuilder) Build(
 			// for each public table column, making it appropriate to set it as
 			// mb.fetchScope.
 			oldValues := cb.oldValues.RemapColumns(colMap)
+			deleteCol := remapDeleteCol(cb.deleteCol, colMap)
 			mb.fetchScope = b.buildDeleteCascadeMutationInput(
-				cb.childTable, &mb.alias, fk, binding, bindingProps, oldValues,
+				cb.childTable, &mb.alias, fk, binding, bindingProps, oldValues, deleteCol,
 			)
 			mb.outScope = mb.fetchScope
 
@@ -179,6 +188,12 @@ var _ memo.PostQueryBuilder = &onDeleteFastCascadeBuilder{}
 func (mb *mutationBuilder) tryNewOnDeleteFastCascadeBuilder(
 	fk cat.ForeignKeyConstraint, fkInboundOrdinal int, childTab cat.Table,
 ) (_ *onDeleteFastCascadeBuilder, ok bool) {
+	// Under MATCH PARTIAL, a child row can refer to multiple parent rows, so
+	// the filter on the parent table cannot simply be transferred over to the
+	// child table.
+	if fk.MatchMethod() == tree.MatchPartial {
+		return nil, false
+	}
 	parentTab := mb.tab
 	mutationInputScope := mb.outScope
 	fkCols := make(opt.ColList, fk.ColumnCount())
@@ -487,6 +502,11 @@ type onDeleteSetBuilder struct {
 	// built.
 	oldValues opt.ColList
 
+	// deleteCol is the column from the mutation input that is true for the
+	// rows that are deleted, or 0 if all the rows of the mutation input are
+	// deleted. See onDeleteCascadeBuilder.deleteCol.
+	deleteCol opt.ColumnID
+
 	// stmtTreeInitFn returns a statementTree that tracks the mutations in
 	// ancestor statements. It may be unset if there are no ancestor statements.
 	stmtTreeInitFn func() statementTree
@@ -495,7 +515,11 @@ type onDeleteSetBuilder struct {
 var _ memo.PostQueryBuilder = &onDeleteSetBuilder{}
 
 func (mb *mutationBuilder) newOnDeleteSetBuilder(
-	fkInboundOrdinal int, childTable cat.Table, action tree.ReferenceAction, oldValues opt.ColList,
+	fkInboundOrdinal int,
+	childTable cat.Table,
+	action tree.ReferenceAction,
+	oldValues opt.ColList,
+	deleteCol opt.ColumnID,
 ) *onDeleteSetBuilder {
 	return &onDeleteSetBuilder{
 		mutatedTable:     mb.tab,
@@ -503,6 +527,7 @@ func (mb *mutationBuilder) newOnDeleteSetBuilder(
 		childTable:       childTable,
 		action:           action,
 		oldValues:        oldValues,
+		deleteCol:        deleteCol,
 		stmtTreeInitFn:   mb.b.stmtTree.GetInitFnForPostQuery(),
 	}
 }
@@ -537,8 +562,9 @@ func (cb *onDeleteSetBuilder) Build(
 			// for each public table column, making it appropriate to set it as
 			// mb.fetchScope.
 			oldValues := cb.oldValues.RemapColumns(colMap)
+			deleteCol := remapDeleteCol(cb.deleteCol, colMap)
 			mb.fetchScope = b.buildDeleteCascadeMutationInput(
-				cb.childTable, &mb.alias, fk, binding, bindingProps, oldValues,
+				cb.childTable, &mb.alias, fk, binding, bindingProps, oldValues, deleteCol,
 			)
 			mb.outScope = mb.fetchScope
 
@@ -584,7 +610,8 @@ func (cb *onDeleteSetBuilder) Build(
 // a cascading action.
 //
 // The WithScan columns that correspond to the FK columns are specified in
-// oldValues.
+// oldValues. If deleteCol is not 0, only the rows of the WithScan for which it
+// is true are considered.
 //
 // The returned scope has one column for each public table column.
 //
@@ -604,6 +631,10 @@ func (cb *onDeleteSetBuilder) Build(
 //
 // Note that NULL values in the mutation input don't require any special
 // handling - they will be effectively ignored by the semi-join.
+//
+// Under MATCH PARTIAL, the NULL FK columns of a child row match any value, and
+// only the child rows which no longer match any row in the parent table are
+// selected (see restrictToOrphanedPartialMatchRows).
 func (b *Builder) buildDeleteCascadeMutationInput(
 	childTable cat.Table,
 	childTableAlias *tree.TableName,
@@ -611,6 +642,7 @@ func (b *Builder) buildDeleteCascadeMutationInput(
 	binding opt.WithID,
 	bindingProps *props.Relational,
 	oldValues opt.ColList,
+	deleteCol opt.ColumnID,
 ) (outScope *scope) {
 	var indexFlags *tree.IndexFlags
 	if b.evalCtx.SessionData().AvoidFullTableScansInMutations {
@@ -662,20 +694,35 @@ func (b *Builder) buildDeleteCascadeMutationInput(
 	md.AddWithBinding(binding, b.factory.ConstructFakeRel(&memo.FakeRelPrivate{
 		Props: bindingProps,
 	}))
-	mutationInput := b.factory.ConstructWithScan(&memo.WithScanPrivate{
+	inCols, withScanCols := oldValues, outCols
+	var deleteOutCol opt.ColumnID
+	if deleteCol != 0 {
+		deleteOutCol = md.AddColumn("delete", types.Bool)
+		inCols = append(inCols[:len(inCols):len(inCols)], deleteCol)
+		withScanCols = append(withScanCols[:len(withScanCols):len(withScanCols)], deleteOutCol)
+	}
+	var mutationInput memo.RelExpr = b.factory.ConstructWithScan(&memo.WithScanPrivate{
 		With:    binding,
-		InCols:  oldValues,
-		OutCols: outCols,
+		InCols:  inCols,
+		OutCols: withScanCols,
 		ID:      md.NextUniqueID(),
 	})
+	if deleteCol != 0 {
+		mutationInput = b.factory.ConstructSelect(mutationInput, memo.FiltersExpr{
+			b.factory.ConstructFiltersItem(b.factory.ConstructVariable(deleteOutCol)),
+		})
+	}
+
+	if fk.MatchMethod() == tree.MatchPartial {
+		b.restrictToOrphanedPartialMatchRows(outScope, childTable, fk)
+	}
 
 	on := make(memo.FiltersExpr, numFKCols)
 	for i := range on {
 		tabOrd := fk.OriginColumnOrdinal(childTable, i)
 		col := outScope.getColumnForTableOrdinal(tabOrd)
-		on[i] = b.factory.ConstructFiltersItem(b.factory.ConstructEq(
-			b.factory.ConstructVariable(col.id),
-			b.factory.ConstructVariable(outCols[i]),
+		on[i] = b.factory.ConstructFiltersItem(buildFKMatchCondition(
+			b.factory, fk.MatchMethod(), col.id, outCols[i], !childTable.Column(tabOrd).IsNullable(),
 		))
 	}
 	outScope.expr = b.factory.ConstructSemiJoin(
@@ -684,6 +731,15 @@ func (b *Builder) buildDeleteCascadeMutationInput(
 	return outScope
 }
 
+// remapDeleteCol remaps the given delete column of a cascade (see
+// onDeleteCascadeBuilder.deleteCol) to the new memo, if it is set.
+func remapDeleteCol(deleteCol opt.ColumnID, colMap opt.ColMap) opt.ColumnID {
+	if deleteCol == 0 {
+		return 0
+	}
+	return opt.ColList{deleteCol}.RemapColumns(colMap)[0]
+}
+
 // onUpdateCascadeBuilder is a memo.PostQueryBuilder implementation for
 // ON UPDATE CASCADE / SET NULL / SET DEFAULT.
 //
@@ -826,6 +882,18 @@ func (cb *onUpdateCascadeBuilder) Build(
 				switch cb.action {
 				case tree.Cascade:
 					updateExprs[i].Expr = &newValScopeCols[i]
+					if fk.MatchMethod() == tree.MatchPartial {
+						// Under MATCH PARTIAL, NULL FK columns of the child row remain
+						// NULL, since they match any value.
+						tabOrd := fk.OriginColumnOrdinal(cb.childTable, i)
+						updateExprs[i].Expr = &tree.CaseExpr{
+							Whens: []*tree.When{{
+								Cond: &tree.IsNullExpr{Expr: mb.outScope.getColumnForTableOrdinal(tabOrd)},
+								Val:  tree.DNull,
+							}},
+							Else: updateExprs[i].Expr,
+						}
+					}
 				case tree.SetNull:
 					updateExprs[i].Expr = tree.DNull
 				case tree.SetDefault:
@@ -1019,13 +1087,16 @@ func (b *Builder) buildUpdateCascadeMutationInput(
 		memo.FiltersExpr{f.ConstructFiltersItem(condition)},
 	)
 
+	if fk.MatchMethod() == tree.MatchPartial {
+		b.restrictToOrphanedPartialMatchRows(outScope, childTable, fk)
+	}
+
 	on := make(memo.FiltersExpr, numFKCols)
 	for i := range on {
 		tabOrd := fk.OriginColumnOrdinal(childTable, i)
 		col := outScope.getColumnForTableOrdinal(tabOrd)
-		on[i] = f.ConstructFiltersItem(f.ConstructEq(
-			f.ConstructVariable(col.id),
-			f.ConstructVariable(outColsOld[i]),
+		on[i] = f.ConstructFiltersItem(buildFKMatchCondition(
+			f, fk.MatchMethod(), col.id, outColsOld[i], !childTable.Column(tabOrd).IsNullable(),
 		))
 	}
 	// This should conceptually be a semi-join, however we need to retain the "new
@@ -1052,9 +1123,66 @@ func (b *Builder) buildUpdateCascadeMutationInput(
 			typ:  colMeta.Type,
 		})
 	}
+
+	// Under MATCH PARTIAL, a child row can match multiple modified parent rows,
+	// in which case it is not clear which new values should be used.
+	if fk.MatchMethod() == tree.MatchPartial {
+		var pkCols opt.ColSet
+		primaryIndex := childTable.Index(cat.PrimaryIndex)
+		for i := 0; i < primaryIndex.KeyColumnCount(); i++ {
+			tabOrd := primaryIndex.Column(i).Ordinal()
+			pkCols.Add(outScope.getColumnForTableOrdinal(tabOrd).id)
+		}
+		outScope = b.buildDistinctOn(
+			pkCols, outScope, false /* nullsAreDistinct */, ambiguousPartialMatchCascadeErrText,
+		)
+	}
 	return outScope
 }
 
+// ambiguousPartialMatchCascadeErrText is error text used when a child row of a
+// MATCH PARTIAL FK matches more than one parent row modified by a cascading
+// update.
+const ambiguousPartialMatchCascadeErrText = "foreign key cascade cannot update a row that matches " +
+	"more than one modified row under MATCH PARTIAL"
+
+// restrictToOrphanedPartialMatchRows filters the rows of the given scope of
+// the child table of a MATCH PARTIAL FK to the rows which no longer have a
+// match in the parent table. Cascading actions only apply to these rows, since
+// a child row which still matches some parent row remains valid.
+func (b *Builder) restrictToOrphanedPartialMatchRows(
+	childScope *scope, childTable cat.Table, fk cat.ForeignKeyConstraint,
+) {
+	parentTable := resolveTable(b.ctx, b.catalog, fk.ReferencedTableID())
+	numFKCols := fk.ColumnCount()
+	childCols := make(opt.ColList, numFKCols)
+	parentOrds := make([]int, numFKCols)
+	var notNullChildCols opt.ColSet
+	for i := 0; i < numFKCols; i++ {
+		tabOrd := fk.OriginColumnOrdinal(childTable, i)
+		childCols[i] = childScope.getColumnForTableOrdinal(tabOrd).id
+		if !childTable.Column(tabOrd).IsNullable() {
+			notNullChildCols.Add(childCols[i])
+		}
+		parentOrds[i] = fk.ReferencedColumnOrdinal(parentTable, i)
+	}
+
+	// The scan is exempt from RLS to maintain data integrity.
+	parentScope := b.buildScan(
+		b.addTable(parentTable, tree.NewUnqualifiedTableName(parentTable.Name())),
+		parentOrds,
+		nil, /* indexFlags */
+		noRowLocking,
+		b.allocScope(),
+		true, /* disableNotVisibleIndex */
+		cat.PolicyScopeExempt,
+	)
+	childScope.expr = buildOrphanedPartialMatchRows(
+		b.factory, childScope.expr, childCols, notNullChildCols,
+		parentScope.expr, parentScope.colList(),
+	)
+}
+
 // buildTriggerCascadeHelper contains boilerplate for PostQueryBuilder.Build
 // implementations. It creates a Builder, sets up panic-to-error conversion,
 // and executes the given function.
@@ -32,6 +32,7 @@ package optbuilder
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cockroachdb/cockroach/pkg/sql/opt"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
@@ -41,6 +42,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/errors"
+	"github.com/lib/pq/oid"
 )
 
 // groupby information stored in scopes.
@@ -95,6 +97,24 @@ type groupby struct {
 	// It is used to ensure that the builder does not throw a grouping error
 	// prematurely.
 	buildingGroupingCols bool
+
+	// groupingSets is non-nil if the GROUP BY clause contains GROUPING SETS,
+	// ROLLUP or CUBE and expands to more than one grouping set. Each element is
+	// the set of grouping columns (in aggInScope) that make up one grouping
+	// set. For example:
+	//
+	//   SELECT a, b, count(*) FROM t GROUP BY ROLLUP (a, b)
+	//
+	//   groupingSets: (a, b), (a), ()
+	//
+	// When groupingSets is set, the grouping columns in aggOutScope have
+	// different column IDs than the ones in aggInScope, since a grouping column
+	// is NULL for rows produced by the grouping sets that do not contain it.
+	groupingSets []opt.ColSet
+
+	// groupingFns contains information about the GROUPING operations that
+	// reference this scope.
+	groupingFns []*groupingInfo
 }
 
 // groupByStrSet is a set of stringified GROUP BY expressions that map to the
@@ -274,19 +294,94 @@ func (a *aggregateInfo) ResolvedType() *types.T {
 var _ tree.Expr = &aggregateInfo{}
 var _ tree.TypedExpr = &aggregateInfo{}
 
+// groupingInfo stores information about a GROUPING operation.
+type groupingInfo struct {
+	*tree.GroupingExpr
+
+	// args are the typed arguments of the GROUPING operation.
+	args []tree.TypedExpr
+
+	// argCols contains the grouping columns in aggInScope that correspond to
+	// args. It is populated when the grouping columns are built.
+	argCols opt.ColList
+
+	// col is the output column of the GROUPING operation. It is only set if the
+	// query has more than one grouping set; otherwise, all of the arguments are
+	// always part of the grouping and the operation is built as the constant 0.
+	col scopeColumn
+}
+
+// bitmask returns the result of the GROUPING operation for rows produced by
+// the given grouping set. Bit i (counting from the least significant bit) is
+// set if the (n-i)th argument is not part of the grouping set.
+func (gi *groupingInfo) bitmask(groupingSet opt.ColSet) int64 {
+	var res int64
+	for _, col := range gi.argCols {
+		res <<= 1
+		if !groupingSet.Contains(col) {
+			res |= 1
+		}
+	}
+	return res
+}
+
+// Walk is part of the tree.Expr interface.
+func (gi *groupingInfo) Walk(v tree.Visitor) tree.Expr {
+	return gi
+}
+
+// TypeCheck is part of the tree.Expr interface.
+func (gi *groupingInfo) TypeCheck(
+	ctx context.Context, semaCtx *tree.SemaContext, desired *types.T,
+) (tree.TypedExpr, error) {
+	return gi, nil
+}
+
+// Eval is part of the tree.TypedExpr interface.
+func (gi *groupingInfo) Eval(_ context.Context, _ tree.ExprEvaluator) (tree.Datum, error) {
+	panic(errors.AssertionFailedf("groupingInfo must be replaced before evaluation"))
+}
+
+// ResolvedType is part of the tree.TypedExpr interface.
+func (gi *groupingInfo) ResolvedType() *types.T {
+	return types.Int
+}
+
+var _ tree.Expr = &groupingInfo{}
+var _ tree.TypedExpr = &groupingInfo{}
+
 func (b *Builder) needsAggregation(sel *tree.SelectClause, scope *scope) bool {
 	// We have an aggregation if:
 	//  - we have a GROUP BY, or
 	//  - we have a HAVING clause, or
-	//  - we have aggregate functions in the SELECT, DISTINCT ON and/or ORDER BY expressions.
+	//  - we have aggregate functions in the SELECT, DISTINCT ON and/or ORDER BY expressions, or
+	//  - we have GROUPING operations, which are only valid in an aggregation.
 	return len(sel.GroupBy) > 0 ||
 		sel.Having != nil ||
-		(scope.groupby != nil && scope.groupby.hasAggregates())
+		(scope.groupby != nil && scope.groupby.hasAggregates()) ||
+		(scope.groupby != nil && len(scope.groupby.groupingFns) > 0)
 }
 
 func (b *Builder) constructGroupBy(
 	input memo.RelExpr, groupingColSet opt.ColSet, aggCols []scopeColumn, ordering opt.Ordering,
 ) memo.RelExpr {
+	aggs := b.constructAggregations(aggCols)
+	private := memo.GroupingPrivate{GroupingCols: groupingColSet}
+
+	// The ordering of the GROUP BY is inherited from the input. This ordering is
+	// only useful for intra-group ordering (for order-sensitive aggregations like
+	// ARRAY_AGG). So we add the grouping columns as optional columns.
+	private.Ordering.FromOrderingWithOptCols(ordering, groupingColSet)
+
+	if groupingColSet.Empty() {
+		return b.factory.ConstructScalarGroupBy(input, aggs, &private)
+	}
+	return b.factory.ConstructGroupBy(input, aggs, &private)
+}
+
+// constructAggregations constructs the aggregations of the given aggregate
+// columns.
+func (b *Builder) constructAggregations(aggCols []scopeColumn) memo.AggregationsExpr {
 	aggs := make(memo.AggregationsExpr, 0, len(aggCols))
 
 	// Deduplicate the columns; we don't need to produce the same aggregation
@@ -303,18 +398,62 @@ func (b *Builder) constructGroupBy(
 			colSet.Add(id)
 		}
 	}
+	return aggs
+}
 
-	private := memo.GroupingPrivate{GroupingCols: groupingColSet}
+// constructGroupingSets constructs the GroupingSets expression that computes
+// the aggregations for each of the grouping sets in g in a single pass over
+// the input. For example:
+//
+//	SELECT a, b, sum(c) FROM t GROUP BY ROLLUP (a, b)
+//
+// is built as:
+//
+//	grouping-sets
+//	 ├── grouping columns: a b
+//	 ├── grouping output columns: a b
+//	 ├── grouping sets: (a,b) (a) ()
+//	 ├── project (pre-projection)
+//	 └── aggregations
+//	      └── sum
+//
+// The grouping columns of aggOutScope are output columns of the GroupingSets
+// expression, which are NULL in the rows produced for the grouping sets that
+// do not contain the corresponding input grouping column. The GROUPING
+// operations are computed by the GroupingSets expression as well.
+func (b *Builder) constructGroupingSets(
+	g *groupby, aggCols []scopeColumn, ordering opt.Ordering,
+) memo.RelExpr {
+	private := b.makeGroupingSetsPrivate(g)
 
-	// The ordering of the GROUP BY is inherited from the input. This ordering is
-	// only useful for intra-group ordering (for order-sensitive aggregations like
-	// ARRAY_AGG). So we add the grouping columns as optional columns.
-	private.Ordering.FromOrderingWithOptCols(ordering, groupingColSet)
+	// The ordering of the input is only useful for intra-group ordering (for
+	// order-sensitive aggregations like ARRAY_AGG). Unlike for GroupBy, the
+	// grouping columns are not optional in the ordering, since they are not
+	// constant within the groups of the grouping sets that do not contain them.
+	private.Ordering.FromOrdering(ordering)
 
-	if groupingColSet.Empty() {
-		return b.factory.ConstructScalarGroupBy(input, aggs, &private)
+	return b.factory.ConstructGroupingSets(g.aggInScope.expr, b.constructAggregations(aggCols), &private)
+}
+
+// makeGroupingSetsPrivate returns the GroupingSetsPrivate for the grouping
+// sets, grouping columns and GROUPING operations of g.
+func (b *Builder) makeGroupingSetsPrivate(g *groupby) memo.GroupingSetsPrivate {
+	groupingCols := g.groupingCols()
+	groupingOutCols := g.aggOutScope.cols[len(g.aggs) : len(g.aggs)+len(groupingCols)]
+	private := memo.GroupingSetsPrivate{
+		GroupingCols:  make(opt.ColList, len(groupingCols)),
+		OutCols:       make(opt.ColList, len(groupingCols)),
+		Sets:          memo.GroupingSetList(g.groupingSets),
+		GroupingFuncs: make(memo.GroupingFuncs, len(g.groupingFns)),
 	}
-	return b.factory.ConstructGroupBy(input, aggs, &private)
+	for i := range groupingCols {
+		private.GroupingCols[i] = groupingCols[i].id
+		private.OutCols[i] = groupingOutCols[i].id
+	}
+	for i, gi := range g.groupingFns {
+		private.GroupingFuncs[i] = memo.GroupingFunc{Col: gi.col.id, Args: gi.argCols}
+	}
+	return private
 }
 
 // buildGroupingColumns builds the grouping columns and adds them to the
@@ -329,8 +468,43 @@ func (b *Builder) buildGroupingColumns(sel *tree.SelectClause, projectionsScope,
 	// The "from" columns are visible to any grouping expressions.
 	b.buildGroupingList(sel.GroupBy, sel.Exprs, projectionsScope, fromScope)
 
-	// Copy the grouping columns to the aggOutScope.
-	g.aggOutScope.appendColumns(g.groupingCols())
+	// Match the arguments of GROUPING operations to the grouping columns. This
+	// must happen before groupStrs is remapped to the output columns below.
+	for _, gi := range g.groupingFns {
+		gi.argCols = make(opt.ColList, len(gi.args))
+		for i, arg := range gi.args {
+			col, ok := g.groupStrs[symbolicExprStr(arg)]
+			if !ok {
+				panic(pgerror.Newf(pgcode.Grouping,
+					"arguments to GROUPING must be grouping expressions of the associated query level",
+				))
+			}
+			gi.argCols[i] = col.id
+		}
+	}
+
+	if g.groupingSets == nil {
+		// Copy the grouping columns to the aggOutScope.
+		g.aggOutScope.appendColumns(g.groupingCols())
+		return
+	}
+
+	// With grouping sets, a grouping column is NULL in the rows produced for the
+	// grouping sets that do not contain it, so the grouping columns cannot be
+	// passed through the aggregation. Synthesize new output columns for them, and
+	// make any references to GROUP BY expressions resolve to the new columns.
+	groupingCols := g.groupingCols()
+	outCols := make(map[opt.ColumnID]*scopeColumn, len(groupingCols))
+	for i := range groupingCols {
+		col := &groupingCols[i]
+		outCols[col.id] = b.synthesizeColumn(g.aggOutScope, col.name, col.typ, col.expr, nil /* scalar */)
+	}
+	for exprStr, col := range g.groupStrs {
+		g.groupStrs[exprStr] = outCols[col.id]
+	}
+	for _, gi := range g.groupingFns {
+		gi.col = *b.synthesizeColumn(g.aggOutScope, scopeColName("grouping"), types.Int, gi, nil /* scalar */)
+	}
 }
 
 // buildAggregation builds the aggregation operators and constructs the
@@ -380,7 +554,11 @@ func (b *Builder) buildAggregation(having opt.ScalarExpr, fromScope *scope) (out
 
 		// Construct the aggregate function from its name and arguments and store
 		// it in the corresponding scope column.
-		aggCols[i].scalar = b.constructAggregate(agg.def.Name, args)
+		if agg.def.Overload.UserDefinedAggregate != nil {
+			aggCols[i].scalar = b.constructUserDefinedAggregate(&agg, args)
+		} else {
+			aggCols[i].scalar = b.constructAggregate(agg.def.Name, args)
+		}
 
 		// Wrap the aggregate function with an AggDistinct operator if DISTINCT
 		// was specified in the query.
@@ -425,12 +603,16 @@ func (b *Builder) buildAggregation(having opt.ScalarExpr, fromScope *scope) (out
 	// aggregate arguments, as well as any additional order by columns.
 	b.constructProjectForScope(fromScope, g.aggInScope)
 
-	g.aggOutScope.expr = b.constructGroupBy(
-		g.aggInScope.expr,
-		groupingColSet,
-		aggCols,
-		g.aggInScope.ordering,
-	)
+	if g.groupingSets != nil {
+		g.aggOutScope.expr = b.constructGroupingSets(g, aggCols, g.aggInScope.ordering)
+	} else {
+		g.aggOutScope.expr = b.constructGroupBy(
+			g.aggInScope.expr,
+			groupingColSet,
+			aggCols,
+			g.aggInScope.ordering,
+		)
+	}
 
 	// Wrap with having filter if it exists.
 	if having != nil {
@@ -505,15 +687,124 @@ func (b *Builder) buildGroupingList(
 	// used in an aggregate function`. The builder cannot know whether there is
 	// a grouping error until the grouping columns are fully built.
 	g.buildingGroupingCols = true
-	for _, e := range groupBy {
-		b.buildGrouping(e, selects, projectionsScope, fromScope, g.aggInScope)
+	if groupBy.HasGroupingSets() {
+		sets := expandGroupingSets(groupBy)
+		groupingSets := make([]opt.ColSet, len(sets))
+		for i, set := range sets {
+			for _, e := range set {
+				groupingSets[i].UnionWith(b.buildGrouping(e, selects, projectionsScope, fromScope, g.aggInScope))
+			}
+		}
+		// A single grouping set is equivalent to a regular GROUP BY.
+		if len(groupingSets) > 1 {
+			g.groupingSets = groupingSets
+		}
+	} else {
+		for _, e := range groupBy {
+			b.buildGrouping(e, selects, projectionsScope, fromScope, g.aggInScope)
+		}
 	}
 	g.buildingGroupingCols = false
 }
 
+const (
+	// maxGroupingSets is the maximum number of grouping sets that a GROUP BY
+	// clause can expand to.
+	maxGroupingSets = 4096
+
+	// maxCubeElements is the maximum number of elements in a CUBE.
+	maxCubeElements = 12
+)
+
+// expandGroupingSets expands a GROUP BY clause containing GROUPING SETS,
+// ROLLUP or CUBE elements into the grouping sets it represents. Each grouping
+// set is returned as the list of GROUP BY expressions it contains. The items of
+// the GROUP BY clause are combined by taking the cross product of their
+// grouping sets, so that
+//
+//	GROUP BY a, ROLLUP (b, c)
+//
+// expands to the grouping sets (a, b, c), (a, b) and (a).
+func expandGroupingSets(groupBy tree.GroupBy) [][]tree.Expr {
+	sets := [][]tree.Expr{nil}
+	for _, e := range groupBy {
+		itemSets := expandGroupingItem(e)
+		if len(sets)*len(itemSets) > maxGroupingSets {
+			panic(pgerror.Newf(pgcode.ProgramLimitExceeded,
+				"too many grouping sets present (maximum %d)", maxGroupingSets,
+			))
+		}
+		product := make([][]tree.Expr, 0, len(sets)*len(itemSets))
+		for _, set := range sets {
+			for _, itemSet := range itemSets {
+				combined := make([]tree.Expr, 0, len(set)+len(itemSet))
+				combined = append(combined, set...)
+				combined = append(combined, itemSet...)
+				product = append(product, combined)
+			}
+		}
+		sets = product
+	}
+	return sets
+}
+
+// expandGroupingItem returns the grouping sets represented by a single item
+// of a GROUP BY clause (or of a GROUPING SETS element). Tuples are not
+// expanded here; they are flattened into their elements when the grouping
+// columns are built, so the empty tuple represents the empty grouping set.
+func expandGroupingItem(e tree.Expr) [][]tree.Expr {
+	gs, ok := e.(*tree.GroupingSets)
+	if !ok {
+		return [][]tree.Expr{{e}}
+	}
+	switch gs.Type {
+	case tree.GroupingSetsRollup:
+		// ROLLUP (a, b, c) is equivalent to
+		// GROUPING SETS ((a, b, c), (a, b), (a), ()).
+		sets := make([][]tree.Expr, 0, len(gs.Exprs)+1)
+		for i := len(gs.Exprs); i >= 0; i-- {
+			sets = append(sets, gs.Exprs[:i:i])
+		}
+		return sets
+
+	case tree.GroupingSetsCube:
+		// CUBE (a, b) is equivalent to GROUPING SETS ((a, b), (a), (b), ()).
+		if len(gs.Exprs) > maxCubeElements {
+			panic(pgerror.Newf(pgcode.ProgramLimitExceeded,
+				"CUBE is limited to %d elements", maxCubeElements,
+			))
+		}
+		n := len(gs.Exprs)
+		sets := make([][]tree.Expr, 0, 1<<n)
+		for mask := (1 << n) - 1; mask >= 0; mask-- {
+			var set []tree.Expr
+			for i := range gs.Exprs {
+				if mask&(1<<(n-1-i)) != 0 {
+					set = append(set, gs.Exprs[i])
+				}
+			}
+			sets = append(sets, set)
+		}
+		return sets
+
+	default:
+		var sets [][]tree.Expr
+		for _, item := range gs.Exprs {
+			sets = append(sets, expandGroupingItem(item)...)
+			if len(sets) > maxGroupingSets {
+				panic(pgerror.Newf(pgcode.ProgramLimitExceeded,
+					"too many grouping sets present (maximum %d)", maxGroupingSets,
+				))
+			}
+		}
+		return sets
+	}
+}
+
 // buildGrouping builds a set of memo groups that represent a GROUP BY
 // expression. The expression (or expressions, if we have a star) is added to
-// groupStrs and to the aggInScope.
+// groupStrs and to the aggInScope. Returns the set of grouping columns that
+// the expression resolves to.
 //
 // groupBy          The given GROUP BY expression.
 // selects          The select expressions are needed in case the GROUP BY
@@ -533,7 +824,7 @@ func (b *Builder) buildGroupingList(
 //	as the aggregate function arguments.
 func (b *Builder) buildGrouping(
 	groupBy tree.Expr, selects tree.SelectExprs, projectionsScope, fromScope, aggInScope *scope,
-) {
+) (cols opt.ColSet) {
 	// Unwrap parenthesized expressions like "((a))" to "a".
 	groupBy = tree.StripParens(groupBy)
 	alias := ""
@@ -631,7 +922,8 @@ func (b *Builder) buildGrouping(
 		// If a grouping column has already been added, don't add it again.
 		// GROUP BY a, a is semantically equivalent to GROUP BY a.
 		exprStr := symbolicExprStr(e)
-		if _, ok := fromScope.groupby.groupStrs[exprStr]; ok {
+		if existing, ok := fromScope.groupby.groupStrs[exprStr]; ok {
+			cols.Add(existing.id)
 			continue
 		}
 
@@ -641,7 +933,9 @@ func (b *Builder) buildGrouping(
 		col := aggInScope.addColumn(scopeColName(tree.Name(alias)), e)
 		b.buildScalar(e, fromScope, aggInScope, col, nil)
 		fromScope.groupby.groupStrs[exprStr] = col
+		cols.Add(col.id)
 	}
+	return cols
 }
 
 // buildAggArg builds a scalar expression which is used as an input in some form
@@ -901,6 +1195,86 @@ func (b *Builder) constructAggregate(name string, args []opt.ScalarExpr) opt.Sca
 	panic(errors.AssertionFailedf("unhandled aggregate: %s", name))
 }
 
+// constructUserDefinedAggregate constructs a UserDefinedAgg operator for an
+// aggregate created with CREATE AGGREGATE. The component functions of the
+// aggregate are built as scalar expressions over synthesized columns that
+// represent the state and the aggregated arguments.
+func (b *Builder) constructUserDefinedAggregate(
+	agg *aggregateInfo, args []opt.ScalarExpr,
+) opt.ScalarExpr {
+	o := agg.def.Overload
+	uda := o.UserDefinedAggregate
+	if err := b.catalog.CheckExecutionPrivilege(b.ctx, o.Oid, b.checkPrivilegeUser); err != nil {
+		panic(err)
+	}
+	argTypes := make([]*types.T, len(args))
+	for i := range args {
+		argTypes[i] = args[i].DataType()
+	}
+	b.factory.Metadata().AddUserDefinedRoutine(o, argTypes, agg.Func.ReferenceByName)
+
+	// Synthesize the columns referenced by the component functions. They are
+	// laid out as the state, the arguments, and the state of another partial
+	// aggregation.
+	compScope := b.allocScope()
+	b.synthesizeColumn(compScope, scopeColName("state"), uda.StateType, nil /* expr */, nil /* scalar */)
+	for i := range argTypes {
+		name := scopeColName(tree.Name(fmt.Sprintf("arg%d", i+1)))
+		b.synthesizeColumn(compScope, name, argTypes[i], nil /* expr */, nil /* scalar */)
+	}
+	b.synthesizeColumn(compScope, scopeColName("other_state"), uda.StateType, nil /* expr */, nil /* scalar */)
+	stateCol := &compScope.cols[0]
+	otherStateCol := &compScope.cols[len(compScope.cols)-1]
+
+	buildComponent := func(fn oid.Oid, cols ...*scopeColumn) (opt.ScalarExpr, *tree.Overload) {
+		exprs := make(tree.Exprs, len(cols))
+		for i := range cols {
+			exprs[i] = cols[i]
+		}
+		f := &tree.FuncExpr{
+			Func:  tree.ResolvableFunctionReference{FunctionReference: &tree.FunctionOID{OID: fn}},
+			Exprs: exprs,
+		}
+		texpr := compScope.resolveType(f, types.AnyElement)
+		typedFunc, ok := texpr.(*tree.FuncExpr)
+		if !ok {
+			panic(errors.AssertionFailedf("expected component function to be a FuncExpr"))
+		}
+		return b.buildScalar(texpr, compScope, nil /* outScope */, nil /* outCol */, nil /* colRefs */),
+			typedFunc.ResolvedOverload()
+	}
+
+	def := &memo.UDADefinition{
+		Name:          agg.def.Name,
+		Typ:           agg.FuncExpr.ResolvedType(),
+		StateType:     uda.StateType,
+		InitCond:      uda.InitCond,
+		Volatility:    o.Volatility,
+		StateCol:      stateCol.id,
+		ArgCols:       make(opt.ColList, len(argTypes)),
+		OtherStateCol: otherStateCol.id,
+	}
+	transitionCols := make([]*scopeColumn, 0, len(argTypes)+1)
+	transitionCols = append(transitionCols, stateCol)
+	for i := range def.ArgCols {
+		col := &compScope.cols[i+1]
+		def.ArgCols[i] = col.id
+		transitionCols = append(transitionCols, col)
+	}
+	var transitionOverload *tree.Overload
+	def.Transition, transitionOverload = buildComponent(uda.StateFunc, transitionCols...)
+	def.Strict = !transitionOverload.CalledOnNullInput
+	if uda.FinalFunc != 0 {
+		def.Final, _ = buildComponent(uda.FinalFunc, stateCol)
+	}
+	if uda.CombineFunc != 0 {
+		var combineOverload *tree.Overload
+		def.Combine, combineOverload = buildComponent(uda.CombineFunc, stateCol, otherStateCol)
+		def.CombineStrict = !combineOverload.CalledOnNullInput
+	}
+	return b.factory.ConstructUserDefinedAgg(args, &memo.UserDefinedAggPrivate{Def: def})
+}
+
 func isAggregate(def *tree.ResolvedFunctionDefinition) bool {
 	return isClass(def, tree.AggregateClass)
 }
@@ -936,6 +1310,11 @@ func newGroupingError(name tree.Name) error {
 // In the unique index or unique without index cases, all key columns must be
 // marked as NOT NULL to allow the implicit grouping.
 func (b *Builder) allowImplicitGroupingColumn(colID opt.ColumnID, g *groupby) bool {
+	if g.groupingSets != nil {
+		// A column is not functionally dependent on the grouping columns in the
+		// rows produced for grouping sets that do not contain all of them.
+		return false
+	}
 	md := b.factory.Metadata()
 	colMeta := md.ColumnMeta(colID)
 	if colMeta.Table == 0 {
@@ -964,6 +1343,10 @@ func (b *Builder) allowImplicitGroupingColumn(colID opt.ColumnID, g *groupby) bo
 	// Check UNIQUE WITHOUT INDEX constraints.
 	for i := 0; i < tab.UniqueCount(); i++ {
 		uniqueConstraint := tab.Unique(i)
+		if uniqueConstraint.IsExclusion() {
+			// Exclusion constraints do not make their columns unique.
+			continue
+		}
 		var uniqueCols opt.ColSet
 		nullable := false
 		for j := 0; j < uniqueConstraint.ColumnCount(); j++ {
@@ -0,0 +1,334 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package optbuilder
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/opt"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+)
+
+// buildInheritingTableScans adds the rows of the tables that inherit, directly
+// or indirectly, from the given parent table to the scan of the parent in
+// parentScope. As in Postgres, a scan of a parent table includes the rows of
+// its children unless the table name is prefixed with ONLY.
+//
+// The children's columns are matched to the parent's columns by name. Columns
+// that a child does not have (for example, because they were added to the
+// parent after the child started inheriting from it) are NULL for the child's
+// rows. The scans are combined with UNION ALL, and the resulting columns keep
+// the names and visibility of the parent's columns.
+//
+// The sample of a TABLESAMPLE clause and the locking of the parent scan apply
+// to the children as well. Like in Postgres, no privileges are required on the
+// children.
+func (b *Builder) buildInheritingTableScans(
+	parent cat.Table,
+	parentScope *scope,
+	locking lockingSpec,
+	sample *memo.TableSample,
+	inScope *scope,
+) (outScope *scope) {
+	// The children are not tracked as dependencies of views and functions,
+	// since their definitions are rebuilt (and the children re-resolved) every
+	// time they are used.
+	if b.trackSchemaDeps {
+		b.trackSchemaDeps = false
+		defer func() {
+			b.trackSchemaDeps = true
+		}()
+	}
+
+	outScope = parentScope
+	visited := map[cat.StableID]struct{}{parent.ID(): {}}
+	toVisit := inheritingTableIDs(parent, nil /* ids */)
+	for len(toVisit) > 0 {
+		id := toVisit[0]
+		toVisit = toVisit[1:]
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		child := resolveTable(b.ctx, b.catalog, id)
+		if child == nil {
+			// The child table is in the process of being added.
+			continue
+		}
+		b.factory.Metadata().AddDependency(opt.DepByID(id), child, 0 /* priv */)
+		toVisit = inheritingTableIDs(child, toVisit)
+
+		tn := tree.MakeUnqualifiedTableName(child.Name())
+		childMeta := b.addTable(child, &tn)
+		policyCommandScope, childLocking := b.prepForTableScan(locking, childMeta)
+		b.tableSample = sample
+		childScope := b.buildScan(
+			childMeta,
+			tableOrdinals(child, columnKinds{
+				includeMutations: false,
+				includeSystem:    true,
+				includeInverted:  false,
+			}),
+			nil /* indexFlags */, childLocking, inScope,
+			false, /* disableNotVisibleIndex */
+			policyCommandScope,
+		)
+
+		// Line the child's columns up with the parent's columns.
+		rightScope := childScope.push()
+		for i := range outScope.cols {
+			col := &outScope.cols[i]
+			childCol := findInheritedColumn(childScope, col)
+			if childCol == nil {
+				b.synthesizeColumn(rightScope, col.name, col.typ, nil /* expr */, b.factory.ConstructNull(col.typ))
+				continue
+			}
+			if !childCol.typ.Identical(col.typ) {
+				panic(pgerror.Newf(pgcode.DatatypeMismatch,
+					"column %q of inheriting table %q has type %s, but the inherited column has type %s",
+					col.name.ReferenceName(), tree.ErrString(&tn), childCol.typ.SQLString(), col.typ.SQLString(),
+				))
+			}
+			rightScope.cols = append(rightScope.cols, *childCol)
+		}
+		rightScope.expr = b.constructProject(childScope.expr, rightScope.cols)
+
+		unionScope := inScope.push()
+		unionScope.cols = make([]scopeColumn, 0, len(outScope.cols))
+		for i := range outScope.cols {
+			col := &outScope.cols[i]
+			newCol := b.synthesizeColumn(unionScope, col.name, col.typ, nil /* expr */, nil /* scalar */)
+			newCol.table = col.table
+			newCol.visibility = col.visibility
+			newCol.kind = col.kind
+		}
+		private := memo.SetPrivate{
+			LeftCols:  colsToColList(outScope.cols),
+			RightCols: colsToColList(rightScope.cols),
+			OutCols:   colsToColList(unionScope.cols),
+		}
+		unionScope.expr = b.factory.ConstructUnionAll(outScope.expr, rightScope.expr, &private)
+		outScope = unionScope
+	}
+	return outScope
+}
+
+// buildInheritedTableMutation builds an UPDATE or DELETE statement whose target
+// is a table that other tables inherit from. As in Postgres, such a statement
+// modifies the rows of the inheriting tables as well, unless the table name is
+// prefixed with ONLY. It returns ok=false if the statement does not need to be
+// built this way, in which case it is built as usual.
+//
+// The statement is split into one statement for the target table and one for
+// each inheriting table, all prefixed with ONLY. Each of them is built like a
+// mutation in a WITH clause, so they all read the rows that existed before the
+// statement. The inheriting tables are referenced by the alias of the target,
+// so that the SET, WHERE and RETURNING clauses resolve against them. The rows
+// returned by a RETURNING clause are the union of the rows returned for each
+// table. Unlike Postgres, the privileges required by the statement are checked
+// on the inheriting tables as well.
+func (b *Builder) buildInheritedTableMutation(
+	tab cat.Table, stmt tree.Statement, inScope *scope,
+) (outScope *scope, ok bool) {
+	if tab.InheritingTableCount() == 0 {
+		return nil, false
+	}
+	var texpr tree.TableExpr
+	var returning tree.ReturningClause
+	var hasOrderByOrLimit bool
+	switch t := stmt.(type) {
+	case *tree.Delete:
+		texpr, returning = t.Table, t.Returning
+		hasOrderByOrLimit = t.OrderBy != nil || t.Limit != nil
+	case *tree.Update:
+		texpr, returning = t.Table, t.Returning
+		hasOrderByOrLimit = t.OrderBy != nil || t.Limit != nil
+	default:
+		panic(errors.AssertionFailedf("unexpected statement type: %T", stmt))
+	}
+	ate, _ := texpr.(*tree.AliasedTableExpr)
+	if ate != nil && ate.Only {
+		return nil, false
+	}
+	if hasOrderByOrLimit {
+		panic(errors.WithHintf(
+			pgerror.Newf(pgcode.FeatureNotSupported,
+				"%s with ORDER BY or LIMIT of table %q, which other tables inherit from, is not supported",
+				stmt.StatementTag(), tab.Name()),
+			"use %s ONLY to modify only the rows of the table itself", stmt.StatementTag(),
+		))
+	}
+
+	// The target table is modified with ONLY, and its inheriting tables are
+	// referenced by ID with the alias of the target table.
+	alias := tab.Name()
+	parentExpr := &tree.AliasedTableExpr{Expr: texpr, Only: true}
+	if ate != nil {
+		if ate.As.Alias != "" {
+			alias = ate.As.Alias
+		}
+		parentCopy := *ate
+		parentCopy.Only = true
+		parentExpr = &parentCopy
+	}
+	targets := []tree.TableExpr{parentExpr}
+	visited := map[cat.StableID]struct{}{tab.ID(): {}}
+	toVisit := inheritingTableIDs(tab, nil /* ids */)
+	for len(toVisit) > 0 {
+		id := toVisit[0]
+		toVisit = toVisit[1:]
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		child := resolveTable(b.ctx, b.catalog, id)
+		if child == nil {
+			// The child table is in the process of being added.
+			continue
+		}
+		toVisit = inheritingTableIDs(child, toVisit)
+		targets = append(targets, &tree.AliasedTableExpr{
+			Expr: &tree.TableRef{TableID: int64(id), As: tree.AliasClause{Alias: alias}},
+			Only: true,
+		})
+	}
+
+	// Every statement returns the same columns, so that their rows can be
+	// combined. If no rows are returned by the original statement, a constant
+	// is returned for each modified row so that the rows can be counted.
+	returningNeeded := resultsNeeded(returning)
+	var newReturning tree.ReturningClause
+	if returningNeeded {
+		newReturning = expandInheritedReturning(tab, alias, returning.(*tree.ReturningExprs))
+	} else {
+		newReturning = &tree.ReturningExprs{tree.SelectExpr{Expr: tree.NewDInt(1)}}
+	}
+
+	for _, target := range targets {
+		var targetStmt tree.Statement
+		switch t := stmt.(type) {
+		case *tree.Delete:
+			del := *t
+			del.With, del.Table, del.Returning = nil, target, newReturning
+			targetStmt = &del
+		case *tree.Update:
+			upd := *t
+			upd.With, upd.Table, upd.Returning = nil, target, newReturning
+			targetStmt = &upd
+		}
+		targetScope := b.buildStatementScan(
+			targetStmt, b.buildStmt(targetStmt, nil /* desiredTypes */, inScope), inScope,
+		)
+		if outScope == nil {
+			outScope = targetScope
+			continue
+		}
+
+		unionScope := inScope.push()
+		unionScope.cols = make([]scopeColumn, 0, len(outScope.cols))
+		for i := range outScope.cols {
+			col := &outScope.cols[i]
+			if targetCol := &targetScope.cols[i]; !targetCol.typ.Identical(col.typ) {
+				panic(pgerror.Newf(pgcode.DatatypeMismatch,
+					"RETURNING expression %d has type %s for an inheriting table of %q, but type %s for the table itself",
+					i+1, targetCol.typ.SQLString(), tab.Name(), col.typ.SQLString(),
+				))
+			}
+			b.synthesizeColumn(unionScope, col.name, col.typ, nil /* expr */, nil /* scalar */)
+		}
+		private := memo.SetPrivate{
+			LeftCols:  colsToColList(outScope.cols),
+			RightCols: colsToColList(targetScope.cols),
+			OutCols:   colsToColList(unionScope.cols),
+		}
+		unionScope.expr = b.factory.ConstructUnionAll(outScope.expr, targetScope.expr, &private)
+		outScope = unionScope
+	}
+	if returningNeeded {
+		return outScope, true
+	}
+
+	// The statement does not return any rows. If it is the root statement, the
+	// number of modified rows is returned in a single row, from which the
+	// executor reports the number of rows affected by the statement.
+	countScope := inScope.push()
+	if b.stmt == stmt {
+		col := b.synthesizeColumn(countScope, scopeColName("count"), types.Int, nil /* expr */, nil /* scalar */)
+		countScope.expr = b.factory.ConstructScalarGroupBy(
+			outScope.expr,
+			memo.AggregationsExpr{b.factory.ConstructAggregationsItem(b.factory.ConstructCountRows(), col.id)},
+			&memo.GroupingPrivate{},
+		)
+	} else {
+		countScope.expr = b.constructProject(outScope.expr, nil /* cols */)
+	}
+	return countScope, true
+}
+
+// expandInheritedReturning returns a copy of the given RETURNING clause of a
+// mutation of a table that other tables inherit from, in which the stars that
+// refer to the table are expanded to the table's visible columns. This ensures
+// that the clause returns the same columns for the inheriting tables, which may
+// have more columns.
+func expandInheritedReturning(
+	tab cat.Table, alias tree.Name, returning *tree.ReturningExprs,
+) *tree.ReturningExprs {
+	var cols tree.SelectExprs
+	for i, n := 0, tab.ColumnCount(); i < n; i++ {
+		col := tab.Column(i)
+		if col.Kind() == cat.Ordinary && col.Visibility() == cat.Visible {
+			cols = append(cols, tree.SelectExpr{
+				Expr: tree.NewUnresolvedName(string(alias), string(col.ColName())),
+			})
+		}
+	}
+	refersToTable := func(e tree.Expr) bool {
+		switch t := e.(type) {
+		case *tree.UnresolvedName:
+			return t.Star && (t.NumParts == 1 || (t.NumParts == 2 && t.Parts[1] == string(alias)))
+		case tree.UnqualifiedStar:
+			return true
+		case *tree.AllColumnsSelector:
+			return t.TableName.NumParts == 1 && t.TableName.Parts[0] == string(alias)
+		}
+		return false
+	}
+	res := make(tree.ReturningExprs, 0, len(*returning))
+	for _, e := range *returning {
+		if refersToTable(e.Expr) {
+			res = append(res, cols...)
+			continue
+		}
+		res = append(res, e)
+	}
+	return &res
+}
+
+// inheritingTableIDs appends the IDs of the tables that directly inherit from
+// the given table to ids.
+func inheritingTableIDs(tab cat.Table, ids []cat.StableID) []cat.StableID {
+	for i, n := 0, tab.InheritingTableCount(); i < n; i++ {
+		ids = append(ids, tab.InheritingTableID(i))
+	}
+	return ids
+}
+
+// findInheritedColumn returns the column of an inheriting table's scan that
+// corresponds to the given column of the parent table's scan, or nil if there
+// is none.
+func findInheritedColumn(childScope *scope, parentCol *scopeColumn) *scopeColumn {
+	for i := range childScope.cols {
+		col := &childScope.cols[i]
+		if col.name.ReferenceName() == parentCol.name.ReferenceName() && col.kind == parentCol.kind {
+			return col
+		}
+	}
+	return nil
+}
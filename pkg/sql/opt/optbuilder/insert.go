@@ -763,6 +763,9 @@ func (mb *mutationBuilder) buildInsert(
 ) {
 	mb.maybeAddRegionColLookup(opt.InsertOp)
 
+	// Check the constraints of the domain-typed columns being inserted.
+	mb.addDomainConstraintChecks(mb.insertColIDs, nil /* guard */)
+
 	// Disambiguate names so that references in any expressions, such as a
 	// check constraint, refer to the correct columns.
 	mb.disambiguateColumns()
@@ -1004,6 +1007,9 @@ func (mb *mutationBuilder) setUpsertCols(insertCols tree.NameList) {
 func (mb *mutationBuilder) buildUpsert(returning *tree.ReturningExprs) {
 	mb.maybeAddRegionColLookup(opt.UpsertOp)
 
+	// Check the constraints of the domain-typed columns being upserted.
+	mb.addDomainConstraintChecksForUpsert()
+
 	// Merge input insert and update columns using CASE expressions.
 	mb.projectUpsertColumns()
 
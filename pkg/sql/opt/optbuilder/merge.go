@@ -0,0 +1,577 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package optbuilder
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/opt"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/cast"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+)
+
+// duplicateMergeErrText is error text used when a row of the target table is
+// matched by more than one source row of a MERGE statement.
+const duplicateMergeErrText = "MERGE command cannot affect row a second time"
+
+// buildMerge builds a memo group for an UpsertOp expression that implements a
+// MERGE statement. The source rows are left-joined with the existing rows of
+// the target table using the ON condition, and the first WHEN clause that
+// applies to each joined row determines its action. For example:
+//
+//	CREATE TABLE abc (a INT PRIMARY KEY, b INT, c INT)
+//	MERGE INTO abc USING xyz ON a = x
+//	WHEN MATCHED AND z IS NULL THEN DELETE
+//	WHEN MATCHED THEN UPDATE SET b = y
+//	WHEN NOT MATCHED THEN INSERT VALUES (x, y, z)
+//
+// This would create an input expression similar to this SQL:
+//
+//	SELECT
+//	  fetch_a, fetch_b, fetch_c,
+//	  CASE action WHEN 2 THEN x END AS ins_a,
+//	  CASE action WHEN 2 THEN y END AS ins_b,
+//	  CASE action WHEN 2 THEN z END AS ins_c,
+//	  CASE action WHEN 1 THEN y ELSE fetch_b END AS upd_b,
+//	  action = 0 AS del
+//	FROM (
+//	  SELECT DISTINCT ON (fetch_a) *, CASE
+//	    WHEN fetch_a IS NOT NULL AND z IS NULL THEN 0
+//	    WHEN fetch_a IS NOT NULL THEN 1
+//	    WHEN fetch_a IS NULL THEN 2
+//	  END AS action
+//	  FROM xyz
+//	  LEFT JOIN abc AS fetch ON a = x
+//	)
+//	WHERE action IS NOT NULL
+//
+// The Upsert operator inserts the rows for which the canary column (fetch_a)
+// is null, deletes the existing rows for which the delete column is true, and
+// updates the remaining existing rows. The DISTINCT ON raises an error if an
+// existing row is matched by more than one source row. Source rows for which no
+// WHEN clause applies, or for which the applicable clause is DO NOTHING, are
+// filtered out.
+//
+// The RETURNING clause can only reference the columns of the target table. For
+// deleted rows, it returns the values of the deleted row.
+func (b *Builder) buildMerge(merge *tree.Merge, inScope *scope) (outScope *scope) {
+	// Find which table we're working on, check the permissions. Existing values
+	// must be read to evaluate the ON condition.
+	tab, depName, alias, refColumns := b.resolveTableForMutation(merge.Table, privilege.SELECT)
+
+	if tab.IsVirtualTable() {
+		panic(pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+			"cannot merge into view \"%s\"", tab.Name(),
+		))
+	}
+
+	if refColumns != nil {
+		panic(pgerror.Newf(pgcode.Syntax,
+			"cannot specify a list of column IDs with MERGE"))
+	}
+
+	// Check the permissions required by the actions of the WHEN clauses.
+	for _, when := range merge.Whens {
+		switch when.Action {
+		case tree.MergeActionUpdate:
+			b.checkPrivilege(depName, tab, privilege.UPDATE)
+		case tree.MergeActionDelete:
+			b.checkPrivilege(depName, tab, privilege.DELETE)
+		case tree.MergeActionInsert:
+			b.checkPrivilege(depName, tab, privilege.INSERT)
+		}
+	}
+
+	// Check if this table has already been mutated in another subquery.
+	b.checkMultipleMutations(tab, generalMutation)
+
+	var mb mutationBuilder
+	mb.init(b, "merge", tab, alias)
+	mb.isMerge = true
+
+	// Build the outer join of the source rows with the existing rows, and
+	// project the action of each joined row.
+	actionColID := mb.buildInputForMerge(inScope, merge.Table, merge.Source, merge.On, merge.Whens)
+
+	// Build the columns of the inserted rows, followed by the BEFORE INSERT
+	// triggers.
+	mb.addInsertColsForMerge(merge.Whens, actionColID)
+
+	// Build the columns of the updated rows and the column that identifies the
+	// deleted rows, followed by the BEFORE UPDATE and DELETE triggers.
+	mb.addUpdateColsForMerge(merge.Whens, actionColID)
+	mb.addDeleteColForMerge(merge.Whens, actionColID)
+	mb.buildRowLevelBeforeTriggers(tree.TriggerEventUpdate, false /* cascade */)
+	if mb.deleteColID != 0 {
+		mb.buildRowLevelBeforeTriggers(tree.TriggerEventDelete, false /* cascade */)
+	}
+
+	// Build the final merge statement, including any returned expressions.
+	var returningExpr *tree.ReturningExprs
+	if resultsNeeded(merge.Returning) {
+		returningExpr = merge.Returning.(*tree.ReturningExprs)
+	}
+	mb.buildMerge(returningExpr)
+
+	return mb.outScope
+}
+
+// buildInputForMerge constructs the left outer join of the MERGE source with
+// the existing rows of the target table, and projects a column that contains
+// the ordinal of the WHEN clause that applies to each joined row. Rows to
+// which no clause applies, or to which a DO NOTHING clause applies, are
+// filtered out. It returns the ID of the action column.
+func (mb *mutationBuilder) buildInputForMerge(
+	inScope *scope, texpr, source tree.TableExpr, on tree.Expr, whens tree.MergeWhens,
+) (actionColID opt.ColumnID) {
+	var indexFlags *tree.IndexFlags
+	if source, ok := texpr.(*tree.AliasedTableExpr); ok && source.IndexFlags != nil {
+		indexFlags = source.IndexFlags
+	}
+
+	if mb.b.evalCtx.SessionData().AvoidFullTableScansInMutations {
+		if indexFlags == nil {
+			indexFlags = &tree.IndexFlags{}
+		}
+		indexFlags.AvoidFullScan = true
+	}
+
+	sourceScope := mb.b.buildFromTables(tree.TableExprs{source}, noLocking, inScope)
+
+	// NOTE: Include mutation columns, but be careful to never use them for any
+	//       reason other than as "fetch columns". See buildScan comment.
+	mb.fetchScope = mb.b.buildScan(
+		mb.b.addTable(mb.tab, &mb.alias),
+		tableOrdinals(mb.tab, columnKinds{
+			includeMutations: true,
+			includeSystem:    true,
+			includeInverted:  false,
+		}),
+		indexFlags,
+		noRowLocking,
+		inScope,
+		false, /* disableNotVisibleIndex */
+		cat.PolicyScopeExempt,
+	)
+
+	// Set list of columns that will be fetched by the input expression.
+	mb.setFetchColIDs(mb.fetchScope.cols)
+
+	// Check that the same table name is not used multiple times.
+	mb.b.validateJoinTableNames(sourceScope, mb.fetchScope)
+
+	// Both the source columns and the target table columns are visible to the
+	// ON condition and to the WHEN clauses. We create a new scope so that
+	// fetchScope is not modified, as it is used later to build partial index
+	// predicate expressions.
+	mb.outScope = sourceScope.replace()
+	mb.outScope.appendColumnsFromScope(sourceScope)
+	mb.outScope.appendColumnsFromScope(mb.fetchScope)
+
+	scalarProps := &mb.b.semaCtx.Properties
+	defer scalarProps.Restore(*scalarProps)
+
+	// ON
+	mb.b.semaCtx.Properties.Require(
+		exprKindOn.String(),
+		tree.RejectGenerators|tree.RejectWindowApplications|tree.RejectProcedures,
+	)
+	mb.outScope.context = exprKindOn
+	filter := mb.b.buildScalar(
+		mb.outScope.resolveAndRequireType(on, types.Bool), mb.outScope, nil, nil, nil,
+	)
+	mb.outScope.expr = mb.b.factory.ConstructLeftJoin(
+		sourceScope.expr,
+		mb.fetchScope.expr,
+		memo.FiltersExpr{mb.b.factory.ConstructFiltersItem(filter)},
+		memo.EmptyJoinPrivate,
+	)
+
+	// Record a not-null "canary" column. After the left-join, this will be null
+	// if the source row did not match any existing row, or not null otherwise.
+	primaryIndex := mb.tab.Index(cat.PrimaryIndex)
+	mb.canaryColID = mb.fetchColIDs[findNotNullIndexCol(primaryIndex)]
+
+	// Project the action column. Its value is the ordinal of the first WHEN
+	// clause whose MATCHED state and condition hold for the row, or null if
+	// there is no such clause or if that clause is DO NOTHING.
+	mb.b.semaCtx.Properties.Require("MERGE WHEN", tree.RejectSpecial)
+	mb.outScope.context = exprKindNone
+	f := mb.b.factory
+	canaryCol := f.ConstructVariable(mb.canaryColID)
+	actionWhens := make(memo.ScalarListExpr, 0, len(whens))
+	for i, when := range whens {
+		var cond opt.ScalarExpr
+		if when.Matched {
+			cond = f.ConstructIsNot(canaryCol, memo.NullSingleton)
+		} else {
+			cond = f.ConstructIs(canaryCol, memo.NullSingleton)
+		}
+		if when.Cond != nil {
+			whenCond := mb.b.buildScalar(
+				mb.outScope.resolveAndRequireType(when.Cond, types.Bool), mb.outScope, nil, nil, nil,
+			)
+			cond = f.ConstructAnd(cond, whenCond)
+		}
+		var action opt.ScalarExpr
+		if when.Action == tree.MergeActionDoNothing {
+			action = memo.NullSingleton
+		} else {
+			action = f.ConstructConstVal(tree.NewDInt(tree.DInt(i)), types.Int)
+		}
+		actionWhens = append(actionWhens, f.ConstructWhen(cond, action))
+	}
+	actionScalar := f.ConstructCase(memo.TrueSingleton, actionWhens, memo.NullSingleton)
+	actionColID = mb.b.projectColWithMetadataName(mb.outScope, "merge_action", types.Int, actionScalar)
+
+	// Filter out the rows that are not affected by the statement.
+	mb.outScope.expr = f.ConstructSelect(
+		mb.outScope.expr,
+		memo.FiltersExpr{f.ConstructFiltersItem(
+			f.ConstructIsNot(f.ConstructVariable(actionColID), memo.NullSingleton),
+		)},
+	)
+
+	// Build a distinct-on operator on the primary key columns to ensure that
+	// every existing row is matched by at most one source row. The source rows
+	// that do not match any existing row have null primary key columns, and are
+	// all kept.
+	var pkCols opt.ColSet
+	for i := 0; i < primaryIndex.KeyColumnCount(); i++ {
+		pkCols.Add(mb.fetchColIDs[primaryIndex.Column(i).Ordinal()])
+	}
+	mb.outScope = mb.b.buildDistinctOn(
+		pkCols, mb.outScope, true /* nullsAreDistinct */, duplicateMergeErrText)
+
+	return actionColID
+}
+
+// addInsertColsForMerge projects the columns of the rows that are inserted by
+// the INSERT actions of a MERGE statement. Each non-computed column is set to
+// the value of the INSERT action that applies to the row, or to its default
+// value if that action does not target the column. The values are null for the
+// rows to which no INSERT action applies.
+func (mb *mutationBuilder) addInsertColsForMerge(whens tree.MergeWhens, actionColID opt.ColumnID) {
+	// VALUES expressions should reject aggregates, generators, etc.
+	scalarProps := &mb.b.semaCtx.Properties
+	defer scalarProps.Restore(*scalarProps)
+	mb.b.semaCtx.Properties.Require("MERGE INSERT", tree.RejectSpecial)
+
+	f := mb.b.factory
+	colWhens := make([]memo.ScalarListExpr, mb.tab.ColumnCount())
+	explicitCols := make(opt.OptionalColList, mb.tab.ColumnCount())
+	hasInsert := false
+	for i, when := range whens {
+		if when.Action != tree.MergeActionInsert {
+			continue
+		}
+		hasInsert = true
+
+		// Determine the target columns of the action.
+		mb.targetColList = mb.targetColList[:0]
+		mb.targetColSet = opt.ColSet{}
+		if len(when.Columns) != 0 {
+			mb.addTargetColsByName(when.Columns)
+			if when.Values != nil {
+				mb.checkNumCols(len(mb.targetColList), len(when.Values))
+			}
+		} else if when.Values != nil {
+			mb.addTargetTableColsForInsert(len(when.Values))
+		}
+		mb.checkPrimaryKeyForInsert()
+		mb.checkForeignKeysForInsert()
+
+		values := make([]tree.Expr, mb.tab.ColumnCount())
+		for j, colID := range mb.targetColList {
+			ord := mb.tabID.ColumnOrdinal(colID)
+			var expr tree.Expr = tree.DefaultVal{}
+			if when.Values != nil {
+				expr = when.Values[j]
+			}
+			if _, ok := expr.(tree.DefaultVal); ok {
+				continue
+			}
+
+			// GENERATED ALWAYS AS IDENTITY columns are not allowed to be
+			// explicitly written to.
+			if col := mb.tab.Column(ord); col.IsGeneratedAlwaysAsIdentity() {
+				panic(sqlerrors.NewGeneratedAlwaysAsIdentityColumnOverrideError(string(col.ColName())))
+			}
+			values[ord] = expr
+			explicitCols[ord] = colID
+		}
+
+		// Every non-computed column gets a value from each INSERT action, so that
+		// default values are only evaluated for the inserted rows.
+		action := f.ConstructConstVal(tree.NewDInt(tree.DInt(i)), types.Int)
+		for ord := range colWhens {
+			col := mb.tab.Column(ord)
+			if col.Kind() != cat.Ordinary || col.IsComputed() {
+				continue
+			}
+			expr := values[ord]
+			if expr == nil {
+				expr = mb.parseDefaultExpr(mb.tabID.ColumnID(ord))
+			}
+			colWhens[ord] = append(colWhens[ord], f.ConstructWhen(action, mb.buildMergeValue(expr, ord)))
+		}
+	}
+
+	// Project the insert columns.
+	projectionsScope := mb.outScope.replace()
+	projectionsScope.appendColumnsFromScope(mb.outScope)
+	mb.targetColList = mb.targetColList[:0]
+	mb.targetColSet = opt.ColSet{}
+	for ord := range colWhens {
+		col := mb.tab.Column(ord)
+		if col.Kind() != cat.Ordinary || col.IsComputed() {
+			continue
+		}
+		var scalar opt.ScalarExpr = f.ConstructNull(col.DatumType())
+		if len(colWhens[ord]) != 0 {
+			scalar = f.ConstructCase(f.ConstructVariable(actionColID), colWhens[ord], scalar)
+		}
+		colName := scopeColName("").WithMetadataName(string(col.ColName()) + "_ins")
+		scopeCol := mb.b.synthesizeColumn(projectionsScope, colName, col.DatumType(), nil /* expr */, scalar)
+		mb.insertColIDs[ord] = scopeCol.id
+
+		tabColID := mb.tabID.ColumnID(ord)
+		mb.targetColList = append(mb.targetColList, tabColID)
+		mb.targetColSet.Add(tabColID)
+	}
+	mb.b.constructProjectForScope(mb.outScope, projectionsScope)
+	mb.outScope = projectionsScope
+
+	// Track whether the value for the region column is explicitly specified.
+	// This is a no-op if the table isn't regional-by-row.
+	mb.setRegionColExplicitlyMutated(explicitCols)
+
+	// Computed columns of the inserted rows must be computed from the insert
+	// columns rather than from the fetched columns, which are null for these
+	// rows. Hide the fetch columns while the remaining insert columns and the
+	// BEFORE INSERT triggers are built.
+	fetchColIDs := mb.fetchColIDs
+	mb.fetchColIDs = make(opt.OptionalColList, len(fetchColIDs))
+	mb.addSynthesizedColsForInsert()
+	if hasInsert {
+		mb.buildRowLevelBeforeTriggers(tree.TriggerEventInsert, false /* cascade */)
+	}
+	mb.fetchColIDs = fetchColIDs
+}
+
+// addUpdateColsForMerge projects the columns of the rows that are updated by
+// the UPDATE actions of a MERGE statement. Each column targeted by at least one
+// UPDATE action is set to the value of the action that applies to the row, or
+// to its existing value otherwise.
+func (mb *mutationBuilder) addUpdateColsForMerge(whens tree.MergeWhens, actionColID opt.ColumnID) {
+	// SET expressions should reject aggregates, generators, etc.
+	scalarProps := &mb.b.semaCtx.Properties
+	defer scalarProps.Restore(*scalarProps)
+	mb.b.semaCtx.Properties.Require("UPDATE SET", tree.RejectSpecial)
+
+	f := mb.b.factory
+	colWhens := make([]memo.ScalarListExpr, mb.tab.ColumnCount())
+	hasUpdate := false
+	for i, when := range whens {
+		if when.Action != tree.MergeActionUpdate {
+			continue
+		}
+		hasUpdate = true
+
+		// Determine the target columns of the action, which also verifies that
+		// no column is targeted more than once.
+		mb.targetColList = mb.targetColList[:0]
+		mb.targetColSet = opt.ColSet{}
+		action := f.ConstructConstVal(tree.NewDInt(tree.DInt(i)), types.Int)
+		addCol := func(expr tree.Expr, ord int) {
+			targetCol := mb.tab.Column(ord)
+
+			// Allow right side of SET to be DEFAULT.
+			if _, ok := expr.(tree.DefaultVal); ok {
+				expr = mb.parseDefaultExpr(mb.tabID.ColumnID(ord))
+			} else if targetCol.IsGeneratedAlwaysAsIdentity() {
+				// GENERATED ALWAYS AS IDENTITY columns are not allowed to be
+				// explicitly written to.
+				panic(sqlerrors.NewGeneratedAlwaysAsIdentityColumnUpdateError(string(targetCol.ColName())))
+			}
+			colWhens[ord] = append(colWhens[ord], f.ConstructWhen(action, mb.buildMergeValue(expr, ord)))
+		}
+
+		n := 0
+		for _, set := range when.Exprs {
+			mb.addTargetColsByName(set.Names)
+			if !set.Tuple {
+				addCol(set.Expr, mb.tabID.ColumnOrdinal(mb.targetColList[n]))
+				n++
+				continue
+			}
+			switch t := set.Expr.(type) {
+			case *tree.Subquery:
+				// Each column is assigned the corresponding element of the row
+				// returned by the subquery. Unlike UPDATE, the subquery is not joined
+				// with the input, but is part of the value of each column, like the
+				// other values of the clause.
+				sub := mb.outScope.replaceSubquery(
+					t, false /* wrapInTuple */, len(set.Names), noExtraColsAllowed,
+				)
+				// Use the data types of the target columns to resolve expressions
+				// with ambiguous types, as for UPDATE.
+				desiredTypes := make([]*types.T, len(set.Names))
+				targetIdx := len(mb.targetColList) - len(set.Names)
+				for i := range desiredTypes {
+					desiredTypes[i] = mb.md.ColumnMeta(mb.targetColList[targetIdx+i]).Type
+				}
+				desired := desiredTypes[0]
+				if len(desiredTypes) > 1 {
+					desired = types.MakeTuple(desiredTypes)
+				}
+				texpr := mb.outScope.resolveType(sub, desired)
+				for i := range set.Names {
+					elem := texpr
+					if len(set.Names) > 1 {
+						elem = tree.NewTypedColumnAccessExpr(texpr, "" /* colName */, i)
+					}
+					addCol(elem, mb.tabID.ColumnOrdinal(mb.targetColList[n]))
+					n++
+				}
+			case *tree.Tuple:
+				mb.checkNumCols(len(set.Names), len(t.Exprs))
+				for _, expr := range t.Exprs {
+					addCol(expr, mb.tabID.ColumnOrdinal(mb.targetColList[n]))
+					n++
+				}
+			default:
+				panic(unimplementedWithIssueDetailf(35713, fmt.Sprintf("%T", set.Expr),
+					"source for a multiple-column UPDATE item must be a sub-SELECT or ROW() expression; not supported: %T", set.Expr))
+			}
+		}
+	}
+
+	mb.targetColList = mb.targetColList[:0]
+	mb.targetColSet = opt.ColSet{}
+	if !hasUpdate {
+		return
+	}
+
+	// Project the update columns.
+	projectionsScope := mb.outScope.replace()
+	projectionsScope.appendColumnsFromScope(mb.outScope)
+	for ord := range colWhens {
+		if len(colWhens[ord]) == 0 {
+			continue
+		}
+		col := mb.tab.Column(ord)
+		scalar := f.ConstructCase(
+			f.ConstructVariable(actionColID), colWhens[ord], f.ConstructVariable(mb.fetchColIDs[ord]),
+		)
+		colName := scopeColName("").WithMetadataName(string(col.ColName()) + "_new")
+		scopeCol := mb.b.synthesizeColumn(projectionsScope, colName, col.DatumType(), nil /* expr */, scalar)
+		mb.updateColIDs[ord] = scopeCol.id
+
+		tabColID := mb.tabID.ColumnID(ord)
+		mb.targetColList = append(mb.targetColList, tabColID)
+		mb.targetColSet.Add(tabColID)
+	}
+	mb.b.constructProjectForScope(mb.outScope, projectionsScope)
+	mb.outScope = projectionsScope
+
+	// Track whether the region column is being explicitly updated. This is a
+	// no-op if the table isn't regional-by-row.
+	mb.setRegionColExplicitlyMutated(mb.updateColIDs)
+
+	// Add additional columns for computed expressions that may depend on the
+	// updated columns.
+	mb.addSynthesizedColsForUpdate()
+}
+
+// addDeleteColForMerge projects the boolean column that is true for the rows
+// that are deleted by the DELETE actions of a MERGE statement. It is a no-op
+// if there are no DELETE actions.
+func (mb *mutationBuilder) addDeleteColForMerge(whens tree.MergeWhens, actionColID opt.ColumnID) {
+	f := mb.b.factory
+	var deleteWhens memo.ScalarListExpr
+	for i, when := range whens {
+		if when.Action == tree.MergeActionDelete {
+			action := f.ConstructConstVal(tree.NewDInt(tree.DInt(i)), types.Int)
+			deleteWhens = append(deleteWhens, f.ConstructWhen(action, memo.TrueSingleton))
+		}
+	}
+	if len(deleteWhens) == 0 {
+		return
+	}
+	scalar := f.ConstructCase(f.ConstructVariable(actionColID), deleteWhens, memo.FalseSingleton)
+	mb.deleteColID = mb.b.projectColWithMetadataName(mb.outScope, "merge_delete", types.Bool, scalar)
+}
+
+// buildMergeValue builds the scalar expression for the given value of an
+// INSERT or UPDATE action of a MERGE statement, which is assigned to the table
+// column with the given ordinal. An assignment cast is added if the type of the
+// value is not identical to the type of the column, and the value is checked
+// against the constraints of the column's type if it is a domain.
+func (mb *mutationBuilder) buildMergeValue(expr tree.Expr, ord int) opt.ScalarExpr {
+	targetCol := mb.tab.Column(ord)
+	targetType := targetCol.DatumType()
+	texpr := mb.outScope.resolveType(expr, targetType)
+	scalar := mb.b.buildScalar(texpr, mb.outScope, nil /* outScope */, nil /* outCol */, nil /* colRefs */)
+
+	srcType := texpr.ResolvedType()
+	if !srcType.Identical(targetType) {
+		if !cast.ValidCast(srcType, targetType, cast.ContextAssignment) {
+			panic(sqlerrors.NewInvalidAssignmentCastError(srcType, targetType, string(targetCol.ColName())))
+		}
+		scalar = mb.b.factory.ConstructAssignmentCast(scalar, targetType)
+	}
+	return mb.b.buildDomainCheck(scalar, targetType)
+}
+
+// buildMerge constructs an Upsert operator for a MERGE statement, possibly
+// wrapped by a Project operator that corresponds to the given RETURNING clause.
+func (mb *mutationBuilder) buildMerge(returning *tree.ReturningExprs) {
+	mb.maybeAddRegionColLookup(opt.UpsertOp)
+
+	// Merge input insert and update columns using CASE expressions.
+	mb.projectUpsertColumns()
+
+	// Disambiguate names so that references in any expressions, such as a
+	// check constraint, refer to the correct columns.
+	mb.disambiguateColumns()
+
+	// Add any check constraint boolean columns to the input.
+	mb.addCheckConstraintCols(false, /* isUpdate */
+		cat.PolicyScopeUpsert, false /* includeSelectPolicies */)
+
+	// Add the partial index predicate expressions to the table metadata.
+	// These expressions are used to prune fetch columns during
+	// normalization.
+	mb.b.addPartialIndexPredicatesForTable(mb.md.TableMeta(mb.tabID), nil /* scan */)
+
+	// Project partial index PUT and DEL boolean columns.
+	mb.projectPartialIndexPutAndDelCols()
+
+	// Project vector index PUT and DEL columns.
+	mb.projectVectorIndexColsForUpsert()
+
+	mb.buildUniqueChecksForUpsert()
+
+	mb.buildFKChecksForMerge()
+
+	mb.buildRowLevelAfterTriggers(opt.InsertOp)
+
+	private := mb.makeMutationPrivate(returning != nil, false /* vectorInsert */)
+	mb.outScope.expr = mb.b.factory.ConstructUpsert(
+		mb.outScope.expr, mb.uniqueChecks, mb.fkChecks, private,
+	)
+
+	returningInScope, returningOutScope := mb.buildReturningScopes(returning, nil /* colRefs */)
+	mb.buildReturning(returning, returningInScope, returningOutScope)
+}
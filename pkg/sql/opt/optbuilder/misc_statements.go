@@ -213,6 +213,10 @@ func (b *Builder) buildWhereForStatistics(
 ) (indexOrd cat.IndexOrdinal, _ *constraint.Constraint) {
 	tabMeta := b.factory.Metadata().TableMeta(tabID)
 	tab := tabMeta.Table
+	if tab.IsForeignTable() {
+		panic(pgerror.Newf(pgcode.WrongObjectType,
+			"cannot create statistics on foreign tables"))
+	}
 	if tab.IsVirtualTable() {
 		panic(pgerror.Newf(pgcode.WrongObjectType,
 			"cannot create statistics on virtual tables"))
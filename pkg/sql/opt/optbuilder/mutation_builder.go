@@ -170,6 +170,16 @@ type mutationBuilder struct {
 	// an insert; otherwise it's an update.
 	canaryColID opt.ColumnID
 
+	// deleteColID is the ID of the boolean column that is true for the existing
+	// rows that a MERGE statement deletes rather than updates. It is only set
+	// when building a MERGE statement with DELETE actions.
+	deleteColID opt.ColumnID
+
+	// isMerge is true if the mutation is built for a MERGE statement. In that
+	// case, rows are only inserted if the canary column is null, so BEFORE
+	// INSERT triggers do not fire for the existing rows.
+	isMerge bool
+
 	// arbiters is the set of indexes and unique constraints that are used to
 	// detect conflicts for UPSERT and INSERT ON CONFLICT statements.
 	arbiters arbiterSet
@@ -1278,6 +1288,25 @@ func (mb *mutationBuilder) buildRLSCheckExpr(
 				),
 			),
 		)
+		if mb.deleteColID != 0 {
+			// A MERGE statement with DELETE actions also removes existing rows. The
+			// deleted rows are checked against the SELECT and DELETE policies on
+			// the existing row (fetchScope), rather than the policies above:
+			//   (isDelete AND all DELETE-related policies)
+			//   OR
+			//   (NOT isDelete AND the UPSERT expression above)
+			isDelete := mb.b.factory.ConstructVariable(mb.deleteColID)
+			scalar = mb.b.factory.ConstructOr(
+				mb.b.factory.ConstructAnd(
+					isDelete,
+					mb.b.factory.ConstructAnd(
+						mb.genPolicyUsingExpr(tabMeta, cat.PolicyScopeSelect, mb.fetchScope, referencedCols),
+						mb.genPolicyUsingExpr(tabMeta, cat.PolicyScopeDelete, mb.fetchScope, referencedCols),
+					),
+				),
+				mb.b.factory.ConstructAnd(mb.b.factory.ConstructNot(isDelete), scalar),
+			)
+		}
 	default:
 		panic(errors.AssertionFailedf("unsupported policy command scope for check expr: %v", cmdScope))
 	}
@@ -1778,6 +1807,7 @@ func (mb *mutationBuilder) makeMutationPrivate(
 		FetchCols:                      checkEmptyList(mb.fetchColIDs),
 		UpdateCols:                     checkEmptyList(mb.updateColIDs),
 		CanaryCol:                      mb.canaryColID,
+		DeleteCol:                      mb.deleteColID,
 		ArbiterIndexes:                 mb.arbiters.IndexOrdinals(),
 		ArbiterConstraints:             mb.arbiters.UniqueConstraintOrdinals(),
 		CheckCols:                      checkEmptyList(mb.checkColIDs),
@@ -1951,6 +1981,14 @@ func (mb *mutationBuilder) parseDefaultExpr(colID opt.ColumnID) tree.Expr {
 	col := mb.tab.Column(ord)
 	exprStr := col.DefaultExprStr()
 
+	// If the column has no default expression, use the default of its type if
+	// it is a domain.
+	if domain := col.DatumType().TypeMeta.DomainData; exprStr == "" && domain != nil {
+		if domain.DefaultExpr != nil {
+			exprStr = *domain.DefaultExpr
+		}
+	}
+
 	// If no default expression, return NULL or a default value.
 	if exprStr == "" {
 		if col.IsMutation() && !col.IsNullable() {
@@ -2217,6 +2255,9 @@ type checkInputScanType uint8
 const (
 	checkInputScanNewVals checkInputScanType = iota
 	checkInputScanFetchedVals
+	// checkInputScanRemainingVals scans the new values of the rows that are not
+	// deleted by a MERGE statement. It is only valid for FK checks.
+	checkInputScanRemainingVals
 )
 
 // buildCheckInputScan constructs an expression that produces the new values of
@@ -2246,7 +2287,7 @@ func (mb *mutationBuilder) buildCheckInputScan(
 	outScope.cols = make([]scopeColumn, len(inputCols))
 
 	for i, tabOrd := range tabOrdinals {
-		if typ == checkInputScanNewVals {
+		if typ == checkInputScanNewVals || typ == checkInputScanRemainingVals {
 			inputCols[i] = mb.mapToReturnColID(tabOrd)
 		} else {
 			inputCols[i] = mb.fetchColIDs[tabOrd]
@@ -2315,6 +2356,25 @@ func (mb *mutationBuilder) buildCheckInputScan(
 	}
 
 	mb.ensureWithID()
+	if typ == checkInputScanRemainingVals {
+		// Also scan the delete column, and use it to filter out the deleted rows.
+		f := mb.b.factory
+		deleteCol := mb.md.AddColumn("delete", types.Bool)
+		withScan := f.ConstructWithScan(&memo.WithScanPrivate{
+			With:       mb.withID,
+			InCols:     append(inputCols, mb.deleteColID),
+			OutCols:    append(outScope.colList(), deleteCol),
+			ID:         f.Metadata().NextUniqueID(),
+			CheckInput: true,
+		})
+		notDeleted := f.ConstructNot(f.ConstructVariable(deleteCol))
+		outScope.expr = f.ConstructProject(
+			f.ConstructSelect(withScan, memo.FiltersExpr{f.ConstructFiltersItem(notDeleted)}),
+			memo.EmptyProjectionsExpr,
+			outScope.colSet(),
+		)
+		return outScope, notNullOutCols
+	}
 	outScope.expr = mb.b.factory.ConstructWithScan(&memo.WithScanPrivate{
 		With:       mb.withID,
 		InCols:     inputCols,
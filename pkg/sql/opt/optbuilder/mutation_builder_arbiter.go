@@ -18,6 +18,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/cockroach/pkg/util/intsets"
 	"github.com/cockroachdb/errors"
 )
@@ -77,6 +78,10 @@ func (mb *mutationBuilder) findArbiters(onConflict *tree.OnConflict) arbiterSet
 				if _, partial := constraint.Predicate(); partial {
 					panic(partialIndexArbiterError(onConflict, mb.tab.Name()))
 				}
+				if constraint.IsExclusion() {
+					panic(unimplemented.NewWithIssue(46657,
+						"ON CONFLICT is not supported with exclusion constraints"))
+				}
 				return makeSingleUniqueConstraintArbiterSet(mb, i)
 			}
 		}
@@ -161,7 +166,9 @@ func (mb *mutationBuilder) inferArbitersFromConflictOrds(
 			}
 		}
 		for uc, ucCount := 0, mb.tab.UniqueCount(); uc < ucCount; uc++ {
-			if mb.tab.Unique(uc).WithoutIndex() {
+			// Conflicts with exclusion constraints cannot be detected with the
+			// equality joins used for arbiters, so they are always checked.
+			if u := mb.tab.Unique(uc); u.WithoutIndex() && !u.IsExclusion() {
 				arbiters.AddUniqueConstraint(uc)
 			}
 		}
@@ -219,6 +226,10 @@ func (mb *mutationBuilder) inferArbitersFromConflictOrds(
 			// Unique constraints with an index were handled above.
 			continue
 		}
+		if uniqueConstraint.IsExclusion() {
+			// Exclusion constraints cannot be arbiters.
+			continue
+		}
 
 		// Determine whether the conflict columns match the columns in the
 		// unique constraint. If not, the constraint cannot be an arbiter. We
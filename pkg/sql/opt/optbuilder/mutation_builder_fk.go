@@ -13,6 +13,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/opt"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/norm"
 	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
@@ -152,12 +153,12 @@ func (mb *mutationBuilder) buildFKChecksAndCascadesForDelete() {
 				builder, ok = mb.tryNewOnDeleteFastCascadeBuilder(h.fk, i, h.otherTab)
 				if !ok {
 					mb.ensureWithID()
-					builder = mb.newOnDeleteCascadeBuilder(i, h.otherTab, cols)
+					builder = mb.newOnDeleteCascadeBuilder(i, h.otherTab, cols, 0 /* deleteCol */)
 				}
 				triggerEventType = tree.TriggerEventDelete
 			case tree.SetNull, tree.SetDefault:
 				mb.ensureWithID()
-				builder = mb.newOnDeleteSetBuilder(i, h.otherTab, a, cols)
+				builder = mb.newOnDeleteSetBuilder(i, h.otherTab, a, cols, 0 /* deleteCol */)
 				triggerEventType = tree.TriggerEventUpdate
 			default:
 				panic(errors.AssertionFailedf("unhandled action type %s", a))
@@ -414,32 +415,7 @@ func (mb *mutationBuilder) buildFKChecksForUpsert() {
 		}
 
 		if a := h.fk.UpdateReferenceAction(); a != tree.Restrict && a != tree.NoAction {
-			telemetry.Inc(sqltelemetry.ForeignKeyCascadesUseCounter)
-			mb.ensureWithID()
-			oldCols := make(opt.ColList, len(h.tabOrdinals))
-			newCols := make(opt.ColList, len(h.tabOrdinals))
-			for j, tabOrd := range h.tabOrdinals {
-				fetchColID := mb.fetchColIDs[tabOrd]
-				// Here we don't need to use the upsertColIDs because the rows that
-				// correspond to inserts will be ignored in the cascade.
-				updateColID := mb.updateColIDs[tabOrd]
-				if updateColID == 0 {
-					updateColID = fetchColID
-				}
-
-				oldCols[j] = fetchColID
-				newCols[j] = updateColID
-			}
-			hasBeforeTriggers := cat.HasRowLevelTriggers(
-				h.otherTab, tree.TriggerActionTimeBefore, tree.TriggerEventUpdate,
-			)
-			builder := mb.newOnUpdateCascadeBuilder(i, h.otherTab, a, oldCols, newCols)
-			mb.cascades = append(mb.cascades, memo.FKCascade{
-				FKConstraint:      h.fk,
-				HasBeforeTriggers: hasBeforeTriggers,
-				Builder:           builder,
-				WithID:            mb.withID,
-			})
+			mb.buildUpdateCascadeForUpsert(i, a)
 			continue
 		}
 
@@ -473,6 +449,153 @@ func (mb *mutationBuilder) buildFKChecksForUpsert() {
 	telemetry.Inc(sqltelemetry.ForeignKeyChecksUseCounter)
 }
 
+// buildUpdateCascadeForUpsert adds the cascade for the given update action of
+// the inbound FK with the given ordinal, for the rows updated by an upsert. It
+// assumes that mb.fkCheckHelper has been initialized with the FK.
+func (mb *mutationBuilder) buildUpdateCascadeForUpsert(
+	fkOrdinal int, action tree.ReferenceAction,
+) {
+	h := &mb.fkCheckHelper
+	telemetry.Inc(sqltelemetry.ForeignKeyCascadesUseCounter)
+	mb.ensureWithID()
+	oldCols := make(opt.ColList, len(h.tabOrdinals))
+	newCols := make(opt.ColList, len(h.tabOrdinals))
+	for j, tabOrd := range h.tabOrdinals {
+		fetchColID := mb.fetchColIDs[tabOrd]
+		// Here we don't need to use the upsertColIDs because the rows that
+		// correspond to inserts will be ignored in the cascade. The rows deleted
+		// by a MERGE statement keep their fetched values, so they are ignored as
+		// well.
+		updateColID := mb.updateColIDs[tabOrd]
+		if updateColID == 0 {
+			updateColID = fetchColID
+		}
+
+		oldCols[j] = fetchColID
+		newCols[j] = updateColID
+	}
+	hasBeforeTriggers := cat.HasRowLevelTriggers(
+		h.otherTab, tree.TriggerActionTimeBefore, tree.TriggerEventUpdate,
+	)
+	builder := mb.newOnUpdateCascadeBuilder(fkOrdinal, h.otherTab, action, oldCols, newCols)
+	mb.cascades = append(mb.cascades, memo.FKCascade{
+		FKConstraint:      h.fk,
+		HasBeforeTriggers: hasBeforeTriggers,
+		Builder:           builder,
+		WithID:            mb.withID,
+	})
+}
+
+// buildFKChecksForMerge builds FK check queries and cascades for a MERGE
+// statement. A MERGE statement without DELETE actions is checked like an
+// upsert. Otherwise, the rows deleted by the DELETE actions are handled like
+// the rows of a DELETE statement: the delete action of an inbound FK either
+// cascades to the child rows that reference them, or requires that there are
+// none.
+func (mb *mutationBuilder) buildFKChecksForMerge() {
+	if mb.deleteColID == 0 {
+		mb.buildFKChecksForUpsert()
+		return
+	}
+
+	numOutbound := mb.tab.OutboundForeignKeyCount()
+	numInbound := mb.tab.InboundForeignKeyCount()
+
+	if numOutbound == 0 && numInbound == 0 {
+		return
+	}
+
+	h := &mb.fkCheckHelper
+	for i := 0; i < numOutbound; i++ {
+		if h.initWithOutboundFK(mb, i) {
+			mb.fkChecks = append(mb.fkChecks, h.buildInsertionCheck())
+		}
+	}
+
+	for i := 0; i < numInbound; i++ {
+		if !h.initWithInboundFK(mb, i) {
+			continue
+		}
+
+		deleteCascades := false
+		if a := h.fk.DeleteReferenceAction(); a != tree.Restrict && a != tree.NoAction {
+			deleteCascades = true
+			mb.buildDeleteCascadeForMerge(i, a)
+		}
+		updated := mb.inboundFKColsUpdated(i)
+		updateCascades := false
+		if updated {
+			if a := h.fk.UpdateReferenceAction(); a != tree.Restrict && a != tree.NoAction {
+				updateCascades = true
+				mb.buildUpdateCascadeForUpsert(i, a)
+			}
+		}
+		if deleteCascades && (!updated || updateCascades) {
+			// The child rows of all the removed values are modified by cascades.
+			continue
+		}
+
+		// The rows that no longer exist are the ones that were deleted or updated
+		// _from_, minus the ones that were updated _to_. As for upserts, the
+		// fetched values of the inserted rows are all null and never match in the
+		// semi join. The checks run after the cascades, so the values whose child
+		// rows were modified by a cascade do not fail the check.
+		oldRowsScope, _ := mb.buildCheckInputScan(checkInputScanFetchedVals, h.tabOrdinals, true /* isFK */)
+		newRowsScope, _ := mb.buildCheckInputScan(checkInputScanRemainingVals, h.tabOrdinals, true /* isFK */)
+		colsForOldRow := oldRowsScope.colList()
+		colsForNewRow := newRowsScope.colList()
+		deletedRows := mb.b.factory.ConstructExcept(
+			oldRowsScope.expr,
+			newRowsScope.expr,
+			&memo.SetPrivate{
+				LeftCols:  colsForOldRow,
+				RightCols: colsForNewRow,
+				OutCols:   colsForOldRow,
+			},
+		)
+		mb.fkChecks = append(mb.fkChecks, h.buildDeletionCheck(deletedRows, colsForOldRow))
+	}
+	telemetry.Inc(sqltelemetry.ForeignKeyChecksUseCounter)
+}
+
+// buildDeleteCascadeForMerge adds the cascade for the given delete action of
+// the inbound FK with the given ordinal, for the rows deleted by a MERGE
+// statement. It assumes that mb.fkCheckHelper has been initialized with the FK.
+func (mb *mutationBuilder) buildDeleteCascadeForMerge(
+	fkOrdinal int, action tree.ReferenceAction,
+) {
+	h := &mb.fkCheckHelper
+	telemetry.Inc(sqltelemetry.ForeignKeyCascadesUseCounter)
+	mb.ensureWithID()
+	cols := make(opt.ColList, len(h.tabOrdinals))
+	for i, tabOrd := range h.tabOrdinals {
+		cols[i] = mb.fetchColIDs[tabOrd]
+	}
+	// The fast cascade builder is not used, since it deletes the child rows of
+	// all the rows of the input of the mutation, rather than only those of the
+	// deleted rows.
+	var builder memo.PostQueryBuilder
+	var triggerEventType tree.TriggerEventType
+	switch action {
+	case tree.Cascade:
+		builder = mb.newOnDeleteCascadeBuilder(fkOrdinal, h.otherTab, cols, mb.deleteColID)
+		triggerEventType = tree.TriggerEventDelete
+	case tree.SetNull, tree.SetDefault:
+		builder = mb.newOnDeleteSetBuilder(fkOrdinal, h.otherTab, action, cols, mb.deleteColID)
+		triggerEventType = tree.TriggerEventUpdate
+	default:
+		panic(errors.AssertionFailedf("unhandled action type %s", action))
+	}
+	mb.cascades = append(mb.cascades, memo.FKCascade{
+		FKConstraint: h.fk,
+		HasBeforeTriggers: cat.HasRowLevelTriggers(
+			h.otherTab, tree.TriggerActionTimeBefore, triggerEventType,
+		),
+		Builder: builder,
+		WithID:  mb.withID,
+	})
+}
+
 // outboundFKColsUpdated returns true if any of the FK columns for an outbound
 // constraint are being updated (according to updateColIDs).
 func (mb *mutationBuilder) outboundFKColsUpdated(fkOrdinal int) bool {
@@ -636,6 +759,15 @@ func resolveTable(ctx context.Context, catalog cat.Catalog, id cat.StableID) cat
 // the FK child table (the table containing the FK reference) and this scan is
 // part of a deletion-side check.
 func (h *fkCheckHelper) buildOtherTableScan(parent bool) (outScope *scope, tabMeta *opt.TableMeta) {
+	return h.buildTableScan(h.otherTab, h.otherTabOrdinals, parent)
+}
+
+// buildTableScan builds a Scan of the given columns of the given table, which
+// is either the FK parent table (if parent is true) or the FK child table. See
+// buildOtherTableScan for more details.
+func (h *fkCheckHelper) buildTableScan(
+	tab cat.Table, ordinals []int, parent bool,
+) (outScope *scope, tabMeta *opt.TableMeta) {
 	locking := noRowLocking
 	// For insertion-side checks, if enable_implicit_fk_locking_for_serializable
 	// is true or we're using a weaker isolation level, we lock the parent row(s)
@@ -653,27 +785,27 @@ func (h *fkCheckHelper) buildOtherTableScan(parent bool) (outScope *scope, tabMe
 				item: &tree.LockingItem{
 					// TODO(michae2): Change this to ForKeyShare when it is supported.
 					Strength:   tree.ForShare,
-					Targets:    []tree.TableName{tree.MakeUnqualifiedTableName(h.otherTab.Name())},
+					Targets:    []tree.TableName{tree.MakeUnqualifiedTableName(tab.Name())},
 					WaitPolicy: tree.LockWaitBlock,
 				},
 			},
 		}
 	}
-	otherTabMeta := h.mb.b.addTable(h.otherTab, tree.NewUnqualifiedTableName(h.otherTab.Name()))
+	tabMeta = h.mb.b.addTable(tab, tree.NewUnqualifiedTableName(tab.Name()))
 	indexFlags := &tree.IndexFlags{IgnoreForeignKeys: true}
 	if h.mb.b.evalCtx.SessionData().AvoidFullTableScansInMutations {
 		indexFlags.AvoidFullScan = true
 	}
 
 	return h.mb.b.buildScan(
-		otherTabMeta,
-		h.otherTabOrdinals,
+		tabMeta,
+		ordinals,
 		indexFlags,
 		locking,
 		h.mb.b.allocScope(),
 		true, /* disableNotVisibleIndex */
 		cat.PolicyScopeExempt,
-	), otherTabMeta
+	), tabMeta
 }
 
 func (h *fkCheckHelper) allocOrdinals(numCols int) {
@@ -700,6 +832,10 @@ func (h *fkCheckHelper) buildInsertionCheck() memo.FKChecksItem {
 		//  - MATCH FULL: only the case where *all* the columns are NULL is
 		//                allowed, and the row doesn't need to have a match in the
 		//                referenced table.
+		//  - MATCH PARTIAL: allows any column(s) to be NULL; if all the columns
+		//                   are NULL, the row doesn't need to have a match in
+		//                   the referenced table. Otherwise, the non-NULL columns
+		//                   must match a row in the referenced table.
 		//
 		// Note that rows that have NULLs will never have a match in the anti
 		// join and will generate errors. To handle these cases, we filter the
@@ -709,7 +845,9 @@ func (h *fkCheckHelper) buildInsertionCheck() memo.FKChecksItem {
 		// For SIMPLE, we filter out any rows which have a NULL. For FULL, we
 		// filter out any rows where all the columns are NULL (rows which have
 		// NULLs a subset of columns are let through and will generate FK errors
-		// because they will never have a match in the anti join).
+		// because they will never have a match in the anti join). For PARTIAL,
+		// we also filter out any rows where all the columns are NULL; the NULL
+		// columns of the remaining rows match any value in the anti join.
 		switch m := h.fk.MatchMethod(); m {
 		case tree.MatchSimple:
 			// Filter out any rows which have a NULL; build filters of the form
@@ -727,7 +865,7 @@ func (h *fkCheckHelper) buildInsertionCheck() memo.FKChecksItem {
 			}
 			withScanScope.expr = f.ConstructSelect(withScanScope.expr, filters)
 
-		case tree.MatchFull:
+		case tree.MatchFull, tree.MatchPartial:
 			// Filter out any rows which have NULLs on all referencing columns.
 			if !notNullWithScanCols.Empty() {
 				// We statically know that some of the referencing columns can't be
@@ -765,14 +903,15 @@ func (h *fkCheckHelper) buildInsertionCheck() memo.FKChecksItem {
 
 	// Build the join filters:
 	//   (origin_a = referenced_a) AND (origin_b = referenced_b) AND ...
+	//
+	// See buildFKMatchCondition for MATCH PARTIAL.
 	antiJoinFilters := make(memo.FiltersExpr, numCols)
 	for j := 0; j < numCols; j++ {
-		antiJoinFilters[j] = f.ConstructFiltersItem(
-			f.ConstructEq(
-				f.ConstructVariable(withScanScope.cols[j].id),
-				f.ConstructVariable(scanScope.cols[j].id),
-			),
-		)
+		originCol := withScanScope.cols[j].id
+		antiJoinFilters[j] = f.ConstructFiltersItem(buildFKMatchCondition(
+			f, h.fk.MatchMethod(), originCol, scanScope.cols[j].id,
+			notNullWithScanCols.Contains(originCol),
+		))
 	}
 	var p memo.JoinPrivate
 	if h.mb.b.evalCtx.SessionData().PreferLookupJoinsForFKs {
@@ -803,24 +942,29 @@ func (h *fkCheckHelper) buildDeletionCheck(
 
 	// Note that it's impossible to orphan a row whose FK key columns contain a
 	// NULL, since by definition a NULL never refers to an actual row (in
-	// either MATCH FULL or MATCH SIMPLE).
+	// either MATCH FULL or MATCH SIMPLE). Under MATCH PARTIAL, a row with some
+	// NULL columns refers to any row that matches its non-NULL columns; see
+	// orphanedPartialMatchRows.
 	// Build the join filters:
 	//   (origin_a = referenced_a) AND (origin_b = referenced_b) AND ...
 	f := h.mb.b.factory
+	notNullOrigCols := h.notNullOtherTabCols(scanScope)
+	childRows := scanScope.expr
+	if h.fk.MatchMethod() == tree.MatchPartial && notNullOrigCols.Len() < len(deleteCols) {
+		childRows = h.orphanedPartialMatchRows(scanScope, notNullOrigCols)
+	}
 	semiJoinFilters := make(memo.FiltersExpr, len(deleteCols))
 	for j := range deleteCols {
-		semiJoinFilters[j] = f.ConstructFiltersItem(
-			f.ConstructEq(
-				f.ConstructVariable(deleteCols[j]),
-				f.ConstructVariable(scanScope.cols[j].id),
-			),
-		)
+		origCol := scanScope.cols[j].id
+		semiJoinFilters[j] = f.ConstructFiltersItem(buildFKMatchCondition(
+			f, h.fk.MatchMethod(), origCol, deleteCols[j], notNullOrigCols.Contains(origCol),
+		))
 	}
 	var p memo.JoinPrivate
 	if h.mb.b.evalCtx.SessionData().PreferLookupJoinsForFKs {
 		p.Flags = memo.PreferLookupJoinIntoRight
 	}
-	semiJoin := f.ConstructSemiJoin(deletedRows, scanScope.expr, semiJoinFilters, &p)
+	semiJoin := f.ConstructSemiJoin(deletedRows, childRows, semiJoinFilters, &p)
 
 	return f.ConstructFKChecksItem(semiJoin, &memo.FKChecksItemPrivate{
 		OriginTable:     origTabMeta.MetaID,
@@ -831,3 +975,100 @@ func (h *fkCheckHelper) buildDeletionCheck(
 		OpName:          h.mb.opName,
 	})
 }
+
+// notNullOtherTabCols returns the FK columns of the "other" table in the given
+// scan scope that are not nullable.
+func (h *fkCheckHelper) notNullOtherTabCols(scanScope *scope) opt.ColSet {
+	var notNullCols opt.ColSet
+	for j, ord := range h.otherTabOrdinals {
+		if !h.otherTab.Column(ord).IsNullable() {
+			notNullCols.Add(scanScope.cols[j].id)
+		}
+	}
+	return notNullCols
+}
+
+// orphanedPartialMatchRows is used by deletion-side checks of MATCH PARTIAL
+// FKs. It filters the given scan of the FK child table to the rows which no
+// longer have a match in the FK parent table (the table being mutated). See
+// buildOrphanedPartialMatchRows.
+func (h *fkCheckHelper) orphanedPartialMatchRows(
+	scanScope *scope, notNullOrigCols opt.ColSet,
+) memo.RelExpr {
+	parentScope, _ := h.buildTableScan(h.mb.tab, h.tabOrdinals, true /* parent */)
+	return buildOrphanedPartialMatchRows(
+		h.mb.b.factory, scanScope.expr, scanScope.colList(), notNullOrigCols,
+		parentScope.expr, parentScope.colList(),
+	)
+}
+
+// buildOrphanedPartialMatchRows filters the given rows of the child table of a
+// MATCH PARTIAL FK to the rows which refer to a parent row but don't have a
+// match in the given rows of the parent table. A child row with NULL values in
+// some of its FK columns can match multiple parent rows, so removing or
+// modifying one of them does not affect the child row as long as another one
+// remains:
+//
+//	SELECT * FROM child
+//	WHERE (child.a IS NOT NULL OR child.b IS NOT NULL)
+//	AND NOT EXISTS (
+//	  SELECT * FROM parent
+//	  WHERE (child.a IS NULL OR child.a = parent.a)
+//	  AND (child.b IS NULL OR child.b = parent.b)
+//	)
+//
+// childCols and parentCols are the FK columns of the child and parent rows,
+// respectively.
+func buildOrphanedPartialMatchRows(
+	f *norm.Factory,
+	childRows memo.RelExpr,
+	childCols opt.ColList,
+	notNullChildCols opt.ColSet,
+	parentRows memo.RelExpr,
+	parentCols opt.ColList,
+) memo.RelExpr {
+	// Child rows with NULLs on all FK columns don't refer to any parent row.
+	var condition opt.ScalarExpr
+	for _, col := range childCols {
+		is := f.ConstructIsNot(f.ConstructVariable(col), memo.NullSingleton)
+		if condition == nil {
+			condition = is
+		} else {
+			condition = f.ConstructOr(condition, is)
+		}
+	}
+	childRows = f.ConstructSelect(childRows, memo.FiltersExpr{f.ConstructFiltersItem(condition)})
+
+	antiJoinFilters := make(memo.FiltersExpr, len(childCols))
+	for j := range childCols {
+		antiJoinFilters[j] = f.ConstructFiltersItem(buildFKMatchCondition(
+			f, tree.MatchPartial, childCols[j], parentCols[j], notNullChildCols.Contains(childCols[j]),
+		))
+	}
+	return f.ConstructAntiJoin(childRows, parentRows, antiJoinFilters, memo.EmptyJoinPrivate)
+}
+
+// buildFKMatchCondition builds the condition under which the given FK origin
+// column matches the given referenced column, which is an equality:
+//
+//	origin_a = referenced_a
+//
+// Under MATCH PARTIAL, a NULL origin column matches any referenced value, so
+// unless the origin column is known to be not NULL the condition is:
+//
+//	(origin_a IS NULL) OR (origin_a = referenced_a)
+func buildFKMatchCondition(
+	f *norm.Factory,
+	match tree.CompositeKeyMatchMethod,
+	originCol, referencedCol opt.ColumnID,
+	originNotNull bool,
+) opt.ScalarExpr {
+	eq := f.ConstructEq(f.ConstructVariable(originCol), f.ConstructVariable(referencedCol))
+	if match != tree.MatchPartial || originNotNull {
+		return eq
+	}
+	return f.ConstructOr(
+		f.ConstructIs(f.ConstructVariable(originCol), memo.NullSingleton),
+		eq,
+	)
+}
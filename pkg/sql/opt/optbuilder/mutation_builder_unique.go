@@ -13,6 +13,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqltelemetry"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
@@ -285,6 +286,21 @@ func (h *uniqueCheckHelper) init(mb *mutationBuilder, uniqueOrdinal int) bool {
 	// exists a non-partial unique constraint with columns that are a subset of
 	// the partial unique constraint columns.
 	primaryOrds := getIndexLaxKeyOrdinals(mb.tab.Index(cat.PrimaryIndex))
+	if h.unique.IsExclusion() {
+		// Rows can conflict according to an exclusion constraint even if their
+		// values for the constraint columns are different, so all the primary
+		// key columns are needed to prevent rows from matching themselves.
+		h.uniqueOrdinals = uniqueOrds
+		h.primaryKeyOrdinals = primaryOrds
+		for tabOrd, ok := uniqueOrds.Next(0); ok; tabOrd, ok = uniqueOrds.Next(tabOrd + 1) {
+			// Rows with NULL values never conflict.
+			if memo.OutputColumnIsAlwaysNull(mb.outScope.expr, mb.mapToReturnColID(tabOrd)) {
+				return false
+			}
+		}
+		h.scanScope, h.scanOrdinals = h.buildTableScan()
+		return true
+	}
 	primaryOrds.DifferenceWith(uniqueOrds)
 	if primaryOrds.Empty() {
 		// The primary key columns are a subset of the unique columns; unique check
@@ -495,13 +511,43 @@ func (h *uniqueCheckHelper) buildInsertionCheck(
 		numFilters += 2
 	}
 	semiJoinFilters := make(memo.FiltersExpr, 0, numFilters)
-	for i, ok := h.uniqueOrdinals.Next(0); ok; i, ok = h.uniqueOrdinals.Next(i + 1) {
-		semiJoinFilters = append(semiJoinFilters, f.ConstructFiltersItem(
-			f.ConstructEq(
-				f.ConstructVariable(uniqueCheckScope.cols[i].id),
-				f.ConstructVariable(h.scanScope.cols[i].id),
-			),
-		))
+	if h.unique.IsExclusion() {
+		// An exclusion constraint compares each column with its own operator:
+		//   (new_a = existing_a) AND (new_b && existing_b) AND ...
+		//
+		// Overlaps filters can be used to plan an inverted join against the
+		// inverted index that backs the constraint, with the equality columns as
+		// its prefix columns. There is no fast path for these checks.
+		buildFastPathCheck = false
+		for i, n := 0, h.unique.ColumnCount(); i < n; i++ {
+			ord := h.unique.ColumnOrdinal(h.mb.tab, i)
+			left := f.ConstructVariable(uniqueCheckScope.cols[ord].id)
+			right := f.ConstructVariable(h.scanScope.cols[ord].id)
+			var cmp opt.ScalarExpr
+			switch h.unique.ExclusionOperator(i) {
+			case treecmp.Overlaps:
+				switch h.mb.tab.Column(ord).DatumType().Family() {
+				case types.GeometryFamily, types.Box2DFamily:
+					// The && operator means "intersects" for geometries and bounding
+					// boxes, like in constructComparison.
+					cmp = f.ConstructBBoxIntersects(left, right)
+				default:
+					cmp = f.ConstructOverlaps(left, right)
+				}
+			default:
+				cmp = f.ConstructEq(left, right)
+			}
+			semiJoinFilters = append(semiJoinFilters, f.ConstructFiltersItem(cmp))
+		}
+	} else {
+		for i, ok := h.uniqueOrdinals.Next(0); ok; i, ok = h.uniqueOrdinals.Next(i + 1) {
+			semiJoinFilters = append(semiJoinFilters, f.ConstructFiltersItem(
+				f.ConstructEq(
+					f.ConstructVariable(uniqueCheckScope.cols[i].id),
+					f.ConstructVariable(h.scanScope.cols[i].id),
+				),
+			))
+		}
 	}
 	// Find the ScanExpr which reads from the table this unique check applies to.
 	var uniqueFastPathCheck memo.RelExpr
@@ -576,8 +622,17 @@ func (h *uniqueCheckHelper) buildInsertionCheck(
 	// Collect the key columns that will be shown in the error message if there
 	// is a duplicate key violation resulting from this uniqueness check.
 	keyCols := make(opt.ColList, 0, h.uniqueOrdinals.Len())
-	for i, ok := h.uniqueOrdinals.Next(0); ok; i, ok = h.uniqueOrdinals.Next(i + 1) {
-		keyCols = append(keyCols, uniqueCheckScope.cols[i].id)
+	if h.unique.IsExclusion() {
+		// The key columns of an exclusion constraint are reported in the order of
+		// the constraint columns.
+		for i, n := 0, h.unique.ColumnCount(); i < n; i++ {
+			ord := h.unique.ColumnOrdinal(h.mb.tab, i)
+			keyCols = append(keyCols, uniqueCheckScope.cols[ord].id)
+		}
+	} else {
+		for i, ok := h.uniqueOrdinals.Next(0); ok; i, ok = h.uniqueOrdinals.Next(i + 1) {
+			keyCols = append(keyCols, uniqueCheckScope.cols[i].id)
+		}
 	}
 
 	// Create a Project that passes-through only the key columns. This allows
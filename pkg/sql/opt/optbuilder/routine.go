@@ -337,6 +337,7 @@ func (b *Builder) buildRoutine(
 				}
 				args[i] = b.factory.ConstructCast(args[i], desiredTyp)
 			}
+			args[i] = b.buildDomainCheck(args[i], desiredTyp)
 			argColName := funcParamColName(tree.Name(paramTypes[i].Name), i)
 			col := b.synthesizeColumn(bodyScope, argColName, desiredTyp, nil /* expr */, nil /* scalar */)
 			col.setParamOrd(i)
@@ -732,6 +733,7 @@ func (b *Builder) maybeAddRoutineAssignmentCasts(
 			}
 			scalar = b.factory.ConstructAssignmentCast(scalar, desiredTypes[i])
 		}
+		scalar = b.buildDomainCheck(scalar, desiredTypes[i])
 		b.synthesizeColumn(outScope, scopeColName(""), desiredTypes[i], nil /* expr */, scalar)
 	}
 	b.constructProjectForScope(stmtScope, outScope)
@@ -117,6 +117,20 @@ func (b *Builder) buildScalar(
 	case *windowInfo:
 		return b.finishBuildScalarRef(t.col, inScope, outScope, outCol, colRefs)
 
+	case *groupingInfo:
+		if inScope.inAgg {
+			panic(pgerror.Newf(pgcode.Grouping,
+				"aggregate function calls cannot contain grouping operations",
+			))
+		}
+		if t.col.id == 0 {
+			// Without grouping sets, every argument is part of the grouping of
+			// every output row.
+			out = b.factory.ConstructConstVal(tree.NewDInt(0), types.Int)
+			break
+		}
+		return b.finishBuildScalarRef(&t.col, inScope.groupby.aggOutScope, outScope, outCol, colRefs)
+
 	case *tree.AndExpr:
 		left := b.buildScalar(reType(t.TypedLeft(), types.Bool), inScope, nil, nil, colRefs)
 		right := b.buildScalar(reType(t.TypedRight(), types.Bool), inScope, nil, nil, colRefs)
@@ -279,7 +293,7 @@ func (b *Builder) buildScalar(
 	case *tree.CastExpr:
 		texpr := t.Expr.(tree.TypedExpr)
 		arg := b.buildScalar(texpr, inScope, nil, nil, colRefs)
-		out = b.factory.ConstructCast(arg, t.ResolvedType())
+		out = b.buildDomainCheck(b.factory.ConstructCast(arg, t.ResolvedType()), t.ResolvedType())
 
 	case *tree.CoalesceExpr:
 		args := make(memo.ScalarListExpr, len(t.Exprs))
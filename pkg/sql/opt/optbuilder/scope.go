@@ -26,6 +26,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treewindow"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/errors"
 	"github.com/cockroachdb/redact"
 )
@@ -143,7 +144,9 @@ const (
 	exprKindOrderByUpdate
 	exprKindReturning
 	exprKindSelect
+	exprKindRepeatable
 	exprKindStoreID
+	exprKindTableSample
 	exprKindValues
 	exprKindWhere
 	exprKindWindowFrameStart
@@ -167,7 +170,9 @@ var exprKindName = [...]string{
 	exprKindOrderByUpdate:     "ORDER BY in UPDATE",
 	exprKindReturning:         "RETURNING",
 	exprKindSelect:            "SELECT",
+	exprKindRepeatable:        "REPEATABLE",
 	exprKindStoreID:           "RELOCATE STORE ID",
+	exprKindTableSample:       "TABLESAMPLE",
 	exprKindValues:            "VALUES",
 	exprKindWhere:             "WHERE",
 	exprKindWindowFrameStart:  "WINDOW FRAME START",
@@ -1123,6 +1128,9 @@ func (s *scope) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
 			break
 		}
 
+	case *tree.GroupingExpr:
+		expr = s.replaceGrouping(t)
+
 	case *tree.ArrayFlatten:
 		if sub, ok := t.Subquery.(*tree.Subquery); ok {
 			// Copy the ArrayFlatten expression so that the tree isn't mutated.
@@ -1147,7 +1155,7 @@ func (s *scope) VisitPre(expr tree.Expr) (recurse bool, newExpr tree.Expr) {
 		}
 
 	case *tree.Subquery:
-		if t.Exists {
+		if t.Exists || t.Unique {
 			expr = s.replaceSubquery(
 				t, true /* wrapInTuple */, -1 /* desiredNumColumns */, noExtraColsAllowed,
 			)
@@ -1321,6 +1329,9 @@ func (s *scope) replaceAggregate(f *tree.FuncExpr, def *tree.ResolvedFunctionDef
 	}
 
 	f = typedFunc.(*tree.FuncExpr)
+	if f.ResolvedOverload().UserDefinedAggregate != nil && f.OrderBy != nil {
+		panic(unimplemented.NewWithIssue(74775, "ordered user-defined aggregates are not supported"))
+	}
 
 	private := memo.FunctionPrivate{
 		Name:       def.Name,
@@ -1331,6 +1342,52 @@ func (s *scope) replaceAggregate(f *tree.FuncExpr, def *tree.ResolvedFunctionDef
 	return s.builder.buildAggregateFunction(f, &private, tempScope, s)
 }
 
+// maxGroupingArgs is the maximum number of arguments of a GROUPING
+// operation, so that the result fits in a 32-bit bitmask.
+const maxGroupingArgs = 31
+
+// replaceGrouping returns a groupingInfo that can be used to replace a
+// GROUPING operation. When a groupingInfo is encountered during the build
+// process, it is replaced with a reference to the column that computes the
+// operation (if the query has grouping sets) or with the constant 0.
+//
+// replaceGrouping also stores the groupingInfo in the groupby information of
+// this scope, so that the arguments can be matched with the grouping columns
+// once they are built.
+func (s *scope) replaceGrouping(t *tree.GroupingExpr) *groupingInfo {
+	switch s.context {
+	case exprKindSelect, exprKindHaving, exprKindOrderBy, exprKindDistinctOn:
+	default:
+		panic(pgerror.Newf(pgcode.Grouping,
+			"grouping operations are not allowed in %s", s.context,
+		))
+	}
+	if len(t.Exprs) > maxGroupingArgs {
+		panic(pgerror.Newf(pgcode.TooManyArguments,
+			"GROUPING must have fewer than %d arguments", maxGroupingArgs+1,
+		))
+	}
+
+	// We need to save and restore the previous value of the field in semaCtx
+	// in case we are recursively called within a subquery context.
+	defer s.builder.semaCtx.Properties.Restore(s.builder.semaCtx.Properties)
+	s.builder.semaCtx.Properties.Require("GROUPING", tree.RejectSpecial)
+
+	info := &groupingInfo{
+		GroupingExpr: t,
+		args:         make([]tree.TypedExpr, len(t.Exprs)),
+	}
+	for i, e := range t.Exprs {
+		info.args[i] = s.resolveType(tree.StripParens(e), types.AnyElement)
+	}
+
+	if s.groupby == nil {
+		s.initGrouping()
+	}
+	s.groupby.groupingFns = append(s.groupby.groupingFns, info)
+	return info
+}
+
 func (s *scope) lookupWindowDef(name tree.Name) *tree.WindowDef {
 	for i := range s.windowDefs {
 		if s.windowDefs[i].Name == name {
@@ -1393,6 +1450,9 @@ func (s *scope) replaceWindowFn(f *tree.FuncExpr, def *tree.ResolvedFunctionDefi
 	}
 
 	f = typedFunc.(*tree.FuncExpr)
+	if f.ResolvedOverload().UserDefinedAggregate != nil {
+		panic(unimplemented.NewWithIssue(74775, "user-defined aggregates as window functions are not supported"))
+	}
 
 	// We will be performing type checking on expressions from PARTITION BY and
 	// ORDER BY clauses below, and we need the semantic context to know that we
@@ -86,6 +86,10 @@ func (b *Builder) buildDataSource(
 			lockCtx.withoutTargets()
 		}
 
+		if source.TableSample != nil {
+			b.tableSample = b.buildTableSample(source.Expr, source.TableSample)
+		}
+		b.scanOnly = source.Only
 		outScope = b.buildDataSource(source.Expr, indexFlags, lockCtx, inScope)
 
 		if source.Ordinality {
@@ -102,9 +106,14 @@ func (b *Builder) buildDataSource(
 
 	case *tree.TableName:
 		tn := source
+		only := b.scanOnly
+		b.scanOnly = false
 
 		// CTEs take precedence over other data sources.
 		if cte := inScope.resolveCTE(tn); cte != nil {
+			if b.tableSample != nil {
+				panic(errTableSampleNotTable)
+			}
 			lockCtx.locking.ignoreLockingForCTE()
 			outScope = inScope.push()
 			inCols := make(opt.ColList, len(cte.cols), len(cte.cols)+len(inScope.ordering))
@@ -156,7 +165,8 @@ func (b *Builder) buildDataSource(
 		case cat.Table:
 			tabMeta := b.addTable(t, &resName)
 			policyCommandScope, locking := b.prepForTableScan(lockCtx.locking, tabMeta)
-			return b.buildScan(
+			sample := b.tableSample
+			outScope = b.buildScan(
 				tabMeta,
 				tableOrdinals(t, columnKinds{
 					includeMutations: false,
@@ -167,11 +177,21 @@ func (b *Builder) buildDataSource(
 				false, /* disableNotVisibleIndex */
 				policyCommandScope,
 			)
+			if !only && t.InheritingTableCount() > 0 {
+				outScope = b.buildInheritingTableScans(t, outScope, lockCtx.locking, sample, inScope)
+			}
+			return outScope
 
 		case cat.Sequence:
+			if b.tableSample != nil {
+				panic(errTableSampleNotTable)
+			}
 			return b.buildSequenceSelect(t, &resName, inScope)
 
 		case cat.View:
+			if b.tableSample != nil {
+				panic(errTableSampleNotTable)
+			}
 			return b.buildView(t, &resName, lockCtx, inScope)
 
 		default:
@@ -212,45 +232,8 @@ func (b *Builder) buildDataSource(
 				"statement source \"%v\" does not return any columns", source.Statement))
 		}
 
-		id := b.factory.Memo().NextWithID()
-		b.factory.Metadata().AddWithBinding(id, innerScope.expr)
-		cte := &cteSource{
-			name:         tree.AliasClause{},
-			cols:         innerScope.makePresentationWithHiddenCols(),
-			originalExpr: source.Statement,
-			expr:         innerScope.expr,
-			id:           id,
-		}
-		b.addCTE(cte)
-
-		inCols := make(opt.ColList, len(cte.cols))
-		outCols := make(opt.ColList, len(cte.cols))
-		for i, col := range cte.cols {
-			id := col.ID
-			c := b.factory.Metadata().ColumnMeta(id)
-			inCols[i] = id
-			outCols[i] = b.factory.Metadata().AddColumn(col.Alias, c.Type)
-		}
-
 		lockCtx.locking.ignoreLockingForCTE()
-		outScope = inScope.push()
-		// Similar to appendColumnsFromScope, but with re-numbering the column IDs.
-		for i, col := range innerScope.cols {
-			col.scalar = nil
-			col.id = outCols[i]
-			outScope.cols = append(outScope.cols, col)
-		}
-
-		outScope.expr = b.factory.ConstructWithScan(&memo.WithScanPrivate{
-			With:    cte.id,
-			Name:    string(cte.name.Alias),
-			InCols:  inCols,
-			OutCols: outCols,
-			ID:      b.factory.Metadata().NextUniqueID(),
-			Mtr:     cte.mtr,
-		})
-
-		return outScope
+		return b.buildStatementScan(source.Statement, innerScope, inScope)
 
 	case *tree.TableRef:
 		ds, depName := b.resolveDataSourceRef(source, privilege.SELECT)
@@ -301,6 +284,52 @@ func (b *Builder) buildDataSource(
 	}
 }
 
+// buildStatementScan adds the expression of innerScope, which was built for the
+// given statement, as a CTE binding, and returns a scope that scans the
+// binding. This is used for the special '[ ... ]' syntax, which is treated as
+// syntactic sugar for a top-level CTE.
+func (b *Builder) buildStatementScan(
+	stmt tree.Statement, innerScope *scope, inScope *scope,
+) (outScope *scope) {
+	id := b.factory.Memo().NextWithID()
+	b.factory.Metadata().AddWithBinding(id, innerScope.expr)
+	cte := &cteSource{
+		name:         tree.AliasClause{},
+		cols:         innerScope.makePresentationWithHiddenCols(),
+		originalExpr: stmt,
+		expr:         innerScope.expr,
+		id:           id,
+	}
+	b.addCTE(cte)
+
+	inCols := make(opt.ColList, len(cte.cols))
+	outCols := make(opt.ColList, len(cte.cols))
+	for i, col := range cte.cols {
+		id := col.ID
+		c := b.factory.Metadata().ColumnMeta(id)
+		inCols[i] = id
+		outCols[i] = b.factory.Metadata().AddColumn(col.Alias, c.Type)
+	}
+
+	outScope = inScope.push()
+	// Similar to appendColumnsFromScope, but with re-numbering the column IDs.
+	for i, col := range innerScope.cols {
+		col.scalar = nil
+		col.id = outCols[i]
+		outScope.cols = append(outScope.cols, col)
+	}
+
+	outScope.expr = b.factory.ConstructWithScan(&memo.WithScanPrivate{
+		With:    cte.id,
+		Name:    string(cte.name.Alias),
+		InCols:  inCols,
+		OutCols: outCols,
+		ID:      b.factory.Metadata().NextUniqueID(),
+		Mtr:     cte.mtr,
+	})
+	return outScope
+}
+
 // buildView parses the view query text and builds it as a Select expression.
 func (b *Builder) buildView(
 	view cat.View, viewName *tree.TableName, lockCtx lockingContext, inScope *scope,
@@ -545,6 +574,11 @@ func (b *Builder) buildScan(
 	tab := tabMeta.Table
 	tabID := tabMeta.MetaID
 
+	// Consume the sample of the TABLESAMPLE clause, if any, so that it only
+	// applies to this scan.
+	sample := b.tableSample
+	b.tableSample = nil
+
 	if indexFlags != nil {
 		if indexFlags.IgnoreForeignKeys {
 			tabMeta.IgnoreForeignKeys = true
@@ -594,13 +628,20 @@ func (b *Builder) buildScan(
 		panic(err)
 	}
 	if tab.IsVirtualTable() {
+		kind := "virtual tables"
+		if tab.IsForeignTable() {
+			kind = "foreign tables"
+		}
 		if indexFlags != nil {
 			panic(pgerror.Newf(pgcode.Syntax,
-				"index flags not allowed with virtual tables"))
+				"index flags not allowed with %s", kind))
 		}
 		if locking.isSet() {
 			panic(pgerror.Newf(pgcode.Syntax,
-				"%s not allowed with virtual tables", locking.get().Strength))
+				"%s not allowed with %s", locking.get().Strength, kind))
+		}
+		if sample != nil {
+			panic(errTableSampleNotTable)
 		}
 		private := memo.ScanPrivate{Table: tabID, Cols: scanColIDs}
 		outScope.expr = b.factory.ConstructScan(&private)
@@ -745,6 +786,9 @@ func (b *Builder) buildScan(
 		private.Flags.NoZigzagJoin = true
 	}
 	private.Flags.DisableNotVisibleIndex = disableNotVisibleIndex
+	if sample != nil {
+		private.Sample = *sample
+	}
 
 	b.addCheckConstraintsForTable(tabMeta)
 	b.addComputedColsForTable(tabMeta, virtualMutationColOrds)
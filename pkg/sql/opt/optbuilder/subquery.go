@@ -70,7 +70,7 @@ type subquery struct {
 
 // isMultiRow returns whether the subquery can return multiple rows.
 func (s *subquery) isMultiRow() bool {
-	return s.wrapInTuple && !s.Exists
+	return s.wrapInTuple && !s.Exists && !s.Unique
 }
 
 // Walk is part of the tree.Expr interface.
@@ -155,7 +155,7 @@ func (s *subquery) TypeCheck(
 	// Without that auto-unwrapping of single-column subqueries, this query would
 	// type check as "<int> IN <tuple{tuple{int}}>" which would fail.
 
-	if s.Exists {
+	if s.Exists || s.Unique {
 		s.typ = types.Bool
 		return s, nil
 	}
@@ -343,6 +343,9 @@ func (b *Builder) buildSingleRowSubquery(
 		}
 		return b.factory.ConstructExists(s.node, &ex), inScope
 	}
+	if s.Unique {
+		return b.factory.ConstructUnique(s.node, &subqueryPrivate), inScope
+	}
 
 	var input memo.RelExpr
 	input, outScope = b.buildSubqueryProjection(s, inScope)
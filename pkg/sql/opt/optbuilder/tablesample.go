@@ -0,0 +1,90 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package optbuilder
+
+import (
+	"math"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/props"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+)
+
+// errTableSampleNotTable is returned when a TABLESAMPLE clause is applied to a
+// data source that is not a table.
+var errTableSampleNotTable = pgerror.New(pgcode.WrongObjectType,
+	"TABLESAMPLE clause can only be applied to tables and materialized views")
+
+// buildTableSample checks the TABLESAMPLE clause of a table expression and
+// returns the sample that should be applied to the scan of the table.
+func (b *Builder) buildTableSample(texpr tree.TableExpr, sample *tree.TableSample) *memo.TableSample {
+	if _, ok := texpr.(*tree.TableName); !ok {
+		panic(errTableSampleNotTable)
+	}
+	method, ok := tree.TableSampleMethodFromName(sample.Method)
+	if !ok {
+		panic(pgerror.Newf(pgcode.UndefinedObject,
+			"tablesample method %s does not exist", tree.ErrString(&sample.Method)))
+	}
+	res := &memo.TableSample{Method: method}
+
+	percent, ok := b.evalTableSampleArg(sample.Percent, exprKindTableSample)
+	if ok {
+		if percent == tree.DNull {
+			panic(pgerror.New(pgcode.InvalidTablesampleArgument, "TABLESAMPLE parameter cannot be null"))
+		}
+		p := float64(tree.MustBeDFloat(percent))
+		if math.IsNaN(p) || p < 0 || p > 100 {
+			panic(pgerror.New(pgcode.InvalidTablesampleArgument, "sample percentage must be between 0 and 100"))
+		}
+		res.Probability = p / 100
+	}
+
+	if sample.Seed != nil {
+		seed, ok := b.evalTableSampleArg(sample.Seed, exprKindRepeatable)
+		if ok {
+			if seed == tree.DNull {
+				panic(pgerror.New(pgcode.InvalidTablesampleRepeat,
+					"TABLESAMPLE REPEATABLE parameter cannot be null"))
+			}
+			res.Seed = int64(math.Float64bits(float64(tree.MustBeDFloat(seed))))
+		}
+		res.Repeatable = true
+	}
+	return res
+}
+
+// evalTableSampleArg type checks and evaluates an argument of a TABLESAMPLE
+// clause. It returns ok=false if the argument depends on placeholders whose
+// values are not known yet, in which case the memo cannot be reused.
+func (b *Builder) evalTableSampleArg(expr tree.Expr, kind exprKind) (_ tree.Datum, ok bool) {
+	// The arguments cannot reference any columns, so we use a "blank" scope.
+	emptyScope := b.allocScope()
+	emptyScope.context = kind
+
+	// We need to save and restore the previous value of the field in semaCtx in
+	// case we are recursively called within a subquery context.
+	defer b.semaCtx.Properties.Restore(b.semaCtx.Properties)
+	b.semaCtx.Properties.Require(kind.String(), tree.RejectSpecial)
+
+	texpr := emptyScope.resolveAndRequireType(expr, types.Float)
+	scalar := b.buildScalar(texpr, emptyScope, nil /* outScope */, nil /* outCol */, nil /* colRefs */)
+	if memo.CanExtractConstDatum(scalar) {
+		return memo.ExtractConstDatum(scalar), true
+	}
+	var shared props.Shared
+	memo.BuildSharedProps(scalar, &shared, b.evalCtx)
+	if shared.HasPlaceholder {
+		// The sample is not needed to prepare the statement, but the memo must be
+		// rebuilt once the placeholder values are known.
+		b.DisableMemoReuse = true
+		return nil, false
+	}
+	panic(pgerror.Newf(pgcode.FeatureNotSupported, "argument of %s must be a constant", kind))
+}
@@ -99,14 +99,17 @@ func (mb *mutationBuilder) buildRowLevelBeforeTriggers(
 		}
 
 		// For UPSERT and INSERT ON CONFLICT, UPDATE triggers should only fire for the
-		// conflicting rows, which are identified by the canary column.
-		if mb.canaryColID != 0 && eventType == tree.TriggerEventUpdate {
-			canaryCol := f.ConstructVariable(mb.canaryColID)
-			isUpdateCond := f.ConstructIsNot(canaryCol, memo.NullSingleton)
+		// conflicting rows, which are identified by the canary column. For MERGE,
+		// each trigger should only fire for the rows of its own event type.
+		if cond := mb.beforeTriggerFiresCond(eventType); cond != nil {
+			elseColID := newColID
+			if eventType == tree.TriggerEventDelete {
+				elseColID = oldColID
+			}
 			triggerFn = f.ConstructCase(
 				memo.TrueSingleton,
-				memo.ScalarListExpr{f.ConstructWhen(isUpdateCond, triggerFn)},
-				f.ConstructVariable(newColID),
+				memo.ScalarListExpr{f.ConstructWhen(cond, triggerFn)},
+				f.ConstructVariable(elseColID),
 			)
 		}
 
@@ -161,6 +164,38 @@ func (mb *mutationBuilder) buildRowLevelBeforeTriggers(
 	return true
 }
 
+// beforeTriggerFiresCond returns a condition that identifies the rows for which
+// row-level BEFORE triggers with the given event type fire, or nil if they fire
+// for every row of the mutation input.
+func (mb *mutationBuilder) beforeTriggerFiresCond(eventType tree.TriggerEventType) opt.ScalarExpr {
+	if mb.canaryColID == 0 {
+		return nil
+	}
+	f := mb.b.factory
+	canaryCol := f.ConstructVariable(mb.canaryColID)
+	switch eventType {
+	case tree.TriggerEventInsert:
+		// UPSERT and INSERT ON CONFLICT fire INSERT triggers for every input row,
+		// but MERGE only fires them for the inserted rows.
+		if mb.isMerge {
+			return f.ConstructIs(canaryCol, memo.NullSingleton)
+		}
+	case tree.TriggerEventUpdate:
+		isUpdateCond := f.ConstructIsNot(canaryCol, memo.NullSingleton)
+		if mb.deleteColID != 0 {
+			isUpdateCond = f.ConstructAnd(
+				isUpdateCond, f.ConstructNot(f.ConstructVariable(mb.deleteColID)),
+			)
+		}
+		return isUpdateCond
+	case tree.TriggerEventDelete:
+		if mb.deleteColID != 0 {
+			return f.ConstructVariable(mb.deleteColID)
+		}
+	}
+	return nil
+}
+
 // buildOldAndNewCols builds the OLD and NEW column tuples for a row-level
 // BEFORE trigger, if applicable. The OLD tuple contains the original values of
 // the columns being updated or deleted, and the NEW tuple contains the new
@@ -445,6 +480,9 @@ func (mb *mutationBuilder) buildRowLevelAfterTriggers(mutation opt.Operator) {
 	if mb.canaryColID != 0 {
 		mb.triggerColIDs.Add(mb.canaryColID)
 	}
+	if mb.deleteColID != 0 {
+		mb.triggerColIDs.Add(mb.deleteColID)
+	}
 	if mb.afterTriggers != nil {
 		panic(errors.AssertionFailedf("afterTriggers already set"))
 	}
@@ -471,6 +509,10 @@ func (mb *mutationBuilder) getEventsToMatchForMutation(
 			// addition to being inserted.
 			eventsToMatch.Add(tree.TriggerEventUpdate)
 		}
+		if mb.deleteColID != 0 {
+			// This is a MERGE with DELETE actions, so rows can also be deleted.
+			eventsToMatch.Add(tree.TriggerEventDelete)
+		}
 	case opt.UpdateOp:
 		eventsToMatch.Add(tree.TriggerEventUpdate)
 	case opt.DeleteOp:
@@ -514,6 +556,9 @@ type rowLevelAfterTriggerBuilder struct {
 	// canaryCol is set for UPSERT and INSERT with ON CONFLICT. It is NULL to
 	// indicate an inserted row, and non-NULL to indicate an updated row.
 	canaryCol opt.ColumnID
+	// deleteCol is set for MERGE with DELETE actions. It is true to indicate
+	// that a row with a non-NULL canaryCol was deleted rather than updated.
+	deleteCol opt.ColumnID
 }
 
 var _ memo.PostQueryBuilder = &rowLevelAfterTriggerBuilder{}
@@ -530,6 +575,7 @@ func (mb *mutationBuilder) newRowLevelAfterTriggerBuilder(
 		updateCols:     updateCols,
 		insertCols:     insertCols,
 		canaryCol:      mb.canaryColID,
+		deleteCol:      mb.deleteColID,
 	}
 }
 
@@ -564,26 +610,36 @@ func (tb *rowLevelAfterTriggerBuilder) Build(
 				// Make space for the canary column.
 				colCount++
 			}
+			if tb.deleteCol != 0 {
+				// Make space for the delete column.
+				colCount++
+			}
 			inCols := make(opt.ColList, 0, colCount)
 			outCols := make(opt.ColList, 0, colCount)
 
 			// Allocate a new scope to build the expression that will call the trigger
 			// functions for each row scanned from the buffer.
 			triggerScope := b.allocScope()
-			var inCanaryCol, outCanaryCol opt.ColumnID
-			if tb.canaryCol != 0 {
-				inCanaryColID, ok := colMap.Get(int(tb.canaryCol))
+			addSingleCol := func(col opt.ColumnID, name string) opt.ColumnID {
+				inColID, ok := colMap.Get(int(col))
 				if !ok {
 					panic(errors.AssertionFailedf("column %d not in mapping %s\n",
-						tb.canaryCol, colMap.String()))
+						col, colMap.String()))
 				}
-				inCanaryCol = opt.ColumnID(inCanaryColID)
-				colType := md.ColumnMeta(inCanaryCol).Type
-				colName := scopeColName("").WithMetadataName("canary")
-				col := b.synthesizeColumn(triggerScope, colName, colType, nil /* expr */, nil /* scalar */)
-				outCanaryCol = col.id
-				inCols = append(inCols, inCanaryCol)
-				outCols = append(outCols, outCanaryCol)
+				inCol := opt.ColumnID(inColID)
+				colType := md.ColumnMeta(inCol).Type
+				colName := scopeColName("").WithMetadataName(name)
+				outCol := b.synthesizeColumn(triggerScope, colName, colType, nil /* expr */, nil /* scalar */)
+				inCols = append(inCols, inCol)
+				outCols = append(outCols, outCol.id)
+				return outCol.id
+			}
+			var outCanaryCol, outDeleteCol opt.ColumnID
+			if tb.canaryCol != 0 {
+				outCanaryCol = addSingleCol(tb.canaryCol, "canary")
+			}
+			if tb.deleteCol != 0 {
+				outDeleteCol = addSingleCol(tb.deleteCol, "delete")
 			}
 			addCols := func(cols opt.ColList, suffix string) opt.ColList {
 				startIdx := len(outCols)
@@ -620,10 +676,13 @@ func (tb *rowLevelAfterTriggerBuilder) Build(
 				}
 				return f.ConstructTuple(elems, tableTyp)
 			}
-			var canaryCheck opt.ScalarExpr
+			var canaryCheck, deleteCheck opt.ScalarExpr
 			if tb.canaryCol != 0 {
 				canaryCheck = f.ConstructIs(f.ConstructVariable(outCanaryCol), memo.NullSingleton)
 			}
+			if tb.deleteCol != 0 {
+				deleteCheck = f.ConstructVariable(outDeleteCol)
+			}
 
 			// Build an expression for the old values of each row.
 			oldScalar := opt.ScalarExpr(memo.NullSingleton)
@@ -643,12 +702,13 @@ func (tb *rowLevelAfterTriggerBuilder) Build(
 			newScalar := opt.ScalarExpr(memo.NullSingleton)
 			if outCanaryCol != 0 {
 				// For an UPSERT/ON CONFLICT, the NEW column contains either inserted or
-				// updated values, depending on the canary column.
-				newScalar = f.ConstructCase(
-					memo.TrueSingleton,
-					memo.ScalarListExpr{f.ConstructWhen(canaryCheck, makeTuple(outInsertCols))},
-					makeTuple(outUpdateCols),
-				)
+				// updated values, depending on the canary column. For a MERGE, it is
+				// NULL for the deleted rows.
+				whens := memo.ScalarListExpr{f.ConstructWhen(canaryCheck, makeTuple(outInsertCols))}
+				if outDeleteCol != 0 {
+					whens = append(whens, f.ConstructWhen(deleteCheck, f.ConstructNull(tableTyp)))
+				}
+				newScalar = f.ConstructCase(memo.TrueSingleton, whens, makeTuple(outUpdateCols))
 			} else if len(outUpdateCols) > 0 {
 				newScalar = makeTuple(outUpdateCols)
 			} else if len(outInsertCols) > 0 {
@@ -670,9 +730,15 @@ func (tb *rowLevelAfterTriggerBuilder) Build(
 			case opt.InsertOp:
 				tgOp = f.ConstructConstVal(tree.NewDString("INSERT"), types.String)
 				if outCanaryCol != 0 {
+					whens := memo.ScalarListExpr{f.ConstructWhen(canaryCheck, tgOp)}
+					if outDeleteCol != 0 {
+						whens = append(whens, f.ConstructWhen(
+							deleteCheck, f.ConstructConstVal(tree.NewDString("DELETE"), types.String),
+						))
+					}
 					tgOp = f.ConstructCase(
 						memo.TrueSingleton,
-						memo.ScalarListExpr{f.ConstructWhen(canaryCheck, tgOp)},
+						whens,
 						f.ConstructConstVal(tree.NewDString("UPDATE"), types.String),
 					)
 				}
@@ -730,15 +796,37 @@ func (tb *rowLevelAfterTriggerBuilder) Build(
 				// triggers should only fire for non-conflicting rows. A trigger that
 				// matches both operations can fire unconditionally.
 				if outCanaryCol != 0 {
-					var hasInsert, hasUpdate bool
+					var hasInsert, hasUpdate, hasDelete bool
 					for j := 0; j < trigger.EventCount(); j++ {
-						if trigger.Event(j).EventType == tree.TriggerEventInsert {
+						switch trigger.Event(j).EventType {
+						case tree.TriggerEventInsert:
 							hasInsert = true
-						} else if trigger.Event(j).EventType == tree.TriggerEventUpdate {
+						case tree.TriggerEventUpdate:
 							hasUpdate = true
+						case tree.TriggerEventDelete:
+							hasDelete = true
 						}
 					}
-					if hasInsert && !hasUpdate {
+					if outDeleteCol != 0 {
+						// For a MERGE with DELETE actions, each trigger should only fire
+						// for the rows of the operations it matches.
+						if !hasInsert || !hasUpdate || !hasDelete {
+							fnIf := func(has bool) opt.ScalarExpr {
+								if has {
+									return triggerFn
+								}
+								return f.ConstructNull(tableTyp)
+							}
+							triggerFn = f.ConstructCase(
+								memo.TrueSingleton,
+								memo.ScalarListExpr{
+									f.ConstructWhen(canaryCheck, fnIf(hasInsert)),
+									f.ConstructWhen(deleteCheck, fnIf(hasDelete)),
+								},
+								fnIf(hasUpdate),
+							)
+						}
+					} else if hasInsert && !hasUpdate {
 						triggerFn = f.ConstructCase(
 							memo.TrueSingleton,
 							memo.ScalarListExpr{f.ConstructWhen(canaryCheck, triggerFn)},
@@ -78,6 +78,9 @@ func (b *Builder) buildUpdate(upd *tree.Update, inScope *scope) (outScope *scope
 			"cannot update view \"%s\"", tab.Name(),
 		))
 	}
+	if outScope, ok := b.buildInheritedTableMutation(tab, upd, inScope); ok {
+		return outScope
+	}
 
 	if refColumns != nil {
 		panic(pgerror.Newf(pgcode.Syntax,
@@ -355,6 +358,9 @@ func (mb *mutationBuilder) buildUpdate(
 ) {
 	mb.maybeAddRegionColLookup(opt.UpdateOp)
 
+	// Check the constraints of the domain-typed columns being updated.
+	mb.addDomainConstraintChecks(mb.updateColIDs, nil /* guard */)
+
 	// Disambiguate names so that references in any expressions, such as a
 	// check constraint, refer to the correct columns.
 	mb.disambiguateColumns()
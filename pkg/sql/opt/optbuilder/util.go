@@ -616,11 +616,17 @@ func (b *Builder) resolveTableForMutation(
 		alias = *outerAlias
 	}
 
-	// We can't mutate materialized views.
-	if tab.IsMaterializedView() {
+	// We can't mutate materialized views, except to apply the changes of an
+	// incremental refresh.
+	if tab.IsMaterializedView() && !b.evalCtx.SessionData().AllowMaterializedViewMutation {
 		panic(pgerror.Newf(pgcode.WrongObjectType, "cannot mutate materialized view %q", tab.Name()))
 	}
 
+	// Foreign tables are read-only.
+	if tab.IsForeignTable() {
+		panic(pgerror.Newf(pgcode.WrongObjectType, "cannot mutate foreign table %q", tab.Name()))
+	}
+
 	return tab, depName, alias, columns
 }
 
@@ -279,6 +279,19 @@ func (b *Builder) buildAggregationAsWindow(
 	// Initialize the aggregate expression.
 	aggregateExpr := g.aggInScope.expr
 
+	if g.groupingSets != nil {
+		g.aggOutScope.expr = b.constructWindowGroupingSets(
+			aggregateExpr, g, argLists, orderings, filterCols,
+		)
+		// Wrap with having filter if it exists.
+		if having != nil {
+			input := g.aggOutScope.expr
+			filters := memo.FiltersExpr{b.factory.ConstructFiltersItem(having)}
+			g.aggOutScope.expr = b.factory.ConstructSelect(input, filters)
+		}
+		return g.aggOutScope
+	}
+
 	// frames accumulates the set of distinct window frames we're computing over
 	// so that we can group functions over the same partition and ordering.
 	frames := make([]memo.WindowExpr, 0, len(g.aggs))
@@ -564,6 +577,118 @@ func (b *Builder) constructWindowGroup(
 	return b.factory.ConstructGroupBy(input, aggs, &private)
 }
 
+// constructWindowGroupingSets is the counterpart of constructWindowGroup for
+// the grouping sets of g. Each aggregate is computed by a window function for
+// each grouping set, partitioned by the columns of the set. The values of the
+// window functions are squashed down by a GroupingSets expression, and the
+// value of each aggregate is then taken from the window function of the
+// grouping set of each row, which is identified by a GROUPING operation over
+// all the grouping columns. For example:
+//
+//	SELECT a, array_agg(b ORDER BY c) FROM t GROUP BY ROLLUP (a)
+//
+// is built as:
+//
+//	project (array_agg := CASE grouping WHEN 0 THEN any1 WHEN 1 THEN any2 END)
+//	 └── grouping-sets
+//	      ├── grouping sets: (a) ()
+//	      ├── window partition=() ordering=+c
+//	      │    ├── window partition=(a) ordering=+c
+//	      │    │    ├── project (pre-projection)
+//	      │    │    └── windows
+//	      │    │         └── array-agg(b) as w1
+//	      │    └── windows
+//	      │         └── array-agg(b) as w2
+//	      └── aggregations
+//	           ├── any-not-null(w1) as any1
+//	           └── any-not-null(w2) as any2
+func (b *Builder) constructWindowGroupingSets(
+	input memo.RelExpr,
+	g *groupby,
+	argLists [][]opt.ScalarExpr,
+	orderings []props.OrderingChoice,
+	filterCols []opt.ColumnID,
+) memo.RelExpr {
+	md := b.factory.Metadata()
+
+	// frames accumulates the set of distinct window frames we're computing over
+	// so that we can group functions over the same partition and ordering.
+	frames := make([]memo.WindowExpr, 0, len(g.groupingSets))
+	aggs := make(memo.AggregationsExpr, 0, len(g.groupingSets)*len(g.aggs))
+	for _, groupingSet := range g.groupingSets {
+		for i, agg := range g.aggs {
+			fn := b.constructAggregate(agg.def.Name, argLists[i])
+			if filterCols[i] != 0 {
+				fn = b.factory.ConstructAggFilter(
+					fn,
+					b.factory.ConstructVariable(filterCols[i]),
+				)
+			}
+			alias := md.ColumnMeta(agg.col.id).Alias
+			windowCol := md.AddColumn(alias, agg.col.typ)
+			frameIdx := b.findMatchingFrameIndex(&frames, groupingSet, orderings[i])
+			frames[frameIdx].Windows = append(frames[frameIdx].Windows,
+				b.factory.ConstructWindowsItem(
+					fn,
+					&memo.WindowsItemPrivate{
+						Frame: windowAggregateFrame(),
+						Col:   windowCol,
+					},
+				),
+			)
+
+			// The window column is constant within the groups of this grouping
+			// set, but not within the groups of the other grouping sets, so use
+			// AnyNotNull instead of ConstAgg.
+			aggs = append(aggs, b.factory.ConstructAggregationsItem(
+				b.factory.ConstructAnyNotNullAgg(b.factory.ConstructVariable(windowCol)),
+				md.AddColumn(alias, agg.col.typ),
+			))
+		}
+	}
+	for _, f := range frames {
+		input = b.factory.ConstructWindow(input, f.Windows, &f.WindowPrivate)
+	}
+
+	// Identify the grouping set of each row with a GROUPING operation over all
+	// the grouping columns.
+	private := b.makeGroupingSetsPrivate(g)
+	allGrouping := groupingInfo{argCols: private.GroupingCols}
+	groupingCol := md.AddColumn("grouping", types.Int)
+	private.GroupingFuncs = append(private.GroupingFuncs, memo.GroupingFunc{
+		Col:  groupingCol,
+		Args: allGrouping.argCols,
+	})
+	expr := b.factory.ConstructGroupingSets(input, aggs, &private)
+
+	// Project the value of each aggregate for the grouping set of each row.
+	// Since there is a row for each empty grouping set even if the input is
+	// empty, the default values of the aggregates must be respected for them.
+	projections := make(memo.ProjectionsExpr, len(g.aggs))
+	for i, agg := range g.aggs {
+		whens := make(memo.ScalarListExpr, len(g.groupingSets))
+		for j, groupingSet := range g.groupingSets {
+			var val opt.ScalarExpr = b.factory.ConstructVariable(aggs[j*len(g.aggs)+i].Col)
+			if defaultNullVal, ok := b.overrideDefaultNullValue(agg); ok && groupingSet.Empty() {
+				val = b.replaceDefaultReturn(val, memo.NullSingleton, defaultNullVal)
+			}
+			whens[j] = b.factory.ConstructWhen(
+				b.factory.ConstructConstVal(tree.NewDInt(tree.DInt(allGrouping.bitmask(groupingSet))), types.Int),
+				val,
+			)
+		}
+		projections[i] = b.factory.ConstructProjectionsItem(
+			b.factory.ConstructCase(b.factory.ConstructVariable(groupingCol), whens, b.factory.ConstructNull(agg.col.typ)),
+			agg.col.id,
+		)
+	}
+	passthrough := private.OutCols.ToSet()
+	for _, gi := range g.groupingFns {
+		passthrough.Add(gi.col.id)
+	}
+	return b.factory.ConstructProject(expr, projections, passthrough)
+}
+
 // replaceDefaultReturn constructs a case expression to apply as a projection over
 // a ScalarGroupBy expression, that replaces the default NULL value from matchVal
 // to replaceVal.
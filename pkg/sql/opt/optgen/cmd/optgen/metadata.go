@@ -191,14 +191,18 @@ func newMetadata(compiled *lang.CompiledExpr, pkg string) *metadata {
 		"UniqueID":             {fullName: "opt.UniqueID", passByVal: true},
 		"WithID":               {fullName: "opt.WithID", passByVal: true},
 		"UDFDefinition":        {fullName: "memo.UDFDefinition", isPointer: true},
+		"UDADefinition":        {fullName: "memo.UDADefinition", isPointer: true},
 		"StoredProcTxnOp":      {fullName: "tree.StoredProcTxnOp", passByVal: true},
 		"TransactionModes":     {fullName: "tree.TransactionModes", passByVal: true},
 		"Ordering":             {fullName: "opt.Ordering", passByVal: true},
 		"OrderingChoice":       {fullName: "props.OrderingChoice", passByVal: true},
 		"GroupingOrder":        {fullName: "memo.GroupingOrder", passByVal: true},
+		"GroupingSetList":      {fullName: "memo.GroupingSetList", passByVal: true},
+		"GroupingFuncs":        {fullName: "memo.GroupingFuncs", passByVal: true},
 		"TupleOrdinal":         {fullName: "memo.TupleOrdinal", passByVal: true},
 		"ScanLimit":            {fullName: "memo.ScanLimit", passByVal: true},
 		"ScanFlags":            {fullName: "memo.ScanFlags", passByVal: true},
+		"TableSample":          {fullName: "memo.TableSample", passByVal: true},
 		"JoinFlags":            {fullName: "memo.JoinFlags", passByVal: true},
 		"WindowFrame":          {fullName: "memo.WindowFrame", passByVal: true},
 		"FKCascades":           {fullName: "memo.FKCascades", passByVal: true},
@@ -63,6 +63,16 @@ func groupByBuildProvided(expr memo.RelExpr, required *props.OrderingChoice) opt
 	return remapProvided(provided, inputFDs, groupBy.GroupingCols)
 }
 
+func groupingSetsBuildChildReqOrdering(
+	parent memo.RelExpr, required *props.OrderingChoice, childIdx int,
+) props.OrderingChoice {
+	if childIdx != 0 {
+		return props.OrderingChoice{}
+	}
+	// GroupingSets requires the ordering in its private.
+	return parent.(*memo.GroupingSetsExpr).Ordering
+}
+
 func distinctOnCanProvideOrdering(expr memo.RelExpr, required *props.OrderingChoice) bool {
 	// DistinctOn may require a certain ordering of its input, but can also pass
 	// through a stronger ordering on the grouping columns.
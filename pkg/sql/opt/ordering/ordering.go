@@ -251,6 +251,13 @@ func init() {
 		buildChildReqOrdering: groupByBuildChildReqOrdering,
 		buildProvidedOrdering: groupByBuildProvided,
 	}
+	funcMap[opt.GroupingSetsOp] = funcs{
+		// The rows of the different grouping sets are not ordered with respect to
+		// each other.
+		canProvideOrdering:    canNeverProvideOrdering,
+		buildChildReqOrdering: groupingSetsBuildChildReqOrdering,
+		buildProvidedOrdering: noProvidedOrdering,
+	}
 	funcMap[opt.DistinctOnOp] = funcs{
 		canProvideOrdering:    distinctOnCanProvideOrdering,
 		buildChildReqOrdering: distinctOnBuildChildReqOrdering,
@@ -25,6 +25,7 @@ import (
 //   - INJECT STATISTICS: imports table statistics from a JSON object.
 //   - ADD CONSTRAINT FOREIGN KEY: add a foreign key reference.
 //   - {ENABLE | DISABLE} ROW LEVEL SECURITY: enables or disables RLS policies for the table.
+//   - INHERIT: makes the table inherit from another table.
 func (tc *Catalog) AlterTable(stmt *tree.AlterTable) {
 	tn := stmt.Table.ToTableName()
 	// Update the table name to include catalog and schema if not provided.
@@ -39,6 +40,15 @@ func (tc *Catalog) AlterTable(stmt *tree.AlterTable) {
 		case *tree.AlterTableSetRLSMode:
 			toggleRLSMode(tab, t.Mode)
 
+		case *tree.AlterTableInherit:
+			if t.NoInherit {
+				panic(errors.AssertionFailedf("NO INHERIT is not supported"))
+			}
+			parentName := t.Parent.ToTableName()
+			tc.qualifyTableName(&parentName)
+			parent := tc.Table(&parentName)
+			parent.inheritedBy = append(parent.inheritedBy, tab.ID())
+
 		case *tree.AlterTableAddConstraint:
 			switch d := t.ConstraintDef.(type) {
 			case *tree.ForeignKeyConstraintTableDef:
@@ -31,6 +31,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/stats"
 	"github.com/cockroachdb/cockroach/pkg/sql/syntheticprivilege"
@@ -922,6 +923,9 @@ type Table struct {
 	rlsForced    bool
 	policies     cat.Policies
 	nextPolicyID descpb.PolicyID
+
+	// inheritedBy contains the IDs of tables that inherit from this table.
+	inheritedBy []cat.StableID
 }
 
 var _ cat.Table = &Table{}
@@ -978,6 +982,11 @@ func (tt *Table) IsVirtualTable() bool {
 	return tt.IsVirtual
 }
 
+// IsForeignTable is part of the cat.Table interface.
+func (tt *Table) IsForeignTable() bool {
+	return false
+}
+
 // IsSystemTable is part of the cat.Table interface.
 func (tt *Table) IsSystemTable() bool {
 	return tt.IsSystem
@@ -1237,6 +1246,16 @@ func (tt *Table) Policies() *cat.Policies {
 	return &tt.policies
 }
 
+// InheritingTableCount is part of the cat.Table interface.
+func (tt *Table) InheritingTableCount() int {
+	return len(tt.inheritedBy)
+}
+
+// InheritingTableID is part of the cat.Table interface.
+func (tt *Table) InheritingTableID(i int) cat.StableID {
+	return tt.inheritedBy[i]
+}
+
 // findPolicyByName will lookup the policy by its name. It returns it's policy
 // type and index within that policy type slice so that callers can do removal
 // if needed.
@@ -1688,6 +1707,11 @@ func (ts *TableStat) HistogramType() *types.T {
 	return ts.histogramType
 }
 
+// DependencyDegrees is part of the cat.TableStatistic interface.
+func (ts *TableStat) DependencyDegrees() []float64 {
+	return ts.js.DependencyDegrees
+}
+
 // IsPartial is part of the cat.TableStatistic interface.
 func (ts *TableStat) IsPartial() bool {
 	return ts.js.IsPartial()
@@ -1806,6 +1830,16 @@ func (fk *ForeignKeyConstraint) UpdateReferenceAction() tree.ReferenceAction {
 	return fk.updateAction
 }
 
+// Deferrable is part of the cat.ForeignKeyConstraint interface.
+func (fk *ForeignKeyConstraint) Deferrable() bool {
+	return false
+}
+
+// InitiallyDeferred is part of the cat.ForeignKeyConstraint interface.
+func (fk *ForeignKeyConstraint) InitiallyDeferred() bool {
+	return false
+}
+
 // UniqueConstraint implements cat.UniqueConstraint. See that interface
 // for more information on the fields.
 type UniqueConstraint struct {
@@ -1879,6 +1913,26 @@ func (u *UniqueConstraint) UniquenessGuaranteedByAnotherIndex() bool {
 	return false
 }
 
+// Deferrable is part of the cat.UniqueConstraint interface.
+func (u *UniqueConstraint) Deferrable() bool {
+	return false
+}
+
+// InitiallyDeferred is part of the cat.UniqueConstraint interface.
+func (u *UniqueConstraint) InitiallyDeferred() bool {
+	return false
+}
+
+// IsExclusion is part of the cat.UniqueConstraint interface.
+func (u *UniqueConstraint) IsExclusion() bool {
+	return false
+}
+
+// ExclusionOperator is part of the cat.UniqueConstraint interface.
+func (u *UniqueConstraint) ExclusionOperator(i int) treecmp.ComparisonOperatorSymbol {
+	panic(errors.AssertionFailedf("not an exclusion constraint"))
+}
+
 // Sequence implements the cat.Sequence interface for testing purposes.
 type Sequence struct {
 	SeqID      cat.StableID
@@ -14,6 +14,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/opt"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/distribution"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/ordering"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/props"
@@ -584,6 +585,9 @@ func (c *coster) ComputeCost(candidate memo.RelExpr, required *physical.Required
 		opt.UpsertDistinctOnOp, opt.EnsureUpsertDistinctOnOp:
 		cost = c.computeGroupingCost(candidate, required)
 
+	case opt.GroupingSetsOp:
+		cost = c.computeGroupingSetsCost(candidate.(*memo.GroupingSetsExpr))
+
 	case opt.LimitOp:
 		cost = c.computeLimitCost(candidate.(*memo.LimitExpr))
 
@@ -656,6 +660,12 @@ func (c *coster) ComputeCost(candidate memo.RelExpr, required *physical.Required
 		}
 	}
 
+	// Add a penalty if the operator does not conform to the shape of the
+	// accepted plan baseline of the statement.
+	if c.o != nil && c.o.planShape != nil && !c.conformsToPlanShape(candidate) {
+		cost.Penalties |= memo.PlanShapePenalty
+	}
+
 	if !cost.Less(memo.MaxCost) {
 		// Optsteps uses MaxCost to suppress nodes in the memo. When a node with
 		// MaxCost is added to the memo, it can lead to an obscure crash with an
@@ -685,6 +695,43 @@ func (c *coster) ComputeCost(candidate memo.RelExpr, required *physical.Required
 	return cost
 }
 
+// conformsToPlanShape returns true if the top-level operator of the candidate
+// expression conforms to the shape of the accepted plan baseline, i.e., if it
+// uses a join algorithm that is used by the baseline, and reads the tables of
+// the baseline using the same indexes.
+func (c *coster) conformsToPlanShape(candidate memo.RelExpr) bool {
+	shape := c.o.planShape
+	md := c.mem.Metadata()
+	allowsIndex := func(tabID opt.TableID, idx cat.IndexOrdinal) bool {
+		tab := md.Table(tabID)
+		return shape.AllowsIndex(tab.ID(), tab.Index(idx).ID())
+	}
+	switch t := candidate.(type) {
+	case *memo.ScanExpr:
+		return allowsIndex(t.Table, t.Index)
+	case *memo.IndexJoinExpr:
+		return shape.AllowsJoin(exec.IndexJoin) && allowsIndex(t.Table, cat.PrimaryIndex)
+	case *memo.LookupJoinExpr:
+		return shape.AllowsJoin(exec.LookupJoin) && allowsIndex(t.Table, t.Index)
+	case *memo.InvertedJoinExpr:
+		return shape.AllowsJoin(exec.InvertedJoin) && allowsIndex(t.Table, t.Index)
+	case *memo.ZigzagJoinExpr:
+		return shape.AllowsJoin(exec.ZigZagJoin) &&
+			allowsIndex(t.LeftTable, t.LeftIndex) && allowsIndex(t.RightTable, t.RightIndex)
+	case *memo.MergeJoinExpr:
+		return shape.AllowsJoin(exec.MergeJoin)
+	case *memo.InnerJoinApplyExpr, *memo.LeftJoinApplyExpr, *memo.SemiJoinApplyExpr,
+		*memo.AntiJoinApplyExpr:
+		return shape.AllowsJoin(exec.ApplyJoin)
+	case *memo.InnerJoinExpr, *memo.LeftJoinExpr, *memo.RightJoinExpr, *memo.FullJoinExpr,
+		*memo.SemiJoinExpr, *memo.AntiJoinExpr:
+		// These joins are executed as hash joins, or as cross joins if there are
+		// no equality conditions.
+		return shape.AllowsJoin(exec.HashJoin) || shape.AllowsJoin(exec.CrossJoin)
+	}
+	return true
+}
+
 func (c *coster) computeTopKCost(topk *memo.TopKExpr, required *physical.Required) memo.Cost {
 	rel := topk.Relational()
 	outputRowCount := rel.Statistics().RowCount
@@ -1563,6 +1610,28 @@ func (c *coster) computeGroupingCost(grouping memo.RelExpr, required *physical.R
 	return cost
 }
 
+func (c *coster) computeGroupingSetsCost(groupingSets *memo.GroupingSetsExpr) memo.Cost {
+	// Start with the same fixed overhead as the grouping operators.
+	cost := memo.Cost{C: cpuCostFactor}
+
+	// Add the CPU cost of emitting the rows.
+	outputRowCount := groupingSets.Relational().Statistics().RowCount
+	cost.C += outputRowCount * cpuCostFactor
+
+	// Each input row is aggregated once for each grouping set, which costs the
+	// same as processing a row in a hash GroupBy.
+	inputRowCount := groupingSets.Input.Relational().Statistics().RowCount
+	expandedRowCount := inputRowCount * float64(len(groupingSets.Sets))
+	groupingColCount := len(groupingSets.GroupingCols) + len(groupingSets.GroupingFuncs)
+	cost.C += expandedRowCount * float64(len(groupingSets.Aggregations)+groupingColCount) * cpuCostFactor
+
+	// Add the cost to build the hash table and to buffer the rows.
+	cost.C += expandedRowCount * cpuCostFactor
+	cost.Add(c.rowBufferCost(outputRowCount))
+
+	return cost
+}
+
 func (c *coster) computeLimitCost(limit *memo.LimitExpr) memo.Cost {
 	// Add the CPU cost of emitting the rows.
 	cost := memo.Cost{C: limit.Relational().Statistics().RowCount * cpuCostFactor}
@@ -434,6 +434,10 @@ func (c *CustomFuncs) MakeOrderingChoiceFromColumn(
 func (c *CustomFuncs) SplitGroupByScanIntoUnionScans(
 	scan memo.RelExpr, sp *memo.ScanPrivate, private *memo.GroupingPrivate,
 ) (_ memo.RelExpr, ok bool) {
+	if !sp.Sample.Empty() {
+		// Splitting a sampled scan would change which rows are sampled.
+		return nil, false
+	}
 	cons, ok := c.getKnownScanConstraint(sp)
 	if !ok {
 		// No valid constraint was found.
@@ -225,6 +225,10 @@ func (c *CustomFuncs) SplitLimitedSelectIntoUnionScansOrSelects(
 	limit tree.Datum,
 	filters memo.FiltersExpr,
 ) (_ memo.RelExpr, ok bool) {
+	if !sp.Sample.Empty() {
+		// Splitting a sampled scan would change which rows are sampled.
+		return nil, false
+	}
 
 	cons, ok := c.getKnownScanConstraint(sp)
 	if !ok {
@@ -13,6 +13,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/opt"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/distribution"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/norm"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/ordering"
@@ -106,6 +107,11 @@ type Optimizer struct {
 	// a lower-cost expression). scratchSort should be accessed using
 	// getScratchSort to ensure that it is properly initialized.
 	scratchSort *memo.SortExpr
+
+	// planShape, if set, is the shape of the accepted plan baseline of the
+	// statement. The default coster penalizes expressions that do not conform
+	// to it. It can be set via a call to the SetPlanShape method.
+	planShape *exec.PlanShape
 }
 
 // maxGroupPasses is the maximum allowed number of optimization passes for any
@@ -176,7 +182,9 @@ func (o *Optimizer) Init(ctx context.Context, evalCtx *eval.Context, catalog cat
 // used to extract a read-only memo during the PREPARE phase.
 func (o *Optimizer) DetachMemo(ctx context.Context) *memo.Memo {
 	detach := o.f.DetachMemo()
+	planShape := o.planShape
 	o.Init(ctx, o.evalCtx, o.catalog)
+	o.planShape = planShape
 	return detach
 }
 
@@ -201,6 +209,12 @@ func (o *Optimizer) SetCoster(coster Coster) {
 	o.coster = coster
 }
 
+// SetPlanShape sets the shape of the accepted plan baseline of the statement.
+// The optimizer will prefer plans with the same shape, if possible.
+func (o *Optimizer) SetPlanShape(shape *exec.PlanShape) {
+	o.planShape = shape
+}
+
 // JoinOrderBuilder returns the JoinOrderBuilder instance that the optimizer is
 // currently using to reorder join trees.
 func (o *Optimizer) JoinOrderBuilder() *JoinOrderBuilder {
@@ -271,6 +285,10 @@ func (o *Optimizer) Optimize() (_ opt.Expr, err error) {
 		return nil, errors.AssertionFailedf("cannot optimize a memo multiple times")
 	}
 
+	if o.planShape != nil {
+		o.mem.SetPlanBaselineGist(o.planShape.Gist())
+	}
+
 	// Optimize the root expression according to the properties required of it.
 	o.optimizeRootWithProps()
 
@@ -1110,6 +1128,8 @@ func (o *Optimizer) disableRulesRandom(probability float64, disabledRules *RuleS
 		// Needed to ensure that all uncorrelated EXISTS subqueries are
 		// converted to COALESCE+subquery expressions.
 		int(opt.ConvertUncorrelatedExistsToCoalesceSubquery),
+		// Needed because there is no execution support for UNIQUE predicates.
+		int(opt.ConvertUniqueToNotExists),
 	)
 
 	for i := opt.RuleName(1); i < opt.NumRuleNames; i++ {
@@ -22,6 +22,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/resolver"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/cat"
 	"github.com/cockroachdb/cockroach/pkg/sql/opt/indexrec"
@@ -669,10 +670,12 @@ func (oc *optCatalog) dataSourceForDesc(
 func (oc *optCatalog) dataSourceForTable(
 	ctx context.Context, flags cat.Flags, desc catalog.TableDescriptor, name *cat.DataSourceName,
 ) (cat.DataSource, error) {
-	if desc.IsVirtualTable() {
+	if desc.IsVirtualTable() || desc.IsForeignTable() {
 		// Virtual tables can have multiple effective instances that utilize the
 		// same descriptor, so we can't cache them (see the comment for
-		// optVirtualTable.id for more information).
+		// optVirtualTable.id for more information). Foreign tables are presented
+		// to the optimizer as virtual tables, since they only support full scans
+		// and have no statistics.
 		return newOptVirtualTable(ctx, oc, desc, name)
 	}
 
@@ -1077,6 +1080,24 @@ func newOptTable(
 			predicate:    u.GetPredicate(),
 			withoutIndex: true,
 			validity:     u.GetConstraintValidity(),
+
+			deferrable:        u.UniqueWithoutIndexDesc().Deferrable,
+			initiallyDeferred: u.UniqueWithoutIndexDesc().InitiallyDeferred,
+		}
+		if u.IsExclusion() {
+			// The columns of an exclusion constraint are positionally matched with
+			// its operators, so their order must be preserved.
+			uwi := u.UniqueWithoutIndexDesc()
+			ops := make([]treecmp.ComparisonOperatorSymbol, len(uwi.ExclusionOperators))
+			for j, symbol := range uwi.ExclusionOperators {
+				op, err := schemaexpr.ParseExclusionOperator(symbol)
+				if err != nil {
+					return nil, err
+				}
+				ops[j] = op
+			}
+			ot.uniqueConstraints[i].columns = uwi.ColumnIDs
+			ot.uniqueConstraints[i].exclusionOperators = ops
 		}
 	}
 
@@ -1192,6 +1213,8 @@ func newOptTable(
 			match:             tree.CompositeKeyMatchMethodType[fk.Match()],
 			deleteAction:      tree.ForeignKeyReferenceActionType[fk.OnDelete()],
 			updateAction:      tree.ForeignKeyReferenceActionType[fk.OnUpdate()],
+			deferrable:        fk.ForeignKeyDesc().Deferrable,
+			initiallyDeferred: fk.ForeignKeyDesc().InitiallyDeferred,
 		})
 	}
 	for _, fk := range ot.desc.InboundForeignKeys() {
@@ -1206,6 +1229,8 @@ func newOptTable(
 			match:             tree.CompositeKeyMatchMethodType[fk.Match()],
 			deleteAction:      tree.ForeignKeyReferenceActionType[fk.OnDelete()],
 			updateAction:      tree.ForeignKeyReferenceActionType[fk.OnUpdate()],
+			deferrable:        fk.ForeignKeyDesc().Deferrable,
+			initiallyDeferred: fk.ForeignKeyDesc().InitiallyDeferred,
 		})
 	}
 
@@ -1391,6 +1416,11 @@ func (ot *optTable) IsVirtualTable() bool {
 	return false
 }
 
+// IsForeignTable is part of the cat.Table interface.
+func (ot *optTable) IsForeignTable() bool {
+	return false
+}
+
 // IsSystemTable is part of the cat.Table interface.
 func (ot *optTable) IsSystemTable() bool {
 	return catalog.IsSystemDescriptor(ot.desc)
@@ -1634,6 +1664,16 @@ func (ot *optTable) Policies() *cat.Policies {
 	return &ot.policies
 }
 
+// InheritingTableCount is part of the cat.Table interface.
+func (ot *optTable) InheritingTableCount() int {
+	return len(ot.desc.GetInheritedBy())
+}
+
+// InheritingTableID is part of the cat.Table interface.
+func (ot *optTable) InheritingTableID(i int) cat.StableID {
+	return cat.StableID(ot.desc.GetInheritedBy()[i])
+}
+
 // LookupColumnOrdinal returns the ordinal of the column with the given ID. A
 // cache makes the lookup O(1).
 func (ot *optTable) LookupColumnOrdinal(colID descpb.ColumnID) (int, error) {
@@ -2153,6 +2193,11 @@ func (os *optTableStat) HistogramType() *types.T {
 	return os.stat.HistogramData.ColumnType
 }
 
+// DependencyDegrees is part of the cat.TableStatistic interface.
+func (os *optTableStat) DependencyDegrees() []float64 {
+	return os.stat.DependencyDegrees
+}
+
 // IsPartial is part of the cat.TableStatistic interface.
 func (os *optTableStat) IsPartial() bool {
 	return os.stat.IsPartial()
@@ -2229,6 +2274,13 @@ type optUniqueConstraint struct {
 	tombstoneIndexOrdinal cat.IndexOrdinal
 	validity              descpb.ConstraintValidity
 
+	deferrable        bool
+	initiallyDeferred bool
+
+	// exclusionOperators is set for exclusion constraints, and contains the
+	// operator used to compare each of the columns.
+	exclusionOperators []treecmp.ComparisonOperatorSymbol
+
 	uniquenessGuaranteedByAnotherIndex bool
 }
 
@@ -2284,7 +2336,30 @@ func (u *optUniqueConstraint) TombstoneIndexOrdinal() (ordinal cat.IndexOrdinal,
 
 // Validated is part of the cat.UniqueConstraint interface.
 func (u *optUniqueConstraint) Validated() bool {
-	return u.validity == descpb.ConstraintValidity_Validated
+	// A deferrable constraint can be violated until the end of the
+	// transaction, so the optimizer cannot assume that it holds. An exclusion
+	// constraint does not make its columns unique.
+	return u.validity == descpb.ConstraintValidity_Validated && !u.deferrable && !u.IsExclusion()
+}
+
+// Deferrable is part of the cat.UniqueConstraint interface.
+func (u *optUniqueConstraint) Deferrable() bool {
+	return u.deferrable
+}
+
+// InitiallyDeferred is part of the cat.UniqueConstraint interface.
+func (u *optUniqueConstraint) InitiallyDeferred() bool {
+	return u.initiallyDeferred
+}
+
+// IsExclusion is part of the cat.UniqueConstraint interface.
+func (u *optUniqueConstraint) IsExclusion() bool {
+	return len(u.exclusionOperators) > 0
+}
+
+// ExclusionOperator is part of the cat.UniqueConstraint interface.
+func (u *optUniqueConstraint) ExclusionOperator(i int) treecmp.ComparisonOperatorSymbol {
+	return u.exclusionOperators[i]
 }
 
 // UniquenessGuaranteedByAnotherIndex is part of the cat.UniqueConstraint
@@ -2313,6 +2388,9 @@ type optForeignKeyConstraint struct {
 	match        tree.CompositeKeyMatchMethod
 	deleteAction tree.ReferenceAction
 	updateAction tree.ReferenceAction
+
+	deferrable        bool
+	initiallyDeferred bool
 }
 
 var _ cat.ForeignKeyConstraint = &optForeignKeyConstraint{}
@@ -2366,7 +2444,9 @@ func (fk *optForeignKeyConstraint) ReferencedColumnOrdinal(referencedTable cat.T
 
 // Validated is part of the cat.ForeignKeyConstraint interface.
 func (fk *optForeignKeyConstraint) Validated() bool {
-	return fk.validity == descpb.ConstraintValidity_Validated
+	// A deferrable constraint can be violated until the end of the
+	// transaction, so the optimizer cannot assume that it holds.
+	return fk.validity == descpb.ConstraintValidity_Validated && !fk.deferrable
 }
 
 // MatchMethod is part of the cat.ForeignKeyConstraint interface.
@@ -2384,6 +2464,16 @@ func (fk *optForeignKeyConstraint) UpdateReferenceAction() tree.ReferenceAction
 	return fk.updateAction
 }
 
+// Deferrable is part of the cat.ForeignKeyConstraint interface.
+func (fk *optForeignKeyConstraint) Deferrable() bool {
+	return fk.deferrable
+}
+
+// InitiallyDeferred is part of the cat.ForeignKeyConstraint interface.
+func (fk *optForeignKeyConstraint) InitiallyDeferred() bool {
+	return fk.initiallyDeferred
+}
+
 // optVirtualTable is similar to optTable but is used with virtual tables.
 type optVirtualTable struct {
 	desc catalog.TableDescriptor
@@ -2429,7 +2519,7 @@ func newOptVirtualTable(
 ) (*optVirtualTable, error) {
 	// Calculate the stable ID (see the comment for optVirtualTable.id).
 	id := cat.StableID(desc.GetID())
-	if name.Catalog() != "" {
+	if name.Catalog() != "" && !desc.IsForeignTable() {
 		// TODO(radu): it's unfortunate that we have to lookup the schema again.
 		found, prefix, err := oc.planner.LookupSchema(ctx, name.Catalog(), name.Schema())
 		if err != nil {
@@ -2572,6 +2662,11 @@ func (ot *optVirtualTable) IsVirtualTable() bool {
 	return true
 }
 
+// IsForeignTable is part of the cat.Table interface.
+func (ot *optVirtualTable) IsForeignTable() bool {
+	return ot.desc.IsForeignTable()
+}
+
 // IsSystemTable is part of the cat.Table interface.
 func (ot *optVirtualTable) IsSystemTable() bool {
 	return false
@@ -2733,6 +2828,9 @@ func (ot *optVirtualTable) RegionalByRowUsingConstraint() cat.ForeignKeyConstrai
 
 // GetDatabaseID is part of the cat.Table interface.
 func (ot *optVirtualTable) GetDatabaseID() descpb.ID {
+	if ot.desc.IsForeignTable() {
+		return ot.desc.GetParentID()
+	}
 	return 0
 }
 
@@ -2776,6 +2874,14 @@ func (ot *optVirtualTable) IsRowLevelSecurityForced() bool { return false }
 // Policies is part of the cat.Table interface.
 func (ot *optVirtualTable) Policies() *cat.Policies { return nil }
 
+// InheritingTableCount is part of the cat.Table interface.
+func (ot *optVirtualTable) InheritingTableCount() int { return 0 }
+
+// InheritingTableID is part of the cat.Table interface.
+func (ot *optVirtualTable) InheritingTableID(i int) cat.StableID {
+	panic(errors.AssertionFailedf("no inheriting tables"))
+}
+
 // optVirtualIndex is a dummy implementation of cat.Index for the indexes
 // reported by a virtual table. The index assumes that table column 0 is a dummy
 // PK column.
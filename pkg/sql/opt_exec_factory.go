@@ -115,6 +115,9 @@ func (ef *execFactory) ConstructLiteralValues(
 func (ef *execFactory) ConstructScan(
 	table cat.Table, index cat.Index, params exec.ScanParams, reqOrdering exec.OutputOrdering,
 ) (exec.Node, error) {
+	if table.IsForeignTable() {
+		return ef.constructForeignScan(table, params, reqOrdering)
+	}
 	if table.IsVirtualTable() {
 		return ef.constructVirtualScan(table, index, params, reqOrdering)
 	}
@@ -158,6 +161,9 @@ func (ef *execFactory) ConstructScan(
 	scan.lockingWaitPolicy = descpb.ToScanLockingWaitPolicy(params.Locking.WaitPolicy)
 	scan.lockingDurability = descpb.ToScanLockingDurability(params.Locking.Durability)
 	scan.localityOptimized = params.LocalityOptimized
+	scan.sampleMethod = params.SampleMethod
+	scan.sampleProbability = params.SampleProbability
+	scan.sampleSeed = params.SampleSeed
 	if !ef.isExplain && !ef.planner.SessionData().Internal {
 		idxUsageKey := roachpb.IndexUsageKey{
 			TableID: roachpb.TableID(tabDesc.GetID()),
@@ -200,6 +206,56 @@ func (ef *execFactory) constructVirtualScan(
 	)
 }
 
+func (ef *execFactory) constructForeignScan(
+	table cat.Table, params exec.ScanParams, reqOrdering exec.OutputOrdering,
+) (exec.Node, error) {
+	// Check for explicit use of the dummy column.
+	if params.NeededCols.Contains(0) {
+		return nil, errors.Errorf("use of %s column not allowed.", table.Column(0).ColName())
+	}
+	if !params.Locking.IsNoOp() {
+		// We shouldn't have allowed SELECT FOR UPDATE for a foreign table.
+		return nil, errors.AssertionFailedf("locking cannot be used with foreign table")
+	}
+	desc := table.(*optVirtualTable).desc
+	publicCols := desc.PublicColumns()
+	n := &foreignScanNode{
+		desc:       desc,
+		neededCols: make([]int, 0, params.NeededCols.Len()),
+		columns:    make(colinfo.ResultColumns, 0, params.NeededCols.Len()),
+	}
+	// The ordinals of the optimizer are shifted by one because of the dummy
+	// PK column.
+	for ord, ok := params.NeededCols.Next(1); ok; ord, ok = params.NeededCols.Next(ord + 1) {
+		col := publicCols[ord-1]
+		n.neededCols = append(n.neededCols, ord-1)
+		n.columns = append(n.columns, colinfo.ResultColumn{
+			Name:           col.GetName(),
+			Typ:            col.GetType(),
+			Hidden:         col.IsHidden(),
+			TableID:        desc.GetID(),
+			PGAttributeNum: uint32(col.GetPGAttributeNum()),
+		})
+	}
+	var res exec.Node = n
+	var err error
+	if params.HardLimit != 0 {
+		res, err = ef.ConstructLimit(res, tree.NewDInt(tree.DInt(params.HardLimit)), nil /* offset */)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The files of a foreign table are not ordered, so we have to sort if we
+	// have a required ordering.
+	if len(reqOrdering) != 0 {
+		res, err = ef.ConstructSort(res, reqOrdering, 0 /* alreadyOrderedPrefix */, 0 /* estimatedInputRowCount */)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 // ConstructFilter is part of the exec.Factory interface.
 func (ef *execFactory) ConstructFilter(
 	n exec.Node, filter tree.TypedExpr, reqOrdering exec.OutputOrdering,
@@ -538,6 +594,50 @@ func (ef *execFactory) ConstructGroupBy(
 	return n, nil
 }
 
+// ConstructGroupingSets is part of the exec.Factory interface.
+func (ef *execFactory) ConstructGroupingSets(
+	input exec.Node,
+	groupCols []exec.NodeColumnOrdinal,
+	sets [][]exec.NodeColumnOrdinal,
+	groupingFuncs [][]exec.NodeColumnOrdinal,
+	aggregations []exec.AggInfo,
+	estimatedRowCount uint64,
+	estimatedInputRowCount uint64,
+) (exec.Node, error) {
+	inputPlan := input.(planNode)
+	inputCols := planColumns(inputPlan)
+	columns := getResultColumnsForGroupBy(inputCols, groupCols, aggregations)
+	for range groupingFuncs {
+		columns = append(columns, colinfo.ResultColumn{Name: "grouping", Typ: types.Int})
+	}
+	n := &groupNode{
+		singleInputPlanNode:    singleInputPlanNode{inputPlan},
+		funcs:                  make([]*aggregateFuncHolder, 0, len(groupCols)+len(aggregations)),
+		columns:                columns,
+		groupCols:              groupCols,
+		groupingSets:           sets,
+		groupingFuncs:          groupingFuncs,
+		estimatedRowCount:      estimatedRowCount,
+		estimatedInputRowCount: estimatedInputRowCount,
+	}
+	for _, col := range n.groupCols {
+		// The aggregators replace the arguments of these functions with the
+		// grouping columns of each grouping set.
+		f := newAggregateFuncHolder(
+			builtins.AnyNotNull,
+			[]exec.NodeColumnOrdinal{col},
+			nil,   /* arguments */
+			false, /* isDistinct */
+			false, /* distsqlBlocklist */
+		)
+		n.funcs = append(n.funcs, f)
+	}
+	if err := ef.addAggregations(n, aggregations); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 func (ef *execFactory) addAggregations(n *groupNode, aggregations []exec.AggInfo) error {
 	for i := range aggregations {
 		agg := &aggregations[i]
@@ -550,6 +650,8 @@ func (ef *execFactory) addAggregations(n *groupNode, aggregations []exec.AggInfo
 			agg.DistsqlBlocklist,
 		)
 		f.filterRenderIdx = int(agg.Filter)
+		f.userDefined = agg.UserDefined
+		f.resultType = agg.ResultType
 
 		n.funcs = append(n.funcs, f)
 	}
@@ -729,6 +831,7 @@ func (ef *execFactory) ConstructLookupJoin(
 	remoteOnlyLookups bool,
 	reverseScans bool,
 	parallelize bool,
+	estimatedTableRowCount uint64,
 ) (exec.Node, error) {
 	if table.IsVirtualTable() {
 		return constructVirtualTableLookupJoin(
@@ -773,6 +876,7 @@ func (ef *execFactory) ConstructLookupJoin(
 			remoteOnlyLookups:          remoteOnlyLookups,
 			reverseScans:               reverseScans,
 			parallelize:                parallelize,
+			estimatedTableRowCount:     estimatedTableRowCount,
 		},
 	}
 	if onCond != tree.DBoolTrue {
@@ -1718,6 +1822,7 @@ func (ef *execFactory) ConstructUpsert(
 	arbiterIndexes cat.IndexOrdinals,
 	arbiterConstraints cat.UniqueOrdinals,
 	canaryCol exec.NodeColumnOrdinal,
+	deleteCol exec.NodeColumnOrdinal,
 	insertColOrdSet exec.TableColumnOrdinalSet,
 	fetchColOrdSet exec.TableColumnOrdinalSet,
 	updateColOrdSet exec.TableColumnOrdinalSet,
@@ -1766,6 +1871,21 @@ func (ef *execFactory) ConstructUpsert(
 		return nil, err
 	}
 
+	// Create the table deleter if existing rows can be deleted, which is only
+	// the case for MERGE statements with DELETE actions.
+	var rd row.Deleter
+	if deleteCol != -1 {
+		rd = row.MakeDeleter(
+			ef.planner.ExecCfg().Codec,
+			tabDesc,
+			lockIdxs,
+			fetchCols,
+			ef.planner.SessionData(),
+			&ef.planner.ExecCfg().Settings.SV,
+			ef.planner.ExecCfg().GetRowMetrics(ef.planner.SessionData().Internal),
+		)
+	}
+
 	// Instantiate the upsert node.
 	ups := upsertNodePool.Get().(*upsertNode)
 	*ups = upsertNode{
@@ -1776,9 +1896,11 @@ func (ef *execFactory) ConstructUpsert(
 			tw: tableUpserter{
 				ri:            ri,
 				canaryOrdinal: int(canaryCol),
+				deleteOrdinal: int(deleteCol),
 				fetchCols:     fetchCols,
 				updateCols:    updateCols,
 				ru:            ru,
+				rd:            rd,
 			},
 		},
 	}
@@ -104,6 +104,10 @@ func TestContextualHelp(t *testing.T) {
 		{`ALTER TYPE t RENAME ??`, `ALTER TYPE`},
 		{`ALTER TYPE t DROP VALUE ??`, `ALTER TYPE`},
 
+		{`ALTER DOMAIN ??`, `ALTER DOMAIN`},
+		{`ALTER DOMAIN d SET ??`, `ALTER DOMAIN`},
+		{`ALTER DOMAIN d ADD ??`, `ALTER DOMAIN`},
+
 		{`ALTER INDEX foo@bar RENAME ??`, `ALTER INDEX`},
 		{`ALTER INDEX foo@bar RENAME TO blih ??`, `ALTER INDEX`},
 		{`ALTER INDEX foo@bar SPLIT ??`, `ALTER INDEX`},
@@ -180,6 +184,16 @@ func TestContextualHelp(t *testing.T) {
 
 		{`CREATE EXTERNAL CONNECTION ??`, `CREATE EXTERNAL CONNECTION`},
 
+		{`CREATE SERVER ??`, `CREATE SERVER`},
+		{`CREATE SERVER s FOREIGN DATA WRAPPER ??`, `CREATE SERVER`},
+		{`DROP SERVER ??`, `DROP SERVER`},
+
+		{`CREATE PUBLICATION ??`, `CREATE PUBLICATION`},
+		{`DROP PUBLICATION ??`, `DROP PUBLICATION`},
+
+		{`CREATE FOREIGN TABLE ??`, `CREATE FOREIGN TABLE`},
+		{`CREATE FOREIGN TABLE t (a INT) SERVER ??`, `CREATE FOREIGN TABLE`},
+
 		{`CREATE VIRTUAL CLUSTER ??`, `CREATE VIRTUAL CLUSTER`},
 		{`CREATE TENANT ??`, `CREATE VIRTUAL CLUSTER`},
 
@@ -210,6 +224,9 @@ func TestContextualHelp(t *testing.T) {
 
 		{`CREATE TYPE blah AS ENUM ??`, `CREATE TYPE`},
 		{`DROP TYPE ??`, `DROP TYPE`},
+		{`CREATE DOMAIN ??`, `CREATE DOMAIN`},
+		{`CREATE DOMAIN d AS INT CHECK ??`, `CREATE DOMAIN`},
+		{`DROP DOMAIN ??`, `DROP DOMAIN`},
 
 		{`CREATE SCHEMA IF ??`, `CREATE SCHEMA`},
 		{`CREATE SCHEMA IF NOT ??`, `CREATE SCHEMA`},
@@ -307,6 +324,13 @@ func TestContextualHelp(t *testing.T) {
 		{`MOVE ??`, `MOVE`},
 		{`MOVE 1 ??`, `MOVE`},
 
+		{`LISTEN ??`, `LISTEN`},
+		{`LOCK ??`, `LOCK`},
+		{`LOCK TABLE t IN ??`, `LOCK`},
+		{`NOTIFY ??`, `NOTIFY`},
+		{`NOTIFY foo, ??`, `NOTIFY`},
+		{`UNLISTEN ??`, `UNLISTEN`},
+
 		{`INSERT INTO ??`, `INSERT`},
 		{`INSERT INTO blah (??`, `<SELECTCLAUSE>`},
 		{`INSERT INTO blah VALUES (1) RETURNING ??`, `INSERT`},
@@ -327,6 +351,10 @@ func TestContextualHelp(t *testing.T) {
 		{`UPDATE blah SET x = 3 ??`, `UPDATE`},
 		{`UPDATE blah SET x = 3 WHERE ??`, `UPDATE`},
 
+		{`MERGE ??`, `MERGE`},
+		{`MERGE INTO blah USING foo ON ??`, `MERGE`},
+		{`MERGE INTO blah USING foo ON true WHEN MATCHED THEN ??`, `MERGE`},
+
 		{`GRANT ALL ??`, `GRANT`},
 		{`GRANT ALL ON foo TO ??`, `GRANT`},
 		{`GRANT ALL ON foo TO bar ??`, `GRANT`},
@@ -381,6 +409,9 @@ func TestContextualHelp(t *testing.T) {
 
 		{`SHOW HISTOGRAM ??`, `SHOW HISTOGRAM`},
 
+		{`SHOW PLAN BASELINES ??`, `SHOW PLAN BASELINES`},
+		{`SHOW PLAN BASELINES FOR ??`, `SHOW PLAN BASELINES`},
+
 		{`SHOW QUERIES ??`, `SHOW STATEMENTS`},
 		{`SHOW LOCAL QUERIES ??`, `SHOW STATEMENTS`},
 
@@ -546,6 +577,9 @@ func TestContextualHelp(t *testing.T) {
 		{`SET LOCAL TIME ??`, `SET LOCAL`},
 		{`SET LOCAL TIME ZONE 'UTC' ??`, `SET LOCAL`},
 
+		{`SET CONSTRAINTS ??`, `SET CONSTRAINTS`},
+		{`SET CONSTRAINTS ALL ??`, `SET CONSTRAINTS`},
+
 		{`SET TRANSACTION ??`, `SET TRANSACTION`},
 		{`SET TRANSACTION ISOLATION LEVEL SNAPSHOT ??`, `SET TRANSACTION`},
 		{`SET TIME ??`, `SET SESSION`},
@@ -612,6 +646,10 @@ func TestContextualHelp(t *testing.T) {
 		{`ALTER PROCEDURE ??`, `ALTER PROCEDURE`},
 		{`DROP PROCEDURE ??`, `DROP PROCEDURE`},
 
+		{`CREATE AGGREGATE ??`, `CREATE AGGREGATE`},
+		{`ALTER AGGREGATE ??`, `ALTER AGGREGATE`},
+		{`DROP AGGREGATE ??`, `DROP AGGREGATE`},
+
 		{`CREATE TRIGGER ??`, `CREATE TRIGGER`},
 		{`CREATE TRIGGER foo ??`, `CREATE TRIGGER`},
 		{`CREATE TRIGGER foo AFTER INSERT ON bar ??`, `CREATE TRIGGER`},
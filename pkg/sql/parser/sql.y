@@ -316,6 +316,12 @@ func (u *sqlSymUnion) enumValueList() tree.EnumValueList {
 func (u *sqlSymUnion) compositeTypeList() []tree.CompositeTypeElem {
     return u.val.([]tree.CompositeTypeElem)
 }
+func (u *sqlSymUnion) domainConstraint() tree.DomainConstraint {
+    return u.val.(tree.DomainConstraint)
+}
+func (u *sqlSymUnion) domainConstraints() tree.DomainConstraints {
+    return u.val.(tree.DomainConstraints)
+}
 func (u *sqlSymUnion) unresolvedName() *tree.UnresolvedName {
     return u.val.(*tree.UnresolvedName)
 }
@@ -334,6 +340,9 @@ func (u *sqlSymUnion) tableNames() tree.TableNames {
 func (u *sqlSymUnion) indexFlags() *tree.IndexFlags {
     return u.val.(*tree.IndexFlags)
 }
+func (u *sqlSymUnion) tableSample() *tree.TableSample {
+    return u.val.(*tree.TableSample)
+}
 func (u *sqlSymUnion) arraySubscript() *tree.ArraySubscript {
     return u.val.(*tree.ArraySubscript)
 }
@@ -532,6 +541,15 @@ func (u *sqlSymUnion) lockingStrength() tree.LockingStrength {
 func (u *sqlSymUnion) lockingWaitPolicy() tree.LockingWaitPolicy {
     return u.val.(tree.LockingWaitPolicy)
 }
+func (u *sqlSymUnion) lockTableMode() tree.LockTableMode {
+    return u.val.(tree.LockTableMode)
+}
+func (u *sqlSymUnion) mergeWhen() *tree.MergeWhen {
+    return u.val.(*tree.MergeWhen)
+}
+func (u *sqlSymUnion) mergeWhens() tree.MergeWhens {
+    return u.val.(tree.MergeWhens)
+}
 func (u *sqlSymUnion) updateExpr() *tree.UpdateExpr {
     return u.val.(*tree.UpdateExpr)
 }
@@ -766,6 +784,9 @@ func (u *sqlSymUnion) transactionModes() tree.TransactionModes {
 func (u *sqlSymUnion) compositeKeyMatchMethod() tree.CompositeKeyMatchMethod {
   return u.val.(tree.CompositeKeyMatchMethod)
 }
+func (u *sqlSymUnion) constraintDeferrability() tree.ConstraintDeferrability {
+  return u.val.(tree.ConstraintDeferrability)
+}
 func (u *sqlSymUnion) referenceAction() tree.ReferenceAction {
     return u.val.(tree.ReferenceAction)
 }
@@ -887,6 +908,12 @@ func (u *sqlSymUnion) routineParams() tree.RoutineParams {
 func (u *sqlSymUnion) routineParam() tree.RoutineParam {
     return u.val.(tree.RoutineParam)
 }
+func (u *sqlSymUnion) aggregateOptions() tree.AggregateOptions {
+    return u.val.(tree.AggregateOptions)
+}
+func (u *sqlSymUnion) aggregateOption() tree.AggregateOption {
+    return u.val.(tree.AggregateOption)
+}
 func (u *sqlSymUnion) routineParamClass() tree.RoutineParamClass {
     return u.val.(tree.RoutineParamClass)
 }
@@ -995,7 +1022,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> ALL ALTER ALWAYS ANALYSE ANALYZE AND AND_AND ANY ANNOTATE_TYPE ARRAY AS ASC AS_JSON AT_AT
 %token <str> ASENSITIVE ASYMMETRIC AT ATOMIC ATTRIBUTE AUTHORIZATION AUTOMATIC AVAILABILITY AVOID_FULL_SCAN
 
-%token <str> BACKUP BACKUPS BACKWARD BATCH BEFORE BEGIN BETWEEN BIDIRECTIONAL BIGINT BIGSERIAL BINARY BIT
+%token <str> BACKUP BACKUPS BACKWARD BASELINES BATCH BEFORE BEGIN BETWEEN BIDIRECTIONAL BIGINT BIGSERIAL BINARY BIT
 %token <str> BUCKET_COUNT
 %token <str> BOOLEAN BOTH BOX2D BY BYPASSRLS
 
@@ -1014,7 +1041,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> DISABLE DISCARD DISTANCE DISTINCT DO DOMAIN DOUBLE DROP
 
 %token <str> EACH ELSE ENABLE ENCODING ENCRYPTED ENCRYPTION_INFO_DIR ENCRYPTION_PASSPHRASE END ENUM ENUMS ERRORS ESCAPE
-%token <str> EXCEPT EXCLUDE EXCLUDING EXISTS EXECUTE EXECUTION EXPERIMENTAL
+%token <str> EXCEPT EXCLUDE EXCLUDING EXCLUSIVE EXISTS EXECUTE EXECUTION EXPERIMENTAL
 %token <str> EXPERIMENTAL_FINGERPRINTS EXPERIMENTAL_REPLICA
 %token <str> EXPERIMENTAL_AUDIT EXPERIMENTAL_RELOCATE
 %token <str> EXPIRATION EXPLAIN EXPORT EXTENSION EXTERNAL EXTRACT EXTRACT_DURATION EXTREMES
@@ -1035,7 +1062,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> IF IFERROR IFNULL IGNORE_FOREIGN_KEYS ILIKE IMMEDIATE IMMEDIATELY IMMUTABLE IMPORT IN INCLUDE
 %token <str> INCLUDING INCLUDE_ALL_SECONDARY_TENANTS INCLUDE_ALL_VIRTUAL_CLUSTERS INCREMENT INCREMENTAL INCREMENTAL_LOCATION
 %token <str> INET INET_CONTAINED_BY_OR_EQUALS
-%token <str> INET_CONTAINS_OR_EQUALS INDEX INDEXES INHERITS INJECT INITIALLY
+%token <str> INET_CONTAINS_OR_EQUALS INDEX INDEXES INHERIT INHERITS INJECT INITIALLY
 %token <str> INDEX_BEFORE_PAREN INDEX_BEFORE_NAME_THEN_PAREN INDEX_AFTER_ORDER_BY_BEFORE_AT
 %token <str> INNER INOUT INPUT INSENSITIVE INSERT INSPECT INSTEAD INT INTEGER
 %token <str> INTERSECT INTERVAL INTO INTO_DB INVERTED INVOKER IS ISERROR ISNULL ISOLATION
@@ -1047,9 +1074,9 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> LABEL LANGUAGE LAST LATERAL LATEST LC_CTYPE LC_COLLATE
 %token <str> LEADING LEASE LEAST LEAKPROOF LEFT LESS LEVEL LIKE LIMIT
 %token <str> LINESTRING LINESTRINGM LINESTRINGZ LINESTRINGZM
-%token <str> LIST LOCAL LOCALITY LOCALTIME LOCALTIMESTAMP LOCKED LOGGED LOGICAL LOGICALLY LOGIN LOOKUP LOW LSHIFT
+%token <str> LIST LISTEN LOCAL LOCALITY LOCALTIME LOCALTIMESTAMP LOCK LOCKED LOGGED LOGICAL LOGICALLY LOGIN LOOKUP LOW LSHIFT
 
-%token <str> MATCH MATERIALIZED MERGE MINVALUE MAXVALUE METHOD MINUTE MODIFYCLUSTERSETTING MODE MONTH MOVE
+%token <str> MATCH MATCHED MATERIALIZED MERGE MINVALUE MAXVALUE METHOD MINUTE MODIFYCLUSTERSETTING MODE MONTH MOVE
 %token <str> MULTILINESTRING MULTILINESTRINGM MULTILINESTRINGZ MULTILINESTRINGZM
 %token <str> MULTIPOINT MULTIPOINTM MULTIPOINTZ MULTIPOINTZM
 %token <str> MULTIPOLYGON MULTIPOLYGONM MULTIPOLYGONZ MULTIPOLYGONZM
@@ -1057,7 +1084,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> NAN NAME NAMES NATURAL NEG_INNER_PRODUCT NEVER NEW NEW_DB_NAME NEW_KMS NEXT NO NOBYPASSRLS NOCANCELQUERY NOCONTROLCHANGEFEED
 %token <str> NOCONTROLJOB NOCREATEDB NOCREATELOGIN NOCREATEROLE NODE NOLOGIN NOMODIFYCLUSTERSETTING NOREPLICATION
 %token <str> NOSQLLOGIN NO_INDEX_JOIN NO_ZIGZAG_JOIN NO_FULL_SCAN NONE NONVOTERS NORMAL NOT
-%token <str> NOTHING NOTHING_AFTER_RETURNING
+%token <str> NOTHING NOTHING_AFTER_RETURNING NOTIFY
 %token <str> NOTNULL
 %token <str> NOVIEWACTIVITY NOVIEWACTIVITYREDACTED NOVIEWCLUSTERSETTING NOWAIT NULL NULLIF NULLS NUMERIC
 
@@ -1086,7 +1113,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> STABLE START STATE STATEMENT STATISTICS STATUS STDIN STDOUT STOP STRAIGHT STREAM STRICT STRING STORAGE STORE STORED STORING SUBJECT SUBSTRING SUPER
 %token <str> SUPPORT SURVIVE SURVIVAL SYMMETRIC SYNTAX SYSTEM SQRT SUBSCRIPTION STATEMENTS
 
-%token <str> TABLE TABLES TABLESPACE TEMP TEMPLATE TEMPORARY TENANT TENANT_NAME TENANTS TESTING_RELOCATE TEXT THEN
+%token <str> TABLE TABLES TABLESAMPLE TABLESPACE TEMP TEMPLATE TEMPORARY TENANT TENANT_NAME TENANTS TESTING_RELOCATE TEXT THEN
 %token <str> TIES TIME TIMETZ TIMESTAMP TIMESTAMPTZ TO THROTTLING TRAILING TRACE
 %token <str> TRANSACTION TRANSACTIONS TRANSFER TRANSFORM TREAT TRIGGER TRIGGERS TRIM TRUE
 %token <str> TRUNCATE TRUSTED TYPE TYPES
@@ -1099,7 +1126,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %token <str> VIEWCLUSTERSETTING VIRTUAL VISIBLE INVISIBLE VISIBILITY VOLATILE VOTERS
 %token <str> VIRTUAL_CLUSTER_NAME VIRTUAL_CLUSTER
 
-%token <str> WATCHED_TABLES WHEN WHERE WINDOW WITH WITHIN WITHOUT WORK WRITE
+%token <str> WATCHED_TABLES WHEN WHERE WINDOW WITH WITHIN WITHOUT WORK WRAPPER WRITE
 
 %token <str> YEAR
 
@@ -1153,10 +1180,11 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Statement> alter_role_stmt
 %type <*tree.SetVar> set_or_reset_clause
 %type <tree.Statement> alter_type_stmt
+%type <tree.Statement> alter_domain_stmt
 %type <tree.Statement> alter_schema_stmt
-%type <tree.Statement> alter_unsupported_stmt
 %type <tree.Statement> alter_func_stmt
 %type <tree.Statement> alter_proc_stmt
+%type <tree.Statement> alter_aggregate_stmt
 %type <tree.Statement> alter_policy_stmt
 
 // ALTER RANGE
@@ -1305,6 +1333,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Statement> create_sequence_stmt
 %type <tree.Statement> create_func_stmt
 %type <tree.Statement> create_proc_stmt
+%type <tree.Statement> create_aggregate_stmt
 %type <tree.Statement> create_trigger_stmt
 %type <tree.Statement> create_policy_stmt
 
@@ -1321,6 +1350,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.CheckExternalConnectionOptions> opt_with_check_external_connection_options_list check_external_connection_options_list check_external_connection_options
 
 %type <tree.Statement> create_type_stmt
+%type <tree.Statement> create_domain_stmt
 %type <tree.Statement> delete_stmt
 %type <tree.Statement> discard_stmt
 
@@ -1328,16 +1358,25 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Statement> drop_ddl_stmt
 %type <tree.Statement> drop_database_stmt
 %type <tree.Statement> drop_external_connection_stmt
+%type <tree.Statement> create_server_stmt
+%type <tree.Statement> create_publication_stmt
+%type <tree.Statement> drop_server_stmt
+%type <tree.Statement> drop_publication_stmt
+%type <tree.Statement> create_foreign_table_stmt
+%type <[]tree.KVOption> opt_foreign_options foreign_option_list
+%type <tree.KVOption> foreign_option
 %type <tree.Statement> drop_index_stmt
 %type <tree.Statement> drop_role_stmt
 %type <tree.Statement> drop_schema_stmt
 %type <tree.Statement> drop_table_stmt
 %type <tree.Statement> drop_type_stmt
+%type <tree.Statement> drop_domain_stmt
 %type <tree.Statement> drop_view_stmt
 %type <tree.Statement> drop_sequence_stmt
 %type <tree.Statement> drop_func_stmt
 %type <tree.Statement> drop_policy_stmt
 %type <tree.Statement> drop_proc_stmt
+%type <tree.Statement> drop_aggregate_stmt
 %type <tree.Statement> drop_trigger_stmt
 %type <tree.Statement> drop_virtual_cluster_stmt
 %type <bool>           opt_immediate
@@ -1381,6 +1420,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Statement> set_session_stmt
 %type <tree.Statement> set_csetting_stmt set_or_reset_csetting_stmt
 %type <tree.Statement> set_transaction_stmt
+%type <tree.Statement> set_constraints_stmt
 %type <tree.Statement> set_exprs_internal
 %type <tree.Statement> generic_set
 %type <tree.Statement> set_rest_more
@@ -1410,6 +1450,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Statement> show_histogram_stmt
 %type <tree.Statement> show_indexes_stmt
 %type <tree.Statement> show_partitions_stmt
+%type <tree.Statement> show_plan_baselines_stmt
 %type <tree.Statement> show_jobs_stmt
 %type <tree.Statement> show_statements_stmt
 %type <tree.Statement> show_ranges_stmt
@@ -1454,6 +1495,10 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Statement> transaction_stmt legacy_transaction_stmt legacy_begin_stmt legacy_end_stmt
 %type <tree.Statement> truncate_stmt
 %type <tree.Statement> unlisten_stmt
+%type <tree.Statement> listen_stmt
+%type <tree.Statement> lock_table_stmt
+%type <tree.Statement> merge_stmt
+%type <tree.Statement> notify_stmt
 %type <tree.Statement> update_stmt
 %type <tree.Statement> upsert_stmt
 %type <tree.Statement> use_stmt
@@ -1492,6 +1537,8 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.LockingItem> for_locking_item
 %type <tree.LockingStrength> for_locking_strength
 %type <tree.LockingWaitPolicy> opt_nowait_or_skip
+%type <tree.LockTableMode> opt_lock_table_mode lock_table_mode
+%type <bool> opt_nowait
 %type <tree.SelectStatement> set_operation
 
 %type <tree.Expr> alter_column_default
@@ -1558,7 +1605,8 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.TableIndexName> table_index_name
 %type <tree.TableIndexNames> table_index_name_list
 
-%type <tree.Operator> all_op qual_op operator_op
+%type <tree.Operator> all_op qual_op operator_op exclude_op
+%type <tree.Expr> opt_exclude_where
 
 %type <tree.IsolationLevel> iso_level
 %type <tree.UserPriority> user_priority
@@ -1571,14 +1619,13 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.PartitionByTable> opt_partition_by_table partition_by_table
 %type <*tree.PartitionByIndex> opt_partition_by_index partition_by_index
 %type <str> partition opt_partition
-%type <str> opt_create_table_inherits
 %type <tree.ListPartition> list_partition
 %type <[]tree.ListPartition> list_partitions
 %type <tree.RangePartition> range_partition
 %type <[]tree.RangePartition> range_partitions
 %type <empty> opt_all_clause
 %type <empty> opt_privileges_clause
-%type <bool> distinct_clause opt_with_data
+%type <bool> distinct_clause opt_with_data opt_view_recursive opt_create_as_data
 %type <tree.DistinctOn> distinct_on_clause
 %type <tree.NameList> opt_column_list insert_column_list opt_stats_columns query_stats_cols
 // Note that "no index" variants exist to disable custom ORDER BY <index> syntax
@@ -1594,7 +1641,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.From> from_clause
 %type <tree.TableExprs> from_list rowsfrom_list opt_from_list
 %type <tree.TablePatterns> table_pattern_list
-%type <tree.TableNames> db_object_name_list table_name_list view_name_list sequence_name_list opt_locked_rels
+%type <tree.TableNames> db_object_name_list table_name_list view_name_list sequence_name_list opt_locked_rels opt_create_table_inherits
 %type <tree.Exprs> expr_list opt_expr_list tuple1_ambiguous_values tuple1_unambiguous_values
 %type <*tree.Tuple> expr_tuple1_ambiguous expr_tuple_unambiguous
 %type <tree.NameList> attrs
@@ -1602,6 +1649,8 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.SelectExprs> opt_target_list target_list
 %type <tree.UpdateExprs> set_clause_list
 %type <*tree.UpdateExpr> set_clause multiple_set_clause
+%type <tree.MergeWhens> merge_when_list
+%type <*tree.MergeWhen> merge_when_clause merge_when_matched_action merge_when_not_matched_action
 %type <tree.ArraySubscripts> array_subscripts
 %type <tree.GroupBy> group_clause
 %type <tree.Exprs> group_by_list
@@ -1664,6 +1713,8 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <*tree.ArraySubscript> array_subscript
 %type <tree.Expr> opt_slice_bound
 %type <*tree.IndexFlags> opt_index_flags
+%type <*tree.TableSample> opt_tablesample_clause
+%type <tree.Expr> opt_repeatable_clause
 %type <*tree.IndexFlags> index_flags_param
 %type <*tree.IndexFlags> index_flags_param_list
 %type <tree.Expr> a_expr b_expr c_expr d_expr typed_literal
@@ -1689,6 +1740,7 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.Expr> rowsfrom_item
 %type <tree.TableExpr> joined_table
 %type <*tree.UnresolvedObjectName> relation_expr
+%type <tree.TableExpr> inheritable_relation_expr
 %type <tree.TableExpr> table_expr_opt_alias_idx table_name_opt_idx
 %type <bool> opt_only opt_descendant
 %type <tree.SelectExpr> target_elem
@@ -1700,6 +1752,9 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <str> explain_option_name
 %type <[]string> explain_option_list opt_enum_val_list enum_val_list
 %type <[]tree.CompositeTypeElem> composite_type_list opt_composite_type_list
+%type <tree.DomainConstraint> domain_constraint domain_constraint_elem
+%type <tree.DomainConstraints> opt_domain_constraint_list domain_constraint_list
+%type <tree.Expr> opt_domain_default
 
 %type <tree.ResolvableTypeReference> typename simple_typename cast_target
 %type <*types.T> const_typename
@@ -1743,12 +1798,15 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <str> general_type_name
 
 %type <tree.ConstraintTableDef> table_constraint constraint_elem create_as_constraint_def create_as_constraint_elem
+%type <tree.ConstraintTableDef> exclude_elem exclude_elem_list
 %type <tree.TableDef> index_def
 %type <tree.TableDef> family_def
 %type <[]tree.NamedColumnQualification> col_qual_list create_as_col_qual_list
 %type <tree.NamedColumnQualification> col_qualification create_as_col_qualification
 %type <tree.ColumnQualification> col_qualification_elem create_as_col_qualification_elem
 %type <tree.CompositeKeyMatchMethod> key_match
+%type <tree.ConstraintDeferrability> opt_deferrable
+%type <bool> constraints_set_mode
 %type <tree.ReferenceActions> reference_actions
 %type <tree.ReferenceAction> reference_action reference_on_delete reference_on_update
 
@@ -1825,6 +1883,8 @@ func (u *sqlSymUnion) filterType() tree.FilterType {
 %type <tree.RoutineOptions> opt_create_routine_opt_list create_routine_opt_list alter_func_opt_list
 %type <tree.RoutineOption> create_routine_opt_item common_routine_opt_item
 %type <tree.RoutineParamClass> routine_param_class
+%type <tree.AggregateOptions> aggregate_opt_list
+%type <tree.AggregateOption> aggregate_opt_item
 %type <*tree.UnresolvedObjectName> routine_create_name
 %type <tree.DoBlockOptions> do_stmt_opt_list
 %type <tree.DoBlockOption> do_stmt_opt_item
@@ -1962,7 +2022,10 @@ stmt_without_legacy_transaction:
 | fetch_cursor_stmt          // EXTEND WITH HELP: FETCH
 | move_cursor_stmt           // EXTEND WITH HELP: MOVE
 | reindex_stmt
-| unlisten_stmt
+| listen_stmt                // EXTEND WITH HELP: LISTEN
+| lock_table_stmt            // EXTEND WITH HELP: LOCK
+| notify_stmt                // EXTEND WITH HELP: NOTIFY
+| unlisten_stmt              // EXTEND WITH HELP: UNLISTEN
 | show_commit_timestamp_stmt // EXTEND WITH HELP: SHOW COMMIT TIMESTAMP
 
 // %Help: ALTER
@@ -1973,7 +2036,6 @@ alter_stmt:
 | alter_external_connection_stmt // EXTEND WITH HELP: ALTER EXTERNAL CONNECTION
 | alter_role_stmt     // EXTEND WITH HELP: ALTER ROLE
 | alter_virtual_cluster_stmt   /* SKIP DOC */
-| alter_unsupported_stmt
 | ALTER error         // SHOW HELP: ALTER
 
 alter_ddl_stmt:
@@ -1986,11 +2048,13 @@ alter_ddl_stmt:
 | alter_partition_stmt          // EXTEND WITH HELP: ALTER PARTITION
 | alter_schema_stmt             // EXTEND WITH HELP: ALTER SCHEMA
 | alter_type_stmt               // EXTEND WITH HELP: ALTER TYPE
+| alter_domain_stmt             // EXTEND WITH HELP: ALTER DOMAIN
 | alter_default_privileges_stmt // EXTEND WITH HELP: ALTER DEFAULT PRIVILEGES
 | alter_changefeed_stmt         // EXTEND WITH HELP: ALTER CHANGEFEED
 | alter_backup_stmt             // EXTEND WITH HELP: ALTER BACKUP
 | alter_func_stmt               // EXTEND WITH HELP: ALTER FUNCTION
 | alter_proc_stmt               // EXTEND WITH HELP: ALTER PROCEDURE
+| alter_aggregate_stmt          // EXTEND WITH HELP: ALTER AGGREGATE
 | alter_backup_schedule  // EXTEND WITH HELP: ALTER BACKUP SCHEDULE
 | alter_policy_stmt             // EXTEND WITH HELP: ALTER POLICY
 | alter_job_stmt                // EXTEND WITH HELP: ALTER JOB
@@ -2212,6 +2276,32 @@ alter_proc_stmt:
 | alter_proc_set_schema_stmt
 | ALTER PROCEDURE error // SHOW HELP: ALTER PROCEDURE
 
+// %Help: ALTER AGGREGATE - change the definition of an aggregate function
+// %Category: DDL
+// %Text:
+// ALTER AGGREGATE name ( [ [ argmode ] [ argname ] argtype [, ...] ] )
+//    RENAME TO new_name
+// ALTER AGGREGATE name ( [ [ argmode ] [ argname ] argtype [, ...] ] )
+//    OWNER TO { new_owner | CURRENT_USER | SESSION_USER }
+// ALTER AGGREGATE name ( [ [ argmode ] [ argname ] argtype [, ...] ] )
+//    SET SCHEMA new_schema
+//
+// %SeeAlso: CREATE AGGREGATE, DROP AGGREGATE
+alter_aggregate_stmt:
+  ALTER AGGREGATE function_with_paramtypes RENAME TO name
+  {
+    $$.val = &tree.AlterRoutineRename{Function: $3.functionObj(), NewName: tree.Name($6), Aggregate: true}
+  }
+| ALTER AGGREGATE function_with_paramtypes OWNER TO role_spec
+  {
+    $$.val = &tree.AlterRoutineSetOwner{Function: $3.functionObj(), NewOwner: $6.roleSpec(), Aggregate: true}
+  }
+| ALTER AGGREGATE function_with_paramtypes SET SCHEMA schema_name
+  {
+    $$.val = &tree.AlterRoutineSetSchema{Function: $3.functionObj(), NewSchemaName: tree.Name($6), Aggregate: true}
+  }
+| ALTER AGGREGATE error // SHOW HELP: ALTER AGGREGATE
+
 // ALTER DATABASE has its error help token here because the ALTER DATABASE
 // prefix is spread over multiple non-terminals.
 | ALTER DATABASE error // SHOW HELP: ALTER DATABASE
@@ -3027,17 +3117,15 @@ alter_table_cmd:
   }
   // ALTER TABLE <name> ALTER CONSTRAINT ...
 | ALTER CONSTRAINT constraint_name error { return unimplementedWithIssueDetail(sqllex, 31632, "alter constraint") }
-  // ALTER TABLE <name> INHERITS ....
-| INHERITS error
+  // ALTER TABLE <name> INHERIT <parent>
+| INHERIT table_name
   {
-    /* SKIP DOC */
-    return unimplementedWithIssueDetail(sqllex, 22456, "alter table inherits")
+    $$.val = &tree.AlterTableInherit{Parent: $2.unresolvedObjectName()}
   }
-  // ALTER TABLE <name> NO INHERITS ....
-| NO INHERITS error
+  // ALTER TABLE <name> NO INHERIT <parent>
+| NO INHERIT table_name
   {
-    /* SKIP DOC */
-    return unimplementedWithIssueDetail(sqllex, 22456, "alter table no inherits")
+    $$.val = &tree.AlterTableInherit{Parent: $3.unresolvedObjectName(), NoInherit: true}
   }
   // ALTER TABLE <name> ALTER PRIMARY KEY USING COLUMNS ( <colnames...> )
 | ALTER PRIMARY KEY USING COLUMNS '(' index_params ')' opt_hash_sharded opt_with_storage_parameter_list
@@ -3352,6 +3440,86 @@ opt_add_val_placement:
     $$.val = (*tree.AlterTypeAddValuePlacement)(nil)
   }
 
+// %Help: ALTER DOMAIN - change the definition of a domain
+// %Category: DDL
+// %Text: ALTER DOMAIN <type_name> <command>
+//
+// Commands:
+//   ALTER DOMAIN ... { SET DEFAULT <expr> | DROP DEFAULT }
+//   ALTER DOMAIN ... { SET | DROP } NOT NULL
+//   ALTER DOMAIN ... ADD [CONSTRAINT <name>] CHECK (<expr>) [NOT VALID]
+//   ALTER DOMAIN ... DROP CONSTRAINT [IF EXISTS] <name> [RESTRICT | CASCADE]
+//   ALTER DOMAIN ... VALIDATE CONSTRAINT <name>
+// %SeeAlso: CREATE DOMAIN, DROP DOMAIN
+alter_domain_stmt:
+  ALTER DOMAIN type_name SET DEFAULT a_expr
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainSetDefault{Default: $6.expr()},
+    }
+  }
+| ALTER DOMAIN type_name DROP DEFAULT
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainSetDefault{},
+    }
+  }
+| ALTER DOMAIN type_name SET NOT NULL
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainSetNotNull{NotNull: true},
+    }
+  }
+| ALTER DOMAIN type_name DROP NOT NULL
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainSetNotNull{NotNull: false},
+    }
+  }
+| ALTER DOMAIN type_name ADD domain_constraint opt_validate_behavior
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainAddConstraint{
+        Constraint: $5.domainConstraint(),
+        NotValid: $6.validationBehavior() == tree.ValidationSkip,
+      },
+    }
+  }
+| ALTER DOMAIN type_name DROP CONSTRAINT constraint_name opt_drop_behavior
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainDropConstraint{
+        Constraint: tree.Name($6),
+        DropBehavior: $7.dropBehavior(),
+      },
+    }
+  }
+| ALTER DOMAIN type_name DROP CONSTRAINT IF EXISTS constraint_name opt_drop_behavior
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainDropConstraint{
+        Constraint: tree.Name($8),
+        IfExists: true,
+        DropBehavior: $9.dropBehavior(),
+      },
+    }
+  }
+| ALTER DOMAIN type_name VALIDATE CONSTRAINT constraint_name
+  {
+    $$.val = &tree.AlterDomain{
+      Type: $3.unresolvedObjectName(),
+      Cmd: &tree.AlterDomainValidateConstraint{Constraint: tree.Name($6)},
+    }
+  }
+| ALTER DOMAIN error // SHOW HELP: ALTER DOMAIN
+
 role_spec:
   IDENT
   {
@@ -3404,7 +3572,7 @@ alter_attribute_action:
 // %Help: REFRESH - recalculate a materialized view
 // %Category: Misc
 // %Text:
-// REFRESH MATERIALIZED VIEW [CONCURRENTLY] view_name [AS OF SYSTEM TIME <expr>>] [WITH [NO] DATA]
+// REFRESH MATERIALIZED VIEW [CONCURRENTLY] view_name [AS OF SYSTEM TIME <expr>>] [WITH [NO] DATA | INCREMENTAL]
 refresh_stmt:
   REFRESH MATERIALIZED VIEW opt_concurrently view_name opt_as_of_clause opt_clear_data
   {
@@ -3426,6 +3594,10 @@ opt_clear_data:
   {
     $$.val = tree.RefreshDataClear
   }
+| INCREMENTAL
+  {
+    $$.val = tree.RefreshDataIncremental
+  }
 | /* EMPTY */
   {
     $$.val = tree.RefreshDataDefault
@@ -3982,6 +4154,151 @@ drop_external_connection_stmt:
 	}
 	| DROP EXTERNAL CONNECTION error // SHOW HELP: DROP EXTERNAL CONNECTION
 
+// %Help: CREATE SERVER - define a foreign server
+// %Category: DDL
+// %Text:
+// CREATE SERVER [IF NOT EXISTS] <name> FOREIGN DATA WRAPPER external_storage
+//   OPTIONS (uri '<uri>')
+//
+// The server is stored as an external connection of the same name.
+// %SeeAlso: CREATE FOREIGN TABLE, DROP SERVER, CREATE EXTERNAL CONNECTION
+create_server_stmt:
+  CREATE SERVER name FOREIGN DATA WRAPPER name opt_foreign_options
+  {
+    $$.val = &tree.CreateServer{
+      Name: tree.Name($3),
+      Wrapper: tree.Name($7),
+      Options: tree.ForeignOptions($8.kvOptions()),
+    }
+  }
+| CREATE SERVER IF NOT EXISTS name FOREIGN DATA WRAPPER name opt_foreign_options
+  {
+    $$.val = &tree.CreateServer{
+      IfNotExists: true,
+      Name: tree.Name($6),
+      Wrapper: tree.Name($10),
+      Options: tree.ForeignOptions($11.kvOptions()),
+    }
+  }
+| CREATE SERVER error // SHOW HELP: CREATE SERVER
+
+// %Help: DROP SERVER - remove a foreign server
+// %Category: DDL
+// %Text: DROP SERVER [IF EXISTS] <name> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE SERVER
+drop_server_stmt:
+  DROP SERVER name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropServer{Names: $3.nameList(), DropBehavior: $4.dropBehavior()}
+  }
+| DROP SERVER IF EXISTS name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropServer{IfExists: true, Names: $5.nameList(), DropBehavior: $6.dropBehavior()}
+  }
+| DROP SERVER error // SHOW HELP: DROP SERVER
+
+// %Help: CREATE PUBLICATION - define a publication for logical replication
+// %Category: DDL
+// %Text:
+// CREATE PUBLICATION <name> [FOR ALL TABLES | FOR TABLE <tablename> [, ...]]
+//
+// The changes to the tables of a publication can be streamed to a logical
+// replication subscriber over a replication connection.
+// %SeeAlso: DROP PUBLICATION
+create_publication_stmt:
+  CREATE PUBLICATION name
+  {
+    $$.val = &tree.CreatePublication{Name: tree.Name($3)}
+  }
+| CREATE PUBLICATION name FOR ALL TABLES
+  {
+    $$.val = &tree.CreatePublication{Name: tree.Name($3), AllTables: true}
+  }
+| CREATE PUBLICATION name FOR TABLE table_name_list
+  {
+    $$.val = &tree.CreatePublication{Name: tree.Name($3), Tables: $6.tableNames()}
+  }
+| CREATE PUBLICATION error // SHOW HELP: CREATE PUBLICATION
+
+// %Help: DROP PUBLICATION - remove a publication
+// %Category: DDL
+// %Text: DROP PUBLICATION [IF EXISTS] <name> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE PUBLICATION
+drop_publication_stmt:
+  DROP PUBLICATION name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropPublication{Names: $3.nameList(), DropBehavior: $4.dropBehavior()}
+  }
+| DROP PUBLICATION IF EXISTS name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropPublication{IfExists: true, Names: $5.nameList(), DropBehavior: $6.dropBehavior()}
+  }
+| DROP PUBLICATION error // SHOW HELP: DROP PUBLICATION
+
+// %Help: CREATE FOREIGN TABLE - define a table over files in external storage
+// %Category: DDL
+// %Text:
+// CREATE FOREIGN TABLE [IF NOT EXISTS] <tablename> ( <colname> <type> [NOT NULL] [, ...] )
+//   SERVER <servername> OPTIONS (filename '<path>' [, <option> '<value>' ...])
+//
+// Options:
+//   filename      path of the files relative to the server URI, may contain a glob
+//   format        'csv' (default) or 'parquet'
+//   delimiter     CSV field delimiter
+//   header        'true' to skip the first line of every CSV file
+//   null          string that represents NULL in CSV files
+//   comment       CSV comment character
+//   compression   'none', 'gzip', 'bzip' or 'auto' (default)
+//
+// %SeeAlso: CREATE SERVER, CREATE TABLE
+create_foreign_table_stmt:
+  CREATE FOREIGN TABLE table_name '(' opt_table_elem_list ')' SERVER name opt_foreign_options
+  {
+    $$.val = &tree.CreateForeignTable{
+      Table: $4.unresolvedObjectName().ToTableName(),
+      Defs: $6.tblDefs(),
+      Server: tree.Name($9),
+      Options: tree.ForeignOptions($10.kvOptions()),
+    }
+  }
+| CREATE FOREIGN TABLE IF NOT EXISTS table_name '(' opt_table_elem_list ')' SERVER name opt_foreign_options
+  {
+    $$.val = &tree.CreateForeignTable{
+      IfNotExists: true,
+      Table: $7.unresolvedObjectName().ToTableName(),
+      Defs: $9.tblDefs(),
+      Server: tree.Name($12),
+      Options: tree.ForeignOptions($13.kvOptions()),
+    }
+  }
+| CREATE FOREIGN TABLE error // SHOW HELP: CREATE FOREIGN TABLE
+
+opt_foreign_options:
+  OPTIONS '(' foreign_option_list ')'
+  {
+    $$.val = $3.kvOptions()
+  }
+| /* EMPTY */
+  {
+    $$.val = []tree.KVOption(nil)
+  }
+
+foreign_option_list:
+  foreign_option
+  {
+    $$.val = []tree.KVOption{$1.kvOption()}
+  }
+| foreign_option_list ',' foreign_option
+  {
+    $$.val = append($1.kvOptions(), $3.kvOption())
+  }
+
+foreign_option:
+  unrestricted_name SCONST
+  {
+    $$.val = tree.KVOption{Key: tree.Name($1), Value: tree.NewStrVal($2)}
+  }
+
 // %Help: RESTORE - restore data from external storage
 // %Category: CCL
 // %Text:
@@ -4201,16 +4518,6 @@ import_format:
     $$ = strings.ToUpper($1)
   }
 
-alter_unsupported_stmt:
-  ALTER DOMAIN error
-  {
-    return unimplemented(sqllex, "alter domain")
-  }
-| ALTER AGGREGATE error
-  {
-    return unimplementedWithIssueDetail(sqllex, 74775, "alter aggregate")
-  }
-
 // %Help: IMPORT - load data from file in a distributed manner
 // %Category: CCL
 // %Text:
@@ -4752,6 +5059,8 @@ create_stmt:
 | create_changefeed_stmt // EXTEND WITH HELP: CREATE CHANGEFEED
 | create_extension_stmt  // EXTEND WITH HELP: CREATE EXTENSION
 | create_external_connection_stmt // EXTEND WITH HELP: CREATE EXTERNAL CONNECTION
+| create_server_stmt     // EXTEND WITH HELP: CREATE SERVER
+| create_publication_stmt // EXTEND WITH HELP: CREATE PUBLICATION
 | create_virtual_cluster_stmt     // EXTEND WITH HELP: CREATE VIRTUAL CLUSTER
 | create_logical_replication_stream_stmt     // EXTEND WITH HELP: CREATE LOGICAL REPLICATION STREAM
 | create_schedule_stmt   // help texts in sub-rule
@@ -5288,6 +5597,57 @@ table_rls_mode:
     $$.val = tree.TableRLSNoForce
   }
 
+// %Help: CREATE AGGREGATE - define a new aggregate function
+// %Category: DDL
+// %Text:
+// CREATE [ OR REPLACE ] AGGREGATE
+//    name ( [ [ argmode ] [ argname ] argtype [, ...] ] ) (
+//    SFUNC = sfunc,
+//    STYPE = state_data_type
+//    [ , FINALFUNC = ffunc ]
+//    [ , COMBINEFUNC = combinefunc ]
+//    [ , INITCOND = initial_condition ]
+// )
+// %SeeAlso: ALTER AGGREGATE, DROP AGGREGATE, CREATE FUNCTION
+create_aggregate_stmt:
+  CREATE opt_or_replace AGGREGATE routine_create_name func_params '(' aggregate_opt_list ')'
+  {
+    n, err := tree.NewCreateAggregate(
+      $2.bool(), $4.unresolvedObjectName().ToRoutineName(), $5.routineParams(), $7.aggregateOptions(),
+    )
+    if err != nil {
+      return setErr(sqllex, err)
+    }
+    $$.val = n
+  }
+| CREATE opt_or_replace AGGREGATE error // SHOW HELP: CREATE AGGREGATE
+
+aggregate_opt_list:
+  aggregate_opt_item
+  {
+    $$.val = tree.AggregateOptions{$1.aggregateOption()}
+  }
+| aggregate_opt_list ',' aggregate_opt_item
+  {
+    $$.val = append($1.aggregateOptions(), $3.aggregateOption())
+  }
+
+aggregate_opt_item:
+  name '=' typename
+  {
+    $$.val = tree.AggregateOption{Name: tree.Name($1), Arg: $3.typeReference()}
+  }
+| name '=' SCONST
+  {
+    s := $3
+    $$.val = tree.AggregateOption{Name: tree.Name($1), Str: &s}
+  }
+| name '=' numeric_only
+  {
+    s := $3.numVal().String()
+    $$.val = tree.AggregateOption{Name: tree.Name($1), Str: &s}
+  }
+
 // %Help: CREATE FUNCTION - define a new function
 // %Category: DDL
 // %Text:
@@ -5476,7 +5836,7 @@ routine_param_class:
 | OUT { $$.val = tree.RoutineParamOut }
 | INOUT { $$.val = tree.RoutineParamInOut }
 | IN OUT { $$.val = tree.RoutineParamInOut }
-| VARIADIC { return unimplementedWithIssueDetail(sqllex, 88947, "variadic user-defined functions") }
+| VARIADIC { $$.val = tree.RoutineParamVariadic }
 
 routine_param_type:
   typename
@@ -5742,6 +6102,32 @@ drop_proc_stmt:
   }
 | DROP PROCEDURE error // SHOW HELP: DROP PROCEDURE
 
+// %Help: DROP AGGREGATE - remove an aggregate function
+// %Category: DDL
+// %Text:
+// DROP AGGREGATE [ IF EXISTS ] name ( [ [ argmode ] [ argname ] argtype [, ...] ] ) [, ...]
+//    [ CASCADE | RESTRICT ]
+// %SeeAlso: CREATE AGGREGATE, ALTER AGGREGATE
+drop_aggregate_stmt:
+  DROP AGGREGATE function_with_paramtypes_list opt_drop_behavior
+  {
+    $$.val = &tree.DropRoutine{
+      Aggregate: true,
+      Routines: $3.routineObjs(),
+      DropBehavior: $4.dropBehavior(),
+    }
+  }
+| DROP AGGREGATE IF EXISTS function_with_paramtypes_list opt_drop_behavior
+  {
+    $$.val = &tree.DropRoutine{
+      IfExists: true,
+      Aggregate: true,
+      Routines: $5.routineObjs(),
+      DropBehavior: $6.dropBehavior(),
+    }
+  }
+| DROP AGGREGATE error // SHOW HELP: DROP AGGREGATE
+
 function_with_paramtypes_list:
   function_with_paramtypes
   {
@@ -6100,18 +6486,14 @@ drop_trigger_stmt:
 
 create_unsupported:
   CREATE ACCESS METHOD error { return unimplemented(sqllex, "create access method") }
-| CREATE AGGREGATE error { return unimplementedWithIssueDetail(sqllex, 74775, "create aggregate") }
 | CREATE CAST error { return unimplemented(sqllex, "create cast") }
 | CREATE CONSTRAINT TRIGGER error { return unimplementedWithIssueDetail(sqllex, 28296, "create constraint") }
 | CREATE CONVERSION error { return unimplemented(sqllex, "create conversion") }
 | CREATE DEFAULT CONVERSION error { return unimplemented(sqllex, "create def conv") }
-| CREATE FOREIGN TABLE error { return unimplemented(sqllex, "create foreign table") }
 | CREATE FOREIGN DATA error { return unimplemented(sqllex, "create fdw") }
 | CREATE opt_or_replace opt_trusted opt_procedural LANGUAGE name error { return unimplementedWithIssueDetail(sqllex, 17511, "create language " + $6) }
 | CREATE OPERATOR error { return unimplementedWithIssue(sqllex, 65017) }
-| CREATE PUBLICATION error { return unimplemented(sqllex, "create publication") }
 | CREATE opt_or_replace RULE error { return unimplemented(sqllex, "create rule") }
-| CREATE SERVER error { return unimplemented(sqllex, "create server") }
 | CREATE SUBSCRIPTION error { return unimplemented(sqllex, "create subscription") }
 | CREATE TABLESPACE error { return unimplementedWithIssueDetail(sqllex, 54113, "create tablespace") }
 | CREATE TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "create text") }
@@ -6126,20 +6508,15 @@ opt_procedural:
 
 drop_unsupported:
   DROP ACCESS METHOD error { return unimplemented(sqllex, "drop access method") }
-| DROP AGGREGATE error { return unimplementedWithIssueDetail(sqllex, 74775, "drop aggregate") }
 | DROP CAST error { return unimplemented(sqllex, "drop cast") }
 | DROP COLLATION error { return unimplemented(sqllex, "drop collation") }
 | DROP CONVERSION error { return unimplemented(sqllex, "drop conversion") }
-| DROP DOMAIN error { return unimplementedWithIssueDetail(sqllex, 27796, "drop") }
 | DROP EXTENSION IF EXISTS name error { return unimplementedWithIssueDetail(sqllex, 74777, "drop extension if exists") }
 | DROP EXTENSION name error { return unimplementedWithIssueDetail(sqllex, 74777, "drop extension") }
-| DROP FOREIGN TABLE error { return unimplemented(sqllex, "drop foreign table") }
 | DROP FOREIGN DATA error { return unimplemented(sqllex, "drop fdw") }
 | DROP opt_procedural LANGUAGE name error { return unimplementedWithIssueDetail(sqllex, 17511, "drop language " + $4) }
 | DROP OPERATOR error { return unimplemented(sqllex, "drop operator") }
-| DROP PUBLICATION error { return unimplemented(sqllex, "drop publication") }
 | DROP RULE error { return unimplemented(sqllex, "drop rule") }
-| DROP SERVER error { return unimplemented(sqllex, "drop server") }
 | DROP SUBSCRIPTION error { return unimplemented(sqllex, "drop subscription") }
 | DROP TEXT error { return unimplementedWithIssueDetail(sqllex, 7821, "drop text") }
 
@@ -6151,11 +6528,14 @@ create_ddl_stmt:
 | create_table_as_stmt // EXTEND WITH HELP: CREATE TABLE
 // Error case for both CREATE TABLE and CREATE TABLE ... AS in one
 | CREATE opt_persistence_temp_table TABLE error   // SHOW HELP: CREATE TABLE
+| create_foreign_table_stmt // EXTEND WITH HELP: CREATE FOREIGN TABLE
 | create_type_stmt     // EXTEND WITH HELP: CREATE TYPE
+| create_domain_stmt   // EXTEND WITH HELP: CREATE DOMAIN
 | create_view_stmt     // EXTEND WITH HELP: CREATE VIEW
 | create_sequence_stmt // EXTEND WITH HELP: CREATE SEQUENCE
 | create_func_stmt     // EXTEND WITH HELP: CREATE FUNCTION
 | create_proc_stmt     // EXTEND WITH HELP: CREATE PROCEDURE
+| create_aggregate_stmt // EXTEND WITH HELP: CREATE AGGREGATE
 | create_trigger_stmt  // EXTEND WITH HELP: CREATE TRIGGER
 | create_policy_stmt   // EXTEND WITH HELP: CREATE POLICY
 
@@ -6562,6 +6942,8 @@ drop_stmt:
 | drop_role_stmt                // EXTEND WITH HELP: DROP ROLE
 | drop_schedule_stmt            // EXTEND WITH HELP: DROP SCHEDULES
 | drop_external_connection_stmt // EXTEND WITH HELP: DROP EXTERNAL CONNECTION
+| drop_server_stmt              // EXTEND WITH HELP: DROP SERVER
+| drop_publication_stmt         // EXTEND WITH HELP: DROP PUBLICATION
 | drop_virtual_cluster_stmt     // EXTEND WITH HELP: DROP VIRTUAL CLUSTER
 | drop_unsupported   {}
 | DROP error                    // SHOW HELP: DROP
@@ -6574,8 +6956,10 @@ drop_ddl_stmt:
 | drop_sequence_stmt // EXTEND WITH HELP: DROP SEQUENCE
 | drop_schema_stmt   // EXTEND WITH HELP: DROP SCHEMA
 | drop_type_stmt     // EXTEND WITH HELP: DROP TYPE
+| drop_domain_stmt   // EXTEND WITH HELP: DROP DOMAIN
 | drop_func_stmt     // EXTEND WITH HELP: DROP FUNCTION
 | drop_proc_stmt     // EXTEND WITH HELP: DROP FUNCTION
+| drop_aggregate_stmt // EXTEND WITH HELP: DROP AGGREGATE
 | drop_trigger_stmt  // EXTEND WITH HELP: DROP TRIGGER
 | drop_policy_stmt   // EXTEND WITH HELP: DROP POLICY
 
@@ -6640,6 +7024,14 @@ drop_table_stmt:
   {
     $$.val = &tree.DropTable{Names: $5.tableNames(), IfExists: true, DropBehavior: $6.dropBehavior()}
   }
+| DROP FOREIGN TABLE table_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropTable{Names: $4.tableNames(), IfExists: false, DropBehavior: $5.dropBehavior()}
+  }
+| DROP FOREIGN TABLE IF EXISTS table_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropTable{Names: $6.tableNames(), IfExists: true, DropBehavior: $7.dropBehavior()}
+  }
 | DROP TABLE error // SHOW HELP: DROP TABLE
 
 // %Help: DROP INDEX - remove an index
@@ -6712,6 +7104,29 @@ drop_type_stmt:
   }
 | DROP TYPE error // SHOW HELP: DROP TYPE
 
+// %Help: DROP DOMAIN - remove a domain
+// %Category: DDL
+// %Text: DROP DOMAIN [IF EXISTS] <type_name> [, ...] [CASCADE | RESTRICT]
+// %SeeAlso: CREATE DOMAIN, ALTER DOMAIN
+drop_domain_stmt:
+  DROP DOMAIN type_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropDomain{
+      Names: $3.unresolvedObjectNames(),
+      IfExists: false,
+      DropBehavior: $4.dropBehavior(),
+    }
+  }
+| DROP DOMAIN IF EXISTS type_name_list opt_drop_behavior
+  {
+    $$.val = &tree.DropDomain{
+      Names: $5.unresolvedObjectNames(),
+      IfExists: true,
+      DropBehavior: $6.dropBehavior(),
+    }
+  }
+| DROP DOMAIN error // SHOW HELP: DROP DOMAIN
+
 // %Help: DROP VIRTUAL CLUSTER - remove a virtual cluster
 // %Category: Experimental
 // %Text: DROP VIRTUAL CLUSTER [IF EXISTS] <virtual_cluster_spec> [IMMEDIATE]
@@ -6936,6 +7351,7 @@ preparable_stmt:
 | import_stmt    // EXTEND WITH HELP: IMPORT
 | insert_stmt    // EXTEND WITH HELP: INSERT
 | inspect_stmt   // EXTEND WITH HELP: INSPECT
+| merge_stmt     // EXTEND WITH HELP: MERGE
 | pause_stmt     // help texts in sub-rule
 | reset_stmt     // help texts in sub-rule
 | restore_stmt   // EXTEND WITH HELP: RESTORE
@@ -7777,7 +8193,7 @@ use_stmt:
 nonpreparable_set_stmt:
   set_transaction_stmt // EXTEND WITH HELP: SET TRANSACTION
 | set_exprs_internal   { /* SKIP DOC */ }
-| SET CONSTRAINTS error { return unimplemented(sqllex, "set constraints") }
+| set_constraints_stmt // EXTEND WITH HELP: SET CONSTRAINTS
 
 // SET SESSION / SET LOCAL / SET CLUSTER SETTING
 preparable_set_stmt:
@@ -8404,6 +8820,36 @@ set_transaction_stmt:
   }
 | SET SESSION TRANSACTION error // SHOW HELP: SET TRANSACTION
 
+// %Help: SET CONSTRAINTS - set constraint check timing for the current transaction
+// %Category: Txn
+// %Text: SET CONSTRAINTS { ALL | <name> [, ...] } { DEFERRED | IMMEDIATE }
+//
+// Only constraints declared DEFERRABLE are affected. DEFERRED constraints are
+// checked when the transaction commits; IMMEDIATE constraints are checked at
+// the end of each statement.
+//
+// %SeeAlso: SET TRANSACTION, CREATE TABLE
+set_constraints_stmt:
+  SET CONSTRAINTS ALL constraints_set_mode
+  {
+    $$.val = &tree.SetConstraints{Deferred: $4.bool()}
+  }
+| SET CONSTRAINTS table_name_list constraints_set_mode
+  {
+    $$.val = &tree.SetConstraints{Names: $3.tableNames(), Deferred: $4.bool()}
+  }
+| SET CONSTRAINTS error // SHOW HELP: SET CONSTRAINTS
+
+constraints_set_mode:
+  DEFERRED
+  {
+    $$.val = true
+  }
+| IMMEDIATE
+  {
+    $$.val = false
+  }
+
 generic_set:
   var_name to_or_eq var_list
   {
@@ -8623,6 +9069,7 @@ show_stmt:
 | show_histogram_stmt        // EXTEND WITH HELP: SHOW HISTOGRAM
 | show_indexes_stmt          // EXTEND WITH HELP: SHOW INDEXES
 | show_partitions_stmt       // EXTEND WITH HELP: SHOW PARTITIONS
+| show_plan_baselines_stmt   // EXTEND WITH HELP: SHOW PLAN BASELINES
 | show_jobs_stmt             // EXTEND WITH HELP: SHOW JOBS
 | show_locality_stmt
 | show_schedules_stmt        // EXTEND WITH HELP: SHOW SCHEDULES
@@ -9001,6 +9448,26 @@ show_histogram_stmt:
   }
 | SHOW HISTOGRAM error // SHOW HELP: SHOW HISTOGRAM
 
+// %Help: SHOW PLAN BASELINES - display plan baselines (experimental)
+// %Category: Experimental
+// %Text: SHOW PLAN BASELINES [FOR <fingerprint>]
+//
+// Returns the captured and accepted plan baselines of all
+// statement fingerprints, or of the given fingerprint.
+// %SeeAlso: SHOW HISTOGRAM
+show_plan_baselines_stmt:
+  SHOW PLAN BASELINES
+  {
+    /* SKIP DOC */
+    $$.val = &tree.ShowPlanBaselines{}
+  }
+| SHOW PLAN BASELINES FOR SCONST
+  {
+    /* SKIP DOC */
+    $$.val = &tree.ShowPlanBaselines{Fingerprint: tree.NewStrVal($5)}
+  }
+| SHOW PLAN BASELINES error // SHOW HELP: SHOW PLAN BASELINES
+
 // %Help: SHOW BACKUP - list backup contents
 // %Category: CCL
 // %Text: SHOW BACKUP [SCHEMAS|FILES|RANGES] <location>
@@ -11003,7 +11470,7 @@ alter_schema_stmt:
 // %Category: DDL
 // %Text:
 // CREATE [[GLOBAL | LOCAL] {TEMPORARY | TEMP}] TABLE [IF NOT EXISTS] <tablename> ( <elements...> ) [<on_commit>]
-// CREATE [[GLOBAL | LOCAL] {TEMPORARY | TEMP}] TABLE [IF NOT EXISTS] <tablename> [( <colnames...> )] AS <source> [<on commit>]
+// CREATE [[GLOBAL | LOCAL] {TEMPORARY | TEMP}] TABLE [IF NOT EXISTS] <tablename> [( <colnames...> )] AS <source> [WITH [NO] DATA] [<on commit>]
 //
 // Table elements:
 //    <name> <type> [<qualifiers...>]
@@ -11040,6 +11507,7 @@ create_table_stmt:
       IfNotExists: false,
       Defs: $6.tblDefs(),
       AsSource: nil,
+      Inherits: $8.tableNames(),
       PartitionByTable: $9.partitionByTable(),
       Persistence: $2.persistence(),
       StorageParams: $10.storageParams(),
@@ -11055,6 +11523,7 @@ create_table_stmt:
       IfNotExists: true,
       Defs: $9.tblDefs(),
       AsSource: nil,
+      Inherits: $11.tableNames(),
       PartitionByTable: $12.partitionByTable(),
       Persistence: $2.persistence(),
       StorageParams: $13.storageParams(),
@@ -11089,12 +11558,11 @@ opt_table_with:
 opt_create_table_inherits:
   /* EMPTY */
   {
-    $$ = ""
+    $$.val = tree.TableNames(nil)
   }
-| INHERITS error
+| INHERITS '(' table_name_list ')'
   {
-    /* SKIP DOC */
-    return unimplementedWithIssueDetail(sqllex, 22456, "create table inherits")
+    $$.val = $3.tableNames()
   }
 
 opt_with_storage_parameter_list:
@@ -11162,6 +11630,7 @@ create_table_as_stmt:
       IfNotExists: false,
       Defs: $5.tblDefs(),
       AsSource: $8.slct(),
+      AsWithNoData: !$9.bool(),
       StorageParams: $6.storageParams(),
       OnCommit: $10.createTableOnCommitSetting(),
       Persistence: $2.persistence(),
@@ -11175,6 +11644,7 @@ create_table_as_stmt:
       IfNotExists: true,
       Defs: $8.tblDefs(),
       AsSource: $11.slct(),
+      AsWithNoData: !$12.bool(),
       StorageParams: $9.storageParams(),
       OnCommit: $13.createTableOnCommitSetting(),
       Persistence: $2.persistence(),
@@ -11182,9 +11652,20 @@ create_table_as_stmt:
   }
 
 opt_create_as_data:
-  /* EMPTY */  { /* no error */ }
-| WITH DATA    { /* SKIP DOC */ /* This is the default */ }
-| WITH NO DATA { return unimplemented(sqllex, "create table as with no data") }
+  WITH DATA
+  {
+    /* SKIP DOC */
+    /* This is the default */
+    $$.val = true
+  }
+| WITH NO DATA
+  {
+    $$.val = false
+  }
+| /* EMPTY */
+  {
+    $$.val = true
+  }
 
 /*
  * Redundancy here is needed to avoid shift/reduce conflicts,
@@ -11716,6 +12197,10 @@ table_constraint:
 constraint_elem:
   CHECK '(' a_expr ')' opt_deferrable
   {
+    if $5.constraintDeferrability().IsDeferrable() {
+      return setErr(sqllex, pgerror.New(pgcode.FeatureNotSupported,
+        "CHECK constraints cannot be marked DEFERRABLE"))
+    }
     $$.val = &tree.CheckConstraintTableDef{
       Expr: $3.expr(),
     }
@@ -11731,6 +12216,7 @@ constraint_elem:
         PartitionByIndex: $7.partitionByIndex(),
         Predicate: $9.expr(),
       },
+      Deferrable: $8.constraintDeferrability(),
     }
   }
 | PRIMARY KEY '(' index_params ')' opt_hash_sharded opt_with_storage_parameter_list
@@ -11754,11 +12240,69 @@ constraint_elem:
       ToCols: $8.nameList(),
       Match: $9.compositeKeyMatchMethod(),
       Actions: $10.referenceActions(),
+      Deferrable: $11.constraintDeferrability(),
     }
   }
-| EXCLUDE USING error
+| EXCLUDE USING name '(' exclude_elem_list ')' opt_deferrable opt_exclude_where
+  {
+    switch $3 {
+      case "gist", "gin", "btree":
+      case "hash", "spgist", "brin":
+        return unimplemented(sqllex, "exclude using " + $3)
+      default:
+        sqllex.Error("unrecognized access method: " + $3)
+        return 1
+    }
+    def := $5.constraintDef().(*tree.UniqueConstraintTableDef)
+    def.ExcludeUsing = tree.Name($3)
+    def.Deferrable = $7.constraintDeferrability()
+    def.Predicate = $8.expr()
+    $$.val = def
+  }
+
+exclude_elem_list:
+  exclude_elem
+| exclude_elem_list ',' exclude_elem
+  {
+    def := $1.constraintDef().(*tree.UniqueConstraintTableDef)
+    elem := $3.constraintDef().(*tree.UniqueConstraintTableDef)
+    def.Columns = append(def.Columns, elem.Columns...)
+    def.ExcludeOperators = append(def.ExcludeOperators, elem.ExcludeOperators...)
+    $$.val = def
+  }
+
+// exclude_elem is a single element of an EXCLUDE USING constraint. It is
+// returned as an exclusion constraint with a single column, which
+// exclude_elem_list merges into the final constraint.
+exclude_elem:
+  index_elem WITH exclude_op
+  {
+    op, ok := $3.op().(treecmp.ComparisonOperator)
+    if !ok {
+      return setErr(sqllex, pgerror.Newf(pgcode.WrongObjectType,
+        "operator %s is not a comparison operator", $3.op()))
+    }
+    $$.val = &tree.UniqueConstraintTableDef{
+      WithoutIndex: true,
+      IndexTableDef: tree.IndexTableDef{
+        Columns: tree.IndexElemList{$1.idxElem()},
+      },
+      ExcludeOperators: []treecmp.ComparisonOperator{op},
+    }
+  }
+
+exclude_op:
+  all_op
+| qual_op
+
+opt_exclude_where:
+  WHERE '(' a_expr ')'
+  {
+    $$.val = $3.expr()
+  }
+| /* EMPTY */
   {
-    return unimplementedWithIssueDetail(sqllex, 46657, "add constraint exclude using")
+    $$.val = tree.Expr(nil)
   }
 
 
@@ -11867,12 +12411,31 @@ create_as_col_qualification_elem:
   }
 
 opt_deferrable:
-  /* EMPTY */ { /* no error */ }
-| DEFERRABLE { return unimplementedWithIssueDetail(sqllex, 31632, "deferrable") }
-| DEFERRABLE INITIALLY DEFERRED { return unimplementedWithIssueDetail(sqllex, 31632, "def initially deferred") }
-| DEFERRABLE INITIALLY IMMEDIATE { return unimplementedWithIssueDetail(sqllex, 31632, "def initially immediate") }
-| INITIALLY DEFERRED { return unimplementedWithIssueDetail(sqllex, 31632, "initially deferred") }
-| INITIALLY IMMEDIATE { return unimplementedWithIssueDetail(sqllex, 31632, "initially immediate") }
+  /* EMPTY */
+  {
+    $$.val = tree.ConstraintNotDeferrable
+  }
+| DEFERRABLE
+  {
+    $$.val = tree.ConstraintInitiallyImmediate
+  }
+| DEFERRABLE INITIALLY IMMEDIATE
+  {
+    $$.val = tree.ConstraintInitiallyImmediate
+  }
+| DEFERRABLE INITIALLY DEFERRED
+  {
+    $$.val = tree.ConstraintInitiallyDeferred
+  }
+| INITIALLY DEFERRED
+  {
+    // INITIALLY DEFERRED implies DEFERRABLE.
+    $$.val = tree.ConstraintInitiallyDeferred
+  }
+| INITIALLY IMMEDIATE
+  {
+    $$.val = tree.ConstraintNotDeferrable
+  }
 
 storing:
   COVERING
@@ -11941,7 +12504,9 @@ opt_column_list:
 // not required to have a match in the referenced table. MATCH SIMPLE
 // allows any of the foreign key columns to be null; if any of them
 // are null, the row is not required to have a match in the referenced
-// table. MATCH PARTIAL is not yet implemented. (Of course, NOT NULL
+// table. MATCH PARTIAL allows any of the foreign key columns to be null;
+// the non-null columns must match the corresponding columns of at least one
+// row in the referenced table. (Of course, NOT NULL
 // constraints can be applied to the referencing column(s) to prevent
 // these cases from arising.)"
 key_match:
@@ -11955,7 +12520,7 @@ key_match:
   }
 | MATCH PARTIAL
   {
-    return unimplementedWithIssueDetail(sqllex, 20305, "match partial")
+    $$.val = tree.MatchPartial
   }
 | /* EMPTY */
   {
@@ -12252,7 +12817,7 @@ role_or_group_or_user:
 // %Help: CREATE VIEW - create a new view
 // %Category: DDL
 // %Text:
-// CREATE [TEMPORARY | TEMP] VIEW [IF NOT EXISTS] <viewname> [( <colnames...> )] [WITH ( <option> [= <value>] [, ....] )] AS <source>
+// CREATE [TEMPORARY | TEMP] [RECURSIVE] VIEW [IF NOT EXISTS] <viewname> [( <colnames...> )] [WITH ( <option> [= <value>] [, ....] )] AS <source>
 // CREATE [TEMPORARY | TEMP] MATERIALIZED VIEW [IF NOT EXISTS] <viewname> [( <colnames...> )] AS <source> [WITH [NO] DATA]
 //
 // Options:
@@ -12262,10 +12827,18 @@ create_view_stmt:
   CREATE opt_temp opt_view_recursive VIEW view_name opt_column_list opt_view_with AS select_stmt
   {
     name := $5.unresolvedObjectName().ToTableName()
+    source := $9.slct()
+    if $3.bool() {
+      if len($6.nameList()) == 0 {
+        sqllex.Error("recursive views must specify a column list")
+        return 1
+      }
+      source = tree.NewRecursiveViewSource(name.ObjectName, $6.nameList(), source)
+    }
     $$.val = &tree.CreateView{
       Name: name,
       ColumnNames: $6.nameList(),
-      AsSource: $9.slct(),
+      AsSource: source,
       Persistence: $2.persistence(),
       Options: $7.viewOptions(),
       IfNotExists: false,
@@ -12277,10 +12850,18 @@ create_view_stmt:
 | CREATE OR REPLACE opt_temp opt_view_recursive VIEW view_name opt_column_list opt_view_with AS select_stmt
   {
     name := $7.unresolvedObjectName().ToTableName()
+    source := $11.slct()
+    if $5.bool() {
+      if len($8.nameList()) == 0 {
+        sqllex.Error("recursive views must specify a column list")
+        return 1
+      }
+      source = tree.NewRecursiveViewSource(name.ObjectName, $8.nameList(), source)
+    }
     $$.val = &tree.CreateView{
       Name: name,
       ColumnNames: $8.nameList(),
-      AsSource: $11.slct(),
+      AsSource: source,
       Persistence: $4.persistence(),
       Options: $9.viewOptions(),
       IfNotExists: false,
@@ -12290,10 +12871,18 @@ create_view_stmt:
 | CREATE opt_temp opt_view_recursive VIEW IF NOT EXISTS view_name opt_column_list opt_view_with AS select_stmt
   {
     name := $8.unresolvedObjectName().ToTableName()
+    source := $12.slct()
+    if $3.bool() {
+      if len($9.nameList()) == 0 {
+        sqllex.Error("recursive views must specify a column list")
+        return 1
+      }
+      source = tree.NewRecursiveViewSource(name.ObjectName, $9.nameList(), source)
+    }
     $$.val = &tree.CreateView{
       Name: name,
       ColumnNames: $9.nameList(),
-      AsSource: $12.slct(),
+      AsSource: source,
       Persistence: $2.persistence(),
       Options: $10.viewOptions(),
       IfNotExists: true,
@@ -12508,8 +13097,14 @@ provisionsrc_clause:
   }
 
 opt_view_recursive:
-  /* EMPTY */ { /* no error */ }
-| RECURSIVE { return unimplemented(sqllex, "create recursive view") }
+  RECURSIVE
+  {
+    $$.val = true
+  }
+| /* EMPTY */
+  {
+    $$.val = false
+  }
 
 // View-specific WITH clause that only accepts security_invoker
 opt_view_with:
@@ -12597,8 +13192,78 @@ create_type_stmt:
 | CREATE TYPE type_name '(' error         { return unimplementedWithIssueDetail(sqllex, 27793, "base") }
   // Shell types, gateway to define base types using the previous syntax.
 | CREATE TYPE type_name                   { return unimplementedWithIssueDetail(sqllex, 27793, "shell") }
-  // Domain types.
-| CREATE DOMAIN type_name error           { return unimplementedWithIssueDetail(sqllex, 27796, "create") }
+
+// %Help: CREATE DOMAIN - create a domain
+// %Category: DDL
+// %Text:
+// CREATE DOMAIN <type_name> [AS] <datatype> [DEFAULT <expr>] [<constraint> ...]
+//
+// Constraints:
+//   [CONSTRAINT <name>] { NOT NULL | NULL | CHECK (<expr>) }
+//
+// CHECK expressions refer to the value being checked as VALUE.
+// %SeeAlso: ALTER DOMAIN, DROP DOMAIN
+create_domain_stmt:
+  CREATE DOMAIN type_name opt_as typename opt_domain_default opt_domain_constraint_list
+  {
+    $$.val = &tree.CreateDomain{
+      TypeName: $3.unresolvedObjectName(),
+      BaseType: $5.typeReference(),
+      Default: $6.expr(),
+      Constraints: $7.domainConstraints(),
+    }
+  }
+| CREATE DOMAIN error // SHOW HELP: CREATE DOMAIN
+
+opt_domain_default:
+  DEFAULT b_expr
+  {
+    $$.val = $2.expr()
+  }
+| /* EMPTY */
+  {
+    $$.val = nil
+  }
+
+opt_domain_constraint_list:
+  domain_constraint_list
+| /* EMPTY */
+  {
+    $$.val = tree.DomainConstraints(nil)
+  }
+
+domain_constraint_list:
+  domain_constraint
+  {
+    $$.val = tree.DomainConstraints{$1.domainConstraint()}
+  }
+| domain_constraint_list domain_constraint
+  {
+    $$.val = append($1.domainConstraints(), $2.domainConstraint())
+  }
+
+domain_constraint:
+  CONSTRAINT constraint_name domain_constraint_elem
+  {
+    c := $3.domainConstraint()
+    c.Name = tree.Name($2)
+    $$.val = c
+  }
+| domain_constraint_elem
+
+domain_constraint_elem:
+  NOT NULL
+  {
+    $$.val = tree.DomainConstraint{NotNull: true}
+  }
+| NULL
+  {
+    $$.val = tree.DomainConstraint{Null: true}
+  }
+| CHECK '(' a_expr ')'
+  {
+    $$.val = tree.DomainConstraint{Check: $3.expr()}
+  }
 
 opt_enum_val_list:
   enum_val_list
@@ -13525,6 +14190,54 @@ savepoint_stmt:
   }
 | SAVEPOINT error // SHOW HELP: SAVEPOINT
 
+// %Help: LOCK - lock tables in the current transaction
+// %Category: Txn
+// %Text:
+// LOCK [TABLE] <tablename> [, ...] [IN <lockmode> MODE] [NOWAIT]
+//
+// Lock modes:
+//    ACCESS SHARE | ROW SHARE | ROW EXCLUSIVE | SHARE UPDATE EXCLUSIVE
+//    | SHARE | SHARE ROW EXCLUSIVE | EXCLUSIVE | ACCESS EXCLUSIVE
+//
+// The default lock mode is ACCESS EXCLUSIVE.
+// %SeeAlso: BEGIN, SELECT
+lock_table_stmt:
+  LOCK opt_table relation_expr_list opt_lock_table_mode opt_nowait
+  {
+    $$.val = &tree.LockTable{Tables: $3.tableNames(), Mode: $4.lockTableMode(), NoWait: $5.bool()}
+  }
+| LOCK error // SHOW HELP: LOCK
+
+opt_lock_table_mode:
+  IN lock_table_mode MODE
+  {
+    $$.val = $2.lockTableMode()
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.LockTableAccessExclusive
+  }
+
+lock_table_mode:
+  ACCESS SHARE             { $$.val = tree.LockTableAccessShare }
+| ROW SHARE                { $$.val = tree.LockTableRowShare }
+| ROW EXCLUSIVE            { $$.val = tree.LockTableRowExclusive }
+| SHARE UPDATE EXCLUSIVE   { $$.val = tree.LockTableShareUpdateExclusive }
+| SHARE                    { $$.val = tree.LockTableShare }
+| SHARE ROW EXCLUSIVE      { $$.val = tree.LockTableShareRowExclusive }
+| EXCLUSIVE                { $$.val = tree.LockTableExclusive }
+| ACCESS EXCLUSIVE         { $$.val = tree.LockTableAccessExclusive }
+
+opt_nowait:
+  NOWAIT
+  {
+    $$.val = true
+  }
+| /* EMPTY */
+  {
+    $$.val = false
+  }
+
 // BEGIN / START / COMMIT / END / ROLLBACK / PREPARE TRANSACTION / COMMIT PREPARED / ROLLBACK PREPARED / ...
 transaction_stmt:
   begin_stmt               // EXTEND WITH HELP: BEGIN
@@ -14192,6 +14905,95 @@ multiple_set_clause:
     $$.val = &tree.UpdateExpr{Tuple: true, Names: $2.nameList(), Expr: $5.expr()}
   }
 
+// %Help: MERGE - conditionally insert, update or delete rows of a table
+// %Category: DML
+// %Text:
+// MERGE INTO <tablename> [[AS] <name>]
+//        USING <source> ON <expr>
+//        WHEN MATCHED [AND <expr>] THEN { UPDATE SET ... | DELETE | DO NOTHING }
+//        WHEN NOT MATCHED [AND <expr>] THEN
+//          { INSERT [( <colnames...> )] { VALUES ( <exprs...> ) | DEFAULT VALUES } | DO NOTHING }
+//        [...]
+//        [RETURNING <exprs...>]
+// %SeeAlso: INSERT, UPDATE, DELETE, UPSERT
+merge_stmt:
+  opt_with_clause MERGE INTO table_expr_opt_alias_idx USING table_ref ON a_expr merge_when_list returning_clause
+  {
+    $$.val = &tree.Merge{
+      With: $1.with(),
+      Table: $4.tblExpr(),
+      Source: $6.tblExpr(),
+      On: $8.expr(),
+      Whens: $9.mergeWhens(),
+      Returning: $10.retClause(),
+    }
+  }
+| opt_with_clause MERGE error // SHOW HELP: MERGE
+
+merge_when_list:
+  merge_when_clause
+  {
+    $$.val = tree.MergeWhens{$1.mergeWhen()}
+  }
+| merge_when_list merge_when_clause
+  {
+    $$.val = append($1.mergeWhens(), $2.mergeWhen())
+  }
+
+merge_when_clause:
+  WHEN MATCHED THEN merge_when_matched_action
+  {
+    $$.val = $4.mergeWhen()
+    $$.val.(*tree.MergeWhen).Matched = true
+  }
+| WHEN MATCHED AND a_expr THEN merge_when_matched_action
+  {
+    $$.val = $6.mergeWhen()
+    $$.val.(*tree.MergeWhen).Matched = true
+    $$.val.(*tree.MergeWhen).Cond = $4.expr()
+  }
+| WHEN NOT MATCHED THEN merge_when_not_matched_action
+  {
+    $$.val = $5.mergeWhen()
+  }
+| WHEN NOT MATCHED AND a_expr THEN merge_when_not_matched_action
+  {
+    $$.val = $7.mergeWhen()
+    $$.val.(*tree.MergeWhen).Cond = $5.expr()
+  }
+
+merge_when_matched_action:
+  UPDATE SET set_clause_list
+  {
+    $$.val = &tree.MergeWhen{Action: tree.MergeActionUpdate, Exprs: $3.updateExprs()}
+  }
+| DELETE
+  {
+    $$.val = &tree.MergeWhen{Action: tree.MergeActionDelete}
+  }
+| DO NOTHING
+  {
+    $$.val = &tree.MergeWhen{Action: tree.MergeActionDoNothing}
+  }
+
+merge_when_not_matched_action:
+  INSERT VALUES '(' expr_list ')'
+  {
+    $$.val = &tree.MergeWhen{Action: tree.MergeActionInsert, Values: $4.exprs()}
+  }
+| INSERT '(' insert_column_list ')' VALUES '(' expr_list ')'
+  {
+    $$.val = &tree.MergeWhen{Action: tree.MergeActionInsert, Columns: $3.nameList(), Values: $7.exprs()}
+  }
+| INSERT DEFAULT VALUES
+  {
+    $$.val = &tree.MergeWhen{Action: tree.MergeActionInsert}
+  }
+| DO NOTHING
+  {
+    $$.val = &tree.MergeWhen{Action: tree.MergeActionDoNothing}
+  }
+
 // %Help: REASSIGN OWNED BY - change ownership of all objects
 // %Category: Priv
 // %Text: REASSIGN OWNED BY {<name> | CURRENT_USER | SESSION_USER}[,...]
@@ -14873,9 +15675,18 @@ group_by_list:
 // rather than reducing the conflicting unreserved_keyword rule.
 group_by_item:
   a_expr { $$.val = $1.expr() }
-| ROLLUP '(' error { return unimplementedWithIssueDetail(sqllex, 46280, "rollup") }
-| CUBE '(' error { return unimplementedWithIssueDetail(sqllex, 46280, "cube") }
-| GROUPING SETS error { return unimplementedWithIssueDetail(sqllex, 46280, "grouping sets") }
+| ROLLUP '(' expr_list ')'
+  {
+    $$.val = &tree.GroupingSets{Type: tree.GroupingSetsRollup, Exprs: $3.exprs()}
+  }
+| CUBE '(' expr_list ')'
+  {
+    $$.val = &tree.GroupingSets{Type: tree.GroupingSetsCube, Exprs: $3.exprs()}
+  }
+| GROUPING SETS '(' group_by_list ')'
+  {
+    $$.val = &tree.GroupingSets{Type: tree.GroupingSetsExplicit, Exprs: $4.exprs()}
+  }
 
 having_clause:
   HAVING a_expr
@@ -15043,6 +15854,26 @@ opt_index_flags:
     $$.val = (*tree.IndexFlags)(nil)
   }
 
+opt_tablesample_clause:
+  TABLESAMPLE name '(' a_expr ')' opt_repeatable_clause
+  {
+    $$.val = &tree.TableSample{Method: tree.Name($2), Percent: $4.expr(), Seed: $6.expr()}
+  }
+| /* EMPTY */
+  {
+    $$.val = (*tree.TableSample)(nil)
+  }
+
+opt_repeatable_clause:
+  REPEATABLE '(' a_expr ')'
+  {
+    $$.val = $3.expr()
+  }
+| /* EMPTY */
+  {
+    $$.val = tree.Expr(nil)
+  }
+
 // %Help: <SOURCE> - define a data source for SELECT
 // %Category: DML
 // %Text:
@@ -15056,6 +15887,7 @@ opt_index_flags:
 //   <source> NATURAL [ <jointype> ] JOIN <source>
 //   <source> CROSS JOIN <source>
 //   <source> WITH ORDINALITY
+//   <tablename> TABLESAMPLE { BERNOULLI | SYSTEM } ( <percent> ) [ REPEATABLE ( <seed> ) ]
 //   '[' EXPLAIN ... ']'
 //   '[' SHOW ... ']'
 //
@@ -15083,15 +15915,14 @@ table_ref:
         As:         $4.aliasClause(),
     }
   }
-| relation_expr opt_index_flags opt_ordinality opt_alias_clause
+| inheritable_relation_expr opt_index_flags opt_ordinality opt_alias_clause opt_tablesample_clause
   {
-    name := $1.unresolvedObjectName().ToTableName()
-    $$.val = &tree.AliasedTableExpr{
-      Expr:       &name,
-      IndexFlags: $2.indexFlags(),
-      Ordinality: $3.bool(),
-      As:         $4.aliasClause(),
-    }
+    ate := $1.tblExpr().(*tree.AliasedTableExpr)
+    ate.IndexFlags = $2.indexFlags()
+    ate.Ordinality = $3.bool()
+    ate.As = $4.aliasClause()
+    ate.TableSample = $5.tableSample()
+    $$.val = ate
   }
 | select_with_parens opt_ordinality opt_alias_clause
   {
@@ -15440,6 +16271,31 @@ relation_expr:
 | ONLY table_name         { $$.val = $2.unresolvedObjectName() }
 | ONLY '(' table_name ')' { $$.val = $3.unresolvedObjectName() }
 
+// inheritable_relation_expr is like relation_expr, but records whether ONLY
+// was specified, in which case tables inheriting from the named table are
+// excluded from the scan.
+inheritable_relation_expr:
+  table_name
+  {
+    name := $1.unresolvedObjectName().ToTableName()
+    $$.val = &tree.AliasedTableExpr{Expr: &name}
+  }
+| table_name '*'
+  {
+    name := $1.unresolvedObjectName().ToTableName()
+    $$.val = &tree.AliasedTableExpr{Expr: &name}
+  }
+| ONLY table_name
+  {
+    name := $2.unresolvedObjectName().ToTableName()
+    $$.val = &tree.AliasedTableExpr{Expr: &name, Only: true}
+  }
+| ONLY '(' table_name ')'
+  {
+    name := $3.unresolvedObjectName().ToTableName()
+    $$.val = &tree.AliasedTableExpr{Expr: &name, Only: true}
+  }
+
 relation_expr_list:
   relation_expr
   {
@@ -15452,16 +16308,47 @@ relation_expr_list:
     $$.val = append($1.tableNames(), name)
   }
 
-// UNLISTEN
+// %Help: LISTEN - listen for notifications on a channel
+// %Category: Misc
+// %Text: LISTEN <channel>
+// %SeeAlso: NOTIFY, UNLISTEN
+listen_stmt:
+  LISTEN name
+  {
+    $$.val = &tree.Listen{ChannelName: tree.Name($2)}
+  }
+| LISTEN error // SHOW HELP: LISTEN
+
+// %Help: NOTIFY - send a notification on a channel
+// %Category: Misc
+// %Text: NOTIFY <channel> [, <payload> ]
+// %SeeAlso: LISTEN, UNLISTEN
+notify_stmt:
+  NOTIFY name
+  {
+    $$.val = &tree.Notify{ChannelName: tree.Name($2)}
+  }
+| NOTIFY name ',' SCONST
+  {
+    payload := $4
+    $$.val = &tree.Notify{ChannelName: tree.Name($2), Payload: &payload}
+  }
+| NOTIFY error // SHOW HELP: NOTIFY
+
+// %Help: UNLISTEN - stop listening for notifications
+// %Category: Misc
+// %Text: UNLISTEN { <channel> | * }
+// %SeeAlso: LISTEN, NOTIFY
 unlisten_stmt:
-   UNLISTEN type_name
-    {
-        $$.val = &tree.Unlisten{ ChannelName: $2.unresolvedObjectName(), Star: false}
-    }
-|  UNLISTEN '*'
-      {
-          $$.val = &tree.Unlisten{ ChannelName:nil, Star: true}
-      }
+  UNLISTEN name
+  {
+    $$.val = &tree.Unlisten{ChannelName: tree.Name($2)}
+  }
+| UNLISTEN '*'
+  {
+    $$.val = &tree.Unlisten{Star: true}
+  }
+| UNLISTEN error // SHOW HELP: UNLISTEN
 
 
 // Given "UPDATE foo set set ...", we have to decide without looking any
@@ -15504,6 +16391,7 @@ table_name_opt_idx:
     $$.val = &tree.AliasedTableExpr{
       Expr: &name,
       IndexFlags: $3.indexFlags(),
+      Only: $1.bool(),
     }
   }
 
@@ -16635,9 +17523,13 @@ a_expr:
   {
     $$.val = tree.DefaultVal{}
   }
-// The UNIQUE predicate is a standard SQL feature but not yet implemented
-// in PostgreSQL (as of 10.5).
-| UNIQUE '(' error { return unimplemented(sqllex, "UNIQUE predicate") }
+// The UNIQUE predicate is a standard SQL feature that is true if the
+// subquery returns no two equal rows without NULL values. It is not
+// implemented in PostgreSQL (as of 10.5).
+| UNIQUE select_with_parens
+  {
+    $$.val = &tree.Subquery{Select: $2.selectStmt(), Unique: true}
+  }
 
 // Restricted expressions
 //
@@ -16938,7 +17830,10 @@ d_expr:
   {
     $$.val = $2.expr()
   }
-| GROUPING '(' expr_list ')' { return unimplemented(sqllex, "d_expr grouping") }
+| GROUPING '(' expr_list ')'
+  {
+    $$.val = &tree.GroupingExpr{Exprs: $3.exprs()}
+  }
 
 func_application:
   func_application_name '(' ')'
@@ -16949,8 +17844,14 @@ func_application:
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRef(), Exprs: $3.exprs(), OrderBy: $4.orderBy(), AggType: tree.GeneralAgg}
   }
-| func_application_name '(' VARIADIC a_expr opt_sort_clause_no_index ')' { return unimplemented(sqllex, "variadic") }
-| func_application_name '(' expr_list ',' VARIADIC a_expr opt_sort_clause_no_index ')' { return unimplemented(sqllex, "variadic") }
+| func_application_name '(' VARIADIC a_expr opt_sort_clause_no_index ')'
+  {
+    $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRef(), Exprs: tree.Exprs{$4.expr()}, OrderBy: $5.orderBy(), AggType: tree.GeneralAgg, Variadic: true}
+  }
+| func_application_name '(' expr_list ',' VARIADIC a_expr opt_sort_clause_no_index ')'
+  {
+    $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRef(), Exprs: append($3.exprs(), $6.expr()), OrderBy: $7.orderBy(), AggType: tree.GeneralAgg, Variadic: true}
+  }
 | func_application_name '(' ALL expr_list opt_sort_clause_no_index ')'
   {
     $$.val = &tree.FuncExpr{Func: $1.resolvableFuncRef(), Type: tree.AllFuncType, Exprs: $4.exprs(), OrderBy: $5.orderBy(), AggType: tree.GeneralAgg}
@@ -18557,6 +19458,7 @@ unreserved_keyword:
 | BACKUP
 | BACKUPS
 | BACKWARD
+| BASELINES
 | BATCH
 | BEFORE
 | BEGIN
@@ -18641,6 +19543,7 @@ unreserved_keyword:
 | ESCAPE
 | EXCLUDE
 | EXCLUDING
+| EXCLUSIVE
 | EXECUTE
 | EXECUTION
 | EXPERIMENTAL
@@ -18705,6 +19608,7 @@ unreserved_keyword:
 | INCREMENTAL_LOCATION
 | INDEX
 | INDEXES
+| INHERIT
 | INHERITS
 | INJECT
 | INPUT
@@ -18738,7 +19642,9 @@ unreserved_keyword:
 | LINESTRINGZ
 | LINESTRINGZM
 | LIST
+| LISTEN
 | LOCAL
+| LOCK
 | LOCKED
 | LOGICAL
 | LOGICALLY
@@ -18748,6 +19654,7 @@ unreserved_keyword:
 | LOOKUP
 | LOW
 | MATCH
+| MATCHED
 | MATERIALIZED
 | MAXVALUE
 | MERGE
@@ -18780,6 +19687,7 @@ unreserved_keyword:
 | NO
 | NORMAL
 | NOTHING
+| NOTIFY
 | NO_INDEX_JOIN
 | NO_ZIGZAG_JOIN
 | NO_FULL_SCAN
@@ -19025,6 +19933,7 @@ unreserved_keyword:
 | WATCHED_TABLES
 | WITHIN
 | WITHOUT
+| WRAPPER
 | WRITE
 | YEAR
 | ZONE
@@ -19064,6 +19973,7 @@ bare_label_keywords:
 | BACKUP
 | BACKUPS
 | BACKWARD
+| BASELINES
 | BATCH
 | BEFORE
 | BEGIN
@@ -19179,6 +20089,7 @@ bare_label_keywords:
 | ESCAPE
 | EXCLUDE
 | EXCLUDING
+| EXCLUSIVE
 | EXECUTE
 | EXECUTION
 | EXISTS
@@ -19262,6 +20173,7 @@ bare_label_keywords:
 | INDEX_AFTER_ORDER_BY_BEFORE_AT
 | INDEX_BEFORE_NAME_THEN_PAREN
 | INDEX_BEFORE_PAREN
+| INHERIT
 | INHERITS
 | INITIALLY
 | INJECT
@@ -19310,10 +20222,12 @@ bare_label_keywords:
 | LINESTRINGZ
 | LINESTRINGZM
 | LIST
+| LISTEN
 | LOCAL
 | LOCALITY
 | LOCALTIME
 | LOCALTIMESTAMP
+| LOCK
 | LOCKED
 | LOGGED
 | LOGICAL
@@ -19322,6 +20236,7 @@ bare_label_keywords:
 | LOOKUP
 | LOW
 | MATCH
+| MATCHED
 | MATERIALIZED
 | MAXVALUE
 | MERGE
@@ -19369,6 +20284,7 @@ bare_label_keywords:
 | NOT
 | NOTHING
 | NOTHING_AFTER_RETURNING
+| NOTIFY
 | NOVIEWACTIVITY
 | NOVIEWACTIVITYREDACTED
 | NOVIEWCLUSTERSETTING
@@ -19567,6 +20483,7 @@ bare_label_keywords:
 | SYSTEM
 | TABLE
 | TABLES
+| TABLESAMPLE
 | TABLESPACE
 | TEMP
 | TEMPLATE
@@ -19641,6 +20558,7 @@ bare_label_keywords:
 | WATCHED_TABLES
 | WHEN
 | WORK
+| WRAPPER
 | WRITE
 | ZONE
 
@@ -19752,6 +20670,7 @@ type_func_name_no_crdb_extra_keyword:
 | OVERLAPS
 | RIGHT
 | SIMILAR
+| TABLESAMPLE
 
 // CockroachDB-specific keywords that can be used in type/function
 // identifiers.
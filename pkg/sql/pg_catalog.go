@@ -16,6 +16,7 @@ import (
 	"time"
 	"unicode"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/keys"
 	"github.com/cockroachdb/cockroach/pkg/security/username"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
@@ -31,6 +32,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/typedesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/oidext"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsn"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/prep"
@@ -745,6 +747,7 @@ var (
 	relKindView             = tree.NewDString("v")
 	relKindMaterializedView = tree.NewDString("m")
 	relKindSequence         = tree.NewDString("S")
+	relKindForeignTable     = tree.NewDString("f")
 
 	relPersistencePermanent = tree.NewDString("p")
 	relPersistenceTemporary = tree.NewDString("t")
@@ -774,6 +777,10 @@ https://www.postgresql.org/docs/9.5/catalog-pg-class.html`,
 			relKind = relKindSequence
 			relAm = oidZero
 			replIdent = "n"
+		} else if table.IsForeignTable() {
+			relKind = relKindForeignTable
+			relAm = oidZero
+			replIdent = "n"
 		}
 		relPersistence := relPersistencePermanent
 		if table.IsTemporary() {
@@ -824,10 +831,10 @@ https://www.postgresql.org/docs/9.5/catalog-pg-class.html`,
 			tree.MakeDBool(tree.DBool(table.IsPhysicalTable())), // relhaspkey
 			tree.DBoolFalse, // relhasrules
 			tree.DBoolFalse, // relhastriggers
-			tree.DBoolFalse, // relhassubclass
-			zeroVal,         // relfrozenxid
-			tree.DNull,      // relacl
-			relOptions,      // reloptions
+			tree.MakeDBool(tree.DBool(len(table.GetInheritedBy()) > 0)), // relhassubclass
+			zeroVal,    // relfrozenxid
+			tree.DNull, // relacl
+			relOptions, // reloptions
 			// These columns were automatically created by pg_catalog_test's missing column generator.
 			tree.MakeDBool(tree.DBool(table.IsRowLevelSecurityForced())), // relforcerowsecurity
 			tree.DNull,                 // relispartition
@@ -948,7 +955,6 @@ var (
 
 	// Avoid unused warning for constants.
 	_ = conTypeTrigger
-	_ = conTypeExclusion
 
 	fkActionNone       = tree.NewDString("a")
 	fkActionRestrict   = tree.NewDString("r")
@@ -1115,13 +1121,24 @@ func populateTableConstraints(
 			conoid = h.UniqueWithoutIndexConstraintOid(
 				db.GetID(), sc.GetID(), table.GetID(), uwoi,
 			)
-			f.WriteString("UNIQUE WITHOUT INDEX (")
-			colNames, err := catalog.ColumnNamesForIDs(table, uwoi.UniqueWithoutIndexDesc().ColumnIDs)
-			if err != nil {
-				return err
+			uwiDesc := uwoi.UniqueWithoutIndexDesc()
+			if uwoi.IsExclusion() {
+				contype = conTypeExclusion
+				elems, err := showExclusionConstraintElems(table, uwiDesc)
+				if err != nil {
+					return err
+				}
+				f.WriteString(elems)
+			} else {
+				f.WriteString("UNIQUE WITHOUT INDEX (")
+				colNames, err := catalog.ColumnNamesForIDs(table, uwiDesc.ColumnIDs)
+				if err != nil {
+					return err
+				}
+				f.WriteString(strings.Join(colNames, ", "))
+				f.WriteByte(')')
 			}
-			f.WriteString(strings.Join(colNames, ", "))
-			f.WriteByte(')')
+			f.WriteString(showConstraintDeferrability(uwiDesc.Deferrable, uwiDesc.InitiallyDeferred))
 			if !uwoi.IsConstraintValidated() {
 				f.WriteString(" NOT VALID")
 			}
@@ -1152,13 +1169,16 @@ func populateTableConstraints(
 			condef = tree.NewDString(fmt.Sprintf("CHECK ((%s))%s", displayExpr, validity))
 		}
 
+		deferrable, initiallyDeferred := constraintDeferrability(c)
+		condeferrable := tree.MakeDBool(tree.DBool(deferrable))
+		condeferred := tree.MakeDBool(tree.DBool(initiallyDeferred))
 		if err := addRow(
 			conoid,                   // oid
 			dNameOrNull(c.GetName()), // conname
 			namespaceOid,             // connamespace
 			contype,                  // contype
-			tree.DBoolFalse,          // condeferrable
-			tree.DBoolFalse,          // condeferred
+			condeferrable,            // condeferrable
+			condeferred,              // condeferred
 			tree.MakeDBool(tree.DBool(!c.IsConstraintUnvalidated())), // convalidated
 			tblOid,         // conrelid
 			oidZero,        // contypid
@@ -2197,14 +2217,26 @@ func indexDefFromDescriptor(
 }
 
 var pgCatalogInheritsTable = virtualSchemaTable{
-	comment: `table inheritance hierarchy (empty - feature does not exist)
+	comment: `table inheritance hierarchy
 https://www.postgresql.org/docs/9.5/catalog-pg-inherits.html`,
 	schema: vtable.PGCatalogInherits,
-	populate: func(_ context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
-		// Table inheritance is not supported.
-		return nil
+	populate: func(ctx context.Context, p *planner, dbContext catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		opts := forEachTableDescOptions{virtualOpts: hideVirtual} /* virtual tables cannot inherit */
+		return forEachTableDesc(ctx, p, dbContext, opts,
+			func(ctx context.Context, descCtx tableDescContext) error {
+				table := descCtx.table
+				for i, parentID := range table.GetInheritsFrom() {
+					if err := addRow(
+						tableOid(table.GetID()),      // inhrelid
+						tableOid(parentID),           // inhparent
+						tree.NewDInt(tree.DInt(i+1)), // inhseqno
+					); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
 	},
-	unimplemented: true,
 }
 
 // Match the OIDs that Postgres uses for languages.
@@ -2740,6 +2772,8 @@ func addPgProcUDFRow(
 	kind := proKindFunction
 	if fnDesc.IsProcedure() {
 		kind = proKindProcedure
+	} else if fnDesc.IsAggregate() {
+		kind = proKindAggregate
 	}
 
 	lang := languageInternalOid
@@ -2755,6 +2789,8 @@ func addPgProcUDFRow(
 	var foundAnyArgNames bool
 	var nArgs, nArgDefaults int
 	var argDefaultsBuilder strings.Builder
+	// variadic is the element type of the VARIADIC parameter, if any.
+	variadic := oidZero
 	for _, param := range fnDesc.GetParams() {
 		class := funcdesc.ToTreeRoutineParamClass(param.Class)
 		if tree.IsInParamClass(class) {
@@ -2793,6 +2829,7 @@ func addPgProcUDFRow(
 			argMode = proArgModeInOut
 		case tree.RoutineParamVariadic:
 			argMode = proArgModeVariadic
+			variadic = tree.NewDOid(param.Type.ArrayContents().Oid())
 		default:
 			return errors.AssertionFailedf("unknown parameter class %d", class)
 		}
@@ -2828,7 +2865,7 @@ func addPgProcUDFRow(
 		lang,            // prolang
 		tree.DNull,      // procost
 		tree.DNull,      // prorows
-		oidZero,         // provariadic // TODO(88947): this might need an adjustment.
+		variadic,        // provariadic
 		tree.DNull,      // prosupport
 		kind,            // prokind
 		tree.DBoolFalse, // prosecdef
@@ -2846,11 +2883,11 @@ func addPgProcUDFRow(
 		argNames,                                        // proargnames
 		argDefaults,                                     // proargdefaults
 		tree.DNull,                                      // protrftypes
-		tree.NewDString(fnDesc.GetFunctionBody()),       // prosrc
-		tree.DNull,                                      // probin
-		tree.DNull,                                      // prosqlbody
-		tree.DNull,                                      // proconfig
-		tree.DNull,                                      // proacl
+		tree.NewDString(fnDesc.GetFunctionBody()), // prosrc
+		tree.DNull, // probin
+		tree.DNull, // prosqlbody
+		tree.DNull, // proconfig
+		tree.DNull, // proacl
 	)
 }
 
@@ -3497,7 +3534,6 @@ var (
 	typTypeRange     = tree.NewDString("r")
 
 	// Avoid unused warning for constants.
-	_ = typTypeDomain
 	_ = typTypePseudo
 	_ = typTypeRange
 
@@ -3645,6 +3681,19 @@ func addPGTypeRow(
 	if cat == typCategoryPseudo {
 		typType = typTypePseudo
 	}
+	typNotNull := tree.DBoolFalse
+	typBaseType := oidZero
+	var typDefault tree.Datum = tree.DNull
+	if typ.IsDomain() {
+		typType = typTypeDomain
+		typBaseType = tree.NewDOid(typ.DomainBaseType().Oid())
+		if domain := typ.TypeMeta.DomainData; domain != nil {
+			typNotNull = tree.MakeDBool(tree.DBool(domain.NotNull))
+			if domain.DefaultExpr != nil {
+				typDefault = tree.NewDString(*domain.DefaultExpr)
+			}
+		}
+	}
 	typname := typ.PGName()
 	typDelim := tree.NewDString(typ.Delimiter())
 	return addRow(
@@ -3674,13 +3723,13 @@ func addPGTypeRow(
 
 		tree.DNull,      // typalign
 		tree.DNull,      // typstorage
-		tree.DBoolFalse, // typnotnull
-		oidZero,         // typbasetype
+		typNotNull,      // typnotnull
+		typBaseType,     // typbasetype
 		negOneVal,       // typtypmod
 		zeroVal,         // typndims
 		typColl(typ, h), // typcollation
 		tree.DNull,      // typdefaultbin
-		tree.DNull,      // typdefault
+		typDefault,      // typdefault
 		tree.DNull,      // typacl
 	)
 }
@@ -4589,9 +4638,9 @@ https://www.postgresql.org/docs/17/catalog-pg-policy.html`,
 				tableOid(table.GetID()),                                    // polrelid
 				tree.NewDString(cmd),                                       // polcmd
 				tree.MakeDBool(policy.Type == catpb.PolicyType_PERMISSIVE), // polpermissive
-				treeRoleOids, // polroles
-				usingExpr,    // polqual
-				checkExpr,    // polwithcheck
+				treeRoleOids,                                               // polroles
+				usingExpr,                                                  // polqual
+				checkExpr,                                                  // polwithcheck
 			); err != nil {
 				return err
 			}
@@ -4628,12 +4677,35 @@ var pgCatalogStatUserFunctionsTable = virtualSchemaTable{
 }
 
 var pgCatalogPublicationTable = virtualSchemaTable{
-	comment: "pg_publication was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogPublication,
-	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+	comment: `publications of the current database
+https://www.postgresql.org/docs/17/catalog-pg-publication.html`,
+	schema: vtable.PgCatalogPublication,
+	populate: func(ctx context.Context, p *planner, dbContext catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		if dbContext == nil || !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_AddSystemPublicationsTables) {
+			return nil
+		}
+		pubs, err := loadPublications(ctx, p.InternalSQLTxn(), dbContext.GetID())
+		if err != nil {
+			return err
+		}
+		h := makeOidHasher()
+		for _, pub := range pubs {
+			if err := addRow(
+				h.PublicationOid(dbContext.GetID(), pub.name), // oid
+				tree.NewDName(pub.name),                       // pubname
+				h.UserOid(pub.owner),                          // pubowner
+				tree.MakeDBool(tree.DBool(pub.allTables)),     // puballtables
+				tree.DBoolTrue,                                // pubinsert
+				tree.DBoolTrue,                                // pubupdate
+				tree.DBoolTrue,                                // pubdelete
+				tree.DBoolFalse,                               // pubtruncate
+				tree.DBoolFalse,                               // pubviaroot
+			); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogAmprocTable = virtualSchemaTable{
@@ -4788,12 +4860,38 @@ var pgCatalogStatXactUserTablesTable = virtualSchemaTable{
 }
 
 var pgCatalogPublicationTablesTable = virtualSchemaTable{
-	comment: "pg_publication_tables was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogPublicationTables,
-	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+	comment: `tables published by the publications of the current database
+https://www.postgresql.org/docs/17/view-pg-publication-tables.html`,
+	schema: vtable.PgCatalogPublicationTables,
+	populate: func(ctx context.Context, p *planner, dbContext catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		if dbContext == nil || !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_AddSystemPublicationsTables) {
+			return nil
+		}
+		pubs, err := loadPublications(ctx, p.InternalSQLTxn(), dbContext.GetID())
+		if err != nil {
+			return err
+		}
+		for _, pub := range pubs {
+			tables, err := publishedTables(ctx, p.InternalSQLTxn(), dbContext, []publication{pub})
+			if err != nil {
+				return err
+			}
+			for _, tbl := range tables {
+				sc, err := p.Descriptors().ByIDWithLeased(p.txn).Get().Schema(ctx, tbl.GetParentSchemaID())
+				if err != nil {
+					return err
+				}
+				if err := addRow(
+					tree.NewDName(pub.name),      // pubname
+					tree.NewDName(sc.GetName()),  // schemaname
+					tree.NewDName(tbl.GetName()), // tablename
+				); err != nil {
+					return err
+				}
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogStatProgressClusterTable = virtualSchemaTable{
@@ -4824,12 +4922,58 @@ var pgCatalogLargeobjectMetadataTable = virtualSchemaTable{
 }
 
 var pgCatalogReplicationSlotsTable = virtualSchemaTable{
-	comment: "pg_replication_slots was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogReplicationSlots,
+	comment: `replication slots
+https://www.postgresql.org/docs/17/view-pg-replication-slots.html`,
+	schema: vtable.PgCatalogReplicationSlots,
 	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		if !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_AddSystemPublicationsTables) {
+			return nil
+		}
+		rows, err := p.InternalSQLTxn().QueryBufferedEx(
+			ctx,
+			"select-replication-slots",
+			p.Txn(),
+			sessiondata.NodeUserSessionDataOverride,
+			`SELECT s.slot_name, s.plugin, s.database_id, n.name, s.confirmed_flush_lsn
+FROM system.replication_slots AS s
+LEFT JOIN system.namespace AS n ON n."parentID" = 0 AND n."parentSchemaID" = 0 AND n.id = s.database_id
+ORDER BY s.slot_name`,
+		)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			dbID := descpb.ID(tree.MustBeDInt(row[2]))
+			database := tree.DNull
+			if row[3] != tree.DNull {
+				database = tree.NewDName(string(tree.MustBeDString(row[3])))
+			}
+			// Changes are streamed from the confirmed flush position, so it is
+			// also the restart position.
+			confirmedFlushLSN := tree.NewDString(lsn.LSN(tree.MustBeDInt(row[4])).String())
+			// The activity of the slots is not tracked, since the walsender that
+			// uses a slot may run on any node.
+			if err := addRow(
+				tree.NewDName(string(tree.MustBeDString(row[0]))), // slot_name
+				tree.NewDName(string(tree.MustBeDString(row[1]))), // plugin
+				tree.NewDString("logical"),                        // slot_type
+				dbOid(dbID),                                       // datoid
+				database,                                          // database
+				tree.DBoolFalse,                                   // temporary
+				tree.DBoolFalse,                                   // active
+				tree.DNull,                                        // active_pid
+				tree.DNull,                                        // xmin
+				tree.DNull,                                        // catalog_xmin
+				confirmedFlushLSN,                                 // restart_lsn
+				confirmedFlushLSN,                                 // confirmed_flush_lsn
+				tree.NewDString("reserved"),                       // wal_status
+				tree.DNull,                                        // safe_wal_size
+			); err != nil {
+				return err
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogSubscriptionRelTable = virtualSchemaTable{
@@ -5100,12 +5244,39 @@ var pgCatalogStatReplicationTable = virtualSchemaTable{
 }
 
 var pgCatalogPublicationRelTable = virtualSchemaTable{
-	comment: "pg_publication_rel was created for compatibility and is currently unimplemented",
-	schema:  vtable.PgCatalogPublicationRel,
-	populate: func(ctx context.Context, p *planner, _ catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+	comment: `tables explicitly added to the publications of the current database
+https://www.postgresql.org/docs/17/catalog-pg-publication-rel.html`,
+	schema: vtable.PgCatalogPublicationRel,
+	populate: func(ctx context.Context, p *planner, dbContext catalog.DatabaseDescriptor, addRow func(...tree.Datum) error) error {
+		if dbContext == nil || !p.ExecCfg().Settings.Version.IsActive(ctx, clusterversion.V26_1_AddSystemPublicationsTables) {
+			return nil
+		}
+		pubs, err := loadPublications(ctx, p.InternalSQLTxn(), dbContext.GetID())
+		if err != nil {
+			return err
+		}
+		h := makeOidHasher()
+		for _, pub := range pubs {
+			if pub.allTables {
+				continue
+			}
+			tables, err := publishedTables(ctx, p.InternalSQLTxn(), dbContext, []publication{pub})
+			if err != nil {
+				return err
+			}
+			pubOid := h.PublicationOid(dbContext.GetID(), pub.name)
+			for _, tbl := range tables {
+				if err := addRow(
+					h.PublicationRelOid(dbContext.GetID(), pub.name, tbl.GetID()), // oid
+					pubOid,                // prpubid
+					tableOid(tbl.GetID()), // prrelid
+				); err != nil {
+					return err
+				}
+			}
+		}
 		return nil
 	},
-	unimplemented: true,
 }
 
 var pgCatalogAvailableExtensionVersionsTable = virtualSchemaTable{
@@ -5409,6 +5580,8 @@ const (
 	castTypeTag
 	triggerTypeTag
 	policyTypeTag
+	publicationTypeTag
+	publicationRelTypeTag
 )
 
 func (h oidHasher) writeTypeTag(tag oidTypeTag) {
@@ -5622,6 +5795,21 @@ func (h oidHasher) PolicyOid(tableID descpb.ID, policyID descpb.PolicyID) *tree.
 	return h.getOid()
 }
 
+func (h oidHasher) PublicationOid(dbID descpb.ID, name string) *tree.DOid {
+	h.writeTypeTag(publicationTypeTag)
+	h.writeDB(dbID)
+	h.writeStr(name)
+	return h.getOid()
+}
+
+func (h oidHasher) PublicationRelOid(dbID descpb.ID, name string, tableID descpb.ID) *tree.DOid {
+	h.writeTypeTag(publicationRelTypeTag)
+	h.writeDB(dbID)
+	h.writeStr(name)
+	h.writeTable(tableID)
+	return h.getOid()
+}
+
 func funcVolatility(v catpb.Function_Volatility) string {
 	switch v {
 	case catpb.Function_IMMUTABLE:
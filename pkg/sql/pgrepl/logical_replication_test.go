@@ -0,0 +1,241 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package pgrepl
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsn"
+	"github.com/cockroachdb/cockroach/pkg/testutils/serverutils"
+	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStartReplication streams the changes of a publication with
+// START_REPLICATION and checks the decoded pgoutput messages.
+func TestStartReplication(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+	srv, db, _ := serverutils.StartServer(t, base.TestServerArgs{})
+	defer srv.Stopper().Stop(ctx)
+	s := srv.ApplicationLayer()
+
+	sqlDB := sqlutils.MakeSQLRunner(db)
+	sqlDB.Exec(t, `SET CLUSTER SETTING kv.rangefeed.enabled = true`)
+	sqlDB.Exec(t, `CREATE TABLE t (k INT PRIMARY KEY, v STRING)`)
+	sqlDB.Exec(t, `CREATE TABLE unpublished (k INT PRIMARY KEY)`)
+	sqlDB.Exec(t, `CREATE PUBLICATION p FOR TABLE t`)
+
+	pgURL, cleanup := s.PGUrl(
+		t, serverutils.CertsDirPrefix("pgrepl_start_replication_test"), serverutils.User(username.RootUser),
+	)
+	defer cleanup()
+	cfg, err := pgconn.ParseConfig(pgURL.String())
+	require.NoError(t, err)
+	cfg.RuntimeParams["replication"] = "database"
+	conn, err := pgconn.ConnectConfig(ctx, cfg)
+	require.NoError(t, err)
+	defer func() { _ = conn.Close(ctx) }()
+
+	results, err := conn.Exec(ctx, `CREATE_REPLICATION_SLOT s LOGICAL pgoutput (SNAPSHOT 'export')`).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Len(t, results[0].Rows, 1)
+	row := results[0].Rows[0]
+	require.Equal(t, "s", string(row[0]))
+	consistentPoint, err := lsn.ParseLSN(string(row[1]))
+	require.NoError(t, err)
+	require.NotEmpty(t, row[2])
+	require.Equal(t, "pgoutput", string(row[3]))
+
+	_, err = conn.Exec(ctx, `CREATE_REPLICATION_SLOT s LOGICAL pgoutput`).ReadAll()
+	require.ErrorContains(t, err, `replication slot "s" already exists`)
+
+	sqlDB.Exec(t, `INSERT INTO t VALUES (1, 'a')`)
+	sqlDB.Exec(t, `INSERT INTO unpublished VALUES (1)`)
+	sqlDB.Exec(t, `INSERT INTO t VALUES (2, NULL)`)
+	sqlDB.Exec(t, `UPDATE t SET v = 'b' WHERE k = 1`)
+	sqlDB.Exec(t, `DELETE FROM t WHERE k = 2`)
+
+	fe := conn.Frontend()
+	fe.Send(&pgproto3.Query{
+		String: `START_REPLICATION SLOT s LOGICAL 0/0 (proto_version '1', publication_names 'p')`,
+	})
+	require.NoError(t, fe.Flush())
+	msg, err := fe.Receive()
+	require.NoError(t, err)
+	require.IsType(t, &pgproto3.CopyBothResponse{}, msg)
+
+	// Read until the transaction of the last change is committed.
+	var changes []string
+	var lastCommit lsn.LSN
+	for committed := false; !committed; {
+		msg, err := fe.Receive()
+		require.NoError(t, err)
+		data, ok := msg.(*pgproto3.CopyData)
+		require.True(t, ok, "unexpected message %T", msg)
+		switch data.Data[0] {
+		case 'k':
+			// Keepalive.
+			continue
+		case 'w':
+		default:
+			t.Fatalf("unexpected CopyData message %q", data.Data[0])
+		}
+		walEnd := lsn.LSN(binary.BigEndian.Uint64(data.Data[9:17]))
+		change := decodePgoutput(t, data.Data[25:])
+		switch change {
+		case "BEGIN":
+			require.Greater(t, walEnd, consistentPoint)
+		case "COMMIT":
+			lastCommit = walEnd
+			committed = len(changes) == 5
+		default:
+			changes = append(changes, change)
+		}
+	}
+	require.Equal(t, []string{
+		"RELATION public.t (k* 20, v 25)",
+		"INSERT (1, a)",
+		"INSERT (2, NULL)",
+		"UPDATE (1, b)",
+		"DELETE (2, NULL)",
+	}, changes)
+
+	// Confirm the last transaction and end the stream.
+	status := []byte{'r'}
+	for i := 0; i < 3; i++ {
+		status = binary.BigEndian.AppendUint64(status, uint64(lastCommit))
+	}
+	status = binary.BigEndian.AppendUint64(status, 0)
+	status = append(status, 0)
+	fe.Send(&pgproto3.CopyData{Data: status})
+	fe.Send(&pgproto3.CopyDone{})
+	require.NoError(t, fe.Flush())
+	var sawCopyDone bool
+	for {
+		msg, err := fe.Receive()
+		require.NoError(t, err)
+		if _, ok := msg.(*pgproto3.ReadyForQuery); ok {
+			break
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.CopyDone:
+			sawCopyDone = true
+		case *pgproto3.ErrorResponse:
+			t.Fatalf("unexpected error: %s", msg.Message)
+		}
+	}
+	require.True(t, sawCopyDone)
+
+	sqlDB.CheckQueryResults(t,
+		`SELECT slot_name, plugin, database, confirmed_flush_lsn FROM pg_catalog.pg_replication_slots`,
+		[][]string{{"s", "pgoutput", "defaultdb", lastCommit.String()}},
+	)
+
+	// The connection can still be used once the stream has ended.
+	_, err = conn.Exec(ctx, `DROP_REPLICATION_SLOT s`).ReadAll()
+	require.NoError(t, err)
+	sqlDB.CheckQueryResults(t, `SELECT count(*) FROM pg_catalog.pg_replication_slots`, [][]string{{"0"}})
+}
+
+// decodePgoutput decodes a message of the pgoutput plugin into a string.
+func decodePgoutput(t *testing.T, msg []byte) string {
+	r := bytes.NewReader(msg[1:])
+	readString := func() string {
+		var b strings.Builder
+		for {
+			c, err := r.ReadByte()
+			require.NoError(t, err)
+			if c == 0 {
+				return b.String()
+			}
+			b.WriteByte(c)
+		}
+	}
+	read := func(v interface{}) {
+		require.NoError(t, binary.Read(r, binary.BigEndian, v))
+	}
+	readTuple := func() string {
+		var n int16
+		read(&n)
+		var vals []string
+		for i := 0; i < int(n); i++ {
+			kind, err := r.ReadByte()
+			require.NoError(t, err)
+			switch kind {
+			case 'n':
+				vals = append(vals, "NULL")
+			case 't':
+				var l int32
+				read(&l)
+				val := make([]byte, l)
+				_, err := r.Read(val)
+				require.NoError(t, err)
+				vals = append(vals, string(val))
+			default:
+				t.Fatalf("unexpected tuple data kind %q", kind)
+			}
+		}
+		return "(" + strings.Join(vals, ", ") + ")"
+	}
+	var relID uint32
+	switch msg[0] {
+	case 'B':
+		return "BEGIN"
+	case 'C':
+		return "COMMIT"
+	case 'R':
+		read(&relID)
+		namespace := readString()
+		name := readString()
+		var replicaIdentity byte
+		var n int16
+		read(&replicaIdentity)
+		read(&n)
+		var cols []string
+		for i := 0; i < int(n); i++ {
+			var flags byte
+			read(&flags)
+			colName := readString()
+			var typeOID uint32
+			var typeMod int32
+			read(&typeOID)
+			read(&typeMod)
+			if flags&1 != 0 {
+				colName += "*"
+			}
+			cols = append(cols, fmt.Sprintf("%s %d", colName, typeOID))
+		}
+		return fmt.Sprintf("RELATION %s.%s (%s)", namespace, name, strings.Join(cols, ", "))
+	case 'I', 'U', 'D':
+		read(&relID)
+		kind, err := r.ReadByte()
+		require.NoError(t, err)
+		if msg[0] == 'D' {
+			require.Equal(t, byte('K'), kind)
+		} else {
+			require.Equal(t, byte('N'), kind)
+		}
+		return map[byte]string{'I': "INSERT", 'U': "UPDATE", 'D': "DELETE"}[msg[0]] + " " + readTuple()
+	default:
+		t.Fatalf("unexpected pgoutput message %q", msg[0])
+		return ""
+	}
+}
@@ -6,7 +6,7 @@
 package lsnutil
 
 import (
-	"time"
+	"math"
 
 	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsn"
 	"github.com/cockroachdb/cockroach/pkg/util/hlc"
@@ -14,7 +14,18 @@ import (
 
 // HLCToLSN converts a HLC to a LSN.
 // It is in a separate package to prevent the `lsn` package importing `log`.
+//
+// The LSN is the wall time of the timestamp in nanoseconds, so all the
+// timestamps that share a wall time map to the same LSN. Logical replication
+// streams all the changes committed at the same wall time as a single
+// transaction.
 func HLCToLSN(h hlc.Timestamp) lsn.LSN {
-	// TODO(#105130): correctly populate this field.
-	return lsn.LSN(h.WallTime/int64(time.Millisecond)) << 32
+	return lsn.LSN(h.WallTime)
+}
+
+// LSNToHLC converts a LSN back into a HLC. It returns the highest timestamp
+// that maps to the LSN, so that a reader at the returned timestamp observes
+// all the changes up to and including the LSN.
+func LSNToHLC(l lsn.LSN) hlc.Timestamp {
+	return hlc.Timestamp{WallTime: int64(l), Logical: math.MaxInt32}
 }
@@ -0,0 +1,231 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+// Package pgoutput encodes the messages of the Postgres logical replication
+// protocol as produced by the pgoutput output plugin, along with the
+// streaming replication messages that carry them over a CopyBoth stream.
+//
+// See https://www.postgresql.org/docs/current/protocol-logicalrep-message-formats.html
+// and https://www.postgresql.org/docs/current/protocol-replication.html.
+package pgoutput
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsn"
+	"github.com/cockroachdb/errors"
+	"github.com/lib/pq/oid"
+)
+
+// Message types of the logical replication protocol.
+const (
+	msgBegin    = 'B'
+	msgCommit   = 'C'
+	msgRelation = 'R'
+	msgInsert   = 'I'
+	msgUpdate   = 'U'
+	msgDelete   = 'D'
+)
+
+// Message types of the streaming replication protocol, sent inside CopyData
+// messages.
+const (
+	msgXLogData            = 'w'
+	msgPrimaryKeepalive    = 'k'
+	msgStandbyStatusUpdate = 'r'
+	msgHotStandbyFeedback  = 'h'
+)
+
+const (
+	tupleNew               = 'N'
+	tupleKey               = 'K'
+	tupleDataNull          = 'n'
+	tupleDataText          = 't'
+	replicaIdentityDefault = 'd'
+	columnFlagPartOfKey    = 1
+
+	// standbyStatusUpdateLen is the length of a standby status update: the
+	// message type, three LSNs, the client time and the reply flag.
+	standbyStatusUpdateLen = 1 + 8 + 8 + 8 + 8 + 1
+)
+
+// postgresEpoch is the epoch used by Postgres for timestamps in the
+// replication protocol.
+var postgresEpoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Column describes a column of a Relation.
+type Column struct {
+	Name    string
+	TypeOID oid.Oid
+	TypeMod int32
+	// IsKey is set if the column is part of the replica identity, which is
+	// the primary key.
+	IsKey bool
+}
+
+// Relation describes a table whose changes are streamed.
+type Relation struct {
+	ID        oid.Oid
+	Namespace string
+	Name      string
+	Columns   []Column
+}
+
+// Tuple holds the text encoding of each value of a row. A nil entry
+// represents NULL.
+type Tuple [][]byte
+
+// toPGTime converts a time to microseconds since the Postgres epoch.
+func toPGTime(t time.Time) int64 {
+	return t.Sub(postgresEpoch).Microseconds()
+}
+
+// AppendBegin appends a Begin message for a transaction that commits at
+// finalLSN.
+func AppendBegin(buf []byte, finalLSN lsn.LSN, commitTime time.Time, xid uint32) []byte {
+	buf = append(buf, msgBegin)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(finalLSN))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(toPGTime(commitTime)))
+	return binary.BigEndian.AppendUint32(buf, xid)
+}
+
+// AppendCommit appends a Commit message.
+func AppendCommit(buf []byte, commitLSN, endLSN lsn.LSN, commitTime time.Time) []byte {
+	buf = append(buf, msgCommit)
+	buf = append(buf, 0 /* flags */)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(commitLSN))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(endLSN))
+	return binary.BigEndian.AppendUint64(buf, uint64(toPGTime(commitTime)))
+}
+
+// AppendRelation appends a Relation message. It must be sent before the first
+// change to a relation, and again whenever the relation's schema changes.
+func AppendRelation(buf []byte, rel *Relation) []byte {
+	buf = append(buf, msgRelation)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(rel.ID))
+	buf = appendCString(buf, rel.Namespace)
+	buf = appendCString(buf, rel.Name)
+	buf = append(buf, replicaIdentityDefault)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(rel.Columns)))
+	for _, c := range rel.Columns {
+		var flags byte
+		if c.IsKey {
+			flags |= columnFlagPartOfKey
+		}
+		buf = append(buf, flags)
+		buf = appendCString(buf, c.Name)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(c.TypeOID))
+		buf = binary.BigEndian.AppendUint32(buf, uint32(c.TypeMod))
+	}
+	return buf
+}
+
+// AppendInsert appends an Insert message carrying the new row.
+func AppendInsert(buf []byte, relID oid.Oid, newTuple Tuple) []byte {
+	buf = append(buf, msgInsert)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(relID))
+	buf = append(buf, tupleNew)
+	return appendTuple(buf, newTuple)
+}
+
+// AppendUpdate appends an Update message carrying the new row. The old row is
+// not sent, which matches the default replica identity when the primary key
+// does not change.
+func AppendUpdate(buf []byte, relID oid.Oid, newTuple Tuple) []byte {
+	buf = append(buf, msgUpdate)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(relID))
+	buf = append(buf, tupleNew)
+	return appendTuple(buf, newTuple)
+}
+
+// AppendDelete appends a Delete message. keyTuple has an entry for every
+// column of the relation, with NULL for the columns that are not part of the
+// key.
+func AppendDelete(buf []byte, relID oid.Oid, keyTuple Tuple) []byte {
+	buf = append(buf, msgDelete)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(relID))
+	buf = append(buf, tupleKey)
+	return appendTuple(buf, keyTuple)
+}
+
+// AppendXLogData appends the header of an XLogData message. The logical
+// replication message that it carries must be appended to the result.
+func AppendXLogData(buf []byte, walStart, walEnd lsn.LSN, sendTime time.Time) []byte {
+	buf = append(buf, msgXLogData)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(walStart))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(walEnd))
+	return binary.BigEndian.AppendUint64(buf, uint64(toPGTime(sendTime)))
+}
+
+// AppendPrimaryKeepalive appends a Primary keepalive message. If
+// replyRequested is set, the client is expected to answer with a standby
+// status update as soon as possible.
+func AppendPrimaryKeepalive(
+	buf []byte, walEnd lsn.LSN, sendTime time.Time, replyRequested bool,
+) []byte {
+	buf = append(buf, msgPrimaryKeepalive)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(walEnd))
+	buf = binary.BigEndian.AppendUint64(buf, uint64(toPGTime(sendTime)))
+	if replyRequested {
+		return append(buf, 1)
+	}
+	return append(buf, 0)
+}
+
+// StandbyStatusUpdate is the progress report sent by a client.
+type StandbyStatusUpdate struct {
+	// Written, Flushed and Applied are the positions up to which the client
+	// has written, flushed and applied the stream.
+	Written, Flushed, Applied lsn.LSN
+	// ReplyRequested is set if the client wants an immediate keepalive.
+	ReplyRequested bool
+}
+
+// IsHotStandbyFeedback returns whether a message sent by the client is a hot
+// standby feedback message, which only matters for physical replication and
+// can be ignored.
+func IsHotStandbyFeedback(msg []byte) bool {
+	return len(msg) > 0 && msg[0] == msgHotStandbyFeedback
+}
+
+// ParseStandbyStatusUpdate parses a standby status update message sent by a
+// client inside a CopyData message.
+func ParseStandbyStatusUpdate(msg []byte) (StandbyStatusUpdate, error) {
+	if len(msg) == 0 || msg[0] != msgStandbyStatusUpdate {
+		return StandbyStatusUpdate{}, errors.Newf("unexpected replication message")
+	}
+	if len(msg) < standbyStatusUpdateLen {
+		return StandbyStatusUpdate{}, errors.Newf(
+			"standby status update has %d bytes, expected %d", len(msg), standbyStatusUpdateLen,
+		)
+	}
+	msg = msg[1:]
+	return StandbyStatusUpdate{
+		Written:        lsn.LSN(binary.BigEndian.Uint64(msg[0:])),
+		Flushed:        lsn.LSN(binary.BigEndian.Uint64(msg[8:])),
+		Applied:        lsn.LSN(binary.BigEndian.Uint64(msg[16:])),
+		ReplyRequested: msg[32] != 0,
+	}, nil
+}
+
+func appendCString(buf []byte, s string) []byte {
+	buf = append(buf, s...)
+	return append(buf, 0)
+}
+
+func appendTuple(buf []byte, t Tuple) []byte {
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(t)))
+	for _, v := range t {
+		if v == nil {
+			buf = append(buf, tupleDataNull)
+			continue
+		}
+		buf = append(buf, tupleDataText)
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(v)))
+		buf = append(buf, v...)
+	}
+	return buf
+}
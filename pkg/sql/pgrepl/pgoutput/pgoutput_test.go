@@ -0,0 +1,132 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package pgoutput
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsn"
+	"github.com/lib/pq/oid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAppendMessages(t *testing.T) {
+	commitTime := postgresEpoch.Add(3 * time.Microsecond)
+
+	t.Run("begin", func(t *testing.T) {
+		buf := AppendBegin(nil, lsn.LSN(0x10), commitTime, 7)
+		require.Equal(t, []byte{
+			'B',
+			0, 0, 0, 0, 0, 0, 0, 0x10,
+			0, 0, 0, 0, 0, 0, 0, 3,
+			0, 0, 0, 7,
+		}, buf)
+	})
+
+	t.Run("commit", func(t *testing.T) {
+		buf := AppendCommit(nil, lsn.LSN(0x10), lsn.LSN(0x11), commitTime)
+		require.Equal(t, []byte{
+			'C', 0,
+			0, 0, 0, 0, 0, 0, 0, 0x10,
+			0, 0, 0, 0, 0, 0, 0, 0x11,
+			0, 0, 0, 0, 0, 0, 0, 3,
+		}, buf)
+	})
+
+	t.Run("relation", func(t *testing.T) {
+		buf := AppendRelation(nil, &Relation{
+			ID:        104,
+			Namespace: "public",
+			Name:      "t",
+			Columns: []Column{
+				{Name: "k", TypeOID: oid.T_int8, TypeMod: -1, IsKey: true},
+				{Name: "v", TypeOID: oid.T_text, TypeMod: -1},
+			},
+		})
+		expected := []byte{'R', 0, 0, 0, 104}
+		expected = append(expected, "public\x00t\x00d"...)
+		expected = append(expected, 0, 2)
+		expected = append(expected, 1, 'k', 0, 0, 0, 0, 20, 0xff, 0xff, 0xff, 0xff)
+		expected = append(expected, 0, 'v', 0, 0, 0, 0, 25, 0xff, 0xff, 0xff, 0xff)
+		require.Equal(t, expected, buf)
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		buf := AppendInsert(nil, 104, Tuple{[]byte("1"), nil})
+		require.Equal(t, []byte{
+			'I', 0, 0, 0, 104, 'N',
+			0, 2,
+			't', 0, 0, 0, 1, '1',
+			'n',
+		}, buf)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		buf := AppendUpdate(nil, 104, Tuple{[]byte("1"), []byte("ab")})
+		require.Equal(t, []byte{
+			'U', 0, 0, 0, 104, 'N',
+			0, 2,
+			't', 0, 0, 0, 1, '1',
+			't', 0, 0, 0, 2, 'a', 'b',
+		}, buf)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		buf := AppendDelete(nil, 104, Tuple{[]byte("1"), nil})
+		require.Equal(t, []byte{
+			'D', 0, 0, 0, 104, 'K',
+			0, 2,
+			't', 0, 0, 0, 1, '1',
+			'n',
+		}, buf)
+	})
+
+	t.Run("xlogdata", func(t *testing.T) {
+		buf := AppendXLogData(nil, lsn.LSN(1), lsn.LSN(2), commitTime)
+		buf = AppendCommit(buf, lsn.LSN(1), lsn.LSN(2), commitTime)
+		require.Equal(t, byte('w'), buf[0])
+		require.Equal(t, uint64(1), binary.BigEndian.Uint64(buf[1:]))
+		require.Equal(t, uint64(2), binary.BigEndian.Uint64(buf[9:]))
+		require.Equal(t, uint64(3), binary.BigEndian.Uint64(buf[17:]))
+		require.Equal(t, byte('C'), buf[25])
+	})
+
+	t.Run("keepalive", func(t *testing.T) {
+		buf := AppendPrimaryKeepalive(nil, lsn.LSN(2), commitTime, true)
+		require.Equal(t, []byte{
+			'k',
+			0, 0, 0, 0, 0, 0, 0, 2,
+			0, 0, 0, 0, 0, 0, 0, 3,
+			1,
+		}, buf)
+	})
+}
+
+func TestParseStandbyStatusUpdate(t *testing.T) {
+	msg := []byte{'r'}
+	msg = binary.BigEndian.AppendUint64(msg, 3)
+	msg = binary.BigEndian.AppendUint64(msg, 2)
+	msg = binary.BigEndian.AppendUint64(msg, 1)
+	msg = binary.BigEndian.AppendUint64(msg, 0)
+	msg = append(msg, 1)
+
+	update, err := ParseStandbyStatusUpdate(msg)
+	require.NoError(t, err)
+	require.Equal(t, StandbyStatusUpdate{
+		Written:        3,
+		Flushed:        2,
+		Applied:        1,
+		ReplyRequested: true,
+	}, update)
+
+	_, err = ParseStandbyStatusUpdate(msg[:10])
+	require.Error(t, err)
+	_, err = ParseStandbyStatusUpdate([]byte{'h'})
+	require.Error(t, err)
+	require.True(t, IsHotStandbyFeedback([]byte{'h'}))
+}
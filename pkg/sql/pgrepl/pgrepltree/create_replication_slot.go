@@ -48,7 +48,7 @@ func (crs *CreateReplicationSlot) Format(ctx *tree.FmtCtx) {
 }
 
 func (crs *CreateReplicationSlot) StatementReturnType() tree.StatementReturnType {
-	return tree.Replication
+	return tree.Rows
 }
 
 func (crs *CreateReplicationSlot) StatementType() tree.StatementType {
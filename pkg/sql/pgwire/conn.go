@@ -23,6 +23,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
 	"github.com/cockroachdb/cockroach/pkg/sql/clusterunique"
+	"github.com/cockroachdb/cockroach/pkg/sql/notify"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/pgreplparser"
@@ -270,6 +271,14 @@ func (c *conn) bufferNotice(ctx context.Context, noticeErr pgnotice.Notice) erro
 	return c.writeErrFields(ctx, noticeErr, &c.writerState.buf)
 }
 
+func (c *conn) bufferNotification(n notify.Notification) error {
+	c.msgBuilder.initMsg(pgwirebase.ServerMsgNotificationResponse)
+	c.msgBuilder.putInt32(n.PID)
+	c.msgBuilder.writeTerminatedString(n.Channel)
+	c.msgBuilder.writeTerminatedString(n.Payload)
+	return c.msgBuilder.finishMsg(&c.writerState.buf)
+}
+
 func (c *conn) sendInitialConnData(
 	ctx context.Context,
 	sqlServer *sql.Server,
@@ -360,8 +369,29 @@ func (c *conn) handleSimpleQuery(
 			log.SqlExec.Infof(ctx, "could not parse simple query in replication protocol: %s", query)
 			return c.stmtBuf.Push(ctx, sql.SendError{Err: err})
 		}
-		switch stmt.AST.(type) {
-		case *pgrepltree.IdentifySystem:
+		switch ast := stmt.AST.(type) {
+		case *pgrepltree.IdentifySystem, *pgrepltree.CreateReplicationSlot,
+			*pgrepltree.DropReplicationSlot:
+		case *pgrepltree.StartReplication:
+			// Like COPY, START_REPLICATION takes control of the connection until
+			// the client ends the replication stream, so this network routine
+			// is blocked until control is passed back.
+			var wg sync.WaitGroup
+			var once sync.Once
+			wg.Add(1)
+			cmd := sql.StartReplication{
+				ParsedStmt:   stmt,
+				Stmt:         ast,
+				Conn:         c,
+				TimeReceived: timeReceived,
+			}
+			cmd.ReplicationDone.WaitGroup = &wg
+			cmd.ReplicationDone.Once = &once
+			if err := c.stmtBuf.Push(ctx, cmd); err != nil {
+				return err
+			}
+			wg.Wait()
+			return nil
 		default:
 			log.SqlExec.Infof(ctx, "unhandled replication protocol query: %s", query)
 			return c.stmtBuf.Push(ctx, sql.SendError{
@@ -1175,6 +1205,16 @@ func (c *conn) bufferCopyOut(columns []colinfo.ResultColumn, format pgwirebase.F
 	return c.msgBuilder.finishMsg(&c.writerState.buf)
 }
 
+// bufferCopyBoth buffers a CopyBothResponse, which starts the CopyBoth stream
+// used by START_REPLICATION. The stream is always in the binary format and
+// has no columns.
+func (c *conn) bufferCopyBoth() error {
+	c.msgBuilder.initMsg(pgwirebase.ServerMsgCopyBothResponse)
+	c.msgBuilder.writeByte(byte(pgwirebase.FormatBinary))
+	c.msgBuilder.putInt16(0)
+	return c.msgBuilder.finishMsg(&c.writerState.buf)
+}
+
 func (c *conn) bufferCopyData(copyData []byte, res *commandResult) error {
 	c.msgBuilder.initMsg(pgwirebase.ServerMsgCopyDataCommand)
 	if _, err := c.msgBuilder.Write(copyData); err != nil {
@@ -1428,6 +1468,18 @@ func (c *conn) CreateCopyOutResult(cmd sql.CopyOut, pos sql.CmdPos) sql.CopyOutR
 	return res
 }
 
+// CreateStartReplicationResult is part of the sql.ClientComm interface.
+func (c *conn) CreateStartReplicationResult(
+	cmd sql.StartReplication, pos sql.CmdPos,
+) sql.StartReplicationResult {
+	res := c.newMiscResult(pos, commandComplete)
+	// START_REPLICATION completes with a plain CommandComplete message once
+	// the client ends the stream.
+	res.stmtType = tree.Ack
+	res.cmdCompleteTag = cmd.Stmt.StatementTag()
+	return res
+}
+
 // pgwireReader is an io.Reader that wraps a conn, maintaining its metrics as
 // it is consumed.
 type pgwireReader struct {
@@ -101,6 +101,8 @@ var (
 	InvalidRegularExpression              = MakeCode("2201B")
 	InvalidRowCountInLimitClause          = MakeCode("2201W")
 	InvalidRowCountInResultOffsetClause   = MakeCode("2201X")
+	InvalidTablesampleArgument            = MakeCode("2202H")
+	InvalidTablesampleRepeat              = MakeCode("2202G")
 	InvalidTimeZoneDisplacementValue      = MakeCode("22009")
 	InvalidUseOfEscapeCharacter           = MakeCode("2200C")
 	MostSpecificTypeMismatch              = MakeCode("2200G")
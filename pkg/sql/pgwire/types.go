@@ -55,6 +55,11 @@ type pgType struct {
 }
 
 func pgTypeForParserType(t *types.T) pgType {
+	// Like Postgres, describe values of a domain type using the base type of
+	// the domain.
+	if t.IsDomain() {
+		t = t.DomainBaseType()
+	}
 	size := tree.PGWireTypeSize(t)
 	tOid := t.Oid()
 	if tOid == oid.T_text && t.Width() > 0 {
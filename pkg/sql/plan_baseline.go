@@ -0,0 +1,233 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/sql/hintpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/hints"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/exec/explain"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/memo"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/optbuilder"
+	"github.com/cockroachdb/cockroach/pkg/sql/opt/xform"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser/statements"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+)
+
+// planBaselinesEnabled controls whether the optimizer prefers the plans of
+// accepted plan baselines.
+var planBaselinesEnabled = settings.RegisterBoolSetting(
+	settings.ApplicationLevel,
+	"sql.plan_baselines.enabled",
+	"if enabled, the optimizer prefers plans with the same shape as the accepted "+
+		"plan baseline of a statement fingerprint, if there is one",
+	true,
+)
+
+// planBaselineCaptureEnabled controls whether the plans chosen for statements
+// are captured as candidate plan baselines.
+var planBaselineCaptureEnabled = settings.RegisterBoolSetting(
+	settings.ApplicationLevel,
+	"sql.plan_baselines.capture.enabled",
+	"if enabled, the plans chosen by the optimizer for SELECT, INSERT, UPDATE "+
+		"and DELETE statements are captured as candidate plan baselines of their "+
+		"statement fingerprint in system.statement_hints",
+	false,
+)
+
+// acceptedPlanBaseline returns the accepted plan baseline among the given
+// statement hints, or nil if there is none.
+func acceptedPlanBaseline(stmtHints []hintpb.StatementHintUnion) *hintpb.PlanBaseline {
+	for i := range stmtHints {
+		if baseline, ok := stmtHints[i].GetValue().(*hintpb.PlanBaseline); ok && baseline.Accepted {
+			return baseline
+		}
+	}
+	return nil
+}
+
+// planBaselineShape returns the shape of the accepted plan baseline of the
+// current statement, or nil if there is none or if it is no longer valid.
+func (opc *optPlanningCtx) planBaselineShape(ctx context.Context) *exec.PlanShape {
+	p := opc.p
+	if len(p.stmt.Hints) == 0 || !planBaselinesEnabled.Get(&p.execCfg.Settings.SV) {
+		return nil
+	}
+	baseline := acceptedPlanBaseline(p.stmt.Hints)
+	if baseline == nil {
+		return nil
+	}
+	shape, err := explain.PlanShapeFromGist(baseline.PlanGist, opc.catalog)
+	if err != nil {
+		log.VEventf(ctx, 1, "ignoring plan baseline %s: %v", baseline.PlanGist, err)
+		return nil
+	}
+	return shape
+}
+
+// maybeCapturePlanBaseline captures the plan of the current statement as a
+// candidate plan baseline of its fingerprint, if capturing is enabled and the
+// plan is not already a baseline of the fingerprint.
+func (p *planner) maybeCapturePlanBaseline(ctx context.Context) {
+	if p.execCfg.StatementHintsCache == nil || p.SessionData().Internal ||
+		!planBaselineCaptureEnabled.Get(&p.execCfg.Settings.SV) {
+		return
+	}
+	switch p.stmt.AST.(type) {
+	case *tree.ParenSelect, *tree.Select, *tree.SelectClause, *tree.UnionClause, *tree.ValuesClause,
+		*tree.Insert, *tree.Update, *tree.Delete:
+	default:
+		return
+	}
+	planGist := p.instrumentation.planGist.String()
+	if planGist == "" || p.stmt.StmtNoConstants == "" {
+		return
+	}
+	// The cached hints of the fingerprint are checked first, so that no
+	// transaction is started for plans that are already baselines or for
+	// fingerprints that already have the maximum number of baselines.
+	if !hints.CanCapturePlanBaseline(p.stmt.Hints, planGist) {
+		return
+	}
+	sampleSQL := formatWithPlaceholders(ctx, p.stmt.AST, p.EvalContext())
+	p.execCfg.StatementHintsCache.CapturePlanBaselineAsync(
+		ctx, p.stmt.StmtNoConstants, planGist, sampleSQL, p.instrumentation.costEstimate,
+	)
+}
+
+// AcceptPlanBaseline is part of the eval.Planner interface.
+func (p *planner) AcceptPlanBaseline(ctx context.Context, hintID int64, verify bool) (bool, error) {
+	txn := p.InternalSQLTxn()
+	fingerprint, hint, ok, err := hints.GetStatementHintFromDB(ctx, txn, hintID)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, pgerror.Newf(pgcode.UndefinedObject, "statement hint %d does not exist", hintID)
+	}
+	baseline, ok := hint.GetValue().(*hintpb.PlanBaseline)
+	if !ok {
+		return false, pgerror.Newf(pgcode.WrongObjectType, "statement hint %d is not a plan baseline", hintID)
+	}
+
+	// The plan must still be valid, i.e., it must not read a table or index
+	// that has since been dropped.
+	if _, err := explain.PlanShapeFromGist(baseline.PlanGist, p.optPlanningCtx.catalog); err != nil {
+		p.BufferClientNotice(ctx, pgnotice.Newf(
+			"plan baseline %d was not accepted: %v", hintID, err,
+		))
+		return false, nil
+	}
+
+	// If requested, the plan must not be estimated to be more expensive than
+	// the currently accepted plan. Both plans are re-costed under the current
+	// statistics, using the statement for which the candidate was captured.
+	if verify {
+		_, fingerprintHints, err := hints.GetFingerprintHintsFromDB(ctx, txn, fingerprint)
+		if err != nil {
+			return false, err
+		}
+		if accepted := acceptedPlanBaseline(fingerprintHints); accepted != nil &&
+			accepted.PlanGist != baseline.PlanGist {
+			ok, err := p.verifyPlanBaseline(ctx, hintID, baseline, accepted)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+	}
+
+	if _, err := hints.AcceptPlanBaseline(ctx, txn, hintID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// verifyPlanBaseline returns true if the plan of the given candidate baseline
+// is not estimated to be more expensive than the plan of the accepted baseline
+// under the current statistics. Both plans are costed by optimizing the sample
+// statement of the candidate towards each baseline. If the plan is rejected, a
+// notice explaining why is sent to the client.
+func (p *planner) verifyPlanBaseline(
+	ctx context.Context, hintID int64, candidate, accepted *hintpb.PlanBaseline,
+) (bool, error) {
+	reject := func(format string, args ...interface{}) (bool, error) {
+		p.BufferClientNotice(ctx, pgnotice.Newf(
+			"plan baseline %d was not accepted: %s", hintID, fmt.Sprintf(format, args...),
+		))
+		return false, nil
+	}
+	if candidate.SampleSQL == "" {
+		return reject("it has no sample statement to estimate its cost with")
+	}
+	stmt, err := parser.ParseOne(candidate.SampleSQL)
+	if err != nil {
+		return reject("%v", err)
+	}
+	candidateCost, err := p.costPlanBaseline(ctx, stmt, candidate)
+	if err != nil {
+		return reject("%v", err)
+	}
+	if candidateCost.Penalties&memo.PlanShapePenalty != 0 {
+		return reject("its plan is no longer possible")
+	}
+	acceptedCost, err := p.costPlanBaseline(ctx, stmt, accepted)
+	if err != nil {
+		return false, err
+	}
+	if acceptedCost.Less(candidateCost) {
+		return reject(
+			"its estimated cost %.2f is greater than the estimated cost %.2f of the "+
+				"accepted plan baseline", candidateCost.C, acceptedCost.C,
+		)
+	}
+	return true, nil
+}
+
+// costPlanBaseline returns the estimated cost under the current statistics of
+// the plan that the optimizer chooses for the given statement when it is
+// steered towards the given plan baseline. The cost includes PlanShapePenalty
+// if no plan with the shape of the baseline is possible.
+func (p *planner) costPlanBaseline(
+	ctx context.Context, stmt statements.Statement[tree.Statement], baseline *hintpb.PlanBaseline,
+) (memo.Cost, error) {
+	catalog := p.optPlanningCtx.catalog
+	shape, err := explain.PlanShapeFromGist(baseline.PlanGist, catalog)
+	if err != nil {
+		return memo.Cost{}, err
+	}
+
+	// The statement is built with its own annotations, which must be restored
+	// afterwards since they are shared with the statement being executed.
+	ann := tree.MakeAnnotations(stmt.NumAnnotations)
+	semaCtx := p.semaCtx
+	semaCtx.Placeholders = tree.PlaceholderInfo{}
+	semaCtx.Annotations = ann
+	evalCtx := p.EvalContext()
+	oldEvalCtxAnn := evalCtx.Annotations
+	evalCtx.Annotations = &ann
+	defer func() { evalCtx.Annotations = oldEvalCtxAnn }()
+
+	var o xform.Optimizer
+	o.Init(ctx, evalCtx, catalog)
+	o.SetPlanShape(shape)
+	bld := optbuilder.New(ctx, &semaCtx, evalCtx, catalog, o.Factory(), stmt.AST)
+	if err := bld.Build(); err != nil {
+		return memo.Cost{}, err
+	}
+	if _, err := o.Optimize(); err != nil {
+		return memo.Cost{}, err
+	}
+	return o.Memo().RootExpr().Cost(), nil
+}
@@ -43,6 +43,8 @@ func getPlanColumns(plan planNode, mut bool) colinfo.ResultColumns {
 	// Nodes that define their own schema.
 	case *delayedNode:
 		return n.columns
+	case *foreignScanNode:
+		return n.columns
 	case *groupNode:
 		return n.columns
 	case *joinNode:
@@ -179,6 +181,8 @@ func getPlanColumns(plan planNode, mut bool) colinfo.ResultColumns {
 
 	case *identifySystemNode:
 		return n.getColumns(mut, colinfo.IdentifySystemColumns)
+	case *createReplicationSlotNode:
+		return n.getColumns(mut, colinfo.CreateReplicationSlotColumns)
 	}
 
 	// Every other node has no columns in their results.
@@ -58,6 +58,7 @@ var planNodeNames = map[reflect.Type]string{
 	reflect.TypeOf(&alterDatabaseDropSecondaryRegion{}):        "alter database secondary region",
 	reflect.TypeOf(&alterDatabaseSetZoneConfigExtensionNode{}): "alter database configure zone extension",
 	reflect.TypeOf(&alterDefaultPrivilegesNode{}):              "alter default privileges",
+	reflect.TypeOf(&alterDomainNode{}):                         "alter domain",
 	reflect.TypeOf(&alterExternalConnectionNode{}):             "alter external connection",
 	reflect.TypeOf(&alterFunctionOptionsNode{}):                "alter function",
 	reflect.TypeOf(&alterFunctionRenameNode{}):                 "alter function rename",
@@ -96,13 +97,18 @@ var planNodeNames = map[reflect.Type]string{
 	reflect.TypeOf(&completionsNode{}):                         "show completions",
 	reflect.TypeOf(&controlJobsNode{}):                         "control jobs",
 	reflect.TypeOf(&controlSchedulesNode{}):                    "control schedules",
+	reflect.TypeOf(&createAggregateNode{}):                     "create aggregate",
 	reflect.TypeOf(&createDatabaseNode{}):                      "create database",
+	reflect.TypeOf(&createDomainNode{}):                        "create domain",
 	reflect.TypeOf(&createExtensionNode{}):                     "create extension",
 	reflect.TypeOf(&createExternalConnectionNode{}):            "create external connection",
+	reflect.TypeOf(&createForeignTableNode{}):                  "create foreign table",
 	reflect.TypeOf(&createFunctionNode{}):                      "create function",
 	reflect.TypeOf(&createIndexNode{}):                         "create index",
+	reflect.TypeOf(&createPublicationNode{}):                   "create publication",
 	reflect.TypeOf(&createSequenceNode{}):                      "create sequence",
 	reflect.TypeOf(&createSchemaNode{}):                        "create schema",
+	reflect.TypeOf(&createServerNode{}):                        "create server",
 	reflect.TypeOf(&createStatsNode{}):                         "create statistics",
 	reflect.TypeOf(&createTableNode{}):                         "create table",
 	reflect.TypeOf(&createTenantNode{}):                        "create tenant",
@@ -120,8 +126,10 @@ var planNodeNames = map[reflect.Type]string{
 	reflect.TypeOf(&dropExternalConnectionNode{}):              "drop external connection",
 	reflect.TypeOf(&dropFunctionNode{}):                        "drop function",
 	reflect.TypeOf(&dropIndexNode{}):                           "drop index",
+	reflect.TypeOf(&dropPublicationNode{}):                     "drop publication",
 	reflect.TypeOf(&dropSequenceNode{}):                        "drop sequence",
 	reflect.TypeOf(&dropSchemaNode{}):                          "drop schema",
+	reflect.TypeOf(&dropServerNode{}):                          "drop server",
 	reflect.TypeOf(&dropTableNode{}):                           "drop table",
 	reflect.TypeOf(&dropTenantNode{}):                          "drop tenant",
 	reflect.TypeOf(&dropTypeNode{}):                            "drop type",
@@ -134,6 +142,7 @@ var planNodeNames = map[reflect.Type]string{
 	reflect.TypeOf(&exportNode{}):                              "export",
 	reflect.TypeOf(&fetchNode{}):                               "fetch",
 	reflect.TypeOf(&filterNode{}):                              "filter",
+	reflect.TypeOf(&foreignScanNode{}):                         "foreign scan",
 	reflect.TypeOf(&endPreparedTxnNode{}):                      "commit/rollback prepared",
 	reflect.TypeOf(&GrantRoleNode{}):                           "grant role",
 	reflect.TypeOf(&groupNode{}):                               "group",
@@ -145,6 +154,7 @@ var planNodeNames = map[reflect.Type]string{
 	reflect.TypeOf(&invertedJoinNode{}):                        "inverted join",
 	reflect.TypeOf(&joinNode{}):                                "join",
 	reflect.TypeOf(&limitNode{}):                               "limit",
+	reflect.TypeOf(&lockTableNode{}):                           "lock table",
 	reflect.TypeOf(&lookupJoinNode{}):                          "lookup join",
 	reflect.TypeOf(&max1RowNode{}):                             "max1row",
 	reflect.TypeOf(&moveNode{}):                                "move",
@@ -200,4 +210,6 @@ var planNodeNames = map[reflect.Type]string{
 	reflect.TypeOf(&zigzagJoinNode{}):                          "zigzag join",
 	reflect.TypeOf(&schemaChangePlanNode{}):                    "schema change",
 	reflect.TypeOf(&identifySystemNode{}):                      "identify system",
+	reflect.TypeOf(&createReplicationSlotNode{}):               "create replication slot",
+	reflect.TypeOf(&dropReplicationSlotNode{}):                 "drop replication slot",
 }
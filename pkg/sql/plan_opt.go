@@ -80,8 +80,8 @@ func (p *planner) prepareUsingOptimizer(
 		*tree.ReleaseSavepoint, *tree.RenameColumn, *tree.RenameDatabase,
 		*tree.RenameIndex, *tree.RenameTable, *tree.Revoke, *tree.RevokeRole,
 		*tree.RollbackPrepared, *tree.RollbackToSavepoint, *tree.RollbackTransaction,
-		*tree.Savepoint, *tree.SetTransaction, *tree.SetTracing, *tree.SetSessionAuthorizationDefault,
-		*tree.SetSessionCharacteristics:
+		*tree.Savepoint, *tree.SetConstraints, *tree.SetTransaction, *tree.SetTracing,
+		*tree.SetSessionAuthorizationDefault, *tree.SetSessionCharacteristics:
 		// These statements do not have result columns and do not support placeholders
 		// so there is no need to do anything during prepare.
 		//
@@ -143,7 +143,7 @@ func (p *planner) prepareUsingOptimizer(
 			} else if pm.HintsGeneration != stmt.Prepared.HintsGeneration && !slices.Equal(pm.HintIDs, stmt.Prepared.HintIDs) {
 				opc.log(ctx, "query cache hit but external statement hints don't match")
 			} else {
-				isStale, err := cachedData.Memo.IsStale(ctx, p.EvalContext(), opc.catalog)
+				isStale, err := opc.isMemoStale(ctx, cachedData.Memo)
 				if err != nil {
 					return 0, err
 				}
@@ -283,7 +283,11 @@ func (p *planner) makeOptimizerPlan(ctx context.Context) error {
 
 	// Build the plan tree.
 	const disableTelemetryAndPlanGists = false
-	return p.runExecBuild(ctx, execMemo, disableTelemetryAndPlanGists)
+	if err := p.runExecBuild(ctx, execMemo, disableTelemetryAndPlanGists); err != nil {
+		return err
+	}
+	p.maybeCapturePlanBaseline(ctx)
+	return nil
 }
 
 // runExecBuild builds the plan tree for the given memo. It assumes that the
@@ -391,6 +395,12 @@ type optPlanningCtx struct {
 	// allowMemoReuse is false.
 	useCache bool
 
+	// planBaselineGist is the gist of the accepted plan baseline that the
+	// statement is optimized towards, or the empty string if there is none.
+	// Optimized memos that were steered towards a different baseline are not
+	// reused.
+	planBaselineGist string
+
 	flags planFlags
 
 	gf explain.PlanGistFactory
@@ -433,11 +443,23 @@ func (opc *optPlanningCtx) reset(ctx context.Context) {
 			// dependencies and check permissions.
 			opc.useCache = false
 		}
+		if p.SessionData().AllowMaterializedViewMutation {
+			// Plans that mutate materialized views must not be reused by other
+			// sessions.
+			opc.allowMemoReuse = false
+			opc.useCache = false
+		}
 
 	default:
 		opc.allowMemoReuse = false
 		opc.useCache = false
 	}
+
+	opc.planBaselineGist = ""
+	if shape := opc.planBaselineShape(ctx); shape != nil {
+		opc.optimizer.SetPlanShape(shape)
+		opc.planBaselineGist = shape.Gist()
+	}
 }
 
 func (opc *optPlanningCtx) log(ctx context.Context, msg string) {
@@ -455,6 +477,17 @@ func (opc *optPlanningCtx) log(ctx context.Context, msg string) {
 	}
 }
 
+// isMemoStale returns true if the given cached memo cannot be reused for the
+// current statement, either because it is stale (see memo.Memo.IsStale), or
+// because it was optimized towards a different accepted plan baseline than the
+// one of the statement.
+func (opc *optPlanningCtx) isMemoStale(ctx context.Context, m *memo.Memo) (bool, error) {
+	if m.IsOptimized() && m.PlanBaselineGist() != opc.planBaselineGist {
+		return true, nil
+	}
+	return m.IsStale(ctx, opc.p.EvalContext(), opc.catalog)
+}
+
 type memoType int
 
 const (
@@ -694,7 +727,7 @@ func (opc *optPlanningCtx) chooseValidPreparedMemo(ctx context.Context) (*memo.M
 	prep := opc.p.stmt.Prepared
 
 	if prep.GenericMemo != nil {
-		isStale, err := prep.GenericMemo.IsStale(ctx, opc.p.EvalContext(), opc.catalog)
+		isStale, err := opc.isMemoStale(ctx, prep.GenericMemo)
 		if err != nil {
 			return nil, err
 		} else if isStale {
@@ -709,7 +742,7 @@ func (opc *optPlanningCtx) chooseValidPreparedMemo(ctx context.Context) (*memo.M
 	}
 
 	if prep.BaseMemo != nil {
-		isStale, err := prep.BaseMemo.IsStale(ctx, opc.p.EvalContext(), opc.catalog)
+		isStale, err := opc.isMemoStale(ctx, prep.BaseMemo)
 		if err != nil {
 			return nil, err
 		} else if isStale {
@@ -840,7 +873,7 @@ func (opc *optPlanningCtx) buildExecMemo(ctx context.Context) (_ *memo.Memo, _ e
 		// Consult the query cache.
 		cachedData, ok := p.execCfg.QueryCache.Find(&p.queryCacheSession, opc.p.stmt.SQL)
 		if ok {
-			if isStale, err := cachedData.Memo.IsStale(ctx, p.EvalContext(), opc.catalog); err != nil {
+			if isStale, err := opc.isMemoStale(ctx, cachedData.Memo); err != nil {
 				return nil, err
 			} else if isStale {
 				opc.log(ctx, "query cache hit but needed update")
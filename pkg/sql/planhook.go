@@ -110,6 +110,7 @@ type PlanHookState interface {
 	) (eval.AsOfSystemTime, error)
 	ResolveMutableTableDescriptor(ctx context.Context, tn *tree.TableName, required bool, requiredType tree.RequiredTableKind) (prefix catalog.ResolvedObjectPrefix, table *tabledesc.Mutable, err error)
 	ResolveExistingObjectEx(ctx context.Context, name *tree.UnresolvedObjectName, required bool, requiredType tree.RequiredTableKind) (res catalog.TableDescriptor, err error)
+	IsExemptFromRowLevelSecurity(ctx context.Context, desc catalog.TableDescriptor) (bool, error)
 	Descriptors() *descs.Collection
 	GetTableAndIndex(ctx context.Context, tableWithIndex *tree.TableIndexName, privilege privilege.Kind, skipCache bool) (prefix catalog.ResolvedObjectPrefix, mut *tabledesc.Mutable, idx catalog.Index, err error)
 	ShowCreate(
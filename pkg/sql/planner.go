@@ -117,6 +117,16 @@ type extendedEvalContext struct {
 
 	// validateDbZoneConfig should the DB zone config on commit.
 	validateDbZoneConfig *bool
+
+	// deferredConstraints refers to deferredConstraints in extraTxnState. It is
+	// nil if the constraint checks cannot be deferred until commit, in which
+	// case they are always immediate.
+	deferredConstraints *deferredConstraints
+
+	// notifications refers to the LISTEN/NOTIFY state of the session. It is
+	// nil if the session cannot receive notifications, which is the case for
+	// internal executors.
+	notifications *sessionNotifications
 }
 
 // copyFromExecCfg copies relevant fields from an ExecutorConfig.
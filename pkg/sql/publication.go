@@ -0,0 +1,297 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/security/username"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/privilege"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+)
+
+// publication is a row of system.publications. A publication is a set of
+// tables of a database whose changes are streamed to the subscribers that
+// name it in START_REPLICATION.
+type publication struct {
+	name      string
+	owner     username.SQLUsername
+	allTables bool
+	// tableIDs are the tables of the publication. It is empty if allTables is
+	// set, in which case every table of the database is published.
+	tableIDs []descpb.ID
+}
+
+// loadPublications returns the publications of a database, ordered by name.
+func loadPublications(ctx context.Context, txn isql.Txn, dbID descpb.ID) ([]publication, error) {
+	const opName = "load-publications"
+	rows, err := txn.QueryBufferedEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		`SELECT name, owner, all_tables, table_ids FROM system.publications
+WHERE database_id = $1 ORDER BY name`, dbID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	pubs := make([]publication, len(rows))
+	for i, row := range rows {
+		pubs[i] = publication{
+			name:      string(tree.MustBeDString(row[0])),
+			owner:     username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[1]))),
+			allTables: bool(tree.MustBeDBool(row[2])),
+		}
+		for _, id := range tree.MustBeDArray(row[3]).Array {
+			pubs[i].tableIDs = append(pubs[i].tableIDs, descpb.ID(tree.MustBeDInt(id)))
+		}
+	}
+	return pubs, nil
+}
+
+// publishedTables returns the tables of a database that are published by
+// pubs, ordered by ID. Tables that were dropped after being added to a
+// publication are skipped.
+func publishedTables(
+	ctx context.Context, txn descs.Txn, db catalog.DatabaseDescriptor, pubs []publication,
+) ([]catalog.TableDescriptor, error) {
+	all, err := txn.Descriptors().GetAllTablesInDatabase(ctx, txn.KV(), db)
+	if err != nil {
+		return nil, err
+	}
+	var ids catalog.DescriptorIDSet
+	var allTables bool
+	for _, pub := range pubs {
+		allTables = allTables || pub.allTables
+		for _, id := range pub.tableIDs {
+			ids.Add(id)
+		}
+	}
+	var tables []catalog.TableDescriptor
+	if err := all.ForEachDescriptor(func(desc catalog.Descriptor) error {
+		tbl, ok := desc.(catalog.TableDescriptor)
+		if !ok || tbl.Dropped() || checkPublishable(tbl) != nil {
+			return nil
+		}
+		if allTables || ids.Contains(tbl.GetID()) {
+			tables = append(tables, tbl)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(tables, func(i, j int) bool { return tables[i].GetID() < tables[j].GetID() })
+	return tables, nil
+}
+
+// checkPublishable returns an error if the changes to a table cannot be
+// published.
+func checkPublishable(tbl catalog.TableDescriptor) error {
+	if !tbl.IsTable() || tbl.IsVirtualTable() || tbl.IsForeignTable() || tbl.IsTemporary() {
+		return pgerror.Newf(pgcode.WrongObjectType,
+			"cannot add relation %q to publication", tbl.GetName())
+	}
+	if len(tbl.GetFamilies()) != 1 {
+		return errors.WithHint(
+			pgerror.Newf(pgcode.FeatureNotSupported,
+				"cannot add table %q with multiple column families to publication", tbl.GetName()),
+			"tables with multiple column families cannot be replicated",
+		)
+	}
+	return nil
+}
+
+// checkPublicationsSupported returns an error if the cluster does not support
+// publications and replication slots yet.
+func checkPublicationsSupported(ctx context.Context, execCfg *ExecutorConfig, op string) error {
+	if !execCfg.Settings.Version.IsActive(ctx, clusterversion.V26_1_AddSystemPublicationsTables) {
+		return pgerror.Newf(pgcode.FeatureNotSupported,
+			"%s requires the cluster to be upgraded to v26.1", op)
+	}
+	return nil
+}
+
+// currentDatabaseDesc returns the descriptor of the current database, or an
+// error mentioning op if there is no current database.
+func (p *planner) currentDatabaseDesc(
+	ctx context.Context, op string,
+) (catalog.DatabaseDescriptor, error) {
+	if p.CurrentDatabase() == "" {
+		return nil, pgerror.Newf(pgcode.InvalidCatalogName,
+			"%s requires a current database", op)
+	}
+	return p.Descriptors().ByNameWithLeased(p.txn).Get().Database(ctx, p.CurrentDatabase())
+}
+
+type createPublicationNode struct {
+	zeroInputPlanNode
+	n *tree.CreatePublication
+}
+
+// CreatePublication creates a publication in the current database.
+// Privileges: CREATE on the database and ownership of the tables, or admin
+// for FOR ALL TABLES.
+func (p *planner) CreatePublication(
+	ctx context.Context, n *tree.CreatePublication,
+) (planNode, error) {
+	if err := checkPublicationsSupported(ctx, p.ExecCfg(), "CREATE PUBLICATION"); err != nil {
+		return nil, err
+	}
+	return &createPublicationNode{n: n}, nil
+}
+
+func (c *createPublicationNode) startExec(params runParams) error {
+	p := params.p
+	ctx := params.ctx
+	db, err := p.currentDatabaseDesc(ctx, "CREATE PUBLICATION")
+	if err != nil {
+		return err
+	}
+	if c.n.AllTables {
+		isAdmin, err := p.HasAdminRole(ctx)
+		if err != nil {
+			return err
+		}
+		if !isAdmin {
+			return pgerror.New(pgcode.InsufficientPrivilege,
+				"must be admin to create FOR ALL TABLES publication")
+		}
+	} else if err := p.CheckPrivilege(ctx, db, privilege.CREATE); err != nil {
+		return err
+	}
+
+	tableIDs := tree.NewDArray(types.Int)
+	var seen catalog.DescriptorIDSet
+	for i := range c.n.Tables {
+		tbl, err := p.ResolveExistingObjectEx(
+			ctx, c.n.Tables[i].ToUnresolvedObjectName(), true /* required */, tree.ResolveAnyTableKind,
+		)
+		if err != nil {
+			return err
+		}
+		if err := checkPublishable(tbl); err != nil {
+			return err
+		}
+		if tbl.GetParentID() != db.GetID() {
+			return pgerror.Newf(pgcode.FeatureNotSupported,
+				"cannot add table %q from another database to publication", tbl.GetName())
+		}
+		hasOwnership, err := p.HasOwnership(ctx, tbl)
+		if err != nil {
+			return err
+		}
+		if !hasOwnership {
+			return pgerror.Newf(pgcode.InsufficientPrivilege,
+				"must be owner of table %s", tbl.GetName())
+		}
+		if seen.Contains(tbl.GetID()) {
+			return pgerror.Newf(pgcode.DuplicateObject,
+				"table %q specified more than once", tbl.GetName())
+		}
+		seen.Add(tbl.GetID())
+		if err := tableIDs.Append(tree.NewDInt(tree.DInt(tbl.GetID()))); err != nil {
+			return err
+		}
+	}
+
+	const opName = "create-publication"
+	row, err := p.InternalSQLTxn().QueryRowEx(
+		ctx, opName, p.Txn(), sessiondata.NodeUserSessionDataOverride,
+		`SELECT 1 FROM system.publications WHERE database_id = $1 AND name = $2`,
+		db.GetID(), string(c.n.Name),
+	)
+	if err != nil {
+		return err
+	}
+	if row != nil {
+		return pgerror.Newf(pgcode.DuplicateObject,
+			"publication %q already exists", c.n.Name)
+	}
+	_, err = p.InternalSQLTxn().ExecEx(
+		ctx, opName, p.Txn(), sessiondata.NodeUserSessionDataOverride,
+		`INSERT INTO system.publications (database_id, name, owner, all_tables, table_ids)
+VALUES ($1, $2, $3, $4, $5)`,
+		db.GetID(), string(c.n.Name), p.User(), c.n.AllTables, tableIDs,
+	)
+	return err
+}
+
+func (c *createPublicationNode) Next(_ runParams) (bool, error) { return false, nil }
+func (c *createPublicationNode) Values() tree.Datums            { return nil }
+func (c *createPublicationNode) Close(_ context.Context)        {}
+
+type dropPublicationNode struct {
+	zeroInputPlanNode
+	n *tree.DropPublication
+}
+
+// DropPublication drops publications of the current database. CASCADE and
+// RESTRICT have no effect since nothing depends on a publication.
+// Privileges: ownership of the publication, or admin.
+func (p *planner) DropPublication(
+	ctx context.Context, n *tree.DropPublication,
+) (planNode, error) {
+	if err := checkPublicationsSupported(ctx, p.ExecCfg(), "DROP PUBLICATION"); err != nil {
+		return nil, err
+	}
+	return &dropPublicationNode{n: n}, nil
+}
+
+func (d *dropPublicationNode) startExec(params runParams) error {
+	p := params.p
+	ctx := params.ctx
+	db, err := p.currentDatabaseDesc(ctx, "DROP PUBLICATION")
+	if err != nil {
+		return err
+	}
+	isAdmin, err := p.HasAdminRole(ctx)
+	if err != nil {
+		return err
+	}
+	const opName = "drop-publication"
+	for _, name := range d.n.Names {
+		row, err := p.InternalSQLTxn().QueryRowEx(
+			ctx, opName, p.Txn(), sessiondata.NodeUserSessionDataOverride,
+			`SELECT owner FROM system.publications WHERE database_id = $1 AND name = $2`,
+			db.GetID(), string(name),
+		)
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			if d.n.IfExists {
+				continue
+			}
+			return pgerror.Newf(pgcode.UndefinedObject, "publication %q does not exist", name)
+		}
+		owner := username.MakeSQLUsernameFromPreNormalizedString(string(tree.MustBeDString(row[0])))
+		if !isAdmin && owner != p.User() {
+			return pgerror.Newf(pgcode.InsufficientPrivilege,
+				"must be owner of publication %s", name)
+		}
+		if _, err := p.InternalSQLTxn().ExecEx(
+			ctx, opName, p.Txn(), sessiondata.NodeUserSessionDataOverride,
+			`DELETE FROM system.publications WHERE database_id = $1 AND name = $2`,
+			db.GetID(), string(name),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *dropPublicationNode) Next(_ runParams) (bool, error) { return false, nil }
+func (d *dropPublicationNode) Values() tree.Datums            { return nil }
+func (d *dropPublicationNode) Close(_ context.Context)        {}
@@ -86,6 +86,13 @@ func (n *refreshMaterializedViewNode) startExec(params runParams) error {
 		}
 	}
 
+	if n.n.RefreshDataOption == tree.RefreshDataIncremental {
+		done, err := params.p.refreshMaterializedViewIncrementally(params.ctx, desc, n.n)
+		if err != nil || done {
+			return err
+		}
+	}
+
 	// Prepare the new set of indexes by cloning all existing indexes on the view.
 	newPrimaryIndex := desc.GetPrimaryIndex().IndexDescDeepCopy()
 	newIndexes := make([]descpb.IndexDescriptor, len(desc.PublicNonPrimaryIndexes()))
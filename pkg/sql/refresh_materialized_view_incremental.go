@@ -0,0 +1,1028 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/kv"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/settings"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/storage"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/log/eventpb"
+	"github.com/cockroachdb/errors"
+)
+
+// incrementalRefreshMaxChangedRows is the maximum number of changed rows of
+// the base tables of a materialized view that an incremental refresh applies.
+// If more rows have changed, recomputing the view is likely to be cheaper, so
+// a full refresh is performed instead.
+var incrementalRefreshMaxChangedRows = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"sql.materialized_view.incremental_refresh.max_changed_rows",
+	"the maximum number of changed rows in the base tables of a materialized view for which "+
+		"REFRESH MATERIALIZED VIEW ... INCREMENTAL applies the changes incrementally; "+
+		"if more rows have changed, the view is fully refreshed instead",
+	100000,
+	settings.NonNegativeInt, /* validateFn */
+)
+
+// incrementalRefreshBatchSize is the number of rows of the view deleted or
+// inserted by each statement that applies the changes of an incremental
+// refresh.
+const incrementalRefreshBatchSize = 1000
+
+// incrementalViewSource is a table read by the query of a materialized view
+// that is eligible for incremental refresh.
+type incrementalViewSource struct {
+	// name is the name by which the columns of the table are referenced in the
+	// query.
+	name tree.Name
+	desc catalog.TableDescriptor
+}
+
+// incrementalViewQuery is the analyzed query of a materialized view that is
+// eligible for incremental refresh. The query is a single SELECT that filters,
+// projects and inner joins tables, and which optionally groups the result and
+// computes SUM, COUNT, MIN and MAX aggregates.
+type incrementalViewQuery struct {
+	sources []incrementalViewSource
+	// aggregate is true if the query computes aggregates. Each output row of
+	// such a query is then determined by the values of its grouping
+	// expressions, so the view is refreshed by recomputing the groups that
+	// contain changed rows.
+	aggregate bool
+	// groupCols are the ordinals of the output columns that are the grouping
+	// expressions of an aggregate query, and groupExprs are the expressions.
+	groupCols  []int
+	groupExprs tree.Exprs
+}
+
+// incrementalRefreshAggregates are the aggregate functions that an
+// incrementally refreshed view may compute.
+var incrementalRefreshAggregates = map[string]struct{}{
+	"sum":   {},
+	"count": {},
+	"min":   {},
+	"max":   {},
+}
+
+// refreshMaterializedViewIncrementally refreshes the given materialized view
+// by applying the changes made to its base tables since its last refresh,
+// rather than recomputing the whole view. The changed rows of the base tables
+// are found with an incremental export of their primary indexes, which uses
+// time-bound iteration to skip the data that has not changed.
+//
+// For each changed row, the contribution of the row to the view as of the
+// last refresh is removed from the view, and its contribution as of the
+// refresh timestamp is added. For a view that computes aggregates, the groups
+// that contain changed rows are recomputed instead.
+//
+// It returns false if the view cannot be refreshed incrementally and a full
+// refresh should be performed instead.
+func (p *planner) refreshMaterializedViewIncrementally(
+	ctx context.Context, desc *tabledesc.Mutable, n *tree.RefreshMaterializedView,
+) (bool, error) {
+	startTime := desc.RefreshedAsOf
+	if startTime.IsEmpty() {
+		p.BufferClientNotice(ctx, pgnotice.Newf(
+			"materialized view %q has not been computed as of a known timestamp; performing a full refresh",
+			desc.GetName(),
+		))
+		return false, nil
+	}
+	endTime := p.Txn().ReadTimestamp()
+	if asOf := p.EvalContext().AsOfSystemTime; asOf != nil && asOf.ForBackfill {
+		endTime = asOf.Timestamp
+	}
+	if endTime.Less(startTime) {
+		return false, pgerror.Newf(pgcode.InvalidParameterValue,
+			"cannot incrementally refresh materialized view %q as of %s, which is before its last refresh as of %s",
+			desc.GetName(), endTime, startTime,
+		)
+	}
+
+	q, err := p.analyzeIncrementalViewQuery(ctx, desc)
+	if err != nil {
+		return false, err
+	}
+
+	// Find the rows of the base tables that changed since the last refresh.
+	maxChangedRows := incrementalRefreshMaxChangedRows.Get(&p.ExecCfg().Settings.SV)
+	changed := make([][]tree.Datums, len(q.sources))
+	var numChanged int64
+	for i := range q.sources {
+		rows, err := p.changedPrimaryKeys(ctx, q.sources[i].desc, startTime, endTime, maxChangedRows-numChanged)
+		if err != nil {
+			if errors.HasType(err, (*kvpb.BatchTimestampBeforeGCError)(nil)) {
+				err = errors.WithHint(err,
+					"The data of the base tables as of the last refresh has been garbage collected. "+
+						"Use REFRESH MATERIALIZED VIEW without INCREMENTAL.")
+			}
+			return false, err
+		}
+		if rows == nil {
+			p.BufferClientNotice(ctx, pgnotice.Newf(
+				"more than %d rows changed since the last refresh of materialized view %q; performing a full refresh",
+				maxChangedRows, desc.GetName(),
+			))
+			return false, nil
+		}
+		changed[i] = rows
+		numChanged += int64(len(rows))
+	}
+
+	if numChanged > 0 {
+		if q.aggregate {
+			err = p.refreshAggregateViewIncrementally(ctx, desc, q, changed, startTime, endTime)
+		} else {
+			err = p.refreshViewIncrementally(ctx, desc, q, changed, startTime, endTime)
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	desc.RefreshedAsOf = endTime
+	if err := p.logEvent(ctx,
+		desc.ID,
+		&eventpb.RefreshMaterializedView{
+			ViewName: p.ResolvedName(n.Name).FQString(),
+		}); err != nil {
+		return false, err
+	}
+	return true, p.writeSchemaChange(
+		ctx, desc, descpb.InvalidMutationID, tree.AsStringWithFQNames(n, p.Ann()),
+	)
+}
+
+// notEligibleForIncrementalRefresh returns the error for a materialized view
+// whose query cannot be refreshed incrementally.
+func notEligibleForIncrementalRefresh(viewName, reason string) error {
+	return errors.WithHint(
+		pgerror.Newf(pgcode.FeatureNotSupported,
+			"materialized view %q is not eligible for incremental refresh: %s", viewName, reason),
+		"Only views that filter, project and inner join tables, and optionally compute "+
+			"SUM, COUNT, MIN and MAX aggregates, can be refreshed incrementally. "+
+			"Use REFRESH MATERIALIZED VIEW without INCREMENTAL.",
+	)
+}
+
+// analyzeIncrementalViewQuery checks that the query of the given materialized
+// view is eligible for incremental refresh, and resolves the tables that it
+// reads.
+func (p *planner) analyzeIncrementalViewQuery(
+	ctx context.Context, desc catalog.TableDescriptor,
+) (*incrementalViewQuery, error) {
+	viewName := desc.GetName()
+	sel, err := parseIncrementalViewQuery(desc)
+	if err != nil {
+		return nil, err
+	}
+	if sel.With != nil || len(sel.OrderBy) > 0 || sel.Limit != nil || len(sel.Locking) > 0 {
+		return nil, notEligibleForIncrementalRefresh(viewName,
+			"WITH, ORDER BY, LIMIT and locking clauses are not supported")
+	}
+	clause, ok := sel.Select.(*tree.SelectClause)
+	if !ok || clause.TableSelect {
+		return nil, notEligibleForIncrementalRefresh(viewName, "the query must be a single SELECT")
+	}
+	if clause.Distinct || len(clause.DistinctOn) > 0 || clause.Having != nil || len(clause.Window) > 0 {
+		return nil, notEligibleForIncrementalRefresh(viewName,
+			"DISTINCT, HAVING and WINDOW clauses are not supported")
+	}
+
+	q := &incrementalViewQuery{}
+	var exprs []tree.Expr
+	var addSource func(te tree.TableExpr) error
+	addSource = func(te tree.TableExpr) error {
+		switch t := te.(type) {
+		case *tree.AliasedTableExpr:
+			tn, ok := t.Expr.(*tree.TableName)
+			if !ok || t.Ordinality || t.Lateral || t.As.Cols != nil || t.TableSample != nil {
+				return notEligibleForIncrementalRefresh(viewName,
+					"the FROM clause may only contain tables and inner joins")
+			}
+			tbl, err := p.ResolveExistingObjectEx(
+				ctx, tn.ToUnresolvedObjectName(), true /* required */, tree.ResolveRequireTableDesc,
+			)
+			if err != nil {
+				return err
+			}
+			if !tbl.IsPhysicalTable() || tbl.IsForeignTable() {
+				return notEligibleForIncrementalRefresh(viewName,
+					fmt.Sprintf("%q is not a physical table", tbl.GetName()))
+			}
+			pk := tbl.GetPrimaryIndex()
+			for i := 0; i < pk.NumKeyColumns(); i++ {
+				col, err := catalog.MustFindColumnByID(tbl, pk.GetKeyColumnID(i))
+				if err != nil {
+					return err
+				}
+				if colinfo.CanHaveCompositeKeyEncoding(col.GetType()) {
+					return notEligibleForIncrementalRefresh(viewName, fmt.Sprintf(
+						"the primary key of table %q has column %q of type %s",
+						tbl.GetName(), col.GetName(), col.GetType().SQLString(),
+					))
+				}
+			}
+			name := t.As.Alias
+			if name == "" {
+				name = tn.ObjectName
+			}
+			for i := range q.sources {
+				if q.sources[i].name == name {
+					return notEligibleForIncrementalRefresh(viewName,
+						fmt.Sprintf("table name %q is specified more than once", name))
+				}
+			}
+			q.sources = append(q.sources, incrementalViewSource{name: name, desc: tbl})
+			return nil
+
+		case *tree.JoinTableExpr:
+			if t.JoinType != "" && t.JoinType != tree.AstInner && t.JoinType != tree.AstCross {
+				return notEligibleForIncrementalRefresh(viewName, "only inner joins are supported")
+			}
+			if on, ok := t.Cond.(*tree.OnJoinCond); ok {
+				exprs = append(exprs, on.Expr)
+			}
+			if err := addSource(t.Left); err != nil {
+				return err
+			}
+			return addSource(t.Right)
+
+		case *tree.ParenTableExpr:
+			return addSource(t.Expr)
+
+		default:
+			return notEligibleForIncrementalRefresh(viewName,
+				"the FROM clause may only contain tables and inner joins")
+		}
+	}
+	for _, te := range clause.From.Tables {
+		if err := addSource(te); err != nil {
+			return nil, err
+		}
+	}
+	if len(q.sources) == 0 {
+		return nil, notEligibleForIncrementalRefresh(viewName, "the query does not read any table")
+	}
+	if clause.Where != nil {
+		exprs = append(exprs, clause.Where.Expr)
+	}
+
+	// Find the aggregates of the output columns. An aggregate must be a whole
+	// output column, so that the other output columns determine the group of
+	// an output row.
+	isAggregate := make([]bool, len(clause.Exprs))
+	for i := range clause.Exprs {
+		fn, ok := clause.Exprs[i].Expr.(*tree.FuncExpr)
+		if !ok {
+			exprs = append(exprs, clause.Exprs[i].Expr)
+			continue
+		}
+		agg, err := p.isIncrementalRefreshAggregate(ctx, fn)
+		if err != nil {
+			return nil, err
+		}
+		if !agg {
+			exprs = append(exprs, fn)
+			continue
+		}
+		if fn.Type == tree.DistinctFuncType || fn.Filter != nil || len(fn.OrderBy) > 0 {
+			return nil, notEligibleForIncrementalRefresh(viewName,
+				"DISTINCT, FILTER and ORDER BY in aggregates are not supported")
+		}
+		isAggregate[i] = true
+		q.aggregate = true
+		exprs = append(exprs, fn.Exprs...)
+	}
+	for _, e := range exprs {
+		if err := p.checkIncrementalViewExpr(ctx, viewName, e); err != nil {
+			return nil, err
+		}
+	}
+
+	if !q.aggregate && len(clause.GroupBy) == 0 {
+		return q, nil
+	}
+	q.aggregate = true
+	// The grouping expressions must be output columns, which identify the
+	// rows of the view that belong to each group.
+	findOutputCol := func(e tree.Expr) int {
+		if n, ok := e.(*tree.NumVal); ok {
+			if ord, err := n.AsInt64(); err == nil && ord >= 1 && int(ord) <= len(clause.Exprs) {
+				return int(ord) - 1
+			}
+			return -1
+		}
+		if n, ok := e.(*tree.UnresolvedName); ok && n.NumParts == 1 {
+			for i := range clause.Exprs {
+				if clause.Exprs[i].As == tree.UnrestrictedName(n.Parts[0]) {
+					return i
+				}
+			}
+		}
+		for i := range clause.Exprs {
+			if tree.AsString(clause.Exprs[i].Expr) == tree.AsString(e) {
+				return i
+			}
+		}
+		return -1
+	}
+	var isGroupCol = make([]bool, len(clause.Exprs))
+	for _, e := range clause.GroupBy {
+		if err := p.checkIncrementalViewExpr(ctx, viewName, e); err != nil {
+			return nil, err
+		}
+		ord := findOutputCol(e)
+		if ord < 0 || isAggregate[ord] {
+			return nil, notEligibleForIncrementalRefresh(viewName,
+				fmt.Sprintf("grouping expression %s is not an output column", tree.AsString(e)))
+		}
+		if !isGroupCol[ord] {
+			isGroupCol[ord] = true
+			q.groupCols = append(q.groupCols, ord)
+			q.groupExprs = append(q.groupExprs, clause.Exprs[ord].Expr)
+		}
+	}
+	for i := range clause.Exprs {
+		if !isAggregate[i] && !isGroupCol[i] {
+			return nil, notEligibleForIncrementalRefresh(viewName, fmt.Sprintf(
+				"output column %s must be a grouping expression or a SUM, COUNT, MIN or MAX aggregate",
+				tree.AsString(clause.Exprs[i].Expr),
+			))
+		}
+	}
+	return q, nil
+}
+
+// parseIncrementalViewQuery parses the query of the given materialized view.
+func parseIncrementalViewQuery(desc catalog.TableDescriptor) (*tree.Select, error) {
+	stmt, err := parser.ParseOne(desc.GetViewQuery())
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.AST.(*tree.Select)
+	if !ok {
+		return nil, errors.AssertionFailedf("unexpected view query %s", desc.GetViewQuery())
+	}
+	return sel, nil
+}
+
+// isIncrementalRefreshAggregate returns true if the given function call is a
+// call to one of the builtin aggregates supported by incremental refresh.
+func (p *planner) isIncrementalRefreshAggregate(
+	ctx context.Context, fn *tree.FuncExpr,
+) (bool, error) {
+	def, err := fn.Func.Resolve(ctx, p.semaCtx.SearchPath, p.semaCtx.FunctionResolver)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := incrementalRefreshAggregates[def.Name]; !ok || fn.WindowDef != nil {
+		return false, nil
+	}
+	for _, o := range def.Overloads {
+		if o.Type != tree.BuiltinRoutine {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// checkIncrementalViewExpr returns an error if the given expression of the
+// query of a materialized view prevents incremental refresh. The expression
+// must not contain subqueries, aggregates or window functions, and the
+// functions it calls must be immutable, since the contributions of the
+// unchanged rows of the base tables to the view are not recomputed.
+func (p *planner) checkIncrementalViewExpr(
+	ctx context.Context, viewName string, expr tree.Expr,
+) error {
+	_, err := tree.SimpleVisit(expr, func(e tree.Expr) (recurse bool, newExpr tree.Expr, err error) {
+		switch t := e.(type) {
+		case *tree.Subquery:
+			return false, nil, notEligibleForIncrementalRefresh(viewName, "subqueries are not supported")
+		case *tree.FuncExpr:
+			if t.WindowDef != nil {
+				return false, nil, notEligibleForIncrementalRefresh(viewName,
+					"window functions are not supported")
+			}
+			def, err := t.Func.Resolve(ctx, p.semaCtx.SearchPath, p.semaCtx.FunctionResolver)
+			if err != nil {
+				return false, nil, err
+			}
+			for _, o := range def.Overloads {
+				if o.Class != tree.NormalClass {
+					return false, nil, notEligibleForIncrementalRefresh(viewName, fmt.Sprintf(
+						"%s must be an output column of the query", def.Name,
+					))
+				}
+				if o.Volatility > volatility.Immutable {
+					return false, nil, notEligibleForIncrementalRefresh(viewName, fmt.Sprintf(
+						"function %s is not immutable", def.Name,
+					))
+				}
+			}
+		}
+		return true, e, nil
+	})
+	return err
+}
+
+// changedPrimaryKeys returns the primary keys of the rows of the given table
+// that were written or deleted after startTime and up to endTime. It returns
+// nil if more than limit rows have changed.
+func (p *planner) changedPrimaryKeys(
+	ctx context.Context, tbl catalog.TableDescriptor, startTime, endTime hlc.Timestamp, limit int64,
+) ([]tree.Datums, error) {
+	codec := p.ExecCfg().Codec
+	pk := tbl.GetPrimaryIndex()
+	colTypes := make([]*types.T, pk.NumKeyColumns())
+	for i := range colTypes {
+		col, err := catalog.MustFindColumnByID(tbl, pk.GetKeyColumnID(i))
+		if err != nil {
+			return nil, err
+		}
+		colTypes[i] = col.GetType()
+	}
+	colDirs := pk.IndexDesc().KeyColumnDirections
+
+	rows := make([]tree.Datums, 0)
+	vals := make([]rowenc.EncDatum, len(colTypes))
+	var alloc tree.DatumAlloc
+	var lastRowPrefix roachpb.Key
+	span := tbl.PrimaryIndexSpan(codec)
+	for {
+		req := &kvpb.ExportRequest{
+			RequestHeader: kvpb.RequestHeader{Key: span.Key, EndKey: span.EndKey},
+			StartTime:     startTime,
+			MVCCFilter:    kvpb.MVCCFilter_Latest,
+		}
+		header := kvpb.Header{
+			Timestamp:                   endTime,
+			ReturnElasticCPUResumeSpans: true,
+		}
+		resp, pErr := kv.SendWrappedWith(ctx, p.ExecCfg().DB.NonTransactionalSender(), header, req)
+		if pErr != nil {
+			return nil, pErr.GoError()
+		}
+		exportResp := resp.(*kvpb.ExportResponse)
+		for _, file := range exportResp.Files {
+			if err := func() error {
+				iter, err := storage.NewMemSSTIterator(file.SST, false /* verify */, storage.IterOptions{
+					KeyTypes:   storage.IterKeyTypePointsOnly,
+					LowerBound: file.Span.Key,
+					UpperBound: file.Span.EndKey,
+				})
+				if err != nil {
+					return err
+				}
+				defer iter.Close()
+				for iter.SeekGE(storage.MVCCKey{Key: file.Span.Key}); ; iter.Next() {
+					if ok, err := iter.Valid(); err != nil {
+						return err
+					} else if !ok {
+						return nil
+					}
+					// The column families of a row are stored under different keys,
+					// which all share the prefix that encodes the primary key.
+					key := iter.UnsafeKey().Key
+					prefixLen, err := keys.GetRowPrefixLength(key)
+					if err != nil {
+						return err
+					}
+					if lastRowPrefix.Equal(key[:prefixLen]) {
+						continue
+					}
+					lastRowPrefix = append(roachpb.Key(nil), key[:prefixLen]...)
+					if _, err := rowenc.DecodeIndexKey(codec, vals, colDirs, lastRowPrefix); err != nil {
+						return err
+					}
+					row := make(tree.Datums, len(vals))
+					for i := range vals {
+						if err := vals[i].EnsureDecoded(colTypes[i], &alloc); err != nil {
+							return err
+						}
+						row[i] = vals[i].Datum
+					}
+					rows = append(rows, row)
+				}
+			}(); err != nil {
+				return nil, err
+			}
+			if int64(len(rows)) > limit {
+				return nil, nil
+			}
+		}
+		if exportResp.ResumeSpan == nil {
+			return rows, nil
+		}
+		span.Key = exportResp.ResumeSpan.Key
+	}
+}
+
+// makeChangedRowsFilter returns an expression that is true for the rows of the
+// sources of a view query that are among the given changed rows.
+func makeChangedRowsFilter(sources []incrementalViewSource, changed [][]tree.Datums) tree.Expr {
+	var filter tree.Expr
+	for i := range sources {
+		if len(changed[i]) == 0 {
+			continue
+		}
+		pk := sources[i].desc.GetPrimaryIndex()
+		cols := make(tree.Exprs, pk.NumKeyColumns())
+		for j := range cols {
+			cols[j] = &tree.UnresolvedName{
+				NumParts: 2,
+				Parts:    tree.NameParts{pk.GetKeyColumnName(j), string(sources[i].name)},
+			}
+		}
+		in := &tree.ComparisonExpr{
+			Operator: treecmp.MakeComparisonOperator(treecmp.In),
+			Left:     makeTupleOrExpr(cols),
+			Right:    makeDatumsTuple(changed[i], len(cols) > 1),
+		}
+		if filter == nil {
+			filter = in
+		} else {
+			filter = &tree.OrExpr{Left: filter, Right: in}
+		}
+	}
+	return filter
+}
+
+// makeTupleOrExpr returns the single expression in exprs, or a tuple of the
+// expressions if there are several.
+func makeTupleOrExpr(exprs tree.Exprs) tree.Expr {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+	return &tree.Tuple{Exprs: exprs}
+}
+
+// makeDatumsTuple returns a tuple of the given rows. Each row is a tuple if
+// asTuples is true, and its single datum otherwise.
+func makeDatumsTuple(rows []tree.Datums, asTuples bool) *tree.Tuple {
+	exprs := make(tree.Exprs, len(rows))
+	for i, row := range rows {
+		rowExprs := make(tree.Exprs, len(row))
+		for j := range row {
+			rowExprs[j] = row[j]
+		}
+		if asTuples {
+			exprs[i] = &tree.Tuple{Exprs: rowExprs}
+		} else {
+			exprs[i] = rowExprs[0]
+		}
+	}
+	return &tree.Tuple{Exprs: exprs}
+}
+
+// queryViewAsOf runs the query of the given materialized view as of the given
+// timestamp, after applying modify to it. The query runs in its own
+// transaction, as the current user.
+func (p *planner) queryViewAsOf(
+	ctx context.Context,
+	desc catalog.TableDescriptor,
+	ts hlc.Timestamp,
+	modify func(clause *tree.SelectClause),
+) ([]tree.Datums, error) {
+	sel, err := parseIncrementalViewQuery(desc)
+	if err != nil {
+		return nil, err
+	}
+	clause := sel.Select.(*tree.SelectClause)
+	modify(clause)
+	clause.From.AsOf = tree.AsOfClause{Expr: tree.NewStrVal(ts.AsOfSystemTime())}
+	ie := p.ExecCfg().InternalDB.Executor(isql.WithSessionData(p.SessionData()))
+	rows, _, err := ie.QueryBufferedExWithCols(
+		ctx, "refresh-view-incrementally", nil /* txn */, sessiondata.NoSessionDataOverride, tree.Serialize(sel),
+	)
+	return rows, err
+}
+
+// andWhere adds the given filter to the WHERE clause of a SELECT.
+func andWhere(clause *tree.SelectClause, filter tree.Expr) {
+	if clause.Where == nil {
+		clause.Where = tree.NewWhere(tree.AstWhere, filter)
+		return
+	}
+	clause.Where = tree.NewWhere(tree.AstWhere, &tree.AndExpr{
+		Left:  &tree.ParenExpr{Expr: clause.Where.Expr},
+		Right: &tree.ParenExpr{Expr: filter},
+	})
+}
+
+// refreshViewIncrementally refreshes a materialized view whose query does not
+// compute aggregates. Each output row of such a query is derived from exactly
+// one row of each of its sources, so the contributions of the changed rows to
+// the view are the output rows of the query restricted to them.
+func (p *planner) refreshViewIncrementally(
+	ctx context.Context,
+	desc *tabledesc.Mutable,
+	q *incrementalViewQuery,
+	changed [][]tree.Datums,
+	startTime, endTime hlc.Timestamp,
+) error {
+	filter := makeChangedRowsFilter(q.sources, changed)
+	restrict := func(clause *tree.SelectClause) { andWhere(clause, filter) }
+	oldRows, err := p.queryViewAsOf(ctx, desc, startTime, restrict)
+	if err != nil {
+		return err
+	}
+	newRows, err := p.queryViewAsOf(ctx, desc, endTime, restrict)
+	if err != nil {
+		return err
+	}
+	toDelete, toInsert := diffIncrementalRefreshRows(oldRows, newRows)
+
+	// Find the primary keys of the rows of the view to delete, by looking them
+	// up by their values. The view may contain duplicate rows, any of which can
+	// be deleted.
+	var deleteRows []tree.Datums
+	seen := make(map[string]struct{}, len(toDelete))
+	for _, row := range oldRows {
+		k := incrementalRefreshRowKey(row)
+		if _, ok := seen[k]; !ok && toDelete[k] > 0 {
+			seen[k] = struct{}{}
+			deleteRows = append(deleteRows, row)
+		}
+	}
+	var deleteKeys []tree.Datums
+	if len(deleteRows) > 0 {
+		lookupCols := make([]int, len(deleteRows[0]))
+		for i := range lookupCols {
+			lookupCols[i] = i
+		}
+		if err := p.lookupViewForIncrementalRefresh(ctx, desc, lookupCols, deleteRows, func(pk, row tree.Datums) {
+			if k := incrementalRefreshRowKey(row); toDelete[k] > 0 {
+				toDelete[k]--
+				deleteKeys = append(deleteKeys, pk)
+			}
+		}); err != nil {
+			return err
+		}
+	}
+	return p.applyIncrementalRefresh(ctx, desc, deleteKeys, toInsert)
+}
+
+// refreshAggregateViewIncrementally refreshes a materialized view whose query
+// computes aggregates, by recomputing the groups that contain rows that
+// changed.
+func (p *planner) refreshAggregateViewIncrementally(
+	ctx context.Context,
+	desc *tabledesc.Mutable,
+	q *incrementalViewQuery,
+	changed [][]tree.Datums,
+	startTime, endTime hlc.Timestamp,
+) error {
+	// A query without grouping expressions has a single group, which contains
+	// all rows.
+	var groups map[string]struct{}
+	var groupRows []tree.Datums
+	var groupFilter tree.Expr
+	if len(q.groupExprs) > 0 {
+		// Find the groups that the changed rows belonged to as of the last
+		// refresh, and belong to now.
+		filter := makeChangedRowsFilter(q.sources, changed)
+		findGroups := func(clause *tree.SelectClause) {
+			clause.Distinct = true
+			clause.Exprs = make(tree.SelectExprs, len(q.groupExprs))
+			for i := range q.groupExprs {
+				clause.Exprs[i] = tree.SelectExpr{Expr: q.groupExprs[i]}
+			}
+			clause.GroupBy = nil
+			andWhere(clause, filter)
+		}
+		oldGroups, err := p.queryViewAsOf(ctx, desc, startTime, findGroups)
+		if err != nil {
+			return err
+		}
+		newGroups, err := p.queryViewAsOf(ctx, desc, endTime, findGroups)
+		if err != nil {
+			return err
+		}
+		groups = make(map[string]struct{})
+		for _, rows := range [][]tree.Datums{oldGroups, newGroups} {
+			for _, row := range rows {
+				k := incrementalRefreshRowKey(row)
+				if _, ok := groups[k]; !ok {
+					groups[k] = struct{}{}
+					groupRows = append(groupRows, row)
+				}
+			}
+		}
+		if len(groups) == 0 {
+			return nil
+		}
+		groupFilter = makeGroupsFilter(q.groupExprs, groupRows)
+	}
+
+	// Recompute the groups, and look up the rows of the view that belong to
+	// them by the values of its grouping columns.
+	newRows, err := p.queryViewAsOf(ctx, desc, endTime, func(clause *tree.SelectClause) {
+		if groupFilter != nil {
+			andWhere(clause, groupFilter)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	var oldRows, oldKeys []tree.Datums
+	if err := p.lookupViewForIncrementalRefresh(ctx, desc, q.groupCols, groupRows, func(pk, row tree.Datums) {
+		if groups != nil {
+			groupRow := make(tree.Datums, len(q.groupCols))
+			for i, ord := range q.groupCols {
+				groupRow[i] = row[ord]
+			}
+			if _, ok := groups[incrementalRefreshRowKey(groupRow)]; !ok {
+				return
+			}
+		}
+		oldRows = append(oldRows, row)
+		oldKeys = append(oldKeys, pk)
+	}); err != nil {
+		return err
+	}
+
+	// Leave the rows of the groups whose aggregates did not change untouched.
+	toDelete, toInsert := diffIncrementalRefreshRows(oldRows, newRows)
+	var deleteKeys []tree.Datums
+	for i, row := range oldRows {
+		if k := incrementalRefreshRowKey(row); toDelete[k] > 0 {
+			toDelete[k]--
+			deleteKeys = append(deleteKeys, oldKeys[i])
+		}
+	}
+	return p.applyIncrementalRefresh(ctx, desc, deleteKeys, toInsert)
+}
+
+// makeGroupsFilter returns an expression that is true for the rows whose
+// values of the given expressions are those of one of the given groups. The
+// groups without NULL values are matched with a single IN comparison, which
+// the optimizer turns into constrained spans when the expressions are the
+// leading columns of an index. The remaining groups are matched with IS NOT
+// DISTINCT FROM.
+func makeGroupsFilter(groupExprs tree.Exprs, groups []tree.Datums) tree.Expr {
+	var filter tree.Expr
+	or := func(e tree.Expr) {
+		if filter == nil {
+			filter = e
+		} else {
+			filter = &tree.OrExpr{Left: filter, Right: e}
+		}
+	}
+	var notNull []tree.Datums
+	for _, group := range groups {
+		if !incrementalRefreshRowHasNull(group) {
+			notNull = append(notNull, group)
+			continue
+		}
+		var match tree.Expr
+		for i := range groupExprs {
+			eq := &tree.ComparisonExpr{
+				Operator: treecmp.MakeComparisonOperator(treecmp.IsNotDistinctFrom),
+				Left:     &tree.ParenExpr{Expr: groupExprs[i]},
+				Right:    group[i],
+			}
+			if match == nil {
+				match = eq
+			} else {
+				match = &tree.AndExpr{Left: match, Right: eq}
+			}
+		}
+		or(match)
+	}
+	if len(notNull) > 0 {
+		left := make(tree.Exprs, len(groupExprs))
+		for i := range groupExprs {
+			left[i] = &tree.ParenExpr{Expr: groupExprs[i]}
+		}
+		or(&tree.ComparisonExpr{
+			Operator: treecmp.MakeComparisonOperator(treecmp.In),
+			Left:     makeTupleOrExpr(left),
+			Right:    makeDatumsTuple(notNull, len(groupExprs) > 1),
+		})
+	}
+	return filter
+}
+
+// incrementalRefreshRowHasNull returns true if the given row contains a NULL
+// value.
+func incrementalRefreshRowHasNull(row tree.Datums) bool {
+	for _, d := range row {
+		if d == tree.DNull {
+			return true
+		}
+	}
+	return false
+}
+
+// incrementalRefreshRowKey returns a string that identifies the values of the
+// given row.
+func incrementalRefreshRowKey(row tree.Datums) string {
+	fmtCtx := tree.NewFmtCtx(tree.FmtSerializable)
+	defer fmtCtx.Close()
+	for _, d := range row {
+		fmtCtx.FormatNode(d)
+		fmtCtx.WriteByte(',')
+	}
+	return fmtCtx.String()
+}
+
+// diffIncrementalRefreshRows compares the multisets of rows of a view that are
+// replaced by an incremental refresh and their replacements. It returns the
+// number of copies of each old row that must be deleted, and the new rows
+// that must be inserted, omitting the rows that appear in both.
+func diffIncrementalRefreshRows(
+	oldRows, newRows []tree.Datums,
+) (toDelete map[string]int, toInsert []tree.Datums) {
+	toDelete = make(map[string]int, len(oldRows))
+	for _, row := range oldRows {
+		toDelete[incrementalRefreshRowKey(row)]++
+	}
+	for _, row := range newRows {
+		if k := incrementalRefreshRowKey(row); toDelete[k] > 0 {
+			toDelete[k]--
+		} else {
+			toInsert = append(toInsert, row)
+		}
+	}
+	return toDelete, toInsert
+}
+
+// lookupViewForIncrementalRefresh calls fn with the primary key and the values
+// of the output columns of the rows of the given materialized view whose
+// values of the output columns with the given ordinals are one of the given
+// keys, and possibly other rows. The rows are looked up by key in batches, so
+// only the affected rows are read if the view has an index whose first column
+// is one of the given columns. If no columns are given, all rows are read.
+func (p *planner) lookupViewForIncrementalRefresh(
+	ctx context.Context,
+	desc catalog.TableDescriptor,
+	lookupCols []int,
+	keys []tree.Datums,
+	fn func(pk, row tree.Datums),
+) error {
+	pkCols := incrementalRefreshPrimaryKeyNames(desc)
+	viewCols := incrementalRefreshColumnNames(desc)
+	query := func(filter tree.Expr) error {
+		stmt := fmt.Sprintf(`SELECT %s, %s FROM [%d AS v]`,
+			tree.AsString(&pkCols), tree.AsString(&viewCols), desc.GetID())
+		if filter != nil {
+			stmt += " WHERE " + tree.Serialize(filter)
+		}
+		it, err := p.InternalSQLTxn().QueryIteratorEx(
+			ctx, "refresh-view-incrementally", p.Txn(), sessiondata.NoSessionDataOverride, stmt,
+		)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = it.Close() }()
+		var ok bool
+		for ok, err = it.Next(ctx); ok; ok, err = it.Next(ctx) {
+			row := it.Cur()
+			fn(row[:len(pkCols)], row[len(pkCols):])
+		}
+		return err
+	}
+	if len(lookupCols) == 0 {
+		return query(nil /* filter */)
+	}
+
+	if !hasIndexOnIncrementalRefreshColumns(desc, lookupCols) {
+		p.BufferClientNotice(ctx, errors.WithHint(
+			pgnotice.Newf(
+				"materialized view %q has no index on the columns used to find the rows "+
+					"affected by an incremental refresh; the whole view is scanned",
+				desc.GetName(),
+			),
+			"Create an index on the grouping columns of the view, or on one of its columns "+
+				"if it does not compute aggregates.",
+		))
+	}
+	lookupExprs := make(tree.Exprs, len(lookupCols))
+	for i, ord := range lookupCols {
+		lookupExprs[i] = &tree.UnresolvedName{NumParts: 1, Parts: tree.NameParts{string(viewCols[ord])}}
+	}
+	for len(keys) > 0 {
+		batch := keys[:min(len(keys), incrementalRefreshBatchSize)]
+		keys = keys[len(batch):]
+		if err := query(makeGroupsFilter(lookupExprs, batch)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasIndexOnIncrementalRefreshColumns returns true if the given materialized
+// view has an index that can be used to look up its rows by the values of the
+// output columns with the given ordinals.
+func hasIndexOnIncrementalRefreshColumns(desc catalog.TableDescriptor, cols []int) bool {
+	visible := desc.VisibleColumns()
+	for _, idx := range desc.ActiveIndexes() {
+		if idx.IsPartial() || idx.GetType() != idxtype.FORWARD || idx.NumKeyColumns() == 0 {
+			continue
+		}
+		for _, ord := range cols {
+			if visible[ord].GetID() == idx.GetKeyColumnID(0) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyIncrementalRefresh deletes the rows of the given materialized view with
+// the given primary keys, and inserts the given rows.
+func (p *planner) applyIncrementalRefresh(
+	ctx context.Context, desc catalog.TableDescriptor, deleteKeys, insertRows []tree.Datums,
+) error {
+	override := sessiondata.InternalExecutorOverride{AllowMaterializedViewMutation: true}
+	pkCols := incrementalRefreshPrimaryKeyNames(desc)
+	pkColExprs := make(tree.Exprs, len(pkCols))
+	for i := range pkCols {
+		pkColExprs[i] = &tree.UnresolvedName{NumParts: 1, Parts: tree.NameParts{string(pkCols[i])}}
+	}
+	for len(deleteKeys) > 0 {
+		batch := deleteKeys[:min(len(deleteKeys), incrementalRefreshBatchSize)]
+		deleteKeys = deleteKeys[len(batch):]
+		in := &tree.ComparisonExpr{
+			Operator: treecmp.MakeComparisonOperator(treecmp.In),
+			Left:     makeTupleOrExpr(pkColExprs),
+			Right:    makeDatumsTuple(batch, len(pkCols) > 1),
+		}
+		stmt := fmt.Sprintf(`DELETE FROM [%d AS v] WHERE %s`, desc.GetID(), tree.Serialize(in))
+		if _, err := p.InternalSQLTxn().ExecEx(
+			ctx, "refresh-view-incrementally", p.Txn(), override, stmt,
+		); err != nil {
+			return err
+		}
+	}
+	viewCols := incrementalRefreshColumnNames(desc)
+	for len(insertRows) > 0 {
+		batch := insertRows[:min(len(insertRows), incrementalRefreshBatchSize)]
+		insertRows = insertRows[len(batch):]
+		var values strings.Builder
+		for i, row := range batch {
+			if i > 0 {
+				values.WriteString(", ")
+			}
+			values.WriteString(tree.Serialize(makeDatumsTuple([]tree.Datums{row}, true /* asTuples */).Exprs[0]))
+		}
+		stmt := fmt.Sprintf(`INSERT INTO [%d AS v] (%s) VALUES %s`,
+			desc.GetID(), tree.AsString(&viewCols), values.String())
+		if _, err := p.InternalSQLTxn().ExecEx(
+			ctx, "refresh-view-incrementally", p.Txn(), override, stmt,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// incrementalRefreshPrimaryKeyNames returns the names of the primary key
+// columns of the given materialized view.
+func incrementalRefreshPrimaryKeyNames(desc catalog.TableDescriptor) tree.NameList {
+	pk := desc.GetPrimaryIndex()
+	names := make(tree.NameList, pk.NumKeyColumns())
+	for i := range names {
+		names[i] = tree.Name(pk.GetKeyColumnName(i))
+	}
+	return names
+}
+
+// incrementalRefreshColumnNames returns the names of the output columns of the
+// given materialized view.
+func incrementalRefreshColumnNames(desc catalog.TableDescriptor) tree.NameList {
+	cols := desc.VisibleColumns()
+	names := make(tree.NameList, len(cols))
+	for i, col := range cols {
+		names[i] = col.ColName()
+	}
+	return names
+}
@@ -156,6 +156,12 @@ func (p *planner) renameColumn(
 	if tableDesc.IsShardColumn(col) {
 		return false, pgerror.Newf(pgcode.ReservedName, "cannot rename shard column")
 	}
+	if err := checkNoInheritingTables(tableDesc, "RENAME COLUMN"); err != nil {
+		return false, err
+	}
+	if err := p.checkColumnNotInherited(ctx, tableDesc, "rename", oldName); err != nil {
+		return false, err
+	}
 	if err := tabledesc.RenameColumnInTable(tableDesc, col, newName, func(shardCol catalog.Column, newShardColName tree.Name) (bool, error) {
 		if c, err := p.findColumnToRename(ctx, tableDesc, shardCol.ColName(), newShardColName); err != nil || c == nil {
 			return false, err
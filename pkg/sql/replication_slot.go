@@ -0,0 +1,254 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsn"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsnutil"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/pgrepltree"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondatapb"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+)
+
+// pgoutputPlugin is the only supported logical decoding output plugin.
+const pgoutputPlugin = "pgoutput"
+
+// replicationSlot is a row of system.replication_slots. A replication slot
+// records the position up to which a subscriber has confirmed that it
+// received the changes of a database, so that the changes can be streamed
+// from that position when the subscriber reconnects.
+type replicationSlot struct {
+	name       string
+	databaseID descpb.ID
+	plugin     string
+	// confirmedFlushLSN is the position up to which the subscriber has
+	// flushed the changes. It is the LSN of a resolved timestamp, see
+	// lsnutil.HLCToLSN.
+	confirmedFlushLSN lsn.LSN
+}
+
+// loadReplicationSlot returns the replication slot with the given name, or
+// nil if it does not exist.
+func loadReplicationSlot(ctx context.Context, txn isql.Txn, name string) (*replicationSlot, error) {
+	const opName = "load-replication-slot"
+	row, err := txn.QueryRowEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		`SELECT database_id, plugin, confirmed_flush_lsn FROM system.replication_slots
+WHERE slot_name = $1`, name,
+	)
+	if err != nil || row == nil {
+		return nil, err
+	}
+	return &replicationSlot{
+		name:              name,
+		databaseID:        descpb.ID(tree.MustBeDInt(row[0])),
+		plugin:            string(tree.MustBeDString(row[1])),
+		confirmedFlushLSN: lsn.LSN(tree.MustBeDInt(row[2])),
+	}, nil
+}
+
+// advanceReplicationSlot moves the confirmed flush position of a replication
+// slot forward. The position never moves backwards.
+func advanceReplicationSlot(ctx context.Context, txn isql.Txn, name string, flushed lsn.LSN) error {
+	const opName = "advance-replication-slot"
+	_, err := txn.ExecEx(
+		ctx, opName, txn.KV(), sessiondata.NodeUserSessionDataOverride,
+		`UPDATE system.replication_slots SET confirmed_flush_lsn = $2
+WHERE slot_name = $1 AND confirmed_flush_lsn < $2`, name, int64(flushed),
+	)
+	return err
+}
+
+// replicationOptionString returns the value of an option of a replication
+// command as a string.
+func replicationOptionString(o pgrepltree.Option) string {
+	switch v := o.Value.(type) {
+	case nil:
+		return ""
+	case *tree.StrVal:
+		return v.RawString()
+	default:
+		return tree.AsStringWithFlags(v, tree.FmtBareStrings)
+	}
+}
+
+// checkDatabaseReplicationMode returns an error if the session was not
+// started with replication=database, which is required by the logical
+// replication commands.
+func (p *planner) checkDatabaseReplicationMode(op string) error {
+	if p.SessionData().ReplicationMode != sessiondatapb.ReplicationMode_REPLICATION_MODE_DATABASE {
+		return pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+			"%s requires a connection with replication=database", op)
+	}
+	return nil
+}
+
+type createReplicationSlotNode struct {
+	zeroInputPlanNode
+	optColumnsSlot
+	n *pgrepltree.CreateReplicationSlot
+	// exportSnapshot is set if the name of a snapshot is returned.
+	exportSnapshot bool
+	values         tree.Datums
+	shown          bool
+}
+
+// CreateReplicationSlot creates a logical replication slot for the current
+// database. The consistent point of the slot is derived from the timestamp of
+// the transaction that creates it: the changes committed after it are
+// streamed by START_REPLICATION.
+//
+// Exported snapshots are not supported. Instead, if a snapshot is requested,
+// the snapshot name is the consistent point as a decimal HLC timestamp, which
+// can be used with AS OF SYSTEM TIME to read the data that the changes
+// streamed from the slot apply to.
+func (p *planner) CreateReplicationSlot(
+	ctx context.Context, n *pgrepltree.CreateReplicationSlot,
+) (planNode, error) {
+	if err := checkPublicationsSupported(ctx, p.ExecCfg(), "CREATE_REPLICATION_SLOT"); err != nil {
+		return nil, err
+	}
+	if err := p.checkDatabaseReplicationMode("CREATE_REPLICATION_SLOT"); err != nil {
+		return nil, err
+	}
+	if n.Kind != pgrepltree.LogicalReplication {
+		return nil, unimplemented.New("physical replication slot", "physical replication slots are not supported")
+	}
+	if n.Temporary {
+		return nil, unimplemented.New("temporary replication slot", "temporary replication slots are not supported")
+	}
+	if n.Plugin != pgoutputPlugin {
+		return nil, pgerror.Newf(pgcode.UndefinedObject,
+			"output plugin %q does not exist", n.Plugin)
+	}
+	node := &createReplicationSlotNode{n: n}
+	for _, o := range n.Options {
+		val := replicationOptionString(o)
+		switch o.Key {
+		case "snapshot":
+			switch val {
+			case "export", "use":
+				node.exportSnapshot = true
+			case "nothing":
+			default:
+				return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+					"unrecognized value for CREATE_REPLICATION_SLOT option %q: %q", o.Key, val)
+			}
+		case "two_phase", "failover":
+			if val != "" && val != "false" && val != "off" && val != "0" {
+				return nil, unimplemented.Newf("replication slot option",
+					"CREATE_REPLICATION_SLOT option %q is not supported", o.Key)
+			}
+		default:
+			return nil, pgerror.Newf(pgcode.Syntax,
+				"unrecognized option: %s", o.Key)
+		}
+	}
+	return node, nil
+}
+
+func (c *createReplicationSlotNode) startExec(params runParams) error {
+	p := params.p
+	ctx := params.ctx
+	db, err := p.currentDatabaseDesc(ctx, "CREATE_REPLICATION_SLOT")
+	if err != nil {
+		return err
+	}
+	const opName = "create-replication-slot"
+	row, err := p.InternalSQLTxn().QueryRowEx(
+		ctx, opName, p.Txn(), sessiondata.NodeUserSessionDataOverride,
+		`SELECT 1 FROM system.replication_slots WHERE slot_name = $1`, string(c.n.Slot),
+	)
+	if err != nil {
+		return err
+	}
+	if row != nil {
+		return pgerror.Newf(pgcode.DuplicateObject,
+			"replication slot %q already exists", c.n.Slot)
+	}
+	// The changes committed at the wall time of the transaction may be
+	// committed both before and after it, so the consistent point is right
+	// before that wall time.
+	consistentPoint := lsnutil.HLCToLSN(p.Txn().ReadTimestamp()) - 1
+	if _, err := p.InternalSQLTxn().ExecEx(
+		ctx, opName, p.Txn(), sessiondata.NodeUserSessionDataOverride,
+		`INSERT INTO system.replication_slots (slot_name, database_id, plugin, confirmed_flush_lsn)
+VALUES ($1, $2, $3, $4)`,
+		string(c.n.Slot), db.GetID(), pgoutputPlugin, int64(consistentPoint),
+	); err != nil {
+		return err
+	}
+	snapshot := tree.DNull
+	if c.exportSnapshot {
+		snapshot = tree.NewDString(lsnutil.LSNToHLC(consistentPoint).AsOfSystemTime())
+	}
+	c.values = tree.Datums{
+		tree.NewDString(string(c.n.Slot)),
+		tree.NewDString(consistentPoint.String()),
+		snapshot,
+		tree.NewDString(pgoutputPlugin),
+	}
+	return nil
+}
+
+func (c *createReplicationSlotNode) Next(_ runParams) (bool, error) {
+	if c.shown {
+		return false, nil
+	}
+	c.shown = true
+	return true, nil
+}
+
+func (c *createReplicationSlotNode) Values() tree.Datums     { return c.values }
+func (c *createReplicationSlotNode) Close(_ context.Context) {}
+
+type dropReplicationSlotNode struct {
+	zeroInputPlanNode
+	n *pgrepltree.DropReplicationSlot
+}
+
+// DropReplicationSlot drops a logical replication slot. A slot that is in use
+// by START_REPLICATION can be dropped; the stream keeps running until the
+// subscriber disconnects, but the position it confirms is no longer recorded.
+func (p *planner) DropReplicationSlot(
+	ctx context.Context, n *pgrepltree.DropReplicationSlot,
+) (planNode, error) {
+	if err := checkPublicationsSupported(ctx, p.ExecCfg(), "DROP_REPLICATION_SLOT"); err != nil {
+		return nil, err
+	}
+	if err := p.checkDatabaseReplicationMode("DROP_REPLICATION_SLOT"); err != nil {
+		return nil, err
+	}
+	return &dropReplicationSlotNode{n: n}, nil
+}
+
+func (d *dropReplicationSlotNode) startExec(params runParams) error {
+	const opName = "drop-replication-slot"
+	n, err := params.p.InternalSQLTxn().ExecEx(
+		params.ctx, opName, params.p.Txn(), sessiondata.NodeUserSessionDataOverride,
+		`DELETE FROM system.replication_slots WHERE slot_name = $1`, string(d.n.Slot),
+	)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return pgerror.Newf(pgcode.UndefinedObject,
+			"replication slot %q does not exist", d.n.Slot)
+	}
+	return nil
+}
+
+func (d *dropReplicationSlotNode) Next(_ runParams) (bool, error) { return false, nil }
+func (d *dropReplicationSlotNode) Values() tree.Datums            { return nil }
+func (d *dropReplicationSlotNode) Close(_ context.Context)        {}
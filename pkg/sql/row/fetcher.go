@@ -252,7 +252,9 @@ type Fetcher struct {
 	kvFetcher *KVFetcher
 	// indexKey stores the index key of the current row, up to (and not including)
 	// any family ID.
-	indexKey       []byte
+	indexKey []byte
+	// rowKey is the indexKey of the row last returned by NextRow.
+	rowKey         []byte
 	prettyValueBuf *bytes.Buffer
 
 	valueColsFound int // how many needed cols we've found so far in the value
@@ -1200,6 +1202,8 @@ func (rf *Fetcher) NextRow(ctx context.Context) (row rowenc.EncDatumRow, spanID
 			log.VEventf(ctx, TraceKVVerbosity, "fetched: %s -> %s", prettyKey, prettyVal)
 		}
 
+		// nextKey resets indexKey if the row is done.
+		rowKey := rf.indexKey
 		rowDone, spanID, err := rf.nextKey(ctx)
 		if err != nil {
 			return nil, 0, err
@@ -1208,6 +1212,7 @@ func (rf *Fetcher) NextRow(ctx context.Context) (row rowenc.EncDatumRow, spanID
 			err := rf.finalizeRow()
 			rowSpanID := rf.spanID
 			rf.spanID = spanID
+			rf.rowKey = rowKey
 			return rf.table.row, rowSpanID, err
 		}
 	}
@@ -1383,6 +1388,12 @@ func (rf *Fetcher) finalizeRow() error {
 	return nil
 }
 
+// RowKey returns the key of the row last returned by NextRow, excluding the
+// column family suffix. It is only valid until the next call to NextRow.
+func (rf *Fetcher) RowKey() roachpb.Key {
+	return rf.rowKey
+}
+
 // Key returns the next key (the key that follows the last returned row).
 // Key returns nil when there are no more rows.
 func (rf *Fetcher) Key() roachpb.Key {
@@ -248,6 +248,9 @@ type DatumRowConverter struct {
 	defaultCache              []tree.TypedExpr
 	computedIVarContainer     schemaexpr.RowIndexedVarContainer
 	partialIndexIVarContainer schemaexpr.RowIndexedVarContainer
+	// domainCheckers is nil if no column in cols has a domain type with
+	// constraints, and is otherwise aligned with cols.
+	domainCheckers []*schemaexpr.DomainChecker
 
 	// FractionFn is used to set the progress header in KVBatches.
 	CompletedRowFn func() int64
@@ -518,6 +521,11 @@ func NewDatumRowConverter(
 		Mapping: ri.InsertColIDtoRowIndex,
 		Cols:    tableDesc.PublicColumns(),
 	}
+
+	c.domainCheckers, err = schemaexpr.MakeDomainCheckers(ctx, c.cols, c.SemaCtx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error type checking and building domain constraints for IMPORT INTO")
+	}
 	return c, nil
 }
 
@@ -558,6 +566,17 @@ func (c *DatumRowConverter) Row(ctx context.Context, sourceID int32, rowIndex in
 		return errors.Wrap(err, "generate insert row")
 	}
 
+	// The optimizer does not plan IMPORT, so the constraints of domain types
+	// are checked here.
+	for i, checker := range c.domainCheckers {
+		if checker == nil {
+			continue
+		}
+		if err := checker.Check(ctx, c.EvalCtx, insertRow[i]); err != nil {
+			return err
+		}
+	}
+
 	// Initialize the PartialIndexUpdateHelper with evaluated predicates for
 	// partial indexes.
 	var pm PartialIndexUpdateHelper
@@ -72,6 +72,16 @@ type aggregatorBase struct {
 	orderedGroupCols []uint32
 	aggregations     []execinfrapb.AggregatorSpec_Aggregation
 
+	// groupingSets is set if the aggregations are computed for several
+	// grouping sets in a single pass (see execinfrapb.AggregatorSpec). In this
+	// case, each input row is expanded into expandedRow once for each grouping
+	// set, and inputTypes includes the virtual grouping set columns.
+	groupingSets []groupingSetExpansion
+	expandedRow  rowenc.EncDatumRow
+	// groupingSetsColsAggs are the ordinals of the aggregations whose
+	// arguments are all virtual grouping set columns.
+	groupingSetsColsAggs []int
+
 	lastOrdGroupCols rowenc.EncDatumRow
 	arena            stringarena.Arena
 	row              rowenc.EncDatumRow
@@ -119,6 +129,35 @@ func (ag *aggregatorBase) init(
 	// grouped-by values for each bucket.  ag.funcs is updated to contain all
 	// the functions which need to be fed values.
 	ag.inputTypes = input.OutputTypes()
+	if len(spec.GroupingSets) > 0 {
+		if len(spec.OrderedGroupCols) > 0 {
+			return errors.AssertionFailedf("ordered grouping columns are not supported with grouping sets")
+		}
+		numInputCols := len(ag.inputTypes)
+		ag.inputTypes = ag.inputTypes[:numInputCols:numInputCols]
+		for i := 0; i < 1+len(spec.GroupingFuncs); i++ {
+			ag.inputTypes = append(ag.inputTypes, types.Int)
+		}
+		for _, c := range spec.GroupingSetsCols() {
+			ag.inputTypes = append(ag.inputTypes, ag.inputTypes[c])
+		}
+		expansions := spec.MakeGroupingSetExpansions()
+		ag.groupingSets = make([]groupingSetExpansion, len(expansions))
+		for i := range expansions {
+			ag.groupingSets[i].cols = expansions[i].Cols
+			ag.groupingSets[i].empty = expansions[i].Empty
+			ag.groupingSets[i].values = make(rowenc.EncDatumRow, len(expansions[i].Values))
+			for j, v := range expansions[i].Values {
+				ag.groupingSets[i].values[j] = rowenc.EncDatum{Datum: tree.NewDInt(tree.DInt(v))}
+			}
+		}
+		ag.expandedRow = make(rowenc.EncDatumRow, len(ag.inputTypes))
+		for i := range spec.Aggregations {
+			if spec.IsGroupingSetsColsAggregation(&spec.Aggregations[i], numInputCols) {
+				ag.groupingSetsColsAggs = append(ag.groupingSetsColsAggs, i)
+			}
+		}
+	}
 	semaCtx := flowCtx.NewSemaContext(flowCtx.Txn)
 	pAlloc := execagg.MakeParamTypesAllocator(spec.Aggregations)
 	for i, aggInfo := range spec.Aggregations {
@@ -160,6 +199,19 @@ func (ag *aggregatorBase) init(
 	)
 }
 
+// groupingSetExpansion describes how an input row is expanded for one of the
+// grouping sets of an aggregator.
+type groupingSetExpansion struct {
+	// values are the values of the virtual grouping level and GROUPING()
+	// columns which are appended to the input row.
+	values rowenc.EncDatumRow
+	// cols are the input columns that the virtual grouping columns, which are
+	// appended after values, are copied from; -1 means NULL.
+	cols []int
+	// empty is set if the grouping set has no columns.
+	empty bool
+}
+
 // execStatsForTrace implements ProcessorBase.ExecStatsForTrace.
 func (ag *aggregatorBase) execStatsForTrace() *execinfrapb.ComponentStats {
 	is, ok := getInputStats(ag.input)
@@ -436,12 +488,28 @@ func (ag *hashAggregator) accumulateRows() (
 				break
 			}
 		}
+		if ag.groupingSets != nil {
+			if err := ag.accumulateGroupingSets(row); err != nil {
+				ag.MoveToDraining(err)
+				return aggStateUnknown, nil, nil
+			}
+			continue
+		}
 		if err := ag.accumulateRow(row); err != nil {
 			ag.MoveToDraining(err)
 			return aggStateUnknown, nil, nil
 		}
 	}
 
+	// Queries like `SELECT count(*) FROM t GROUP BY ROLLUP (n)` expect a row
+	// for the empty grouping set if nothing was aggregated.
+	if len(ag.buckets) < 1 && ag.groupingSets != nil {
+		if err := ag.accumulateEmptyGroupingSets(); err != nil {
+			ag.MoveToDraining(err)
+			return aggStateUnknown, nil, nil
+		}
+	}
+
 	// Queries like `SELECT MAX(n) FROM t` expect a row of NULLs if nothing was
 	// aggregated.
 	if len(ag.buckets) < 1 && len(ag.groupCols) == 0 {
@@ -822,6 +890,79 @@ func (ag *hashAggregator) encode(
 	return appendTo, nil
 }
 
+// accumulateGroupingSets accumulates a single input row once for each
+// grouping set, with the virtual grouping set columns appended.
+func (ag *hashAggregator) accumulateGroupingSets(row rowenc.EncDatumRow) error {
+	numInputCols := len(row)
+	copy(ag.expandedRow, row)
+	for i := range ag.groupingSets {
+		gs := &ag.groupingSets[i]
+		copy(ag.expandedRow[numInputCols:], gs.values)
+		groupingColsStart := numInputCols + len(gs.values)
+		for j, c := range gs.cols {
+			if c < 0 {
+				ag.expandedRow[groupingColsStart+j] = rowenc.NullEncDatum()
+			} else {
+				ag.expandedRow[groupingColsStart+j] = row[c]
+			}
+		}
+		if err := ag.accumulateRow(ag.expandedRow); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accumulateEmptyGroupingSets creates the group of each empty grouping set
+// when the input is empty. The aggregations over the virtual grouping set
+// columns are computed over the virtual columns of the grouping set, and the
+// other aggregations over no rows.
+func (ag *hashAggregator) accumulateEmptyGroupingSets() error {
+	numInputCols := len(ag.input.OutputTypes())
+	for i := 0; i < numInputCols; i++ {
+		ag.expandedRow[i] = rowenc.NullEncDatum()
+	}
+	for i := range ag.groupingSets {
+		gs := &ag.groupingSets[i]
+		if !gs.empty {
+			continue
+		}
+		copy(ag.expandedRow[numInputCols:], gs.values)
+		groupingColsStart := numInputCols + len(gs.values)
+		for j := range gs.cols {
+			ag.expandedRow[groupingColsStart+j] = rowenc.NullEncDatum()
+		}
+		encoded, err := ag.encode(ag.scratch, ag.expandedRow)
+		if err != nil {
+			return err
+		}
+		ag.scratch = encoded[:0]
+		s, err := ag.arena.AllocBytes(ag.Ctx(), encoded)
+		if err != nil {
+			return err
+		}
+		bucket, err := ag.createAggregateFuncs()
+		if err != nil {
+			return err
+		}
+		ag.buckets[s] = bucket
+		for _, aggIdx := range ag.groupingSetsColsAggs {
+			a := &ag.aggregations[aggIdx]
+			args := make(tree.Datums, len(a.ColIdx))
+			for j, c := range a.ColIdx {
+				if err := ag.expandedRow[c].EnsureDecoded(ag.inputTypes[c], &ag.datumAlloc); err != nil {
+					return err
+				}
+				args[j] = ag.expandedRow[c].Datum
+			}
+			if err := bucket[aggIdx].Add(ag.Ctx(), args[0], args[1:]...); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // accumulateRow accumulates a single row, returning an error if accumulation
 // failed for any reason.
 func (ag *hashAggregator) accumulateRow(row rowenc.EncDatumRow) error {
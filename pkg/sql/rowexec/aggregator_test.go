@@ -386,6 +386,86 @@ func TestAggregator(t *testing.T) {
 				},
 			},
 		},
+		{
+			// SELECT @1, @2, <grouping level>, GROUPING(@1, @2), sum_int(@3),
+			// sum_int(@1) GROUP BY ROLLUP (@1, @2). The virtual columns are the
+			// grouping level (@4), GROUPING(@1, @2) (@5) and the copies of @1
+			// and @2 (@6 and @7) which are NULL when they aren't grouped by.
+			Name: "SumGroupByRollup",
+			Input: ProcessorTestCaseRows{
+				Rows: [][]interface{}{
+					{1, 1, 10},
+					{1, 2, 20},
+					{2, 1, 30},
+				},
+				Types: types.MakeIntCols(3),
+			},
+			Output: ProcessorTestCaseRows{
+				Rows: [][]interface{}{
+					{1, 1, 0, 0, 10, 1},
+					{1, 2, 0, 0, 20, 1},
+					{2, 1, 0, 0, 30, 2},
+					{1, nil, 1, 1, 30, 2},
+					{2, nil, 1, 1, 30, 2},
+					{nil, nil, 2, 3, 60, 4},
+				},
+				Types: types.MakeIntCols(6),
+			},
+			ProcessorCore: execinfrapb.ProcessorCoreUnion{
+				Aggregator: &execinfrapb.AggregatorSpec{
+					GroupCols: []uint32{3, 5, 6},
+					GroupingSets: []execinfrapb.AggregatorSpec_ColumnSet{
+						{Cols: []uint32{0, 1}},
+						{Cols: []uint32{0}},
+						{},
+					},
+					GroupingFuncs: []execinfrapb.AggregatorSpec_ColumnSet{
+						{Cols: []uint32{0, 1}},
+					},
+					Aggregations: aggregations([]aggTestSpec{
+						{fname: "ANY_NOT_NULL", colIdx: []uint32{5}},
+						{fname: "ANY_NOT_NULL", colIdx: []uint32{6}},
+						{fname: "ANY_NOT_NULL", colIdx: []uint32{3}},
+						{fname: "ANY_NOT_NULL", colIdx: []uint32{4}},
+						{fname: "SUM_INT", colIdx: col2},
+						{fname: "SUM_INT", colIdx: col0},
+					}),
+				},
+			},
+		},
+		{
+			// SELECT @1, GROUPING(@1), count(*), sum_int(@2) GROUP BY ROLLUP (@1)
+			// (no rows). Only the empty grouping set produces a row.
+			Name: "CountSumGroupByRollupNoRows",
+			Input: ProcessorTestCaseRows{
+				Rows:  [][]interface{}{},
+				Types: types.MakeIntCols(2),
+			},
+			Output: ProcessorTestCaseRows{
+				Rows: [][]interface{}{
+					{nil, 1, 0, nil},
+				},
+				Types: types.MakeIntCols(4),
+			},
+			ProcessorCore: execinfrapb.ProcessorCoreUnion{
+				Aggregator: &execinfrapb.AggregatorSpec{
+					GroupCols: []uint32{2, 4},
+					GroupingSets: []execinfrapb.AggregatorSpec_ColumnSet{
+						{Cols: []uint32{0}},
+						{},
+					},
+					GroupingFuncs: []execinfrapb.AggregatorSpec_ColumnSet{
+						{Cols: []uint32{0}},
+					},
+					Aggregations: aggregations([]aggTestSpec{
+						{fname: "ANY_NOT_NULL", colIdx: []uint32{4}},
+						{fname: "ANY_NOT_NULL", colIdx: []uint32{3}},
+						{fname: "COUNT_ROWS"},
+						{fname: "SUM_INT", colIdx: col1},
+					}),
+				},
+			},
+		},
 	}
 
 	ctx := context.Background()
@@ -95,9 +95,10 @@ type joinReader struct {
 	}
 
 	// limitedMemMonitor is a limited memory monitor to account for the memory
-	// used by buffered rows in joinReaderOrderingStrategy. If the memory limit is
-	// exceeded, the joinReader will spill to disk. diskMonitor is used to monitor
-	// the disk utilization in this case.
+	// used by buffered rows in joinReaderOrderingStrategy or by the hash table of
+	// an adaptive lookup join. If the memory limit is exceeded, the joinReader
+	// will spill to disk. diskMonitor is used to monitor the disk utilization in
+	// this case.
 	limitedMemMonitor   *mon.BytesMonitor
 	unlimitedMemMonitor *mon.BytesMonitor
 	diskMonitor         *mon.BytesMonitor
@@ -258,6 +259,50 @@ type joinReader struct {
 	// only lookups to rows in remote regions and remote accesses are set to
 	// error out via a session setting.
 	errorOnLookup bool
+
+	// adaptive contains the state of an adaptive lookup join. Once more than
+	// rowThreshold input rows have been read, and the lookup index is estimated
+	// to have at most adaptiveJoinScanRowsPerLookup times as many rows as the
+	// input rows read, an adaptive lookup join stops performing point lookups.
+	// Instead, it scans the whole lookup index once, builds a hash table of the
+	// scanned rows keyed by their lookup columns, and probes it with the
+	// remaining input rows, so the join becomes a hash join.
+	//
+	// Only lookup joins that are planned on a single processor with an estimate
+	// of the size of the lookup index, that use defaultSpanGenerator and
+	// joinReaderNoOrderingStrategy, that don't lock the looked up rows, and
+	// that fetch all of the index columns used for the lookup are adaptive.
+	adaptive struct {
+		// rowThreshold is zero if the join is not adaptive.
+		rowThreshold int64
+		// lookupRowCount is the estimated number of rows in the lookup index.
+		lookupRowCount uint64
+		// inputRowsRead is the number of input rows read so far.
+		inputRowsRead int64
+		// switched is true once the join switched to a hash join.
+		switched bool
+		// spanGen is the span generator of the join strategy.
+		spanGen *defaultSpanGenerator
+		// lookupColOrds are the ordinals of the fetched columns that
+		// correspond to the lookup columns.
+		lookupColOrds []uint32
+		// lookedUpTypes are the types of the fetched columns.
+		lookedUpTypes []*types.T
+		// fullSpan is the span of the entire lookup index.
+		fullSpan roachpb.Span
+		// hashTable contains the rows of the lookup index. It is built by the
+		// first batch of input rows read after the switch.
+		hashTable *rowcontainer.HashDiskBackedRowContainer
+		// probeSpanID is the ID of the next span of the current batch to probe
+		// the hash table with. All input rows that generated the same span
+		// have the same lookup values, so each span is probed only once.
+		probeSpanID int
+		// probeIter iterates over the rows of the hash table that match the
+		// span probeSpanID-1. advanceProbeIter is true if probeIter points at
+		// a row that was already returned.
+		probeIter        rowcontainer.RowMarkerIterator
+		advanceProbeIter bool
+	}
 }
 
 var _ execinfra.Processor = &joinReader{}
@@ -277,6 +322,23 @@ var ParallelizeMultiKeyLookupJoinsEnabled = settings.RegisterBoolSetting(
 	false,
 )
 
+// adaptiveJoinRowThreshold is the number of input rows after which a lookup
+// join switches to a hash join over a single full scan of the lookup index.
+var adaptiveJoinRowThreshold = settings.RegisterIntSetting(
+	settings.ApplicationLevel,
+	"sql.distsql.adaptive_join.row_threshold",
+	"number of input rows after which a lookup join switches to a hash join "+
+		"over a full scan of the lookup index; 0 disables the switch",
+	1_000_000,
+	settings.NonNegativeInt,
+)
+
+// adaptiveJoinScanRowsPerLookup is the number of rows of the lookup index that
+// a full scan is assumed to read in the time it takes to perform the lookup for
+// a single input row. An adaptive lookup join only switches to a hash join if
+// the lookup index has at most this many rows per input row read.
+const adaptiveJoinScanRowsPerLookup = 10
+
 // newJoinReader returns a new joinReader.
 func newJoinReader(
 	ctx context.Context,
@@ -605,6 +667,9 @@ func newJoinReader(
 			jr.batchSizeBytes = memoryLimit / 2
 		}
 	}
+	if readerType == lookupJoinReaderType {
+		jr.maybeInitAdaptiveJoin(flowCtx, spec, rightTypes)
+	}
 
 	var fetcher row.Fetcher
 	if err := fetcher.Init(
@@ -783,6 +848,179 @@ func (jr *joinReader) initJoinReaderStrategy(
 	return nil
 }
 
+// maybeInitAdaptiveJoin initializes jr.adaptive if the lookup join can switch
+// to a hash join over a full scan of the lookup index. It must be called after
+// the join reader strategy has been initialized.
+func (jr *joinReader) maybeInitAdaptiveJoin(
+	flowCtx *execinfra.FlowCtx, spec *execinfrapb.JoinReaderSpec, lookedUpTypes []*types.T,
+) {
+	rowThreshold := adaptiveJoinRowThreshold.Get(&flowCtx.Cfg.Settings.SV)
+	if rowThreshold == 0 || spec.AdaptiveLookupRowCount == 0 || spec.LockingStrength != descpb.ScanLockingStrength_FOR_NONE ||
+		spec.FetchSpec.External != nil || jr.groupingState.doGrouping {
+		return
+	}
+	strategy, ok := jr.strategy.(*joinReaderNoOrderingStrategy)
+	if !ok {
+		return
+	}
+	spanGen, ok := strategy.joinReaderSpanGenerator.(*defaultSpanGenerator)
+	if !ok {
+		return
+	}
+	// The looked up rows are matched with the input rows using the values of
+	// the index columns used for the lookup, so all of them must be fetched.
+	lookupColOrds := make([]uint32, len(jr.lookupCols))
+	for i := range lookupColOrds {
+		colID := jr.fetchSpec.KeyAndSuffixColumns[i].ColumnID
+		found := false
+		for fetchedOrd := range jr.fetchSpec.FetchedColumns {
+			if jr.fetchSpec.FetchedColumns[fetchedOrd].ColumnID == colID {
+				lookupColOrds[i] = uint32(fetchedOrd)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return
+		}
+	}
+	fullSpan, _, err := spanGen.spanBuilder.SpanFromEncDatums(nil /* values */)
+	if err != nil {
+		return
+	}
+	jr.adaptive.rowThreshold = rowThreshold
+	jr.adaptive.lookupRowCount = spec.AdaptiveLookupRowCount
+	jr.adaptive.spanGen = spanGen
+	jr.adaptive.lookupColOrds = lookupColOrds
+	jr.adaptive.lookedUpTypes = lookedUpTypes
+	jr.adaptive.fullSpan = fullSpan
+}
+
+// maybeSwitchAdaptiveJoin accounts for a new batch of input rows read by an
+// adaptive lookup join, and switches the join to a hash join if the number of
+// input rows read so far exceeds the threshold and is large enough relative to
+// the estimated size of the lookup index that a full scan of it is cheaper
+// than the lookups. It returns whether the batch should be joined using the
+// hash table.
+func (jr *joinReader) maybeSwitchAdaptiveJoin(numInputRows int) bool {
+	if jr.adaptive.rowThreshold == 0 {
+		return false
+	}
+	if !jr.adaptive.switched {
+		jr.adaptive.inputRowsRead += int64(numInputRows)
+		if jr.adaptive.inputRowsRead > jr.adaptive.rowThreshold &&
+			uint64(jr.adaptive.inputRowsRead)*adaptiveJoinScanRowsPerLookup >= jr.adaptive.lookupRowCount {
+			log.VEventf(jr.Ctx(), 1, "switching to hash join after reading %d input rows",
+				jr.adaptive.inputRowsRead)
+			jr.adaptive.switched = true
+		}
+	}
+	return jr.adaptive.switched
+}
+
+// buildAdaptiveHashTable scans the whole lookup index once and adds the
+// scanned rows to the hash table of an adaptive lookup join, keyed by their
+// lookup columns.
+func (jr *joinReader) buildAdaptiveHashTable() error {
+	ctx := jr.Ctx()
+	log.VEventf(ctx, 1, "building hash table from a full scan of the lookup index")
+	// The join reader strategy of an adaptive lookup join doesn't use the
+	// monitors of the disk-backed row container, so they are free to use.
+	mn := mon.MakeName("joinreader-hash")
+	jr.limitedMemMonitor = execinfra.NewLimitedMonitor(ctx, jr.MemMonitor, jr.FlowCtx, mn.Limited())
+	jr.limitedMemMonitor.RelinquishAllOnReleaseBytes()
+	jr.unlimitedMemMonitor = execinfra.NewMonitor(ctx, jr.FlowCtx.Mon, mn.Unlimited())
+	jr.diskMonitor = execinfra.NewMonitor(ctx, jr.FlowCtx.DiskMonitor, mn.Disk())
+	jr.adaptive.hashTable = rowcontainer.NewHashDiskBackedRowContainer(
+		jr.FlowCtx.EvalCtx,
+		jr.limitedMemMonitor,
+		jr.unlimitedMemMonitor,
+		jr.diskMonitor,
+		jr.FlowCtx.Cfg.TempStorage,
+	)
+	// Rows with NULL lookup columns never match any input row.
+	if err := jr.adaptive.hashTable.Init(
+		ctx,
+		false, /* shouldMark */
+		jr.adaptive.lookedUpTypes,
+		jr.adaptive.lookupColOrds,
+		false, /* encodeNull */
+	); err != nil {
+		return err
+	}
+	if err := jr.fetcher.StartScan(
+		ctx, roachpb.Spans{jr.adaptive.fullSpan}, nil /* spanIDs */, jr.getBatchBytesLimit(), rowinfra.NoRowLimit,
+	); err != nil {
+		return err
+	}
+	for {
+		row, _, err := jr.fetcher.NextRow(ctx)
+		if err != nil {
+			return err
+		}
+		if row == nil {
+			return nil
+		}
+		jr.rowsRead++
+		if err := jr.adaptive.hashTable.AddRow(ctx, row); err != nil {
+			return err
+		}
+	}
+}
+
+// probeAdaptiveHashTable returns the next row of the hash table of an adaptive
+// lookup join that matches the current batch of input rows, along with the ID
+// of the span that the matching input rows generated. It returns a nil row
+// once all spans of the batch have been probed.
+//
+// The returned row is only valid until the next call.
+func (jr *joinReader) probeAdaptiveHashTable() (rowenc.EncDatumRow, int, error) {
+	ctx := jr.Ctx()
+	a := &jr.adaptive
+	for {
+		if a.probeIter != nil && a.probeSpanID > 0 {
+			if a.advanceProbeIter {
+				a.probeIter.Next()
+				a.advanceProbeIter = false
+			}
+			if ok, err := a.probeIter.Valid(); err != nil {
+				return nil, 0, err
+			} else if ok {
+				row, err := a.probeIter.EncRow()
+				if err != nil {
+					return nil, 0, err
+				}
+				a.advanceProbeIter = true
+				return row, a.probeSpanID - 1, nil
+			}
+		}
+		inputRowIndices := a.spanGen.spanIDToInputRowIndices
+		if a.probeSpanID >= len(inputRowIndices) {
+			return nil, 0, nil
+		}
+		// Probe the hash table with the lookup values of any input row that
+		// generated the span.
+		inputRow := jr.scratchInputRows[inputRowIndices[a.probeSpanID][0]]
+		a.probeSpanID++
+		if a.probeIter == nil {
+			inputTypes := jr.input.OutputTypes()
+			probeEqColTypes := make([]*types.T, len(jr.lookupCols))
+			for i, col := range jr.lookupCols {
+				probeEqColTypes[i] = inputTypes[col]
+			}
+			var err error
+			a.probeIter, err = a.hashTable.NewBucketIterator(ctx, inputRow, jr.lookupCols, probeEqColTypes)
+			if err != nil {
+				return nil, 0, err
+			}
+		} else if err := a.probeIter.Reset(ctx, inputRow); err != nil {
+			return nil, 0, err
+		}
+		a.probeIter.Rewind()
+		a.advanceProbeIter = false
+	}
+}
+
 // SetBatchSizeBytes sets the desired batch size. It should only be used in tests.
 func (jr *joinReader) SetBatchSizeBytes(batchSize int64) {
 	jr.batchSizeBytes = batchSize
@@ -886,9 +1124,10 @@ func (jr *joinReader) getBatchBytesLimit() rowinfra.BytesLimit {
 		// BatchRequests.
 		return rowinfra.NoBytesLimit
 	}
-	if jr.parallelize {
+	if jr.parallelize && !jr.adaptive.switched {
 		// We deem it safe to not use the TargetBytes limit in order to get the
-		// DistSender-level parallelism.
+		// DistSender-level parallelism. This doesn't apply to the full scan of
+		// an adaptive lookup join.
 		return rowinfra.NoBytesLimit
 	}
 	if testingLimit := jr.FlowCtx.Cfg.TestingKnobs.JoinReaderBatchBytesLimit; testingLimit != 0 {
@@ -1045,6 +1284,7 @@ func (jr *joinReader) readInput() (
 		return jrStateUnknown, nil, jr.DrainHelper()
 	}
 	jr.curBatchInputRowCount = int64(len(jr.scratchInputRows))
+	fullScan := jr.maybeSwitchAdaptiveJoin(len(jr.scratchInputRows))
 	jr.resetScratchWhenReadingInput = true
 	jr.curBatchSizeBytes = 0
 	jr.curBatchRowsRead = 0
@@ -1059,6 +1299,19 @@ func (jr *joinReader) readInput() (
 		jr.MoveToDraining(noHomeRegionError)
 		return jrStateUnknown, nil, jr.DrainHelper()
 	}
+	if fullScan {
+		// Instead of looking up the spans, probe the hash table with them in
+		// fetchLookupRow. The hash table is built from a single full scan of
+		// the index by the first batch after the switch.
+		if jr.adaptive.hashTable == nil {
+			if err := jr.buildAdaptiveHashTable(); err != nil {
+				jr.MoveToDraining(err)
+				return jrStateUnknown, nil, jr.DrainHelper()
+			}
+		}
+		jr.adaptive.probeSpanID = 0
+		return jrFetchingLookupRows, outRow, nil
+	}
 
 	// Sort the spans by key order, except for a special case: an index-join with
 	// maintainOrdering. That case can be executed efficiently if we don't sort:
@@ -1146,7 +1399,14 @@ var noHomeRegionError = pgerror.Newf(pgcode.QueryHasNoHomeRegion,
 func (jr *joinReader) fetchLookupRow() (joinReaderState, *execinfrapb.ProducerMetadata) {
 	for {
 		// Fetch the next row and tell the strategy to process it.
-		lookedUpRow, spanID, err := jr.fetcher.NextRow(jr.Ctx())
+		var lookedUpRow rowenc.EncDatumRow
+		var spanID int
+		var err error
+		if jr.adaptive.switched {
+			lookedUpRow, spanID, err = jr.probeAdaptiveHashTable()
+		} else {
+			lookedUpRow, spanID, err = jr.fetcher.NextRow(jr.Ctx())
+		}
 		if err != nil {
 			jr.MoveToDraining(scrub.UnwrapScrubError(err))
 			return jrStateUnknown, jr.DrainHelper()
@@ -1155,7 +1415,9 @@ func (jr *joinReader) fetchLookupRow() (joinReaderState, *execinfrapb.ProducerMe
 			// Done with this input batch.
 			break
 		}
-		jr.rowsRead++
+		if !jr.adaptive.switched {
+			jr.rowsRead++
+		}
 		jr.curBatchRowsRead++
 
 		if nextState, err := jr.strategy.processLookedUpRow(jr.Ctx(), lookedUpRow, spanID); err != nil {
@@ -1280,6 +1542,12 @@ func (jr *joinReader) close() {
 			}
 		}
 		jr.strategy.close(jr.Ctx())
+		if jr.adaptive.probeIter != nil {
+			jr.adaptive.probeIter.Close()
+		}
+		if jr.adaptive.hashTable != nil {
+			jr.adaptive.hashTable.Close(jr.Ctx())
+		}
 		jr.memAcc.Close(jr.Ctx())
 		if jr.limitedMemMonitor != nil {
 			jr.limitedMemMonitor.Stop(jr.Ctx())
@@ -1322,6 +1590,9 @@ func (jr *joinReader) execStatsForTrace() *execinfrapb.ComponentStats {
 			UsedStreamer:        jr.usesStreamer,
 		},
 		Output: jr.OutputHelper.Stats(),
+		Join: execinfrapb.JoinStats{
+			SwitchedToHashJoin: jr.adaptive.switched,
+		},
 	}
 	// Note that there is no need to include the maximum bytes of
 	// jr.limitedMemMonitor because it is a child of jr.MemMonitor.
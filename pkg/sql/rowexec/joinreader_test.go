@@ -1327,6 +1327,148 @@ CREATE TABLE test.t (a INT, s STRING, INDEX (a, s))`); err != nil {
 	require.True(t, jr.(*joinReader).Spilled())
 }
 
+// TestJoinReaderAdaptive verifies that a lookup join that switches to a hash
+// join over a full scan of the lookup index produces the same results as the
+// lookup join that doesn't switch, and that it scans the index only once. It
+// also verifies that the join doesn't switch if the lookup index is estimated
+// to be too large relative to the input.
+func TestJoinReaderAdaptive(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	ctx := context.Background()
+
+	srv, sqlDB, kvDB := serverutils.StartServer(t, base.TestServerArgs{})
+	defer srv.Stopper().Stop(ctx)
+	s := srv.ApplicationLayer()
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE test;
+CREATE TABLE test.t (a INT PRIMARY KEY, b INT);
+INSERT INTO test.t SELECT i, i * 10 FROM generate_series(1, 100) AS g(i)`); err != nil {
+		t.Fatal(err)
+	}
+	td := desctestutils.TestingGetPublicTableDescriptor(kvDB, s.Codec(), "test", "t")
+
+	st := s.ClusterSettings()
+	tempEngine, _, err := storage.NewTempEngine(ctx, base.DefaultTestTempStorageConfig(st), nil /* statsCollector */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tempEngine.Close()
+
+	evalCtx := eval.MakeTestingEvalContextWithCodec(s.Codec(), st)
+	defer evalCtx.Stop(ctx)
+	diskMonitor := execinfra.NewTestDiskMonitor(ctx, st)
+	defer diskMonitor.Stop(ctx)
+	flowCtx := execinfra.FlowCtx{
+		EvalCtx: &evalCtx,
+		Mon:     evalCtx.TestingMon,
+		Cfg: &execinfra.ServerConfig{
+			Settings:    st,
+			TempStorage: tempEngine,
+		},
+		Txn:         kv.NewTxn(ctx, s.DB(), srv.NodeID()),
+		DiskMonitor: diskMonitor,
+	}
+
+	var fetchSpec fetchpb.IndexFetchSpec
+	if err := rowenc.InitIndexFetchSpec(
+		&fetchSpec, s.Codec(), td, td.GetPrimaryIndex(), []descpb.ColumnID{1, 2},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	// The input contains duplicates, a NULL, and values without a match.
+	var inputRows rowenc.EncDatumRows
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5, 200, 8, 9, 7, 9, 300} {
+		inputRows = append(inputRows, rowenc.EncDatumRow{rowenc.DatumToEncDatumUnsafe(types.Int, tree.NewDInt(tree.DInt(v)))})
+	}
+	inputRows = append(inputRows, rowenc.EncDatumRow{rowenc.DatumToEncDatumUnsafe(types.Int, tree.DNull)})
+
+	outputTypes := []*types.T{types.Int, types.Int, types.Int}
+	for _, joinType := range []descpb.JoinType{
+		descpb.InnerJoin, descpb.LeftOuterJoin, descpb.LeftSemiJoin, descpb.LeftAntiJoin,
+	} {
+		t.Run(joinType.String(), func(t *testing.T) {
+			post := &execinfrapb.PostProcessSpec{Projection: true, OutputColumns: []uint32{0, 1, 2}}
+			typs := outputTypes
+			if !joinType.ShouldIncludeRightColsInOutput() {
+				post.OutputColumns = []uint32{0}
+				typs = types.OneIntCol
+			}
+			run := func(
+				rowThreshold int64, lookupRowCount uint64,
+			) (rows []string, switched bool, rowsRead int64) {
+				adaptiveJoinRowThreshold.Override(ctx, &st.SV, rowThreshold)
+				out := &distsqlutils.RowBuffer{}
+				jr, err := newJoinReader(
+					ctx,
+					&flowCtx,
+					0, /* processorID */
+					&execinfrapb.JoinReaderSpec{
+						FetchSpec:              fetchSpec,
+						LookupColumns:          []uint32{0},
+						Type:                   joinType,
+						AdaptiveLookupRowCount: lookupRowCount,
+					},
+					distsqlutils.NewRowBuffer(types.OneIntCol, inputRows, distsqlutils.RowBufferArgs{}),
+					post,
+					lookupJoinReaderType,
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+				// Look up one input row at a time until the join switches.
+				jr.(*joinReader).SetBatchSizeBytes(1)
+				jr.Run(ctx, out)
+				for {
+					row, meta := out.Next()
+					if meta != nil && meta.Metrics == nil {
+						t.Fatalf("unexpected metadata %+v", meta)
+					}
+					if row == nil && meta == nil {
+						break
+					}
+					if row != nil {
+						rows = append(rows, row.String(typs))
+					}
+				}
+				sort.Strings(rows)
+				return rows, jr.(*joinReader).adaptive.switched, jr.(*joinReader).rowsRead
+			}
+
+			// With an estimate of 10 rows in the lookup index, the size of the
+			// index doesn't prevent the switch after the first input row.
+			const smallLookupRowCount = 10
+			expected, switched, _ := run(0 /* rowThreshold */, smallLookupRowCount)
+			require.False(t, switched)
+			require.NotEmpty(t, expected)
+			for _, rowThreshold := range []int64{1, 5, int64(len(inputRows) - 1)} {
+				actual, switched, rowsRead := run(rowThreshold, smallLookupRowCount)
+				require.True(t, switched)
+				require.Equal(t, expected, actual)
+				// Each input row read before the switch looks up at most one
+				// row, and the remaining input rows are joined with a single
+				// scan of the 100 rows of the index, even though every one of
+				// them is in its own batch.
+				require.GreaterOrEqual(t, rowsRead, int64(100))
+				require.LessOrEqual(t, rowsRead, 100+rowThreshold)
+			}
+			actual, switched, _ := run(int64(len(inputRows)), smallLookupRowCount)
+			require.False(t, switched)
+			require.Equal(t, expected, actual)
+			// The join doesn't switch if the lookup index is too large relative
+			// to the input, or if its size is unknown.
+			for _, lookupRowCount := range []uint64{1_000_000, 0} {
+				actual, switched, _ = run(1 /* rowThreshold */, lookupRowCount)
+				require.False(t, switched)
+				require.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
 // TestJoinReaderDrain tests various scenarios in which a joinReader's consumer
 // is closed.
 func TestJoinReaderDrain(t *testing.T) {
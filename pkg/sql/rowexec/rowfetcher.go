@@ -37,6 +37,9 @@ type rowFetcher interface {
 	) error
 
 	NextRow(ctx context.Context) (_ rowenc.EncDatumRow, spanID int, _ error)
+	// RowKey returns the key of the row last returned by NextRow, excluding
+	// the column family suffix.
+	RowKey() roachpb.Key
 	NextRowInto(
 		ctx context.Context, destination rowenc.EncDatumRow, colIdxMap catalog.TableColMap,
 	) (ok bool, err error)
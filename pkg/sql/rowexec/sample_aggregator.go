@@ -148,6 +148,11 @@ func newSampleAggregator(
 		if spec.Sketches[i].GenerateHistogram {
 			sampleCols.Add(int(spec.Sketches[i].Columns[0]))
 		}
+		if spec.Sketches[i].GenerateDependencies {
+			for _, c := range spec.Sketches[i].Columns {
+				sampleCols.Add(int(c))
+			}
+		}
 	}
 
 	s.sr.Init(
@@ -524,6 +529,19 @@ func (s *sampleAggregator) writeResults(ctx context.Context) error {
 				histogram = &h
 			}
 
+			var dependencyDegrees []float64
+			if si.spec.GenerateDependencies && len(si.spec.Columns) > 1 && len(s.sr.Get()) != 0 {
+				colIdxs := make([]int, len(si.spec.Columns))
+				for i, c := range si.spec.Columns {
+					colIdxs[i] = int(c)
+				}
+				var err error
+				dependencyDegrees, err = stats.DependencyDegrees(ctx, s.FlowCtx.EvalCtx, s.sr.Get(), colIdxs)
+				if err != nil {
+					return err
+				}
+			}
+
 			columnIDs := make([]descpb.ColumnID, len(si.spec.Columns))
 			for i, c := range si.spec.Columns {
 				columnIDs[i] = s.sampledCols[c]
@@ -557,6 +575,7 @@ func (s *sampleAggregator) writeResults(ctx context.Context) error {
 				histogram,
 				si.spec.PartialPredicate,
 				si.spec.FullStatisticID,
+				dependencyDegrees,
 			); err != nil {
 				return err
 			}
@@ -44,6 +44,9 @@ type tableReader struct {
 
 	ignoreMisplannedRanges bool
 
+	// sampler is set if the rows are sampled (see TableReaderSpec.SampleRows).
+	sampler *execinfrapb.RowSampler
+
 	// fetcher wraps a row.Fetcher, allowing the tableReader to add a stat
 	// collection layer.
 	fetcher rowFetcher
@@ -97,6 +100,10 @@ func newTableReader(
 	tr.parallelize = spec.Parallelize
 	tr.maxTimestampAge = time.Duration(spec.MaxTimestampAgeNanos)
 	tr.stageID = stageID
+	if spec.SampleRows {
+		sampler := spec.MakeRowSampler()
+		tr.sampler = &sampler
+	}
 
 	// Make sure the key column types are hydrated. The fetched column types
 	// will be hydrated in ProcessorBase.Init below.
@@ -283,6 +290,9 @@ func (tr *tableReader) Next() (rowenc.EncDatumRow, *execinfrapb.ProducerMetadata
 		// case can avoid tracking of the stall time which gives a noticeable
 		// performance hit.
 		tr.rowsRead++
+		if tr.sampler != nil && !tr.sampler.SampleRow(tr.fetcher.RowKey()) {
+			continue
+		}
 		if outRow := tr.ProcessRowHelper(row); outRow != nil {
 			return outRow, nil
 		}
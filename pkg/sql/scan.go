@@ -77,6 +77,11 @@ type scanNode struct {
 	// order for this optimization to work, the DistSQL planner must create a
 	// local plan.
 	localityOptimized bool
+	// sampleMethod, sampleProbability and sampleSeed describe the TABLESAMPLE
+	// clause of the scan, if any. See exec.ScanParams.
+	sampleMethod      tree.TableSampleMethod
+	sampleProbability float64
+	sampleSeed        int64
 }
 
 // fetchPlanningInfo contains information common to operators that fetch rows
@@ -649,6 +649,11 @@ func (sc *SchemaChanger) maybeMakeAddTablePublic(
 			return nil
 		}
 		mut.State = descpb.DescriptorState_PUBLIC
+		// The data of a materialized view was backfilled as of the creation time
+		// of the view, which is where its first incremental refresh starts from.
+		if mut.MaterializedView() && !mut.IsRefreshViewRequired() {
+			mut.RefreshedAsOf = mut.GetCreateAsOfTime()
+		}
 		return txn.Descriptors().WriteDesc(ctx, true /* kvTrace */, mut, txn.KV())
 	})
 }
@@ -1124,6 +1124,9 @@ func (b *builderState) ResolveUserDefinedTypeType(
 	case descpb.TypeDescriptor_COMPOSITE:
 		b.ensureDescriptor(typ.GetID())
 		b.mustOwn(typ.GetID())
+	case descpb.TypeDescriptor_DOMAIN:
+		// Domains are only supported by the legacy schema changer.
+		panic(scerrors.NotImplementedErrorf(nil /* n */, redact.Sprintf("domain %q", typ.GetName())))
 	case descpb.TypeDescriptor_TABLE_IMPLICIT_RECORD_TYPE:
 		// Implicit record types are not directly modifiable.
 		panic(pgerror.Newf(pgcode.DependentObjectsStillExist,
@@ -1180,6 +1183,10 @@ func (b *builderState) resolveRelation(
 		if t.IsTemporary() {
 			panic(scerrors.NotImplementedErrorf(nil /* n */, "dropping a temporary table"))
 		}
+		if t.IsForeignTable() && !p.InDropContext {
+			panic(pgerror.Newf(pgcode.WrongObjectType,
+				"%s is a foreign table and cannot be modified", tree.ErrNameString(rel.GetName())))
+		}
 	} else if typ, isType := rel.(catalog.TypeDescriptor); isType {
 		if typ.GetKind() == descpb.TypeDescriptor_ALIAS && typ.GetID() == descpb.InvalidID {
 			// This case handles the types in types.PublicSchemaAliases -- BOX2D,
@@ -1498,6 +1505,9 @@ func (b *builderState) ResolveRoutine(
 			),
 		)
 	}
+	if ol.Class == tree.AggregateClass {
+		panic(scerrors.NotImplementedErrorf(routineObj, "user-defined aggregates are not supported"))
+	}
 
 	fnID := funcdesc.UserDefinedFunctionOIDToID(ol.Oid)
 	if p.RequireOwnership {
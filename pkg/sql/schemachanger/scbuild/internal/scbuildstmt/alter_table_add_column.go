@@ -67,6 +67,9 @@ func alterTableAddColumn(
 		}
 		panic(sqlerrors.NewColumnAlreadyExistsInRelationError(string(d.Name), tn.Object()))
 	}
+	if !columnAlreadyExists {
+		panicIfTableHasInheritingTables(b, "ADD COLUMN", tbl.TableID)
+	}
 	var colSerialDefaultExpression *scpb.Expression
 	if d.IsSerial || d.GeneratedIdentity.IsGeneratedAsIdentity {
 		d, colSerialDefaultExpression = alterTableAddColumnSerialOrGeneratedIdentity(b, d, tn)
@@ -535,7 +538,13 @@ func addColumn(b BuildCtx, spec addColumnSpec, n tree.NodeFormatter) (backing *s
 		}
 
 		inflatedChain := getInflatedPrimaryIndexChain(b, spec.tbl.TableID)
-		if spec.def == nil && spec.colType.ComputeExpr == nil && spec.compute == nil && spec.transientCompute == nil {
+		// Columns of a domain type with constraints or a default value are
+		// backfilled, since the constraints are checked and the default is used
+		// by the backfill.
+		domain := spec.colType.Type.TypeMeta.DomainData
+		domainNeedsBackfill := domain.HasConstraints() || (domain != nil && domain.DefaultExpr != nil)
+		if spec.def == nil && spec.colType.ComputeExpr == nil && spec.compute == nil && spec.transientCompute == nil &&
+			!domainNeedsBackfill {
 			// Optimization opportunity: if we were to add a new column without default
 			// value nor computed expression, then we can just add the column to existing
 			// non-nil primary indexes without actually backfilling any data. This is
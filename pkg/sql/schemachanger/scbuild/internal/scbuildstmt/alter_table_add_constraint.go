@@ -24,6 +24,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/volatility"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
@@ -39,6 +40,18 @@ func alterTableAddConstraint(
 	stmt tree.Statement,
 	t *tree.AlterTableAddConstraint,
 ) {
+	// Deferrable constraints are not yet represented in the declarative schema
+	// changer elements; let the legacy schema changer add them.
+	switch d := t.ConstraintDef.(type) {
+	case *tree.UniqueConstraintTableDef:
+		if d.Deferrable.IsDeferrable() {
+			panic(scerrors.NotImplementedErrorf(t, "DEFERRABLE unique constraint"))
+		}
+	case *tree.ForeignKeyConstraintTableDef:
+		if d.Deferrable.IsDeferrable() {
+			panic(scerrors.NotImplementedErrorf(t, "DEFERRABLE foreign key constraint"))
+		}
+	}
 	switch d := t.ConstraintDef.(type) {
 	case *tree.UniqueConstraintTableDef:
 		if d.PrimaryKey {
@@ -114,6 +127,7 @@ func alterTableAddCheck(
 	} else if skip {
 		return
 	}
+	panicIfTableHasInheritingTables(b, "ADD CHECK constraint", tbl.TableID)
 
 	// 2. CheckDeepCopy whether this check constraint is syntactically valid.
 	// See the comments of DequalifyAndValidateExprImpl for criteria.
@@ -460,8 +474,9 @@ func alterTableAddUniqueWithoutIndex(
 ) {
 	d := t.ConstraintDef.(*tree.UniqueConstraintTableDef)
 
-	// 1. A bunch of checks.
-	if !b.SessionData().EnableUniqueWithoutIndexConstraints {
+	// 1. A bunch of checks. Exclusion constraints are always created without an
+	// index, so they are not gated by the session setting.
+	if !b.SessionData().EnableUniqueWithoutIndexConstraints && !d.IsExclusion() {
 		panic(pgerror.New(pgcode.FeatureNotSupported,
 			"unique constraints without an index are not yet supported",
 		))
@@ -485,18 +500,35 @@ func alterTableAddUniqueWithoutIndex(
 	}
 
 	// 2. Check that columns that we want to have uniqueness should have no duplicate.
+	kind := "unique"
+	if d.IsExclusion() {
+		kind = "exclusion"
+	}
 	var colSet catalog.TableColSet
 	var colIDs []catid.ColumnID
 	var colNames []string
+	var colTypes []*types.T
 	for _, col := range d.Columns {
+		if d.IsExclusion() && col.Expr != nil {
+			panic(unimplemented.NewWithIssueDetail(46657, "exclude using expression",
+				"expressions are not supported in exclusion constraints"))
+		}
 		colID := getColumnIDFromColumnName(b, tbl.TableID, col.Column, true /*required*/)
 		if colSet.Contains(colID) {
 			panic(pgerror.Newf(pgcode.DuplicateColumn,
-				"column %q appears twice in unique constraint", col.Column))
+				"column %q appears twice in %s constraint", col.Column, kind))
 		}
 		colSet.Add(colID)
 		colIDs = append(colIDs, colID)
 		colNames = append(colNames, string(col.Column))
+		colTypes = append(colTypes, mustRetrieveColumnTypeElem(b, tbl.TableID, colID).Type)
+	}
+	var exclusionOps []string
+	if d.IsExclusion() {
+		var err error
+		if exclusionOps, err = schemaexpr.ValidateExclusionOperators(colTypes, d.ExcludeOperators); err != nil {
+			panic(err)
+		}
 	}
 
 	// 3. If a name is provided, check that this name is not used; Otherwise, generate
@@ -507,8 +539,12 @@ func alterTableAddUniqueWithoutIndex(
 		return
 	}
 	if d.Name == "" {
+		defaultName := fmt.Sprintf("unique_%s", strings.Join(colNames, "_"))
+		if d.IsExclusion() {
+			defaultName = fmt.Sprintf("%s_%s_excl", tn.Object(), strings.Join(colNames, "_"))
+		}
 		d.Name = tree.Name(tabledesc.GenerateUniqueName(
-			fmt.Sprintf("unique_%s", strings.Join(colNames, "_")),
+			defaultName,
 			func(name string) bool {
 				return constraintNameInUse(b, tbl.TableID, name)
 			},
@@ -544,6 +580,10 @@ func alterTableAddUniqueWithoutIndex(
 			ConstraintID:         constraintID,
 			ColumnIDs:            colIDs,
 			IndexIDForValidation: getIndexIDForValidationForConstraint(b, tbl.TableID),
+			ExclusionOperators:   exclusionOps,
+		}
+		if d.IsExclusion() {
+			uwi.ExclusionIndexMethod = string(d.ExcludeUsing)
 		}
 		if d.Predicate != nil {
 			uwi.Predicate = b.WrapExpression(tbl.TableID, d.Predicate)
@@ -552,9 +592,13 @@ func alterTableAddUniqueWithoutIndex(
 		b.LogEventForExistingTarget(uwi)
 	} else {
 		uwi := &scpb.UniqueWithoutIndexConstraintUnvalidated{
-			TableID:      tbl.TableID,
-			ConstraintID: constraintID,
-			ColumnIDs:    colIDs,
+			TableID:            tbl.TableID,
+			ConstraintID:       constraintID,
+			ColumnIDs:          colIDs,
+			ExclusionOperators: exclusionOps,
+		}
+		if d.IsExclusion() {
+			uwi.ExclusionIndexMethod = string(d.ExcludeUsing)
 		}
 		if d.Predicate != nil {
 			uwi.Predicate = b.WrapExpression(tbl.TableID, d.Predicate)
@@ -567,6 +611,22 @@ func alterTableAddUniqueWithoutIndex(
 		ConstraintID: constraintID,
 		Name:         string(d.Name),
 	})
+
+	// 6. Back an exclusion constraint with an inverted index, so that it can be
+	// checked with an inverted join instead of a scan of the entire table.
+	if d.IsExclusion() {
+		if ords, ok := schemaexpr.ExclusionIndexColumns(colTypes, d.ExcludeOperators); ok {
+			columns := make(tree.IndexElemList, len(ords))
+			for i, ord := range ords {
+				columns[i] = tree.IndexElem{Column: d.Columns[ord].Column}
+			}
+			CreateIndex(b, &tree.CreateIndex{
+				Table:   *tn,
+				Type:    idxtype.INVERTED,
+				Columns: columns,
+			})
+		}
+	}
 }
 
 // getFullyResolvedColNames returns fully resolved column names for `colNames`.
@@ -600,7 +660,8 @@ func areColsUniqueInTable(b BuildCtx, tableID catid.DescID, columnIDs []catid.Co
 		case *scpb.SecondaryIndex:
 			ret = isIndexUniqueAndCanServeFK(b, &te.Index, columnIDs)
 		case *scpb.UniqueWithoutIndexConstraint:
-			if te.Predicate == nil && descpb.ColumnIDs(te.ColumnIDs).PermutationOf(columnIDs) {
+			if te.Predicate == nil && len(te.ExclusionOperators) == 0 &&
+				descpb.ColumnIDs(te.ColumnIDs).PermutationOf(columnIDs) {
 				ret = true
 			}
 		}
@@ -42,6 +42,8 @@ func alterTableAlterColumnType(
 	colID := getColumnIDFromColumnName(b, tbl.TableID, t.Column, true /* required */)
 	col := mustRetrieveColumnElem(b, tbl.TableID, colID)
 	panicIfSystemColumn(col, t.Column)
+	panicIfTableHasInheritingTables(b, "ALTER COLUMN TYPE", tbl.TableID)
+	panicIfColumnIsInherited(b, "alter", tbl.TableID, t.Column)
 
 	// Setup for the new type ahead of any checking. As we need its resolved type
 	// for the checks.
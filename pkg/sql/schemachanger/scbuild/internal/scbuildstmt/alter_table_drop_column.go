@@ -41,6 +41,7 @@ func alterTableDropColumn(
 		return
 	}
 	checkColumnNotInaccessible(col, n)
+	panicIfColumnIsInherited(b, "drop", tbl.TableID, n.Column)
 	dropColumn(b, tn, tbl, stmt, n, col, elts, n.DropBehavior)
 	b.LogEventForExistingTarget(col)
 }
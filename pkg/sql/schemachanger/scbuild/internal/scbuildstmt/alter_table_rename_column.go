@@ -29,6 +29,8 @@ func alterTableRenameColumn(
 		panic(scerrors.NotImplementedError(n))
 	}
 	alterColumnPreChecks(b, tn, tbl, t.Column)
+	panicIfTableHasInheritingTables(b, "RENAME COLUMN", tbl.TableID)
+	panicIfColumnIsInherited(b, "rename", tbl.TableID, t.Column)
 
 	// 1. Resolve the column by current name.
 	eltsFromColName := b.ResolveColumn(tbl.TableID, t.Column, ResolveParams{
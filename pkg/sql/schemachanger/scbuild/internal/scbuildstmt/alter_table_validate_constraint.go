@@ -128,10 +128,12 @@ func validateConstraint(b BuildCtx, tableID catid.DescID, spec validateConstrain
 	if spec.uwiNotValidElem != nil {
 		b.Drop(spec.uwiNotValidElem)
 		b.Add(&scpb.UniqueWithoutIndexConstraint{
-			TableID:      tableID,
-			ConstraintID: nextConstraintID,
-			ColumnIDs:    spec.uwiNotValidElem.ColumnIDs,
-			Predicate:    spec.uwiNotValidElem.Predicate,
+			TableID:              tableID,
+			ConstraintID:         nextConstraintID,
+			ColumnIDs:            spec.uwiNotValidElem.ColumnIDs,
+			Predicate:            spec.uwiNotValidElem.Predicate,
+			ExclusionOperators:   spec.uwiNotValidElem.ExclusionOperators,
+			ExclusionIndexMethod: spec.uwiNotValidElem.ExclusionIndexMethod,
 		})
 	}
 	if spec.fkNotValidElem != nil {
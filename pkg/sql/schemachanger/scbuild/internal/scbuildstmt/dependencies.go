@@ -382,8 +382,9 @@ type ResolveParams struct {
 	// and not just tables, sequences, and views.
 	ResolveTypes bool
 
-	// InDropContext, if set, indicates that overload resolution is being
-	// performed in the DROP routine context.
+	// InDropContext, if set, indicates that the descriptor is being resolved
+	// by a DROP statement. This affects overload resolution of routines and
+	// allows resolving foreign tables, which cannot be modified otherwise.
 	InDropContext bool
 }
 
@@ -21,6 +21,9 @@ func DropFunction(b BuildCtx, n *tree.DropRoutine) {
 		// TODO(chengxiong): remove this when we allow UDF usage.
 		panic(scerrors.NotImplementedErrorf(n, "cascade dropping functions"))
 	}
+	if n.Aggregate {
+		panic(scerrors.NotImplementedErrorf(n, "dropping aggregate functions"))
+	}
 
 	routineType := tree.UDFRoutine
 	if n.Procedure {
@@ -26,6 +26,7 @@ func DropTable(b BuildCtx, n *tree.DropTable) {
 		elts := b.ResolveTable(name.ToUnresolvedObjectName(), ResolveParams{
 			IsExistenceOptional: n.IfExists,
 			RequiredPrivilege:   privilege.DROP,
+			InDropContext:       true,
 		})
 		_, _, tbl := scpb.FindTable(elts)
 		if tbl == nil {
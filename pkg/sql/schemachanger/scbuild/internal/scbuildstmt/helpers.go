@@ -241,6 +241,9 @@ func dropCascadeDescriptor(b BuildCtx, id catid.DescID) {
 			dropCascadeDescriptor(next, t.TableID)
 		case *scpb.PolicyDeps:
 			dropCascadeDescriptor(next, t.TableID)
+		case *scpb.TableInheritance:
+			// Tables inheriting from a dropped table are dropped along with it.
+			dropCascadeDescriptor(next, t.TableID)
 		case *scpb.Column, *scpb.ColumnType:
 			// These only have type references.
 			break
@@ -1272,6 +1275,42 @@ func panicIfRegionChangeUnderwayOnRBRTable(b BuildCtx, op redact.SafeString, tab
 	}
 }
 
+// panicIfTableHasInheritingTables blocks the given operation on a table that
+// other tables inherit from. Scans of the table include the rows of the
+// inheriting tables, whose columns are matched to the table's columns by name,
+// and changes to the table's columns and CHECK constraints are not propagated
+// to them.
+func panicIfTableHasInheritingTables(b BuildCtx, op redact.SafeString, tableID catid.DescID) {
+	if undroppedBackrefs(b, tableID).FilterTableInheritance().IsEmpty() {
+		return
+	}
+	panic(pgerror.Newf(pgcode.FeatureNotSupported,
+		"cannot %s on table %q because other tables inherit from it", op, simpleName(b, tableID)))
+}
+
+// panicIfColumnIsInherited blocks the given operation on a column that the
+// table inherits from one of its parent tables.
+func panicIfColumnIsInherited(
+	b BuildCtx, op redact.SafeString, tableID catid.DescID, columnName tree.Name,
+) {
+	b.QueryByID(tableID).FilterTableInheritance().ForEach(func(
+		_ scpb.Status, target scpb.TargetStatus, e *scpb.TableInheritance,
+	) {
+		if target != scpb.ToPublic {
+			return
+		}
+		if b.QueryByID(e.ParentTableID).FilterColumnName().Filter(func(
+			_ scpb.Status, target scpb.TargetStatus, cn *scpb.ColumnName,
+		) bool {
+			return target == scpb.ToPublic && cn.Name == string(columnName)
+		}).IsEmpty() {
+			return
+		}
+		panic(pgerror.Newf(pgcode.InvalidTableDefinition,
+			"cannot %s inherited column %q", op, tree.ErrString(&columnName)))
+	})
+}
+
 // haveSameIndexColsByKind returns true if two indexes have the same index
 // columns of a particular kind.
 func haveSameIndexColsByKind(
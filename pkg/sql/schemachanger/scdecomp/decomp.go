@@ -17,6 +17,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/schemaexpr"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catconstants"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/catid"
@@ -25,6 +26,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/util/iterutil"
 	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
 	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/redact"
 )
 
 type walkCtx struct {
@@ -214,6 +216,11 @@ func (w *walkCtx) walkType(typ catalog.TypeDescriptor) {
 				Name:            comp.GetElementLabel(i),
 			})
 		}
+	} else if typ.AsDomainTypeDescriptor() != nil {
+		// Domains are only supported by the legacy schema changer.
+		panic(scerrors.NotImplementedErrorf(nil, /* n */
+			redact.Sprintf("domain %q", typ.GetName()),
+		))
 	} else {
 		panic(errors.AssertionFailedf("unsupported type kind %q", typ.GetKind()))
 	}
@@ -487,6 +494,15 @@ func (w *walkCtx) walkRelation(tbl catalog.TableDescriptor) {
 			JobIDs:  tbl.TableDesc().LDRJobIDs,
 		})
 	}
+	for _, parentID := range tbl.GetInheritsFrom() {
+		w.ev(scpb.Status_PUBLIC, &scpb.TableInheritance{
+			TableID:       tbl.GetID(),
+			ParentTableID: parentID,
+		})
+	}
+	for _, childID := range tbl.GetInheritedBy() {
+		w.backRefs.Add(childID)
+	}
 }
 
 func (w *walkCtx) walkLocality(tbl catalog.TableDescriptor, l *catpb.LocalityConfig) {
@@ -781,20 +797,31 @@ func (w *walkCtx) walkUniqueWithoutIndexConstraint(
 				c.GetName(), tbl.GetName(), tbl.GetID()))
 		}
 	}
+	columnIDs := c.CollectKeyColumnIDs().Ordered()
+	desc := c.UniqueWithoutIndexDesc()
+	if c.IsExclusion() {
+		// The columns of an exclusion constraint are positionally matched with
+		// its operators, so their order must be preserved.
+		columnIDs = append([]descpb.ColumnID(nil), desc.ColumnIDs...)
+	}
 	if c.IsConstraintUnvalidated() {
 		uwi := &scpb.UniqueWithoutIndexConstraintUnvalidated{
-			TableID:      tbl.GetID(),
-			ConstraintID: c.GetConstraintID(),
-			ColumnIDs:    c.CollectKeyColumnIDs().Ordered(),
-			Predicate:    expr,
+			TableID:              tbl.GetID(),
+			ConstraintID:         c.GetConstraintID(),
+			ColumnIDs:            columnIDs,
+			Predicate:            expr,
+			ExclusionOperators:   desc.ExclusionOperators,
+			ExclusionIndexMethod: desc.ExclusionIndexMethod,
 		}
 		w.ev(scpb.Status_PUBLIC, uwi)
 	} else {
 		uwi := &scpb.UniqueWithoutIndexConstraint{
-			TableID:      tbl.GetID(),
-			ConstraintID: c.GetConstraintID(),
-			ColumnIDs:    c.CollectKeyColumnIDs().Ordered(),
-			Predicate:    expr,
+			TableID:              tbl.GetID(),
+			ConstraintID:         c.GetConstraintID(),
+			ColumnIDs:            columnIDs,
+			Predicate:            expr,
+			ExclusionOperators:   desc.ExclusionOperators,
+			ExclusionIndexMethod: desc.ExclusionIndexMethod,
 		}
 		w.ev(scpb.Status_PUBLIC, uwi)
 	}
@@ -7,6 +7,7 @@ package scmutationexec
 
 import (
 	"context"
+	"slices"
 
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
@@ -167,6 +168,39 @@ func (i *immediateVisitor) RemoveForeignKeyBackReference(
 	return nil
 }
 
+func (i *immediateVisitor) RemoveTableInheritance(
+	ctx context.Context, op scop.RemoveTableInheritance,
+) error {
+	tbl, err := i.checkOutTable(ctx, op.TableID)
+	if err != nil || tbl.Dropped() {
+		return err
+	}
+	tbl.InheritsFrom = slices.DeleteFunc(tbl.InheritsFrom, func(id descpb.ID) bool {
+		return id == op.ParentTableID
+	})
+	if len(tbl.InheritsFrom) == 0 {
+		tbl.InheritsFrom = nil
+	}
+	return nil
+}
+
+func (i *immediateVisitor) RemoveTableInheritanceBackReference(
+	ctx context.Context, op scop.RemoveTableInheritanceBackReference,
+) error {
+	parent, err := i.checkOutTable(ctx, op.ParentTableID)
+	if err != nil || parent.Dropped() {
+		// Exit early if the back-reference holder is getting dropped.
+		return err
+	}
+	parent.InheritedBy = slices.DeleteFunc(parent.InheritedBy, func(id descpb.ID) bool {
+		return id == op.TableID
+	})
+	if len(parent.InheritedBy) == 0 {
+		parent.InheritedBy = nil
+	}
+	return nil
+}
+
 func (i *immediateVisitor) UpdateTableBackReferencesInTypes(
 	ctx context.Context, op scop.UpdateTableBackReferencesInTypes,
 ) error {
@@ -809,6 +843,7 @@ func (i *immediateVisitor) SetObjectParentID(ctx context.Context, op scop.SetObj
 			ReturnType:  t.GetReturnType().Type,
 			ReturnSet:   t.GetReturnType().ReturnSet,
 			IsProcedure: t.IsProcedure(),
+			IsAggregate: t.IsAggregate(),
 		}
 		for pIdx, p := range t.Params {
 			class := funcdesc.ToTreeRoutineParamClass(p.Class)
@@ -819,6 +854,9 @@ func (i *immediateVisitor) SetObjectParentID(ctx context.Context, op scop.SetObj
 				ol.OutParamOrdinals = append(ol.OutParamOrdinals, int32(pIdx))
 				ol.OutParamTypes = append(ol.OutParamTypes, p.Type)
 			}
+			if class == tree.RoutineParamVariadic {
+				ol.IsVariadic = true
+			}
 			if p.DefaultExpr != nil {
 				ol.DefaultExprs = append(ol.DefaultExprs, *p.DefaultExpr)
 			}
@@ -446,6 +446,22 @@ type RemoveForeignKeyBackReference struct {
 	OriginConstraintID descpb.ConstraintID
 }
 
+// RemoveTableInheritance removes a parent table from the tables that a table
+// inherits from.
+type RemoveTableInheritance struct {
+	immediateMutationOp
+	TableID       descpb.ID
+	ParentTableID descpb.ID
+}
+
+// RemoveTableInheritanceBackReference removes an inheriting table from the
+// back-references of its parent table.
+type RemoveTableInheritanceBackReference struct {
+	immediateMutationOp
+	ParentTableID descpb.ID
+	TableID       descpb.ID
+}
+
 // AddUniqueWithoutIndexConstraint adds a non-existent
 // unique_without_index constraint to the table.
 type AddUniqueWithoutIndexConstraint struct {
@@ -455,6 +471,10 @@ type AddUniqueWithoutIndexConstraint struct {
 	ColumnIDs    []descpb.ColumnID
 	PartialExpr  catpb.Expression
 	Validity     descpb.ConstraintValidity
+	// ExclusionOperators and ExclusionIndexMethod are set for exclusion
+	// constraints.
+	ExclusionOperators   []string
+	ExclusionIndexMethod string
 }
 
 // MakeValidatedUniqueWithoutIndexConstraintPublic moves a new, validated unique_without_index
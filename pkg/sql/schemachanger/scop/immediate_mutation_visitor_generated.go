@@ -70,6 +70,8 @@ type ImmediateMutationVisitor interface {
 	MakePublicForeignKeyConstraintValidated(context.Context, MakePublicForeignKeyConstraintValidated) error
 	RemoveForeignKeyConstraint(context.Context, RemoveForeignKeyConstraint) error
 	RemoveForeignKeyBackReference(context.Context, RemoveForeignKeyBackReference) error
+	RemoveTableInheritance(context.Context, RemoveTableInheritance) error
+	RemoveTableInheritanceBackReference(context.Context, RemoveTableInheritanceBackReference) error
 	AddUniqueWithoutIndexConstraint(context.Context, AddUniqueWithoutIndexConstraint) error
 	MakeValidatedUniqueWithoutIndexConstraintPublic(context.Context, MakeValidatedUniqueWithoutIndexConstraintPublic) error
 	MakePublicUniqueWithoutIndexConstraintValidated(context.Context, MakePublicUniqueWithoutIndexConstraintValidated) error
@@ -441,6 +443,16 @@ func (op RemoveForeignKeyBackReference) Visit(ctx context.Context, v ImmediateMu
 	return v.RemoveForeignKeyBackReference(ctx, op)
 }
 
+// Visit is part of the ImmediateMutationOp interface.
+func (op RemoveTableInheritance) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.RemoveTableInheritance(ctx, op)
+}
+
+// Visit is part of the ImmediateMutationOp interface.
+func (op RemoveTableInheritanceBackReference) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
+	return v.RemoveTableInheritanceBackReference(ctx, op)
+}
+
 // Visit is part of the ImmediateMutationOp interface.
 func (op AddUniqueWithoutIndexConstraint) Visit(ctx context.Context, v ImmediateMutationVisitor) error {
 	return v.AddUniqueWithoutIndexConstraint(ctx, op)
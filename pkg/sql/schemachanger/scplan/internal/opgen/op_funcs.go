@@ -191,6 +191,18 @@ func checkIfDescriptorIsWithoutData(id descpb.ID, md *opGenContext) bool {
 	return !doesDescriptorHaveData
 }
 
+// checkIfTableHasData determines if a table has a TableData element, which is
+// not the case for tables whose rows are not stored in the cluster, like
+// foreign tables.
+func checkIfTableHasData(tableID descpb.ID, md *opGenContext) bool {
+	for _, t := range md.Targets {
+		if td, ok := t.Element().(*scpb.TableData); ok && td.TableID == tableID {
+			return true
+		}
+	}
+	return false
+}
+
 // checkIfZoneConfigHasGCDependents will determine if a table/database
 // descriptor has data dependencies it still needs to GC. This allows us to
 // determine when we need to skip certain operations like deleting a zone
@@ -41,6 +41,15 @@ func init() {
 				emit(func(this *scpb.Table, md *opGenContext) *scop.CreateGCJobForTable {
 					return nil
 				}),
+				emit(func(this *scpb.Table, md *opGenContext) *scop.DeleteDescriptor {
+					// Tables with data are deleted by the GC job of their data.
+					if checkIfTableHasData(this.TableID, md) {
+						return nil
+					}
+					return &scop.DeleteDescriptor{
+						DescriptorID: this.TableID,
+					}
+				}),
 			),
 		),
 	)
@@ -0,0 +1,45 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package opgen
+
+import (
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scop"
+	"github.com/cockroachdb/cockroach/pkg/sql/schemachanger/scpb"
+)
+
+// TableInheritance transitions between ABSENT and PUBLIC directly.
+// ABSENT --> PUBLIC: unimplemented, inheritance links are only added by the
+// legacy schema changer.
+// PUBLIC --> ABSENT: removes the link from both tables.
+func init() {
+	opRegistry.register((*scpb.TableInheritance)(nil),
+		toPublic(
+			scpb.Status_ABSENT,
+			to(scpb.Status_PUBLIC,
+				emit(func(this *scpb.TableInheritance) *scop.NotImplemented {
+					return notImplemented(this)
+				}),
+			),
+		),
+		toAbsent(
+			scpb.Status_PUBLIC,
+			to(scpb.Status_ABSENT,
+				emit(func(this *scpb.TableInheritance) *scop.RemoveTableInheritanceBackReference {
+					return &scop.RemoveTableInheritanceBackReference{
+						ParentTableID: this.ParentTableID,
+						TableID:       this.TableID,
+					}
+				}),
+				emit(func(this *scpb.TableInheritance) *scop.RemoveTableInheritance {
+					return &scop.RemoveTableInheritance{
+						TableID:       this.TableID,
+						ParentTableID: this.ParentTableID,
+					}
+				}),
+			),
+		),
+	)
+}
@@ -23,11 +23,13 @@ func init() {
 						partialExpr = this.Predicate.Expr
 					}
 					return &scop.AddUniqueWithoutIndexConstraint{
-						TableID:      this.TableID,
-						ConstraintID: this.ConstraintID,
-						ColumnIDs:    this.ColumnIDs,
-						PartialExpr:  partialExpr,
-						Validity:     descpb.ConstraintValidity_Unvalidated,
+						TableID:              this.TableID,
+						ConstraintID:         this.ConstraintID,
+						ColumnIDs:            this.ColumnIDs,
+						PartialExpr:          partialExpr,
+						Validity:             descpb.ConstraintValidity_Unvalidated,
+						ExclusionOperators:   this.ExclusionOperators,
+						ExclusionIndexMethod: this.ExclusionIndexMethod,
 					}
 				}),
 				emit(func(this *scpb.UniqueWithoutIndexConstraintUnvalidated) *scop.UpdateTableBackReferencesInTypes {
@@ -6966,6 +6966,43 @@ SELECT
 		},
 	),
 
+	"crdb_internal.assert_domain_constraint": makeBuiltin(
+		tree.FunctionProperties{
+			Category:     builtinconstants.CategorySystemInfo,
+			Undocumented: true,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "val", Typ: types.AnyElement},
+				{Name: "satisfied", Typ: types.Bool},
+				{Name: "domain_name", Typ: types.String},
+				{Name: "constraint_name", Typ: types.String},
+			},
+			ReturnType: tree.IdentityReturnType(0),
+			Fn: func(_ context.Context, _ *eval.Context, args tree.Datums) (tree.Datum, error) {
+				// A NULL result of a CHECK constraint does not violate it.
+				if args[1] != tree.DBoolFalse {
+					return args[0], nil
+				}
+				domainName := tree.MustBeDString(args[2])
+				constraintName := tree.MustBeDString(args[3])
+				if constraintName == "" {
+					return nil, pgerror.Newf(pgcode.NotNullViolation,
+						"domain %s does not allow null values", domainName)
+				}
+				return nil, pgerror.WithConstraintName(pgerror.Newf(pgcode.CheckViolation,
+					"value for domain %s violates check constraint %q", domainName, constraintName,
+				), string(constraintName))
+			},
+			Info: "This function is used internally to enforce the constraints of domains. " +
+				"It returns val if satisfied is not false, and otherwise returns an error " +
+				"for the given constraint, or for the NOT NULL constraint of the domain if " +
+				"constraint_name is empty.",
+			Volatility:        volatility.Immutable,
+			CalledOnNullInput: true,
+		},
+	),
+
 	"crdb_internal.round_decimal_values": makeBuiltin(
 		tree.FunctionProperties{
 			Category: builtinconstants.CategorySystemInfo,
@@ -9714,6 +9751,54 @@ WHERE object_id = table_descriptor_id
 			Volatility: volatility.Volatile,
 		},
 	),
+	"crdb_internal.accept_plan_baseline": makeBuiltin(
+		tree.FunctionProperties{
+			Category:         builtinconstants.CategorySystemInfo,
+			DistsqlBlocklist: true,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "hint_id", Typ: types.Int},
+			},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				hintID := int64(tree.MustBeDInt(args[0]))
+				accepted, err := evalCtx.Planner.AcceptPlanBaseline(ctx, hintID, false /* verify */)
+				if err != nil {
+					return nil, err
+				}
+				return tree.MakeDBool(tree.DBool(accepted)), nil
+			},
+			Info: "This function makes the plan baseline with the given hint ID the accepted plan" +
+				" baseline of its statement fingerprint, which the optimizer prefers while it is" +
+				" valid. It returns false if the plan reads a table or index that no longer exists." +
+				" The accepted and previously accepted plan baselines are assigned new hint IDs.",
+			Volatility: volatility.Volatile,
+		},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "hint_id", Typ: types.Int},
+				{Name: "verify", Typ: types.Bool},
+			},
+			ReturnType: tree.FixedReturnType(types.Bool),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				hintID := int64(tree.MustBeDInt(args[0]))
+				verify := bool(tree.MustBeDBool(args[1]))
+				accepted, err := evalCtx.Planner.AcceptPlanBaseline(ctx, hintID, verify)
+				if err != nil {
+					return nil, err
+				}
+				return tree.MakeDBool(tree.DBool(accepted)), nil
+			},
+			Info: "This function makes the plan baseline with the given hint ID the accepted plan" +
+				" baseline of its statement fingerprint, which the optimizer prefers while it is" +
+				" valid. It returns false if the plan reads a table or index that no longer exists" +
+				" or, if verify is true, if its estimated cost under the current statistics is greater" +
+				" than that of the currently accepted plan baseline. The accepted and previously" +
+				" accepted plan baselines are assigned new hint IDs.",
+			Volatility: volatility.Volatile,
+		},
+	),
 	"crdb_internal.clear_statement_hints_cache": makeBuiltin(
 		tree.FunctionProperties{
 			Category:         builtinconstants.CategorySystemRepair,
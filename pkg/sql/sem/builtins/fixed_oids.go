@@ -2863,6 +2863,10 @@ var builtinOidsArray = []string{
 	2908: `crdb_internal.inject_hint(statement_fingerprint: string, donor_sql: string) -> int`,
 	2909: `crdb_internal.clear_statement_hints_cache() -> void`,
 	2910: `crdb_internal.await_statement_hints_cache() -> void`,
+	2911: `pg_notify(channel: string, payload: string) -> void`,
+	2912: `crdb_internal.assert_domain_constraint(val: anyelement, satisfied: bool, domain_name: string, constraint_name: string) -> anyelement`,
+	2913: `crdb_internal.accept_plan_baseline(hint_id: int) -> bool`,
+	2914: `crdb_internal.accept_plan_baseline(hint_id: int, verify: bool) -> bool`,
 }
 
 var builtinOidsBySignature map[string]oid.Oid
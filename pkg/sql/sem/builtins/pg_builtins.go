@@ -1443,6 +1443,38 @@ FROM defaults_parsed
 		},
 	),
 
+	// See https://www.postgresql.org/docs/current/functions-info.html#FUNCTIONS-INFO-NOTIFY.
+	"pg_notify": makeBuiltin(
+		tree.FunctionProperties{DistsqlBlocklist: true},
+		tree.Overload{
+			Types: tree.ParamTypes{
+				{Name: "channel", Typ: types.String},
+				{Name: "payload", Typ: types.String},
+			},
+			ReturnType: tree.FixedReturnType(types.Void),
+			Fn: func(ctx context.Context, evalCtx *eval.Context, args tree.Datums) (tree.Datum, error) {
+				// As in Postgres, a NULL channel is rejected as an empty channel
+				// name, and a NULL payload is sent as an empty payload.
+				var channel, payload string
+				if args[0] != tree.DNull {
+					channel = string(tree.MustBeDString(args[0]))
+				}
+				if args[1] != tree.DNull {
+					payload = string(tree.MustBeDString(args[1]))
+				}
+				if err := evalCtx.Planner.SendNotification(ctx, channel, payload); err != nil {
+					return nil, err
+				}
+				return tree.DVoidDatum, nil
+			},
+			Info: "Sends a notification with the given payload to the sessions listening " +
+				"on the given channel when the current transaction commits. It is " +
+				"equivalent to NOTIFY channel, payload.",
+			Volatility:        volatility.Volatile,
+			CalledOnNullInput: true,
+		},
+	),
+
 	// pg_is_in_recovery returns true if the Postgres database is currently in
 	// recovery.  This is not applicable so this can always return false.
 	// https://www.postgresql.org/docs/current/static/functions-admin.html#FUNCTIONS-RECOVERY-INFO-TABLE
@@ -227,6 +227,19 @@ func LookupCast(src, tgt *types.T) (Cast, bool) {
 		}, true
 	}
 
+	// Domains have dynamic OIDs, so casts to and from domains are looked up
+	// using their base types. Values of a domain and of its base type can be
+	// used interchangeably, so the cast between them is implicit.
+	if src.IsDomain() || tgt.IsDomain() {
+		if src.IsDomain() {
+			src = src.DomainBaseType()
+		}
+		if tgt.IsDomain() {
+			tgt = tgt.DomainBaseType()
+		}
+		return LookupCast(src, tgt)
+	}
+
 	// Enums have dynamic OIDs, so they can't be populated in castMap. Instead,
 	// we dynamically create cast structs for valid enum casts.
 	if srcFamily == types.EnumFamily && tgtFamily == types.StringFamily {
@@ -111,6 +111,9 @@ const (
 	PreparedTransactionsTableName           SystemTableName = "prepared_transactions"
 	InspectErrorsTableName                  SystemTableName = "inspect_errors"
 	StatementHintsTableName                 SystemTableName = "statement_hints"
+	NotificationsTableName                  SystemTableName = "notifications"
+	PublicationsTableName                   SystemTableName = "publications"
+	ReplicationSlotsTableName               SystemTableName = "replication_slots"
 )
 
 // Oid for virtual database and table.
@@ -229,6 +232,7 @@ const (
 	CrdbInternalStoreLivenessSupportFrom
 	CrdbInternalStoreLivenessSupportFor
 	CrdbInternalClusterInspectErrorsViewID
+	CrdbInternalPlanBaselinesTableID
 	// CrdbInternalTestID is reserved for tests that need to inject virtual tables
 	// into crdb_internal.
 	CrdbInternalTestID
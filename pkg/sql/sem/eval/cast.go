@@ -97,6 +97,12 @@ func PerformAssignmentCast(
 func performCast(
 	ctx context.Context, evalCtx *Context, d tree.Datum, t *types.T, truncateWidth bool,
 ) (tree.Datum, error) {
+	// Values of a domain are represented as values of its base type. The
+	// constraints of the domain are checked by the optimizer, which wraps
+	// casts to domains in crdb_internal.assert_domain_constraint.
+	if t.IsDomain() {
+		t = t.DomainBaseType()
+	}
 	d, err := performCastWithoutPrecisionTruncation(ctx, evalCtx, d, t, truncateWidth)
 	if err != nil {
 		return nil, err
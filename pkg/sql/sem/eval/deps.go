@@ -429,6 +429,11 @@ type Planner interface {
 	// CLOSE statement.
 	PLpgSQLCloseCursor(cursorName tree.Name) error
 
+	// SendNotification sends a notification with the given payload on the
+	// given channel when the current transaction commits. It is used to
+	// implement the pg_notify builtin.
+	SendNotification(ctx context.Context, channel, payload string) error
+
 	// PLpgSQLFetchCursor returns the next row from the cursor with the given
 	// name, if any. It returns nil if no such row exists. Used to implement the
 	// PLpgSQL FETCH statement.
@@ -477,6 +482,12 @@ type Planner interface {
 	// the system.statement_hints table. It returns the hint ID of the newly
 	// created hint.
 	InsertStatementHint(ctx context.Context, statementFingerprint string, hint hintpb.StatementHintUnion) (int64, error)
+
+	// AcceptPlanBaseline makes the plan baseline with the given hint ID the
+	// accepted plan baseline of its statement fingerprint. It returns false if
+	// the plan is no longer valid or, if verify is true, if it is estimated to
+	// be more expensive than the currently accepted plan baseline.
+	AcceptPlanBaseline(ctx context.Context, hintID int64, verify bool) (bool, error)
 }
 
 // InternalRows is an iterator interface that's exposed by the internal
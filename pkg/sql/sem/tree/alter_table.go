@@ -78,6 +78,7 @@ func (*AlterTableSetIdentity) alterTableCmd()        {}
 func (*AlterTableIdentity) alterTableCmd()           {}
 func (*AlterTableDropIdentity) alterTableCmd()       {}
 func (*AlterTableSetRLSMode) alterTableCmd()         {}
+func (*AlterTableInherit) alterTableCmd()            {}
 
 var _ AlterTableCmd = &AlterTableAddColumn{}
 var _ AlterTableCmd = &AlterTableAddConstraint{}
@@ -103,6 +104,7 @@ var _ AlterTableCmd = &AlterTableSetIdentity{}
 var _ AlterTableCmd = &AlterTableIdentity{}
 var _ AlterTableCmd = &AlterTableDropIdentity{}
 var _ AlterTableCmd = &AlterTableSetRLSMode{}
+var _ AlterTableCmd = &AlterTableInherit{}
 
 // ColumnMutationCmd is the subset of AlterTableCmds that modify an
 // existing column.
@@ -941,6 +943,30 @@ func (node *AlterTableSetRLSMode) Format(ctx *FmtCtx) {
 	ctx.WriteString(" ROW LEVEL SECURITY")
 }
 
+// AlterTableInherit represents an INHERIT or NO INHERIT command, which adds
+// or removes the table as a child of the parent table.
+type AlterTableInherit struct {
+	Parent    *UnresolvedObjectName
+	NoInherit bool
+}
+
+// TelemetryName implements the AlterTableCmd interface.
+func (node *AlterTableInherit) TelemetryName() string {
+	if node.NoInherit {
+		return "no_inherit"
+	}
+	return "inherit"
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterTableInherit) Format(ctx *FmtCtx) {
+	if node.NoInherit {
+		ctx.WriteString(" NO")
+	}
+	ctx.WriteString(" INHERIT ")
+	ctx.FormatNode(node.Parent)
+}
+
 // GetTableType returns a string representing the type of table the command
 // is operating on.
 // It is assumed if the table is not a sequence or a view, then it is a
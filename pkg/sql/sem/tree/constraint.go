@@ -105,7 +105,7 @@ type CompositeKeyMatchMethod semenumpb.Match
 const (
 	MatchSimple CompositeKeyMatchMethod = iota
 	MatchFull
-	MatchPartial // Note: PARTIAL not actually supported at this point.
+	MatchPartial
 )
 
 // CompositeKeyMatchMethodType allows the conversion from a
@@ -138,3 +138,36 @@ func (x CompositeKeyMatchMethod) String() string {
 		return strconv.Itoa(int(x))
 	}
 }
+
+// ConstraintDeferrability describes when a constraint is checked: at the end of
+// each statement, or (if the constraint is DEFERRABLE and the check has been
+// deferred) at the end of the transaction.
+type ConstraintDeferrability uint8
+
+// The values for ConstraintDeferrability.
+const (
+	// ConstraintNotDeferrable is the default; the constraint is always checked
+	// at the end of the statement.
+	ConstraintNotDeferrable ConstraintDeferrability = iota
+	// ConstraintInitiallyImmediate constraints are checked at the end of the
+	// statement unless deferred with SET CONSTRAINTS.
+	ConstraintInitiallyImmediate
+	// ConstraintInitiallyDeferred constraints are checked at the end of the
+	// transaction unless made immediate with SET CONSTRAINTS.
+	ConstraintInitiallyDeferred
+)
+
+// IsDeferrable returns true if the constraint can be deferred.
+func (x ConstraintDeferrability) IsDeferrable() bool {
+	return x != ConstraintNotDeferrable
+}
+
+// Format implements the NodeFormatter interface.
+func (x ConstraintDeferrability) Format(ctx *FmtCtx) {
+	switch x {
+	case ConstraintInitiallyImmediate:
+		ctx.WriteString(" DEFERRABLE")
+	case ConstraintInitiallyDeferred:
+		ctx.WriteString(" DEFERRABLE INITIALLY DEFERRED")
+	}
+}
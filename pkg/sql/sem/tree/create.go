@@ -24,6 +24,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/idxtype"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree/treecmp"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
 	"github.com/cockroachdb/cockroach/pkg/util/collatedstring"
 	"github.com/cockroachdb/cockroach/pkg/util/pretty"
@@ -1112,6 +1113,13 @@ type UniqueConstraintTableDef struct {
 	PrimaryKey   bool
 	WithoutIndex bool
 	IfNotExists  bool
+	Deferrable   ConstraintDeferrability
+	// ExcludeUsing is set for an EXCLUDE USING constraint and contains the
+	// index method. Exclusion constraints are always WithoutIndex.
+	ExcludeUsing Name
+	// ExcludeOperators contains the operator used to compare each column of an
+	// EXCLUDE USING constraint.
+	ExcludeOperators []treecmp.ComparisonOperator
 	// FormatAsIndex indicates if the constraint should be formatted as an index
 	// definition. This is needed since indexes support syntax for things like
 	// storage parameters and sharding, while constraints do not.
@@ -1138,6 +1146,10 @@ func (node *UniqueConstraintTableDef) Format(ctx *FmtCtx) {
 		ctx.FormatNode(&node.Name)
 		ctx.WriteByte(' ')
 	}
+	if node.IsExclusion() {
+		node.formatExclusion(ctx)
+		return
+	}
 	if node.PrimaryKey {
 		ctx.WriteString("PRIMARY KEY ")
 	} else {
@@ -1167,6 +1179,7 @@ func (node *UniqueConstraintTableDef) Format(ctx *FmtCtx) {
 	if node.PartitionByIndex != nil {
 		ctx.FormatNode(node.PartitionByIndex)
 	}
+	ctx.FormatNode(node.Deferrable)
 	if node.Predicate != nil {
 		ctx.WriteString(" WHERE ")
 		ctx.FormatNode(node.Predicate)
@@ -1184,6 +1197,32 @@ func (node *UniqueConstraintTableDef) Format(ctx *FmtCtx) {
 	}
 }
 
+// IsExclusion returns true if this is an EXCLUDE USING constraint.
+func (node *UniqueConstraintTableDef) IsExclusion() bool {
+	return node.ExcludeUsing != ""
+}
+
+func (node *UniqueConstraintTableDef) formatExclusion(ctx *FmtCtx) {
+	ctx.WriteString("EXCLUDE USING ")
+	ctx.FormatNode(&node.ExcludeUsing)
+	ctx.WriteString(" (")
+	for i := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(&node.Columns[i])
+		ctx.WriteString(" WITH ")
+		ctx.WriteString(node.ExcludeOperators[i].Symbol.String())
+	}
+	ctx.WriteByte(')')
+	ctx.FormatNode(node.Deferrable)
+	if node.Predicate != nil {
+		ctx.WriteString(" WHERE (")
+		ctx.FormatNode(node.Predicate)
+		ctx.WriteByte(')')
+	}
+}
+
 // ForeignKeyConstraintTableDef represents a FOREIGN KEY constraint in the AST.
 type ForeignKeyConstraintTableDef struct {
 	Name        Name
@@ -1193,6 +1232,7 @@ type ForeignKeyConstraintTableDef struct {
 	Actions     ReferenceActions
 	Match       CompositeKeyMatchMethod
 	IfNotExists bool
+	Deferrable  ConstraintDeferrability
 }
 
 // Format implements the NodeFormatter interface.
@@ -1223,6 +1263,7 @@ func (node *ForeignKeyConstraintTableDef) Format(ctx *FmtCtx) {
 	}
 
 	ctx.FormatNode(&node.Actions)
+	ctx.FormatNode(node.Deferrable)
 }
 
 // SetName implements the ConstraintTableDef interface.
@@ -1512,6 +1553,13 @@ type CreateTable struct {
 	// these columns.
 	Defs     TableDefs
 	AsSource *Select
+	// AsWithNoData is set for CREATE TABLE ... AS ... WITH NO DATA, which
+	// creates the table without filling it with the rows of AsSource.
+	AsWithNoData bool
+	// Inherits lists the parent tables of a CREATE TABLE ... INHERITS
+	// statement, whose columns and CHECK constraints are copied into the new
+	// table.
+	Inherits TableNames
 	Locality *Locality
 }
 
@@ -1569,10 +1617,18 @@ func (node *CreateTable) FormatBody(ctx *FmtCtx) {
 		}
 		ctx.WriteString(" AS ")
 		ctx.FormatNode(node.AsSource)
+		if node.AsWithNoData {
+			ctx.WriteString(" WITH NO DATA")
+		}
 	} else {
 		ctx.WriteString(" (")
 		ctx.FormatNode(&node.Defs)
 		ctx.WriteByte(')')
+		if len(node.Inherits) > 0 {
+			ctx.WriteString(" INHERITS (")
+			ctx.FormatNode(&node.Inherits)
+			ctx.WriteByte(')')
+		}
 		if node.PartitionByTable != nil {
 			ctx.FormatNode(node.PartitionByTable)
 		}
@@ -2040,6 +2096,39 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 	}
 }
 
+// NewRecursiveViewSource returns the query of a view created with CREATE
+// RECURSIVE VIEW. As in Postgres, a recursive view is a view over a recursive
+// CTE that has the same name and columns as the view:
+//
+//	CREATE RECURSIVE VIEW v (cols) AS query
+//
+// is equivalent to:
+//
+//	CREATE VIEW v (cols) AS WITH RECURSIVE v (cols) AS (query) SELECT cols FROM v
+func NewRecursiveViewSource(name Name, cols NameList, query *Select) *Select {
+	cteCols := make(ColumnDefList, len(cols))
+	exprs := make(SelectExprs, len(cols))
+	for i, col := range cols {
+		cteCols[i] = ColumnDef{Name: col}
+		exprs[i] = SelectExpr{Expr: NewUnresolvedName(string(col))}
+	}
+	return &Select{
+		With: &With{
+			Recursive: true,
+			CTEList: []*CTE{{
+				Name: AliasClause{Alias: name, Cols: cteCols},
+				Stmt: query,
+			}},
+		},
+		Select: &SelectClause{
+			Exprs: exprs,
+			From: From{
+				Tables: TableExprs{&AliasedTableExpr{Expr: NewUnqualifiedTableName(name)}},
+			},
+		},
+	}
+}
+
 // RefreshMaterializedView represents a REFRESH MATERIALIZED VIEW statement.
 type RefreshMaterializedView struct {
 	Name              *UnresolvedObjectName
@@ -2062,6 +2151,9 @@ const (
 	// RefreshDataClear refers to the WITH NO DATA option provided to the REFRESH
 	// MATERIALIZED VIEW statement.
 	RefreshDataClear
+	// RefreshDataIncremental refers to the INCREMENTAL option provided to the
+	// REFRESH MATERIALIZED VIEW statement.
+	RefreshDataIncremental
 )
 
 // Format implements the NodeFormatter interface.
@@ -2080,6 +2172,8 @@ func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
 		ctx.WriteString(" WITH DATA")
 	case RefreshDataClear:
 		ctx.WriteString(" WITH NO DATA")
+	case RefreshDataIncremental:
+		ctx.WriteString(" INCREMENTAL")
 	}
 }
 
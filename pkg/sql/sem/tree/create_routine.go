@@ -10,6 +10,7 @@ import (
 
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
 	"github.com/cockroachdb/errors"
 )
 
@@ -406,10 +407,10 @@ const (
 )
 
 // IsInParamClass returns true if the given parameter class specifies an input
-// parameter (i.e. either unspecified, IN or, INOUT).
+// parameter (i.e. either unspecified, IN, INOUT or, VARIADIC).
 func IsInParamClass(class RoutineParamClass) bool {
 	switch class {
-	case RoutineParamDefault, RoutineParamIn, RoutineParamInOut:
+	case RoutineParamDefault, RoutineParamIn, RoutineParamInOut, RoutineParamVariadic:
 		return true
 	default:
 		return false
@@ -427,8 +428,8 @@ func IsOutParamClass(class RoutineParamClass) bool {
 	}
 }
 
-// IsInParam returns true if the parameter is an input parameter (i.e. either IN
-// or INOUT).
+// IsInParam returns true if the parameter is an input parameter (i.e. either IN,
+// INOUT or VARIADIC).
 func (node *RoutineParam) IsInParam() bool {
 	return IsInParamClass(node.Class)
 }
@@ -445,10 +446,138 @@ type RoutineReturnType struct {
 	SetOf bool
 }
 
+// CreateAggregate represents a CREATE AGGREGATE statement. The aggregate is
+// defined by a state transition function which is called once per input row,
+// an optional final function which computes the result from the final state,
+// and an optional combine function which merges two partial states.
+type CreateAggregate struct {
+	Replace     bool
+	Name        RoutineName
+	Params      RoutineParams
+	StateFunc   RoutineName
+	StateType   ResolvableTypeReference
+	FinalFunc   *RoutineName
+	CombineFunc *RoutineName
+	InitCond    *string
+}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateAggregate) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE ")
+	if node.Replace {
+		ctx.WriteString("OR REPLACE ")
+	}
+	ctx.WriteString("AGGREGATE ")
+	ctx.FormatNode(&node.Name)
+	ctx.WriteByte('(')
+	ctx.FormatNode(node.Params)
+	ctx.WriteString(") (SFUNC = ")
+	ctx.FormatNode(&node.StateFunc)
+	ctx.WriteString(", STYPE = ")
+	ctx.FormatTypeReference(node.StateType)
+	if node.FinalFunc != nil {
+		ctx.WriteString(", FINALFUNC = ")
+		ctx.FormatNode(node.FinalFunc)
+	}
+	if node.CombineFunc != nil {
+		ctx.WriteString(", COMBINEFUNC = ")
+		ctx.FormatNode(node.CombineFunc)
+	}
+	if node.InitCond != nil {
+		ctx.WriteString(", INITCOND = ")
+		ctx.FormatNode(NewStrVal(*node.InitCond))
+	}
+	ctx.WriteByte(')')
+}
+
+// AggregateOption is a single "name = value" element of the definition list
+// of a CREATE AGGREGATE statement. Exactly one of Arg and Str is set.
+type AggregateOption struct {
+	Name Name
+	Arg  ResolvableTypeReference
+	Str  *string
+}
+
+// AggregateOptions is a list of AggregateOption.
+type AggregateOptions []AggregateOption
+
+// NewCreateAggregate constructs a CreateAggregate from the options list of a
+// CREATE AGGREGATE statement, validating that the required options are
+// present and that no option is repeated.
+func NewCreateAggregate(
+	replace bool, name RoutineName, params RoutineParams, options AggregateOptions,
+) (*CreateAggregate, error) {
+	node := &CreateAggregate{Replace: replace, Name: name, Params: params}
+	seen := make(map[string]struct{}, len(options))
+	for _, o := range options {
+		optName := strings.ToLower(string(o.Name))
+		if _, ok := seen[optName]; ok {
+			return nil, ErrConflictingRoutineOption
+		}
+		seen[optName] = struct{}{}
+		switch optName {
+		case "sfunc":
+			fn, err := aggregateOptionRoutineName(optName, o)
+			if err != nil {
+				return nil, err
+			}
+			node.StateFunc = fn
+		case "finalfunc":
+			fn, err := aggregateOptionRoutineName(optName, o)
+			if err != nil {
+				return nil, err
+			}
+			node.FinalFunc = &fn
+		case "combinefunc":
+			fn, err := aggregateOptionRoutineName(optName, o)
+			if err != nil {
+				return nil, err
+			}
+			node.CombineFunc = &fn
+		case "stype":
+			if o.Arg == nil {
+				return nil, pgerror.Newf(pgcode.Syntax, "aggregate stype must be a type name")
+			}
+			node.StateType = o.Arg
+		case "initcond":
+			if o.Str == nil {
+				return nil, pgerror.Newf(pgcode.Syntax, "aggregate initcond must be a constant")
+			}
+			node.InitCond = o.Str
+		case "basetype", "sspace", "finalfunc_extra", "finalfunc_modify", "serialfunc",
+			"deserialfunc", "msfunc", "minvfunc", "mstype", "msspace", "mfinalfunc",
+			"mfinalfunc_extra", "mfinalfunc_modify", "minitcond", "sortop", "parallel",
+			"hypothetical":
+			return nil, unimplemented.NewWithIssueDetailf(74775, optName,
+				"aggregate option %s is not supported", optName)
+		default:
+			return nil, pgerror.Newf(pgcode.Syntax, "aggregate attribute %q not recognized", optName)
+		}
+	}
+	if _, ok := seen["sfunc"]; !ok {
+		return nil, pgerror.New(pgcode.InvalidFunctionDefinition, "aggregate sfunc must be specified")
+	}
+	if node.StateType == nil {
+		return nil, pgerror.New(pgcode.InvalidFunctionDefinition, "aggregate stype must be specified")
+	}
+	return node, nil
+}
+
+// aggregateOptionRoutineName returns the function name given as the value of
+// a CREATE AGGREGATE option. Function names are parsed as type names by the
+// grammar, so they are converted back here.
+func aggregateOptionRoutineName(optName string, o AggregateOption) (RoutineName, error) {
+	if u, ok := o.Arg.(*UnresolvedObjectName); ok {
+		return u.ToRoutineName(), nil
+	}
+	return RoutineName{}, pgerror.Newf(pgcode.Syntax, "aggregate %s must be a function name", optName)
+}
+
 // DropRoutine represents a DROP FUNCTION or DROP PROCEDURE statement.
 type DropRoutine struct {
 	IfExists     bool
 	Procedure    bool
+	Aggregate    bool
 	Routines     RoutineObjs
 	DropBehavior DropBehavior
 }
@@ -457,6 +586,8 @@ type DropRoutine struct {
 func (node *DropRoutine) Format(ctx *FmtCtx) {
 	if node.Procedure {
 		ctx.WriteString("DROP PROCEDURE ")
+	} else if node.Aggregate {
+		ctx.WriteString("DROP AGGREGATE ")
 	} else {
 		ctx.WriteString("DROP FUNCTION ")
 	}
@@ -522,12 +653,15 @@ type AlterRoutineRename struct {
 	Function  RoutineObj
 	NewName   Name
 	Procedure bool
+	Aggregate bool
 }
 
 // Format implements the NodeFormatter interface.
 func (node *AlterRoutineRename) Format(ctx *FmtCtx) {
 	if node.Procedure {
 		ctx.WriteString("ALTER PROCEDURE ")
+	} else if node.Aggregate {
+		ctx.WriteString("ALTER AGGREGATE ")
 	} else {
 		ctx.WriteString("ALTER FUNCTION ")
 	}
@@ -542,12 +676,15 @@ type AlterRoutineSetSchema struct {
 	Function      RoutineObj
 	NewSchemaName Name
 	Procedure     bool
+	Aggregate     bool
 }
 
 // Format implements the NodeFormatter interface.
 func (node *AlterRoutineSetSchema) Format(ctx *FmtCtx) {
 	if node.Procedure {
 		ctx.WriteString("ALTER PROCEDURE ")
+	} else if node.Aggregate {
+		ctx.WriteString("ALTER AGGREGATE ")
 	} else {
 		ctx.WriteString("ALTER FUNCTION ")
 	}
@@ -562,12 +699,15 @@ type AlterRoutineSetOwner struct {
 	Function  RoutineObj
 	NewOwner  RoleSpec
 	Procedure bool
+	Aggregate bool
 }
 
 // Format implements the NodeFormatter interface.
 func (node *AlterRoutineSetOwner) Format(ctx *FmtCtx) {
 	if node.Procedure {
 		ctx.WriteString("ALTER PROCEDURE ")
+	} else if node.Aggregate {
+		ctx.WriteString("ALTER AGGREGATE ")
 	} else {
 		ctx.WriteString("ALTER FUNCTION ")
 	}
@@ -638,6 +778,8 @@ const (
 	TTLUpdateExpr                   SchemaExprContext = "TTL UPDATE"
 	PolicyUsingExpr                 SchemaExprContext = "POLICY USING"
 	PolicyWithCheckExpr             SchemaExprContext = "POLICY WITH CHECK"
+	DomainCheckExpr                 SchemaExprContext = "DOMAIN CHECK"
+	DomainDefaultExpr               SchemaExprContext = "DOMAIN DEFAULT"
 )
 
 func ComputedColumnExprContext(isVirtual bool) SchemaExprContext {
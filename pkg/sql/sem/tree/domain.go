@@ -0,0 +1,248 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// CreateDomain represents a CREATE DOMAIN statement.
+type CreateDomain struct {
+	TypeName *UnresolvedObjectName
+	BaseType ResolvableTypeReference
+	// Default is the optional DEFAULT expression of the domain.
+	Default     Expr
+	Constraints DomainConstraints
+}
+
+var _ Statement = &CreateDomain{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateDomain) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE DOMAIN ")
+	ctx.FormatNode(node.TypeName)
+	ctx.WriteString(" AS ")
+	ctx.FormatTypeReference(node.BaseType)
+	if node.Default != nil {
+		ctx.WriteString(" DEFAULT ")
+		ctx.FormatNode(node.Default)
+	}
+	if len(node.Constraints) > 0 {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(&node.Constraints)
+	}
+}
+
+// DomainConstraints is a list of domain constraints.
+type DomainConstraints []DomainConstraint
+
+// Format implements the NodeFormatter interface.
+func (node *DomainConstraints) Format(ctx *FmtCtx) {
+	for i := range *node {
+		if i > 0 {
+			ctx.WriteByte(' ')
+		}
+		ctx.FormatNode(&(*node)[i])
+	}
+}
+
+// DomainConstraint represents a NOT NULL, NULL or CHECK constraint of a
+// domain. Exactly one of NotNull, Null and Check is set.
+type DomainConstraint struct {
+	Name    Name
+	NotNull bool
+	Null    bool
+	// Check is the expression of a CHECK constraint. It refers to the value
+	// being checked as VALUE.
+	Check Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *DomainConstraint) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("CONSTRAINT ")
+		ctx.FormatNode(&node.Name)
+		ctx.WriteByte(' ')
+	}
+	switch {
+	case node.NotNull:
+		ctx.WriteString("NOT NULL")
+	case node.Null:
+		ctx.WriteString("NULL")
+	default:
+		ctx.WriteString("CHECK (")
+		ctx.FormatNode(node.Check)
+		ctx.WriteByte(')')
+	}
+}
+
+// AlterDomain represents an ALTER DOMAIN statement.
+type AlterDomain struct {
+	Type *UnresolvedObjectName
+	Cmd  AlterDomainCmd
+}
+
+var _ Statement = &AlterDomain{}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterDomain) Format(ctx *FmtCtx) {
+	ctx.WriteString("ALTER DOMAIN ")
+	ctx.FormatNode(node.Type)
+	ctx.FormatNode(node.Cmd)
+}
+
+// AlterDomainCmd represents a domain modification operation.
+type AlterDomainCmd interface {
+	NodeFormatter
+	alterDomainCmd()
+	// TelemetryName returns the counter name to use for telemetry purposes.
+	TelemetryName() string
+}
+
+func (*AlterDomainSetDefault) alterDomainCmd()         {}
+func (*AlterDomainSetNotNull) alterDomainCmd()         {}
+func (*AlterDomainAddConstraint) alterDomainCmd()      {}
+func (*AlterDomainDropConstraint) alterDomainCmd()     {}
+func (*AlterDomainValidateConstraint) alterDomainCmd() {}
+
+var _ AlterDomainCmd = &AlterDomainSetDefault{}
+var _ AlterDomainCmd = &AlterDomainSetNotNull{}
+var _ AlterDomainCmd = &AlterDomainAddConstraint{}
+var _ AlterDomainCmd = &AlterDomainDropConstraint{}
+var _ AlterDomainCmd = &AlterDomainValidateConstraint{}
+
+// AlterDomainSetDefault represents an ALTER DOMAIN SET DEFAULT or DROP DEFAULT
+// command. Default is nil for DROP DEFAULT.
+type AlterDomainSetDefault struct {
+	Default Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterDomainSetDefault) Format(ctx *FmtCtx) {
+	if node.Default == nil {
+		ctx.WriteString(" DROP DEFAULT")
+		return
+	}
+	ctx.WriteString(" SET DEFAULT ")
+	ctx.FormatNode(node.Default)
+}
+
+// TelemetryName implements the AlterDomainCmd interface.
+func (node *AlterDomainSetDefault) TelemetryName() string {
+	if node.Default == nil {
+		return "drop_default"
+	}
+	return "set_default"
+}
+
+// AlterDomainSetNotNull represents an ALTER DOMAIN SET NOT NULL or DROP NOT
+// NULL command.
+type AlterDomainSetNotNull struct {
+	NotNull bool
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterDomainSetNotNull) Format(ctx *FmtCtx) {
+	if node.NotNull {
+		ctx.WriteString(" SET NOT NULL")
+	} else {
+		ctx.WriteString(" DROP NOT NULL")
+	}
+}
+
+// TelemetryName implements the AlterDomainCmd interface.
+func (node *AlterDomainSetNotNull) TelemetryName() string {
+	if node.NotNull {
+		return "set_not_null"
+	}
+	return "drop_not_null"
+}
+
+// AlterDomainAddConstraint represents an ALTER DOMAIN ADD CONSTRAINT command.
+type AlterDomainAddConstraint struct {
+	Constraint DomainConstraint
+	NotValid   bool
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterDomainAddConstraint) Format(ctx *FmtCtx) {
+	ctx.WriteString(" ADD ")
+	ctx.FormatNode(&node.Constraint)
+	if node.NotValid {
+		ctx.WriteString(" NOT VALID")
+	}
+}
+
+// TelemetryName implements the AlterDomainCmd interface.
+func (node *AlterDomainAddConstraint) TelemetryName() string {
+	return "add_constraint"
+}
+
+// AlterDomainDropConstraint represents an ALTER DOMAIN DROP CONSTRAINT
+// command.
+type AlterDomainDropConstraint struct {
+	Constraint   Name
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterDomainDropConstraint) Format(ctx *FmtCtx) {
+	ctx.WriteString(" DROP CONSTRAINT ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Constraint)
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
+
+// TelemetryName implements the AlterDomainCmd interface.
+func (node *AlterDomainDropConstraint) TelemetryName() string {
+	return "drop_constraint"
+}
+
+// AlterDomainValidateConstraint represents an ALTER DOMAIN VALIDATE
+// CONSTRAINT command.
+type AlterDomainValidateConstraint struct {
+	Constraint Name
+}
+
+// Format implements the NodeFormatter interface.
+func (node *AlterDomainValidateConstraint) Format(ctx *FmtCtx) {
+	ctx.WriteString(" VALIDATE CONSTRAINT ")
+	ctx.FormatNode(&node.Constraint)
+}
+
+// TelemetryName implements the AlterDomainCmd interface.
+func (node *AlterDomainValidateConstraint) TelemetryName() string {
+	return "validate_constraint"
+}
+
+// DropDomain represents a DROP DOMAIN statement.
+type DropDomain struct {
+	Names        []*UnresolvedObjectName
+	IfExists     bool
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropDomain{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropDomain) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP DOMAIN ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	for i := range node.Names {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.FormatNode(node.Names[i])
+	}
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
@@ -748,6 +748,21 @@ func (node *CoalesceExpr) GetWhenCondition(i int) (whenCond Expr) {
 	return whenCond
 }
 
+// GroupingExpr represents a GROUPING(...) operation, which returns a bitmask
+// indicating which of its arguments are not included in the grouping set of
+// the current output row. GroupingExpr is replaced by the optbuilder during
+// the analysis of aggregations, so it is never type checked nor evaluated.
+type GroupingExpr struct {
+	Exprs Exprs
+}
+
+// Format implements the NodeFormatter interface.
+func (node *GroupingExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("GROUPING(")
+	ctx.FormatNode(&node.Exprs)
+	ctx.WriteByte(')')
+}
+
 // DefaultVal represents the DEFAULT expression.
 type DefaultVal struct{}
 
@@ -957,6 +972,8 @@ func (node *TypedExprs) String() string {
 type Subquery struct {
 	Select SelectStatement
 	Exists bool
+	// Unique is true if the subquery is the operand of a UNIQUE predicate.
+	Unique bool
 
 	// Idx is a query-unique index for the subquery.
 	// Subqueries are 1-indexed to ensure that the default
@@ -995,6 +1012,8 @@ func (node *Subquery) Format(ctx *FmtCtx) {
 		ctx.WithFlags(ctx.flags & ^FmtShowTypes, func() {
 			if node.Exists {
 				ctx.WriteString("EXISTS ")
+			} else if node.Unique {
+				ctx.WriteString("UNIQUE ")
 			}
 			if node.Select == nil {
 				// If the subquery is generated by the optimizer, we
@@ -1275,6 +1294,11 @@ type FuncExpr struct {
 	// InCall is true when the FuncExpr is part of a CALL statement.
 	InCall bool
 
+	// Variadic is true when the last argument is an array that is passed to
+	// the VARIADIC parameter of a routine as a whole, as in f(1, VARIADIC
+	// ARRAY[2, 3]).
+	Variadic bool
+
 	typeAnnotation
 	fnProps *FunctionProperties
 	fn      *Overload
@@ -1390,7 +1414,17 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 
 	ctx.WriteByte('(')
 	ctx.WriteString(typ)
-	ctx.FormatNode(&node.Exprs)
+	if node.Variadic && len(node.Exprs) > 0 {
+		fixedExprs := node.Exprs[:len(node.Exprs)-1]
+		ctx.FormatNode(&fixedExprs)
+		if len(fixedExprs) > 0 {
+			ctx.WriteString(", ")
+		}
+		ctx.WriteString("VARIADIC ")
+		ctx.FormatNode(node.Exprs[len(node.Exprs)-1])
+	} else {
+		ctx.FormatNode(&node.Exprs)
+	}
 	if node.AggType == GeneralAgg && len(node.OrderBy) > 0 {
 		ctx.WriteByte(' ')
 		ctx.FormatNode(&node.OrderBy)
@@ -1788,6 +1822,8 @@ func (node *DVoid) String() string            { return AsString(node) }
 func (node *Exprs) String() string            { return AsString(node) }
 func (node *ArrayFlatten) String() string     { return AsString(node) }
 func (node *FuncExpr) String() string         { return AsString(node) }
+func (node *GroupingExpr) String() string     { return AsString(node) }
+func (node *GroupingSets) String() string     { return AsString(node) }
 func (node *IfExpr) String() string           { return AsString(node) }
 func (node *IfErrExpr) String() string        { return AsString(node) }
 func (node *IndexedVar) String() string       { return AsString(node) }
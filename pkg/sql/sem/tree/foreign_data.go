@@ -0,0 +1,124 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// ForeignOptions is the OPTIONS clause of CREATE SERVER and CREATE FOREIGN
+// TABLE. The value of every option is a string constant.
+type ForeignOptions []KVOption
+
+// Format implements the NodeFormatter interface.
+func (o *ForeignOptions) Format(ctx *FmtCtx) {
+	ctx.WriteString("OPTIONS (")
+	for i := range *o {
+		n := &(*o)[i]
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		// Option keys never contain PII and should be distinguished for feature
+		// tracking purposes.
+		ctx.WithFlags(ctx.flags&^FmtMarkRedactionNode, func() {
+			ctx.FormatNode((*UnrestrictedName)(&n.Key))
+		})
+		ctx.WriteByte(' ')
+		if n.Key == "uri" {
+			// The URI of a server may contain secrets.
+			ctx.FormatURI(n.Value)
+		} else {
+			ctx.FormatNode(n.Value)
+		}
+	}
+	ctx.WriteByte(')')
+}
+
+// CreateServer represents a CREATE SERVER statement.
+type CreateServer struct {
+	IfNotExists bool
+	Name        Name
+	Wrapper     Name
+	Options     ForeignOptions
+}
+
+var _ Statement = &CreateServer{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateServer) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE SERVER ")
+	if node.IfNotExists {
+		ctx.WriteString("IF NOT EXISTS ")
+	}
+	ctx.FormatNode(&node.Name)
+	ctx.WriteString(" FOREIGN DATA WRAPPER ")
+	ctx.FormatNode(&node.Wrapper)
+	if len(node.Options) > 0 {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(&node.Options)
+	}
+}
+
+// String implements the Statement interface.
+func (node *CreateServer) String() string {
+	return AsString(node)
+}
+
+// DropServer represents a DROP SERVER statement.
+type DropServer struct {
+	IfExists     bool
+	Names        NameList
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropServer{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropServer) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP SERVER ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Names)
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
+
+// String implements the Statement interface.
+func (node *DropServer) String() string {
+	return AsString(node)
+}
+
+// CreateForeignTable represents a CREATE FOREIGN TABLE statement.
+type CreateForeignTable struct {
+	IfNotExists bool
+	Table       TableName
+	Defs        TableDefs
+	Server      Name
+	Options     ForeignOptions
+}
+
+var _ Statement = &CreateForeignTable{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreateForeignTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE FOREIGN TABLE ")
+	if node.IfNotExists {
+		ctx.WriteString("IF NOT EXISTS ")
+	}
+	ctx.FormatNode(&node.Table)
+	ctx.WriteString(" (")
+	ctx.FormatNode(&node.Defs)
+	ctx.WriteString(") SERVER ")
+	ctx.FormatNode(&node.Server)
+	if len(node.Options) > 0 {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(&node.Options)
+	}
+}
+
+// String implements the Statement interface.
+func (node *CreateForeignTable) String() string {
+	return AsString(node)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// LockTableMode is the lock mode of a LOCK TABLE statement. The modes are
+// ordered from the weakest to the strongest, as in Postgres.
+type LockTableMode uint8
+
+// LockTableMode values.
+const (
+	LockTableAccessShare LockTableMode = iota
+	LockTableRowShare
+	LockTableRowExclusive
+	LockTableShareUpdateExclusive
+	LockTableShare
+	LockTableShareRowExclusive
+	LockTableExclusive
+	LockTableAccessExclusive
+)
+
+var lockTableModeName = [...]string{
+	LockTableAccessShare:          "ACCESS SHARE",
+	LockTableRowShare:             "ROW SHARE",
+	LockTableRowExclusive:         "ROW EXCLUSIVE",
+	LockTableShareUpdateExclusive: "SHARE UPDATE EXCLUSIVE",
+	LockTableShare:                "SHARE",
+	LockTableShareRowExclusive:    "SHARE ROW EXCLUSIVE",
+	LockTableExclusive:            "EXCLUSIVE",
+	LockTableAccessExclusive:      "ACCESS EXCLUSIVE",
+}
+
+func (m LockTableMode) String() string {
+	return lockTableModeName[m]
+}
+
+// LockTable represents a LOCK TABLE statement.
+type LockTable struct {
+	Tables TableNames
+	Mode   LockTableMode
+	NoWait bool
+}
+
+var _ Statement = &LockTable{}
+
+// Format implements the NodeFormatter interface.
+func (node *LockTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("LOCK TABLE ")
+	ctx.FormatNode(&node.Tables)
+	ctx.WriteString(" IN ")
+	ctx.WriteString(node.Mode.String())
+	ctx.WriteString(" MODE")
+	if node.NoWait {
+		ctx.WriteString(" NOWAIT")
+	}
+}
+
+// String implements the Statement interface.
+func (node *LockTable) String() string {
+	return AsString(node)
+}
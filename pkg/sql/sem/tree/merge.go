@@ -0,0 +1,111 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// Merge represents a MERGE statement.
+type Merge struct {
+	With      *With
+	Table     TableExpr
+	Source    TableExpr
+	On        Expr
+	Whens     MergeWhens
+	Returning ReturningClause
+}
+
+// Format implements the NodeFormatter interface.
+func (node *Merge) Format(ctx *FmtCtx) {
+	ctx.FormatNode(node.With)
+	ctx.WriteString("MERGE INTO ")
+	ctx.FormatNode(node.Table)
+	ctx.WriteString(" USING ")
+	ctx.FormatNode(node.Source)
+	ctx.WriteString(" ON ")
+	ctx.FormatNode(node.On)
+	ctx.WriteByte(' ')
+	ctx.FormatNode(&node.Whens)
+	if HasReturningClause(node.Returning) {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(node.Returning)
+	}
+}
+
+// MergeAction is the action performed by a WHEN clause of a MERGE statement.
+type MergeAction uint8
+
+// MergeAction values.
+const (
+	MergeActionDoNothing MergeAction = iota
+	MergeActionUpdate
+	MergeActionDelete
+	MergeActionInsert
+)
+
+// MergeWhens represents the list of WHEN clauses of a MERGE statement.
+type MergeWhens []*MergeWhen
+
+// Format implements the NodeFormatter interface.
+func (node *MergeWhens) Format(ctx *FmtCtx) {
+	for i, n := range *node {
+		if i > 0 {
+			ctx.WriteByte(' ')
+		}
+		ctx.FormatNode(n)
+	}
+}
+
+// MergeWhen represents a WHEN [NOT] MATCHED clause of a MERGE statement.
+type MergeWhen struct {
+	// Matched is true for WHEN MATCHED clauses, which apply to source rows that
+	// join with a row of the target table, and false for WHEN NOT MATCHED
+	// clauses.
+	Matched bool
+	// Cond is the optional AND condition of the clause.
+	Cond   Expr
+	Action MergeAction
+	// Exprs are the SET expressions of an UPDATE action.
+	Exprs UpdateExprs
+	// Columns and Values are the target columns and the values of an INSERT
+	// action. Values is nil for INSERT DEFAULT VALUES.
+	Columns NameList
+	Values  Exprs
+}
+
+// Format implements the NodeFormatter interface.
+func (node *MergeWhen) Format(ctx *FmtCtx) {
+	ctx.WriteString("WHEN ")
+	if !node.Matched {
+		ctx.WriteString("NOT ")
+	}
+	ctx.WriteString("MATCHED")
+	if node.Cond != nil {
+		ctx.WriteString(" AND ")
+		ctx.FormatNode(node.Cond)
+	}
+	ctx.WriteString(" THEN ")
+	switch node.Action {
+	case MergeActionDoNothing:
+		ctx.WriteString("DO NOTHING")
+	case MergeActionUpdate:
+		ctx.WriteString("UPDATE SET ")
+		ctx.FormatNode(&node.Exprs)
+	case MergeActionDelete:
+		ctx.WriteString("DELETE")
+	case MergeActionInsert:
+		ctx.WriteString("INSERT")
+		if len(node.Columns) > 0 {
+			ctx.WriteString(" (")
+			ctx.FormatNode(&node.Columns)
+			ctx.WriteByte(')')
+		}
+		if node.Values == nil {
+			ctx.WriteString(" DEFAULT VALUES")
+		} else {
+			ctx.WriteString(" VALUES (")
+			ctx.FormatNode(&node.Values)
+			ctx.WriteByte(')')
+		}
+	}
+}
@@ -300,11 +300,41 @@ type Overload struct {
 	// UDFContainsOnlySignature is false, then DEFAULT expressions are included
 	// into RoutineParams.
 	DefaultExprs Exprs
+	// Variadic is true if the last input parameter of the routine is a
+	// VARIADIC parameter. In that case the last element of Types is an array
+	// type, and the routine can be called with any number of trailing
+	// arguments of its element type. Only used for UDFs.
+	Variadic bool
 
 	// SecurityMode is true when privilege checks during function execution
 	// should be performed against the function owner rather than the invoking
 	// user.
 	SecurityMode RoutineSecurity
+
+	// UserDefinedAggregate is set when the overload represents a user-defined
+	// aggregate created with CREATE AGGREGATE. Only set when
+	// UDFContainsOnlySignature is false.
+	UserDefinedAggregate *UserDefinedAggregate
+}
+
+// UserDefinedAggregate describes the component functions of a user-defined
+// aggregate.
+type UserDefinedAggregate struct {
+	// StateFunc is the OID of the state transition function. It is called with
+	// the current state followed by the aggregate arguments and returns the
+	// new state.
+	StateFunc oid.Oid
+	// StateType is the type of the aggregate state.
+	StateType *types.T
+	// FinalFunc is the OID of the function which computes the aggregate result
+	// from the final state. It is zero if the state is returned as-is.
+	FinalFunc oid.Oid
+	// CombineFunc is the OID of the function which merges two partial states.
+	// It is zero if the aggregate cannot be computed in multiple stages.
+	CombineFunc oid.Oid
+	// InitCond is the string representation of the initial state. If nil, the
+	// initial state is NULL.
+	InitCond *string
 }
 
 // params implements the overloadImpl interface.
@@ -1014,8 +1044,8 @@ func (s *overloadTypeChecker) typeCheckOverloadedExprs(
 			return params.MatchLen(numInputExprs)
 		}
 		// Some "suffix" parameters have DEFAULT expressions, so values for them
-		// can be omitted from the input expressions.
-		// TODO(88947): this logic might need to change to support VARIADIC.
+		// can be omitted from the input expressions. (Overloads that were
+		// expanded for a VARIADIC parameter have no DEFAULT expressions.)
 		paramsLen := params.Length()
 		return paramsLen-len(defaultExprs) <= numInputExprs && numInputExprs <= paramsLen
 	}
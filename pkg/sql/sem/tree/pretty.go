@@ -688,12 +688,23 @@ func (node *Subquery) doc(p *PrettyCfg) pretty.Doc {
 			pretty.Keyword("EXISTS"),
 			d,
 		)
+	} else if node.Unique {
+		d = pretty.Concat(
+			pretty.Keyword("UNIQUE"),
+			d,
+		)
 	}
 	return d
 }
 
 func (node *AliasedTableExpr) doc(p *PrettyCfg) pretty.Doc {
 	d := p.Doc(node.Expr)
+	if node.Only {
+		d = pretty.Concat(
+			p.keywordWithText("", "ONLY", " "),
+			d,
+		)
+	}
 	if node.Lateral {
 		d = pretty.Concat(
 			p.keywordWithText("", "LATERAL", " "),
@@ -721,6 +732,23 @@ func (node *AliasedTableExpr) doc(p *PrettyCfg) pretty.Doc {
 			),
 		)
 	}
+	if node.TableSample != nil {
+		d = p.nestUnder(d, p.Doc(node.TableSample))
+	}
+	return d
+}
+
+func (node *TableSample) doc(p *PrettyCfg) pretty.Doc {
+	d := pretty.ConcatSpace(
+		p.keywordWithText("", "TABLESAMPLE", ""),
+		pretty.Concat(p.Doc(&node.Method), p.bracket(" (", p.Doc(node.Percent), ")")),
+	)
+	if node.Seed != nil {
+		d = pretty.ConcatSpace(d, pretty.Concat(
+			p.keywordWithText("", "REPEATABLE", ""),
+			p.bracket(" (", p.Doc(node.Seed), ")"),
+		))
+	}
 	return d
 }
 
@@ -728,7 +756,21 @@ func (node *FuncExpr) doc(p *PrettyCfg) pretty.Doc {
 	d := p.Doc(&node.Func)
 
 	if len(node.Exprs) > 0 {
-		args := node.Exprs.doc(p)
+		var args pretty.Doc
+		if !node.Variadic {
+			args = node.Exprs.doc(p)
+		} else {
+			argDocs := make([]pretty.Doc, len(node.Exprs))
+			for i, e := range node.Exprs {
+				if p.Simplify {
+					e = StripParens(e)
+				}
+				argDocs[i] = p.Doc(e)
+			}
+			last := len(argDocs) - 1
+			argDocs[last] = pretty.ConcatSpace(pretty.Keyword("VARIADIC"), argDocs[last])
+			args = p.commaSeparated(argDocs...)
+		}
 		if node.Type != 0 {
 			args = pretty.ConcatLine(
 				pretty.Text(funcTypeName[node.Type]),
@@ -1289,6 +1331,15 @@ func (node *CreateTable) doc(p *PrettyCfg) pretty.Doc {
 	clauses := make([]pretty.Doc, 0, 4)
 	if node.As() {
 		clauses = append(clauses, p.Doc(node.AsSource))
+		if node.AsWithNoData {
+			clauses = append(clauses, pretty.Keyword("WITH NO DATA"))
+		}
+	}
+	if len(node.Inherits) > 0 {
+		clauses = append(clauses, pretty.ConcatSpace(
+			pretty.Keyword("INHERITS"),
+			p.bracket("(", p.Doc(&node.Inherits), ")"),
+		))
 	}
 	if node.PartitionByTable != nil {
 		clauses = append(clauses, p.Doc(node.PartitionByTable))
@@ -1816,6 +1867,9 @@ func (node *UniqueConstraintTableDef) doc(p *PrettyCfg) pretty.Doc {
 	//    [WHERE ...]
 	//    [NOT VISIBLE | VISIBILITY ...]
 	//
+	if node.IsExclusion() {
+		return p.docAsString(node)
+	}
 	clauses := make([]pretty.Doc, 0, 6)
 	var title pretty.Doc
 	if node.PrimaryKey {
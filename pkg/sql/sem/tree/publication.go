@@ -0,0 +1,61 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package tree
+
+// CreatePublication represents a CREATE PUBLICATION statement.
+type CreatePublication struct {
+	Name Name
+	// AllTables is set for FOR ALL TABLES. If neither AllTables is set nor
+	// Tables is non-empty, the publication does not contain any tables.
+	AllTables bool
+	Tables    TableNames
+}
+
+var _ Statement = &CreatePublication{}
+
+// Format implements the NodeFormatter interface.
+func (node *CreatePublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("CREATE PUBLICATION ")
+	ctx.FormatNode(&node.Name)
+	if node.AllTables {
+		ctx.WriteString(" FOR ALL TABLES")
+	} else if len(node.Tables) > 0 {
+		ctx.WriteString(" FOR TABLE ")
+		ctx.FormatNode(&node.Tables)
+	}
+}
+
+// String implements the Statement interface.
+func (node *CreatePublication) String() string {
+	return AsString(node)
+}
+
+// DropPublication represents a DROP PUBLICATION statement.
+type DropPublication struct {
+	IfExists     bool
+	Names        NameList
+	DropBehavior DropBehavior
+}
+
+var _ Statement = &DropPublication{}
+
+// Format implements the NodeFormatter interface.
+func (node *DropPublication) Format(ctx *FmtCtx) {
+	ctx.WriteString("DROP PUBLICATION ")
+	if node.IfExists {
+		ctx.WriteString("IF EXISTS ")
+	}
+	ctx.FormatNode(&node.Names)
+	if node.DropBehavior != DropDefault {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.DropBehavior.String())
+	}
+}
+
+// String implements the Statement interface.
+func (node *DropPublication) String() string {
+	return AsString(node)
+}
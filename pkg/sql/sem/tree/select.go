@@ -183,6 +183,70 @@ func (node *SelectExpr) Format(ctx *FmtCtx) {
 	}
 }
 
+// TableSample represents a TABLESAMPLE clause, which returns a random subset
+// of the rows of a table.
+type TableSample struct {
+	// Method is the name of the sampling method, such as BERNOULLI or SYSTEM.
+	Method Name
+	// Percent is the percentage of the table to sample, between 0 and 100.
+	Percent Expr
+	// Seed is the seed of the REPEATABLE clause, or nil if there is none.
+	Seed Expr
+}
+
+// Format implements the NodeFormatter interface.
+func (node *TableSample) Format(ctx *FmtCtx) {
+	ctx.WriteString("TABLESAMPLE ")
+	// NB: we do not anonymize the method name because it names one of a fixed
+	// set of sampling methods.
+	ctx.WithFlags(ctx.flags&^FmtAnonymize&^FmtMarkRedactionNode, func() {
+		ctx.FormatNode(&node.Method)
+	})
+	ctx.WriteString(" (")
+	ctx.FormatNode(node.Percent)
+	ctx.WriteByte(')')
+	if node.Seed != nil {
+		ctx.WriteString(" REPEATABLE (")
+		ctx.FormatNode(node.Seed)
+		ctx.WriteByte(')')
+	}
+}
+
+// TableSampleMethod identifies the sampling method of a TABLESAMPLE clause.
+type TableSampleMethod uint8
+
+const (
+	// TableSampleNone indicates that the table is not sampled.
+	TableSampleNone TableSampleMethod = iota
+	// TableSampleBernoulli samples each row independently.
+	TableSampleBernoulli
+	// TableSampleSystem samples whole ranges of the table.
+	TableSampleSystem
+)
+
+// TableSampleMethodFromName returns the sampling method with the given name,
+// or false if there is no such method.
+func TableSampleMethodFromName(name Name) (TableSampleMethod, bool) {
+	switch name {
+	case "bernoulli":
+		return TableSampleBernoulli, true
+	case "system":
+		return TableSampleSystem, true
+	}
+	return TableSampleNone, false
+}
+
+// String implements the fmt.Stringer interface.
+func (m TableSampleMethod) String() string {
+	switch m {
+	case TableSampleBernoulli:
+		return "bernoulli"
+	case TableSampleSystem:
+		return "system"
+	}
+	return ""
+}
+
 // AliasClause represents an alias, optionally with a column def list:
 // "AS name", "AS name(col1, col2)", or "AS name(col1 INT, col2 STRING)".
 // Note that the last form is only valid in the context of record-returning
@@ -602,7 +666,12 @@ type AliasedTableExpr struct {
 	IndexFlags *IndexFlags
 	Ordinality bool
 	Lateral    bool
-	As         AliasClause
+	// Only is set if the table expression is prefixed with ONLY, in which case
+	// tables inheriting from the named table are excluded from the scan.
+	Only bool
+	As   AliasClause
+	// TableSample is set if the table expression has a TABLESAMPLE clause.
+	TableSample *TableSample
 }
 
 // Format implements the NodeFormatter interface.
@@ -610,6 +679,9 @@ func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	if node.Lateral {
 		ctx.WriteString("LATERAL ")
 	}
+	if node.Only {
+		ctx.WriteString("ONLY ")
+	}
 	ctx.FormatNode(node.Expr)
 	if node.IndexFlags != nil && !ctx.HasFlags(FmtHideHints) {
 		ctx.FormatNode(node.IndexFlags)
@@ -621,6 +693,10 @@ func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 		ctx.WriteString(" AS ")
 		ctx.FormatNode(&node.As)
 	}
+	if node.TableSample != nil {
+		ctx.WriteByte(' ')
+		ctx.FormatNode(node.TableSample)
+	}
 }
 
 // ParenTableExpr represents a parenthesized TableExpr.
@@ -789,6 +865,61 @@ func (node *GroupBy) Format(ctx *FmtCtx) {
 	}
 }
 
+// HasGroupingSets returns true if any of the GROUP BY items is a GROUPING
+// SETS, ROLLUP or CUBE element.
+func (node GroupBy) HasGroupingSets() bool {
+	for _, e := range node {
+		if _, ok := e.(*GroupingSets); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupingSetsType identifies the flavor of a GroupingSets element of a GROUP
+// BY clause.
+type GroupingSetsType uint8
+
+const (
+	// GroupingSetsExplicit represents GROUPING SETS (...).
+	GroupingSetsExplicit GroupingSetsType = iota
+	// GroupingSetsRollup represents ROLLUP (...).
+	GroupingSetsRollup
+	// GroupingSetsCube represents CUBE (...).
+	GroupingSetsCube
+)
+
+var groupingSetsTypeName = [...]string{
+	GroupingSetsExplicit: "GROUPING SETS",
+	GroupingSetsRollup:   "ROLLUP",
+	GroupingSetsCube:     "CUBE",
+}
+
+func (t GroupingSetsType) String() string {
+	return groupingSetsTypeName[t]
+}
+
+// GroupingSets represents a GROUPING SETS, ROLLUP or CUBE element of a GROUP
+// BY clause.
+//
+// For ROLLUP and CUBE, each element of Exprs is either a single grouping
+// expression or a Tuple of expressions that are treated as a unit. For
+// GROUPING SETS, each element is a grouping expression, a Tuple listing the
+// expressions of one grouping set (the empty Tuple is the empty grouping set),
+// or a nested GroupingSets.
+type GroupingSets struct {
+	Type  GroupingSetsType
+	Exprs Exprs
+}
+
+// Format implements the NodeFormatter interface.
+func (node *GroupingSets) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Type.String())
+	ctx.WriteString(" (")
+	ctx.FormatNode(&node.Exprs)
+	ctx.WriteByte(')')
+}
+
 // DistinctOn represents a DISTINCT ON clause.
 type DistinctOn []Expr
 
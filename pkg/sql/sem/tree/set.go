@@ -59,6 +59,32 @@ func (node *SetVar) Format(ctx *FmtCtx) {
 	}
 }
 
+// SetConstraints represents a SET CONSTRAINTS statement, which changes
+// whether DEFERRABLE constraints are checked at the end of each statement or
+// at the end of the transaction.
+type SetConstraints struct {
+	// Names lists the constraints to modify. The "table" part of each name is
+	// the constraint name; it may be qualified by a schema. Names is empty for
+	// SET CONSTRAINTS ALL.
+	Names    TableNames
+	Deferred bool
+}
+
+// Format implements the NodeFormatter interface.
+func (node *SetConstraints) Format(ctx *FmtCtx) {
+	ctx.WriteString("SET CONSTRAINTS ")
+	if len(node.Names) == 0 {
+		ctx.WriteString("ALL")
+	} else {
+		ctx.FormatNode(&node.Names)
+	}
+	if node.Deferred {
+		ctx.WriteString(" DEFERRED")
+	} else {
+		ctx.WriteString(" IMMEDIATE")
+	}
+}
+
 // SetClusterSetting represents a SET CLUSTER SETTING statement.
 type SetClusterSetting struct {
 	Name  string
@@ -1333,6 +1333,22 @@ func (node *ShowHistogram) Format(ctx *FmtCtx) {
 	ctx.Printf("SHOW HISTOGRAM %d", node.HistogramID)
 }
 
+// ShowPlanBaselines represents a SHOW PLAN BASELINES statement.
+type ShowPlanBaselines struct {
+	// Fingerprint, if set, restricts the output to the plan baselines of the
+	// given statement fingerprint.
+	Fingerprint *StrVal
+}
+
+// Format implements the NodeFormatter interface.
+func (node *ShowPlanBaselines) Format(ctx *FmtCtx) {
+	ctx.WriteString("SHOW PLAN BASELINES")
+	if node.Fingerprint != nil {
+		ctx.WriteString(" FOR ")
+		ctx.FormatNode(node.Fingerprint)
+	}
+}
+
 // ShowPartitions represents a SHOW PARTITIONS statement.
 type ShowPartitions struct {
 	IsDB     bool
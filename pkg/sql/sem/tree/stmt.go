@@ -91,6 +91,7 @@ const (
 	AlterTableTag          = "ALTER TABLE"
 	AlterPolicyTag         = "ALTER POLICY"
 	BackupTag              = "BACKUP"
+	CreateAggregateTag     = "CREATE AGGREGATE"
 	CreateIndexTag         = "CREATE INDEX"
 	CreateFunctionTag      = "CREATE FUNCTION"
 	CreateProcedureTag     = "CREATE PROCEDURE"
@@ -106,6 +107,7 @@ const (
 	CommentOnSchemaTag     = "COMMENT ON SCHEMA"
 	CommentOnTableTag      = "COMMENT ON TABLE"
 	CommentOnTypeTag       = "COMMENT ON TYPE"
+	DropAggregateTag       = "DROP AGGREGATE"
 	DropDatabaseTag        = "DROP DATABASE"
 	DropFunctionTag        = "DROP FUNCTION"
 	DropPolicyTag          = "DROP POLICY"
@@ -185,7 +187,7 @@ func CanWriteData(stmt Statement) bool {
 	}
 	switch stmt.(type) {
 	// Normal write operations.
-	case *Insert, *Delete, *Update, *Truncate:
+	case *Insert, *Delete, *Update, *Merge, *Truncate:
 		return true
 	// Import operations.
 	case *CopyFrom, *Import, *Restore:
@@ -632,6 +634,15 @@ func (*AlterTenantService) StatementType() StatementType { return TypeDCL }
 // StatementTag returns a short string identifying the type of statement.
 func (*AlterTenantService) StatementTag() string { return "ALTER VIRTUAL CLUSTER SERVICE" }
 
+// StatementReturnType implements the Statement interface.
+func (*AlterDomain) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*AlterDomain) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*AlterDomain) StatementTag() string { return "ALTER DOMAIN" }
+
 // StatementReturnType implements the Statement interface.
 func (*AlterType) StatementReturnType() StatementReturnType { return DDL }
 
@@ -994,6 +1005,33 @@ func (*CreateExternalConnection) StatementType() StatementType { return TypeDDL
 // StatementTag returns a short string identifying the type of statement.
 func (*CreateExternalConnection) StatementTag() string { return "CREATE EXTERNAL CONNECTION" }
 
+// StatementReturnType implements the Statement interface.
+func (*CreateForeignTable) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CreateForeignTable) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreateForeignTable) StatementTag() string { return "CREATE FOREIGN TABLE" }
+
+// StatementReturnType implements the Statement interface.
+func (*CreateServer) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*CreateServer) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreateServer) StatementTag() string { return "CREATE SERVER" }
+
+// StatementReturnType implements the Statement interface.
+func (*CreatePublication) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*CreatePublication) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreatePublication) StatementTag() string { return "CREATE PUBLICATION" }
+
 // StatementReturnType implements the Statement interface.
 func (*AlterExternalConnection) StatementReturnType() StatementReturnType { return Ack }
 
@@ -1060,6 +1098,24 @@ func (*DoBlock) StatementType() StatementType { return TypeDML }
 // StatementTag returns a short string identifying the type of statement.
 func (*DoBlock) StatementTag() string { return "DO" }
 
+// StatementReturnType implements the Statement interface.
+func (*DropServer) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*DropServer) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropServer) StatementTag() string { return "DROP SERVER" }
+
+// StatementReturnType implements the Statement interface.
+func (*DropPublication) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*DropPublication) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropPublication) StatementTag() string { return "DROP PUBLICATION" }
+
 // StatementReturnType implements the Statement interface.
 func (*DropExternalConnection) StatementReturnType() StatementReturnType { return Ack }
 
@@ -1114,6 +1170,15 @@ func (n *CreateTable) StatementTag() string {
 	return "CREATE TABLE"
 }
 
+// StatementReturnType implements the Statement interface.
+func (*CreateDomain) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CreateDomain) StatementType() StatementType { return TypeDDL }
+
+// StatementTag implements the Statement interface.
+func (*CreateDomain) StatementTag() string { return "CREATE DOMAIN" }
+
 // StatementReturnType implements the Statement interface.
 func (*CreateType) StatementReturnType() StatementReturnType { return DDL }
 
@@ -1276,6 +1341,15 @@ func (*DropRole) StatementTag() string { return "DROP ROLE" }
 
 func (*DropRole) hiddenFromShowQueries() {}
 
+// StatementReturnType implements the Statement interface.
+func (*DropDomain) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*DropDomain) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*DropDomain) StatementTag() string { return "DROP DOMAIN" }
+
 // StatementReturnType implements the Statement interface.
 func (*DropType) StatementReturnType() StatementReturnType { return DDL }
 
@@ -1430,6 +1504,42 @@ func (*LiteralValuesClause) StatementType() StatementType { return TypeDML }
 // StatementTag returns a short string identifying the type of statement.
 func (*LiteralValuesClause) StatementTag() string { return "VALUES" }
 
+// StatementReturnType implements the Statement interface.
+func (*Listen) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*Listen) StatementType() StatementType { return TypeTCL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*Listen) StatementTag() string { return "LISTEN" }
+
+// StatementReturnType implements the Statement interface.
+func (*LockTable) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*LockTable) StatementType() StatementType { return TypeTCL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*LockTable) StatementTag() string { return "LOCK TABLE" }
+
+// StatementReturnType implements the Statement interface.
+func (n *Merge) StatementReturnType() StatementReturnType { return n.Returning.statementReturnType() }
+
+// StatementType implements the Statement interface.
+func (*Merge) StatementType() StatementType { return TypeDML }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*Merge) StatementTag() string { return "MERGE" }
+
+// StatementReturnType implements the Statement interface.
+func (*Notify) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*Notify) StatementType() StatementType { return TypeTCL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*Notify) StatementTag() string { return "NOTIFY" }
+
 // StatementReturnType implements the Statement interface.
 func (*ParenSelect) StatementReturnType() StatementReturnType { return Rows }
 
@@ -1702,6 +1812,15 @@ func (*SetClusterSetting) StatementType() StatementType { return TypeDCL }
 // StatementTag returns a short string identifying the type of statement.
 func (*SetClusterSetting) StatementTag() string { return "SET CLUSTER SETTING" }
 
+// StatementReturnType implements the Statement interface.
+func (*SetConstraints) StatementReturnType() StatementReturnType { return Ack }
+
+// StatementType implements the Statement interface.
+func (*SetConstraints) StatementType() StatementType { return TypeTCL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*SetConstraints) StatementTag() string { return "SET CONSTRAINTS" }
+
 // StatementReturnType implements the Statement interface.
 func (*SetTransaction) StatementReturnType() StatementReturnType { return Ack }
 
@@ -2024,6 +2143,15 @@ func (*ShowHistogram) StatementType() StatementType { return TypeDML }
 // StatementTag returns a short string identifying the type of statement.
 func (*ShowHistogram) StatementTag() string { return "SHOW HISTOGRAM" }
 
+// StatementReturnType implements the Statement interface.
+func (*ShowPlanBaselines) StatementReturnType() StatementReturnType { return Rows }
+
+// StatementType implements the Statement interface.
+func (*ShowPlanBaselines) StatementType() StatementType { return TypeDML }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*ShowPlanBaselines) StatementTag() string { return "SHOW PLAN BASELINES" }
+
 // StatementReturnType implements the Statement interface.
 func (*ShowSchedules) StatementReturnType() StatementReturnType { return Rows }
 
@@ -2434,6 +2562,15 @@ func (n *CreateRoutine) StatementTag() string {
 	return CreateFunctionTag
 }
 
+// StatementReturnType implements the Statement interface.
+func (*CreateAggregate) StatementReturnType() StatementReturnType { return DDL }
+
+// StatementType implements the Statement interface.
+func (*CreateAggregate) StatementType() StatementType { return TypeDDL }
+
+// StatementTag returns a short string identifying the type of statement.
+func (*CreateAggregate) StatementTag() string { return CreateAggregateTag }
+
 // StatementReturnType implements the Statement interface.
 func (*RoutineReturn) StatementReturnType() StatementReturnType { return Rows }
 
@@ -2454,6 +2591,9 @@ func (n *DropRoutine) StatementTag() string {
 	if n.Procedure {
 		return DropProcedureTag
 	}
+	if n.Aggregate {
+		return DropAggregateTag
+	}
 	return DropFunctionTag
 }
 
@@ -2498,6 +2638,8 @@ func (*AlterRoutineRename) StatementType() StatementType { return TypeDDL }
 func (n *AlterRoutineRename) StatementTag() string {
 	if n.Procedure {
 		return "ALTER PROCEDURE"
+	} else if n.Aggregate {
+		return "ALTER AGGREGATE"
 	} else {
 		return "ALTER FUNCTION"
 	}
@@ -2513,6 +2655,8 @@ func (*AlterRoutineSetSchema) StatementType() StatementType { return TypeDDL }
 func (n *AlterRoutineSetSchema) StatementTag() string {
 	if n.Procedure {
 		return "ALTER PROCEDURE"
+	} else if n.Aggregate {
+		return "ALTER AGGREGATE"
 	} else {
 		return "ALTER FUNCTION"
 	}
@@ -2528,6 +2672,8 @@ func (*AlterRoutineSetOwner) StatementType() StatementType { return TypeDDL }
 func (n *AlterRoutineSetOwner) StatementTag() string {
 	if n.Procedure {
 		return "ALTER PROCEDURE"
+	} else if n.Aggregate {
+		return "ALTER AGGREGATE"
 	} else {
 		return "ALTER FUNCTION"
 	}
@@ -2594,6 +2740,7 @@ func (n *AlterTenantReset) String() string                    { return AsString(
 func (n *AlterTenantRename) String() string                   { return AsString(n) }
 func (n *AlterTenantReplication) String() string              { return AsString(n) }
 func (n *AlterTenantService) String() string                  { return AsString(n) }
+func (n *AlterDomain) String() string                         { return AsString(n) }
 func (n *AlterType) String() string                           { return AsString(n) }
 func (n *AlterRole) String() string                           { return AsString(n) }
 func (n *AlterRoleSet) String() string                        { return AsString(n) }
@@ -2623,7 +2770,9 @@ func (n *CopyFrom) String() string                            { return AsString(
 func (n *CopyTo) String() string                              { return AsString(n) }
 func (n *CreateChangefeed) String() string                    { return AsString(n) }
 func (n *CreateDatabase) String() string                      { return AsString(n) }
+func (n *CreateDomain) String() string                        { return AsString(n) }
 func (n *CreateExtension) String() string                     { return AsString(n) }
+func (n *CreateAggregate) String() string                     { return AsString(n) }
 func (n *CreateRoutine) String() string                       { return AsString(n) }
 func (n *CreateTrigger) String() string                       { return AsString(n) }
 func (n *CreateIndex) String() string                         { return AsString(n) }
@@ -2642,6 +2791,7 @@ func (n *Delete) String() string                              { return AsString(
 func (n *DeclareCursor) String() string                       { return AsString(n) }
 func (n *DoBlock) String() string                             { return AsString(n) }
 func (n *DropDatabase) String() string                        { return AsString(n) }
+func (n *DropDomain) String() string                          { return AsString(n) }
 func (n *DropPolicy) String() string                          { return AsString(n) }
 func (n *DropRoutine) String() string                         { return AsString(n) }
 func (n *DropTrigger) String() string                         { return AsString(n) }
@@ -2666,6 +2816,8 @@ func (n *FetchCursor) String() string                         { return AsString(
 func (n *Grant) String() string                               { return AsString(n) }
 func (n *GrantRole) String() string                           { return AsString(n) }
 func (n *MoveCursor) String() string                          { return AsString(n) }
+func (n *Merge) String() string                               { return AsString(n) }
+func (n *MergeWhen) String() string                           { return AsString(n) }
 func (n *Insert) String() string                              { return AsString(n) }
 func (n *Inspect) String() string                             { return AsString(n) }
 func (n *Import) String() string                              { return AsString(n) }
@@ -2697,6 +2849,7 @@ func (n *Scrub) String() string                               { return AsString(
 func (n *Select) String() string                              { return AsString(n) }
 func (n *SelectClause) String() string                        { return AsString(n) }
 func (n *SetClusterSetting) String() string                   { return AsString(n) }
+func (n *SetConstraints) String() string                      { return AsString(n) }
 func (n *SetZoneConfig) String() string                       { return AsString(n) }
 func (n *SetSessionAuthorizationDefault) String() string      { return AsString(n) }
 func (n *SetSessionCharacteristics) String() string           { return AsString(n) }
@@ -2734,6 +2887,7 @@ func (n *ShowJobs) String() string                            { return AsString(
 func (n *ShowChangefeedJobs) String() string                  { return AsString(n) }
 func (n *ShowLastQueryStatistics) String() string             { return AsString(n) }
 func (n *ShowPartitions) String() string                      { return AsString(n) }
+func (n *ShowPlanBaselines) String() string                   { return AsString(n) }
 func (n *ShowPolicies) String() string                        { return AsString(n) }
 func (n *ShowQueries) String() string                         { return AsString(n) }
 func (n *ShowRanges) String() string                          { return AsString(n) }
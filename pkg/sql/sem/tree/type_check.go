@@ -1032,6 +1032,8 @@ var (
 	errInvalidMaxUsage     = pgerror.New(pgcode.Syntax, "MAXVALUE can only appear within a range partition expression")
 	errInvalidMinUsage     = pgerror.New(pgcode.Syntax, "MINVALUE can only appear within a range partition expression")
 	errPrivateFunction     = pgerror.New(pgcode.ReservedName, "function reserved for internal use")
+	errGroupingNotAllowed  = pgerror.New(pgcode.Grouping, "GROUPING is not allowed in this context")
+	errGroupingSetsUsage   = pgerror.New(pgcode.Syntax, "ROLLUP, CUBE and GROUPING SETS can only appear in a GROUP BY clause")
 )
 
 // NewAggInAggError creates an error for the case when an aggregate function is
@@ -1193,6 +1195,103 @@ func (expr *FuncExpr) typeCheckWithFuncAncestor(semaCtx *SemaContext, fn func()
 	return fn()
 }
 
+// expandVariadicOverloads returns the overloads that should be considered for
+// a function call with numArgs arguments. Each routine overload with a
+// VARIADIC parameter is replaced with a copy in which the VARIADIC parameter
+// is repeated as many times as needed to accept all trailing arguments, each
+// time with the element type of the parameter. The returned map maps each
+// copy to its original overload, and it is nil if no copies were made.
+//
+// If explicitVariadic is true, the last argument of the call is passed with
+// the VARIADIC keyword, so only routines with a VARIADIC parameter are
+// considered, and the argument must match the array type of the parameter.
+func expandVariadicOverloads(
+	overloads []QualifiedOverload, numArgs int, explicitVariadic bool,
+) (_ []QualifiedOverload, variadicOverloads map[*Overload]*Overload) {
+	if explicitVariadic {
+		res := make([]QualifiedOverload, 0, len(overloads))
+		for _, ol := range overloads {
+			if ol.Variadic {
+				res = append(res, ol)
+			}
+		}
+		return res, nil
+	}
+	var res []QualifiedOverload
+	for i, ol := range overloads {
+		paramTypes, ok := ol.Types.(ParamTypes)
+		numInputArgs := numArgs
+		if ol.Type == ProcedureRoutine {
+			// The arguments of a CALL statement include those for OUT
+			// parameters, which must precede the VARIADIC parameter.
+			numInputArgs -= len(ol.OutParamOrdinals)
+		}
+		if !ol.Variadic || !ok || numInputArgs < len(paramTypes) {
+			// If the VARIADIC parameter is omitted from the call, the original
+			// overload can still match through its DEFAULT expression.
+			if res != nil {
+				res = append(res, ol)
+			}
+			continue
+		}
+		if res == nil {
+			res = make([]QualifiedOverload, i, len(overloads))
+			copy(res, overloads[:i])
+			variadicOverloads = make(map[*Overload]*Overload)
+		}
+		numFixed := len(paramTypes) - 1
+		elemParam := ParamType{
+			Name: paramTypes[numFixed].Name,
+			Typ:  paramTypes[numFixed].Typ.ArrayContents(),
+		}
+		expandedTypes := make(ParamTypes, numInputArgs)
+		copy(expandedTypes, paramTypes[:numFixed])
+		for j := numFixed; j < numInputArgs; j++ {
+			expandedTypes[j] = elemParam
+		}
+		expanded := *ol.Overload
+		expanded.Types = expandedTypes
+		// All arguments are supplied, so there are no DEFAULT expressions to
+		// consider.
+		expanded.DefaultExprs = nil
+		variadicOverloads[&expanded] = ol.Overload
+		res = append(res, QualifiedOverload{Schema: ol.Schema, Overload: &expanded})
+	}
+	if res == nil {
+		return overloads, nil
+	}
+	return res, variadicOverloads
+}
+
+// packVariadicArgs returns a copy of the given arguments in which all
+// arguments starting at ordinal numFixed are replaced by a single array of
+// the given type.
+func packVariadicArgs(args []TypedExpr, numFixed int, arrTyp *types.T) []TypedExpr {
+	elemTyp := arrTyp.ArrayContents()
+	if elemTyp.IsPolymorphicType() {
+		// The element type is determined by the arguments.
+		elemTyp = types.Unknown
+		for _, arg := range args[numFixed:] {
+			if typ := arg.ResolvedType(); typ.Family() != types.UnknownFamily {
+				elemTyp = typ
+				break
+			}
+		}
+		arrTyp = types.MakeArray(elemTyp)
+	}
+	elems := make(TypedExprs, len(args)-numFixed)
+	for i, arg := range args[numFixed:] {
+		if elemTyp.Family() != types.UnknownFamily && !arg.ResolvedType().Identical(elemTyp) {
+			arg = NewTypedCastExpr(arg, elemTyp)
+		}
+		elems[i] = arg
+	}
+	res := make([]TypedExpr, numFixed+1)
+	copy(res, args[:numFixed])
+	res[numFixed] = NewTypedArray(elems, arrTyp)
+	return res
+}
+
 // TypeCheck implements the Expr interface.
 func (expr *FuncExpr) TypeCheck(
 	ctx context.Context, semaCtx *SemaContext, desired *types.T,
@@ -1232,6 +1331,18 @@ func (expr *FuncExpr) TypeCheck(
 			"%s()", def.Name)
 	}
 
+	// Routines with a VARIADIC parameter are matched against the call through
+	// copies of their overloads that accept the given number of arguments.
+	resolvedDef := def
+	overloads, variadicOverloads := expandVariadicOverloads(def.Overloads, len(expr.Exprs), expr.Variadic)
+	if variadicOverloads != nil || expr.Variadic {
+		def = &ResolvedFunctionDefinition{
+			Name:                 def.Name,
+			Overloads:            overloads,
+			UnsupportedWithIssue: def.UnsupportedWithIssue,
+		}
+	}
+
 	typeNames := func(typedExprs []TypedExpr) string {
 		var sb strings.Builder
 		sb.WriteByte('(')
@@ -1295,6 +1406,26 @@ func (expr *FuncExpr) TypeCheck(
 		return nil, err
 	}
 
+	// If the call matches both a routine with a VARIADIC parameter and one
+	// without it in the same schema, the latter is preferred.
+	if variadicOverloads != nil && len(s.overloadIdxs) > 1 {
+		nonVariadicSchemas := make(map[string]struct{})
+		for _, idx := range s.overloadIdxs {
+			if ol := def.Overloads[idx]; variadicOverloads[ol.Overload] == nil {
+				nonVariadicSchemas[ol.Schema] = struct{}{}
+			}
+		}
+		filtered := s.overloadIdxs[:0]
+		for _, idx := range s.overloadIdxs {
+			ol := def.Overloads[idx]
+			if _, ok := nonVariadicSchemas[ol.Schema]; ok && variadicOverloads[ol.Overload] != nil {
+				continue
+			}
+			filtered = append(filtered, idx)
+		}
+		s.overloadIdxs = filtered
+	}
+
 	var hasUDFOverload bool
 	var calledOnNullInputFns, notCalledOnNullInputFns intsets.Fast
 	for _, idx := range s.overloadIdxs {
@@ -1397,6 +1528,19 @@ func (expr *FuncExpr) TypeCheck(
 		}
 	}
 
+	// If the call matched a copy of a routine with a VARIADIC parameter, pack
+	// the trailing arguments into an array and use the original overload.
+	typedExprs := s.typedExprs
+	if orig, ok := variadicOverloads[favoredOverload.Overload]; ok {
+		numFixed := orig.Types.Length() - 1
+		if orig.Type == ProcedureRoutine {
+			numFixed += len(orig.OutParamOrdinals)
+		}
+		typedExprs = packVariadicArgs(s.typedExprs, numFixed, orig.Types.GetAt(orig.Types.Length()-1))
+		favoredOverload.Overload = orig
+		expr.Variadic = true
+	}
+
 	// Just pick the first overload from the search path.
 	overloadImpl := favoredOverload.Overload
 	if overloadImpl.Private {
@@ -1488,17 +1632,18 @@ func (expr *FuncExpr) TypeCheck(
 		}
 	}
 
-	for i, subExpr := range s.typedExprs {
+	expr.Exprs = expr.Exprs[:len(typedExprs)]
+	for i, subExpr := range typedExprs {
 		expr.Exprs[i] = subExpr
 	}
 
-	expr.Func.FunctionReference = def
+	expr.Func.FunctionReference = resolvedDef
 	expr.fn = overloadImpl
 	expr.fnProps = &overloadImpl.FunctionProperties
-	expr.typ = overloadImpl.returnType()(s.typedExprs)
+	expr.typ = overloadImpl.returnType()(typedExprs)
 	if expr.typ == UnknownReturnType {
 		typeNames := make([]string, 0, len(expr.Exprs))
-		for _, expr := range s.typedExprs {
+		for _, expr := range typedExprs {
 			typeNames = append(typeNames, expr.ResolvedType().String())
 		}
 		return nil, pgerror.Newf(
@@ -1846,6 +1991,20 @@ func (expr *UnaryExpr) TypeCheck(
 	return expr, nil
 }
 
+// TypeCheck implements the Expr interface.
+func (expr *GroupingExpr) TypeCheck(
+	_ context.Context, _ *SemaContext, desired *types.T,
+) (TypedExpr, error) {
+	return nil, errGroupingNotAllowed
+}
+
+// TypeCheck implements the Expr interface.
+func (expr *GroupingSets) TypeCheck(
+	_ context.Context, _ *SemaContext, desired *types.T,
+) (TypedExpr, error) {
+	return nil, errGroupingSetsUsage
+}
+
 // TypeCheck implements the Expr interface.
 func (expr DefaultVal) TypeCheck(
 	_ context.Context, _ *SemaContext, desired *types.T,
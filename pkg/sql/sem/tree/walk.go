@@ -368,6 +368,28 @@ func (expr *FuncExpr) Walk(v Visitor) Expr {
 	return ret
 }
 
+// Walk implements the Expr interface.
+func (expr *GroupingExpr) Walk(v Visitor) Expr {
+	exprs, changed := walkExprSlice(v, expr.Exprs)
+	if changed {
+		exprCopy := *expr
+		exprCopy.Exprs = exprs
+		return &exprCopy
+	}
+	return expr
+}
+
+// Walk implements the Expr interface.
+func (expr *GroupingSets) Walk(v Visitor) Expr {
+	exprs, changed := walkExprSlice(v, expr.Exprs)
+	if changed {
+		exprCopy := *expr
+		exprCopy.Exprs = exprs
+		return &exprCopy
+	}
+	return expr
+}
+
 // Walk implements the Expr interface.
 func (expr *IfExpr) Walk(v Visitor) Expr {
 	c, changedC := WalkExpr(v, expr.Cond)
@@ -574,9 +596,22 @@ func (expr *Subquery) WalkTableExpr(v Visitor) TableExpr {
 // WalkTableExpr implements the TableExpr interface.
 func (expr *AliasedTableExpr) WalkTableExpr(v Visitor) TableExpr {
 	newExpr, changed := walkTableExpr(v, expr.Expr)
-	if changed {
+	sample, changedSample := expr.TableSample, false
+	if _, ok := v.(ExtendedVisitor); ok && sample != nil {
+		percent, changedPercent := WalkExpr(v, sample.Percent)
+		seed, changedSeed := sample.Seed, false
+		if seed != nil {
+			seed, changedSeed = WalkExpr(v, seed)
+		}
+		if changedPercent || changedSeed {
+			sample = &TableSample{Method: sample.Method, Percent: percent, Seed: seed}
+			changedSample = true
+		}
+	}
+	if changed || changedSample {
 		exprCopy := *expr
 		exprCopy.Expr = newExpr
+		exprCopy.TableSample = sample
 		return &exprCopy
 	}
 	return expr
@@ -87,6 +87,10 @@ type InternalExecutorOverride struct {
 	// AlwaysDistributeFullScans, if true, overrides the
 	// always_distribute_full_scans session variable.
 	AlwaysDistributeFullScans bool
+	// AllowMaterializedViewMutation, if true, allows the internal executor to
+	// mutate the data of materialized views. It is used to apply the changes of
+	// an incremental refresh.
+	AllowMaterializedViewMutation bool
 }
 
 // NoSessionDataOverride is the empty InternalExecutorOverride which does not
@@ -179,6 +179,11 @@ type LocalUnmigratableSessionData struct {
 	// AuthenticationMethod is the method used to authenticate this session.
 	AuthenticationMethod redact.SafeString
 
+	// AllowMaterializedViewMutation allows statements to mutate the data of
+	// materialized views. It is only set for the internal executor sessions
+	// that apply incremental refreshes.
+	AllowMaterializedViewMutation bool
+
 	// ////////////////////////////////////////////////////////////////////////
 	// WARNING: consider whether a session parameter you're adding needs to  //
 	// be propagated to the remote nodes or needs to persist amongst session //
@@ -88,6 +88,9 @@ func ShowCreateTable(
 	if desc.IsTemporary() {
 		f.WriteString("TEMP ")
 	}
+	if desc.IsForeignTable() {
+		f.WriteString("FOREIGN ")
+	}
 	f.WriteString("TABLE ")
 	f.FormatNode(tn)
 	f.WriteString(" (")
@@ -107,6 +110,21 @@ func ShowCreateTable(
 		f.WriteString(colstr)
 	}
 
+	if desc.IsForeignTable() {
+		foreign := desc.TableDesc().Foreign
+		f.WriteString("\n) SERVER ")
+		f.FormatName(foreign.Server)
+		f.WriteByte(' ')
+		opts := foreignTableOptions(foreign)
+		f.FormatNode(&opts)
+		if !displayOptions.IgnoreComments {
+			if err := showComments(tn, desc, selectComment(ctx, p, desc.GetID()), &f.Buffer); err != nil {
+				return "", err
+			}
+		}
+		return f.CloseAndGetString(), nil
+	}
+
 	if desc.IsPhysicalTable() {
 		f.WriteString(",\n\tCONSTRAINT ")
 		formatQuoteNames(&f.Buffer, desc.GetPrimaryIndex().GetName())
@@ -194,6 +212,9 @@ func ShowCreateTable(
 	if err := showConstraintClause(ctx, desc, p.EvalContext(), &p.semaCtx, p.SessionData(), f); err != nil {
 		return "", err
 	}
+	if err := showInheritsClause(desc, dbPrefix, lCtx, f); err != nil {
+		return "", err
+	}
 
 	if err := ShowCreatePartitioning(
 		a, p.ExecCfg().Codec, desc, desc.GetPrimaryIndex(), desc.GetPrimaryIndex().GetPartitioning(),
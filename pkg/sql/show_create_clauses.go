@@ -584,6 +584,37 @@ func showComments(
 	return nil
 }
 
+// showInheritsClause creates the INHERITS clause of a CREATE statement for a
+// table that inherits from other tables.
+func showInheritsClause(
+	desc catalog.TableDescriptor, dbPrefix string, lCtx simpleSchemaResolver, f *tree.FmtCtx,
+) error {
+	if len(desc.GetInheritsFrom()) == 0 {
+		return nil
+	}
+	f.WriteString(" INHERITS (")
+	for i, id := range desc.GetInheritsFrom() {
+		if i > 0 {
+			f.WriteString(", ")
+		}
+		if lCtx == nil {
+			f.WriteString(fmt.Sprintf("[%d as ref]", id))
+			continue
+		}
+		parent, err := lCtx.getTableByID(id)
+		if err != nil {
+			return err
+		}
+		parentName, err := getTableNameFromTableDescriptor(lCtx, parent, dbPrefix)
+		if err != nil {
+			return err
+		}
+		f.FormatNode(&parentName)
+	}
+	f.WriteString(")")
+	return nil
+}
+
 // showForeignKeyConstraint returns a valid SQL representation of a FOREIGN KEY
 // clause for a given index. If the table's schema name is in the searchPath, then the
 // schema name will not be included in the result.
@@ -644,12 +675,51 @@ func showForeignKeyConstraint(
 		buf.WriteString(" ON UPDATE ")
 		buf.WriteString(tree.ForeignKeyReferenceActionType[fk.OnUpdate].String())
 	}
+	buf.WriteString(showConstraintDeferrability(fk.Deferrable, fk.InitiallyDeferred))
 	if fk.Validity != descpb.ConstraintValidity_Validated {
 		buf.WriteString(" NOT VALID")
 	}
 	return nil
 }
 
+// showConstraintDeferrability returns the DEFERRABLE clause for a constraint,
+// or the empty string for constraints that are not deferrable.
+func showConstraintDeferrability(deferrable, initiallyDeferred bool) string {
+	d := tree.ConstraintNotDeferrable
+	if initiallyDeferred {
+		d = tree.ConstraintInitiallyDeferred
+	} else if deferrable {
+		d = tree.ConstraintInitiallyImmediate
+	}
+	return tree.AsString(d)
+}
+
+// showExclusionConstraintElems returns the EXCLUDE USING clause of an
+// exclusion constraint, listing each column with its operator in constraint
+// order.
+func showExclusionConstraintElems(
+	desc catalog.TableDescriptor, uwi *descpb.UniqueWithoutIndexConstraint,
+) (string, error) {
+	colNames, err := catalog.ColumnNamesForIDs(desc, uwi.ColumnIDs)
+	if err != nil {
+		return "", err
+	}
+	f := tree.NewFmtCtx(tree.FmtSimple)
+	f.WriteString("EXCLUDE USING ")
+	f.WriteString(uwi.ExclusionIndexMethod)
+	f.WriteString(" (")
+	for i, name := range colNames {
+		if i > 0 {
+			f.WriteString(", ")
+		}
+		f.FormatNameP(&name)
+		f.WriteString(" WITH ")
+		f.WriteString(uwi.ExclusionOperators[i])
+	}
+	f.WriteString(")")
+	return f.CloseAndGetString(), nil
+}
+
 // ShowCreateSequence returns a valid SQL representation of the
 // CREATE SEQUENCE statement used to create the given sequence.
 func ShowCreateSequence(
@@ -917,22 +987,40 @@ func showConstraintClause(
 			formatQuoteNames(&f.Buffer, c.GetName())
 			f.WriteString(" ")
 		}
-		f.WriteString("UNIQUE WITHOUT INDEX (")
-		colNames, err := catalog.ColumnNamesForIDs(desc, c.CollectKeyColumnIDs().Ordered())
-		if err != nil {
-			return err
+		uwi := c.UniqueWithoutIndexDesc()
+		if c.IsExclusion() {
+			elems, err := showExclusionConstraintElems(desc, uwi)
+			if err != nil {
+				return err
+			}
+			f.WriteString(elems)
+		} else {
+			f.WriteString("UNIQUE WITHOUT INDEX (")
+			colNames, err := catalog.ColumnNamesForIDs(desc, c.CollectKeyColumnIDs().Ordered())
+			if err != nil {
+				return err
+			}
+			f.WriteString(strings.Join(colNames, ", "))
+			f.WriteString(")")
 		}
-		f.WriteString(strings.Join(colNames, ", "))
-		f.WriteString(")")
+		f.WriteString(showConstraintDeferrability(uwi.Deferrable, uwi.InitiallyDeferred))
 		if c.IsPartial() {
-			f.WriteString(" WHERE ")
 			pred, err := schemaexpr.FormatExprForDisplay(
 				ctx, desc, c.GetPredicate(), evalCtx, semaCtx, sessionData, exprFmtFlags,
 			)
 			if err != nil {
 				return errors.Wrapf(err, "failed to format unique constraint without index for table %s", desc.GetName())
 			}
-			f.WriteString(pred)
+			// The grammar requires the predicate of an exclusion constraint to be
+			// parenthesized.
+			if c.IsExclusion() {
+				f.WriteString(" WHERE (")
+				f.WriteString(pred)
+				f.WriteString(")")
+			} else {
+				f.WriteString(" WHERE ")
+				f.WriteString(pred)
+			}
 		}
 		if !c.IsConstraintValidated() {
 			f.WriteString(" NOT VALID")
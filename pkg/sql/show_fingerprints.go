@@ -106,6 +106,18 @@ func (p *planner) ShowFingerprints(
 		return nil, err
 	}
 
+	// The fingerprint queries run as the node user, so they would include the
+	// rows hidden from the current user by row-level security policies. A
+	// fingerprint of only the visible rows could not be compared against other
+	// fingerprints of the table, so we refuse instead of applying the policies.
+	if exempt, err := p.IsExemptFromRowLevelSecurity(ctx, tableDesc); err != nil {
+		return nil, err
+	} else if !exempt {
+		return nil, pgerror.Newf(pgcode.InsufficientPrivilege,
+			"%s is not supported on table %s for users subject to its row-level security policies",
+			op, tableDesc.GetName())
+	}
+
 	return &showFingerprintsNode{
 		columns:      colinfo.ShowFingerprintsColumns,
 		tableDesc:    tableDesc,
@@ -0,0 +1,106 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/colinfo"
+	"github.com/cockroachdb/cockroach/pkg/sql/hintpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/sessiondata"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+)
+
+var showPlanBaselinesColumns = colinfo.ResultColumns{
+	{Name: "hint_id", Typ: types.Int},
+	{Name: "fingerprint", Typ: types.String},
+	{Name: "plan_gist", Typ: types.String},
+	{Name: "accepted", Typ: types.Bool},
+	{Name: "estimated_cost", Typ: types.Float},
+	{Name: "created_at", Typ: types.TimestampTZ},
+}
+
+// ShowPlanBaselines returns a SHOW PLAN BASELINES statement.
+// Privileges: None.
+func (p *planner) ShowPlanBaselines(
+	ctx context.Context, n *tree.ShowPlanBaselines,
+) (planNode, error) {
+	return &delayedNode{
+		name:    n.String(),
+		columns: showPlanBaselinesColumns,
+
+		constructor: func(ctx context.Context, p *planner) (_ planNode, err error) {
+			var fingerprint *string
+			if n.Fingerprint != nil {
+				f := n.Fingerprint.RawString()
+				fingerprint = &f
+			}
+			v := p.newContainerValuesNode(showPlanBaselinesColumns, 0 /* capacity */)
+			if err := forEachPlanBaseline(ctx, p, fingerprint, func(
+				hintID, fingerprint tree.Datum, baseline *hintpb.PlanBaseline, createdAt tree.Datum,
+			) error {
+				outRow := tree.Datums{
+					hintID,
+					fingerprint,
+					tree.NewDString(baseline.PlanGist),
+					tree.MakeDBool(tree.DBool(baseline.Accepted)),
+					tree.NewDFloat(tree.DFloat(baseline.EstimatedCost)),
+					createdAt,
+				}
+				_, err := v.rows.AddRow(ctx, outRow)
+				return err
+			}); err != nil {
+				v.Close(ctx)
+				return nil, err
+			}
+			return v, nil
+		},
+	}, nil
+}
+
+// forEachPlanBaseline calls fn for each plan baseline in
+// system.statement_hints, ordered by fingerprint and hint ID. If fingerprint is
+// non-nil, only the baselines of that statement fingerprint are visited.
+func forEachPlanBaseline(
+	ctx context.Context,
+	p *planner,
+	fingerprint *string,
+	fn func(hintID, fingerprint tree.Datum, baseline *hintpb.PlanBaseline, createdAt tree.Datum) error,
+) error {
+	query := `SELECT "row_id", "fingerprint", "hint", "created_at" FROM system.statement_hints`
+	var args []interface{}
+	if fingerprint != nil {
+		query += ` WHERE "hash" = fnv64($1) AND "fingerprint" = $1`
+		args = append(args, *fingerprint)
+	}
+	query += ` ORDER BY "fingerprint", "row_id"`
+	rows, err := p.InternalSQLTxn().QueryBufferedEx(
+		ctx,
+		"read-plan-baselines",
+		p.txn,
+		sessiondata.NodeUserSessionDataOverride,
+		query,
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		hint, err := hintpb.FromBytes([]byte(tree.MustBeDBytes(row[2])))
+		if err != nil {
+			return err
+		}
+		baseline, ok := hint.GetValue().(*hintpb.PlanBaseline)
+		if !ok {
+			continue
+		}
+		if err := fn(row[0], row[1], baseline, row[3]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
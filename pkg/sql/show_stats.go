@@ -8,6 +8,7 @@ package sql
 import (
 	"context"
 	encjson "encoding/json"
+	"fmt"
 	"sort"
 	"time"
 
@@ -96,7 +97,7 @@ func (p *planner) ShowTableStats(ctx context.Context, n *tree.ShowTableStats) (p
 			//    "handle" which can be used with SHOW HISTOGRAM.
 			// TODO(yuzefovich): refactor the code to use the iterator API
 			// (currently it is not possible due to a panic-catcher below).
-			stmt := `SELECT
+			stmt := fmt.Sprintf(`SELECT
 							"tableID",
 							"statisticID",
 							name,
@@ -108,10 +109,13 @@ func (p *planner) ShowTableStats(ctx context.Context, n *tree.ShowTableStats) (p
 							"avgSize",
 							"partialPredicate",
 							histogram,
-							"fullStatisticID"
+							"fullStatisticID",
+							%s
 						FROM system.table_statistics
 						WHERE "tableID" = $1
-						ORDER BY "createdAt", "columnIDs", "statisticID"`
+						ORDER BY "createdAt", "columnIDs", "statisticID"`,
+				stats.DependencyDegreesColumn(ctx, p.ExecCfg().Settings),
+			)
 
 			// There is a privilege check above to make sure the user has any
 			// privilege on the table being inspected. We use the node user to execute
@@ -142,6 +146,7 @@ func (p *planner) ShowTableStats(ctx context.Context, n *tree.ShowTableStats) (p
 				partialPredicateIdx
 				histogramIdx
 				fullStatisticIDIdx
+				dependencyDegreesIdx
 				numCols
 			)
 
@@ -266,6 +271,13 @@ func (p *planner) ShowTableStats(ctx context.Context, n *tree.ShowTableStats) (p
 						statsRow.PartialPredicate = string(*r[partialPredicateIdx].(*tree.DString))
 						statsRow.FullStatisticID = (uint64)(*r[fullStatisticIDIdx].(*tree.DInt))
 					}
+					if r[dependencyDegreesIdx] != tree.DNull {
+						degrees := r[dependencyDegreesIdx].(*tree.DArray).Array
+						statsRow.DependencyDegrees = make([]float64, len(degrees))
+						for j, d := range degrees {
+							statsRow.DependencyDegrees[j] = float64(*d.(*tree.DFloat))
+						}
+					}
 					if err := statsRow.DecodeAndSetHistogram(ctx, &p.semaCtx, r[histIdx]); err != nil {
 						v.Close(ctx)
 						return nil, err
@@ -398,5 +410,17 @@ func tableStatisticProtoToRow(stat *stats.TableStatisticProto) (tree.Datums, err
 		row = append(row, tree.NewDBytes(tree.DBytes(histogram)))
 	}
 	row = append(row, FullStatisticID)
+
+	dependencyDegrees := tree.DNull
+	if len(stat.DependencyDegrees) > 0 {
+		degrees := tree.NewDArray(types.Float)
+		for _, d := range stat.DependencyDegrees {
+			if err := degrees.Append(tree.NewDFloat(tree.DFloat(d))); err != nil {
+				return nil, err
+			}
+		}
+		dependencyDegrees = degrees
+	}
+	row = append(row, dependencyDegrees)
 	return row, nil
 }
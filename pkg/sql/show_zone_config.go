@@ -9,6 +9,7 @@ import (
 	"bytes"
 	"context"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/pkg/config/zonepb"
 	"github.com/cockroachdb/cockroach/pkg/keys"
@@ -18,6 +19,7 @@ import (
 	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
 	"github.com/cockroachdb/cockroach/pkg/sql/sqlerrors"
 	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/cockroach/pkg/util/duration"
 	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
 	"github.com/cockroachdb/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -205,6 +207,11 @@ func zoneConfigToSQL(zs *tree.ZoneSpecifier, zone *zonepb.ZoneConfig) (tree.Datu
 		maybeWriteComma(f)
 		f.Printf("\tgc.ttlseconds = %d", zone.GC.TTLSeconds)
 	}
+	if zone.ColdStorageAfterSeconds != nil && *zone.ColdStorageAfterSeconds > 0 {
+		maybeWriteComma(f)
+		d := duration.MakeDuration(int64(*zone.ColdStorageAfterSeconds)*int64(time.Second), 0, 0)
+		f.Printf("\tcold_storage_after = %s", lexbase.EscapeSQLString(d.String()))
+	}
 	if zone.GlobalReads != nil {
 		maybeWriteComma(f)
 		f.Printf("\tglobal_reads = %t", *zone.GlobalReads)
@@ -217,6 +224,10 @@ func zoneConfigToSQL(zs *tree.ZoneSpecifier, zone *zonepb.ZoneConfig) (tree.Datu
 		maybeWriteComma(f)
 		f.Printf("\tnum_voters = %d", *zone.NumVoters)
 	}
+	if zone.NumWitnesses != nil && *zone.NumWitnesses > 0 {
+		maybeWriteComma(f)
+		f.Printf("\tnum_witnesses = %d", *zone.NumWitnesses)
+	}
 	if !zone.InheritedConstraints {
 		maybeWriteComma(f)
 		f.Printf("\tconstraints = %s", lexbase.EscapeSQLString(constraints))
@@ -0,0 +1,93 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package stats
+
+import (
+	"context"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+)
+
+// DependencyDegrees estimates the degrees of functional dependency between the
+// given columns of the sampled rows. The ith degree is the fraction of the
+// sampled rows with a non-NULL value in column colIdxs[i] that belong to a group
+// of rows with that value which all have the same values in the other columns.
+// A degree of 1 means that the column functionally determines the other
+// columns in the sample.
+//
+// This is the same measure as the "dependencies" extended statistics of
+// Postgres, except that each column is tested as the determinant of all other
+// columns together.
+func DependencyDegrees(
+	ctx context.Context, compareCtx tree.CompareContext, samples []SampledRow, colIdxs []int,
+) ([]float64, error) {
+	degrees := make([]float64, len(colIdxs))
+	rows := make([]tree.Datums, 0, len(samples))
+	for i, determinant := range colIdxs {
+		// Collect the rows with a non-NULL value in the determinant column, with
+		// the determinant first.
+		rows = rows[:0]
+		for _, sample := range samples {
+			d := sample.Row[determinant].Datum
+			if d == nil || d == tree.DNull {
+				continue
+			}
+			row := make(tree.Datums, 0, len(colIdxs))
+			row = append(row, d)
+			for j, c := range colIdxs {
+				if j != i {
+					row = append(row, sample.Row[c].Datum)
+				}
+			}
+			rows = append(rows, row)
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		// Sort the rows, so that the rows of each group are adjacent, and the
+		// rows of a group agree on the other columns if and only if the first
+		// and last rows of the group do.
+		var err error
+		compareRows := func(a, b tree.Datums, cols int) int {
+			for k := 0; k < cols; k++ {
+				c, cmpErr := a[k].Compare(ctx, compareCtx, b[k])
+				if cmpErr != nil {
+					err = cmpErr
+					return 0
+				}
+				if c != 0 {
+					return c
+				}
+			}
+			return 0
+		}
+		sort.Slice(rows, func(a, b int) bool {
+			return compareRows(rows[a], rows[b], len(colIdxs)) < 0
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var determined int
+		for start := 0; start < len(rows); {
+			end := start + 1
+			for end < len(rows) && compareRows(rows[start], rows[end], 1 /* cols */) == 0 {
+				end++
+			}
+			if compareRows(rows[start], rows[end-1], len(colIdxs)) == 0 {
+				determined += end - start
+			}
+			start = end
+		}
+		if err != nil {
+			return nil, err
+		}
+		degrees[i] = float64(determined) / float64(len(rows))
+	}
+	return degrees, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package stats
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/eval"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDependencyDegrees(t *testing.T) {
+	ctx := context.Background()
+	evalCtx := eval.MakeTestingEvalContext(cluster.MakeTestingClusterSettings())
+
+	makeSamples := func(rows [][]tree.Datum) []SampledRow {
+		samples := make([]SampledRow, len(rows))
+		for i, row := range rows {
+			samples[i].Row = make(rowenc.EncDatumRow, len(row))
+			for j, d := range row {
+				samples[i].Row[j] = rowenc.EncDatum{Datum: d}
+			}
+		}
+		return samples
+	}
+	i := func(v int) tree.Datum { return tree.NewDInt(tree.DInt(v)) }
+	s := func(v string) tree.Datum { return tree.NewDString(v) }
+
+	testCases := []struct {
+		name     string
+		rows     [][]tree.Datum
+		colIdxs  []int
+		expected []float64
+	}{
+		{
+			name: "zip determines city",
+			// Columns are (zip, city). Each zip has a single city, but city A has
+			// two zips.
+			rows: [][]tree.Datum{
+				{i(1), s("A")},
+				{i(2), s("A")},
+				{i(3), s("B")},
+				{i(3), s("B")},
+				{i(4), s("C")},
+			},
+			colIdxs:  []int{0, 1},
+			expected: []float64{1, 0.6},
+		},
+		{
+			name: "independent",
+			rows: [][]tree.Datum{
+				{i(1), i(1)},
+				{i(1), i(2)},
+				{i(2), i(1)},
+				{i(2), i(2)},
+			},
+			colIdxs:  []int{0, 1},
+			expected: []float64{0, 0},
+		},
+		{
+			name: "nulls in the determinant are ignored",
+			rows: [][]tree.Datum{
+				{tree.DNull, i(1)},
+				{tree.DNull, i(2)},
+				{i(1), i(1)},
+				{i(1), i(1)},
+			},
+			colIdxs:  []int{0, 1},
+			expected: []float64{1, 1},
+		},
+		{
+			name: "subset of columns",
+			// Columns are (a, b, c), and (a, c) are tested. Column b is ignored.
+			rows: [][]tree.Datum{
+				{i(1), i(1), i(1)},
+				{i(1), i(2), i(1)},
+				{i(2), i(3), i(2)},
+				{i(3), i(4), i(2)},
+			},
+			colIdxs:  []int{2, 0},
+			expected: []float64{0.5, 1},
+		},
+		{
+			name:     "no rows",
+			colIdxs:  []int{0, 1},
+			expected: []float64{0, 0},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			degrees, err := DependencyDegrees(ctx, &evalCtx, makeSamples(tc.rows), tc.colIdxs)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, degrees)
+		})
+	}
+}
@@ -7,7 +7,9 @@ package stats
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/settings/cluster"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/isql"
@@ -37,6 +39,7 @@ func InsertNewStats(
 			statistic.HistogramData,
 			statistic.PartialPredicate,
 			statistic.FullStatisticID,
+			statistic.DependencyDegrees,
 		)
 		if err != nil {
 			return err
@@ -60,6 +63,7 @@ func InsertNewStat(
 	h *HistogramData,
 	partialPredicate string,
 	fullStatisticID uint64,
+	dependencyDegrees []float64,
 ) error {
 	// We must pass a nil interface{} if we want to insert a NULL.
 	var nameVal, histogramVal interface{}
@@ -88,9 +92,36 @@ func InsertNewStat(
 		predicateValue = partialPredicate
 	}
 
+	args := []interface{}{
+		tableID,
+		nameVal,
+		columnIDsVal,
+		rowCount,
+		distinctCount,
+		nullCount,
+		avgSize,
+		histogramVal,
+		predicateValue,
+		fullStatisticID,
+	}
+	// The dependencyDegrees column only exists once the cluster has been
+	// upgraded, so it is only written if there are degrees to store.
+	var degreesCol, degreesPlaceholder string
+	if len(dependencyDegrees) > 0 &&
+		settings.Version.IsActive(ctx, clusterversion.V26_1_TableStatisticsDependencyDegrees) {
+		degreesVal := tree.NewDArray(types.Float)
+		for _, d := range dependencyDegrees {
+			if err := degreesVal.Append(tree.NewDFloat(tree.DFloat(d))); err != nil {
+				return err
+			}
+		}
+		args = append(args, degreesVal)
+		degreesCol, degreesPlaceholder = `, "dependencyDegrees"`, fmt.Sprintf(", $%d", len(args))
+	}
+
 	_, err := txn.Exec(
 		ctx, "insert-statistic", txn.KV(),
-		`INSERT INTO system.table_statistics (
+		fmt.Sprintf(`INSERT INTO system.table_statistics (
 					"tableID",
 					"name",
 					"columnIDs",
@@ -100,18 +131,9 @@ func InsertNewStat(
 					"avgSize",
 					histogram,
 					"partialPredicate",
-					"fullStatisticID"
-				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
-		tableID,
-		nameVal,
-		columnIDsVal,
-		rowCount,
-		distinctCount,
-		nullCount,
-		avgSize,
-		histogramVal,
-		predicateValue,
-		fullStatisticID,
+					"fullStatisticID"%s
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10%s)`, degreesCol, degreesPlaceholder),
+		args...,
 	)
 	return err
 }
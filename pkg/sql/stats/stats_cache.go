@@ -13,6 +13,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
 	"github.com/cockroachdb/cockroach/pkg/jobs/jobspb"
 	"github.com/cockroachdb/cockroach/pkg/keys"
 	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed"
@@ -255,9 +256,9 @@ func (sc *TableStatisticsCache) GetTableStats(
 //
 // The statistics are ordered by their CreatedAt time (newest-to-oldest).
 func GetTableStatsProtosFromDB(
-	ctx context.Context, table catalog.TableDescriptor, executor isql.Executor,
+	ctx context.Context, st *cluster.Settings, table catalog.TableDescriptor, executor isql.Executor,
 ) (statsProtos []*TableStatisticProto, err error) {
-	return getTableStatsProtosFromDB(ctx, table.GetID(), executor)
+	return getTableStatsProtosFromDB(ctx, st, table.GetID(), executor)
 }
 
 // DisallowedOnSystemTable returns true if this tableID belongs to a special
@@ -326,6 +327,11 @@ func tableTypeCanHaveStats(table catalog.TableDescriptor) bool {
 		// Don't try to get statistics for virtual tables.
 		return false
 	}
+	if table.IsForeignTable() {
+		// Don't try to get statistics for foreign tables, whose data lives
+		// outside of the cluster.
+		return false
+	}
 	if table.IsView() {
 		// Don't try to get statistics for views.
 		return false
@@ -577,6 +583,7 @@ const (
 	partialPredicateIndex
 	histogramIndex
 	fullStatisticsIdIndex
+	dependencyDegreesIndex
 	statsLen
 )
 
@@ -614,6 +621,7 @@ func NewTableStatisticProto(datums tree.Datums) (*TableStatisticProto, error) {
 		{"partialPredicate", partialPredicateIndex, types.String, true},
 		{"histogram", hgIndex, types.Bytes, true},
 		{"fullStatisticID", fullStatisticsIdIndex, types.Int, true},
+		{"dependencyDegrees", dependencyDegreesIndex, types.FloatArray, true},
 	}
 
 	for _, v := range expectedTypes {
@@ -648,6 +656,13 @@ func NewTableStatisticProto(datums tree.Datums) (*TableStatisticProto, error) {
 	if datums[fullStatisticsIdIndex] != tree.DNull {
 		res.FullStatisticID = uint64(*datums[fullStatisticsIdIndex].(*tree.DInt))
 	}
+	if datums[dependencyDegreesIndex] != tree.DNull {
+		degrees := datums[dependencyDegreesIndex].(*tree.DArray)
+		res.DependencyDegrees = make([]float64, len(degrees.Array))
+		for i, d := range degrees.Array {
+			res.DependencyDegrees[i] = float64(*d.(*tree.DFloat))
+		}
+	}
 	if datums[hgIndex] != tree.DNull {
 		res.HistogramData = &HistogramData{}
 		if err := protoutil.Unmarshal(
@@ -975,12 +990,23 @@ SELECT
 	"avgSize",
 	"partialPredicate",
 	histogram,
-	"fullStatisticID"
+	"fullStatisticID",
+	%s
 FROM system.table_statistics
 WHERE "tableID" = $1
 ORDER BY "createdAt" DESC, "columnIDs" DESC, "statisticID" DESC
 `
 
+// DependencyDegreesColumn returns the expression used to read the
+// dependencyDegrees column of system.table_statistics, which is NULL until the
+// column has been added to the table.
+func DependencyDegreesColumn(ctx context.Context, st *cluster.Settings) string {
+	if !st.Version.IsActive(ctx, clusterversion.V26_1_TableStatisticsDependencyDegrees) {
+		return `NULL::FLOAT8[]`
+	}
+	return `"dependencyDegrees"`
+}
+
 // getTableStatsFromDB retrieves the statistics in system.table_statistics
 // for the given table ID.
 //
@@ -994,7 +1020,8 @@ func (sc *TableStatisticsCache) getTableStatsFromDB(
 	typeResolver *descs.DistSQLTypeResolver,
 ) (_ []*TableStatistic, _ map[descpb.ColumnID]*types.T, err error) {
 	it, err := sc.db.Executor().QueryIteratorEx(
-		ctx, "get-table-statistics", nil /* txn */, sessiondata.NodeUserSessionDataOverride, getTableStatisticsStmt, tableID,
+		ctx, "get-table-statistics", nil /* txn */, sessiondata.NodeUserSessionDataOverride,
+		fmt.Sprintf(getTableStatisticsStmt, DependencyDegreesColumn(ctx, st)), tableID,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -1069,10 +1096,11 @@ func (sc *TableStatisticsCache) getTableStatsFromDB(
 // It ignores any statistics that cannot be decoded (e.g. because of a
 // user-defined type that doesn't exist) and returns the rest (with no error).
 func getTableStatsProtosFromDB(
-	ctx context.Context, tableID descpb.ID, executor isql.Executor,
+	ctx context.Context, st *cluster.Settings, tableID descpb.ID, executor isql.Executor,
 ) (statsProtos []*TableStatisticProto, err error) {
 	it, err := executor.QueryIteratorEx(
-		ctx, "get-table-statistics-protos", nil /* txn */, sessiondata.NodeUserSessionDataOverride, getTableStatisticsStmt, tableID,
+		ctx, "get-table-statistics-protos", nil /* txn */, sessiondata.NodeUserSessionDataOverride,
+		fmt.Sprintf(getTableStatisticsStmt, DependencyDegreesColumn(ctx, st)), tableID,
 	)
 	if err != nil {
 		return nil, err
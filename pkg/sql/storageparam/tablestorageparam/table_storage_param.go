@@ -13,10 +13,12 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/catpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
 	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
 	"github.com/cockroachdb/cockroach/pkg/sql/paramparse"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
 	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgnotice"
@@ -600,6 +602,26 @@ var tableParams = map[string]tableParam{
 			return nil
 		},
 	},
+	`sql_stats_column_groups`: {
+		onSet: func(
+			ctx context.Context, po *Setter, semaCtx *tree.SemaContext, evalCtx *eval.Context, key string, datum tree.Datum,
+		) error {
+			stringVal, err := paramparse.DatumAsString(ctx, evalCtx, key, datum)
+			if err != nil {
+				return err
+			}
+			groups, err := parseStatsColumnGroups(po.TableDesc, stringVal)
+			if err != nil {
+				return pgerror.Wrapf(err, pgcode.InvalidParameterValue, "invalid value for %s", key)
+			}
+			po.TableDesc.StatsColumnGroups = groups
+			return nil
+		},
+		onReset: func(_ context.Context, po *Setter, evalCtx *eval.Context, key string) error {
+			po.TableDesc.StatsColumnGroups = nil
+			return nil
+		},
+	},
 	`schema_locked`: {
 		onSet: func(ctx context.Context, po *Setter, semaCtx *tree.SemaContext, evalCtx *eval.Context, key string, datum tree.Datum) error {
 			boolVal, err := boolFromDatum(ctx, evalCtx, key, datum)
@@ -619,6 +641,20 @@ var tableParams = map[string]tableParam{
 			return nil
 		},
 	},
+	`lock_table_blocks_dml`: {
+		onSet: func(ctx context.Context, po *Setter, semaCtx *tree.SemaContext, evalCtx *eval.Context, key string, datum tree.Datum) error {
+			boolVal, err := boolFromDatum(ctx, evalCtx, key, datum)
+			if err != nil {
+				return err
+			}
+			po.TableDesc.LockTableBlocksDML = boolVal
+			return nil
+		},
+		onReset: func(_ context.Context, po *Setter, evalCtx *eval.Context, key string) error {
+			po.TableDesc.LockTableBlocksDML = false
+			return nil
+		},
+	},
 	catpb.RBRUsingConstraintTableSettingName: {
 		onSet: func(ctx context.Context, po *Setter, semaCtx *tree.SemaContext, evalCtx *eval.Context, key string, datum tree.Datum) error {
 			// Handled by the schema changer.
@@ -643,6 +679,44 @@ func nonNegativeIntWithMaximum(max int64) func(int64) error {
 	}
 }
 
+// parseStatsColumnGroups parses a list of groups of columns of the given
+// table, such as "(a, b), (b, c, d)". Each group must contain at least two
+// distinct columns.
+func parseStatsColumnGroups(
+	desc catalog.TableDescriptor, s string,
+) ([]descpb.StatsColumnGroup, error) {
+	exprs, err := parser.ParseExprs([]string{s})
+	if err != nil {
+		return nil, err
+	}
+	groups := make([]descpb.StatsColumnGroup, 0, len(exprs))
+	for _, expr := range exprs {
+		tuple, ok := expr.(*tree.Tuple)
+		if !ok || len(tuple.Exprs) < 2 {
+			return nil, errors.Newf("expected a parenthesized list of at least two columns, found %s", expr)
+		}
+		var group descpb.StatsColumnGroup
+		var seen catalog.TableColSet
+		for _, e := range tuple.Exprs {
+			name, ok := e.(*tree.UnresolvedName)
+			if !ok || name.NumParts != 1 {
+				return nil, errors.Newf("expected a column name, found %s", e)
+			}
+			col, err := catalog.MustFindColumnByName(desc, name.Parts[0])
+			if err != nil {
+				return nil, err
+			}
+			if seen.Contains(col.GetID()) {
+				return nil, errors.Newf("column %q is specified more than once", col.GetName())
+			}
+			seen.Add(col.GetID())
+			group.ColumnIDs = append(group.ColumnIDs, col.GetID())
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
 func init() {
 	for _, param := range []string{
 		`toast_tuple_target`,
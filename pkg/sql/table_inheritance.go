@@ -0,0 +1,488 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/tabledesc"
+	"github.com/cockroachdb/cockroach/pkg/sql/parser"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+	"github.com/cockroachdb/redact"
+)
+
+// expandInheritedTableDefs returns the table definitions of a CREATE TABLE ...
+// INHERITS statement, with the columns and CHECK constraints of the parent
+// tables added to the definitions of n, along with the resolved parent tables.
+// Inherited columns come first, in the order of the parents. Columns with the
+// same name are merged into one, which is only allowed if their types match.
+// If n has no INHERITS clause, expandInheritedTableDefs returns nil.
+func expandInheritedTableDefs(
+	params runParams, n *tree.CreateTable,
+) (tree.TableDefs, []*tabledesc.Mutable, error) {
+	if len(n.Inherits) == 0 {
+		return nil, nil, nil
+	}
+	if n.Persistence.IsTemporary() {
+		return nil, nil, pgerror.New(pgcode.FeatureNotSupported,
+			"inheritance is not supported for temporary tables")
+	}
+	parents := make([]*tabledesc.Mutable, 0, len(n.Inherits))
+	var newDefs tree.TableDefs
+	inheritedCols := make(map[tree.Name]int)
+	var checks tree.TableDefs
+	checkExprs := make(map[string]string)
+	for i := range n.Inherits {
+		parent, err := params.p.resolveInheritanceParent(params.ctx, &n.Inherits[i])
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, other := range parents {
+			if other.ID == parent.ID {
+				return nil, nil, pgerror.Newf(pgcode.DuplicateTable,
+					"relation %q would be inherited from more than once", parent.Name)
+			}
+		}
+		parents = append(parents, parent)
+
+		for j := range parent.Columns {
+			c := &parent.Columns[j]
+			implicit, err := isImplicitlyCreatedBySystem(parent, c)
+			if err != nil {
+				return nil, nil, err
+			}
+			if implicit {
+				continue
+			}
+			if idx, ok := inheritedCols[tree.Name(c.Name)]; ok {
+				prev := newDefs[idx].(*tree.ColumnTableDef)
+				prevType := prev.Type.(*types.T)
+				if !prevType.Identical(c.Type) {
+					return nil, nil, errors.WithDetailf(
+						pgerror.Newf(pgcode.DatatypeMismatch,
+							"inherited column %q has a type conflict", c.Name),
+						"%s versus %s", prevType.SQLString(), c.Type.SQLString())
+				}
+				if !c.Nullable {
+					prev.Nullable.Nullability = tree.NotNull
+				}
+				continue
+			}
+			def, err := inheritedColumnDef(c)
+			if err != nil {
+				return nil, nil, err
+			}
+			inheritedCols[def.Name] = len(newDefs)
+			newDefs = append(newDefs, def)
+		}
+
+		for _, c := range parent.Checks {
+			if c.FromHashShardedColumn || c.Validity != descpb.ConstraintValidity_Validated {
+				continue
+			}
+			if expr, ok := checkExprs[c.Name]; ok {
+				if expr != c.Expr {
+					return nil, nil, pgerror.Newf(pgcode.DuplicateObject,
+						"check constraint name %q appears multiple times but with different expressions",
+						c.Name)
+				}
+				continue
+			}
+			checkExprs[c.Name] = c.Expr
+			def := &tree.CheckConstraintTableDef{Name: tree.Name(c.Name)}
+			def.Expr, err = parser.ParseExpr(c.Expr)
+			if err != nil {
+				return nil, nil, err
+			}
+			checks = append(checks, def)
+		}
+	}
+
+	// Merge locally defined columns into the inherited columns with the same
+	// name. Locally defined check constraints take the place of the inherited
+	// check constraints with the same name.
+	localChecks := make(map[tree.Name]struct{})
+	for _, def := range n.Defs {
+		d, ok := def.(*tree.ColumnTableDef)
+		if !ok {
+			if c, ok := def.(*tree.CheckConstraintTableDef); ok && c.Name != "" {
+				localChecks[c.Name] = struct{}{}
+			}
+			newDefs = append(newDefs, def)
+			continue
+		}
+		idx, ok := inheritedCols[d.Name]
+		if !ok {
+			newDefs = append(newDefs, def)
+			continue
+		}
+		prev := newDefs[idx].(*tree.ColumnTableDef)
+		prevType := prev.Type.(*types.T)
+		typ, err := tree.ResolveType(params.ctx, d.Type, params.p.semaCtx.GetTypeResolver())
+		if err != nil {
+			return nil, nil, err
+		}
+		if !typ.Identical(prevType) {
+			return nil, nil, errors.WithDetailf(
+				pgerror.Newf(pgcode.DatatypeMismatch, "column %q has a type conflict", d.Name),
+				"%s versus %s", prevType.SQLString(), typ.SQLString())
+		}
+		merged := *d
+		if prev.Nullable.Nullability == tree.NotNull {
+			merged.Nullable.Nullability = tree.NotNull
+		}
+		if merged.DefaultExpr.Expr == nil {
+			merged.DefaultExpr = prev.DefaultExpr
+		}
+		newDefs[idx] = &merged
+	}
+	for _, def := range checks {
+		if _, ok := localChecks[def.(*tree.CheckConstraintTableDef).Name]; !ok {
+			newDefs = append(newDefs, def)
+		}
+	}
+	return newDefs, parents, nil
+}
+
+// inheritedColumnDef returns the definition of a column inherited from the
+// given parent table column.
+func inheritedColumnDef(c *descpb.ColumnDescriptor) (*tree.ColumnTableDef, error) {
+	def := &tree.ColumnTableDef{
+		Name:   tree.Name(c.Name),
+		Type:   c.Type,
+		Hidden: c.Hidden,
+	}
+	if c.Nullable {
+		def.Nullable.Nullability = tree.Null
+	} else {
+		def.Nullable.Nullability = tree.NotNull
+	}
+	var err error
+	if c.DefaultExpr != nil {
+		if def.DefaultExpr.Expr, err = parser.ParseExpr(*c.DefaultExpr); err != nil {
+			return nil, err
+		}
+	}
+	if c.ComputeExpr != nil {
+		def.Computed.Computed = true
+		def.Computed.Virtual = c.Virtual
+		if def.Computed.Expr, err = parser.ParseExpr(*c.ComputeExpr); err != nil {
+			return nil, err
+		}
+	}
+	if c.OnUpdateExpr != nil {
+		if def.OnUpdateExpr.Expr, err = parser.ParseExpr(*c.OnUpdateExpr); err != nil {
+			return nil, err
+		}
+	}
+	return def, nil
+}
+
+// resolveInheritanceParent resolves a table that another table is about to
+// inherit from, and checks that the current user is allowed to do so.
+func (p *planner) resolveInheritanceParent(
+	ctx context.Context, name *tree.TableName,
+) (*tabledesc.Mutable, error) {
+	_, parent, err := p.ResolveMutableTableDescriptor(ctx, name, true /* required */, tree.ResolveRequireTableDesc)
+	if err != nil {
+		return nil, err
+	}
+	if parent.IsTemporary() {
+		return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+			"cannot inherit from temporary relation %q", parent.Name)
+	}
+	if parent.IsForeignTable() {
+		return nil, pgerror.Newf(pgcode.WrongObjectType,
+			"cannot inherit from foreign table %q", parent.Name)
+	}
+	hasOwnership, err := p.HasOwnership(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	if !hasOwnership {
+		return nil, pgerror.Newf(pgcode.InsufficientPrivilege,
+			"must be owner of table %s", parent.Name)
+	}
+	return parent, nil
+}
+
+// addInheritanceLink records that child inherits from parent.
+func addInheritanceLink(child, parent *tabledesc.Mutable) {
+	child.InheritsFrom = append(child.InheritsFrom, parent.ID)
+	parent.InheritedBy = append(parent.InheritedBy, child.ID)
+}
+
+// removeInheritanceLink removes the record that child inherits from parent. It
+// returns false if child does not inherit from parent.
+func removeInheritanceLink(child, parent *tabledesc.Mutable) bool {
+	found := false
+	child.InheritsFrom, found = removeInheritanceID(child.InheritsFrom, parent.ID)
+	parent.InheritedBy, _ = removeInheritanceID(parent.InheritedBy, child.ID)
+	return found
+}
+
+func removeInheritanceID(ids []descpb.ID, id descpb.ID) ([]descpb.ID, bool) {
+	for i := range ids {
+		if ids[i] == id {
+			return append(ids[:i:i], ids[i+1:]...), true
+		}
+	}
+	return ids, false
+}
+
+// alterTableInherit implements ALTER TABLE ... [NO] INHERIT. Like in Postgres,
+// a table can only start inheriting from a parent if it already has all of the
+// parent's columns and CHECK constraints; no columns or constraints are added
+// or removed by either command.
+func (p *planner) alterTableInherit(
+	ctx context.Context, child *tabledesc.Mutable, n *tree.AlterTableInherit,
+) error {
+	parentName := n.Parent.ToTableName()
+	if n.NoInherit {
+		_, parent, err := p.ResolveMutableTableDescriptor(ctx, &parentName, true /* required */, tree.ResolveRequireTableDesc)
+		if err != nil {
+			return err
+		}
+		if !removeInheritanceLink(child, parent) {
+			return pgerror.Newf(pgcode.UndefinedTable,
+				"relation %q is not a parent of relation %q", parent.Name, child.Name)
+		}
+		return p.writeSchemaChange(ctx, parent, descpb.InvalidMutationID,
+			fmt.Sprintf("updating table %q after table %q stopped inheriting from it",
+				parent.Name, child.Name))
+	}
+
+	if child.IsTemporary() {
+		return pgerror.New(pgcode.FeatureNotSupported,
+			"inheritance is not supported for temporary tables")
+	}
+	parent, err := p.resolveInheritanceParent(ctx, &parentName)
+	if err != nil {
+		return err
+	}
+	if parent.ID == child.ID {
+		return pgerror.Newf(pgcode.WrongObjectType,
+			"circular inheritance not allowed: %q would inherit from itself", child.Name)
+	}
+	for _, id := range child.InheritsFrom {
+		if id == parent.ID {
+			return pgerror.Newf(pgcode.DuplicateTable,
+				"relation %q would be inherited from more than once", parent.Name)
+		}
+	}
+	if isDescendant, err := p.inheritsFrom(ctx, parent, child.ID); err != nil {
+		return err
+	} else if isDescendant {
+		return errors.WithDetailf(
+			pgerror.New(pgcode.DuplicateTable, "circular inheritance not allowed"),
+			"%q is already a child of %q.", parent.Name, child.Name)
+	}
+
+	// The child must already have all the parent's columns and constraints.
+	for i := range parent.Columns {
+		c := &parent.Columns[i]
+		implicit, err := isImplicitlyCreatedBySystem(parent, c)
+		if err != nil {
+			return err
+		}
+		if implicit {
+			continue
+		}
+		childCol := catalog.FindColumnByName(child, c.Name)
+		if childCol == nil || !childCol.Public() {
+			return pgerror.Newf(pgcode.DatatypeMismatch,
+				"child table is missing column %q", c.Name)
+		}
+		if !childCol.GetType().Identical(c.Type) {
+			return pgerror.Newf(pgcode.DatatypeMismatch,
+				"child table %q has different type for column %q", child.Name, c.Name)
+		}
+		if !c.Nullable && childCol.IsNullable() {
+			return pgerror.Newf(pgcode.DatatypeMismatch,
+				"column %q in child table must be marked NOT NULL", c.Name)
+		}
+	}
+	for _, c := range parent.Checks {
+		if c.FromHashShardedColumn || c.Validity != descpb.ConstraintValidity_Validated {
+			continue
+		}
+		var childCheck *descpb.TableDescriptor_CheckConstraint
+		for _, cc := range child.Checks {
+			if cc.Name == c.Name {
+				childCheck = cc
+				break
+			}
+		}
+		if childCheck == nil {
+			return pgerror.Newf(pgcode.DatatypeMismatch,
+				"child table is missing constraint %q", c.Name)
+		}
+		if childCheck.Expr != c.Expr {
+			return pgerror.Newf(pgcode.DatatypeMismatch,
+				"child table %q has different definition for check constraint %q",
+				child.Name, c.Name)
+		}
+	}
+
+	addInheritanceLink(child, parent)
+	return p.writeSchemaChange(ctx, parent, descpb.InvalidMutationID,
+		fmt.Sprintf("updating table %q after table %q started inheriting from it",
+			parent.Name, child.Name))
+}
+
+// inheritsFrom returns whether the given table inherits, directly or
+// indirectly, from the table with the given ID.
+func (p *planner) inheritsFrom(
+	ctx context.Context, table catalog.TableDescriptor, ancestorID descpb.ID,
+) (bool, error) {
+	visited := make(map[descpb.ID]struct{})
+	toVisit := append([]descpb.ID(nil), table.GetInheritsFrom()...)
+	for len(toVisit) > 0 {
+		id := toVisit[0]
+		toVisit = toVisit[1:]
+		if id == ancestorID {
+			return true, nil
+		}
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+		parent, err := p.Descriptors().MutableByID(p.txn).Table(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		toVisit = append(toVisit, parent.InheritsFrom...)
+	}
+	return false, nil
+}
+
+// canDropInheritedTable returns an error if the given table has inheriting
+// tables that would be left behind by a DROP without CASCADE.
+func (p *planner) canDropInheritedTable(
+	ctx context.Context,
+	tableDesc *tabledesc.Mutable,
+	dropped map[descpb.ID]toDelete,
+	behavior tree.DropBehavior,
+) error {
+	if behavior == tree.DropCascade {
+		return nil
+	}
+	for _, id := range tableDesc.InheritedBy {
+		if _, ok := dropped[id]; ok {
+			continue
+		}
+		child, err := p.Descriptors().MutableByID(p.txn).Table(ctx, id)
+		if err != nil {
+			return err
+		}
+		return errors.WithHint(
+			errors.WithDetailf(
+				pgerror.Newf(pgcode.DependentObjectsStillExist,
+					"cannot drop table %s because other objects depend on it", tableDesc.Name),
+				"table %s inherits from table %s", child.Name, tableDesc.Name),
+			"use CASCADE if you really want to drop it.")
+	}
+	return nil
+}
+
+// dropInheritingTables drops the tables that inherit from the given table,
+// which is being dropped with CASCADE. It returns the names of the views that
+// were dropped along with them.
+func (p *planner) dropInheritingTables(
+	ctx context.Context,
+	tableDesc *tabledesc.Mutable,
+	droppingParent bool,
+	jobDesc string,
+	behavior tree.DropBehavior,
+) ([]string, error) {
+	var droppedViews []string
+	inheritedBy := append([]descpb.ID(nil), tableDesc.InheritedBy...)
+	for _, id := range inheritedBy {
+		child, err := p.Descriptors().MutableByID(p.txn).Table(ctx, id)
+		if err != nil {
+			return droppedViews, err
+		}
+		if child.Dropped() {
+			continue
+		}
+		if !droppingParent {
+			if err := p.canDropTable(ctx, child, true /* checkOwnership */); err != nil {
+				return droppedViews, err
+			}
+		}
+		cascadedViews, err := p.dropTableImpl(ctx, child, droppingParent, jobDesc, behavior)
+		if err != nil {
+			return droppedViews, err
+		}
+		droppedViews = append(droppedViews, cascadedViews...)
+	}
+	tableDesc.InheritedBy = nil
+	return droppedViews, nil
+}
+
+// removeInheritanceBackReferences removes the given table, which is being
+// dropped, from the inheriting tables of its parents.
+func (p *planner) removeInheritanceBackReferences(
+	ctx context.Context, tableDesc *tabledesc.Mutable,
+) error {
+	for _, id := range tableDesc.InheritsFrom {
+		parent, err := p.Descriptors().MutableByID(p.txn).Table(ctx, id)
+		if err != nil {
+			return errors.Wrapf(err, "error resolving inherited table ID %d", id)
+		}
+		if parent.Dropped() {
+			// The parent table is being dropped. No need to modify it further.
+			continue
+		}
+		parent.InheritedBy, _ = removeInheritanceID(parent.InheritedBy, tableDesc.ID)
+		jobDesc := fmt.Sprintf("updating table %q after dropping inheriting table %q",
+			parent.Name, tableDesc.Name)
+		if err := p.writeSchemaChange(ctx, parent, descpb.InvalidMutationID, jobDesc); err != nil {
+			return err
+		}
+	}
+	tableDesc.InheritsFrom = nil
+	return nil
+}
+
+// checkNoInheritingTables returns an error if other tables inherit from the
+// given table, on which the given operation is about to be performed. Scans of
+// the table include the rows of the inheriting tables, whose columns are
+// matched to the table's columns by name, and changes to the table's columns
+// and CHECK constraints are not propagated to them.
+func checkNoInheritingTables(tableDesc catalog.TableDescriptor, op redact.SafeString) error {
+	if len(tableDesc.GetInheritedBy()) == 0 {
+		return nil
+	}
+	return pgerror.Newf(pgcode.FeatureNotSupported,
+		"cannot %s on table %q because other tables inherit from it", op, tableDesc.GetName())
+}
+
+// checkColumnNotInherited returns an error if the given column is inherited
+// from one of the parents of the given table, on which the given operation is
+// about to be performed.
+func (p *planner) checkColumnNotInherited(
+	ctx context.Context, tableDesc catalog.TableDescriptor, op redact.SafeString, colName tree.Name,
+) error {
+	for _, id := range tableDesc.GetInheritsFrom() {
+		parent, err := p.Descriptors().ByIDWithoutLeased(p.txn).Get().Table(ctx, id)
+		if err != nil {
+			return err
+		}
+		if catalog.FindColumnByTreeName(parent, colName) != nil {
+			return pgerror.Newf(pgcode.InvalidTableDefinition,
+				"cannot %s inherited column %q", op, tree.ErrString(&colName))
+		}
+	}
+	return nil
+}
@@ -98,9 +98,10 @@ var maxBatchBytes = settings.RegisterByteSizeSetting(
 	4<<20,
 )
 
-// init initializes the tableWriterBase with a Txn.
+// init initializes the tableWriterBase with a Txn, and acquires the
+// table-level lock that the mutation takes on the table, if any.
 func (tb *tableWriterBase) init(
-	txn *kv.Txn, tableDesc catalog.TableDescriptor, evalCtx *eval.Context,
+	ctx context.Context, txn *kv.Txn, tableDesc catalog.TableDescriptor, evalCtx *eval.Context,
 ) error {
 	if txn.Type() != kv.RootTxn {
 		return errors.AssertionFailedf("unexpectedly non-root txn is used by the table writer")
@@ -125,6 +126,9 @@ func (tb *tableWriterBase) init(
 	}
 	tb.maxBatchByteSize = mutations.MaxBatchByteSize(batchMaxBytes, tb.forceProductionBatchSizes)
 	tb.initNewBatch()
+	if evalCtx != nil {
+		return acquireDMLTableLock(ctx, txn, evalCtx.Codec, tableDesc, evalCtx.SessionData())
+	}
 	return nil
 }
 
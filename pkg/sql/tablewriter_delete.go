@@ -26,8 +26,8 @@ type tableDeleter struct {
 }
 
 // init initializes the tableDeleter with a Txn.
-func (td *tableDeleter) init(_ context.Context, txn *kv.Txn, evalCtx *eval.Context) error {
-	return td.tableWriterBase.init(txn, td.tableDesc(), evalCtx)
+func (td *tableDeleter) init(ctx context.Context, txn *kv.Txn, evalCtx *eval.Context) error {
+	return td.tableWriterBase.init(ctx, txn, td.tableDesc(), evalCtx)
 }
 
 // row performs a delete.
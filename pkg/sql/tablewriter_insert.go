@@ -22,8 +22,8 @@ type tableInserter struct {
 }
 
 // init initializes the tableInserter with a Txn.
-func (ti *tableInserter) init(_ context.Context, txn *kv.Txn, evalCtx *eval.Context) error {
-	return ti.tableWriterBase.init(txn, ti.tableDesc(), evalCtx)
+func (ti *tableInserter) init(ctx context.Context, txn *kv.Txn, evalCtx *eval.Context) error {
+	return ti.tableWriterBase.init(ctx, txn, ti.tableDesc(), evalCtx)
 }
 
 // row performs an insert.
@@ -22,8 +22,8 @@ type tableUpdater struct {
 }
 
 // init initializes the tableUpdater with a Txn.
-func (tu *tableUpdater) init(_ context.Context, txn *kv.Txn, evalCtx *eval.Context) error {
-	return tu.tableWriterBase.init(txn, tu.tableDesc(), evalCtx)
+func (tu *tableUpdater) init(ctx context.Context, txn *kv.Txn, evalCtx *eval.Context) error {
+	return tu.tableWriterBase.init(ctx, txn, tu.tableDesc(), evalCtx)
 }
 
 // rowForUpdate performs an update.
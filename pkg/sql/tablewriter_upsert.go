@@ -71,12 +71,22 @@ type tableUpserter struct {
 	// an update is performed. This column will always be one of the fetchCols.
 	canaryOrdinal int
 
+	// deleteOrdinal is the ordinal position of the boolean column within the
+	// input row that is true if an existing row should be deleted rather than
+	// updated. It is -1 unless the upsert implements a MERGE statement with
+	// DELETE actions, and it always directly follows the canary column.
+	deleteOrdinal int
+
 	// resultRow is a reusable slice of Datums used to store result rows.
 	resultRow tree.Datums
 
 	// ru is used when updating rows.
 	ru row.Updater
 
+	// rd is used when deleting rows. It is only initialized if deleteOrdinal is
+	// not -1.
+	rd row.Deleter
+
 	// tabColIdxToRetIdx is the mapping from the columns in the table to the
 	// columns in the resultRowBuffer. A value of -1 is used to indicate
 	// that the table column at that index is not part of the resultRowBuffer
@@ -88,7 +98,7 @@ type tableUpserter struct {
 
 // init initializes the tableUpserter with a Txn.
 func (tu *tableUpserter) init(ctx context.Context, txn *kv.Txn, evalCtx *eval.Context) error {
-	if err := tu.tableWriterBase.init(txn, tu.ri.Helper.TableDesc, evalCtx); err != nil {
+	if err := tu.tableWriterBase.init(ctx, txn, tu.ri.Helper.TableDesc, evalCtx); err != nil {
 		return err
 	}
 
@@ -197,8 +207,13 @@ func (tu *tableUpserter) row(
 		return tu.insertNonConflictingRow(ctx, datums[:insertEnd], pm, vh, oth, row.CPutOp, traceKV)
 	}
 
-	// If no columns need to be updated, then possibly collect the unchanged row.
+	// Delete the existing row if requested by a MERGE statement.
 	fetchEnd := insertEnd + len(tu.fetchCols)
+	if tu.deleteOrdinal != -1 && datums[tu.deleteOrdinal] == tree.DBoolTrue {
+		return tu.deleteConflictingRow(ctx, tu.b, datums[insertEnd:fetchEnd], pm, vh, oth, traceKV)
+	}
+
+	// If no columns need to be updated, then possibly collect the unchanged row.
 	if len(tu.updateCols) == 0 {
 		if !tu.rowsNeeded {
 			return nil
@@ -328,6 +343,40 @@ func (tu *tableUpserter) updateConflictingRow(
 	return tu.addRow(ctx, tu.resultRow)
 }
 
+// deleteConflictingRow deletes an existing row from the table on behalf of a
+// DELETE action of a MERGE statement. The existing values from the row are
+// provided in fetchRow. If the RETURNING clause was specified, then the deleted
+// row is stored in the rowsUpserted collection.
+func (tu *tableUpserter) deleteConflictingRow(
+	ctx context.Context,
+	b *kv.Batch,
+	fetchRow tree.Datums,
+	pm row.PartialIndexUpdateHelper,
+	vh row.VectorIndexUpdateHelper,
+	oth row.OriginTimestampCPutHelper,
+	traceKV bool,
+) error {
+	if err := tu.rd.DeleteRow(
+		ctx, b, fetchRow, pm, vh, oth, false /* mustValidateOldPKValues */, traceKV,
+	); err != nil {
+		return err
+	}
+
+	// We only need a result row if we're collecting rows.
+	if !tu.rowsNeeded {
+		return nil
+	}
+
+	// The deleted row is returned with its existing values.
+	tableRow := tu.makeResultFromRow(fetchRow, tu.rd.FetchColIDtoRowIndex)
+	for tabIdx := range tableRow {
+		if retIdx := tu.tabColIdxToRetIdx[tabIdx]; retIdx >= 0 {
+			tu.resultRow[retIdx] = tableRow[tabIdx]
+		}
+	}
+	return tu.addRow(ctx, tu.resultRow)
+}
+
 // tableDesc returns the TableDescriptor for the table that the optTableInserter
 // will modify.
 func (tu *tableUpserter) tableDesc() catalog.TableDescriptor {
@@ -63,6 +63,10 @@ func (t *truncateNode) startExec(params runParams) error {
 		if err != nil {
 			return err
 		}
+		if tableDesc.IsForeignTable() {
+			return pgerror.Newf(pgcode.WrongObjectType,
+				"cannot truncate foreign table %q", tableDesc.Name)
+		}
 
 		if err := p.CheckPrivilege(ctx, tableDesc, privilege.DROP); err != nil {
 			return err
@@ -549,6 +549,14 @@ func (t *typeSchemaChanger) exec(ctx context.Context) error {
 		}
 	}
 
+	// Validate the CHECK constraints being added to a domain against the values
+	// already stored in columns of the domain.
+	if typeDesc.AsDomainTypeDescriptor() != nil && !typeDesc.Dropped() {
+		if err := t.validateDomainChecks(ctx); err != nil {
+			return err
+		}
+	}
+
 	// If the type is being dropped, remove the descriptor here only
 	// if the declarative schema changer is not in use.
 	if typeDesc.Dropped() && typeDesc.GetDeclarativeSchemaChangerState() == nil {
@@ -1393,6 +1401,75 @@ func enumMemberIsRemoving(member *descpb.TypeDescriptor_EnumMember) bool {
 	return false
 }
 
+// validateDomainChecks validates the CHECK constraints of a domain that are
+// in the Validating state and marks them as validated. As with the removal of
+// enum values, the validation is done in a separate txn to the one that
+// mutates the descriptor, as it can take arbitrarily long.
+func (t *typeSchemaChanger) validateDomainChecks(ctx context.Context) error {
+	var validated []string
+	if err := t.execCfg.InternalDB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+		validated = validated[:0]
+		typeDesc, err := txn.Descriptors().MutableByID(txn.KV()).Type(ctx, t.typeID)
+		if err != nil {
+			return err
+		}
+		for i := range typeDesc.Domain.Checks {
+			chk := &typeDesc.Domain.Checks[i]
+			if chk.Validity != descpb.ConstraintValidity_Validating {
+				continue
+			}
+			if err := validateDomainValues(ctx, txn, typeDesc, chk); err != nil {
+				return err
+			}
+			validated = append(validated, chk.Name)
+		}
+		return nil
+	}); err != nil || len(validated) == 0 {
+		return err
+	}
+
+	return t.execCfg.InternalDB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+		typeDesc, err := txn.Descriptors().MutableByID(txn.KV()).Type(ctx, t.typeID)
+		if err != nil {
+			return err
+		}
+		for _, name := range validated {
+			// The constraint may have been dropped or validated concurrently.
+			if idx := findDomainCheck(typeDesc.Domain, name); idx >= 0 {
+				if chk := &typeDesc.Domain.Checks[idx]; chk.Validity == descpb.ConstraintValidity_Validating {
+					chk.Validity = descpb.ConstraintValidity_Validated
+				}
+			}
+		}
+		return txn.Descriptors().WriteDesc(ctx, true /* kvTrace */, typeDesc, txn.KV())
+	})
+}
+
+// cleanupDomainChecks removes the CHECK constraints of a domain that were
+// being added when the type schema change failed.
+func (t *typeSchemaChanger) cleanupDomainChecks(ctx context.Context) error {
+	return t.execCfg.InternalDB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+		typeDesc, err := txn.Descriptors().MutableByID(txn.KV()).Type(ctx, t.typeID)
+		if err != nil {
+			return err
+		}
+		if typeDesc.Kind != descpb.TypeDescriptor_DOMAIN {
+			return nil
+		}
+		checks := typeDesc.Domain.Checks[:0]
+		for _, chk := range typeDesc.Domain.Checks {
+			if chk.Validity != descpb.ConstraintValidity_Validating {
+				checks = append(checks, chk)
+			}
+		}
+		if len(checks) == len(typeDesc.Domain.Checks) {
+			return nil
+		}
+		typeDesc.Domain.Checks = checks
+		return txn.Descriptors().WriteDesc(ctx, true /* kvTrace */, typeDesc, txn.KV())
+	})
+}
+
 // execWithRetry is a wrapper around exec that retries the type schema change
 // on retryable errors.
 func (t *typeSchemaChanger) execWithRetry(ctx context.Context) error {
@@ -1473,6 +1550,10 @@ func (t *typeChangeResumer) OnFailOrCancel(
 			return err
 		}
 
+		if err := tc.cleanupDomainChecks(ctx); err != nil {
+			return err
+		}
+
 		if fn := tc.execCfg.TypeSchemaChangerTestingKnobs.RunAfterOnFailOrCancel; fn != nil {
 			return fn()
 		}
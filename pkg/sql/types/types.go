@@ -320,6 +320,28 @@ type UserDefinedTypeMetadata struct {
 	// for a table. Note: this can be deleted if we migrate implicit record types
 	// to ordinary persisted composite types.
 	ImplicitRecordType bool
+
+	// DomainData is non-nil iff the metadata is for a DOMAIN type.
+	DomainData *DomainMetadata
+}
+
+// DomainMetadata is metadata about a DOMAIN needed to enforce its constraints.
+type DomainMetadata struct {
+	// NotNull is true if the domain does not allow NULL values.
+	NotNull bool
+	// DefaultExpr is the serialized default expression of the domain, if any.
+	DefaultExpr *string
+	// CheckNames and CheckExprs are the names and serialized expressions of the
+	// CHECK constraints of the domain. The expressions refer to the value being
+	// checked as VALUE.
+	CheckNames []string
+	CheckExprs []string
+}
+
+// HasConstraints returns whether the domain has any constraints that need to
+// be enforced when a value is coerced to it.
+func (d *DomainMetadata) HasConstraints() bool {
+	return d != nil && (d.NotNull || len(d.CheckExprs) > 0)
 }
 
 // EnumMetadata is metadata about an ENUM needed for evaluation.
@@ -1400,6 +1422,20 @@ func MakeEnum(typeOID, arrayTypeOID oid.Oid) *T {
 	}}
 }
 
+// MakeDomain constructs a new instance of a DOMAIN type over the given base
+// type with the given stable type ID. The domain shares the family and
+// attributes of its base type. Note that it does not hydrate cached fields on
+// the type.
+func MakeDomain(typeOID, arrayTypeOID oid.Oid, base *T) *T {
+	internal := base.InternalType
+	internal.Oid = typeOID
+	internal.UDTMetadata = &PersistentUserDefinedTypeMetadata{
+		ArrayTypeOID:      arrayTypeOID,
+		DomainBaseTypeOID: base.Oid(),
+	}
+	return &T{InternalType: internal}
+}
+
 // MakeArray constructs a new instance of an ArrayFamily type with the given
 // element type (which may itself be an ArrayFamily type).
 func MakeArray(typ *T) *T {
@@ -1719,6 +1755,23 @@ func RemapUserDefinedTypeOIDs(t *T, newOID, newArrayOID oid.Oid) {
 	}
 }
 
+// IsDomain returns whether or not t is a DOMAIN type.
+func (t *T) IsDomain() bool {
+	return t.InternalType.UDTMetadata != nil && t.InternalType.UDTMetadata.DomainBaseTypeOID != 0
+}
+
+// DomainBaseType returns the base type of a DOMAIN type. It must only be
+// called on domain types.
+func (t *T) DomainBaseType() *T {
+	if !t.IsDomain() {
+		panic(errors.AssertionFailedf("type %d is not a domain", t.Oid()))
+	}
+	base := &T{InternalType: t.InternalType}
+	base.InternalType.Oid = t.InternalType.UDTMetadata.DomainBaseTypeOID
+	base.InternalType.UDTMetadata = nil
+	return base
+}
+
 // UserDefined returns whether or not t is a user defined type.
 func (t *T) UserDefined() bool {
 	return IsOIDUserDefinedType(t.Oid())
@@ -1788,6 +1841,12 @@ func (f Family) Name() redact.SafeString {
 //
 // TODO(andyk): Should these be changed to be the same as SQLStandardName?
 func (t *T) Name() string {
+	if t.IsDomain() {
+		if t.TypeMeta.Name == nil {
+			return fmt.Sprintf("@%d", t.Oid())
+		}
+		return t.TypeMeta.Name.Basename()
+	}
 	switch fam := t.Family(); fam {
 	case AnyFamily:
 		switch t.Oid() {
@@ -2161,6 +2220,12 @@ func (t *T) InformationSchemaName() string {
 // reproduce the type via parsing the string as a type. It is used in error
 // messages and also to produce the output of SHOW CREATE.
 func (t *T) SQLString() string {
+	if t.IsDomain() {
+		if t.TypeMeta.Name == nil {
+			return fmt.Sprintf("@%d", t.Oid())
+		}
+		return t.TypeMeta.Name.FQName(false /* explicitCatalog */)
+	}
 	switch t.Family() {
 	case BitFamily:
 		switch t.Oid() {
@@ -2342,6 +2407,9 @@ func (t *T) SQLStringForError() redact.RedactableString {
 		case ArrayFamily:
 			prefix = "ARRAY"
 		}
+		if t.IsDomain() {
+			prefix = "DOMAIN"
+		}
 		return redact.Sprintf("USER DEFINED %s: %s", redact.Safe(prefix), t.SQLString())
 	}
 	switch t.Family() {
@@ -2614,6 +2682,9 @@ func (t *InternalType) Identical(other *InternalType) bool {
 		if t.UDTMetadata.ArrayTypeOID != other.UDTMetadata.ArrayTypeOID {
 			return false
 		}
+		if t.UDTMetadata.DomainBaseTypeOID != other.UDTMetadata.DomainBaseTypeOID {
+			return false
+		}
 	} else if t.UDTMetadata != nil {
 		return false
 	} else if other.UDTMetadata != nil {
@@ -137,8 +137,15 @@ func (n *upsertNode) processBatch(params runParams) (lastBatch bool, err error)
 // processSourceRow processes one row from the source for upsertion.
 // The table writer is in charge of accumulating the result rows.
 func (r *upsertRun) processSourceRow(params runParams, rowVals tree.Datums) error {
+	// An existing row that is deleted by a MERGE statement is not subject to
+	// NOT NULL and CHECK constraints.
+	isDelete := r.tw.deleteOrdinal != -1 && rowVals[r.tw.canaryOrdinal] != tree.DNull &&
+		rowVals[r.tw.deleteOrdinal] == tree.DBoolTrue
+
 	// Check for NOT NULL constraint violations.
-	if r.tw.canaryOrdinal != -1 && rowVals[r.tw.canaryOrdinal] != tree.DNull {
+	if isDelete {
+		// The row is deleted, so there is nothing to check.
+	} else if r.tw.canaryOrdinal != -1 && rowVals[r.tw.canaryOrdinal] != tree.DNull {
 		// When there is a canary column and its value is not NULL, then an
 		// existing row is being updated, so check only the update columns for
 		// NOT NULL constraint violations.
@@ -163,17 +170,22 @@ func (r *upsertRun) processSourceRow(params runParams, rowVals tree.Datums) erro
 	if r.tw.canaryOrdinal != -1 {
 		lastUpsertCol++
 	}
+	if r.tw.deleteOrdinal != -1 {
+		lastUpsertCol++
+	}
 	upsertVals := rowVals[:lastUpsertCol]
 	rowVals = rowVals[lastUpsertCol:]
 
 	// Verify the CHECK constraints by inspecting boolean columns from the input that
 	// contain the results of evaluation.
 	if !r.checkOrds.Empty() {
-		if err := checkMutationInput(
-			params.ctx, params.p.EvalContext(), &params.p.semaCtx, params.p.SessionData(),
-			r.tw.tableDesc(), r.checkOrds, rowVals[:r.checkOrds.Len()],
-		); err != nil {
-			return err
+		if !isDelete {
+			if err := checkMutationInput(
+				params.ctx, params.p.EvalContext(), &params.p.semaCtx, params.p.SessionData(),
+				r.tw.tableDesc(), r.checkOrds, rowVals[:r.checkOrds.Len()],
+			); err != nil {
+				return err
+			}
 		}
 		rowVals = rowVals[r.checkOrds.Len():]
 	}
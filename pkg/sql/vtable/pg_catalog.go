@@ -1131,7 +1131,9 @@ CREATE TABLE pg_catalog.pg_ts_template (
 	tmpllexize REGPROC
 )`
 
-// PgCatalogPublicationRel is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogPublicationRel describes the schema of the
+// pg_catalog.pg_publication_rel table.
+// https://www.postgresql.org/docs/17/catalog-pg-publication-rel.html
 const PgCatalogPublicationRel = `
 CREATE TABLE pg_catalog.pg_publication_rel (
 	oid OID,
@@ -1554,7 +1556,9 @@ CREATE TABLE pg_catalog.pg_hba_file_rules (
 	error STRING
 )`
 
-// PgCatalogPublication is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogPublication describes the schema of the pg_catalog.pg_publication
+// table.
+// https://www.postgresql.org/docs/17/catalog-pg-publication.html
 const PgCatalogPublication = `
 CREATE TABLE pg_catalog.pg_publication (
 	oid OID,
@@ -1681,7 +1685,9 @@ CREATE TABLE pg_catalog.pg_stat_xact_user_tables (
 	n_tup_hot_upd INT
 )`
 
-// PgCatalogPublicationTables is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogPublicationTables describes the schema of the
+// pg_catalog.pg_publication_tables view.
+// https://www.postgresql.org/docs/17/view-pg-publication-tables.html
 const PgCatalogPublicationTables = `
 CREATE TABLE pg_catalog.pg_publication_tables (
 	pubname NAME,
@@ -1792,7 +1798,9 @@ CREATE TABLE pg_catalog.pg_largeobject_metadata (
 	lomacl STRING[]
 )`
 
-// PgCatalogReplicationSlots is an empty table in the pg_catalog that is not implemented yet
+// PgCatalogReplicationSlots describes the schema of the
+// pg_catalog.pg_replication_slots view.
+// https://www.postgresql.org/docs/17/view-pg-replication-slots.html
 const PgCatalogReplicationSlots = `
 CREATE TABLE pg_catalog.pg_replication_slots (
 	slot_name NAME,
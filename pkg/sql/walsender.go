@@ -0,0 +1,697 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package sql
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvclient/rangefeed/rangefeedbuffer"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvpb"
+	"github.com/cockroachdb/cockroach/pkg/kv/kvserver"
+	"github.com/cockroachdb/cockroach/pkg/roachpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descs"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/fetchpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/lease"
+	"github.com/cockroachdb/cockroach/pkg/sql/isql"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsn"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/lsnutil"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/pgoutput"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgrepl/pgrepltree"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgwirebase"
+	"github.com/cockroachdb/cockroach/pkg/sql/row"
+	"github.com/cockroachdb/cockroach/pkg/sql/rowenc"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/util/ctxlog"
+	"github.com/cockroachdb/cockroach/pkg/util/errorutil/unimplemented"
+	"github.com/cockroachdb/cockroach/pkg/util/fsm"
+	"github.com/cockroachdb/cockroach/pkg/util/hlc"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/timeutil"
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	// walSenderKeepaliveInterval is how often a keepalive is sent to the
+	// client when no changes are streamed.
+	walSenderKeepaliveInterval = 10 * time.Second
+	// walSenderSlotUpdateInterval is how often the position confirmed by the
+	// client is persisted in the replication slot.
+	walSenderSlotUpdateInterval = time.Second
+	// walSenderBufferLimit is the maximum number of changes buffered while
+	// waiting for the resolved timestamp to advance. The stream fails if a
+	// burst of changes exceeds it.
+	walSenderBufferLimit = 1 << 18
+)
+
+// walSender streams the changes to the tables of a set of publications to a
+// logical replication client, for START_REPLICATION, in the format of the
+// pgoutput plugin.
+//
+// The changes are read with a rangefeed over the published tables. They are
+// buffered until the resolved timestamp of the rangefeed passes them, and are
+// then streamed in timestamp order, grouped into transactions by the wall
+// time of their commit timestamp: the LSN of a transaction is that wall time
+// (see lsnutil.HLCToLSN). The client confirms the LSNs up to which it has
+// flushed the changes, which are persisted in the replication slot so that
+// streaming resumes after them when the client reconnects.
+type walSender struct {
+	execCfg *ExecutorConfig
+	conn    pgwirebase.Conn
+	res     StartReplicationResult
+	fmtCtx  *tree.FmtCtx
+
+	slot      *replicationSlot
+	published catalog.DescriptorIDSet
+	// startLSN is the position after which changes are streamed.
+	startLSN lsn.LSN
+
+	// tables holds the decoding state of the published tables, keyed by ID.
+	tables map[descpb.ID]*walSenderTable
+	// sentLSN is the LSN of the last transaction sent to the client, or the
+	// position up to which the client is known to be caught up.
+	sentLSN lsn.LSN
+	// flushedLSN is the position confirmed by the client that was last
+	// persisted in the replication slot.
+	flushedLSN lsn.LSN
+	xid        uint32
+	msgs       [][]byte
+	alloc      tree.DatumAlloc
+}
+
+// walSenderTable is the decoding state of a version of a published table.
+type walSenderTable struct {
+	desc    catalog.TableDescriptor
+	rel     pgoutput.Relation
+	fetcher row.Fetcher
+	// relationSent is set once the Relation message for this version of the
+	// table has been sent to the client.
+	relationSent bool
+}
+
+// execStartReplication streams the changes of the publications named in a
+// START_REPLICATION command until the client ends the stream. Like
+// execCopyIn, it takes control of the network connection, which is handed back
+// once it returns.
+func (ex *connExecutor) execStartReplication(
+	ctx context.Context, cmd StartReplication, res StartReplicationResult,
+) (retEv fsm.Event, retPayload fsm.EventPayload) {
+	// When we're done, unblock the network connection.
+	defer cmd.ReplicationDone.Once.Do(cmd.ReplicationDone.WaitGroup.Done)
+
+	if _, isNoTxn := ex.machine.CurState().(stateNoTxn); !isNoTxn {
+		return ex.makeErrEvent(pgerror.New(pgcode.ActiveSQLTransaction,
+			"START_REPLICATION cannot run inside a transaction block"), cmd.ParsedStmt.AST)
+	}
+
+	ex.incrementStartedStmtCounter(cmd.Stmt)
+	var cancelQuery context.CancelFunc
+	ctx, cancelQuery = ctxlog.WithCancel(ctx)
+	queryID := ex.server.cfg.GenerateID()
+	ex.addActiveQuery(cmd.ParsedStmt, nil /* placeholders */, queryID, cancelQuery)
+	ex.metrics.EngineMetrics.SQLActiveStatements.Inc(1,
+		ex.sessionData().Database, ex.sessionData().ApplicationName)
+	defer func() {
+		ex.removeActiveQuery(queryID, cmd.Stmt)
+		cancelQuery()
+		ex.metrics.EngineMetrics.SQLActiveStatements.Dec(1,
+			ex.sessionData().Database, ex.sessionData().ApplicationName)
+		if !payloadHasError(retPayload) {
+			ex.incrementExecutedStmtCounter(cmd.Stmt)
+		}
+	}()
+
+	ws, err := ex.newWalSender(ctx, cmd, res)
+	if err == nil {
+		err = ws.run(ctx)
+	}
+	if err != nil {
+		log.SqlExec.Infof(ctx, "error executing %s: %v", cmd, err)
+		return ex.makeErrEvent(err, cmd.ParsedStmt.AST)
+	}
+	return nil, nil
+}
+
+// newWalSender validates a START_REPLICATION command and loads the
+// replication slot and publications that it names.
+func (ex *connExecutor) newWalSender(
+	ctx context.Context, cmd StartReplication, res StartReplicationResult,
+) (*walSender, error) {
+	execCfg := ex.server.cfg
+	if err := checkPublicationsSupported(ctx, execCfg, "START_REPLICATION"); err != nil {
+		return nil, err
+	}
+	if cmd.Stmt.Kind != pgrepltree.LogicalReplication {
+		return nil, unimplemented.New("physical replication", "physical replication is not supported")
+	}
+	if execCfg.Codec.IsSystem() && !kvserver.RangefeedEnabled.Get(&execCfg.Settings.SV) {
+		return nil, pgerror.New(pgcode.ObjectNotInPrerequisiteState,
+			"START_REPLICATION requires the kv.rangefeed.enabled setting to be enabled")
+	}
+	pubNames, err := parseStartReplicationOptions(cmd.Stmt.Options)
+	if err != nil {
+		return nil, err
+	}
+	dbName := ex.sessionData().Database
+	if dbName == "" {
+		return nil, pgerror.New(pgcode.InvalidCatalogName,
+			"START_REPLICATION requires a current database")
+	}
+
+	ws := &walSender{
+		execCfg: execCfg,
+		conn:    cmd.Conn,
+		res:     res,
+		fmtCtx:  ex.planner.EvalContext().FmtCtx(tree.FmtPgwireText),
+		tables:  make(map[descpb.ID]*walSenderTable),
+	}
+	if err := execCfg.InternalDB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+		db, err := txn.Descriptors().ByNameWithLeased(txn.KV()).Get().Database(ctx, dbName)
+		if err != nil {
+			return err
+		}
+		ws.slot, err = loadReplicationSlot(ctx, txn, string(cmd.Stmt.Slot))
+		if err != nil {
+			return err
+		}
+		if ws.slot == nil {
+			return pgerror.Newf(pgcode.UndefinedObject,
+				"replication slot %q does not exist", cmd.Stmt.Slot)
+		}
+		if ws.slot.databaseID != db.GetID() {
+			return pgerror.Newf(pgcode.ObjectNotInPrerequisiteState,
+				"replication slot %q was not created in this database", cmd.Stmt.Slot)
+		}
+		pubs, err := loadPublications(ctx, txn, db.GetID())
+		if err != nil {
+			return err
+		}
+		var selected []publication
+		for _, name := range pubNames {
+			found := false
+			for _, pub := range pubs {
+				if pub.name == name {
+					selected = append(selected, pub)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return pgerror.Newf(pgcode.UndefinedObject, "publication %q does not exist", name)
+			}
+		}
+		tables, err := publishedTables(ctx, txn, db, selected)
+		if err != nil {
+			return err
+		}
+		for _, tbl := range tables {
+			ws.published.Add(tbl.GetID())
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Like Postgres, streaming starts at the position confirmed by the client
+	// if the requested position is before it.
+	ws.startLSN = cmd.Stmt.LSN
+	if ws.startLSN < ws.slot.confirmedFlushLSN {
+		ws.startLSN = ws.slot.confirmedFlushLSN
+	}
+	ws.sentLSN = ws.startLSN
+	ws.flushedLSN = ws.slot.confirmedFlushLSN
+	return ws, nil
+}
+
+// parseStartReplicationOptions validates the options of the pgoutput plugin
+// and returns the names of the publications to stream.
+func parseStartReplicationOptions(opts pgrepltree.Options) ([]string, error) {
+	var pubNames []string
+	for _, o := range opts {
+		val := replicationOptionString(o)
+		switch o.Key {
+		case "proto_version":
+			switch val {
+			case "1", "2", "3", "4":
+			default:
+				return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+					"client sent proto_version=%s but server only supports protocol 1 to 4", val)
+			}
+		case "publication_names":
+			for _, name := range strings.Split(val, ",") {
+				name = strings.TrimSpace(name)
+				if len(name) >= 2 && name[0] == '"' && name[len(name)-1] == '"' {
+					name = strings.ReplaceAll(name[1:len(name)-1], `""`, `"`)
+				} else {
+					name = strings.ToLower(name)
+				}
+				if name == "" {
+					return nil, pgerror.New(pgcode.InvalidName, "invalid publication_names syntax")
+				}
+				pubNames = append(pubNames, name)
+			}
+		case "binary", "streaming", "two_phase":
+			if val != "" && val != "false" && val != "off" && val != "0" {
+				return nil, unimplemented.Newf("pgoutput option",
+					"pgoutput option %q is not supported", o.Key)
+			}
+		case "messages", "origin":
+			// Logical decoding messages and replication origins are not used in
+			// CockroachDB, so these options have no effect.
+		default:
+			return nil, pgerror.Newf(pgcode.InvalidParameterValue,
+				"unrecognized pgoutput option: %s", o.Key)
+		}
+	}
+	if len(pubNames) == 0 {
+		return nil, pgerror.New(pgcode.InvalidParameterValue,
+			"publication_names parameter missing")
+	}
+	return pubNames, nil
+}
+
+// run streams the changes until the client ends the stream or an error
+// occurs.
+func (ws *walSender) run(ctx context.Context) (retErr error) {
+	if err := ws.res.SendCopyBoth(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		for _, t := range ws.tables {
+			t.fetcher.Close(ctx)
+		}
+	}()
+
+	// The client's messages are read on a separate goroutine, since reading
+	// from the connection blocks.
+	statusCh := make(chan pgoutput.StandbyStatusUpdate)
+	readerDone := make(chan error, 1)
+	stopReader := make(chan struct{})
+	go func() {
+		readerDone <- ws.readClientMessages(statusCh, stopReader)
+	}()
+	defer func() {
+		close(stopReader)
+		if retErr == nil {
+			// The client ended the stream; end ours too.
+			retErr = ws.res.SendCopyDone(ctx)
+			return
+		}
+		// End the stream so that the client ends it too, and wait for the
+		// reader to finish before handing the connection back.
+		if err := ws.res.SendCopyDone(ctx); err == nil {
+			if err := ws.res.FlushCopyData(ctx); err == nil {
+				<-readerDone
+			}
+		}
+	}()
+
+	buf := rangefeedbuffer.New[*kvpb.RangeFeedValue](walSenderBufferLimit)
+	frontierCh := make(chan hlc.Timestamp, 1)
+	rfErrCh := make(chan error, 1)
+	reportErr := func(err error) {
+		select {
+		case rfErrCh <- err:
+		default:
+		}
+	}
+	var spans []roachpb.Span
+	for _, id := range ws.published.Ordered() {
+		spans = append(spans, ws.execCfg.Codec.TableSpan(uint32(id)))
+	}
+	if len(spans) > 0 {
+		rf, err := ws.execCfg.RangeFeedFactory.RangeFeed(
+			ctx, "pgwire-walsender", spans, lsnutil.LSNToHLC(ws.startLSN),
+			func(ctx context.Context, v *kvpb.RangeFeedValue) {
+				if err := buf.Add(v); err != nil {
+					reportErr(errors.Wrap(err, "too many changes are buffered"))
+				}
+			},
+			rangefeed.WithDiff(true),
+			rangefeed.WithOnFrontierAdvance(func(ctx context.Context, ts hlc.Timestamp) {
+				// Only the latest frontier matters.
+				select {
+				case <-frontierCh:
+				default:
+				}
+				frontierCh <- ts
+			}),
+			rangefeed.WithOnDeleteRange(func(context.Context, *kvpb.RangeFeedDeleteRange) {
+				// Range deletions only remove the data of dropped tables and
+				// indexes, which is not published.
+			}),
+			rangefeed.WithOnInternalError(func(ctx context.Context, err error) {
+				reportErr(err)
+			}),
+		)
+		if err != nil {
+			return err
+		}
+		defer rf.Close()
+	}
+
+	keepalive := timeutil.NewTimer()
+	defer keepalive.Stop()
+	keepalive.Reset(walSenderKeepaliveInterval)
+	var lastSlotUpdate time.Time
+	var pendingFlushedLSN lsn.LSN
+	for {
+		replyRequested := false
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-readerDone:
+			// Put the result back, since it is waited for again if the stream
+			// ends with an error.
+			readerDone <- err
+			// Persist the last position confirmed by the client.
+			return errors.CombineErrors(err, ws.updateSlot(ctx, pendingFlushedLSN))
+		case err := <-rfErrCh:
+			return err
+		case frontier := <-frontierCh:
+			if err := ws.sendUpTo(ctx, buf, frontier); err != nil {
+				return err
+			}
+			continue
+		case status := <-statusCh:
+			if status.Flushed > pendingFlushedLSN {
+				pendingFlushedLSN = status.Flushed
+			}
+			replyRequested = status.ReplyRequested
+		case <-keepalive.C:
+			keepalive.Read = true
+		}
+		if now := timeutil.Now(); now.Sub(lastSlotUpdate) >= walSenderSlotUpdateInterval {
+			if err := ws.updateSlot(ctx, pendingFlushedLSN); err != nil {
+				return err
+			}
+			lastSlotUpdate = now
+		}
+		if replyRequested || keepalive.Read {
+			if err := ws.sendKeepalive(ctx, replyRequested); err != nil {
+				return err
+			}
+			keepalive.Reset(walSenderKeepaliveInterval)
+		}
+	}
+}
+
+// readClientMessages reads the messages sent by the client until it ends the
+// stream, in which case nil is returned. Standby status updates are sent on
+// statusCh, until stop is closed.
+func (ws *walSender) readClientMessages(
+	statusCh chan<- pgoutput.StandbyStatusUpdate, stop <-chan struct{},
+) error {
+	readBuf := pgwirebase.MakeReadBuffer(
+		pgwirebase.ReadBufferOptionWithClusterSettings(&ws.execCfg.Settings.SV),
+	)
+	for {
+		typ, _, err := readBuf.ReadTypedMsg(ws.conn.Rd())
+		if err != nil {
+			return err
+		}
+		switch typ {
+		case pgwirebase.ClientMsgCopyData:
+			if pgoutput.IsHotStandbyFeedback(readBuf.Msg) {
+				continue
+			}
+			status, err := pgoutput.ParseStandbyStatusUpdate(readBuf.Msg)
+			if err != nil {
+				return pgwirebase.NewProtocolViolationErrorf("%v", err)
+			}
+			select {
+			case statusCh <- status:
+			case <-stop:
+			}
+		case pgwirebase.ClientMsgCopyDone:
+			return nil
+		case pgwirebase.ClientMsgCopyFail:
+			return pgerror.Newf(pgcode.QueryCanceled,
+				"START_REPLICATION failed: %s", string(readBuf.Msg))
+		case pgwirebase.ClientMsgTerminate:
+			return pgerror.New(pgcode.ConnectionFailure, "client terminated the connection")
+		case pgwirebase.ClientMsgFlush, pgwirebase.ClientMsgSync:
+		default:
+			return pgwirebase.NewUnrecognizedMsgTypeErr(typ)
+		}
+	}
+}
+
+// updateSlot persists the position confirmed by the client in the
+// replication slot, if it advanced.
+func (ws *walSender) updateSlot(ctx context.Context, flushed lsn.LSN) error {
+	if flushed <= ws.flushedLSN {
+		return nil
+	}
+	// The client may confirm positions past the last transaction it
+	// received, for example in response to a keepalive.
+	if flushed > ws.sentLSN {
+		flushed = ws.sentLSN
+	}
+	if err := ws.execCfg.InternalDB.Txn(ctx, func(ctx context.Context, txn isql.Txn) error {
+		return advanceReplicationSlot(ctx, txn, ws.slot.name, flushed)
+	}); err != nil {
+		return err
+	}
+	ws.flushedLSN = flushed
+	return nil
+}
+
+// sendKeepalive sends a keepalive carrying the position up to which the
+// client is caught up.
+func (ws *walSender) sendKeepalive(ctx context.Context, replyRequested bool) error {
+	msg := pgoutput.AppendPrimaryKeepalive(nil, ws.sentLSN, timeutil.Now(), replyRequested)
+	if err := ws.res.SendCopyData(ctx, msg, false /* isHeader */); err != nil {
+		return err
+	}
+	return ws.res.FlushCopyData(ctx)
+}
+
+// sendUpTo streams the buffered changes whose wall time is below the wall
+// time of the frontier, since changes can still be committed at the wall time
+// of the frontier.
+func (ws *walSender) sendUpTo(
+	ctx context.Context, buf *rangefeedbuffer.Buffer[*kvpb.RangeFeedValue], frontier hlc.Timestamp,
+) error {
+	upTo := lsnutil.HLCToLSN(frontier) - 1
+	if upTo <= ws.sentLSN {
+		return nil
+	}
+	events := buf.Flush(ctx, lsnutil.LSNToHLC(upTo))
+	for len(events) > 0 {
+		commitLSN := lsnutil.HLCToLSN(events[0].Timestamp())
+		n := 1
+		for n < len(events) && lsnutil.HLCToLSN(events[n].Timestamp()) == commitLSN {
+			n++
+		}
+		if err := ws.sendTxn(ctx, commitLSN, events[:n]); err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+	ws.sentLSN = upTo
+	return ws.res.FlushCopyData(ctx)
+}
+
+// sendTxn streams the changes committed at the given LSN as a transaction.
+func (ws *walSender) sendTxn(
+	ctx context.Context, commitLSN lsn.LSN, events []*kvpb.RangeFeedValue,
+) error {
+	ws.msgs = ws.msgs[:0]
+	for _, ev := range events {
+		if err := ws.decodeChange(ctx, ev); err != nil {
+			return err
+		}
+	}
+	if len(ws.msgs) == 0 {
+		return nil
+	}
+	ws.xid++
+	commitTime := timeutil.Unix(0, int64(commitLSN))
+	now := timeutil.Now()
+	send := func(msg []byte) error {
+		data := pgoutput.AppendXLogData(nil, commitLSN, commitLSN, now)
+		return ws.res.SendCopyData(ctx, append(data, msg...), false /* isHeader */)
+	}
+	if err := send(pgoutput.AppendBegin(nil, commitLSN, commitTime, ws.xid)); err != nil {
+		return err
+	}
+	for _, msg := range ws.msgs {
+		if err := send(msg); err != nil {
+			return err
+		}
+	}
+	return send(pgoutput.AppendCommit(nil, commitLSN, commitLSN, commitTime))
+}
+
+// decodeChange decodes a change to a row of a published table into a message
+// of the logical replication protocol, preceded by a Relation message if the
+// client does not know the current schema of the table.
+func (ws *walSender) decodeChange(ctx context.Context, ev *kvpb.RangeFeedValue) error {
+	key, err := ws.execCfg.Codec.StripTenantPrefix(ev.Key)
+	if err != nil {
+		return err
+	}
+	_, tableID, indexID, err := rowenc.DecodePartialTableIDIndexID(key)
+	if err != nil {
+		return err
+	}
+	if !ws.published.Contains(tableID) {
+		return nil
+	}
+	t, err := ws.tableAt(ctx, tableID, ev.Timestamp())
+	if err != nil {
+		if errors.Is(err, catalog.ErrDescriptorNotFound) || catalog.HasInactiveDescriptorError(err) {
+			// The table is being dropped or is not public yet.
+			return nil
+		}
+		return err
+	}
+	if indexID != t.desc.GetPrimaryIndexID() {
+		// Only the primary index holds the rows of the table.
+		return nil
+	}
+
+	kv := roachpb.KeyValue{Key: ev.Key, Value: ev.Value}
+	if !ev.Value.IsPresent() {
+		if !ev.PrevValue.IsPresent() {
+			// The row did not exist.
+			return nil
+		}
+		// The key of a deleted row is all that is needed to identify it.
+		kv.Value = ev.PrevValue
+	}
+	datums, err := t.decodeRow(ctx, kv)
+	if err != nil {
+		return err
+	}
+	if !t.relationSent {
+		ws.msgs = append(ws.msgs, pgoutput.AppendRelation(nil, &t.rel))
+		t.relationSent = true
+	}
+	tuple := make(pgoutput.Tuple, len(datums))
+	for i, d := range datums {
+		if d == tree.DNull || (!ev.Value.IsPresent() && !t.rel.Columns[i].IsKey) {
+			continue
+		}
+		ws.fmtCtx.FormatNode(d)
+		tuple[i] = append([]byte(nil), ws.fmtCtx.Bytes()...)
+		ws.fmtCtx.Reset()
+	}
+	switch {
+	case !ev.Value.IsPresent():
+		ws.msgs = append(ws.msgs, pgoutput.AppendDelete(nil, t.rel.ID, tuple))
+	case ev.PrevValue.IsPresent():
+		ws.msgs = append(ws.msgs, pgoutput.AppendUpdate(nil, t.rel.ID, tuple))
+	default:
+		ws.msgs = append(ws.msgs, pgoutput.AppendInsert(nil, t.rel.ID, tuple))
+	}
+	return nil
+}
+
+// tableAt returns the decoding state of a table as of the given timestamp.
+// The state is rebuilt when the version of the table changes, in which case a
+// Relation message is sent again.
+func (ws *walSender) tableAt(
+	ctx context.Context, id descpb.ID, ts hlc.Timestamp,
+) (*walSenderTable, error) {
+	// The lease manager caches the versions of the descriptor, so it is cheap
+	// to use to check the version.
+	leased, err := ws.execCfg.LeaseManager.Acquire(ctx, lease.TimestampToReadTimestamp(ts), id)
+	if err != nil {
+		return nil, err
+	}
+	version := leased.Underlying().GetVersion()
+	leased.Release(ctx)
+	if t, ok := ws.tables[id]; ok && t.desc.GetVersion() == version {
+		return t, nil
+	}
+
+	// Read the descriptor with its types hydrated, along with the name of its
+	// schema, at the timestamp of the change.
+	t := &walSenderTable{}
+	if err := ws.execCfg.InternalDB.DescsTxn(ctx, func(ctx context.Context, txn descs.Txn) error {
+		if err := txn.KV().SetFixedTimestamp(ctx, ts); err != nil {
+			return err
+		}
+		tbl, err := txn.Descriptors().ByIDWithLeased(txn.KV()).WithoutNonPublic().Get().Table(ctx, id)
+		if err != nil {
+			return err
+		}
+		sc, err := txn.Descriptors().ByIDWithLeased(txn.KV()).Get().Schema(ctx, tbl.GetParentSchemaID())
+		if err != nil {
+			return err
+		}
+		t.desc = tbl
+		t.rel = pgoutput.Relation{
+			ID:        tableOid(tbl.GetID()).Oid,
+			Namespace: sc.GetName(),
+			Name:      tbl.GetName(),
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(t.desc.GetFamilies()) != 1 {
+		return nil, pgerror.Newf(pgcode.FeatureNotSupported,
+			"table %q with multiple column families cannot be replicated", t.desc.GetName())
+	}
+
+	keyCols := t.desc.GetPrimaryIndex().CollectKeyColumnIDs()
+	var colIDs []descpb.ColumnID
+	for _, col := range t.desc.PublicColumns() {
+		isKey := keyCols.Contains(col.GetID())
+		if col.IsVirtual() || (col.IsHidden() && !isKey) {
+			continue
+		}
+		colIDs = append(colIDs, col.GetID())
+		t.rel.Columns = append(t.rel.Columns, pgoutput.Column{
+			Name:    col.GetName(),
+			TypeOID: col.GetType().Oid(),
+			TypeMod: col.GetType().TypeModifier(),
+			IsKey:   isKey,
+		})
+	}
+	var spec fetchpb.IndexFetchSpec
+	if err := rowenc.InitIndexFetchSpec(
+		&spec, ws.execCfg.Codec, t.desc, t.desc.GetPrimaryIndex(), colIDs,
+	); err != nil {
+		return nil, err
+	}
+	if err := t.fetcher.Init(ctx, row.FetcherInitArgs{
+		WillUseKVProvider: true,
+		Alloc:             &ws.alloc,
+		Spec:              &spec,
+	}); err != nil {
+		return nil, err
+	}
+	if prev, ok := ws.tables[id]; ok {
+		prev.fetcher.Close(ctx)
+	}
+	ws.tables[id] = t
+	return t, nil
+}
+
+// decodeRow decodes the row stored in a KV of the primary index.
+func (t *walSenderTable) decodeRow(ctx context.Context, kv roachpb.KeyValue) (tree.Datums, error) {
+	if err := t.fetcher.ConsumeKVProvider(ctx, &row.KVProvider{KVs: []roachpb.KeyValue{kv}}); err != nil {
+		return nil, err
+	}
+	datums, _, err := t.fetcher.NextRowDecoded(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if datums == nil {
+		return nil, errors.AssertionFailedf("no row decoded from key %s", kv.Key)
+	}
+	return datums, nil
+}
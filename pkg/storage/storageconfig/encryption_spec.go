@@ -20,6 +20,8 @@ type EncryptionOptions struct {
 	KeySource EncryptionKeySource `yaml:"key-source,omitempty"`
 	// Set if KeySource == EncryptionKeyFiles.
 	KeyFiles *EncryptionKeyFiles `yaml:",inline"`
+	// Set if KeySource == EncryptionKeyFromKMS.
+	KMS *EncryptionKMS `yaml:",inline"`
 	// Data key rotation period. Defaults to 7 days if not specified.
 	RotationPeriod time.Duration `yaml:"rotation-period,omitempty"`
 }
@@ -32,11 +34,24 @@ type EncryptionKeyFiles struct {
 	OldKey string `yaml:"old-key"`
 }
 
+// EncryptionKMS is used when the store key is encrypted by a KMS. The store
+// key is generated by the store and is only ever written to disk encrypted by
+// the KMS master key.
+type EncryptionKMS struct {
+	// URI of the KMS master key used to encrypt the store key.
+	URI string `yaml:"kms"`
+	// URI of the previous KMS master key. It is only needed when rotating to a
+	// new master key, until the store has re-encrypted its store keys using the
+	// new one.
+	OldURI string `yaml:"old-kms,omitempty"`
+}
+
 // EncryptionKeySource is an enum identifying the source of the encryption key.
 type EncryptionKeySource int32
 
 const (
 	EncryptionKeyFromFiles EncryptionKeySource = 0
+	EncryptionKeyFromKMS   EncryptionKeySource = 1
 )
 
 var _ yaml.IsZeroer = EncryptionKeyFromFiles
@@ -59,11 +74,18 @@ func (e *EncryptionOptions) Validate() error {
 	} else if e.RotationPeriod < time.Second {
 		return errors.Newf("invalid rotation period %s", e.RotationPeriod)
 	}
+	if e.KeySource == EncryptionKeyFromFiles && e.KeyFiles == nil && e.KMS != nil {
+		// The key source is implied when only a KMS is specified.
+		e.KeySource = EncryptionKeyFromKMS
+	}
 	switch e.KeySource {
 	case EncryptionKeyFromFiles:
 		if e.KeyFiles == nil {
 			return errors.New("no key files specified")
 		}
+		if e.KMS != nil {
+			return errors.New("key files and kms cannot both be specified")
+		}
 		currentKey, err := getAbsoluteFSPath("key", e.KeyFiles.CurrentKey)
 		if err != nil {
 			return err
@@ -76,6 +98,15 @@ func (e *EncryptionOptions) Validate() error {
 		e.KeyFiles.OldKey = oldKey
 		return nil
 
+	case EncryptionKeyFromKMS:
+		if e.KMS == nil || e.KMS.URI == "" {
+			return errors.New("no kms specified")
+		}
+		if e.KeyFiles != nil {
+			return errors.New("key files and kms cannot both be specified")
+		}
+		return nil
+
 	default:
 		return errors.Newf("unknown key source %d", e.KeySource)
 	}
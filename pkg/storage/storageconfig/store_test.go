@@ -45,6 +45,17 @@ func TestStoreYAMLOutput(t *testing.T) {
 				RotationPeriod: time.Hour,
 			},
 		},
+		"kms": {
+			Path: "/mnt/data1",
+			EncryptionOptions: &EncryptionOptions{
+				KeySource: EncryptionKeyFromKMS,
+				KMS: &EncryptionKMS{
+					URI:    "aws-kms:///new-key?AUTH=implicit&REGION=us-east-1",
+					OldURI: "aws-kms:///old-key?AUTH=implicit&REGION=us-east-1",
+				},
+				RotationPeriod: time.Hour,
+			},
+		},
 		"pebble-opts": {
 			Path: "/mnt/data1",
 			PebbleOptions: `[Options]
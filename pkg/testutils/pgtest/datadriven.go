@@ -240,6 +240,12 @@ func MsgsToJSONWithIgnore(msgs []pgproto3.BackendMessage, args *datadriven.TestD
 					m.ConstraintName = ""
 				}
 			}
+		case "ignore_pids":
+			for _, msg := range msgs {
+				if m, ok := msg.(*pgproto3.NotificationResponse); ok {
+					m.PID = 0
+				}
+			}
 		case "ignore":
 			for _, typ := range arg.Vals {
 				ignore[fmt.Sprintf("*pgproto3.%s", typ)] = true
@@ -311,6 +317,8 @@ func toMessage(typ string) interface{} {
 		return &pgproto3.Execute{}
 	case "Flush":
 		return &pgproto3.Flush{}
+	case "NotificationResponse":
+		return &pgproto3.NotificationResponse{}
 	case "Parse":
 		return &pgproto3.Parse{}
 	case "PortalSuspended":
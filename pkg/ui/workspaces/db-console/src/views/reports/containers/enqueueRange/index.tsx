@@ -30,6 +30,7 @@ const QUEUES = [
   "raftsnapshot",
   "consistencyChecker",
   "timeSeriesMaintenance",
+  "coldStorage",
 ];
 
 const queueOptions = QUEUES.map(q => {
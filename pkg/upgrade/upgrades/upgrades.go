@@ -123,6 +123,34 @@ var upgrades = []upgradebase.Upgrade{
 
 	newFirstUpgrade(clusterversion.V26_1_Start.Version()),
 
+	upgrade.NewTenantUpgrade(
+		"create notifications table",
+		clusterversion.V26_1_AddSystemNotificationsTable.Version(),
+		upgrade.NoPrecondition,
+		createNotificationsTable,
+		upgrade.RestoreActionNotRequired(
+			"cluster restore does not restore this table",
+		),
+	),
+
+	upgrade.NewTenantUpgrade(
+		"create publications and replication_slots tables",
+		clusterversion.V26_1_AddSystemPublicationsTables.Version(),
+		upgrade.NoPrecondition,
+		createPublicationsTables,
+		upgrade.RestoreActionNotRequired(
+			"cluster restore does not restore these tables",
+		),
+	),
+
+	upgrade.NewTenantUpgrade(
+		"add dependencyDegrees column to system.table_statistics",
+		clusterversion.V26_1_TableStatisticsDependencyDegrees.Version(),
+		upgrade.NoPrecondition,
+		tableStatisticsDependencyDegreesMigration,
+		upgrade.RestoreActionNotRequired("cluster restore does not restore the new column"),
+	),
+
 	// Note: when starting a new release version, the first upgrade (for
 	// Vxy_zStart) must be a newFirstUpgrade. Keep this comment at the bottom.
 }
@@ -0,0 +1,33 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package upgrades
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/upgrade"
+)
+
+// createPublicationsTables creates the system.publications and
+// system.replication_slots tables.
+func createPublicationsTables(
+	ctx context.Context, _ clusterversion.ClusterVersion, d upgrade.TenantDeps,
+) error {
+	for _, tbl := range []catalog.TableDescriptor{
+		systemschema.PublicationsTable, systemschema.ReplicationSlotsTable,
+	} {
+		if err := createSystemTable(
+			ctx, d.DB, d.Settings, d.Codec, tbl, tree.LocalityLevelTable,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package upgrades
+
+import (
+	"context"
+
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/upgrade"
+)
+
+const addTableStatisticsDependencyDegreesColumn = `
+ALTER TABLE system.table_statistics
+	ADD COLUMN IF NOT EXISTS "dependencyDegrees" FLOAT8[]
+	FAMILY "fam_0_tableID_statisticID_name_columnIDs_createdAt_rowCount_distinctCount_nullCount_histogram"
+`
+
+func tableStatisticsDependencyDegreesMigration(
+	ctx context.Context, version clusterversion.ClusterVersion, deps upgrade.TenantDeps,
+) error {
+	op := operation{
+		name:           "add-dependency-degrees-column-to-table-statistics",
+		schemaList:     []string{"dependencyDegrees"},
+		query:          addTableStatisticsDependencyDegreesColumn,
+		schemaExistsFn: hasColumn,
+	}
+	return migrateTable(ctx, version, deps, op, keys.TableStatisticsTableID,
+		systemschema.TableStatisticsTable)
+}
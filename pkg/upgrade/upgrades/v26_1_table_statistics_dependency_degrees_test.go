@@ -0,0 +1,114 @@
+// Copyright 2026 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package upgrades_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/base"
+	"github.com/cockroachdb/cockroach/pkg/clusterversion"
+	"github.com/cockroachdb/cockroach/pkg/keys"
+	"github.com/cockroachdb/cockroach/pkg/server"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/descpb"
+	"github.com/cockroachdb/cockroach/pkg/sql/catalog/systemschema"
+	"github.com/cockroachdb/cockroach/pkg/testutils/sqlutils"
+	"github.com/cockroachdb/cockroach/pkg/testutils/testcluster"
+	"github.com/cockroachdb/cockroach/pkg/upgrade/upgrades"
+	"github.com/cockroachdb/cockroach/pkg/util/leaktest"
+	"github.com/cockroachdb/cockroach/pkg/util/log"
+	"github.com/cockroachdb/cockroach/pkg/util/protoutil"
+)
+
+func TestTableStatisticsDependencyDegreesMigration(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+	defer log.Scope(t).Close(t)
+
+	clusterversion.SkipWhenMinSupportedVersionIsAtLeast(t, clusterversion.V26_1)
+
+	clusterArgs := base.TestClusterArgs{
+		ServerArgs: base.TestServerArgs{
+			Knobs: base.TestingKnobs{
+				Server: &server.TestingKnobs{
+					DisableAutomaticVersionUpgrade: make(chan struct{}),
+					ClusterVersionOverride:         clusterversion.MinSupported.Version(),
+				},
+			},
+		},
+	}
+
+	var (
+		ctx   = context.Background()
+		tc    = testcluster.StartTestCluster(t, 1, clusterArgs)
+		s     = tc.Server(0)
+		sqlDB = tc.ServerConn(0)
+	)
+	defer tc.Stopper().Stop(ctx)
+
+	var (
+		validationStmts = []string{
+			`SELECT "dependencyDegrees" FROM system.table_statistics LIMIT 0`,
+		}
+		validationSchemas = []upgrades.Schema{
+			{Name: "dependencyDegrees", ValidationFn: upgrades.HasColumn},
+		}
+	)
+
+	// Inject the old copy of the descriptor.
+	upgrades.InjectLegacyTable(ctx, t, s, systemschema.TableStatisticsTable,
+		getOldTableStatisticsDescriptor)
+	validateSchemaExists := func(expectExists bool) {
+		upgrades.ValidateSchemaExists(
+			ctx,
+			t,
+			s,
+			sqlDB,
+			keys.TableStatisticsTableID,
+			systemschema.TableStatisticsTable,
+			validationStmts,
+			validationSchemas,
+			expectExists,
+		)
+	}
+	// Validate that the table_statistics table has the old schema.
+	validateSchemaExists(false)
+	// Statistics can be read and written before the upgrade.
+	r := sqlutils.MakeSQLRunner(sqlDB)
+	r.Exec(t, `CREATE TABLE t (a INT, b INT)`)
+	r.Exec(t, `CREATE STATISTICS s ON a, b FROM t`)
+	r.Exec(t, `SHOW STATISTICS FOR TABLE t`)
+	// Run the upgrade.
+	upgrades.Upgrade(
+		t,
+		sqlDB,
+		clusterversion.V26_1_TableStatisticsDependencyDegrees,
+		nil,   /* done */
+		false, /* expectError */
+	)
+	// Validate that the table has the new schema.
+	validateSchemaExists(true)
+	// The degrees of functional dependency are stored once the column exists.
+	r.Exec(t, `INSERT INTO t VALUES (1, 1), (2, 1)`)
+	r.Exec(t, `CREATE STATISTICS s ON a, b FROM t`)
+	r.CheckQueryResults(t, `
+SELECT "dependencyDegrees" FROM system.table_statistics
+WHERE "tableID" = 't'::REGCLASS::INT8 AND "dependencyDegrees" IS NOT NULL`,
+		[][]string{{"{1,0}"}},
+	)
+}
+
+// getOldTableStatisticsDescriptor returns the system.table_statistics table
+// descriptor that was being used before adding the dependencyDegrees column.
+func getOldTableStatisticsDescriptor() *descpb.TableDescriptor {
+	tableDesc := protoutil.Clone(systemschema.TableStatisticsTable.TableDesc()).(*descpb.TableDescriptor)
+	tableDesc.Version = 1
+	tableDesc.Columns = tableDesc.Columns[:len(tableDesc.Columns)-1]
+	tableDesc.NextColumnID--
+	fam := &tableDesc.Families[0]
+	fam.ColumnNames = fam.ColumnNames[:len(fam.ColumnNames)-1]
+	fam.ColumnIDs = fam.ColumnIDs[:len(fam.ColumnIDs)-1]
+	return tableDesc
+}
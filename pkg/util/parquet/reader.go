@@ -0,0 +1,111 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package parquet
+
+import (
+	"github.com/apache/arrow/go/v11/parquet"
+	"github.com/apache/arrow/go/v11/parquet/file"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgcode"
+	"github.com/cockroachdb/cockroach/pkg/sql/pgwire/pgerror"
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/cockroachdb/errors"
+)
+
+// ReadRows reads the rows of a parquet file and calls fn with the datums of
+// every row. The columns are looked up in the file by name, so the file may
+// contain more columns than requested, in any order.
+//
+// Unlike ReadFile, ReadRows does not require the CRDB-specific reader
+// metadata. Instead, every column must be encoded the way the Writer encodes
+// a column of the requested type, which is the case for files written by
+// EXPORT and by changefeeds. Tuple, enum and collated string columns are not
+// supported.
+func ReadRows(
+	r parquet.ReaderAtSeeker,
+	columnNames []string,
+	columnTypes []*types.T,
+	fn func(row tree.Datums) error,
+) (err error) {
+	for i, typ := range columnTypes {
+		elemTyp := typ
+		if typ.Family() == types.ArrayFamily {
+			elemTyp = typ.ArrayContents()
+		}
+		switch elemTyp.Family() {
+		case types.TupleFamily, types.EnumFamily, types.CollatedStringFamily:
+			return pgerror.Newf(pgcode.FeatureNotSupported,
+				"cannot read column %q of type %s from a parquet file", columnNames[i], typ.SQLString())
+		}
+	}
+	sd, err := NewSchema(columnNames, columnTypes)
+	if err != nil {
+		return err
+	}
+
+	reader, err := file.NewParquetReader(r)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := reader.Close(); closeErr != nil {
+			err = errors.CombineErrors(err, closeErr)
+		}
+	}()
+
+	// Find the physical column of the file for every requested column, and
+	// check that it is encoded the way the Writer encodes the requested type.
+	fileSchema := reader.MetaData().Schema
+	fileCols := make([]int, len(sd.cols))
+	decoders := make([]decoder, len(sd.cols))
+	for i, col := range sd.cols {
+		want := sd.schema.Column(col.physicalColsStartIdx)
+		idx := fileSchema.ColumnIndexByName(want.Path())
+		if idx < 0 {
+			return pgerror.Newf(pgcode.UndefinedColumn,
+				"column %q not found in parquet file", columnNames[i])
+		}
+		got := fileSchema.Column(idx)
+		if got.PhysicalType() != want.PhysicalType() ||
+			got.MaxDefinitionLevel() != want.MaxDefinitionLevel() {
+			return pgerror.Newf(pgcode.DatatypeMismatch,
+				"column %q of parquet file cannot be read as %s",
+				columnNames[i], columnTypes[i].SQLString())
+		}
+		fileCols[i] = idx
+		// NB: the type of an array column is the type of its elements.
+		if decoders[i], err = decoderFromFamilyAndType(col.typ.Oid(), col.typ.Family()); err != nil {
+			return err
+		}
+	}
+
+	colDatums := make([]tree.Datums, len(fileCols))
+	for rg := 0; rg < reader.NumRowGroups(); rg++ {
+		rgr := reader.RowGroup(rg)
+		rowsInRowGroup := rgr.NumRows()
+		for i, idx := range fileCols {
+			col, err := rgr.Column(idx)
+			if err != nil {
+				return err
+			}
+			isArray := columnTypes[i].Family() == types.ArrayFamily
+			colDatums[i], err = readColInRowGroup(col, decoders[i], rowsInRowGroup, isArray, false /* isTuple */)
+			if err != nil {
+				return err
+			}
+		}
+		for rowIdx := int64(0); rowIdx < rowsInRowGroup; rowIdx++ {
+			row := make(tree.Datums, len(colDatums))
+			for i := range colDatums {
+				row[i] = colDatums[i][rowIdx]
+			}
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The Cockroach Authors.
+//
+// Use of this software is governed by the CockroachDB Software License
+// included in the /LICENSE file.
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/pkg/sql/sem/tree"
+	"github.com/cockroachdb/cockroach/pkg/sql/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRows(t *testing.T) {
+	colNames := []string{"a", "b", "c"}
+	colTypes := []*types.T{types.Int, types.String, types.IntArray}
+	schemaDef, err := NewSchema(colNames, colTypes)
+	require.NoError(t, err)
+
+	arr := tree.NewDArray(types.Int)
+	require.NoError(t, arr.Append(tree.NewDInt(1)))
+	require.NoError(t, arr.Append(tree.DNull))
+	written := [][]tree.Datum{
+		{tree.NewDInt(1), tree.NewDString("foo"), arr},
+		{tree.NewDInt(2), tree.DNull, tree.DNull},
+		{tree.DNull, tree.NewDString("bar"), tree.NewDArray(types.Int)},
+	}
+
+	var buf bytes.Buffer
+	// Use small row groups so that the rows span several of them.
+	writer, err := NewWriter(schemaDef, &buf, WithMaxRowGroupLength(2))
+	require.NoError(t, err)
+	for _, row := range written {
+		require.NoError(t, writer.AddRow(row))
+	}
+	require.NoError(t, writer.Close())
+
+	read := func(names []string, typs []*types.T) ([]tree.Datums, error) {
+		var rows []tree.Datums
+		err := ReadRows(bytes.NewReader(buf.Bytes()), names, typs, func(row tree.Datums) error {
+			rows = append(rows, row)
+			return nil
+		})
+		return rows, err
+	}
+
+	t.Run("all columns", func(t *testing.T) {
+		rows, err := read(colNames, colTypes)
+		require.NoError(t, err)
+		require.Len(t, rows, len(written))
+		for i := range written {
+			for j := range written[i] {
+				ValidateDatum(t, written[i][j], rows[i][j])
+			}
+		}
+	})
+
+	t.Run("subset of columns out of order", func(t *testing.T) {
+		rows, err := read([]string{"c", "a"}, []*types.T{types.IntArray, types.Int})
+		require.NoError(t, err)
+		require.Len(t, rows, len(written))
+		for i := range written {
+			ValidateDatum(t, written[i][2], rows[i][0])
+			ValidateDatum(t, written[i][0], rows[i][1])
+		}
+	})
+
+	t.Run("no columns", func(t *testing.T) {
+		rows, err := read(nil, nil)
+		require.NoError(t, err)
+		require.Len(t, rows, len(written))
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		_, err := read([]string{"d"}, []*types.T{types.Int})
+		require.ErrorContains(t, err, `column "d" not found in parquet file`)
+	})
+
+	t.Run("mismatched type", func(t *testing.T) {
+		_, err := read([]string{"a"}, []*types.T{types.String})
+		require.ErrorContains(t, err, `column "a" of parquet file cannot be read as STRING`)
+	})
+}